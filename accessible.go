@@ -0,0 +1,157 @@
+package charts
+
+import (
+	"strconv"
+
+	"github.com/go-analyze/charts/chartdraw"
+)
+
+// AccessibleOption enables accessible chart output. The chart title and subtitle are set as the SVG title and
+// description, referenced from ARIA attributes on the document, and each series and data element is grouped with an
+// ARIA role and label. For PDF output the title and description are set in the document info.
+type AccessibleOption struct {
+	// Title overrides the accessible title, by default the chart title text is used.
+	Title string
+	// Description overrides the accessible description, by default the chart subtitle text is used.
+	Description string
+	// DataTable when set to *true embeds a visually hidden table of the series data within SVG output.
+	DataTable *bool
+}
+
+// accessibleState tracks the accessible options for a painter and its children.
+type accessibleState struct {
+	opt AccessibleOption
+	// documentSet is true once the document info has been set, so only the outermost chart sets it.
+	documentSet bool
+}
+
+// isAccessible returns true if chart elements should be labeled for assistive technologies.
+func (p *Painter) isAccessible() bool {
+	return p.accessible != nil && p.outputFormat == ChartOutputSVG
+}
+
+// setDocumentInfo sets the accessible document title, description and data table. Only the first call for a painter
+// and its children has an effect, so charts rendered within another chart don't replace the document info.
+func (p *Painter) setDocumentInfo(title TitleOption, sl seriesList, labels []string, valueFormatter ValueFormatter) {
+	if p.accessible == nil || p.accessible.documentSet {
+		return
+	}
+	p.accessible.documentSet = true
+
+	opt := p.accessible.opt
+	info := chartdraw.DocumentInfo{
+		Title:       opt.Title,
+		Description: opt.Description,
+	}
+	if info.Title == "" {
+		info.Title = title.Text
+	}
+	if info.Description == "" {
+		info.Description = title.Subtext
+	}
+	if flagIs(true, opt.DataTable) && sl.len() != 0 {
+		info.DataTable = newDataTable(sl, labels, valueFormatter)
+	}
+	if r, ok := p.render.(chartdraw.DocumentRenderer); ok {
+		r.SetDocumentInfo(info)
+	}
+}
+
+// dataTableColumn is a column of formatted values within a data table.
+type dataTableColumn struct {
+	header string
+	values []string
+}
+
+// newDataTable builds a table with a row for each category and a column for each series, candlestick series are
+// given a column for each of the open, high, low and close values. If every series has at most a single value and
+// there are no category labels, a row is used for each series instead.
+func newDataTable(sl seriesList, labels []string, valueFormatter ValueFormatter) *chartdraw.DataTable {
+	formatValue := func(v float64) string {
+		if v == GetNullValue() {
+			return ""
+		} else if valueFormatter != nil {
+			return valueFormatter(v)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	formatValues := func(values []float64) []string {
+		result := make([]string, len(values))
+		for i, v := range values {
+			result[i] = formatValue(v)
+		}
+		return result
+	}
+
+	var columns []dataTableColumn
+	var maxLen int
+	for i := 0; i < sl.len(); i++ {
+		name := sl.getSeriesName(i)
+		if name == "" {
+			name = "Series " + strconv.Itoa(i+1)
+		}
+		if ohlc := seriesOHLCData(sl.getSeries(i)); ohlc != nil {
+			open, high, low, closeCol := make([]string, len(ohlc)), make([]string, len(ohlc)),
+				make([]string, len(ohlc)), make([]string, len(ohlc))
+			for j, v := range ohlc {
+				open[j], high[j], low[j], closeCol[j] =
+					formatValue(v.Open), formatValue(v.High), formatValue(v.Low), formatValue(v.Close)
+			}
+			columns = append(columns,
+				dataTableColumn{header: name + " open", values: open},
+				dataTableColumn{header: name + " high", values: high},
+				dataTableColumn{header: name + " low", values: low},
+				dataTableColumn{header: name + " close", values: closeCol})
+			maxLen = chartdraw.MaxInt(maxLen, len(ohlc))
+			continue
+		}
+		values := sl.getSeriesValues(i)
+		columns = append(columns, dataTableColumn{header: name, values: formatValues(values)})
+		maxLen = chartdraw.MaxInt(maxLen, len(values))
+	}
+
+	if maxLen <= 1 && len(labels) == 0 {
+		table := &chartdraw.DataTable{
+			Header: []string{"Series", "Value"},
+			Rows:   make([][]string, len(columns)),
+		}
+		for i, col := range columns {
+			table.Rows[i] = []string{col.header, labelAt(col.values, 0)}
+		}
+		return table
+	}
+
+	table := &chartdraw.DataTable{
+		Header: make([]string, len(columns)+1),
+		Rows:   make([][]string, chartdraw.MaxInt(maxLen, len(labels))),
+	}
+	table.Header[0] = "Category"
+	for i, col := range columns {
+		table.Header[i+1] = col.header
+	}
+	for rowIndex := range table.Rows {
+		row := make([]string, len(columns)+1)
+		row[0] = labelAt(labels, rowIndex)
+		if row[0] == "" {
+			row[0] = strconv.Itoa(rowIndex + 1)
+		}
+		for i, col := range columns {
+			row[i+1] = labelAt(col.values, rowIndex)
+		}
+		table.Rows[rowIndex] = row
+	}
+	return table
+}
+
+// seriesOHLCData returns the OHLC data if the series is a candlestick series, otherwise nil.
+func seriesOHLCData(s series) []OHLCData {
+	switch v := s.(type) {
+	case *CandlestickSeries:
+		return v.Data
+	case *GenericSeries:
+		if v.Type == ChartTypeCandlestick {
+			return filterSeriesList[CandlestickSeriesList](GenericSeriesList{*v}, ChartTypeCandlestick)[0].Data
+		}
+	}
+	return nil
+}
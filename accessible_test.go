@@ -0,0 +1,101 @@
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func renderAccessibleSVG(t *testing.T, accessible AccessibleOption, draw func(p *Painter) error) string {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+		Accessible:   &accessible,
+	})
+	require.NoError(t, draw(p))
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestAccessibleLineChart(t *testing.T) {
+	t.Parallel()
+
+	svg := renderAccessibleSVG(t, AccessibleOption{DataTable: Ptr(true)}, func(p *Painter) error {
+		return p.LineChart(makeBasicLineChartOption())
+	})
+	assert.Contains(t, svg, `role="graphics-document document" aria-labelledby="`)
+	assert.NotContains(t, svg, "aria-describedby")
+	assert.Contains(t, svg, `-title">Line</title>`)
+	assert.Contains(t, svg, `<thead><tr><th scope="col">Category</th><th scope="col">1</th><th scope="col">2</th></tr></thead>`)
+	assert.Contains(t, svg, `<tr><th scope="row">A</th><td>120</td><td>820</td></tr>`)
+	assert.Equal(t, 7, strings.Count(svg, `<tr><th scope="row">`))
+	assert.Contains(t, svg, `<g class="chart-series" data-series="1" role="graphics-object" aria-label="1">`)
+	assert.Contains(t, svg, `<g class="chart-data" data-series="1" data-name="A" data-value="120" role="graphics-symbol" aria-label="1, A: 120">`)
+	// legend items are only grouped for interactive output
+	assert.NotContains(t, svg, "chart-legend-item")
+	assert.Equal(t, strings.Count(svg, "<g"), strings.Count(svg, "</g>"))
+}
+
+func TestAccessiblePieChart(t *testing.T) {
+	t.Parallel()
+
+	svg := renderAccessibleSVG(t, AccessibleOption{Description: "Share by series", DataTable: Ptr(true)},
+		func(p *Painter) error {
+			return p.PieChart(makeBasicPieChartOption())
+		})
+	assert.Contains(t, svg, `-title">Title</title>`)
+	assert.Contains(t, svg, `-desc">Share by series</desc>`)
+	assert.Contains(t, svg, `<thead><tr><th scope="col">Series</th><th scope="col">Value</th></tr></thead>`)
+	assert.Contains(t, svg, `<tr><th scope="row">Series-A</th><td>1048</td></tr>`)
+	assert.Equal(t, strings.Count(svg, "<g"), strings.Count(svg, "</g>"))
+}
+
+func TestAccessibleCandlestickDataTable(t *testing.T) {
+	t.Parallel()
+
+	svg := renderAccessibleSVG(t, AccessibleOption{DataTable: Ptr(true)}, func(p *Painter) error {
+		return p.CandlestickChart(makeBasicCandlestickChartOption())
+	})
+	assert.Contains(t, svg, `<th scope="col">Price open</th><th scope="col">Price high</th>`+
+		`<th scope="col">Price low</th><th scope="col">Price close</th>`)
+	assert.Contains(t, svg, `<tr><th scope="row">Jan</th><td>100</td><td>110</td><td>95</td><td>105</td></tr>`)
+}
+
+func TestAccessibleRender(t *testing.T) {
+	t.Parallel()
+
+	p, err := LineRender([][]float64{{120, GetNullValue(), 101}},
+		AccessibleSVGOptionFunc(AccessibleOption{Title: "Weekly email", DataTable: Ptr(true)}),
+		TitleTextOptionFunc("Email", "Last week"),
+		XAxisLabelsOptionFunc([]string{"Mon", "Tue", "Wed"}),
+		LegendLabelsOptionFunc([]string{"Email"}),
+	)
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	svg := string(data)
+	assert.Contains(t, svg, `-title">Weekly email</title>`)
+	assert.Contains(t, svg, `-desc">Last week</desc>`)
+	assert.Contains(t, svg, `<tr><th scope="row">Tue</th><td></td></tr>`)
+	assert.Contains(t, svg, `<g class="chart-series" data-series="Email" role="graphics-object" aria-label="Email">`)
+	assert.NotContains(t, svg, "<script")
+
+	// the title and description are set as the PDF document info
+	p, err = LineRender([][]float64{{120, 132, 101}},
+		func(opt *ChartOption) {
+			opt.Accessible = &AccessibleOption{}
+		},
+		TitleTextOptionFunc("Email"),
+		PDFOutputOptionFunc(),
+	)
+	require.NoError(t, err)
+	data, err = p.Bytes()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "/Title <FEFF0045006D00610069006C>")
+}
@@ -15,9 +15,41 @@ type Box = chartdraw.Box
 // Point represents an X,Y coordinate pair.
 type Point = chartdraw.Point
 
+// PointF represents an X,Y coordinate pair with subpixel precision.
+type PointF = chartdraw.PointF
+
 // Color represents an RGBA color.
 type Color = drawing.Color
 
+// LinearGradient describes a linear color gradient relative to the bounding box of the filled shape.
+type LinearGradient = drawing.LinearGradient
+
+// GradientStop defines the color at an offset along a LinearGradient.
+type GradientStop = drawing.GradientStop
+
+// NewVerticalGradient returns a LinearGradient from the top color at the top of the shape to the bottom color at the bottom.
+func NewVerticalGradient(top, bottom Color) LinearGradient {
+	return drawing.NewVerticalGradient(top, bottom)
+}
+
+// EncoderOptions configures the JPG quality, PNG compression level and PNG palette use for raster output.
+type EncoderOptions = chartdraw.EncoderOptions
+
+// TerminalOptions configures the character mode, width and colors of terminal output.
+type TerminalOptions = chartdraw.TerminalOptions
+
+// ImageFilter selects the interpolation used when scaling images in raster output.
+type ImageFilter = drawing.ImageFilter
+
+const (
+	// ImageFilterNearest scales images using the nearest pixel, this is the default.
+	ImageFilterNearest = drawing.NearestFilter
+	// ImageFilterBilinear scales images using bilinear interpolation.
+	ImageFilterBilinear = drawing.BilinearFilter
+	// ImageFilterBicubic scales images using bicubic interpolation, producing the smoothest result.
+	ImageFilterBicubic = drawing.BicubicFilter
+)
+
 // FontStyle configures font properties including size, color, and family.
 type FontStyle = chartdraw.FontStyle
 
@@ -63,7 +95,7 @@ func fillFontStyleDefaults(fs FontStyle, defaultSize float64, defaultColor Color
 	return fs
 }
 
-// mergeFontStyles sets from the default FontStyles the size, color, and font as
+// mergeFontStyles sets from the default FontStyles the size, color, font, and rich text flag as
 // provided by the default styles (in order).
 func mergeFontStyles(primary FontStyle, defaultFs ...FontStyle) FontStyle {
 	if primary.FontSize == 0 {
@@ -90,6 +122,14 @@ func mergeFontStyles(primary FontStyle, defaultFs ...FontStyle) FontStyle {
 			}
 		}
 	}
+	if primary.RichText == nil {
+		for _, fs := range defaultFs {
+			if fs.RichText != nil {
+				primary.RichText = fs.RichText
+				break
+			}
+		}
+	}
 	return primary
 }
 
@@ -185,9 +225,26 @@ const (
 	ChartOutputSVG           = "svg"
 	ChartOutputPNG           = "png"
 	ChartOutputJPG           = "jpg"
+	ChartOutputPDF           = "pdf"
+	ChartOutputTerminal      = "terminal"
 	chartDefaultOutputFormat = ChartOutputPNG
 )
 
+const (
+	// TerminalBraille renders terminal output with a 2x4 grid of braille dots per character.
+	TerminalBraille = chartdraw.TerminalBraille
+	// TerminalBlock renders terminal output with two vertically stacked half blocks per character.
+	TerminalBlock = chartdraw.TerminalBlock
+	// TerminalSixel renders terminal output as Sixel graphics.
+	TerminalSixel = chartdraw.TerminalSixel
+	// TerminalColorTrue colors terminal output with 24-bit ANSI escape sequences.
+	TerminalColorTrue = chartdraw.TerminalColorTrue
+	// TerminalColor256 colors terminal output with the ANSI 256 color palette.
+	TerminalColor256 = chartdraw.TerminalColor256
+	// TerminalColorNone renders terminal output without escape sequences.
+	TerminalColorNone = chartdraw.TerminalColorNone
+)
+
 const (
 	PositionLeft   = "left"
 	PositionRight  = "right"
@@ -0,0 +1,169 @@
+package charts
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"math"
+	"time"
+
+	"github.com/go-analyze/charts/chartdraw"
+)
+
+const (
+	// ChartOutputGIF renders an animation as an animated GIF.
+	ChartOutputGIF = "gif"
+	// ChartOutputAPNG renders an animation as an animated PNG.
+	ChartOutputAPNG = "apng"
+)
+
+// AnimationOption configures rendering a sequence of charts as an animation.
+type AnimationOption struct {
+	// OutputFormat specifies the animation format: ChartOutputGIF (default), ChartOutputAPNG, or ChartOutputSVG for
+	// an SVG document animated with SMIL.
+	OutputFormat string
+	// Frames are the charts rendered for each frame of the animation. If empty, frames are generated from the Chart
+	// using the FrameFunc.
+	Frames []ChartOption
+	// Chart is the base chart used to generate frames when Frames is not set.
+	Chart ChartOption
+	// FrameCount is the number of frames to generate from the Chart.
+	FrameCount int
+	// FrameFunc updates a copy of the Chart for the frame at the given index. Slices within the option are shared
+	// between frames, so the function should replace rather than modify them.
+	FrameFunc func(index int, opt *ChartOption)
+	// FrameDelay is the time each frame is shown, default is 500ms.
+	FrameDelay time.Duration
+	// PlayCount is the number of times the animation is played, zero repeats the animation forever.
+	PlayCount int
+	// DynamicRange when set to *true allows the y-axis ranges to change between frames. By default the ranges
+	// are calculated across the data of all frames so the axes stay fixed as the data changes.
+	DynamicRange *bool
+}
+
+// frames returns the chart options for each frame of the animation.
+func (o *AnimationOption) frames() ([]ChartOption, error) {
+	if len(o.Frames) != 0 {
+		return o.Frames, nil
+	} else if o.FrameFunc == nil || o.FrameCount <= 0 {
+		return nil, errors.New("animation requires Frames or a FrameCount and FrameFunc")
+	}
+	frames := make([]ChartOption, o.FrameCount)
+	for i := range frames {
+		frames[i] = o.Chart
+		o.FrameFunc(i, &frames[i])
+	}
+	return frames, nil
+}
+
+// RenderAnimation renders each frame of the animation and encodes them as an animated GIF, PNG, or SVG.
+func RenderAnimation(opt AnimationOption) ([]byte, error) {
+	frames, err := opt.frames()
+	if err != nil {
+		return nil, err
+	}
+	frames = append([]ChartOption(nil), frames...) // copy so axis ranges can be set without changing the caller slice
+	if !flagIs(true, opt.DynamicRange) {
+		setStableAxisRanges(frames)
+	}
+	animOpt := chartdraw.AnimationOptions{
+		FrameDelay: opt.FrameDelay,
+		PlayCount:  opt.PlayCount,
+	}
+
+	frameFormat := ChartOutputPNG
+	switch opt.OutputFormat {
+	case "", ChartOutputGIF, ChartOutputAPNG:
+	case ChartOutputSVG:
+		frameFormat = ChartOutputSVG
+	default:
+		return nil, fmt.Errorf("unsupported animation output format: %s", opt.OutputFormat)
+	}
+	painters := make([]*Painter, len(frames))
+	for i, frame := range frames {
+		frame.OutputFormat = frameFormat
+		if painters[i], err = Render(frame); err != nil {
+			return nil, fmt.Errorf("error rendering animation frame %d: %w", i, err)
+		}
+	}
+
+	if frameFormat == ChartOutputSVG {
+		documents := make([][]byte, len(painters))
+		for i, p := range painters {
+			if documents[i], err = p.Bytes(); err != nil {
+				return nil, err
+			}
+		}
+		return chartdraw.AnimateSVG(documents, animOpt)
+	}
+	images := make([]image.Image, len(painters))
+	for i, p := range painters {
+		if images[i], err = p.Image(); err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	if opt.OutputFormat == ChartOutputAPNG {
+		err = chartdraw.EncodeAPNG(&buf, images, animOpt)
+	} else {
+		err = chartdraw.EncodeGIF(&buf, images, animOpt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// setStableAxisRanges sets the y-axis range of every frame to the padded range of the data across all frames, so the
+// axes don't move as the animation progresses. The axis configuration of the first frame is used when padding.
+// Horizontal bar frames share the data extent of their x-axis instead.
+func setStableAxisRanges(frames []ChartOption) {
+	setSharedAxisRanges(frames, true, false)
+
+	var vertical []*ChartOption
+	var axisCount int
+	for i := range frames {
+		if !hasHorizontalBarSeries(frames[i].SeriesList) {
+			vertical = append(vertical, &frames[i])
+			axisCount = chartdraw.MaxInt(axisCount, getSeriesYAxisCount(frames[i].SeriesList))
+		}
+	}
+	for yIndex := 0; yIndex < axisCount; yIndex++ {
+		var axis YAxisOption
+		if len(vertical[0].YAxis) > yIndex {
+			axis = vertical[0].YAxis[yIndex]
+		}
+		minVal, maxVal := math.MaxFloat64, -math.MaxFloat64
+		for _, frame := range vertical {
+			frameMin, frameMax := getValueAxisExtent(frame.SeriesList, yIndex, flagIs(true, frame.StackSeries))
+			minVal, maxVal = math.Min(minVal, frameMin), math.Max(maxVal, frameMax)
+		}
+
+		minPadScale, maxPadScale := 1.0, 1.0
+		if axis.RangeValuePaddingScale != nil {
+			minPadScale = *axis.RangeValuePaddingScale
+			maxPadScale = minPadScale
+		}
+		if axis.Min != nil && *axis.Min < minVal {
+			minVal = *axis.Min
+			minPadScale = 0.0
+		}
+		if axis.Max != nil && *axis.Max > maxVal {
+			maxVal = *axis.Max
+			maxPadScale = 0.0
+		}
+		labelCount := estimateValueLabelCount(minVal, maxVal, axis.LabelCount, axis.Unit, axis.LabelCountAdjustment)
+		minPadded, maxPadded := padRange(labelCount, minVal, maxVal, minPadScale, maxPadScale)
+
+		for _, frame := range vertical {
+			yAxis := make([]YAxisOption, chartdraw.MaxInt(len(frame.YAxis), yIndex+1))
+			copy(yAxis, frame.YAxis)
+			yAxis[yIndex].Min = Ptr(minPadded)
+			yAxis[yIndex].Max = Ptr(maxPadded)
+			// the padded range is already applied, so every frame renders the same range
+			yAxis[yIndex].RangeValuePaddingScale = Ptr(0.0)
+			frame.YAxis = yAxis
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package charts
+
+import (
+	"bytes"
+	"image/gif"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeAnimationOption(format string) AnimationOption {
+	return AnimationOption{
+		OutputFormat: format,
+		Chart: ChartOption{
+			Width:      600,
+			Height:     400,
+			SeriesList: NewSeriesListLine([][]float64{{0, 0, 0}}).ToGenericSeriesList(),
+			XAxis:      XAxisOption{Labels: []string{"A", "B", "C"}},
+		},
+		FrameCount: 3,
+		FrameFunc: func(index int, opt *ChartOption) {
+			scale := float64(index + 1)
+			opt.SeriesList = NewSeriesListLine([][]float64{{10 * scale, 40 * scale, 25 * scale}}).ToGenericSeriesList()
+			opt.Title = TitleOption{Text: "Frame " + string(rune('1'+index))}
+		},
+	}
+}
+
+func TestSetStableAxisRanges(t *testing.T) {
+	t.Parallel()
+
+	opt := makeAnimationOption(ChartOutputSVG)
+	frames, err := opt.frames()
+	require.NoError(t, err)
+	setStableAxisRanges(frames)
+
+	for _, frame := range frames {
+		require.Len(t, frame.YAxis, 1)
+		assert.Equal(t, 0.0, *frame.YAxis[0].Min)
+		assert.Equal(t, 126.0, *frame.YAxis[0].Max)
+	}
+	assert.Nil(t, opt.Chart.YAxis, "base chart should not be modified")
+
+	// each frame renders the same axis labels
+	labelPattern := regexp.MustCompile(`>(\d+)</text>`)
+	var expectedLabels [][]string
+	for i, frame := range frames {
+		frame.OutputFormat = ChartOutputSVG
+		p, err := Render(frame)
+		require.NoError(t, err)
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		var labels [][]string
+		for _, m := range labelPattern.FindAllStringSubmatch(string(data), -1) {
+			labels = append(labels, m[1:])
+		}
+		if i == 0 {
+			expectedLabels = labels
+		} else {
+			assert.Equal(t, expectedLabels, labels)
+		}
+	}
+}
+
+func TestSetStableAxisRangesHorizontalBar(t *testing.T) {
+	t.Parallel()
+
+	frames := []ChartOption{
+		{SeriesList: NewSeriesListHorizontalBar([][]float64{{10, 20}}).ToGenericSeriesList()},
+		{SeriesList: NewSeriesListHorizontalBar([][]float64{{5, 80}}).ToGenericSeriesList()},
+	}
+	setStableAxisRanges(frames)
+
+	for _, frame := range frames {
+		assert.Equal(t, &valueExtent{min: 5, max: 80}, frame.XAxis.dataExtent)
+		assert.Nil(t, frame.YAxis, "category axis should not be given a value range")
+	}
+}
+
+func TestRenderAnimationGIF(t *testing.T) {
+	t.Parallel()
+
+	data, err := RenderAnimation(makeAnimationOption(""))
+	require.NoError(t, err)
+	anim, err := gif.DecodeAll(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, anim.Image, 3)
+	assert.Equal(t, 600, anim.Config.Width)
+	assert.Equal(t, []int{50, 50, 50}, anim.Delay)
+}
+
+func TestRenderAnimationAPNG(t *testing.T) {
+	t.Parallel()
+
+	data, err := RenderAnimation(makeAnimationOption(ChartOutputAPNG))
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")))
+	assert.Equal(t, 3, bytes.Count(data, []byte("fcTL")))
+}
+
+func TestRenderAnimationSVG(t *testing.T) {
+	t.Parallel()
+
+	data, err := RenderAnimation(makeAnimationOption(ChartOutputSVG))
+	require.NoError(t, err)
+	svg := string(data)
+	assert.Equal(t, 1, strings.Count(svg, "<svg"))
+	assert.Contains(t, svg, `<animate attributeName="d" values="`)
+	assert.Contains(t, svg, `>Frame 2<animate attributeName="visibility" values="hidden;visible;hidden" calcMode="discrete" dur="1.5s" repeatCount="indefinite"/></text>`)
+}
+
+func TestRenderAnimationErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := RenderAnimation(AnimationOption{})
+	require.Error(t, err)
+
+	_, err = RenderAnimation(makeAnimationOption(ChartOutputPDF))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported animation output format")
+}
@@ -0,0 +1,166 @@
+package charts
+
+import (
+	"strings"
+
+	"github.com/go-analyze/charts/chartdraw"
+)
+
+const (
+	// autoSizeBarSize is the space reserved for each bar within a category when auto-sizing.
+	autoSizeBarSize = 16
+	// autoSizeCategoryMargin is the space reserved between bar categories when auto-sizing.
+	autoSizeCategoryMargin = 12
+	// autoSizeFunnelLayerSize is the space reserved for each funnel layer when auto-sizing.
+	autoSizeFunnelLayerSize = 32
+)
+
+// AutoSizeOption configures computing chart dimensions from the chart content.
+type AutoSizeOption struct {
+	// AspectRatio is the width divided by the height, used to calculate an unset Width or Height from the other.
+	AspectRatio float64
+	// CategorySize is the minimum space for each category (or funnel layer) along the axis which grows with the
+	// content. By default, it is calculated from the number of bars in each category.
+	CategorySize int
+	// MaxWidth limits the computed width, zero is unlimited.
+	MaxWidth int
+	// MaxHeight limits the computed height, zero is unlimited.
+	MaxHeight int
+}
+
+// baseSize returns the target dimensions before growing to fit the content.
+func (a *AutoSizeOption) baseSize(width, height int) (int, int) {
+	if a.AspectRatio > 0 {
+		if width <= 0 && height > 0 {
+			width = int(float64(height) * a.AspectRatio)
+		} else if height <= 0 {
+			width = getDefaultInt(width, defaultChartWidth)
+			height = int(float64(width) / a.AspectRatio)
+		}
+	}
+	return getDefaultInt(width, defaultChartWidth), getDefaultInt(height, defaultChartHeight)
+}
+
+// autoSizeContent returns the number of categories along the axis which grows with the content, the minimum size of
+// each category, and if the content grows the chart height rather than width. A zero count indicates the chart size
+// is not determined by the content.
+func (o *ChartOption) autoSizeContent() (int, int, bool) {
+	var barCount, horizontalBarCount, funnelCount, candlestickCount int
+	for _, s := range o.SeriesList {
+		switch s.Type {
+		case ChartTypeBar:
+			barCount++
+		case ChartTypeHorizontalBar:
+			horizontalBarCount++
+		case ChartTypeFunnel:
+			funnelCount++
+		case ChartTypeCandlestick:
+			candlestickCount++
+		}
+	}
+	barSize := autoSizeBarSize
+	if o.BarSize > 0 {
+		barSize = o.BarSize
+	}
+	if flagIs(true, o.StackSeries) {
+		barCount = chartdraw.MinInt(barCount, 1)
+		horizontalBarCount = chartdraw.MinInt(horizontalBarCount, 1)
+	}
+
+	var count, size int
+	var vertical bool
+	switch {
+	case funnelCount != 0:
+		count, size, vertical = funnelCount, autoSizeFunnelLayerSize, true
+	case horizontalBarCount != 0:
+		count = getSeriesMaxDataCount(o.SeriesList)
+		if len(o.YAxis) != 0 {
+			count = chartdraw.MaxInt(count, len(o.YAxis[0].Labels))
+		}
+		size, vertical = horizontalBarCount*barSize+autoSizeCategoryMargin, true
+	case barCount != 0 || candlestickCount != 0:
+		count = chartdraw.MaxInt(getSeriesMaxDataCount(o.SeriesList), len(o.XAxis.Labels))
+		size = chartdraw.MaxInt(barCount, candlestickCount)*barSize + autoSizeCategoryMargin
+	}
+	if o.AutoSize.CategorySize > 0 {
+		size = o.AutoSize.CategorySize
+	}
+	return count, size, vertical
+}
+
+// applyAutoSize sets the chart Width and Height from the AutoSize configuration and the chart content.
+func (o *ChartOption) applyAutoSize() error {
+	width, height := o.AutoSize.baseSize(o.Width, o.Height)
+	if count, size, vertical := o.autoSizeContent(); count > 0 {
+		// render the axes, title and legend at the target size to find the space remaining for the plot
+		probe := *o
+		probe.OutputFormat = ChartOutputSVG
+		probe.Width, probe.Height = width, height
+		probe.AutoSize = nil
+		probe.Image = nil
+		probe.Interactive = nil
+		probe.Accessible = nil
+		probe.Watermark = nil
+		probe.Children = nil
+		var plot Box
+		probe.plotProbe = &plot
+		if _, err := Render(probe); err != nil {
+			return err
+		}
+		if vertical {
+			height = chartdraw.MaxInt(height, height-plot.Height()+count*size)
+		} else {
+			width = chartdraw.MaxInt(width, width-plot.Width()+count*size)
+		}
+	}
+	if o.AutoSize.MaxWidth > 0 {
+		width = chartdraw.MinInt(width, o.AutoSize.MaxWidth)
+	}
+	if o.AutoSize.MaxHeight > 0 {
+		height = chartdraw.MinInt(height, o.AutoSize.MaxHeight)
+	}
+	o.Width, o.Height = width, height
+	return nil
+}
+
+// tableAutoWidth returns the table width required to show the text of each cell without wrapping.
+func tableAutoWidth(p *Painter, opt TableChartOption) int {
+	fontStyle := opt.FontStyle
+	if fontStyle.FontSize <= 0 {
+		fontStyle.FontSize = defaultFontSize
+	}
+	if fontStyle.Font == nil {
+		fontStyle.Font = GetDefaultFont()
+	}
+	fontStyle.FontColor = ColorBlack // measured text must not be transparent
+	spanSum := 0
+	spans := make([]int, len(opt.Header))
+	for i := range spans {
+		spans[i] = 1
+		if i < len(opt.Spans) && opt.Spans[i] > 0 {
+			spans[i] = opt.Spans[i]
+		}
+		spanSum += spans[i]
+	}
+
+	var width int
+	measureRow := func(row []string) {
+		for i, text := range row {
+			if i >= len(spans) {
+				break
+			}
+			var textWidth int
+			for _, line := range strings.Split(text, "\n") {
+				textWidth = chartdraw.MaxInt(textWidth, p.MeasureText(line, 0, fontStyle).Width())
+			}
+			textWidth += opt.Padding.Left + opt.Padding.Right + 1
+			// columns are divided proportionally to their span, so the table must be wide enough for every column
+			width = chartdraw.MaxInt(width, (textWidth*spanSum+spans[i]-1)/spans[i])
+		}
+	}
+	measureRow(opt.Header)
+	for _, row := range opt.Data {
+		measureRow(row)
+	}
+	return width
+}
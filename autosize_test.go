@@ -0,0 +1,189 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeAutoSizeValues(count int) ([]float64, []string) {
+	values := make([]float64, count)
+	labels := make([]string, count)
+	for i := range values {
+		values[i] = float64(i + 1)
+		labels[i] = "Category " + strconv.Itoa(i+1)
+	}
+	return values, labels
+}
+
+func TestAutoSizeBaseSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		opt            AutoSizeOption
+		width, height  int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{"default", AutoSizeOption{}, 0, 0, defaultChartWidth, defaultChartHeight},
+		{"fixed", AutoSizeOption{AspectRatio: 2}, 300, 200, 300, 200},
+		{"ratio_from_width", AutoSizeOption{AspectRatio: 2}, 800, 0, 800, 400},
+		{"ratio_from_height", AutoSizeOption{AspectRatio: 2}, 0, 300, 600, 300},
+		{"ratio_default_width", AutoSizeOption{AspectRatio: 1}, 0, 0, defaultChartWidth, defaultChartWidth},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height := tt.opt.baseSize(tt.width, tt.height)
+			assert.Equal(t, tt.expectedWidth, width)
+			assert.Equal(t, tt.expectedHeight, height)
+		})
+	}
+}
+
+func TestRenderAutoSize(t *testing.T) {
+	t.Parallel()
+
+	values, labels := makeAutoSizeValues(40)
+	tests := []struct {
+		name           string
+		opt            func() ChartOption
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{
+			name: "horizontal_bar_grows_height",
+			opt: func() ChartOption {
+				return ChartOption{
+					Width:      600,
+					AutoSize:   &AutoSizeOption{},
+					SeriesList: NewSeriesListHorizontalBar([][]float64{values, values}).ToGenericSeriesList(),
+					YAxis:      []YAxisOption{{Labels: labels}},
+				}
+			},
+			expectedWidth:  600,
+			expectedHeight: 1824,
+		},
+		{
+			name: "horizontal_bar_few_categories",
+			opt: func() ChartOption {
+				return ChartOption{
+					Width:      600,
+					AutoSize:   &AutoSizeOption{},
+					SeriesList: NewSeriesListHorizontalBar([][]float64{values[:3]}).ToGenericSeriesList(),
+					YAxis:      []YAxisOption{{Labels: labels[:3]}},
+				}
+			},
+			expectedWidth:  600,
+			expectedHeight: defaultChartHeight,
+		},
+		{
+			name: "horizontal_bar_max_height",
+			opt: func() ChartOption {
+				return ChartOption{
+					AutoSize:   &AutoSizeOption{MaxHeight: 1000},
+					SeriesList: NewSeriesListHorizontalBar([][]float64{values}).ToGenericSeriesList(),
+				}
+			},
+			expectedWidth:  defaultChartWidth,
+			expectedHeight: 1000,
+		},
+		{
+			name: "bar_grows_width",
+			opt: func() ChartOption {
+				return ChartOption{
+					Height:     300,
+					AutoSize:   &AutoSizeOption{CategorySize: 30},
+					SeriesList: NewSeriesListBar([][]float64{values}).ToGenericSeriesList(),
+					XAxis:      XAxisOption{Labels: labels},
+				}
+			},
+			expectedWidth:  1289,
+			expectedHeight: 300,
+		},
+		{
+			name: "funnel_grows_height",
+			opt: func() ChartOption {
+				return ChartOption{
+					Width:      400,
+					AutoSize:   &AutoSizeOption{},
+					SeriesList: NewSeriesListFunnel(values[:20]).ToGenericSeriesList(),
+				}
+			},
+			expectedWidth:  400,
+			expectedHeight: 680,
+		},
+		{
+			name: "pie_aspect_ratio",
+			opt: func() ChartOption {
+				return ChartOption{
+					Width:      800,
+					AutoSize:   &AutoSizeOption{AspectRatio: 2},
+					SeriesList: NewSeriesListPie(values[:5]).ToGenericSeriesList(),
+				}
+			},
+			expectedWidth:  800,
+			expectedHeight: 400,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt := tt.opt()
+			opt.OutputFormat = ChartOutputSVG
+			p, err := Render(opt)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedWidth, p.Width())
+			assert.Equal(t, tt.expectedHeight, p.Height())
+		})
+	}
+}
+
+func TestTableAutoSize(t *testing.T) {
+	t.Parallel()
+
+	header := []string{"Name", "Description"}
+	data := [][]string{{"A", "A long description which would wrap at a narrow table width"}}
+
+	p, err := TableOptionRenderDirect(TableChartOption{
+		OutputFormat: ChartOutputSVG,
+		Width:        200,
+		Header:       header,
+		Data:         data,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 200, p.Width())
+	wrappedHeight := p.Height()
+
+	p, err = TableOptionRenderDirect(TableChartOption{
+		OutputFormat: ChartOutputSVG,
+		Width:        200,
+		AutoSize:     &AutoSizeOption{},
+		Header:       header,
+		Data:         data,
+	})
+	require.NoError(t, err)
+	assert.Greater(t, p.Width(), 600)
+	assert.Less(t, p.Height(), wrappedHeight)
+
+	p, err = TableOptionRenderDirect(TableChartOption{
+		OutputFormat: ChartOutputSVG,
+		Width:        200,
+		AutoSize:     &AutoSizeOption{MaxWidth: 400},
+		Header:       header,
+		Data:         data,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 400, p.Width())
+
+	p, err = TableOptionRenderDirect(TableChartOption{
+		OutputFormat: ChartOutputSVG,
+		Width:        200,
+		AutoSize:     &AutoSizeOption{},
+		Header:       header,
+		Data:         [][]string{{"A", "B"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 200, p.Width()) // Width is the minimum
+}
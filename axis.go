@@ -37,6 +37,8 @@ type axisOption struct {
 	labelOffset          OffsetInt
 	labelSkipCount       int
 	painterPrePositioned bool
+	// labelsHidden skips drawing the labels and title, while still reserving their space.
+	labelsHidden bool
 }
 
 func (a *axisPainter) Render() (Box, error) {
@@ -104,7 +106,7 @@ func (a *axisPainter) Render() (Box, error) {
 	child := top.Child(PainterPaddingOption(padding))
 
 	// draw axis title
-	if opt.title != "" {
+	if opt.title != "" && !opt.labelsHidden {
 		switch opt.position {
 		case PositionLeft:
 			cx := child.Height() >> 1
@@ -222,6 +224,10 @@ func (a *axisPainter) Render() (Box, error) {
 		labelPadding.Bottom = tickLength + labelMargin
 	default: // PositionBottom
 		labelPadding.Top = tickLength + labelMargin
+		if opt.aRange.textLineHeight > 0 && opt.aRange.labelRotation == 0 {
+			// multi-line labels are drawn down from the first line baseline
+			labelPadding.Top -= opt.aRange.textMaxHeight - opt.aRange.textLineHeight
+		}
 		if opt.aRange.labelRotation != 0 {
 			flatWidth, flatHeight :=
 				top.measureTextMaxWidthHeight(opt.aRange.labels, 0, opt.aRange.labelFontStyle)
@@ -237,18 +243,21 @@ func (a *axisPainter) Render() (Box, error) {
 			alignSide = AlignLeft
 		}
 	}
-	labelPainter.multiText(multiTextOption{
-		textList:       rangeLabels,
-		vertical:       isVertical,
-		centerLabels:   centerLabels,
-		align:          alignSide,
-		textRotation:   opt.aRange.labelRotation,
-		offset:         opt.labelOffset,
-		firstIndex:     opt.aRange.dataStartIndex,
-		labelCount:     opt.aRange.labelCount,
-		labelSkipCount: opt.labelSkipCount,
-		fontStyle:      opt.aRange.labelFontStyle,
-	})
+	if !opt.labelsHidden {
+		labelPainter.multiText(multiTextOption{
+			textList:       rangeLabels,
+			vertical:       isVertical,
+			centerLabels:   centerLabels,
+			align:          alignSide,
+			textRotation:   opt.aRange.labelRotation,
+			lineHeight:     opt.aRange.textLineHeight,
+			offset:         opt.labelOffset,
+			firstIndex:     opt.aRange.dataStartIndex,
+			labelCount:     opt.aRange.labelCount,
+			labelSkipCount: opt.labelSkipCount,
+			fontStyle:      opt.aRange.labelFontStyle,
+		})
+	}
 
 	if opt.splitLineShow { // show auxiliary lines
 		if isVertical {
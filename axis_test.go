@@ -162,7 +162,7 @@ func TestAxisRender(t *testing.T) {
 				}
 				return axisOption{
 					aRange: calculateCategoryAxisRange(p, p.Width(), false, false, labels, 0,
-						0, 0, 0, tsl, 0, fs),
+						0, 0, 0, tsl, 0, fs, categoryLabelOverflow{}),
 					boundaryGap: Ptr(true),
 				}
 			},
@@ -69,13 +69,15 @@ type BarChartOption struct {
 	RoundedBarCaps *bool
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
+	// LabelLayout when set places the series labels together, moving or hiding labels to avoid overlaps.
+	LabelLayout *LabelLayoutOption
 }
 
 // TODO - v0.6 - calculateBarMarginsAndSize should handle percents and maybe de-duplicate with calculateCandleMarginsAndSize
-func calculateBarMarginsAndSize(seriesCount, space int, configuredBarSize int, configuredBarMargin *float64) (int, int, int) {
+func calculateBarMarginsAndSize(seriesCount int, space float64, configuredBarSize int, configuredBarMargin *float64) (float64, float64, float64) {
 	// default margins, adjusted below with config and series count
-	margin := 10   // margin between each series block
-	barMargin := 5 // margin between each bar
+	margin := 10.0   // margin between each series block
+	barMargin := 5.0 // margin between each bar
 	if space < 20 {
 		margin = 2
 		barMargin = 2
@@ -83,26 +85,28 @@ func calculateBarMarginsAndSize(seriesCount, space int, configuredBarSize int, c
 		margin = 5
 		barMargin = 3
 	}
+	count := float64(seriesCount)
+	barSize := float64(configuredBarSize)
 	// check margin configuration if bar size allows margin
-	if configuredBarSize+barMargin < space/seriesCount {
+	if barSize+barMargin < space/count {
 		// BarWidth is in range that we should also consider an optional margin configuration
 		if configuredBarMargin != nil {
-			barMargin = int(math.Round(*configuredBarMargin))
-			if barMargin+configuredBarSize > space/seriesCount {
-				barMargin = (space / seriesCount) - configuredBarSize
+			barMargin = *configuredBarMargin
+			if barMargin+barSize > space/count {
+				barMargin = (space / count) - barSize
 			}
 		}
 	} // else, bar width is out of range.  Ignore margin config
 
-	barSize := (space - 2*margin - barMargin*(seriesCount-1)) / seriesCount
+	size := (space - 2*margin - barMargin*(count-1)) / count
 	// check bar size configuration, limited by the series count and space available
-	if configuredBarSize > 0 && configuredBarSize < barSize {
-		barSize = configuredBarSize
+	if configuredBarSize > 0 && barSize < size {
+		size = barSize
 		// recalculate margin
-		margin = (space - seriesCount*barSize - barMargin*(seriesCount-1)) / 2
+		margin = (space - count*size - barMargin*(count-1)) / 2
 	}
 
-	return margin, barMargin, barSize
+	return margin, barMargin, size
 }
 
 func (b *barChart) renderChart(result *defaultRenderResult) (Box, error) {
@@ -115,13 +119,12 @@ func (b *barChart) renderChart(result *defaultRenderResult) (Box, error) {
 	seriesPainter := result.seriesPainter
 
 	x0, x1 := result.xaxisRange.getRange(0)
-	width := int(x1 - x0)
-	barMaxHeight := seriesPainter.Height() // total vertical space for bars
+	width := x1 - x0
+	barMaxHeight := float64(seriesPainter.Height()) // total vertical space for bars
 	seriesNames := opt.SeriesList.names()
-	divideValues := result.xaxisRange.autoDivide()
 	stackedSeries := flagIs(true, opt.StackSeries)
-	var margin, barMargin, barWidth int
-	var accumulatedHeights []int // prior heights for stacking to avoid recalculating the heights
+	var margin, barMargin, barWidth float64
+	var accumulatedHeights []float64 // prior heights for stacking to avoid recalculating the heights
 	if stackedSeries {
 		barCount := getSeriesYAxisCount(opt.SeriesList) // only two bars if two y-axis
 		configuredMargin := opt.BarMargin
@@ -129,7 +132,7 @@ func (b *barChart) renderChart(result *defaultRenderResult) (Box, error) {
 			configuredMargin = nil // no margin needed with a single bar
 		}
 		margin, _, barWidth = calculateBarMarginsAndSize(barCount, width, opt.BarWidth, configuredMargin)
-		accumulatedHeights = make([]int, result.xaxisRange.divideCount)
+		accumulatedHeights = make([]float64, result.xaxisRange.divideCount)
 	} else {
 		margin, barMargin, barWidth = calculateBarMarginsAndSize(seriesCount, width, opt.BarWidth, opt.BarMargin)
 	}
@@ -147,6 +150,10 @@ func (b *barChart) renderChart(result *defaultRenderResult) (Box, error) {
 			seriesThemeIndex = *series.absThemeIndex
 		}
 		seriesColor := opt.Theme.GetSeriesColor(seriesThemeIndex)
+		var fillGradient LinearGradient
+		if flagIs(true, series.FillGradient) {
+			fillGradient = NewVerticalGradient(seriesColor, seriesColor.WithAlpha(0))
+		}
 
 		var labelPainter *seriesLabelPainter
 		if flagIs(true, series.Label.Show) {
@@ -154,6 +161,8 @@ func (b *barChart) renderChart(result *defaultRenderResult) (Box, error) {
 			rendererList = append(rendererList, labelPainter)
 		}
 
+		valueFormatter := getPreferredValueFormatter(series.Label.ValueFormatter, opt.ValueFormatter)
+		seriesPainter.startSeriesGroup(series.Name)
 		points := make([]Point, len(series.Values)) // used for mark points
 		for j, item := range series.Values {
 			if j >= result.xaxisRange.divideCount {
@@ -161,44 +170,53 @@ func (b *barChart) renderChart(result *defaultRenderResult) (Box, error) {
 			}
 
 			// Compute bar placement differently for stacked vs non-stacked.
-			var x, top, bottom int
-			h := yRange.getHeight(item)
+			var x, top, bottom float64
+			h := yRange.getHeightF(item)
 
 			if stackSeries {
 				// Use accumulatedHeights to stack
-				x = divideValues[j] + margin
+				x = float64(j)*width + margin
 				top = barMaxHeight - (accumulatedHeights[j] + h)
 				bottom = barMaxHeight - accumulatedHeights[j]
 				accumulatedHeights[j] += h
 			} else {
 				// Non-stacked: offset each series in its own lane
-				x = divideValues[j] + margin + index*(barWidth+barMargin)
+				x = float64(j)*width + margin + float64(index)*(barWidth+barMargin)
 				top = barMaxHeight - h
 				bottom = barMaxHeight - 1 // or -0, depending on your style
 			}
 
 			// In stacked mode, only round caps on the last series
-			if flagIs(true, opt.RoundedBarCaps) && (!stackSeries || index == seriesCount-1) {
-				seriesPainter.roundedRect(
-					Box{Top: top, Left: x, Right: x + barWidth, Bottom: bottom, IsSet: true},
-					barWidth, true, false, seriesColor, seriesColor, 0.0)
+			roundedCaps := flagIs(true, opt.RoundedBarCaps) && (!stackSeries || index == seriesCount-1)
+			seriesPainter.startValueGroup(series.Name, labelAt(opt.XAxis.Labels, j), item, valueFormatter)
+			if fillGradient.IsZero() {
+				if roundedCaps {
+					seriesPainter.roundedRectF(x, top, x+barWidth, bottom, barWidth, true, false,
+						seriesColor, seriesColor, 0.0)
+				} else {
+					seriesPainter.FilledRectF(x, top, x+barWidth, bottom, seriesColor, seriesColor, 0.0)
+				}
+			} else if roundedCaps {
+				seriesPainter.roundedRectGradientF(x, top, x+barWidth, bottom, barWidth, true, false,
+					fillGradient, ColorTransparent, 0.0)
 			} else {
-				seriesPainter.FilledRect(x, top, x+barWidth, bottom, seriesColor, seriesColor, 0.0)
+				seriesPainter.FilledRectGradientF(x, top, x+barWidth, bottom, fillGradient, ColorTransparent, 0.0)
 			}
+			seriesPainter.endGroup()
 
 			// Prepare point for mark points
 			points[j] = Point{
-				X: x + (barWidth >> 1), // center of the bar horizontally
-				Y: top,                 // top of bar
+				X: int(x + barWidth/2), // center of the bar horizontally
+				Y: int(top),            // top of bar
 			}
 
 			if labelPainter != nil {
-				labelY := top
+				labelY := int(top)
 				var radians float64
 				fontStyle := series.Label.FontStyle
 				labelBottom := opt.SeriesLabelPosition == PositionBottom && !stackSeries
 				if labelBottom {
-					labelY = barMaxHeight
+					labelY = int(barMaxHeight)
 					radians = -math.Pi / 2 // Rotated label at the bottom
 				}
 				if fontStyle.FontColor.IsZero() {
@@ -225,13 +243,14 @@ func (b *barChart) renderChart(result *defaultRenderResult) (Box, error) {
 					index:     index,
 					value:     item,
 					fontStyle: fontStyle,
-					x:         x + (barWidth >> 1),
+					x:         int(x + barWidth/2),
 					y:         labelY,
 					radians:   radians,
 					offset:    series.Label.Offset,
 				})
 			}
 		}
+		seriesPainter.endGroup()
 
 		var globalSeriesData []float64 // lazily initialized
 		if len(series.MarkLine.Lines) > 0 {
@@ -320,9 +339,17 @@ func (b *barChart) renderChart(result *defaultRenderResult) (Box, error) {
 func (b *barChart) Render() (Box, error) {
 	p := b.p
 	opt := b.opt
+	if opt.LabelLayout != nil {
+		p = p.Child(painterLabelLayoutOption(*opt.LabelLayout))
+	}
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		var visible []int
+		visible, opt.Theme = opt.Legend.hideSeries(opt.SeriesList, opt.Theme, opt.ValueFormatter)
+		opt.SeriesList = selectSeriesIndexes(opt.SeriesList, visible)
+	}
 	if opt.Legend.Symbol == "" {
 		// default to rectangle symbol for this chart type
 		opt.Legend.Symbol = SymbolSquare
@@ -342,5 +369,9 @@ func (b *barChart) Render() (Box, error) {
 	if err != nil {
 		return BoxZero, err
 	}
-	return b.renderChart(renderResult)
+	box, err := b.renderChart(renderResult)
+	if err == nil && opt.LabelLayout != nil {
+		p.labelLayout.render()
+	}
+	return box, err
 }
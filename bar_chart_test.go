@@ -2,6 +2,7 @@ package charts
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -104,7 +105,7 @@ func TestBarChart(t *testing.T) {
 			name:        "basic_themed",
 			themed:      true,
 			makeOptions: makeBasicBarChartOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"18\" y=\"211\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"18\" y=\"250\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"18\" y=\"289\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"18\" y=\"328\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"27\" y=\"368\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 46 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 46 369\nL 46 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 91 369\nL 91 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 136 369\nL 136 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 182 369\nL 182 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 227 369\nL 227 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 272 369\nL 272 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 318 369\nL 318 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 363 369\nL 363 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 408 369\nL 408 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 454 369\nL 454 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 499 369\nL 499 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 544 369\nL 544 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><text x=\"55\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"100\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"145\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"192\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"234\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"282\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"330\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"371\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"418\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"464\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"507\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"554\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 51 361\nL 67 361\nL 67 363\nL 51 363\nL 51 361\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 96 355\nL 112 355\nL 112 363\nL 96 363\nL 96 355\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 141 351\nL 157 351\nL 157 363\nL 141 363\nL 141 351\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 187 321\nL 203 321\nL 203 363\nL 187 363\nL 187 321\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 232 317\nL 248 317\nL 248 363\nL 232 363\nL 232 317\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 277 221\nL 293 221\nL 293 363\nL 277 363\nL 277 221\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 323 111\nL 339 111\nL 339 363\nL 323 363\nL 323 111\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 368 61\nL 384 61\nL 384 363\nL 368 363\nL 368 61\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 413 303\nL 429 303\nL 429 363\nL 413 363\nL 413 303\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 459 327\nL 475 327\nL 475 363\nL 459 363\nL 459 327\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 504 353\nL 520 353\nL 520 363\nL 504 363\nL 504 353\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 549 358\nL 565 358\nL 565 363\nL 549 363\nL 549 358\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 70 360\nL 86 360\nL 86 363\nL 70 363\nL 70 360\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 115 353\nL 131 353\nL 131 363\nL 115 363\nL 115 353\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 160 348\nL 176 348\nL 176 363\nL 160 363\nL 160 348\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 206 315\nL 222 315\nL 222 363\nL 206 363\nL 206 315\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 251 311\nL 267 311\nL 267 363\nL 251 363\nL 251 311\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 296 232\nL 312 232\nL 312 363\nL 296 363\nL 296 232\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 342 36\nL 358 36\nL 358 363\nL 342 363\nL 342 36\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 387 23\nL 403 23\nL 403 363\nL 387 363\nL 387 23\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 432 273\nL 448 273\nL 448 363\nL 432 363\nL 432 273\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 478 329\nL 494 329\nL 494 363\nL 478 363\nL 478 329\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 523 353\nL 539 353\nL 539 363\nL 523 363\nL 523 353\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 568 360\nL 584 360\nL 584 363\nL 568 363\nL 568 360\" style=\"stroke:none;fill:rgb(255,210,100)\"/><text x=\"55\" y=\"356\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"95\" y=\"350\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"145\" y=\"346\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"182\" y=\"316\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"227\" y=\"312\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"272\" y=\"216\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"315\" y=\"106\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"360\" y=\"56\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"408\" y=\"298\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"460\" y=\"322\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"503\" y=\"348\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"548\" y=\"353\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"69\" y=\"355\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"114\" y=\"348\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"164\" y=\"343\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"201\" y=\"310\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"246\" y=\"306\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"291\" y=\"227\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"334\" y=\"31\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"379\" y=\"18\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"427\" y=\"268\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"473\" y=\"324\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"527\" y=\"348\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"567\" y=\"355\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"18\" y=\"211\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"18\" y=\"250\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"18\" y=\"289\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"18\" y=\"328\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"27\" y=\"368\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 42 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 46 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 46 369\nL 46 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 91 369\nL 91 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 136 369\nL 136 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 182 369\nL 182 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 227 369\nL 227 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 272 369\nL 272 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 318 369\nL 318 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 363 369\nL 363 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 408 369\nL 408 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 454 369\nL 454 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 499 369\nL 499 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 544 369\nL 544 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><text x=\"55\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"100\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"145\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"192\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"234\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"282\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"330\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"371\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"418\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"464\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"507\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"554\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 51 360.25\nL 67.17 360.25\nL 67.17 363\nL 51 363\nL 51 360.25\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 96.33 354.82\nL 112.5 354.82\nL 112.5 363\nL 96.33 363\nL 96.33 354.82\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 141.67 350.89\nL 157.83 350.89\nL 157.83 363\nL 141.67 363\nL 141.67 350.89\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 187 320.55\nL 203.17 320.55\nL 203.17 363\nL 187 363\nL 187 320.55\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 232.33 316.05\nL 248.5 316.05\nL 248.5 363\nL 232.33 363\nL 232.33 316.05\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 277.67 220.34\nL 293.83 220.34\nL 293.83 363\nL 277.67 363\nL 277.67 220.34\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 323 110.02\nL 339.17 110.02\nL 339.17 363\nL 323 363\nL 323 110.02\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 368.33 60.2\nL 384.5 60.2\nL 384.5 363\nL 368.33 363\nL 368.33 60.2\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 413.67 302.94\nL 429.83 302.94\nL 429.83 363\nL 413.67 363\nL 413.67 302.94\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 459 326.54\nL 475.17 326.54\nL 475.17 363\nL 459 363\nL 459 326.54\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 504.33 352.01\nL 520.5 352.01\nL 520.5 363\nL 504.33 363\nL 504.33 352.01\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 549.67 357.82\nL 565.83 357.82\nL 565.83 363\nL 549.67 363\nL 549.67 357.82\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 70.17 359.13\nL 86.33 359.13\nL 86.33 363\nL 70.17 363\nL 70.17 359.13\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 115.5 352.95\nL 131.67 352.95\nL 131.67 363\nL 115.5 363\nL 115.5 352.95\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 160.83 347.14\nL 177 347.14\nL 177 363\nL 160.83 363\nL 160.83 347.14\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 206.17 314.55\nL 222.33 314.55\nL 222.33 363\nL 206.17 363\nL 206.17 314.55\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 251.5 310.24\nL 267.67 310.24\nL 267.67 363\nL 251.5 363\nL 251.5 310.24\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 296.83 231.58\nL 313 231.58\nL 313 363\nL 296.83 363\nL 296.83 231.58\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 342.17 35.1\nL 358.33 35.1\nL 358.33 363\nL 342.17 363\nL 342.17 35.1\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 387.5 22.74\nL 403.67 22.74\nL 403.67 363\nL 387.5 363\nL 387.5 22.74\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 432.83 272.78\nL 449 272.78\nL 449 363\nL 432.83 363\nL 432.83 272.78\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 478.17 328.79\nL 494.33 328.79\nL 494.33 363\nL 478.17 363\nL 478.17 328.79\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 523.5 352.76\nL 539.67 352.76\nL 539.67 363\nL 523.5 363\nL 523.5 352.76\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 568.83 359.69\nL 585 359.69\nL 585 363\nL 568.83 363\nL 568.83 359.69\" style=\"stroke:none;fill:rgb(255,210,100)\"/><text x=\"55\" y=\"355\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"95\" y=\"349\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"145\" y=\"345\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"182\" y=\"315\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"227\" y=\"311\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"272\" y=\"215\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"315\" y=\"105\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"360\" y=\"55\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"408\" y=\"297\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"460\" y=\"321\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"503\" y=\"347\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"548\" y=\"352\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"69\" y=\"354\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"114\" y=\"347\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"164\" y=\"342\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"201\" y=\"309\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"246\" y=\"305\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"291\" y=\"226\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"334\" y=\"30\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"379\" y=\"17\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"427\" y=\"267\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"473\" y=\"323\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"527\" y=\"347\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"567\" y=\"354\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
 			pngCRC:      0x2f3996e3,
 		},
 		{
@@ -114,7 +115,7 @@ func TestBarChart(t *testing.T) {
 				opt.RoundedBarCaps = Ptr(true)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 93 330\nL 94 330\nL 94 330\nA 63 63 90.00 0 1 157 393\nL 157 379\nL 30 379\nL 30 393\nL 30 393\nA 63 63 90.00 0 1 93 330\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 373 244\nL 374 244\nL 374 244\nA 63 63 90.00 0 1 437 307\nL 437 379\nL 310 379\nL 310 307\nL 310 307\nA 63 63 90.00 0 1 373 244\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 225 244\nL 226 244\nL 226 244\nA 63 63 90.00 0 1 289 307\nL 289 379\nL 162 379\nL 162 307\nL 162 307\nA 63 63 90.00 0 1 225 244\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 505 71\nL 506 71\nL 506 71\nA 63 63 90.00 0 1 569 134\nL 569 379\nL 442 379\nL 442 134\nL 442 134\nA 63 63 90.00 0 1 505 71\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 93.75 329.6\nL 93.75 329.6\nL 93.75 329.6\nA 63.75 63.75 90.00 0 1 157.5 393.35\nL 157.5 379\nL 30 379\nL 30 393.35\nL 30 393.35\nA 63.75 63.75 90.00 0 1 93.75 329.6\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 373.75 243.2\nL 373.75 243.2\nL 373.75 243.2\nA 63.75 63.75 90.00 0 1 437.5 306.95\nL 437.5 379\nL 310 379\nL 310 306.95\nL 310 306.95\nA 63.75 63.75 90.00 0 1 373.75 243.2\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 226.25 243.2\nL 226.25 243.2\nL 226.25 243.2\nA 63.75 63.75 90.00 0 1 290 306.95\nL 290 379\nL 162.5 379\nL 162.5 306.95\nL 162.5 306.95\nA 63.75 63.75 90.00 0 1 226.25 243.2\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 506.25 70.4\nL 506.25 70.4\nL 506.25 70.4\nA 63.75 63.75 90.00 0 1 570 134.15\nL 570 379\nL 442.5 379\nL 442.5 134.15\nL 442.5 134.15\nA 63.75 63.75 90.00 0 1 506.25 70.4\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0xb177bec7,
 		},
 		{
@@ -127,7 +128,7 @@ func TestBarChart(t *testing.T) {
 				opt.Title.FontStyle = customFont
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"18\" y=\"212\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"18\" y=\"251\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"18\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"18\" y=\"329\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"27\" y=\"369\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 207\nL 590 207\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 286\nL 590 286\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 325\nL 590 325\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 365\nL 590 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 370\nL 46 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 91 370\nL 91 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 136 370\nL 136 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 182 370\nL 182 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 227 370\nL 227 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 272 370\nL 272 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 370\nL 318 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 363 370\nL 363 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 408 370\nL 408 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 454 370\nL 454 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 499 370\nL 499 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 544 370\nL 544 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 370\nL 590 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"64\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"109\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"154\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"200\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"244\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"291\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"337\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"380\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"427\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"472\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"516\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"563\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 51 362\nL 67 362\nL 67 364\nL 51 364\nL 51 362\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 96 356\nL 112 356\nL 112 364\nL 96 364\nL 96 356\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 141 352\nL 157 352\nL 157 364\nL 141 364\nL 141 352\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 187 322\nL 203 322\nL 203 364\nL 187 364\nL 187 322\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 232 317\nL 248 317\nL 248 364\nL 232 364\nL 232 317\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 277 221\nL 293 221\nL 293 364\nL 277 364\nL 277 221\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 323 111\nL 339 111\nL 339 364\nL 323 364\nL 323 111\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 368 61\nL 384 61\nL 384 364\nL 368 364\nL 368 61\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 413 304\nL 429 304\nL 429 364\nL 413 364\nL 413 304\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 459 328\nL 475 328\nL 475 364\nL 459 364\nL 459 328\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 504 353\nL 520 353\nL 520 364\nL 504 364\nL 504 353\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 549 359\nL 565 359\nL 565 364\nL 549 364\nL 549 359\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 70 361\nL 86 361\nL 86 364\nL 70 364\nL 70 361\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 115 354\nL 131 354\nL 131 364\nL 115 364\nL 115 354\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 160 349\nL 176 349\nL 176 364\nL 160 364\nL 160 349\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 206 316\nL 222 316\nL 222 364\nL 206 364\nL 206 316\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 251 312\nL 267 312\nL 267 364\nL 251 364\nL 251 312\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 296 233\nL 312 233\nL 312 364\nL 296 364\nL 296 233\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 342 36\nL 358 36\nL 358 364\nL 342 364\nL 342 36\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 387 23\nL 403 23\nL 403 364\nL 387 364\nL 387 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 432 274\nL 448 274\nL 448 364\nL 432 364\nL 432 274\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 478 330\nL 494 330\nL 494 364\nL 478 364\nL 478 330\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 523 354\nL 539 354\nL 539 364\nL 523 364\nL 523 354\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 568 361\nL 584 361\nL 584 364\nL 568 364\nL 568 361\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"55\" y=\"357\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"95\" y=\"351\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"145\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"182\" y=\"317\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"227\" y=\"312\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"272\" y=\"216\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"315\" y=\"106\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"360\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"408\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"460\" y=\"323\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"503\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"548\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"69\" y=\"356\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"114\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"164\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"201\" y=\"311\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"246\" y=\"307\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"291\" y=\"228\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"334\" y=\"31\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"379\" y=\"18\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"427\" y=\"269\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"473\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"527\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"567\" y=\"356\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"18\" y=\"212\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"18\" y=\"251\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"18\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"18\" y=\"329\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"27\" y=\"369\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 207\nL 590 207\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 286\nL 590 286\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 325\nL 590 325\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 365\nL 590 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 370\nL 46 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 91 370\nL 91 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 136 370\nL 136 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 182 370\nL 182 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 227 370\nL 227 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 272 370\nL 272 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 370\nL 318 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 363 370\nL 363 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 408 370\nL 408 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 454 370\nL 454 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 499 370\nL 499 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 544 370\nL 544 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 370\nL 590 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"64\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"109\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"154\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"200\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"244\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"291\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"337\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"380\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"427\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"472\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"516\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"563\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 51 361.24\nL 67.17 361.24\nL 67.17 364\nL 51 364\nL 51 361.24\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 96.33 355.8\nL 112.5 355.8\nL 112.5 364\nL 96.33 364\nL 96.33 355.8\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 141.67 351.85\nL 157.83 351.85\nL 157.83 364\nL 141.67 364\nL 141.67 351.85\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 187 321.42\nL 203.17 321.42\nL 203.17 364\nL 187 364\nL 187 321.42\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 232.33 316.92\nL 248.5 316.92\nL 248.5 364\nL 232.33 364\nL 232.33 316.92\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 277.67 220.93\nL 293.83 220.93\nL 293.83 364\nL 277.67 364\nL 277.67 220.93\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 323 110.3\nL 339.17 110.3\nL 339.17 364\nL 323 364\nL 323 110.3\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 368.33 60.34\nL 384.5 60.34\nL 384.5 364\nL 368.33 364\nL 368.33 60.34\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 413.67 303.77\nL 429.83 303.77\nL 429.83 364\nL 413.67 364\nL 413.67 303.77\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 459 327.43\nL 475.17 327.43\nL 475.17 364\nL 459 364\nL 459 327.43\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 504.33 352.98\nL 520.5 352.98\nL 520.5 364\nL 504.33 364\nL 504.33 352.98\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 549.67 358.8\nL 565.83 358.8\nL 565.83 364\nL 549.67 364\nL 549.67 358.8\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 70.17 360.12\nL 86.33 360.12\nL 86.33 364\nL 70.17 364\nL 70.17 360.12\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 115.5 353.92\nL 131.67 353.92\nL 131.67 364\nL 115.5 364\nL 115.5 353.92\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 160.83 348.1\nL 177 348.1\nL 177 364\nL 160.83 364\nL 160.83 348.1\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 206.17 315.41\nL 222.33 315.41\nL 222.33 364\nL 206.17 364\nL 206.17 315.41\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 251.5 311.09\nL 267.67 311.09\nL 267.67 364\nL 251.5 364\nL 251.5 311.09\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 296.83 232.2\nL 313 232.2\nL 313 364\nL 296.83 364\nL 296.83 232.2\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 342.17 35.17\nL 358.33 35.17\nL 358.33 364\nL 342.17 364\nL 342.17 35.17\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 387.5 22.77\nL 403.67 22.77\nL 403.67 364\nL 387.5 364\nL 387.5 22.77\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 432.83 273.53\nL 449 273.53\nL 449 364\nL 432.83 364\nL 432.83 273.53\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 478.17 329.69\nL 494.33 329.69\nL 494.33 364\nL 478.17 364\nL 478.17 329.69\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 523.5 353.73\nL 539.67 353.73\nL 539.67 364\nL 523.5 364\nL 523.5 353.73\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 568.83 360.68\nL 585 360.68\nL 585 364\nL 568.83 364\nL 568.83 360.68\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"55\" y=\"356\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"95\" y=\"350\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"145\" y=\"346\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"182\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"227\" y=\"311\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"272\" y=\"215\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"315\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"360\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"408\" y=\"298\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"460\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"503\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"548\" y=\"353\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"69\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"114\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"164\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"201\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"246\" y=\"306\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"291\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"334\" y=\"30\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"379\" y=\"17\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"427\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"473\" y=\"324\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"527\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"567\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
 			pngCRC: 0x8aa078e6,
 		},
 		{
@@ -138,7 +139,7 @@ func TestBarChart(t *testing.T) {
 				opt.YAxis[0].Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 10 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 369\nL 10 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 106 369\nL 106 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 155 369\nL 155 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 203 369\nL 203 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 251 369\nL 251 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 369\nL 300 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 348 369\nL 348 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 396 369\nL 396 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 445 369\nL 445 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 493 369\nL 493 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 541 369\nL 541 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"21\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"69\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"116\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"212\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"262\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"314\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"358\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"407\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"457\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"503\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"552\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 15 361\nL 32 361\nL 32 363\nL 15 363\nL 15 361\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 63 355\nL 80 355\nL 80 363\nL 63 363\nL 63 355\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 111 351\nL 128 351\nL 128 363\nL 111 363\nL 111 351\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 160 321\nL 177 321\nL 177 363\nL 160 363\nL 160 321\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 208 317\nL 225 317\nL 225 363\nL 208 363\nL 208 317\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 256 221\nL 273 221\nL 273 363\nL 256 363\nL 256 221\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 305 111\nL 322 111\nL 322 363\nL 305 363\nL 305 111\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 353 61\nL 370 61\nL 370 363\nL 353 363\nL 353 61\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 401 303\nL 418 303\nL 418 363\nL 401 363\nL 401 303\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 327\nL 467 327\nL 467 363\nL 450 363\nL 450 327\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 498 353\nL 515 353\nL 515 363\nL 498 363\nL 498 353\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 546 358\nL 563 358\nL 563 363\nL 546 363\nL 546 358\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 35 360\nL 52 360\nL 52 363\nL 35 363\nL 35 360\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 83 353\nL 100 353\nL 100 363\nL 83 363\nL 83 353\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 131 348\nL 148 348\nL 148 363\nL 131 363\nL 131 348\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 180 315\nL 197 315\nL 197 363\nL 180 363\nL 180 315\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 228 311\nL 245 311\nL 245 363\nL 228 363\nL 228 311\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 276 232\nL 293 232\nL 293 363\nL 276 363\nL 276 232\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 325 36\nL 342 36\nL 342 363\nL 325 363\nL 325 36\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 373 23\nL 390 23\nL 390 363\nL 373 363\nL 373 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 421 273\nL 438 273\nL 438 363\nL 421 363\nL 421 273\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 470 329\nL 487 329\nL 487 363\nL 470 363\nL 470 329\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 518 353\nL 535 353\nL 535 363\nL 518 363\nL 518 353\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 566 360\nL 583 360\nL 583 363\nL 566 363\nL 566 360\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"19\" y=\"356\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"62\" y=\"350\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"115\" y=\"346\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"155\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"203\" y=\"312\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"251\" y=\"216\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"297\" y=\"106\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"345\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"396\" y=\"298\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"451\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"497\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"545\" y=\"353\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"34\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"82\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"135\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"175\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"223\" y=\"306\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"271\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"317\" y=\"31\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"365\" y=\"18\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"416\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"465\" y=\"324\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"522\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"565\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 10 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 369\nL 10 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 106 369\nL 106 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 155 369\nL 155 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 203 369\nL 203 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 251 369\nL 251 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 369\nL 300 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 348 369\nL 348 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 396 369\nL 396 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 445 369\nL 445 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 493 369\nL 493 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 541 369\nL 541 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"21\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"69\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"116\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"212\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"262\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"314\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"358\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"407\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"457\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"503\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"552\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 15 360.25\nL 32.67 360.25\nL 32.67 363\nL 15 363\nL 15 360.25\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 63.33 354.82\nL 81 354.82\nL 81 363\nL 63.33 363\nL 63.33 354.82\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 111.67 350.89\nL 129.33 350.89\nL 129.33 363\nL 111.67 363\nL 111.67 350.89\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 160 320.55\nL 177.67 320.55\nL 177.67 363\nL 160 363\nL 160 320.55\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 208.33 316.05\nL 226 316.05\nL 226 363\nL 208.33 363\nL 208.33 316.05\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 256.67 220.34\nL 274.33 220.34\nL 274.33 363\nL 256.67 363\nL 256.67 220.34\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 305 110.02\nL 322.67 110.02\nL 322.67 363\nL 305 363\nL 305 110.02\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 353.33 60.2\nL 371 60.2\nL 371 363\nL 353.33 363\nL 353.33 60.2\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 401.67 302.94\nL 419.33 302.94\nL 419.33 363\nL 401.67 363\nL 401.67 302.94\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 326.54\nL 467.67 326.54\nL 467.67 363\nL 450 363\nL 450 326.54\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 498.33 352.01\nL 516 352.01\nL 516 363\nL 498.33 363\nL 498.33 352.01\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 546.67 357.82\nL 564.33 357.82\nL 564.33 363\nL 546.67 363\nL 546.67 357.82\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 35.67 359.13\nL 53.33 359.13\nL 53.33 363\nL 35.67 363\nL 35.67 359.13\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 84 352.95\nL 101.67 352.95\nL 101.67 363\nL 84 363\nL 84 352.95\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 132.33 347.14\nL 150 347.14\nL 150 363\nL 132.33 363\nL 132.33 347.14\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 180.67 314.55\nL 198.33 314.55\nL 198.33 363\nL 180.67 363\nL 180.67 314.55\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 229 310.24\nL 246.67 310.24\nL 246.67 363\nL 229 363\nL 229 310.24\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 277.33 231.58\nL 295 231.58\nL 295 363\nL 277.33 363\nL 277.33 231.58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 325.67 35.1\nL 343.33 35.1\nL 343.33 363\nL 325.67 363\nL 325.67 35.1\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 374 22.74\nL 391.67 22.74\nL 391.67 363\nL 374 363\nL 374 22.74\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 422.33 272.78\nL 440 272.78\nL 440 363\nL 422.33 363\nL 422.33 272.78\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 470.67 328.79\nL 488.33 328.79\nL 488.33 363\nL 470.67 363\nL 470.67 328.79\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 519 352.76\nL 536.67 352.76\nL 536.67 363\nL 519 363\nL 519 352.76\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 567.33 359.69\nL 585 359.69\nL 585 363\nL 567.33 363\nL 567.33 359.69\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"19\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"63\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"116\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"155\" y=\"315\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"204\" y=\"311\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"252\" y=\"215\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"297\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"346\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"397\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"451\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"498\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"546\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"35\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"83\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"137\" y=\"342\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"176\" y=\"309\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"224\" y=\"305\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"273\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"318\" y=\"30\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"366\" y=\"17\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"418\" y=\"267\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"466\" y=\"323\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"523\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"567\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
 			pngCRC: 0x75d0d097,
 		},
 		{
@@ -148,7 +149,7 @@ func TestBarChart(t *testing.T) {
 				opt.XAxis.BoundaryGap = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"18\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"18\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"18\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"18\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"27\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 369\nL 46 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 95 369\nL 95 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 144 369\nL 144 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 194 369\nL 194 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 243 369\nL 243 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 293 369\nL 293 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 342 369\nL 342 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 392 369\nL 392 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 441 369\nL 441 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 491 369\nL 491 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 540 369\nL 540 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"45\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"143\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"242\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"341\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"391\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"490\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"563\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 51 361\nL 67 361\nL 67 363\nL 51 363\nL 51 361\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 96 355\nL 112 355\nL 112 363\nL 96 363\nL 96 355\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 141 351\nL 157 351\nL 157 363\nL 141 363\nL 141 351\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 187 321\nL 203 321\nL 203 363\nL 187 363\nL 187 321\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 232 317\nL 248 317\nL 248 363\nL 232 363\nL 232 317\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 277 221\nL 293 221\nL 293 363\nL 277 363\nL 277 221\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 323 111\nL 339 111\nL 339 363\nL 323 363\nL 323 111\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 368 61\nL 384 61\nL 384 363\nL 368 363\nL 368 61\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 413 303\nL 429 303\nL 429 363\nL 413 363\nL 413 303\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 459 327\nL 475 327\nL 475 363\nL 459 363\nL 459 327\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 504 353\nL 520 353\nL 520 363\nL 504 363\nL 504 353\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 549 358\nL 565 358\nL 565 363\nL 549 363\nL 549 358\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 70 360\nL 86 360\nL 86 363\nL 70 363\nL 70 360\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 115 353\nL 131 353\nL 131 363\nL 115 363\nL 115 353\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 160 348\nL 176 348\nL 176 363\nL 160 363\nL 160 348\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 206 315\nL 222 315\nL 222 363\nL 206 363\nL 206 315\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 251 311\nL 267 311\nL 267 363\nL 251 363\nL 251 311\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 296 232\nL 312 232\nL 312 363\nL 296 363\nL 296 232\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 342 36\nL 358 36\nL 358 363\nL 342 363\nL 342 36\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 387 23\nL 403 23\nL 403 363\nL 387 363\nL 387 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 432 273\nL 448 273\nL 448 363\nL 432 363\nL 432 273\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 478 329\nL 494 329\nL 494 363\nL 478 363\nL 478 329\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 523 353\nL 539 353\nL 539 363\nL 523 363\nL 523 353\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 568 360\nL 584 360\nL 584 363\nL 568 363\nL 568 360\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"55\" y=\"356\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"95\" y=\"350\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"145\" y=\"346\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"182\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"227\" y=\"312\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"272\" y=\"216\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"315\" y=\"106\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"360\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"408\" y=\"298\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"460\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"503\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"548\" y=\"353\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"69\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"114\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"164\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"201\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"246\" y=\"306\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"291\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"334\" y=\"31\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"379\" y=\"18\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"427\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"473\" y=\"324\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"527\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"567\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"18\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"18\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"18\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"18\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"27\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 369\nL 46 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 95 369\nL 95 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 144 369\nL 144 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 194 369\nL 194 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 243 369\nL 243 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 293 369\nL 293 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 342 369\nL 342 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 392 369\nL 392 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 441 369\nL 441 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 491 369\nL 491 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 540 369\nL 540 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"45\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"143\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"242\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"341\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"391\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"490\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"563\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 51 360.25\nL 67.17 360.25\nL 67.17 363\nL 51 363\nL 51 360.25\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 96.33 354.82\nL 112.5 354.82\nL 112.5 363\nL 96.33 363\nL 96.33 354.82\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 141.67 350.89\nL 157.83 350.89\nL 157.83 363\nL 141.67 363\nL 141.67 350.89\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 187 320.55\nL 203.17 320.55\nL 203.17 363\nL 187 363\nL 187 320.55\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 232.33 316.05\nL 248.5 316.05\nL 248.5 363\nL 232.33 363\nL 232.33 316.05\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 277.67 220.34\nL 293.83 220.34\nL 293.83 363\nL 277.67 363\nL 277.67 220.34\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 323 110.02\nL 339.17 110.02\nL 339.17 363\nL 323 363\nL 323 110.02\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 368.33 60.2\nL 384.5 60.2\nL 384.5 363\nL 368.33 363\nL 368.33 60.2\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 413.67 302.94\nL 429.83 302.94\nL 429.83 363\nL 413.67 363\nL 413.67 302.94\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 459 326.54\nL 475.17 326.54\nL 475.17 363\nL 459 363\nL 459 326.54\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 504.33 352.01\nL 520.5 352.01\nL 520.5 363\nL 504.33 363\nL 504.33 352.01\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 549.67 357.82\nL 565.83 357.82\nL 565.83 363\nL 549.67 363\nL 549.67 357.82\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 70.17 359.13\nL 86.33 359.13\nL 86.33 363\nL 70.17 363\nL 70.17 359.13\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 115.5 352.95\nL 131.67 352.95\nL 131.67 363\nL 115.5 363\nL 115.5 352.95\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 160.83 347.14\nL 177 347.14\nL 177 363\nL 160.83 363\nL 160.83 347.14\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 206.17 314.55\nL 222.33 314.55\nL 222.33 363\nL 206.17 363\nL 206.17 314.55\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 251.5 310.24\nL 267.67 310.24\nL 267.67 363\nL 251.5 363\nL 251.5 310.24\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 296.83 231.58\nL 313 231.58\nL 313 363\nL 296.83 363\nL 296.83 231.58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 342.17 35.1\nL 358.33 35.1\nL 358.33 363\nL 342.17 363\nL 342.17 35.1\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 387.5 22.74\nL 403.67 22.74\nL 403.67 363\nL 387.5 363\nL 387.5 22.74\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 432.83 272.78\nL 449 272.78\nL 449 363\nL 432.83 363\nL 432.83 272.78\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 478.17 328.79\nL 494.33 328.79\nL 494.33 363\nL 478.17 363\nL 478.17 328.79\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 523.5 352.76\nL 539.67 352.76\nL 539.67 363\nL 523.5 363\nL 523.5 352.76\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 568.83 359.69\nL 585 359.69\nL 585 363\nL 568.83 363\nL 568.83 359.69\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"55\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"95\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"145\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"182\" y=\"315\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"227\" y=\"311\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"272\" y=\"215\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"315\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"360\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"408\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"460\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"503\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"548\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"69\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"114\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"164\" y=\"342\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"201\" y=\"309\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"246\" y=\"305\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"291\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"334\" y=\"30\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"379\" y=\"17\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"427\" y=\"267\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"473\" y=\"323\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"527\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"567\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
 			pngCRC: 0xd36b33d,
 		},
 		{
@@ -160,7 +161,7 @@ func TestBarChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><path d=\"M 21 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 25 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 25 369\nL 25 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 72 369\nL 72 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 119 369\nL 119 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 166 369\nL 166 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 213 369\nL 213 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 260 369\nL 260 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 307 369\nL 307 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 354 369\nL 354 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 401 369\nL 401 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 448 369\nL 448 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 495 369\nL 495 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 542 369\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"35\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"82\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"128\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"177\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"221\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"270\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"320\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"363\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"411\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"459\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"504\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"553\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 30 361\nL 47 361\nL 47 363\nL 30 363\nL 30 361\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 77 355\nL 94 355\nL 94 363\nL 77 363\nL 77 355\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 124 351\nL 141 351\nL 141 363\nL 124 363\nL 124 351\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 171 321\nL 188 321\nL 188 363\nL 171 363\nL 171 321\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 218 317\nL 235 317\nL 235 363\nL 218 363\nL 218 317\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 265 221\nL 282 221\nL 282 363\nL 265 363\nL 265 221\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 312 111\nL 329 111\nL 329 363\nL 312 363\nL 312 111\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 359 61\nL 376 61\nL 376 363\nL 359 363\nL 359 61\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 406 303\nL 423 303\nL 423 363\nL 406 363\nL 406 303\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 453 327\nL 470 327\nL 470 363\nL 453 363\nL 453 327\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 500 353\nL 517 353\nL 517 363\nL 500 363\nL 500 353\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 547 358\nL 564 358\nL 564 363\nL 547 363\nL 547 358\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 50 360\nL 67 360\nL 67 363\nL 50 363\nL 50 360\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 97 353\nL 114 353\nL 114 363\nL 97 363\nL 97 353\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 144 348\nL 161 348\nL 161 363\nL 144 363\nL 144 348\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 191 315\nL 208 315\nL 208 363\nL 191 363\nL 191 315\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 238 311\nL 255 311\nL 255 363\nL 238 363\nL 238 311\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 285 232\nL 302 232\nL 302 363\nL 285 363\nL 285 232\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 332 36\nL 349 36\nL 349 363\nL 332 363\nL 332 36\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 379 23\nL 396 23\nL 396 363\nL 379 363\nL 379 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 426 273\nL 443 273\nL 443 363\nL 426 363\nL 426 273\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 473 329\nL 490 329\nL 490 363\nL 473 363\nL 473 329\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 520 353\nL 537 353\nL 537 363\nL 520 363\nL 520 353\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 567 360\nL 584 360\nL 584 363\nL 567 363\nL 567 360\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"34\" y=\"356\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"76\" y=\"350\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"128\" y=\"346\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"166\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"213\" y=\"312\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"260\" y=\"216\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"304\" y=\"106\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"351\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"401\" y=\"298\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"454\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"499\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"546\" y=\"353\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"49\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"96\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"148\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"186\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"233\" y=\"306\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"280\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"324\" y=\"31\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"371\" y=\"18\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"421\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"468\" y=\"324\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"524\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"566\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><path d=\"M 21 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 25 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 25 369\nL 25 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 72 369\nL 72 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 119 369\nL 119 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 166 369\nL 166 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 213 369\nL 213 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 260 369\nL 260 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 307 369\nL 307 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 354 369\nL 354 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 401 369\nL 401 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 448 369\nL 448 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 495 369\nL 495 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 542 369\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"35\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"82\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"128\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"177\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"221\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"270\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"320\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"363\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"411\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"459\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"504\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"553\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 30 360.25\nL 47.04 360.25\nL 47.04 363\nL 30 363\nL 30 360.25\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 77.08 354.82\nL 94.12 354.82\nL 94.12 363\nL 77.08 363\nL 77.08 354.82\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 124.17 350.89\nL 141.21 350.89\nL 141.21 363\nL 124.17 363\nL 124.17 350.89\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 171.25 320.55\nL 188.29 320.55\nL 188.29 363\nL 171.25 363\nL 171.25 320.55\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 218.33 316.05\nL 235.38 316.05\nL 235.38 363\nL 218.33 363\nL 218.33 316.05\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 265.42 220.34\nL 282.46 220.34\nL 282.46 363\nL 265.42 363\nL 265.42 220.34\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 312.5 110.02\nL 329.54 110.02\nL 329.54 363\nL 312.5 363\nL 312.5 110.02\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 359.58 60.2\nL 376.63 60.2\nL 376.63 363\nL 359.58 363\nL 359.58 60.2\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 406.67 302.94\nL 423.71 302.94\nL 423.71 363\nL 406.67 363\nL 406.67 302.94\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 453.75 326.54\nL 470.79 326.54\nL 470.79 363\nL 453.75 363\nL 453.75 326.54\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 500.83 352.01\nL 517.88 352.01\nL 517.88 363\nL 500.83 363\nL 500.83 352.01\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 547.92 357.82\nL 564.96 357.82\nL 564.96 363\nL 547.92 363\nL 547.92 357.82\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 50.04 359.13\nL 67.08 359.13\nL 67.08 363\nL 50.04 363\nL 50.04 359.13\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 97.12 352.95\nL 114.17 352.95\nL 114.17 363\nL 97.12 363\nL 97.12 352.95\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 144.21 347.14\nL 161.25 347.14\nL 161.25 363\nL 144.21 363\nL 144.21 347.14\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 191.29 314.55\nL 208.33 314.55\nL 208.33 363\nL 191.29 363\nL 191.29 314.55\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 238.38 310.24\nL 255.42 310.24\nL 255.42 363\nL 238.38 363\nL 238.38 310.24\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 285.46 231.58\nL 302.5 231.58\nL 302.5 363\nL 285.46 363\nL 285.46 231.58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 332.54 35.1\nL 349.58 35.1\nL 349.58 363\nL 332.54 363\nL 332.54 35.1\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 379.63 22.74\nL 396.67 22.74\nL 396.67 363\nL 379.63 363\nL 379.63 22.74\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 426.71 272.78\nL 443.75 272.78\nL 443.75 363\nL 426.71 363\nL 426.71 272.78\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 473.79 328.79\nL 490.83 328.79\nL 490.83 363\nL 473.79 363\nL 473.79 328.79\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 520.88 352.76\nL 537.92 352.76\nL 537.92 363\nL 520.88 363\nL 520.88 352.76\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 567.96 359.69\nL 585 359.69\nL 585 363\nL 567.96 363\nL 567.96 359.69\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"34\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"76\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"128\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"166\" y=\"315\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"213\" y=\"311\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"260\" y=\"215\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"305\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"352\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"402\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"455\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"500\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"547\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"49\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"96\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"148\" y=\"342\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"186\" y=\"309\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"233\" y=\"305\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"280\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"325\" y=\"30\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"372\" y=\"17\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"422\" y=\"267\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"469\" y=\"323\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"525\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"567\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
 			pngCRC: 0xcd12afb6,
 		},
 		{
@@ -172,7 +173,7 @@ func TestBarChart(t *testing.T) {
 				opt.BarWidth = 1000
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 15 386\nL 32 386\nL 32 389\nL 15 389\nL 15 386\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 63 381\nL 80 381\nL 80 389\nL 63 389\nL 63 381\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 111 376\nL 128 376\nL 128 389\nL 111 389\nL 111 376\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 160 344\nL 177 344\nL 177 389\nL 160 389\nL 160 344\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 208 339\nL 225 339\nL 225 389\nL 208 389\nL 208 339\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 256 236\nL 273 236\nL 273 389\nL 256 389\nL 256 236\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 305 118\nL 322 118\nL 322 389\nL 305 389\nL 305 118\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 353 64\nL 370 64\nL 370 389\nL 353 389\nL 353 64\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 401 325\nL 418 325\nL 418 389\nL 401 389\nL 401 325\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 350\nL 467 350\nL 467 389\nL 450 389\nL 450 350\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 498 378\nL 515 378\nL 515 389\nL 498 389\nL 498 378\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 546 384\nL 563 384\nL 563 389\nL 546 389\nL 546 384\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 35 385\nL 52 385\nL 52 389\nL 35 389\nL 35 385\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 83 379\nL 100 379\nL 100 389\nL 83 389\nL 83 379\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 131 372\nL 148 372\nL 148 389\nL 131 389\nL 131 372\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 180 337\nL 197 337\nL 197 389\nL 180 389\nL 180 337\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 228 333\nL 245 333\nL 245 389\nL 228 389\nL 228 333\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 276 248\nL 293 248\nL 293 389\nL 276 389\nL 276 248\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 325 37\nL 342 37\nL 342 389\nL 325 389\nL 325 37\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 373 24\nL 390 24\nL 390 389\nL 373 389\nL 373 24\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 421 293\nL 438 293\nL 438 389\nL 421 389\nL 421 293\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 470 353\nL 487 353\nL 487 389\nL 470 389\nL 470 353\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 518 378\nL 535 378\nL 535 389\nL 518 389\nL 518 378\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 566 386\nL 583 386\nL 583 389\nL 566 389\nL 566 386\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"19\" y=\"381\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"62\" y=\"376\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"115\" y=\"371\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"155\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"203\" y=\"334\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"251\" y=\"231\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"297\" y=\"113\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"345\" y=\"59\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"396\" y=\"320\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"451\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"497\" y=\"373\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"545\" y=\"379\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"34\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"82\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"135\" y=\"367\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"175\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"223\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"271\" y=\"243\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"317\" y=\"32\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"365\" y=\"19\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"416\" y=\"288\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"465\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"522\" y=\"373\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"565\" y=\"381\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 15 385.98\nL 32.67 385.98\nL 32.67 389\nL 15 389\nL 15 385.98\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 63.33 380.15\nL 81 380.15\nL 81 389\nL 63.33 389\nL 63.33 380.15\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 111.67 375.93\nL 129.33 375.93\nL 129.33 389\nL 111.67 389\nL 111.67 375.93\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 160 343.35\nL 177.67 343.35\nL 177.67 389\nL 160 389\nL 160 343.35\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 208.33 338.53\nL 226 338.53\nL 226 389\nL 208.33 389\nL 208.33 338.53\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 256.67 235.79\nL 274.33 235.79\nL 274.33 389\nL 256.67 389\nL 256.67 235.79\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 305 117.37\nL 322.67 117.37\nL 322.67 389\nL 305 389\nL 305 117.37\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 353.33 63.88\nL 371 63.88\nL 371 389\nL 353.33 389\nL 353.33 63.88\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 401.67 324.46\nL 419.33 324.46\nL 419.33 389\nL 401.67 389\nL 401.67 324.46\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 349.79\nL 467.67 349.79\nL 467.67 389\nL 450 389\nL 450 349.79\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 498.33 377.13\nL 516 377.13\nL 516 389\nL 498.33 389\nL 498.33 377.13\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 546.67 383.37\nL 564.33 383.37\nL 564.33 389\nL 546.67 389\nL 546.67 383.37\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 35.67 384.77\nL 53.33 384.77\nL 53.33 389\nL 35.67 389\nL 35.67 384.77\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 84 378.14\nL 101.67 378.14\nL 101.67 389\nL 84 389\nL 84 378.14\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 132.33 371.9\nL 150 371.9\nL 150 389\nL 132.33 389\nL 132.33 371.9\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 180.67 336.92\nL 198.33 336.92\nL 198.33 389\nL 180.67 389\nL 180.67 336.92\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 229 332.3\nL 246.67 332.3\nL 246.67 389\nL 229 389\nL 229 332.3\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 277.33 247.85\nL 295 247.85\nL 295 389\nL 277.33 389\nL 277.33 247.85\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 325.67 36.94\nL 343.33 36.94\nL 343.33 389\nL 325.67 389\nL 325.67 36.94\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 374 23.67\nL 391.67 23.67\nL 391.67 389\nL 374 389\nL 374 23.67\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 422.33 292.08\nL 440 292.08\nL 440 389\nL 422.33 389\nL 422.33 292.08\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 470.67 352.2\nL 488.33 352.2\nL 488.33 389\nL 470.67 389\nL 470.67 352.2\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 519 377.94\nL 536.67 377.94\nL 536.67 389\nL 519 389\nL 519 377.94\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 567.33 385.38\nL 585 385.38\nL 585 389\nL 567.33 389\nL 567.33 385.38\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"19\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"63\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"116\" y=\"370\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"155\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"204\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"252\" y=\"230\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"297\" y=\"112\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"346\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"397\" y=\"319\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"451\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"498\" y=\"372\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"546\" y=\"378\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"35\" y=\"379\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"83\" y=\"373\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"137\" y=\"366\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"176\" y=\"331\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"224\" y=\"327\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"273\" y=\"242\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"318\" y=\"31\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"366\" y=\"18\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"418\" y=\"287\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"466\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"523\" y=\"372\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"567\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
 			pngCRC: 0x6f6c7682,
 		},
 		{
@@ -182,7 +183,7 @@ func TestBarChart(t *testing.T) {
 				opt.BarWidth = 2
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 155 330\nL 157 330\nL 157 379\nL 155 379\nL 155 330\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 435 244\nL 437 244\nL 437 379\nL 435 379\nL 435 244\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 162 244\nL 164 244\nL 164 379\nL 162 379\nL 162 244\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 442 71\nL 444 71\nL 444 379\nL 442 379\nL 442 71\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 155.5 329.6\nL 157.5 329.6\nL 157.5 379\nL 155.5 379\nL 155.5 329.6\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 435.5 243.2\nL 437.5 243.2\nL 437.5 379\nL 435.5 379\nL 435.5 243.2\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 162.5 243.2\nL 164.5 243.2\nL 164.5 379\nL 162.5 379\nL 162.5 243.2\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 442.5 70.4\nL 444.5 70.4\nL 444.5 379\nL 442.5 379\nL 442.5 70.4\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0xa9ef8762,
 		},
 		{
@@ -192,7 +193,7 @@ func TestBarChart(t *testing.T) {
 				opt.BarMargin = Ptr(0.0)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 30 330\nL 160 330\nL 160 379\nL 30 379\nL 30 330\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 244\nL 440 244\nL 440 379\nL 310 379\nL 310 244\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 160 244\nL 290 244\nL 290 379\nL 160 379\nL 160 244\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 440 71\nL 570 71\nL 570 379\nL 440 379\nL 440 71\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 30 329.6\nL 160 329.6\nL 160 379\nL 30 379\nL 30 329.6\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 243.2\nL 440 243.2\nL 440 379\nL 310 379\nL 310 243.2\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 160 243.2\nL 290 243.2\nL 290 379\nL 160 379\nL 160 243.2\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 440 70.4\nL 570 70.4\nL 570 379\nL 440 379\nL 440 70.4\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x83daa059,
 		},
 		{
@@ -202,7 +203,7 @@ func TestBarChart(t *testing.T) {
 				opt.BarMargin = Ptr(1000.0) // will be limited to fit graph
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 30 330\nL 90 330\nL 90 379\nL 30 379\nL 30 330\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 244\nL 370 244\nL 370 379\nL 310 379\nL 310 244\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 230 244\nL 290 244\nL 290 379\nL 230 379\nL 230 244\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 510 71\nL 570 71\nL 570 379\nL 510 379\nL 510 71\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 30 329.6\nL 90 329.6\nL 90 379\nL 30 379\nL 30 329.6\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 243.2\nL 370 243.2\nL 370 379\nL 310 379\nL 310 243.2\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 230 243.2\nL 290 243.2\nL 290 379\nL 230 379\nL 230 243.2\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 510 70.4\nL 570 70.4\nL 570 379\nL 510 379\nL 510 70.4\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x2f5d0556,
 		},
 		{
@@ -213,7 +214,7 @@ func TestBarChart(t *testing.T) {
 				opt.BarMargin = Ptr(0.0)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 150 330\nL 160 330\nL 160 379\nL 150 379\nL 150 330\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 430 244\nL 440 244\nL 440 379\nL 430 379\nL 430 244\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 160 244\nL 170 244\nL 170 379\nL 160 379\nL 160 244\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 440 71\nL 450 71\nL 450 379\nL 440 379\nL 440 71\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 150 329.6\nL 160 329.6\nL 160 379\nL 150 379\nL 150 329.6\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 430 243.2\nL 440 243.2\nL 440 379\nL 430 379\nL 430 243.2\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 160 243.2\nL 170 243.2\nL 170 379\nL 160 379\nL 160 243.2\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 440 70.4\nL 450 70.4\nL 450 379\nL 440 379\nL 440 70.4\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x882b6b42,
 		},
 		{
@@ -224,7 +225,7 @@ func TestBarChart(t *testing.T) {
 				opt.BarMargin = Ptr(1000.0) // will be limited for readability
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 85 330\nL 95 330\nL 95 379\nL 85 379\nL 85 330\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 365 244\nL 375 244\nL 375 379\nL 365 379\nL 365 244\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 225 244\nL 235 244\nL 235 379\nL 225 379\nL 225 244\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 505 71\nL 515 71\nL 515 379\nL 505 379\nL 505 71\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 85 329.6\nL 95 329.6\nL 95 379\nL 85 379\nL 85 329.6\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 365 243.2\nL 375 243.2\nL 375 379\nL 365 379\nL 365 243.2\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 225 243.2\nL 235 243.2\nL 235 379\nL 225 379\nL 225 243.2\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 505 70.4\nL 515 70.4\nL 515 379\nL 505 379\nL 505 70.4\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0xea22413e,
 		},
 		{
@@ -239,7 +240,7 @@ func TestBarChart(t *testing.T) {
 				opt.YAxis[1].Theme = opt.Theme.WithYAxisSeriesColor(1)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T</text><text x=\"564\" y=\"47\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"564\" y=\"82\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"564\" y=\"118\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"564\" y=\"154\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"564\" y=\"189\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"564\" y=\"225\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"564\" y=\"261\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"564\" y=\"296\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"564\" y=\"332\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"564\" y=\"368\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"9\" y=\"47\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"9\" y=\"82\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"118\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">140</text><text x=\"9\" y=\"154\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"9\" y=\"189\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"18\" y=\"225\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"18\" y=\"261\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"18\" y=\"296\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"18\" y=\"332\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"27\" y=\"368\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 42 41\nL 554 41\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 76\nL 554 76\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 112\nL 554 112\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 148\nL 554 148\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 184\nL 554 184\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 220\nL 554 220\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 256\nL 554 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 292\nL 554 292\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 328\nL 554 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 364\nL 554 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 369\nL 46 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 88 369\nL 88 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 130 369\nL 130 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 173 369\nL 173 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 215 369\nL 215 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 257 369\nL 257 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 369\nL 300 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 342 369\nL 342 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 384 369\nL 384 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 427 369\nL 427 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 469 369\nL 469 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 511 369\nL 511 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 554 369\nL 554 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"54\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"96\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"137\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"182\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"221\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"265\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"311\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"349\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"392\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"436\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"476\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"519\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 51 361\nL 65 361\nL 65 363\nL 51 363\nL 51 361\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 93 356\nL 107 356\nL 107 363\nL 93 363\nL 93 356\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 135 352\nL 149 352\nL 149 363\nL 135 363\nL 135 352\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 178 323\nL 192 323\nL 192 363\nL 178 363\nL 178 323\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 220 319\nL 234 319\nL 234 363\nL 220 363\nL 220 319\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 262 227\nL 276 227\nL 276 363\nL 262 363\nL 262 227\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 305 121\nL 319 121\nL 319 363\nL 305 363\nL 305 121\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 347 73\nL 361 73\nL 361 363\nL 347 363\nL 347 73\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 389 306\nL 403 306\nL 403 363\nL 389 363\nL 389 306\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 432 329\nL 446 329\nL 446 363\nL 432 363\nL 432 329\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 474 353\nL 488 353\nL 488 363\nL 474 363\nL 474 353\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 516 359\nL 530 359\nL 530 363\nL 516 363\nL 516 359\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 68 360\nL 82 360\nL 82 363\nL 68 363\nL 68 360\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 110 354\nL 124 354\nL 124 363\nL 110 363\nL 110 354\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 152 349\nL 166 349\nL 166 363\nL 152 363\nL 152 349\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 195 319\nL 209 319\nL 209 363\nL 195 363\nL 195 319\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 237 315\nL 251 315\nL 251 363\nL 237 363\nL 237 315\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 279 244\nL 293 244\nL 293 363\nL 279 363\nL 279 244\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 322 64\nL 336 64\nL 336 363\nL 322 363\nL 322 64\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 364 53\nL 378 53\nL 378 363\nL 364 363\nL 364 53\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 406 281\nL 420 281\nL 420 363\nL 406 363\nL 406 281\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 449 332\nL 463 332\nL 463 363\nL 449 363\nL 449 332\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 491 354\nL 505 354\nL 505 363\nL 491 363\nL 491 354\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 533 361\nL 547 361\nL 547 363\nL 533 363\nL 533 361\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"54\" y=\"356\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"91\" y=\"351\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"138\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"172\" y=\"318\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"214\" y=\"314\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"256\" y=\"222\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"296\" y=\"116\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"338\" y=\"68\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"383\" y=\"301\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"432\" y=\"324\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"472\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"514\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"66\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"108\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"155\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"189\" y=\"314\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"231\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"273\" y=\"239\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"313\" y=\"59\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"355\" y=\"48\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"400\" y=\"276\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"443\" y=\"327\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"494\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"531\" y=\"356\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T</text><text x=\"564\" y=\"47\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"564\" y=\"82\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"564\" y=\"118\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"564\" y=\"154\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"564\" y=\"189\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"564\" y=\"225\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"564\" y=\"261\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"564\" y=\"296\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"564\" y=\"332\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"564\" y=\"368\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"9\" y=\"47\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"9\" y=\"82\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"118\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">140</text><text x=\"9\" y=\"154\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"9\" y=\"189\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"18\" y=\"225\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"18\" y=\"261\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"18\" y=\"296\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"18\" y=\"332\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"27\" y=\"368\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 42 41\nL 554 41\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 76\nL 554 76\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 112\nL 554 112\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 148\nL 554 148\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 184\nL 554 184\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 220\nL 554 220\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 256\nL 554 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 292\nL 554 292\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 328\nL 554 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 364\nL 554 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 369\nL 46 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 88 369\nL 88 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 130 369\nL 130 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 173 369\nL 173 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 215 369\nL 215 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 257 369\nL 257 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 369\nL 300 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 342 369\nL 342 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 384 369\nL 384 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 427 369\nL 427 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 469 369\nL 469 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 511 369\nL 511 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 554 369\nL 554 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"54\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"96\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"137\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"182\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"221\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"265\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"311\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"349\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"392\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"436\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"476\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"519\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 51 360.41\nL 65.67 360.41\nL 65.67 363\nL 51 363\nL 51 360.41\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 93.33 355.21\nL 108 355.21\nL 108 363\nL 93.33 363\nL 93.33 355.21\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 135.67 351.44\nL 150.33 351.44\nL 150.33 363\nL 135.67 363\nL 135.67 351.44\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 178 322.37\nL 192.67 322.37\nL 192.67 363\nL 178 363\nL 178 322.37\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 220.33 318.06\nL 235 318.06\nL 235 363\nL 220.33 363\nL 220.33 318.06\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 262.67 226.37\nL 277.33 226.37\nL 277.33 363\nL 262.67 363\nL 262.67 226.37\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 305 120.67\nL 319.67 120.67\nL 319.67 363\nL 305 363\nL 305 120.67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 347.33 72.94\nL 362 72.94\nL 362 363\nL 347.33 363\nL 347.33 72.94\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 389.67 305.5\nL 404.33 305.5\nL 404.33 363\nL 389.67 363\nL 389.67 305.5\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 432 328.11\nL 446.67 328.11\nL 446.67 363\nL 432 363\nL 432 328.11\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 474.33 352.52\nL 489 352.52\nL 489 363\nL 474.33 363\nL 474.33 352.52\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 516.67 358.08\nL 531.33 358.08\nL 531.33 363\nL 516.67 363\nL 516.67 358.08\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 68.67 359.56\nL 83.33 359.56\nL 83.33 363\nL 68.67 363\nL 68.67 359.56\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 111 353.92\nL 125.67 353.92\nL 125.67 363\nL 111 363\nL 111 353.92\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 153.33 348.62\nL 168 348.62\nL 168 363\nL 153.33 363\nL 153.33 348.62\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 195.67 318.88\nL 210.33 318.88\nL 210.33 363\nL 195.67 363\nL 195.67 318.88\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 238 314.95\nL 252.67 314.95\nL 252.67 363\nL 238 363\nL 238 314.95\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 280.33 243.17\nL 295 243.17\nL 295 363\nL 280.33 363\nL 280.33 243.17\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 322.67 63.9\nL 337.33 63.9\nL 337.33 363\nL 322.67 363\nL 322.67 63.9\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 365 52.62\nL 379.67 52.62\nL 379.67 363\nL 365 363\nL 365 52.62\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 407.33 280.77\nL 422 280.77\nL 422 363\nL 407.33 363\nL 407.33 280.77\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 449.67 331.87\nL 464.33 331.87\nL 464.33 363\nL 449.67 363\nL 449.67 331.87\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 492 353.75\nL 506.67 353.75\nL 506.67 363\nL 492 363\nL 492 353.75\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 534.33 360.07\nL 549 360.07\nL 549 363\nL 534.33 363\nL 534.33 360.07\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"54\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"91\" y=\"350\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"139\" y=\"346\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"172\" y=\"317\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"214\" y=\"313\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"257\" y=\"221\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"296\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"338\" y=\"67\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"384\" y=\"300\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"432\" y=\"323\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"472\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"515\" y=\"353\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"67\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"109\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"156\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"190\" y=\"313\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"232\" y=\"309\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"274\" y=\"238\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"314\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"356\" y=\"47\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"401\" y=\"275\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"444\" y=\"326\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"495\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"532\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text></svg>",
 			pngCRC: 0xa34f80b9,
 		},
 		{
@@ -251,7 +252,7 @@ func TestBarChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 30 330\nL 157 330\nL 157 379\nL 30 379\nL 30 330\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 244\nL 437 244\nL 437 379\nL 310 379\nL 310 244\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 162 244\nL 289 244\nL 289 379\nL 162 379\nL 162 244\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 442 71\nL 569 71\nL 569 379\nL 442 379\nL 442 71\" style=\"stroke:none;fill:rgb(145,204,117)\"/><circle cx=\"23\" cy=\"330\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 330\nL 562 330\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 325\nL 578 330\nL 562 335\nL 567 330\nL 562 325\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"580\" y=\"334\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">12</text><circle cx=\"23\" cy=\"244\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 244\nL 562 244\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 239\nL 578 244\nL 562 249\nL 567 244\nL 562 239\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"580\" y=\"248\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><circle cx=\"23\" cy=\"287\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 287\nL 562 287\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 282\nL 578 287\nL 562 292\nL 567 287\nL 562 282\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"580\" y=\"291\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18</text><circle cx=\"23\" cy=\"244\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 244\nL 562 244\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 239\nL 578 244\nL 562 249\nL 567 244\nL 562 239\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"580\" y=\"248\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><circle cx=\"23\" cy=\"71\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 71\nL 562 71\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 66\nL 578 71\nL 562 76\nL 567 71\nL 562 66\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"580\" y=\"75\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><circle cx=\"23\" cy=\"157\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 157\nL 562 157\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 152\nL 578 157\nL 562 162\nL 567 157\nL 562 152\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"580\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">36</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 30 329.6\nL 157.5 329.6\nL 157.5 379\nL 30 379\nL 30 329.6\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 243.2\nL 437.5 243.2\nL 437.5 379\nL 310 379\nL 310 243.2\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 162.5 243.2\nL 290 243.2\nL 290 379\nL 162.5 379\nL 162.5 243.2\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 442.5 70.4\nL 570 70.4\nL 570 379\nL 442.5 379\nL 442.5 70.4\" style=\"stroke:none;fill:rgb(145,204,117)\"/><circle cx=\"23\" cy=\"330\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 330\nL 562 330\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 325\nL 578 330\nL 562 335\nL 567 330\nL 562 325\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"580\" y=\"334\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">12</text><circle cx=\"23\" cy=\"244\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 244\nL 562 244\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 239\nL 578 244\nL 562 249\nL 567 244\nL 562 239\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"580\" y=\"248\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><circle cx=\"23\" cy=\"287\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 287\nL 562 287\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 282\nL 578 287\nL 562 292\nL 567 287\nL 562 282\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"580\" y=\"291\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18</text><circle cx=\"23\" cy=\"244\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 244\nL 562 244\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 239\nL 578 244\nL 562 249\nL 567 244\nL 562 239\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"580\" y=\"248\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><circle cx=\"23\" cy=\"71\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 71\nL 562 71\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 66\nL 578 71\nL 562 76\nL 567 71\nL 562 66\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"580\" y=\"75\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><circle cx=\"23\" cy=\"157\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 157\nL 562 157\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 152\nL 578 157\nL 562 162\nL 567 157\nL 562 152\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"580\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">36</text></svg>",
 			pngCRC: 0x88db720a,
 		},
 		{
@@ -263,13 +264,13 @@ func TestBarChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 30 300\nL 157 300\nL 157 379\nL 30 379\nL 30 300\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 220\nL 437 220\nL 437 379\nL 310 379\nL 310 220\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 162 220\nL 289 220\nL 289 379\nL 162 379\nL 162 220\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 442 60\nL 569 60\nL 569 379\nL 442 379\nL 442 60\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 89 293\nA 14 14 330.00 1 1 97 293\nL 93 279\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 79 279\nQ93,314 107,279\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"86\" y=\"284\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">12</text><path d=\"M 369 213\nA 14 14 330.00 1 1 377 213\nL 373 199\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 359 199\nQ373,234 387,199\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"366\" y=\"204\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><path d=\"M 221 213\nA 14 14 330.00 1 1 229 213\nL 225 199\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 211 199\nQ225,234 239,199\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"218\" y=\"204\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><path d=\"M 501 53\nA 14 14 330.00 1 1 509 53\nL 505 39\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 491 39\nQ505,74 519,39\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"498\" y=\"44\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 30 300\nL 157.5 300\nL 157.5 379\nL 30 379\nL 30 300\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 220\nL 437.5 220\nL 437.5 379\nL 310 379\nL 310 220\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 162.5 220\nL 290 220\nL 290 379\nL 162.5 379\nL 162.5 220\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 442.5 60\nL 570 60\nL 570 379\nL 442.5 379\nL 442.5 60\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 89 293\nA 14 14 330.00 1 1 97 293\nL 93 279\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 79 279\nQ93,314 107,279\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"86\" y=\"284\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">12</text><path d=\"M 369 213\nA 14 14 330.00 1 1 377 213\nL 373 199\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 359 199\nQ373,234 387,199\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"366\" y=\"204\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><path d=\"M 222 213\nA 14 14 330.00 1 1 230 213\nL 226 199\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 212 199\nQ226,234 240,199\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"219\" y=\"204\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><path d=\"M 502 53\nA 14 14 330.00 1 1 510 53\nL 506 39\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 492 39\nQ506,74 520,39\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"499\" y=\"44\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text></svg>",
 			pngCRC: 0x71230483,
 		},
 		{
 			name:        "stack_series",
 			makeOptions: makeFullBarChartStackedOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 217 29\nL 247 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"232\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"249\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 280 29\nL 310 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"295\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"312\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 342 29\nL 372 29\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"357\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"374\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">297</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">264</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">231</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">198</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">165</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"28\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99</text><text x=\"28\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">66</text><text x=\"28\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">33</text><text x=\"37\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 52 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 56 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 56 359\nL 56 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 121 359\nL 121 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 359\nL 187 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 252 359\nL 252 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 359\nL 318 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 383 359\nL 383 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 449 359\nL 449 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 359\nL 514 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"84\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"150\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"215\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"281\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"346\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"412\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"477\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"543\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 66 350\nL 111 350\nL 111 354\nL 66 354\nL 66 350\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 131 331\nL 176 331\nL 176 354\nL 131 354\nL 131 331\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 197 329\nL 242 329\nL 242 354\nL 197 354\nL 197 329\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 262 252\nL 307 252\nL 307 354\nL 262 354\nL 262 252\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 328 212\nL 373 212\nL 373 354\nL 328 354\nL 328 212\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 393 322\nL 438 322\nL 438 354\nL 393 354\nL 393 322\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 459 334\nL 504 334\nL 504 354\nL 459 354\nL 459 334\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 524 351\nL 569 351\nL 569 354\nL 524 354\nL 524 351\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 66 341\nL 111 341\nL 111 350\nL 66 350\nL 66 341\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 131 305\nL 176 305\nL 176 331\nL 131 331\nL 131 305\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 197 301\nL 242 301\nL 242 329\nL 197 329\nL 197 301\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 262 107\nL 307 107\nL 307 252\nL 262 252\nL 262 107\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 328 90\nL 373 90\nL 373 212\nL 328 212\nL 328 90\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 393 274\nL 438 274\nL 438 322\nL 393 322\nL 393 274\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 459 316\nL 504 316\nL 504 334\nL 459 334\nL 459 316\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 524 349\nL 569 349\nL 569 351\nL 524 351\nL 524 349\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 66 261\nL 111 261\nL 111 341\nL 66 341\nL 66 261\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 131 265\nL 176 265\nL 176 305\nL 131 305\nL 131 265\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 197 273\nL 242 273\nL 242 301\nL 197 301\nL 197 273\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 262 79\nL 307 79\nL 307 107\nL 262 107\nL 262 79\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 328 66\nL 373 66\nL 373 90\nL 328 90\nL 328 66\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 393 250\nL 438 250\nL 438 274\nL 393 274\nL 393 250\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 459 276\nL 504 276\nL 504 316\nL 459 316\nL 459 276\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 524 268\nL 569 268\nL 569 349\nL 524 349\nL 524 268\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 346 205\nA 14 14 330.00 1 1 354 205\nL 350 191\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 336 191\nQ350,226 364,191\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"337\" y=\"196\" style=\"stroke:none;fill:rgb(238,238,238);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">142.2</text><path d=\"M 280 100\nA 14 14 330.00 1 1 288 100\nL 284 86\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 270 86\nQ284,121 298,86\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"271\" y=\"91\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">144.6</text><path d=\"M 542 261\nA 14 14 330.00 1 1 550 261\nL 546 247\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 532 247\nQ546,282 560,247\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"533\" y=\"252\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80.8</text></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 217 29\nL 247 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"232\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"249\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 280 29\nL 310 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"295\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"312\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 342 29\nL 372 29\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"357\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"374\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">297</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">264</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">231</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">198</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">165</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"28\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99</text><text x=\"28\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">66</text><text x=\"28\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">33</text><text x=\"37\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 52 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 56 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 56 359\nL 56 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 121 359\nL 121 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 359\nL 187 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 252 359\nL 252 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 359\nL 318 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 383 359\nL 383 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 449 359\nL 449 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 359\nL 514 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"84\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"150\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"215\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"281\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"346\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"412\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"477\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"543\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 66 349.08\nL 111.5 349.08\nL 111.5 354\nL 66 354\nL 66 349.08\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 131.5 330.72\nL 177 330.72\nL 177 354\nL 131.5 354\nL 131.5 330.72\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 197 328.31\nL 242.5 328.31\nL 242.5 354\nL 197 354\nL 197 328.31\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 262.5 251.05\nL 308 251.05\nL 308 354\nL 262.5 354\nL 262.5 251.05\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 328 211.32\nL 373.5 211.32\nL 373.5 354\nL 328 354\nL 328 211.32\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 393.5 321.29\nL 439 321.29\nL 439 354\nL 393.5 354\nL 393.5 321.29\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 459 333.93\nL 504.5 333.93\nL 504.5 354\nL 459 354\nL 459 333.93\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 524.5 350.69\nL 570 350.69\nL 570 354\nL 524.5 354\nL 524.5 350.69\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 66 340.05\nL 111.5 340.05\nL 111.5 349.08\nL 66 349.08\nL 66 340.05\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 131.5 304.23\nL 177 304.23\nL 177 330.72\nL 131.5 330.72\nL 131.5 304.23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 197 299.52\nL 242.5 299.52\nL 242.5 328.31\nL 197 328.31\nL 197 299.52\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 262.5 105.97\nL 308 105.97\nL 308 251.05\nL 262.5 251.05\nL 262.5 105.97\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 328 88.71\nL 373.5 88.71\nL 373.5 211.32\nL 328 211.32\nL 328 88.71\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 393.5 272.43\nL 439 272.43\nL 439 321.29\nL 393.5 321.29\nL 393.5 272.43\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 459 315.07\nL 504.5 315.07\nL 504.5 333.93\nL 459 333.93\nL 459 315.07\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 524.5 348.38\nL 570 348.38\nL 570 350.69\nL 524.5 350.69\nL 524.5 348.38\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 66 259.78\nL 111.5 259.78\nL 111.5 340.05\nL 66 340.05\nL 66 259.78\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 131.5 263.7\nL 177 263.7\nL 177 304.23\nL 131.5 304.23\nL 131.5 263.7\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 197 271.02\nL 242.5 271.02\nL 242.5 299.52\nL 197 299.52\nL 197 271.02\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 262.5 77.07\nL 308 77.07\nL 308 105.97\nL 262.5 105.97\nL 262.5 77.07\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 328 64.23\nL 373.5 64.23\nL 373.5 88.71\nL 328 88.71\nL 328 64.23\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 393.5 248.14\nL 439 248.14\nL 439 272.43\nL 393.5 272.43\nL 393.5 248.14\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 459 274.13\nL 504.5 274.13\nL 504.5 315.07\nL 459 315.07\nL 459 274.13\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 524.5 267.31\nL 570 267.31\nL 570 348.38\nL 524.5 348.38\nL 524.5 267.31\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 346 204\nA 14 14 330.00 1 1 354 204\nL 350 190\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 336 190\nQ350,225 364,190\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"337\" y=\"195\" style=\"stroke:none;fill:rgb(238,238,238);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">142.2</text><path d=\"M 281 98\nA 14 14 330.00 1 1 289 98\nL 285 84\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 271 84\nQ285,119 299,84\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"272\" y=\"89\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">144.6</text><path d=\"M 543 260\nA 14 14 330.00 1 1 551 260\nL 547 246\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 533 246\nQ547,281 561,246\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"534\" y=\"251\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80.8</text></svg>",
 			pngCRC:      0x86614a2,
 		},
 		{
@@ -290,7 +291,7 @@ func TestBarChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 20 359\nL 20 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 90 359\nL 90 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 160 359\nL 160 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 230 359\nL 230 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 359\nL 300 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 370 359\nL 370 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 440 359\nL 440 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 510 359\nL 510 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"51\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"121\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"191\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"261\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"331\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"401\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"471\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"541\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 30 349\nL 80 349\nL 80 354\nL 30 354\nL 30 349\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 100 328\nL 150 328\nL 150 354\nL 100 354\nL 100 328\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 170 326\nL 220 326\nL 220 354\nL 170 354\nL 170 326\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 240 239\nL 290 239\nL 290 354\nL 240 354\nL 240 239\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 195\nL 360 195\nL 360 354\nL 310 354\nL 310 195\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 380 318\nL 430 318\nL 430 354\nL 380 354\nL 380 318\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 332\nL 500 332\nL 500 354\nL 450 354\nL 450 332\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 520 351\nL 570 351\nL 570 354\nL 520 354\nL 520 351\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 30 339\nL 80 339\nL 80 349\nL 30 349\nL 30 339\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 100 299\nL 150 299\nL 150 328\nL 100 328\nL 100 299\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 170 294\nL 220 294\nL 220 326\nL 170 326\nL 170 294\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 240 77\nL 290 77\nL 290 239\nL 240 239\nL 240 77\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 310 58\nL 360 58\nL 360 195\nL 310 195\nL 310 58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 380 264\nL 430 264\nL 430 318\nL 380 318\nL 380 264\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 450 311\nL 500 311\nL 500 332\nL 450 332\nL 450 311\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 520 349\nL 570 349\nL 570 351\nL 520 351\nL 520 349\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 55 250\nL 55 250\nL 55 250\nA 25 25 90.00 0 1 80 275\nL 80 339\nL 30 339\nL 30 275\nL 30 275\nA 25 25 90.00 0 1 55 250\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 125 254\nL 125 254\nL 125 254\nA 25 25 90.00 0 1 150 279\nL 150 299\nL 100 299\nL 100 279\nL 100 279\nA 25 25 90.00 0 1 125 254\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 195 263\nL 195 263\nL 195 263\nA 25 25 90.00 0 1 220 288\nL 220 294\nL 170 294\nL 170 288\nL 170 288\nA 25 25 90.00 0 1 195 263\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 265 45\nL 265 45\nL 265 45\nA 25 25 90.00 0 1 290 70\nL 290 77\nL 240 77\nL 240 70\nL 240 70\nA 25 25 90.00 0 1 265 45\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 335 31\nL 335 31\nL 335 31\nA 25 25 90.00 0 1 360 56\nL 360 58\nL 310 58\nL 310 56\nL 310 56\nA 25 25 90.00 0 1 335 31\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 405 237\nL 405 237\nL 405 237\nA 25 25 90.00 0 1 430 262\nL 430 264\nL 380 264\nL 380 262\nL 380 262\nA 25 25 90.00 0 1 405 237\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 475 266\nL 475 266\nL 475 266\nA 25 25 90.00 0 1 500 291\nL 500 311\nL 450 311\nL 450 291\nL 450 291\nA 25 25 90.00 0 1 475 266\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 545 259\nL 545 259\nL 545 259\nA 25 25 90.00 0 1 570 284\nL 570 349\nL 520 349\nL 520 284\nL 520 284\nA 25 25 90.00 0 1 545 259\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"51\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"118\" y=\"323\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23</text><text x=\"188\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"254\" y=\"234\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"324\" y=\"190\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><text x=\"398\" y=\"313\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"468\" y=\"327\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"541\" y=\"346\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"51\" y=\"334\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"118\" y=\"294\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26</text><text x=\"188\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"254\" y=\"72\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"324\" y=\"53\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"398\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"468\" y=\"306\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18</text><text x=\"541\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"48\" y=\"245\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"118\" y=\"249\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"188\" y=\"258\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"258\" y=\"40\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"328\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"398\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"468\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"538\" y=\"254\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 20 359\nL 20 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 90 359\nL 90 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 160 359\nL 160 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 230 359\nL 230 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 359\nL 300 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 370 359\nL 370 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 440 359\nL 440 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 510 359\nL 510 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"51\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"121\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"191\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"261\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"331\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"401\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"471\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"541\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 30 348.49\nL 80 348.49\nL 80 354\nL 30 354\nL 30 348.49\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 100 327.91\nL 150 327.91\nL 150 354\nL 100 354\nL 100 327.91\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 170 325.21\nL 220 325.21\nL 220 354\nL 170 354\nL 170 325.21\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 240 238.62\nL 290 238.62\nL 290 354\nL 240 354\nL 240 238.62\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 194.08\nL 360 194.08\nL 360 354\nL 310 354\nL 310 194.08\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 380 317.34\nL 430 317.34\nL 430 354\nL 380 354\nL 380 317.34\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 331.51\nL 500 331.51\nL 500 354\nL 450 354\nL 450 331.51\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 520 350.29\nL 570 350.29\nL 570 354\nL 520 354\nL 520 350.29\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 30 338.37\nL 80 338.37\nL 80 348.49\nL 30 348.49\nL 30 338.37\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 100 298.22\nL 150 298.22\nL 150 327.91\nL 100 327.91\nL 100 298.22\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 170 292.94\nL 220 292.94\nL 220 325.21\nL 170 325.21\nL 170 292.94\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 240 76\nL 290 76\nL 290 238.62\nL 240 238.62\nL 240 76\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 310 56.66\nL 360 56.66\nL 360 194.08\nL 310 194.08\nL 310 56.66\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 380 262.57\nL 430 262.57\nL 430 317.34\nL 380 317.34\nL 380 262.57\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 450 310.37\nL 500 310.37\nL 500 331.51\nL 450 331.51\nL 450 310.37\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 520 347.7\nL 570 347.7\nL 570 350.29\nL 520 350.29\nL 520 347.7\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 55 248.4\nL 55 248.4\nL 55 248.4\nA 25 25 90.00 0 1 80 273.4\nL 80 338.37\nL 30 338.37\nL 30 273.4\nL 30 273.4\nA 25 25 90.00 0 1 55 248.4\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 125 252.79\nL 125 252.79\nL 125 252.79\nA 25 25 90.00 0 1 150 277.79\nL 150 298.22\nL 100 298.22\nL 100 277.79\nL 100 277.79\nA 25 25 90.00 0 1 125 252.79\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 195 261\nL 195 261\nL 195 261\nA 25 25 90.00 0 1 220 286\nL 220 292.94\nL 170 292.94\nL 170 286\nL 170 286\nA 25 25 90.00 0 1 195 261\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 265 43.62\nL 265 43.62\nL 265 43.62\nA 25 25 90.00 0 1 290 68.62\nL 290 76\nL 240 76\nL 240 68.62\nL 240 68.62\nA 25 25 90.00 0 1 265 43.62\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 335 29.22\nL 335 29.22\nL 335 29.22\nA 25 25 90.00 0 1 360 54.22\nL 360 56.66\nL 310 56.66\nL 310 54.22\nL 310 54.22\nA 25 25 90.00 0 1 335 29.22\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 405 235.36\nL 405 235.36\nL 405 235.36\nA 25 25 90.00 0 1 430 260.36\nL 430 262.57\nL 380 262.57\nL 380 260.36\nL 380 260.36\nA 25 25 90.00 0 1 405 235.36\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 475 264.48\nL 475 264.48\nL 475 264.48\nA 25 25 90.00 0 1 500 289.48\nL 500 310.37\nL 450 310.37\nL 450 289.48\nL 450 289.48\nA 25 25 90.00 0 1 475 264.48\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 545 256.84\nL 545 256.84\nL 545 256.84\nA 25 25 90.00 0 1 570 281.84\nL 570 347.7\nL 520 347.7\nL 520 281.84\nL 520 281.84\nA 25 25 90.00 0 1 545 256.84\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"51\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"118\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23</text><text x=\"188\" y=\"320\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"254\" y=\"233\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"324\" y=\"189\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><text x=\"398\" y=\"312\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"468\" y=\"326\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"541\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"51\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"118\" y=\"293\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26</text><text x=\"188\" y=\"287\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"254\" y=\"71\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"324\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"398\" y=\"257\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"468\" y=\"305\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18</text><text x=\"541\" y=\"342\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"48\" y=\"243\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"118\" y=\"247\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"188\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"258\" y=\"38\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"328\" y=\"24\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"398\" y=\"230\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"468\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"538\" y=\"251\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text></svg>",
 			pngCRC: 0x58590bd4,
 		},
 		{
@@ -314,7 +315,7 @@ func TestBarChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 20 359\nL 20 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 90 359\nL 90 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 160 359\nL 160 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 230 359\nL 230 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 359\nL 300 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 370 359\nL 370 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 440 359\nL 440 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 510 359\nL 510 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"51\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"121\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"191\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"261\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"331\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"401\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"471\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"541\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 30 349\nL 80 349\nL 80 354\nL 30 354\nL 30 349\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 100 328\nL 150 328\nL 150 354\nL 100 354\nL 100 328\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 170 326\nL 220 326\nL 220 354\nL 170 354\nL 170 326\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 240 239\nL 290 239\nL 290 354\nL 240 354\nL 240 239\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 195\nL 360 195\nL 360 354\nL 310 354\nL 310 195\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 380 318\nL 430 318\nL 430 354\nL 380 354\nL 380 318\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 332\nL 500 332\nL 500 354\nL 450 354\nL 450 332\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 520 351\nL 570 351\nL 570 354\nL 520 354\nL 520 351\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 30 339\nL 80 339\nL 80 349\nL 30 349\nL 30 339\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 100 299\nL 150 299\nL 150 328\nL 100 328\nL 100 299\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 170 294\nL 220 294\nL 220 326\nL 170 326\nL 170 294\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 240 77\nL 290 77\nL 290 239\nL 240 239\nL 240 77\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 310 58\nL 360 58\nL 360 195\nL 310 195\nL 310 58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 380 264\nL 430 264\nL 430 318\nL 380 318\nL 380 264\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 450 311\nL 500 311\nL 500 332\nL 450 332\nL 450 311\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 520 349\nL 570 349\nL 570 351\nL 520 351\nL 520 349\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 30 250\nL 80 250\nL 80 339\nL 30 339\nL 30 250\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 100 254\nL 150 254\nL 150 299\nL 100 299\nL 100 254\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 170 263\nL 220 263\nL 220 294\nL 170 294\nL 170 263\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 240 45\nL 290 45\nL 290 77\nL 240 77\nL 240 45\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 310 31\nL 360 31\nL 360 58\nL 310 58\nL 310 31\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 380 237\nL 430 237\nL 430 264\nL 380 264\nL 380 237\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 450 266\nL 500 266\nL 500 311\nL 450 311\nL 450 266\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 520 259\nL 570 259\nL 570 349\nL 520 349\nL 520 259\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 541 252\nA 14 14 330.00 1 1 549 252\nL 545 238\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 531 238\nQ545,273 559,238\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"538\" y=\"243\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><path d=\"M 331 24\nA 14 14 330.00 1 1 339 24\nL 335 10\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><path d=\"M 321 10\nQ335,45 349,10\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><text x=\"324\" y=\"15\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">288</text><text x=\"51\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"118\" y=\"323\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23</text><text x=\"188\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"254\" y=\"234\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"324\" y=\"190\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><text x=\"398\" y=\"313\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"468\" y=\"327\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"541\" y=\"346\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"51\" y=\"334\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"118\" y=\"294\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26</text><text x=\"188\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"254\" y=\"72\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"324\" y=\"53\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"398\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"468\" y=\"306\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18</text><text x=\"541\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"48\" y=\"245\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"118\" y=\"249\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"188\" y=\"258\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"258\" y=\"40\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"398\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"468\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 20 359\nL 20 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 90 359\nL 90 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 160 359\nL 160 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 230 359\nL 230 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 359\nL 300 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 370 359\nL 370 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 440 359\nL 440 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 510 359\nL 510 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"51\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"121\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"191\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"261\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"331\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"401\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"471\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"541\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 30 348.49\nL 80 348.49\nL 80 354\nL 30 354\nL 30 348.49\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 100 327.91\nL 150 327.91\nL 150 354\nL 100 354\nL 100 327.91\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 170 325.21\nL 220 325.21\nL 220 354\nL 170 354\nL 170 325.21\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 240 238.62\nL 290 238.62\nL 290 354\nL 240 354\nL 240 238.62\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 194.08\nL 360 194.08\nL 360 354\nL 310 354\nL 310 194.08\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 380 317.34\nL 430 317.34\nL 430 354\nL 380 354\nL 380 317.34\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 331.51\nL 500 331.51\nL 500 354\nL 450 354\nL 450 331.51\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 520 350.29\nL 570 350.29\nL 570 354\nL 520 354\nL 520 350.29\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 30 338.37\nL 80 338.37\nL 80 348.49\nL 30 348.49\nL 30 338.37\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 100 298.22\nL 150 298.22\nL 150 327.91\nL 100 327.91\nL 100 298.22\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 170 292.94\nL 220 292.94\nL 220 325.21\nL 170 325.21\nL 170 292.94\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 240 76\nL 290 76\nL 290 238.62\nL 240 238.62\nL 240 76\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 310 56.66\nL 360 56.66\nL 360 194.08\nL 310 194.08\nL 310 56.66\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 380 262.57\nL 430 262.57\nL 430 317.34\nL 380 317.34\nL 380 262.57\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 450 310.37\nL 500 310.37\nL 500 331.51\nL 450 331.51\nL 450 310.37\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 520 347.7\nL 570 347.7\nL 570 350.29\nL 520 350.29\nL 520 347.7\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 30 248.4\nL 80 248.4\nL 80 338.37\nL 30 338.37\nL 30 248.4\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 100 252.79\nL 150 252.79\nL 150 298.22\nL 100 298.22\nL 100 252.79\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 170 261\nL 220 261\nL 220 292.94\nL 170 292.94\nL 170 261\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 240 43.62\nL 290 43.62\nL 290 76\nL 240 76\nL 240 43.62\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 310 29.22\nL 360 29.22\nL 360 56.66\nL 310 56.66\nL 310 29.22\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 380 235.36\nL 430 235.36\nL 430 262.57\nL 380 262.57\nL 380 235.36\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 450 264.48\nL 500 264.48\nL 500 310.37\nL 450 310.37\nL 450 264.48\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 520 256.84\nL 570 256.84\nL 570 347.7\nL 520 347.7\nL 520 256.84\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 541 249\nA 14 14 330.00 1 1 549 249\nL 545 235\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 531 235\nQ545,270 559,235\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"538\" y=\"240\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><path d=\"M 331 22\nA 14 14 330.00 1 1 339 22\nL 335 8\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><path d=\"M 321 8\nQ335,43 349,8\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><text x=\"324\" y=\"13\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">288</text><text x=\"51\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"118\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23</text><text x=\"188\" y=\"320\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"254\" y=\"233\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"324\" y=\"189\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><text x=\"398\" y=\"312\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"468\" y=\"326\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"541\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"51\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"118\" y=\"293\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26</text><text x=\"188\" y=\"287\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"254\" y=\"71\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"324\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"398\" y=\"257\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"468\" y=\"305\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18</text><text x=\"541\" y=\"342\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"48\" y=\"243\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"118\" y=\"247\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"188\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"258\" y=\"38\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"398\" y=\"230\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"468\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text></svg>",
 			pngCRC: 0x6ed1ee54,
 		},
 		{
@@ -349,9 +350,34 @@ func TestBarChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 20 359\nL 20 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 90 359\nL 90 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 160 359\nL 160 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 230 359\nL 230 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 359\nL 300 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 370 359\nL 370 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 440 359\nL 440 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 510 359\nL 510 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"51\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"121\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"191\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"261\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"331\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"401\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"471\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"541\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 30 349\nL 80 349\nL 80 354\nL 30 354\nL 30 349\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 100 328\nL 150 328\nL 150 354\nL 100 354\nL 100 328\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 170 326\nL 220 326\nL 220 354\nL 170 354\nL 170 326\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 240 239\nL 290 239\nL 290 354\nL 240 354\nL 240 239\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 195\nL 360 195\nL 360 354\nL 310 354\nL 310 195\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 380 318\nL 430 318\nL 430 354\nL 380 354\nL 380 318\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 332\nL 500 332\nL 500 354\nL 450 354\nL 450 332\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 520 351\nL 570 351\nL 570 354\nL 520 354\nL 520 351\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 30 339\nL 80 339\nL 80 349\nL 30 349\nL 30 339\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 100 299\nL 150 299\nL 150 328\nL 100 328\nL 100 299\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 170 294\nL 220 294\nL 220 326\nL 170 326\nL 170 294\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 240 77\nL 290 77\nL 290 239\nL 240 239\nL 240 77\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 310 58\nL 360 58\nL 360 195\nL 310 195\nL 310 58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 380 264\nL 430 264\nL 430 318\nL 380 318\nL 380 264\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 450 311\nL 500 311\nL 500 332\nL 450 332\nL 450 311\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 520 349\nL 570 349\nL 570 351\nL 520 351\nL 520 349\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 30 250\nL 80 250\nL 80 339\nL 30 339\nL 30 250\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 100 254\nL 150 254\nL 150 299\nL 100 299\nL 100 254\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 170 263\nL 220 263\nL 220 294\nL 170 294\nL 170 263\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 240 45\nL 290 45\nL 290 77\nL 240 77\nL 240 45\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 310 31\nL 360 31\nL 360 58\nL 310 58\nL 310 31\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 380 237\nL 430 237\nL 430 264\nL 380 264\nL 380 237\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 450 266\nL 500 266\nL 500 311\nL 450 311\nL 450 266\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 520 259\nL 570 259\nL 570 349\nL 520 349\nL 520 259\" style=\"stroke:none;fill:rgb(250,200,88)\"/><circle cx=\"23\" cy=\"199\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 199\nL 562 199\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 194\nL 578 199\nL 562 204\nL 567 199\nL 562 194\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"203\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">137</text><circle cx=\"23\" cy=\"265\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 265\nL 562 265\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 260\nL 578 265\nL 562 270\nL 567 265\nL 562 260\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"269\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">79</text><circle cx=\"23\" cy=\"30\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 30\nL 562 30\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 25\nL 578 30\nL 562 35\nL 567 30\nL 562 25\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"34\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">288</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 20 359\nL 20 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 90 359\nL 90 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 160 359\nL 160 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 230 359\nL 230 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 300 359\nL 300 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 370 359\nL 370 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 440 359\nL 440 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 510 359\nL 510 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"51\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"121\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"191\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"261\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"331\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"401\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"471\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"541\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 30 348.49\nL 80 348.49\nL 80 354\nL 30 354\nL 30 348.49\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 100 327.91\nL 150 327.91\nL 150 354\nL 100 354\nL 100 327.91\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 170 325.21\nL 220 325.21\nL 220 354\nL 170 354\nL 170 325.21\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 240 238.62\nL 290 238.62\nL 290 354\nL 240 354\nL 240 238.62\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 310 194.08\nL 360 194.08\nL 360 354\nL 310 354\nL 310 194.08\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 380 317.34\nL 430 317.34\nL 430 354\nL 380 354\nL 380 317.34\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 450 331.51\nL 500 331.51\nL 500 354\nL 450 354\nL 450 331.51\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 520 350.29\nL 570 350.29\nL 570 354\nL 520 354\nL 520 350.29\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 30 338.37\nL 80 338.37\nL 80 348.49\nL 30 348.49\nL 30 338.37\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 100 298.22\nL 150 298.22\nL 150 327.91\nL 100 327.91\nL 100 298.22\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 170 292.94\nL 220 292.94\nL 220 325.21\nL 170 325.21\nL 170 292.94\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 240 76\nL 290 76\nL 290 238.62\nL 240 238.62\nL 240 76\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 310 56.66\nL 360 56.66\nL 360 194.08\nL 310 194.08\nL 310 56.66\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 380 262.57\nL 430 262.57\nL 430 317.34\nL 380 317.34\nL 380 262.57\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 450 310.37\nL 500 310.37\nL 500 331.51\nL 450 331.51\nL 450 310.37\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 520 347.7\nL 570 347.7\nL 570 350.29\nL 520 350.29\nL 520 347.7\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 30 248.4\nL 80 248.4\nL 80 338.37\nL 30 338.37\nL 30 248.4\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 100 252.79\nL 150 252.79\nL 150 298.22\nL 100 298.22\nL 100 252.79\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 170 261\nL 220 261\nL 220 292.94\nL 170 292.94\nL 170 261\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 240 43.62\nL 290 43.62\nL 290 76\nL 240 76\nL 240 43.62\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 310 29.22\nL 360 29.22\nL 360 56.66\nL 310 56.66\nL 310 29.22\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 380 235.36\nL 430 235.36\nL 430 262.57\nL 380 262.57\nL 380 235.36\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 450 264.48\nL 500 264.48\nL 500 310.37\nL 450 310.37\nL 450 264.48\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 520 256.84\nL 570 256.84\nL 570 347.7\nL 520 347.7\nL 520 256.84\" style=\"stroke:none;fill:rgb(250,200,88)\"/><circle cx=\"23\" cy=\"199\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 199\nL 562 199\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 194\nL 578 199\nL 562 204\nL 567 199\nL 562 194\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"203\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">137</text><circle cx=\"23\" cy=\"265\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 265\nL 562 265\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 260\nL 578 265\nL 562 270\nL 567 265\nL 562 260\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"269\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">79</text><circle cx=\"23\" cy=\"30\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 30\nL 562 30\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 25\nL 578 30\nL 562 35\nL 567 30\nL 562 25\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"34\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">288</text></svg>",
 			pngCRC: 0x75d281c8,
 		},
+		{
+			name: "label_overflow_auto_wrap",
+			makeOptions: func() BarChartOption {
+				opt := NewBarChartOptionWithData([][]float64{{120, 200, 150, 80, 70, 110}})
+				opt.XAxis.Labels = []string{"North America Region", "South America Region", "Western Europe",
+					"Eastern Europe", "Asia Pacific Markets", "Middle East and Africa"}
+				opt.XAxis.LabelOverflow = LabelOverflowAuto
+				opt.Padding = NewBoxEqual(10)
+				return opt
+			},
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">205</text><text x=\"9\" y=\"43\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"71\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">175</text><text x=\"9\" y=\"98\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"126\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">145</text><text x=\"9\" y=\"153\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"181\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"18\" y=\"236\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">85</text><text x=\"18\" y=\"264\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">70</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 37\nL 590 37\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 65\nL 590 65\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 93\nL 590 93\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 121\nL 590 121\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 148\nL 590 148\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 176\nL 590 176\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 204\nL 590 204\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 232\nL 590 232\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 260\nL 590 260\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 265\nL 46 260\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 136 265\nL 136 260\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 227 265\nL 227 260\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 265\nL 318 260\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 408 265\nL 408 260\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 499 265\nL 499 260\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 265\nL 590 260\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"32\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,32,280)\">North America Region</text><text x=\"122\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,122,280)\">South America Region</text><text x=\"229\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,229,280)\">Western Europe</text><text x=\"321\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,321,280)\">Eastern Europe</text><text x=\"398\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,398,280)\">Asia Pacific Markets</text><text x=\"484\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,484,280)\">Middle East and Africa</text><path d=\"M 56 167.41\nL 126.67 167.41\nL 126.67 259\nL 56 259\nL 56 167.41\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 146.67 19.26\nL 217.33 19.26\nL 217.33 259\nL 146.67 259\nL 146.67 19.26\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 237.33 111.85\nL 308 111.85\nL 308 259\nL 237.33 259\nL 237.33 111.85\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 328 241.48\nL 398.67 241.48\nL 398.67 259\nL 328 259\nL 328 241.48\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 418.67 260\nL 489.33 260\nL 489.33 259\nL 418.67 259\nL 418.67 260\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 509.33 185.93\nL 580 185.93\nL 580 259\nL 509.33 259\nL 509.33 185.93\" style=\"stroke:none;fill:rgb(84,112,198)\"/></svg>",
+			pngCRC: 0x7991fc92,
+		},
+		{
+			name: "label_overflow_auto_rotate",
+			makeOptions: func() BarChartOption {
+				opt := NewBarChartOptionWithData([][]float64{{120, 200, 150, 80, 70, 110, 90, 60}})
+				opt.XAxis.Labels = []string{"North America Region", "South America Region", "Western Europe",
+					"Eastern Europe", "Asia Pacific Markets", "Middle East and Africa", "Central Asia", "Oceania"}
+				opt.XAxis.LabelOverflow = LabelOverflowAuto
+				return opt
+			},
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"19\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">204</text><text x=\"19\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">188</text><text x=\"19\" y=\"76\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">172</text><text x=\"19\" y=\"102\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">156</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">140</text><text x=\"19\" y=\"152\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">124</text><text x=\"19\" y=\"178\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108</text><text x=\"28\" y=\"203\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">92</text><text x=\"28\" y=\"228\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">76</text><text x=\"28\" y=\"254\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><path d=\"M 52 20\nL 580 20\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 45\nL 580 45\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 71\nL 580 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 96\nL 580 96\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 147\nL 580 147\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 173\nL 580 173\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 198\nL 580 198\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 224\nL 580 224\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 56 250\nL 580 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 56 255\nL 56 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 121 255\nL 121 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 255\nL 187 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 252 255\nL 252 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 255\nL 318 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 383 255\nL 383 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 449 255\nL 449 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 255\nL 514 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 255\nL 580 250\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"29\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,29,270)\">North America Region</text><text x=\"95\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,95,270)\">South America Region</text><text x=\"176\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,176,270)\">Western Europe</text><text x=\"243\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,243,270)\">Eastern Europe</text><text x=\"295\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,295,270)\">Asia Pacific Markets</text><text x=\"356\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,356,270)\">Middle East and Africa</text><text x=\"446\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,446,270)\">Central Asia</text><text x=\"522\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,522,270)\">Oceania</text><path d=\"M 66 154.17\nL 111.5 154.17\nL 111.5 249\nL 66 249\nL 66 154.17\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 131.5 26.39\nL 177 26.39\nL 177 249\nL 131.5 249\nL 131.5 26.39\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 197 106.25\nL 242.5 106.25\nL 242.5 249\nL 197 249\nL 197 106.25\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 262.5 218.06\nL 308 218.06\nL 308 249\nL 262.5 249\nL 262.5 218.06\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 328 234.03\nL 373.5 234.03\nL 373.5 249\nL 328 249\nL 328 234.03\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 393.5 170.14\nL 439 170.14\nL 439 249\nL 393.5 249\nL 393.5 170.14\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 459 202.08\nL 504.5 202.08\nL 504.5 249\nL 459 249\nL 459 202.08\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 524.5 250\nL 570 250\nL 570 249\nL 524.5 249\nL 524.5 250\" style=\"stroke:none;fill:rgb(84,112,198)\"/></svg>",
+			pngCRC: 0xb1db7369,
+		},
 	}
 
 	for i, tt := range tests {
@@ -413,12 +439,12 @@ func TestCalculateBarMarginsAndSize(t *testing.T) {
 	tests := []struct {
 		name            string
 		seriesCount     int
-		space           int
+		space           float64
 		barWidth        int
 		barMargin       *float64
-		expectedMargin  int
-		expectedSpacing int
-		expectedSize    int
+		expectedMargin  float64
+		expectedSpacing float64
+		expectedSize    float64
 	}{
 		{
 			name:            "large_space_default",
@@ -426,7 +452,7 @@ func TestCalculateBarMarginsAndSize(t *testing.T) {
 			space:           100,
 			expectedMargin:  10,
 			expectedSpacing: 5,
-			expectedSize:    23,
+			expectedSize:    70.0 / 3,
 		},
 		{
 			name:            "small_space_multiple",
@@ -454,7 +480,7 @@ func TestCalculateBarMarginsAndSize(t *testing.T) {
 			barMargin:       Ptr(1.0),
 			expectedMargin:  5,
 			expectedSpacing: 3,
-			expectedSize:    13,
+			expectedSize:    13.5,
 		},
 		{
 			name:            "single_series_default",
@@ -479,9 +505,9 @@ func TestCalculateBarMarginsAndSize(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			margin, spacing, size := calculateBarMarginsAndSize(tt.seriesCount, tt.space, tt.barWidth, tt.barMargin)
-			assert.Equal(t, tt.expectedMargin, margin)
-			assert.Equal(t, tt.expectedSpacing, spacing)
-			assert.Equal(t, tt.expectedSize, size)
+			assert.InDelta(t, tt.expectedMargin, margin, 0.0001)
+			assert.InDelta(t, tt.expectedSpacing, spacing, 0.0001)
+			assert.InDelta(t, tt.expectedSize, size, 0.0001)
 		})
 	}
 }
@@ -516,3 +542,23 @@ func TestBarChartError(t *testing.T) {
 		})
 	}
 }
+
+func TestBarChartFillGradient(t *testing.T) {
+	t.Parallel()
+
+	opt := NewBarChartOptionWithData([][]float64{{1, 2, 3}, {3, 2, 1}})
+	opt.SeriesList[0].FillGradient = Ptr(true)
+
+	for _, rounded := range []bool{false, true} {
+		opt.RoundedBarCaps = Ptr(rounded)
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.BarChart(opt))
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		svg := string(data)
+		assert.Equal(t, 1, strings.Count(svg, "<linearGradient"))
+		assert.Contains(t, svg, `<stop offset="0" stop-color="rgb(84,112,198)"/><stop offset="1" stop-color="rgb(84,112,198)" stop-opacity="0"/>`)
+		assert.Equal(t, 3, strings.Count(svg, "fill:url(#gradient-"))
+		assert.Equal(t, 3, strings.Count(svg, "fill:rgb(145,204,117)"))
+	}
+}
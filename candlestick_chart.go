@@ -115,10 +115,10 @@ func createPatternAwareLabelFormatter(originalSeries *CandlestickSeries, seriesI
 
 // TODO - v0.6 - attempt to de-duplicate with calculateBarMarginsAndSize
 // calculateCandleMarginsAndSize calculates margins and candle sizes similar to bar charts.
-func calculateCandleMarginsAndSize(seriesCount, space int, configuredCandleSize int, configuredCandleMargin *float64) (int, int, int) {
+func calculateCandleMarginsAndSize(seriesCount int, space, configuredCandleSize float64, configuredCandleMargin *float64) (float64, float64, float64) {
 	// default margins, adjusted below with config and series count
-	margin := 10      // margin between each series group
-	candleMargin := 5 // margin between each candle
+	margin := 10.0      // margin between each series group
+	candleMargin := 5.0 // margin between each candle
 	if space < 20 {
 		margin = 2
 		candleMargin = 2
@@ -126,23 +126,24 @@ func calculateCandleMarginsAndSize(seriesCount, space int, configuredCandleSize
 		margin = 5
 		candleMargin = 3
 	}
+	count := float64(seriesCount)
 	// check margin configuration if candle size allows margin
-	if configuredCandleSize+candleMargin < space/seriesCount {
+	if configuredCandleSize+candleMargin < space/count {
 		// CandleWidth is in range that we should also consider an optional margin configuration
 		if configuredCandleMargin != nil {
-			candleMargin = int(math.Round(*configuredCandleMargin))
-			if candleMargin+configuredCandleSize > space/seriesCount {
-				candleMargin = (space / seriesCount) - configuredCandleSize
+			candleMargin = *configuredCandleMargin
+			if candleMargin+configuredCandleSize > space/count {
+				candleMargin = (space / count) - configuredCandleSize
 			}
 		}
 	} // else, candle width is out of range.  Ignore margin config
 
-	candleSize := (space - 2*margin - candleMargin*(seriesCount-1)) / seriesCount
+	candleSize := (space - 2*margin - candleMargin*(count-1)) / count
 	// check candle size configuration, limited by the series count and space available
 	if configuredCandleSize > 0 && configuredCandleSize < candleSize {
 		candleSize = configuredCandleSize
 		// recalculate margin
-		margin = (space - seriesCount*candleSize - candleMargin*(seriesCount-1)) / 2
+		margin = (space - count*candleSize - candleMargin*(count-1)) / 2
 	}
 
 	return margin, candleMargin, candleSize
@@ -175,19 +176,21 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 	} else if candleWidthRatio > 1 {
 		candleWidthRatio = 1
 	}
-	candleWidth := int(float64(width) * candleWidthRatio / float64(maxDataCount))
+	candleWidth := float64(width) * candleWidthRatio / float64(maxDataCount)
 	if candleWidth < 1 {
 		candleWidth = 1
 	}
 
 	// Calculate candleWidthPerSeries for body rendering
-	candleWidthPerSeries := candleWidth / seriesCount
+	candleWidthPerSeries := candleWidth / float64(seriesCount)
 	if candleWidthPerSeries < 1 {
 		candleWidthPerSeries = 1
 	}
 
-	// Use autoDivide for positioning
-	divideValues := result.xaxisRange.autoDivide()
+	// Each time period section has the same width, positions are calculated without truncating to whole pixels
+	x0, x1 := result.xaxisRange.getRange(0)
+	sectionWidth := x1 - x0
+	divideCount := result.xaxisRange.divideCount
 
 	// Center positions for each series index
 	seriesCenterValues := make([][]int, seriesList.len())
@@ -251,27 +254,16 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 		seriesLowPoints[seriesIndex] = make([]Point, len(series.Data))
 		seriesCenterValues[seriesIndex] = make([]int, len(series.Data))
 		// Render each candlestick in this series
+		valueFormatter := getPreferredValueFormatter(series.Label.ValueFormatter, opt.ValueFormatter)
+		seriesPainter.startSeriesGroup(series.Name)
 		for j, ohlc := range series.Data {
-			if j >= maxDataCount || j >= len(divideValues) {
+			if j >= maxDataCount || j >= divideCount {
 				continue
 			}
-
-			// Position calculation: center candlesticks in each time period
-			// Calculate the center of each time period section
-			var sectionWidth int
-			if j < len(divideValues)-1 {
-				sectionWidth = divideValues[j+1] - divideValues[j]
-			} else {
-				// Last section uses same width as previous
-				if j > 0 {
-					sectionWidth = divideValues[j] - divideValues[j-1]
-				} else {
-					sectionWidth = seriesPainter.Width() / maxDataCount
-				}
-			}
+			sectionStart := float64(j) * sectionWidth
 
 			// Calculate margins and positioning exactly like bar charts
-			var groupMargin, candleMargin, candleWidth int
+			var groupMargin, candleMargin, candleWidth float64
 			if seriesList.len() == 1 {
 				// Single series: use simple centering
 				groupMargin = 0
@@ -283,7 +275,7 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 				var candleMarginFloat *float64
 				if opt.CandleMargin != nil {
 					// Convert percentage to absolute pixels
-					marginPixels := float64(sectionWidth) * (*opt.CandleMargin)
+					marginPixels := sectionWidth * (*opt.CandleMargin)
 					candleMarginFloat = &marginPixels
 				}
 
@@ -293,16 +285,18 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 						sectionWidth, candleWidthPerSeries, candleMarginFloat)
 			}
 
-			var centerX int
+			var centerXF float64
 			if seriesList.len() == 1 {
 				// Single series: center in the time period section
-				centerX = divideValues[j] + sectionWidth/2
+				centerXF = sectionStart + sectionWidth/2
 			} else {
 				// Multiple series: use exact bar chart positioning formula
-				// x = divideValues[j] + margin + index*(barWidth+barMargin)
-				x := divideValues[j] + groupMargin + seriesIndex*(candleWidth+candleMargin)
-				centerX = x + candleWidth/2
+				// x = sectionStart + margin + index*(barWidth+barMargin)
+				x := sectionStart + groupMargin + float64(seriesIndex)*(candleWidth+candleMargin)
+				centerXF = x + candleWidth/2
 			}
+			// The integer position is used for labels, mark points, and trend lines, while the float position draws the candle.
+			centerX := roundFloatToInt(centerXF)
 			seriesCenterValues[seriesIndex][j] = centerX
 
 			if !validateOHLCData(ohlc) { // if invalid mark as null and skip
@@ -315,16 +309,16 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 				continue
 			}
 
-			leftX := centerX - candleWidth/2
-			rightX := centerX + candleWidth/2
+			leftX := centerXF - candleWidth/2
+			rightX := centerXF + candleWidth/2
 
-			highY := yRange.getRestHeight(ohlc.High)
-			lowY := yRange.getRestHeight(ohlc.Low)
-			openY := yRange.getRestHeight(ohlc.Open)
-			closeY := yRange.getRestHeight(ohlc.Close)
+			highY := yRange.getRestHeightF(ohlc.High)
+			lowY := yRange.getRestHeightF(ohlc.Low)
+			openY := yRange.getRestHeightF(ohlc.Open)
+			closeY := yRange.getRestHeightF(ohlc.Close)
 
-			bodyTop := int(math.Min(float64(openY), float64(closeY)))
-			bodyBottom := int(math.Max(float64(openY), float64(closeY)))
+			bodyTop := math.Min(openY, closeY)
+			bodyBottom := math.Max(openY, closeY)
 
 			// Determine colors and style
 			isBullish := ohlc.Close >= ohlc.Open
@@ -345,6 +339,8 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 				wickColor = bodyColor
 			}
 
+			seriesPainter.startOHLCGroup(series.Name, labelAt(opt.XAxis.Labels, j), ohlc, valueFormatter)
+
 			// Draw high-low wick (if enabled)
 			showWicks := !flagIs(false, opt.ShowWicks)
 			if series.ShowWicks != nil {
@@ -356,15 +352,15 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 			}
 			if showWicks {
 				if highY < bodyTop {
-					seriesPainter.LineStroke([]Point{
-						{X: centerX, Y: highY},
-						{X: centerX, Y: bodyTop},
+					seriesPainter.LineStrokeF([]PointF{
+						{X: centerXF, Y: highY},
+						{X: centerXF, Y: bodyTop},
 					}, wickColor, wickWidth)
 				}
 				if lowY > bodyBottom {
-					seriesPainter.LineStroke([]Point{
-						{X: centerX, Y: bodyBottom},
-						{X: centerX, Y: lowY},
+					seriesPainter.LineStrokeF([]PointF{
+						{X: centerXF, Y: bodyBottom},
+						{X: centerXF, Y: lowY},
 					}, wickColor, wickWidth)
 				}
 
@@ -375,51 +371,52 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 				}
 
 				// Draw horizontal cap at high point
-				seriesPainter.LineStroke([]Point{
-					{X: centerX - capWidth, Y: highY},
-					{X: centerX + capWidth, Y: highY},
+				seriesPainter.LineStrokeF([]PointF{
+					{X: centerXF - capWidth, Y: highY},
+					{X: centerXF + capWidth, Y: highY},
 				}, wickColor, wickWidth)
 
 				// Draw horizontal cap at low point
-				seriesPainter.LineStroke([]Point{
-					{X: centerX - capWidth, Y: lowY},
-					{X: centerX + capWidth, Y: lowY},
+				seriesPainter.LineStrokeF([]PointF{
+					{X: centerXF - capWidth, Y: lowY},
+					{X: centerXF + capWidth, Y: lowY},
 				}, wickColor, wickWidth)
 			}
 
 			// Draw open-close body based on style
 			if bodyTop == bodyBottom { // Doji (open == close)
 				// Draw thin line instead of rectangle
-				seriesPainter.LineStroke([]Point{
+				seriesPainter.LineStrokeF([]PointF{
 					{X: leftX, Y: bodyTop},
 					{X: rightX, Y: bodyTop},
 				}, bodyColor, 1.0)
 			} else {
 				switch candleStyle {
 				case CandleStyleFilled:
-					seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
+					seriesPainter.FilledRectF(leftX, bodyTop, rightX, bodyBottom,
 						bodyColor, bodyColor, 0.0)
 
 				case CandleStyleTraditional:
 					if isBullish { // Hollow body for bullish
-						seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
+						seriesPainter.FilledRectF(leftX, bodyTop, rightX, bodyBottom,
 							ColorTransparent, bodyColor, wickWidth)
 					} else { // Filled body for bearish
-						seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
+						seriesPainter.FilledRectF(leftX, bodyTop, rightX, bodyBottom,
 							bodyColor, bodyColor, 0.0)
 					}
 
 				case CandleStyleOutline:
-					seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
+					seriesPainter.FilledRectF(leftX, bodyTop, rightX, bodyBottom,
 						ColorTransparent, bodyColor, wickWidth)
 				}
 			}
+			seriesPainter.endGroup()
 
 			// Store points for all OHLC values for mark points
-			seriesClosePoints[seriesIndex][j] = Point{X: centerX, Y: closeY}
-			seriesOpenPoints[seriesIndex][j] = Point{X: centerX, Y: openY}
-			seriesHighPoints[seriesIndex][j] = Point{X: centerX, Y: highY}
-			seriesLowPoints[seriesIndex][j] = Point{X: centerX, Y: lowY}
+			seriesClosePoints[seriesIndex][j] = Point{X: centerX, Y: roundFloatToInt(closeY)}
+			seriesOpenPoints[seriesIndex][j] = Point{X: centerX, Y: roundFloatToInt(openY)}
+			seriesHighPoints[seriesIndex][j] = Point{X: centerX, Y: roundFloatToInt(highY)}
+			seriesLowPoints[seriesIndex][j] = Point{X: centerX, Y: roundFloatToInt(lowY)}
 
 			// Add label if enabled (pattern logic is now handled in the label formatter)
 			if labelPainter != nil {
@@ -427,12 +424,13 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 					index:     j,          // Data point index (candlestick position), not series index
 					value:     ohlc.Close, // Use close price for label
 					x:         centerX,
-					y:         closeY,
+					y:         roundFloatToInt(closeY),
 					fontStyle: series.Label.FontStyle,
 					offset:    series.Label.Offset,
 				})
 			}
 		}
+		seriesPainter.endGroup()
 	}
 
 	// Handle mark lines, mark points, and trend lines for each series and OHLC component
@@ -487,6 +485,7 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 			},
 		}
 
+		var highValues, lowValues, volumeValues []float64 // lazily initialized for trend lines
 		for _, component := range ohlcComponents {
 			var values []float64
 
@@ -533,10 +532,18 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 				if values == nil {
 					values = component.extractFunc(series)
 				}
+				if highValues == nil {
+					highValues = series.ExtractHighPrices()
+					lowValues = series.ExtractLowPrices()
+					volumeValues = series.ExtractVolumes()
+				}
 				trendLinePainter.add(trendLineRenderOption{
 					defaultStrokeColor: opt.Theme.GetSeriesTrendColor(seriesThemeIndex),
 					xValues:            seriesCenterValues[seriesIndex],
 					seriesValues:       values,
+					highValues:         highValues,
+					lowValues:          lowValues,
+					volumeValues:       volumeValues,
 					axisRange:          yRange,
 					trends:             component.trendLines,
 					dashed:             false, // Default for candlestick charts
@@ -557,6 +564,11 @@ func (k *candlestickChart) Render() (Box, error) {
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		var visible []int
+		visible, opt.Theme = opt.Legend.hideSeries(opt.SeriesList, opt.Theme, opt.ValueFormatter)
+		opt.SeriesList = selectSeriesIndexes(opt.SeriesList, visible)
+	}
 	opt.Legend.Symbol = symbolCandlestick
 
 	renderResult, err := defaultRender(p, defaultRenderOption{
@@ -63,13 +63,13 @@ func TestCandlestickChart(t *testing.T) {
 		{
 			name:        "basic",
 			makeOptions: makeBasicCandlestickChartOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 139 268\nL 139 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 416\nL 139 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 268\nL 167 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 490\nL 167 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 82 342\nL 196 342\nL 196 416\nL 82 416\nL 82 342\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283 194\nL 283 239\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283 342\nL 283 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 194\nL 311 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 416\nL 311 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226 239\nL 340 239\nL 340 342\nL 226 342\nL 226 239\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 150\nL 428 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 239\nL 428 298\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 150\nL 456 150\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 298\nL 456 298\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 371 194\nL 485 194\nL 485 239\nL 371 239\nL 371 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 572 120\nL 572 194\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 572 298\nL 572 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 120\nL 600 120\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 342\nL 600 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515 194\nL 629 194\nL 629 298\nL 515 298\nL 515 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717 224\nL 717 283\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717 298\nL 717 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 224\nL 745 224\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 342\nL 745 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 660 283\nL 774 283\nL 774 298\nL 660 298\nL 660 283\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 139.3 268\nL 139.3 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 416\nL 139.3 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 268\nL 168.22 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 490\nL 168.22 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 81.46 342\nL 197.14 342\nL 197.14 416\nL 81.46 416\nL 81.46 342\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283.9 194\nL 283.9 238.4\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283.9 342\nL 283.9 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 194\nL 312.82 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 416\nL 312.82 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226.06 238.4\nL 341.74 238.4\nL 341.74 342\nL 226.06 342\nL 226.06 238.4\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 149.6\nL 428.5 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 238.4\nL 428.5 297.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 149.6\nL 457.42 149.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 297.6\nL 457.42 297.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 370.66 194\nL 486.34 194\nL 486.34 238.4\nL 370.66 238.4\nL 370.66 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 573.1 120\nL 573.1 194\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 573.1 297.6\nL 573.1 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 120\nL 602.02 120\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 342\nL 602.02 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515.26 194\nL 630.94 194\nL 630.94 297.6\nL 515.26 297.6\nL 515.26 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717.7 223.6\nL 717.7 282.8\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717.7 297.6\nL 717.7 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 223.6\nL 746.62 223.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 342\nL 746.62 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 659.86 282.8\nL 775.54 282.8\nL 775.54 297.6\nL 659.86 297.6\nL 659.86 282.8\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
 			pngCRC:      0xeba56810,
 		},
 		{
 			name:        "minimal",
 			makeOptions: makeMinimalCandlestickChartOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 139 259\nL 139 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 425\nL 139 508\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 259\nL 167 259\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 508\nL 167 508\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 82 342\nL 196 342\nL 196 425\nL 82 425\nL 82 342\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283 176\nL 283 226\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283 342\nL 283 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 176\nL 311 176\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 425\nL 311 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226 226\nL 340 226\nL 340 342\nL 226 342\nL 226 226\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 126\nL 428 176\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 226\nL 428 292\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 126\nL 456 126\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 292\nL 456 292\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 371 176\nL 485 176\nL 485 226\nL 371 226\nL 371 176\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 572 93\nL 572 176\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 572 292\nL 572 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 93\nL 600 93\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 342\nL 600 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515 176\nL 629 176\nL 629 292\nL 515 292\nL 515 176\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717 209\nL 717 276\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717 292\nL 717 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 209\nL 745 209\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 342\nL 745 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 660 276\nL 774 276\nL 774 292\nL 660 292\nL 660 276\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 139.3 258.57\nL 139.3 341.43\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 424.29\nL 139.3 507.14\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 258.57\nL 168.22 258.57\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 507.14\nL 168.22 507.14\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 81.46 341.43\nL 197.14 341.43\nL 197.14 424.29\nL 81.46 424.29\nL 81.46 341.43\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283.9 175.71\nL 283.9 225.43\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283.9 341.43\nL 283.9 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 175.71\nL 312.82 175.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 424.29\nL 312.82 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226.06 225.43\nL 341.74 225.43\nL 341.74 341.43\nL 226.06 341.43\nL 226.06 225.43\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 126\nL 428.5 175.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 225.43\nL 428.5 291.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 126\nL 457.42 126\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 291.71\nL 457.42 291.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 370.66 175.71\nL 486.34 175.71\nL 486.34 225.43\nL 370.66 225.43\nL 370.66 175.71\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 573.1 92.86\nL 573.1 175.71\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 573.1 291.71\nL 573.1 341.43\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 92.86\nL 602.02 92.86\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 341.43\nL 602.02 341.43\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515.26 175.71\nL 630.94 175.71\nL 630.94 291.71\nL 515.26 291.71\nL 515.26 175.71\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717.7 208.86\nL 717.7 275.14\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717.7 291.71\nL 717.7 341.43\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 208.86\nL 746.62 208.86\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 341.43\nL 746.62 341.43\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 659.86 275.14\nL 775.54 275.14\nL 775.54 291.71\nL 659.86 291.71\nL 659.86 275.14\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
 			pngCRC:      0x2b82f3ad,
 		},
 		{
@@ -81,7 +81,7 @@ func TestCandlestickChart(t *testing.T) {
 				opt.SeriesList[0].ShowWicks = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 103 342\nL 175 342\nL 175 416\nL 103 416\nL 103 342\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 247 239\nL 319 239\nL 319 342\nL 247 342\nL 247 239\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 392 194\nL 464 194\nL 464 239\nL 392 239\nL 392 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 536 194\nL 608 194\nL 608 298\nL 536 298\nL 536 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 681 283\nL 753 283\nL 753 298\nL 681 298\nL 681 283\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 103.15 342\nL 175.45 342\nL 175.45 416\nL 103.15 416\nL 103.15 342\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 247.75 238.4\nL 320.05 238.4\nL 320.05 342\nL 247.75 342\nL 247.75 238.4\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 392.35 194\nL 464.65 194\nL 464.65 238.4\nL 392.35 238.4\nL 392.35 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 536.95 194\nL 609.25 194\nL 609.25 297.6\nL 536.95 297.6\nL 536.95 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 681.55 282.8\nL 753.85 282.8\nL 753.85 297.6\nL 681.55 297.6\nL 681.55 282.8\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
 			pngCRC: 0xb10562a4,
 		},
 		{
@@ -95,7 +95,7 @@ func TestCandlestickChart(t *testing.T) {
 				opt.SeriesList[0] = CandlestickSeries{Data: data}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 139 268\nL 139 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 416\nL 139 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 268\nL 167 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 490\nL 167 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 82 416\nL 196 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283 194\nL 283 239\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283 342\nL 283 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 194\nL 311 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 416\nL 311 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226 239\nL 340 239\nL 340 342\nL 226 342\nL 226 239\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 150\nL 428 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 239\nL 428 298\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 150\nL 456 150\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 298\nL 456 298\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 371 194\nL 485 194\nL 485 239\nL 371 239\nL 371 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 572 120\nL 572 194\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 572 298\nL 572 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 120\nL 600 120\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 342\nL 600 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515 194\nL 629 194\nL 629 298\nL 515 298\nL 515 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717 224\nL 717 283\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717 298\nL 717 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 224\nL 745 224\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 342\nL 745 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 660 283\nL 774 283\nL 774 298\nL 660 298\nL 660 283\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 139.3 268\nL 139.3 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 416\nL 139.3 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 268\nL 168.22 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 490\nL 168.22 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 81.46 416\nL 197.14 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283.9 194\nL 283.9 238.4\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283.9 342\nL 283.9 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 194\nL 312.82 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 416\nL 312.82 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226.06 238.4\nL 341.74 238.4\nL 341.74 342\nL 226.06 342\nL 226.06 238.4\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 149.6\nL 428.5 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 238.4\nL 428.5 297.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 149.6\nL 457.42 149.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 297.6\nL 457.42 297.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 370.66 194\nL 486.34 194\nL 486.34 238.4\nL 370.66 238.4\nL 370.66 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 573.1 120\nL 573.1 194\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 573.1 297.6\nL 573.1 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 120\nL 602.02 120\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 342\nL 602.02 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515.26 194\nL 630.94 194\nL 630.94 297.6\nL 515.26 297.6\nL 515.26 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717.7 223.6\nL 717.7 282.8\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717.7 297.6\nL 717.7 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 223.6\nL 746.62 223.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 342\nL 746.62 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 659.86 282.8\nL 775.54 282.8\nL 775.54 297.6\nL 659.86 297.6\nL 659.86 282.8\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
 			pngCRC: 0x828ebf1,
 		},
 		{
@@ -120,7 +120,7 @@ func TestCandlestickChart(t *testing.T) {
 				opt.Legend.SeriesNames = []string{"Price", "Volume"}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 314 26\nL 329 26\nL 321 13\nL 314 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 329 13\nL 344 13\nL 336 26\nL 329 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"346\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><path d=\"M 402 26\nL 417 26\nL 409 13\nL 402 26\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 417 13\nL 432 13\nL 424 26\nL 417 13\" style=\"stroke:none;fill:rgb(250,128,80)\"/><text x=\"434\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Volume</text><text x=\"764\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">253</text><text x=\"764\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">246</text><text x=\"764\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">239</text><text x=\"764\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">232</text><text x=\"764\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">225</text><text x=\"764\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">218</text><text x=\"764\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">211</text><text x=\"764\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">204</text><text x=\"764\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">197</text><text x=\"764\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 754 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 754 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 754 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 754 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 754 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 754 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 754 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 754 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 754 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 754 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 204 569\nL 204 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 341 569\nL 341 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 479 569\nL 479 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 616 569\nL 616 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 754 569\nL 754 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"122\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"259\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"396\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"535\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"670\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 106 268\nL 106 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 106 416\nL 106 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 93 268\nL 119 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 93 490\nL 119 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 79 342\nL 133 342\nL 133 416\nL 79 416\nL 79 342\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 243 194\nL 243 239\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 243 342\nL 243 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 230 194\nL 256 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 230 416\nL 256 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 216 239\nL 270 239\nL 270 342\nL 216 342\nL 216 239\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 380 150\nL 380 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 239\nL 380 298\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 367 150\nL 393 150\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 367 298\nL 393 298\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 353 194\nL 407 194\nL 407 239\nL 353 239\nL 353 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 518 120\nL 518 194\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 518 298\nL 518 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 505 120\nL 531 120\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 505 342\nL 531 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 491 194\nL 545 194\nL 545 298\nL 491 298\nL 491 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 655 224\nL 655 283\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 655 298\nL 655 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 642 224\nL 668 224\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 642 342\nL 668 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 628 283\nL 682 283\nL 682 298\nL 628 298\nL 628 283\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 165 318\nL 165 400\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 165 482\nL 165 564\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 152 318\nL 178 318\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 152 564\nL 178 564\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 138 400\nL 192 400\nL 192 482\nL 138 482\nL 138 400\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 302 236\nL 302 277\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 302 400\nL 302 482\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 289 236\nL 315 236\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 289 482\nL 315 482\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 275 277\nL 329 277\nL 329 400\nL 275 400\nL 275 277\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 439 153\nL 439 236\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 439 277\nL 439 359\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 426 153\nL 452 153\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 426 359\nL 452 359\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 412 236\nL 466 236\nL 466 277\nL 412 277\nL 412 236\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 577 112\nL 577 236\" style=\"stroke-width:1;stroke:rgb(250,128,80);fill:none\"/><path d=\"M 577 359\nL 577 400\" style=\"stroke-width:1;stroke:rgb(250,128,80);fill:none\"/><path d=\"M 564 112\nL 590 112\" style=\"stroke-width:1;stroke:rgb(250,128,80);fill:none\"/><path d=\"M 564 400\nL 590 400\" style=\"stroke-width:1;stroke:rgb(250,128,80);fill:none\"/><path d=\"M 550 236\nL 604 236\nL 604 359\nL 550 359\nL 550 236\" style=\"stroke:none;fill:rgb(250,128,80)\"/><path d=\"M 714 277\nL 714 318\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 714 359\nL 714 441\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 701 277\nL 727 277\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 701 441\nL 727 441\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 687 318\nL 741 318\nL 741 359\nL 687 359\nL 687 318\" style=\"stroke:none;fill:rgb(64,160,110)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 314 26\nL 329 26\nL 321 13\nL 314 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 329 13\nL 344 13\nL 336 26\nL 329 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"346\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><path d=\"M 402 26\nL 417 26\nL 409 13\nL 402 26\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 417 13\nL 432 13\nL 424 26\nL 417 13\" style=\"stroke:none;fill:rgb(250,128,80)\"/><text x=\"434\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Volume</text><text x=\"764\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">253</text><text x=\"764\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">246</text><text x=\"764\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">239</text><text x=\"764\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">232</text><text x=\"764\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">225</text><text x=\"764\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">218</text><text x=\"764\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">211</text><text x=\"764\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">204</text><text x=\"764\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">197</text><text x=\"764\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 754 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 754 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 754 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 754 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 754 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 754 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 754 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 754 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 754 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 754 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 204 569\nL 204 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 341 569\nL 341 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 479 569\nL 479 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 616 569\nL 616 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 754 569\nL 754 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"122\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"259\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"396\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"535\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"670\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 105.72 268\nL 105.72 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 105.72 416\nL 105.72 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.98 268\nL 119.46 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.98 490\nL 119.46 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 78.24 342\nL 133.2 342\nL 133.2 416\nL 78.24 416\nL 78.24 342\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 243.12 194\nL 243.12 238.4\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 243.12 342\nL 243.12 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 229.38 194\nL 256.86 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 229.38 416\nL 256.86 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 215.64 238.4\nL 270.6 238.4\nL 270.6 342\nL 215.64 342\nL 215.64 238.4\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 380.52 149.6\nL 380.52 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.52 238.4\nL 380.52 297.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 366.78 149.6\nL 394.26 149.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 366.78 297.6\nL 394.26 297.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 353.04 194\nL 408 194\nL 408 238.4\nL 353.04 238.4\nL 353.04 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 517.92 120\nL 517.92 194\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 517.92 297.6\nL 517.92 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 504.18 120\nL 531.66 120\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 504.18 342\nL 531.66 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 490.44 194\nL 545.4 194\nL 545.4 297.6\nL 490.44 297.6\nL 490.44 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 655.32 223.6\nL 655.32 282.8\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 655.32 297.6\nL 655.32 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 641.58 223.6\nL 669.06 223.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 641.58 342\nL 669.06 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 627.84 282.8\nL 682.8 282.8\nL 682.8 297.6\nL 627.84 297.6\nL 627.84 282.8\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 165.68 317.33\nL 165.68 399.56\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 165.68 481.78\nL 165.68 564\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 151.94 317.33\nL 179.42 317.33\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 151.94 564\nL 179.42 564\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 138.2 399.56\nL 193.16 399.56\nL 193.16 481.78\nL 138.2 481.78\nL 138.2 399.56\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 303.08 235.11\nL 303.08 276.22\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 303.08 399.56\nL 303.08 481.78\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 289.34 235.11\nL 316.82 235.11\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 289.34 481.78\nL 316.82 481.78\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 275.6 276.22\nL 330.56 276.22\nL 330.56 399.56\nL 275.6 399.56\nL 275.6 276.22\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 440.48 152.89\nL 440.48 235.11\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 440.48 276.22\nL 440.48 358.44\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 426.74 152.89\nL 454.22 152.89\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 426.74 358.44\nL 454.22 358.44\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 413 235.11\nL 467.96 235.11\nL 467.96 276.22\nL 413 276.22\nL 413 235.11\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 577.88 111.78\nL 577.88 235.11\" style=\"stroke-width:1;stroke:rgb(250,128,80);fill:none\"/><path d=\"M 577.88 358.44\nL 577.88 399.56\" style=\"stroke-width:1;stroke:rgb(250,128,80);fill:none\"/><path d=\"M 564.14 111.78\nL 591.62 111.78\" style=\"stroke-width:1;stroke:rgb(250,128,80);fill:none\"/><path d=\"M 564.14 399.56\nL 591.62 399.56\" style=\"stroke-width:1;stroke:rgb(250,128,80);fill:none\"/><path d=\"M 550.4 235.11\nL 605.36 235.11\nL 605.36 358.44\nL 550.4 358.44\nL 550.4 235.11\" style=\"stroke:none;fill:rgb(250,128,80)\"/><path d=\"M 715.28 276.22\nL 715.28 317.33\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 715.28 358.44\nL 715.28 440.67\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 701.54 276.22\nL 729.02 276.22\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 701.54 440.67\nL 729.02 440.67\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><path d=\"M 687.8 317.33\nL 742.76 317.33\nL 742.76 358.44\nL 687.8 358.44\nL 687.8 317.33\" style=\"stroke:none;fill:rgb(64,160,110)\"/></svg>",
 			pngCRC: 0x2f222e42,
 		},
 		{
@@ -141,7 +141,7 @@ func TestCandlestickChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 139 268\nL 139 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 416\nL 139 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 268\nL 167 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 490\nL 167 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 82 342\nL 196 342\nL 196 416\nL 82 416\nL 82 342\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283 194\nL 283 239\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283 342\nL 283 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 194\nL 311 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 416\nL 311 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226 239\nL 340 239\nL 340 342\nL 226 342\nL 226 239\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 150\nL 428 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 239\nL 428 298\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 150\nL 456 150\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 298\nL 456 298\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 371 194\nL 485 194\nL 485 239\nL 371 239\nL 371 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 572 120\nL 572 194\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 572 298\nL 572 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 120\nL 600 120\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 342\nL 600 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515 194\nL 629 194\nL 629 298\nL 515 298\nL 515 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717 224\nL 717 283\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717 298\nL 717 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 224\nL 745 224\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 342\nL 745 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 660 283\nL 774 283\nL 774 298\nL 660 298\nL 660 283\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 139 379\nL 283 333\nL 428 259\nL 572 244\nL 717 246\" style=\"stroke-width:2;stroke:red;fill:none\"/><path d=\"M 139 268\nL 283 239\nL 428 203\nL 572 170\nL 717 192\" style=\"stroke-width:2;stroke:rgb(217,0,116);fill:none\"/><path d=\"M 139 490\nL 283 461\nL 428 396\nL 572 374\nL 717 362\" style=\"stroke-width:2;stroke:blue;fill:none\"/><path d=\"M 139 291\nL 283 259\nL 428 244\nL 572 259\nL 717 291\" style=\"stroke-width:2;stroke:green;fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 139.3 268\nL 139.3 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 416\nL 139.3 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 268\nL 168.22 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 490\nL 168.22 490\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 81.46 342\nL 197.14 342\nL 197.14 416\nL 81.46 416\nL 81.46 342\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283.9 194\nL 283.9 238.4\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283.9 342\nL 283.9 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 194\nL 312.82 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 416\nL 312.82 416\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226.06 238.4\nL 341.74 238.4\nL 341.74 342\nL 226.06 342\nL 226.06 238.4\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 149.6\nL 428.5 194\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 238.4\nL 428.5 297.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 149.6\nL 457.42 149.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 297.6\nL 457.42 297.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 370.66 194\nL 486.34 194\nL 486.34 238.4\nL 370.66 238.4\nL 370.66 194\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 573.1 120\nL 573.1 194\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 573.1 297.6\nL 573.1 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 120\nL 602.02 120\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 342\nL 602.02 342\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515.26 194\nL 630.94 194\nL 630.94 297.6\nL 515.26 297.6\nL 515.26 194\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717.7 223.6\nL 717.7 282.8\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717.7 297.6\nL 717.7 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 223.6\nL 746.62 223.6\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 342\nL 746.62 342\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 659.86 282.8\nL 775.54 282.8\nL 775.54 297.6\nL 659.86 297.6\nL 659.86 282.8\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 139 379\nL 284 333\nL 429 259\nL 573 244\nL 718 246\" style=\"stroke-width:2;stroke:red;fill:none\"/><path d=\"M 139 268\nL 284 239\nL 429 203\nL 573 170\nL 718 192\" style=\"stroke-width:2;stroke:rgb(217,0,116);fill:none\"/><path d=\"M 139 490\nL 284 461\nL 429 396\nL 573 374\nL 718 362\" style=\"stroke-width:2;stroke:blue;fill:none\"/><path d=\"M 139 291\nL 284 259\nL 429 244\nL 573 259\nL 718 291\" style=\"stroke-width:2;stroke:green;fill:none\"/></svg>",
 			pngCRC: 0xd5a8538a,
 		},
 		{
@@ -179,7 +179,7 @@ func TestCandlestickChart(t *testing.T) {
 					SeriesList: CandlestickSeriesList{series},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 139 248\nL 139 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 139 406\nL 139 485\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 111 248\nL 167 248\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 111 485\nL 167 485\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 82 327\nL 196 327\nL 196 406\nL 82 406\nL 82 327\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 283 169\nL 283 216\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 283 327\nL 283 406\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 255 169\nL 311 169\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 255 406\nL 311 406\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 226 216\nL 340 216\nL 340 327\nL 226 327\nL 226 216\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 428 121\nL 428 169\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 428 216\nL 428 280\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 400 121\nL 456 121\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 400 280\nL 456 280\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 371 169\nL 485 169\nL 485 216\nL 371 216\nL 371 169\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 572 90\nL 572 169\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 572 280\nL 572 327\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 544 90\nL 600 90\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 544 327\nL 600 327\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 515 169\nL 629 169\nL 629 280\nL 515 280\nL 515 169\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 717 200\nL 717 264\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 717 280\nL 717 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 689 200\nL 745 200\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 689 327\nL 745 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 660 264\nL 774 264\nL 774 280\nL 660 280\nL 660 264\" style=\"stroke:none;fill:rgb(145,204,117)\"/><circle cx=\"70\" cy=\"169\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 76 169\nL 772 169\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 772 164\nL 788 169\nL 772 174\nL 777 169\nL 772 164\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"790\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">115</text><circle cx=\"70\" cy=\"90\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 76 90\nL 772 90\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 772 85\nL 788 90\nL 772 95\nL 777 90\nL 772 85\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"790\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">120</text><circle cx=\"70\" cy=\"485\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 76 485\nL 772 485\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 772 480\nL 788 485\nL 772 490\nL 777 485\nL 772 480\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"790\" y=\"489\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">95</text><circle cx=\"70\" cy=\"251\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 76 251\nL 772 251\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 772 246\nL 788 251\nL 772 256\nL 777 251\nL 772 246\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"790\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">109.8</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"126\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"270\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"414\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"560\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"702\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 139.3 247.43\nL 139.3 326.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 139.3 405.71\nL 139.3 484.86\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 110.38 247.43\nL 168.22 247.43\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 110.38 484.86\nL 168.22 484.86\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 81.46 326.57\nL 197.14 326.57\nL 197.14 405.71\nL 81.46 405.71\nL 81.46 326.57\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 283.9 168.29\nL 283.9 215.77\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 283.9 326.57\nL 283.9 405.71\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 254.98 168.29\nL 312.82 168.29\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 254.98 405.71\nL 312.82 405.71\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 226.06 215.77\nL 341.74 215.77\nL 341.74 326.57\nL 226.06 326.57\nL 226.06 215.77\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 428.5 120.8\nL 428.5 168.29\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 428.5 215.77\nL 428.5 279.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 399.58 120.8\nL 457.42 120.8\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 399.58 279.09\nL 457.42 279.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 370.66 168.29\nL 486.34 168.29\nL 486.34 215.77\nL 370.66 215.77\nL 370.66 168.29\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 573.1 89.14\nL 573.1 168.29\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 573.1 279.09\nL 573.1 326.57\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 544.18 89.14\nL 602.02 89.14\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 544.18 326.57\nL 602.02 326.57\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 515.26 168.29\nL 630.94 168.29\nL 630.94 279.09\nL 515.26 279.09\nL 515.26 168.29\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 717.7 199.94\nL 717.7 263.26\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 717.7 279.09\nL 717.7 326.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 688.78 199.94\nL 746.62 199.94\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 688.78 326.57\nL 746.62 326.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 659.86 263.26\nL 775.54 263.26\nL 775.54 279.09\nL 659.86 279.09\nL 659.86 263.26\" style=\"stroke:none;fill:rgb(145,204,117)\"/><circle cx=\"70\" cy=\"169\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 76 169\nL 772 169\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 772 164\nL 788 169\nL 772 174\nL 777 169\nL 772 164\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"790\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">115</text><circle cx=\"70\" cy=\"90\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 76 90\nL 772 90\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 772 85\nL 788 90\nL 772 95\nL 777 90\nL 772 85\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"790\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">120</text><circle cx=\"70\" cy=\"485\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 76 485\nL 772 485\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 772 480\nL 788 485\nL 772 490\nL 777 485\nL 772 480\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"790\" y=\"489\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">95</text><circle cx=\"70\" cy=\"251\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 76 251\nL 772 251\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 772 246\nL 788 251\nL 772 256\nL 777 251\nL 772 246\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"790\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">109.8</text></svg>",
 			pngCRC: 0x577c3666,
 		},
 		{
@@ -217,7 +217,7 @@ func TestCandlestickChart(t *testing.T) {
 					SeriesList: CandlestickSeriesList{series},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"18\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98</text><text x=\"18\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94</text><text x=\"18\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 569\nL 46 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 194 569\nL 194 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 343 569\nL 343 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 492 569\nL 492 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 641 569\nL 641 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"107\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"255\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"403\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"554\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"700\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 120 257\nL 120 334\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 120 411\nL 120 488\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 91 257\nL 149 257\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 91 488\nL 149 488\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 61 334\nL 179 334\nL 179 411\nL 61 411\nL 61 334\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 268 180\nL 268 226\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 268 334\nL 268 411\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 239 180\nL 297 180\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 239 411\nL 297 411\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 209 226\nL 327 226\nL 327 334\nL 209 334\nL 209 226\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 417 134\nL 417 180\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 417 226\nL 417 287\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 388 134\nL 446 134\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 388 287\nL 446 287\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 358 180\nL 476 180\nL 476 226\nL 358 226\nL 358 180\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 566 103\nL 566 180\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 566 287\nL 566 334\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 537 103\nL 595 103\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 537 334\nL 595 334\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 507 180\nL 625 180\nL 625 287\nL 507 287\nL 507 180\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 715 211\nL 715 272\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 715 287\nL 715 334\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 686 211\nL 744 211\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 686 334\nL 744 334\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 656 272\nL 774 272\nL 774 287\nL 656 287\nL 656 272\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 562 173\nA 14 14 330.00 1 1 570 173\nL 566 159\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 552 159\nQ566,194 580,159\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"555\" y=\"164\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">115</text><path d=\"M 562 96\nA 14 14 330.00 1 1 570 96\nL 566 82\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 552 82\nQ566,117 580,82\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"555\" y=\"87\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">120</text><path d=\"M 116 481\nA 14 14 330.00 1 1 124 481\nL 120 467\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 106 467\nQ120,502 134,467\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"113\" y=\"472\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 116 327\nA 14 14 330.00 1 1 124 327\nL 120 313\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 106 313\nQ120,348 134,313\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"109\" y=\"318\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">105</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"18\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98</text><text x=\"18\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94</text><text x=\"18\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 569\nL 46 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 194 569\nL 194 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 343 569\nL 343 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 492 569\nL 492 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 641 569\nL 641 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"107\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"255\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"403\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"554\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"700\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 120.4 256.22\nL 120.4 333.17\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 120.4 410.11\nL 120.4 487.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 90.64 256.22\nL 150.16 256.22\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 90.64 487.06\nL 150.16 487.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 60.88 333.17\nL 179.92 333.17\nL 179.92 410.11\nL 60.88 410.11\nL 60.88 333.17\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 269.2 179.28\nL 269.2 225.44\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 269.2 333.17\nL 269.2 410.11\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 239.44 179.28\nL 298.96 179.28\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 239.44 410.11\nL 298.96 410.11\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 209.68 225.44\nL 328.72 225.44\nL 328.72 333.17\nL 209.68 333.17\nL 209.68 225.44\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 418 133.11\nL 418 179.28\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 418 225.44\nL 418 287\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 388.24 133.11\nL 447.76 133.11\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 388.24 287\nL 447.76 287\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 358.48 179.28\nL 477.52 179.28\nL 477.52 225.44\nL 358.48 225.44\nL 358.48 179.28\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 566.8 102.33\nL 566.8 179.28\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 566.8 287\nL 566.8 333.17\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 537.04 102.33\nL 596.56 102.33\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 537.04 333.17\nL 596.56 333.17\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 507.28 179.28\nL 626.32 179.28\nL 626.32 287\nL 507.28 287\nL 507.28 179.28\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 715.6 210.06\nL 715.6 271.61\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 715.6 287\nL 715.6 333.17\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 685.84 210.06\nL 745.36 210.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 685.84 333.17\nL 745.36 333.17\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 656.08 271.61\nL 775.12 271.61\nL 775.12 287\nL 656.08 287\nL 656.08 271.61\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 563 172\nA 14 14 330.00 1 1 571 172\nL 567 158\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 553 158\nQ567,193 581,158\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"556\" y=\"163\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">115</text><path d=\"M 563 95\nA 14 14 330.00 1 1 571 95\nL 567 81\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 553 81\nQ567,116 581,81\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"556\" y=\"86\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">120</text><path d=\"M 116 480\nA 14 14 330.00 1 1 124 480\nL 120 466\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 106 466\nQ120,501 134,466\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"113\" y=\"471\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 116 326\nA 14 14 330.00 1 1 124 326\nL 120 312\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 106 312\nQ120,347 134,312\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"109\" y=\"317\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">105</text></svg>",
 			pngCRC: 0x9aa8a7fd,
 		},
 		{
@@ -251,7 +251,7 @@ func TestCandlestickChart(t *testing.T) {
 					SeriesList: CandlestickSeriesList{series},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.67</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111.33</text><text x=\"30\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.67</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"30\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.67</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.33</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"242\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"604\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 247 169\nL 247 432\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 247 433\nL 247 564\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 175 169\nL 319 169\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 175 564\nL 319 564\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 103 432\nL 391 432\nL 391 433\nL 103 433\nL 103 432\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 609 37\nL 609 116\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 609 301\nL 609 433\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 537 37\nL 681 37\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 537 433\nL 681 433\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 465 116\nL 753 116\nL 753 301\nL 465 301\nL 465 116\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 252 419\nL 285 419\nL 285 419\nA 4 4 90.00 0 1 289 423\nL 289 436\nL 289 436\nA 4 4 90.00 0 1 285 440\nL 252 440\nL 252 440\nA 4 4 90.00 0 1 248 436\nL 248 423\nL 248 423\nA 4 4 90.00 0 1 252 419\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"252\" y=\"436\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><text x=\"614\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">User Label</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.67</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111.33</text><text x=\"30\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.67</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"30\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.67</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.33</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"242\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"604\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 247.75 168.29\nL 247.75 431.83\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 247.75 432.1\nL 247.75 564\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 175.45 168.29\nL 320.05 168.29\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 175.45 564\nL 320.05 564\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 103.15 431.83\nL 392.35 431.83\nL 392.35 432.1\nL 103.15 432.1\nL 103.15 431.83\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 609.25 36.38\nL 609.25 115.52\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 609.25 300.19\nL 609.25 432.1\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 536.95 36.38\nL 681.55 36.38\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 536.95 432.1\nL 681.55 432.1\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 464.65 115.52\nL 753.85 115.52\nL 753.85 300.19\nL 464.65 300.19\nL 464.65 115.52\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 253 419\nL 286 419\nL 286 419\nA 4 4 90.00 0 1 290 423\nL 290 436\nL 290 436\nA 4 4 90.00 0 1 286 440\nL 253 440\nL 253 440\nA 4 4 90.00 0 1 249 436\nL 249 423\nL 249 423\nA 4 4 90.00 0 1 253 419\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"253\" y=\"436\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><text x=\"614\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">User Label</text></svg>",
 			pngCRC: 0x161b609d,
 		},
 		{
@@ -276,7 +276,7 @@ func TestCandlestickChart(t *testing.T) {
 					SeriesList: CandlestickSeriesList{{Data: data}},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">131.13</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">127.33</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.54</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">119.75</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115.96</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.17</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.38</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.58</text><text x=\"9\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100.79</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 74 455\nL 74 506\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 74 540\nL 74 590\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 72 455\nL 76 455\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 72 590\nL 76 590\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 69 506\nL 79 506\nL 79 540\nL 69 540\nL 69 506\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 446\nL 88 497\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 88 531\nL 88 582\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 86 446\nL 90 446\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 86 582\nL 90 582\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 83 497\nL 93 497\nL 93 531\nL 83 531\nL 83 497\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 102 438\nL 102 489\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 102 523\nL 102 574\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 100 438\nL 104 438\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 100 574\nL 104 574\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 97 489\nL 107 489\nL 107 523\nL 97 523\nL 97 489\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 117 429\nL 117 480\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 117 514\nL 117 565\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 115 429\nL 119 429\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 115 565\nL 119 565\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 112 480\nL 122 480\nL 122 514\nL 112 514\nL 112 480\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 131 421\nL 131 472\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 131 506\nL 131 557\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 129 421\nL 133 421\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 129 557\nL 133 557\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 126 472\nL 136 472\nL 136 506\nL 126 506\nL 126 472\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 146 412\nL 146 463\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 146 497\nL 146 548\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 144 412\nL 148 412\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 144 548\nL 148 548\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 141 463\nL 151 463\nL 151 497\nL 141 497\nL 141 463\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 160 404\nL 160 455\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 160 489\nL 160 540\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 158 404\nL 162 404\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 158 540\nL 162 540\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 155 455\nL 165 455\nL 165 489\nL 155 489\nL 155 455\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 175 395\nL 175 446\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 175 480\nL 175 531\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 173 395\nL 177 395\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 173 531\nL 177 531\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 170 446\nL 180 446\nL 180 480\nL 170 480\nL 170 446\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 189 387\nL 189 438\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 189 472\nL 189 523\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 187 387\nL 191 387\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 187 523\nL 191 523\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 184 438\nL 194 438\nL 194 472\nL 184 472\nL 184 438\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 204 378\nL 204 429\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 204 463\nL 204 514\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 202 378\nL 206 378\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 202 514\nL 206 514\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 199 429\nL 209 429\nL 209 463\nL 199 463\nL 199 429\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 218 370\nL 218 421\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 218 455\nL 218 506\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 216 370\nL 220 370\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 216 506\nL 220 506\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 213 421\nL 223 421\nL 223 455\nL 213 455\nL 213 421\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 233 361\nL 233 412\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 233 446\nL 233 497\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 231 361\nL 235 361\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 231 497\nL 235 497\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 228 412\nL 238 412\nL 238 446\nL 228 446\nL 228 412\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 247 353\nL 247 404\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 247 438\nL 247 489\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 245 353\nL 249 353\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 245 489\nL 249 489\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 242 404\nL 252 404\nL 252 438\nL 242 438\nL 242 404\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 261 344\nL 261 395\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 261 429\nL 261 480\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 259 344\nL 263 344\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 259 480\nL 263 480\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 256 395\nL 266 395\nL 266 429\nL 256 429\nL 256 395\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 276 336\nL 276 387\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 276 421\nL 276 472\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 274 336\nL 278 336\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 274 472\nL 278 472\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 271 387\nL 281 387\nL 281 421\nL 271 421\nL 271 387\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 290 327\nL 290 378\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 290 412\nL 290 463\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 288 327\nL 292 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 288 463\nL 292 463\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 285 378\nL 295 378\nL 295 412\nL 285 412\nL 285 378\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 305 319\nL 305 370\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 305 404\nL 305 455\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 303 319\nL 307 319\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 303 455\nL 307 455\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 300 370\nL 310 370\nL 310 404\nL 300 404\nL 300 370\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 319 310\nL 319 361\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 319 395\nL 319 446\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 317 310\nL 321 310\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 317 446\nL 321 446\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 314 361\nL 324 361\nL 324 395\nL 314 395\nL 314 361\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 334 302\nL 334 353\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 334 387\nL 334 438\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 332 302\nL 336 302\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 332 438\nL 336 438\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 329 353\nL 339 353\nL 339 387\nL 329 387\nL 329 353\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 348 293\nL 348 344\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 348 378\nL 348 429\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 346 293\nL 350 293\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 346 429\nL 350 429\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 343 344\nL 353 344\nL 353 378\nL 343 378\nL 343 344\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 363 285\nL 363 336\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 363 370\nL 363 421\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 361 285\nL 365 285\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 361 421\nL 365 421\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 358 336\nL 368 336\nL 368 370\nL 358 370\nL 358 336\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 377 276\nL 377 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 377 361\nL 377 412\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 375 276\nL 379 276\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 375 412\nL 379 412\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 372 327\nL 382 327\nL 382 361\nL 372 361\nL 372 327\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 392 268\nL 392 319\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 392 353\nL 392 404\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 390 268\nL 394 268\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 390 404\nL 394 404\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 387 319\nL 397 319\nL 397 353\nL 387 353\nL 387 319\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 406 259\nL 406 310\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 406 344\nL 406 395\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 404 259\nL 408 259\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 404 395\nL 408 395\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 401 310\nL 411 310\nL 411 344\nL 401 344\nL 401 310\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 421 251\nL 421 302\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 421 336\nL 421 387\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 419 251\nL 423 251\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 419 387\nL 423 387\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 416 302\nL 426 302\nL 426 336\nL 416 336\nL 416 302\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 435 242\nL 435 293\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 435 327\nL 435 378\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 433 242\nL 437 242\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 433 378\nL 437 378\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 430 293\nL 440 293\nL 440 327\nL 430 327\nL 430 293\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 449 234\nL 449 285\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 449 319\nL 449 370\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 447 234\nL 451 234\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 447 370\nL 451 370\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 444 285\nL 454 285\nL 454 319\nL 444 319\nL 444 285\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 464 225\nL 464 276\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 464 310\nL 464 361\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 462 225\nL 466 225\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 462 361\nL 466 361\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 459 276\nL 469 276\nL 469 310\nL 459 310\nL 459 276\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 478 217\nL 478 268\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 478 302\nL 478 353\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 476 217\nL 480 217\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 476 353\nL 480 353\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 473 268\nL 483 268\nL 483 302\nL 473 302\nL 473 268\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 493 208\nL 493 259\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 493 293\nL 493 344\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 491 208\nL 495 208\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 491 344\nL 495 344\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 488 259\nL 498 259\nL 498 293\nL 488 293\nL 488 259\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 507 200\nL 507 251\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 507 285\nL 507 336\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 505 200\nL 509 200\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 505 336\nL 509 336\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 502 251\nL 512 251\nL 512 285\nL 502 285\nL 502 251\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 522 191\nL 522 242\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 522 276\nL 522 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 520 191\nL 524 191\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 520 327\nL 524 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 517 242\nL 527 242\nL 527 276\nL 517 276\nL 517 242\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 536 183\nL 536 234\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 536 268\nL 536 319\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 534 183\nL 538 183\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 534 319\nL 538 319\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 531 234\nL 541 234\nL 541 268\nL 531 268\nL 531 234\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 551 174\nL 551 225\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 551 259\nL 551 310\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 549 174\nL 553 174\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 549 310\nL 553 310\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 546 225\nL 556 225\nL 556 259\nL 546 259\nL 546 225\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 565 166\nL 565 217\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 565 251\nL 565 302\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 563 166\nL 567 166\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 563 302\nL 567 302\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 560 217\nL 570 217\nL 570 251\nL 560 251\nL 560 217\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 580 157\nL 580 208\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 580 242\nL 580 293\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 578 157\nL 582 157\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 578 293\nL 582 293\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 575 208\nL 585 208\nL 585 242\nL 575 242\nL 575 208\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 594 149\nL 594 200\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 594 234\nL 594 285\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 592 149\nL 596 149\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 592 285\nL 596 285\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 589 200\nL 599 200\nL 599 234\nL 589 234\nL 589 200\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 609 140\nL 609 191\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 609 225\nL 609 276\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 607 140\nL 611 140\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 607 276\nL 611 276\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 604 191\nL 614 191\nL 614 225\nL 604 225\nL 604 191\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 623 132\nL 623 183\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 623 217\nL 623 268\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 621 132\nL 625 132\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 621 268\nL 625 268\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 618 183\nL 628 183\nL 628 217\nL 618 217\nL 618 183\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 637 123\nL 637 174\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 637 208\nL 637 259\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 635 123\nL 639 123\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 635 259\nL 639 259\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 632 174\nL 642 174\nL 642 208\nL 632 208\nL 632 174\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 652 115\nL 652 166\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 652 200\nL 652 251\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 650 115\nL 654 115\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 650 251\nL 654 251\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 647 166\nL 657 166\nL 657 200\nL 647 200\nL 647 166\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 666 106\nL 666 157\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 666 191\nL 666 242\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 664 106\nL 668 106\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 664 242\nL 668 242\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 661 157\nL 671 157\nL 671 191\nL 661 191\nL 661 157\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 681 98\nL 681 149\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 681 183\nL 681 234\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 679 98\nL 683 98\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 679 234\nL 683 234\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 676 149\nL 686 149\nL 686 183\nL 676 183\nL 676 149\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 695 89\nL 695 140\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 695 174\nL 695 225\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 693 89\nL 697 89\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 693 225\nL 697 225\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 690 140\nL 700 140\nL 700 174\nL 690 174\nL 690 140\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 710 81\nL 710 132\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 710 166\nL 710 217\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 708 81\nL 712 81\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 708 217\nL 712 217\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 705 132\nL 715 132\nL 715 166\nL 705 166\nL 705 132\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 724 72\nL 724 123\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 724 157\nL 724 208\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 722 72\nL 726 72\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 722 208\nL 726 208\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 719 123\nL 729 123\nL 729 157\nL 719 157\nL 719 123\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 739 64\nL 739 115\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 739 149\nL 739 200\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 737 64\nL 741 64\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 737 200\nL 741 200\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 734 115\nL 744 115\nL 744 149\nL 734 149\nL 734 115\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 753 55\nL 753 106\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 753 140\nL 753 191\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 751 55\nL 755 55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 751 191\nL 755 191\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 748 106\nL 758 106\nL 758 140\nL 748 140\nL 748 106\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 768 47\nL 768 98\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 768 132\nL 768 183\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 766 47\nL 770 47\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 766 183\nL 770 183\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 763 98\nL 773 98\nL 773 132\nL 763 132\nL 763 98\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 782 38\nL 782 89\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 782 123\nL 782 174\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 780 38\nL 784 38\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 780 174\nL 784 174\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 777 89\nL 787 89\nL 787 123\nL 777 123\nL 777 89\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">131.13</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">127.33</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.54</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">119.75</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115.96</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.17</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.38</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.58</text><text x=\"9\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100.79</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 74.23 454.03\nL 74.23 505.02\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 74.23 539.01\nL 74.23 590\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 71.34 454.03\nL 77.12 454.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 71.34 590\nL 77.12 590\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 68.45 505.02\nL 80.01 505.02\nL 80.01 539.01\nL 68.45 539.01\nL 68.45 505.02\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88.69 445.53\nL 88.69 496.52\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 88.69 530.51\nL 88.69 581.5\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 85.8 445.53\nL 91.58 445.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 85.8 581.5\nL 91.58 581.5\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 82.91 496.52\nL 94.47 496.52\nL 94.47 530.51\nL 82.91 530.51\nL 82.91 496.52\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 103.15 437.03\nL 103.15 488.02\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 103.15 522.01\nL 103.15 573\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 100.26 437.03\nL 106.04 437.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 100.26 573\nL 106.04 573\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 97.37 488.02\nL 108.93 488.02\nL 108.93 522.01\nL 97.37 522.01\nL 97.37 488.02\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 117.61 428.53\nL 117.61 479.52\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 117.61 513.52\nL 117.61 564.51\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 114.72 428.53\nL 120.5 428.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 114.72 564.51\nL 120.5 564.51\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 111.83 479.52\nL 123.39 479.52\nL 123.39 513.52\nL 111.83 513.52\nL 111.83 479.52\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 132.07 420.04\nL 132.07 471.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 132.07 505.02\nL 132.07 556.01\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 129.18 420.04\nL 134.96 420.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 129.18 556.01\nL 134.96 556.01\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 126.29 471.03\nL 137.85 471.03\nL 137.85 505.02\nL 126.29 505.02\nL 126.29 471.03\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 146.53 411.54\nL 146.53 462.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 146.53 496.52\nL 146.53 547.51\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 143.64 411.54\nL 149.42 411.54\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 143.64 547.51\nL 149.42 547.51\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 140.75 462.53\nL 152.31 462.53\nL 152.31 496.52\nL 140.75 496.52\nL 140.75 462.53\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 160.99 403.04\nL 160.99 454.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 160.99 488.02\nL 160.99 539.01\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 158.1 403.04\nL 163.88 403.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 158.1 539.01\nL 163.88 539.01\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 155.21 454.03\nL 166.77 454.03\nL 166.77 488.02\nL 155.21 488.02\nL 155.21 454.03\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 175.45 394.54\nL 175.45 445.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 175.45 479.52\nL 175.45 530.51\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 172.56 394.54\nL 178.34 394.54\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 172.56 530.51\nL 178.34 530.51\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 169.67 445.53\nL 181.23 445.53\nL 181.23 479.52\nL 169.67 479.52\nL 169.67 445.53\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 189.91 386.04\nL 189.91 437.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 189.91 471.03\nL 189.91 522.01\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 187.02 386.04\nL 192.8 386.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 187.02 522.01\nL 192.8 522.01\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 184.13 437.03\nL 195.69 437.03\nL 195.69 471.03\nL 184.13 471.03\nL 184.13 437.03\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 204.37 377.55\nL 204.37 428.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 204.37 462.53\nL 204.37 513.52\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 201.48 377.55\nL 207.26 377.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 201.48 513.52\nL 207.26 513.52\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 198.59 428.53\nL 210.15 428.53\nL 210.15 462.53\nL 198.59 462.53\nL 198.59 428.53\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 218.83 369.05\nL 218.83 420.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 218.83 454.03\nL 218.83 505.02\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 215.94 369.05\nL 221.72 369.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 215.94 505.02\nL 221.72 505.02\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 213.05 420.04\nL 224.61 420.04\nL 224.61 454.03\nL 213.05 454.03\nL 213.05 420.04\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 233.29 360.55\nL 233.29 411.54\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 233.29 445.53\nL 233.29 496.52\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 230.4 360.55\nL 236.18 360.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 230.4 496.52\nL 236.18 496.52\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 227.51 411.54\nL 239.07 411.54\nL 239.07 445.53\nL 227.51 445.53\nL 227.51 411.54\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 247.75 352.05\nL 247.75 403.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 247.75 437.03\nL 247.75 488.02\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 244.86 352.05\nL 250.64 352.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 244.86 488.02\nL 250.64 488.02\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 241.97 403.04\nL 253.53 403.04\nL 253.53 437.03\nL 241.97 437.03\nL 241.97 403.04\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 262.21 343.55\nL 262.21 394.54\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 262.21 428.53\nL 262.21 479.52\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 259.32 343.55\nL 265.1 343.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 259.32 479.52\nL 265.1 479.52\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 256.43 394.54\nL 267.99 394.54\nL 267.99 428.53\nL 256.43 428.53\nL 256.43 394.54\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 276.67 335.05\nL 276.67 386.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 276.67 420.04\nL 276.67 471.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 273.78 335.05\nL 279.56 335.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 273.78 471.03\nL 279.56 471.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 270.89 386.04\nL 282.45 386.04\nL 282.45 420.04\nL 270.89 420.04\nL 270.89 386.04\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 291.13 326.56\nL 291.13 377.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 291.13 411.54\nL 291.13 462.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 288.24 326.56\nL 294.02 326.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 288.24 462.53\nL 294.02 462.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 285.35 377.55\nL 296.91 377.55\nL 296.91 411.54\nL 285.35 411.54\nL 285.35 377.55\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 305.59 318.06\nL 305.59 369.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 305.59 403.04\nL 305.59 454.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 302.7 318.06\nL 308.48 318.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 302.7 454.03\nL 308.48 454.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 299.81 369.05\nL 311.37 369.05\nL 311.37 403.04\nL 299.81 403.04\nL 299.81 369.05\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 320.05 309.56\nL 320.05 360.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 320.05 394.54\nL 320.05 445.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 317.16 309.56\nL 322.94 309.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 317.16 445.53\nL 322.94 445.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 314.27 360.55\nL 325.83 360.55\nL 325.83 394.54\nL 314.27 394.54\nL 314.27 360.55\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 334.51 301.06\nL 334.51 352.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 334.51 386.04\nL 334.51 437.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 331.62 301.06\nL 337.4 301.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 331.62 437.03\nL 337.4 437.03\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 328.73 352.05\nL 340.29 352.05\nL 340.29 386.04\nL 328.73 386.04\nL 328.73 352.05\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 348.97 292.56\nL 348.97 343.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 348.97 377.55\nL 348.97 428.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 346.08 292.56\nL 351.86 292.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 346.08 428.53\nL 351.86 428.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 343.19 343.55\nL 354.75 343.55\nL 354.75 377.55\nL 343.19 377.55\nL 343.19 343.55\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 363.43 284.07\nL 363.43 335.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 363.43 369.05\nL 363.43 420.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 360.54 284.07\nL 366.32 284.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 360.54 420.04\nL 366.32 420.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 357.65 335.05\nL 369.21 335.05\nL 369.21 369.05\nL 357.65 369.05\nL 357.65 335.05\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 377.89 275.57\nL 377.89 326.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 377.89 360.55\nL 377.89 411.54\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 375 275.57\nL 380.78 275.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 375 411.54\nL 380.78 411.54\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 372.11 326.56\nL 383.67 326.56\nL 383.67 360.55\nL 372.11 360.55\nL 372.11 326.56\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 392.35 267.07\nL 392.35 318.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 392.35 352.05\nL 392.35 403.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 389.46 267.07\nL 395.24 267.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 389.46 403.04\nL 395.24 403.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 386.57 318.06\nL 398.13 318.06\nL 398.13 352.05\nL 386.57 352.05\nL 386.57 318.06\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 406.81 258.57\nL 406.81 309.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 406.81 343.55\nL 406.81 394.54\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 403.92 258.57\nL 409.7 258.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 403.92 394.54\nL 409.7 394.54\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 401.03 309.56\nL 412.59 309.56\nL 412.59 343.55\nL 401.03 343.55\nL 401.03 309.56\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 421.27 250.07\nL 421.27 301.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 421.27 335.05\nL 421.27 386.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 418.38 250.07\nL 424.16 250.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 418.38 386.04\nL 424.16 386.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 415.49 301.06\nL 427.05 301.06\nL 427.05 335.05\nL 415.49 335.05\nL 415.49 301.06\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 435.73 241.58\nL 435.73 292.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 435.73 326.56\nL 435.73 377.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 432.84 241.58\nL 438.62 241.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 432.84 377.55\nL 438.62 377.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 429.95 292.56\nL 441.51 292.56\nL 441.51 326.56\nL 429.95 326.56\nL 429.95 292.56\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 450.19 233.08\nL 450.19 284.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 450.19 318.06\nL 450.19 369.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 447.3 233.08\nL 453.08 233.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 447.3 369.05\nL 453.08 369.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 444.41 284.07\nL 455.97 284.07\nL 455.97 318.06\nL 444.41 318.06\nL 444.41 284.07\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 464.65 224.58\nL 464.65 275.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 464.65 309.56\nL 464.65 360.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 461.76 224.58\nL 467.54 224.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 461.76 360.55\nL 467.54 360.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 458.87 275.57\nL 470.43 275.57\nL 470.43 309.56\nL 458.87 309.56\nL 458.87 275.57\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 479.11 216.08\nL 479.11 267.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 479.11 301.06\nL 479.11 352.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 476.22 216.08\nL 482 216.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 476.22 352.05\nL 482 352.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 473.33 267.07\nL 484.89 267.07\nL 484.89 301.06\nL 473.33 301.06\nL 473.33 267.07\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 493.57 207.58\nL 493.57 258.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 493.57 292.56\nL 493.57 343.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 490.68 207.58\nL 496.46 207.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 490.68 343.55\nL 496.46 343.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 487.79 258.57\nL 499.35 258.57\nL 499.35 292.56\nL 487.79 292.56\nL 487.79 258.57\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 508.03 199.08\nL 508.03 250.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 508.03 284.07\nL 508.03 335.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 505.14 199.08\nL 510.92 199.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 505.14 335.05\nL 510.92 335.05\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 502.25 250.07\nL 513.81 250.07\nL 513.81 284.07\nL 502.25 284.07\nL 502.25 250.07\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 522.49 190.59\nL 522.49 241.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 522.49 275.57\nL 522.49 326.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 519.6 190.59\nL 525.38 190.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 519.6 326.56\nL 525.38 326.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 516.71 241.58\nL 528.27 241.58\nL 528.27 275.57\nL 516.71 275.57\nL 516.71 241.58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 536.95 182.09\nL 536.95 233.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 536.95 267.07\nL 536.95 318.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 534.06 182.09\nL 539.84 182.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 534.06 318.06\nL 539.84 318.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 531.17 233.08\nL 542.73 233.08\nL 542.73 267.07\nL 531.17 267.07\nL 531.17 233.08\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 551.41 173.59\nL 551.41 224.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 551.41 258.57\nL 551.41 309.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 548.52 173.59\nL 554.3 173.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 548.52 309.56\nL 554.3 309.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 545.63 224.58\nL 557.19 224.58\nL 557.19 258.57\nL 545.63 258.57\nL 545.63 224.58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 565.87 165.09\nL 565.87 216.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 565.87 250.07\nL 565.87 301.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 562.98 165.09\nL 568.76 165.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 562.98 301.06\nL 568.76 301.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 560.09 216.08\nL 571.65 216.08\nL 571.65 250.07\nL 560.09 250.07\nL 560.09 216.08\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 580.33 156.59\nL 580.33 207.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 580.33 241.58\nL 580.33 292.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 577.44 156.59\nL 583.22 156.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 577.44 292.56\nL 583.22 292.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 574.55 207.58\nL 586.11 207.58\nL 586.11 241.58\nL 574.55 241.58\nL 574.55 207.58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 594.79 148.1\nL 594.79 199.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 594.79 233.08\nL 594.79 284.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 591.9 148.1\nL 597.68 148.1\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 591.9 284.07\nL 597.68 284.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 589.01 199.08\nL 600.57 199.08\nL 600.57 233.08\nL 589.01 233.08\nL 589.01 199.08\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 609.25 139.6\nL 609.25 190.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 609.25 224.58\nL 609.25 275.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 606.36 139.6\nL 612.14 139.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 606.36 275.57\nL 612.14 275.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 603.47 190.59\nL 615.03 190.59\nL 615.03 224.58\nL 603.47 224.58\nL 603.47 190.59\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 623.71 131.1\nL 623.71 182.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 623.71 216.08\nL 623.71 267.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 620.82 131.1\nL 626.6 131.1\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 620.82 267.07\nL 626.6 267.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 617.93 182.09\nL 629.49 182.09\nL 629.49 216.08\nL 617.93 216.08\nL 617.93 182.09\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 638.17 122.6\nL 638.17 173.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 638.17 207.58\nL 638.17 258.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 635.28 122.6\nL 641.06 122.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 635.28 258.57\nL 641.06 258.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 632.39 173.59\nL 643.95 173.59\nL 643.95 207.58\nL 632.39 207.58\nL 632.39 173.59\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 652.63 114.1\nL 652.63 165.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 652.63 199.08\nL 652.63 250.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 649.74 114.1\nL 655.52 114.1\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 649.74 250.07\nL 655.52 250.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 646.85 165.09\nL 658.41 165.09\nL 658.41 199.08\nL 646.85 199.08\nL 646.85 165.09\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 667.09 105.6\nL 667.09 156.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 667.09 190.59\nL 667.09 241.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 664.2 105.6\nL 669.98 105.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 664.2 241.58\nL 669.98 241.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 661.31 156.59\nL 672.87 156.59\nL 672.87 190.59\nL 661.31 190.59\nL 661.31 156.59\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 681.55 97.11\nL 681.55 148.1\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 681.55 182.09\nL 681.55 233.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 678.66 97.11\nL 684.44 97.11\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 678.66 233.08\nL 684.44 233.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 675.77 148.1\nL 687.33 148.1\nL 687.33 182.09\nL 675.77 182.09\nL 675.77 148.1\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 696.01 88.61\nL 696.01 139.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 696.01 173.59\nL 696.01 224.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 693.12 88.61\nL 698.9 88.61\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 693.12 224.58\nL 698.9 224.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 690.23 139.6\nL 701.79 139.6\nL 701.79 173.59\nL 690.23 173.59\nL 690.23 139.6\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 710.47 80.11\nL 710.47 131.1\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 710.47 165.09\nL 710.47 216.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 707.58 80.11\nL 713.36 80.11\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 707.58 216.08\nL 713.36 216.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 704.69 131.1\nL 716.25 131.1\nL 716.25 165.09\nL 704.69 165.09\nL 704.69 131.1\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 724.93 71.61\nL 724.93 122.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 724.93 156.59\nL 724.93 207.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 722.04 71.61\nL 727.82 71.61\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 722.04 207.58\nL 727.82 207.58\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 719.15 122.6\nL 730.71 122.6\nL 730.71 156.59\nL 719.15 156.59\nL 719.15 122.6\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 739.39 63.11\nL 739.39 114.1\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 739.39 148.1\nL 739.39 199.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 736.5 63.11\nL 742.28 63.11\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 736.5 199.08\nL 742.28 199.08\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 733.61 114.1\nL 745.17 114.1\nL 745.17 148.1\nL 733.61 148.1\nL 733.61 114.1\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 753.85 54.62\nL 753.85 105.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 753.85 139.6\nL 753.85 190.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 750.96 54.62\nL 756.74 54.62\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 750.96 190.59\nL 756.74 190.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 748.07 105.6\nL 759.63 105.6\nL 759.63 139.6\nL 748.07 139.6\nL 748.07 105.6\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 768.31 46.12\nL 768.31 97.11\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 768.31 131.1\nL 768.31 182.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 765.42 46.12\nL 771.2 46.12\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 765.42 182.09\nL 771.2 182.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 762.53 97.11\nL 774.09 97.11\nL 774.09 131.1\nL 762.53 131.1\nL 762.53 97.11\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 782.77 37.62\nL 782.77 88.61\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 782.77 122.6\nL 782.77 173.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 779.88 37.62\nL 785.66 37.62\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 779.88 173.59\nL 785.66 173.59\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 776.99 88.61\nL 788.55 88.61\nL 788.55 122.6\nL 776.99 122.6\nL 776.99 88.61\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x1d24c9ce,
 		},
 		{
@@ -320,7 +320,7 @@ func TestCandlestickChart(t *testing.T) {
 					Padding: NewBoxEqual(10),
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 253 26\nL 268 26\nL 260 13\nL 253 26\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 268 13\nL 283 13\nL 275 26\nL 268 13\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"285\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Stock A</text><path d=\"M 359 26\nL 374 26\nL 366 13\nL 359 26\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 374 13\nL 389 13\nL 381 26\nL 374 13\" style=\"stroke:none;fill:rgb(252,132,82)\"/><text x=\"391\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Stock B</text><path d=\"M 464 26\nL 479 26\nL 471 13\nL 464 26\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 479 13\nL 494 13\nL 486 26\nL 479 13\" style=\"stroke:none;fill:rgb(234,124,204)\"/><text x=\"496\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Stock C</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">148</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">136</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">124</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"18\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">88</text><text x=\"18\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">76</text><text x=\"18\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">64</text><text x=\"18\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">52</text><text x=\"18\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><path d=\"M 42 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 569\nL 46 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 194 569\nL 194 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 343 569\nL 343 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 492 569\nL 492 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 641 569\nL 641 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"101\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 1</text><text x=\"249\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 2</text><text x=\"398\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 3</text><text x=\"547\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 4</text><text x=\"696\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 5</text><path d=\"M 75 229\nL 75 253\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 75 277\nL 75 301\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 66 229\nL 84 229\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 66 301\nL 84 301\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 56 253\nL 94 253\nL 94 277\nL 56 277\nL 56 253\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 223 205\nL 223 219\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 223 253\nL 223 277\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 214 205\nL 232 205\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 214 277\nL 232 277\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 204 219\nL 242 219\nL 242 253\nL 204 253\nL 204 219\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 372 190\nL 372 205\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 372 219\nL 372 238\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 363 190\nL 381 190\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 363 238\nL 381 238\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 353 205\nL 391 205\nL 391 219\nL 353 219\nL 353 205\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 670 214\nL 670 234\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 670 238\nL 670 253\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 661 214\nL 679 214\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 661 253\nL 679 253\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 651 234\nL 689 234\nL 689 238\nL 651 238\nL 651 234\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 119 133\nL 119 157\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 119 181\nL 119 205\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 110 133\nL 128 133\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 110 205\nL 128 205\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 100 157\nL 138 157\nL 138 181\nL 100 181\nL 100 157\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 267 109\nL 267 123\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 267 157\nL 267 181\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 258 109\nL 276 109\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 258 181\nL 276 181\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 248 123\nL 286 123\nL 286 157\nL 248 157\nL 248 123\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 416 94\nL 416 109\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 416 123\nL 416 142\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 407 94\nL 425 94\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 407 142\nL 425 142\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 397 109\nL 435 109\nL 435 123\nL 397 123\nL 397 109\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 714 118\nL 714 138\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 714 142\nL 714 157\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 705 118\nL 723 118\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 705 157\nL 723 157\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 695 138\nL 733 138\nL 733 142\nL 695 142\nL 695 138\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 163 229\nL 163 349\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 163 373\nL 163 541\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 154 229\nL 172 229\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 154 541\nL 172 541\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 144 349\nL 182 349\nL 182 373\nL 144 373\nL 144 349\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 311 205\nL 311 349\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 311 363\nL 311 564\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 302 205\nL 320 205\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 302 564\nL 320 564\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 292 349\nL 330 349\nL 330 363\nL 292 363\nL 292 349\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 460 190\nL 460 349\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 460 363\nL 460 526\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 451 190\nL 469 190\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 451 526\nL 469 526\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 441 349\nL 479 349\nL 479 363\nL 441 363\nL 441 349\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 609 181\nL 609 334\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 609 349\nL 609 564\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 600 181\nL 618 181\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 600 564\nL 618 564\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 590 334\nL 628 334\nL 628 349\nL 590 349\nL 590 334\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 758 214\nL 758 329\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 758 334\nL 758 541\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 749 214\nL 767 214\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 749 541\nL 767 541\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 739 329\nL 777 329\nL 777 334\nL 739 334\nL 739 329\" style=\"stroke:none;fill:rgb(84,112,198)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 253 26\nL 268 26\nL 260 13\nL 253 26\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 268 13\nL 283 13\nL 275 26\nL 268 13\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"285\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Stock A</text><path d=\"M 359 26\nL 374 26\nL 366 13\nL 359 26\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 374 13\nL 389 13\nL 381 26\nL 374 13\" style=\"stroke:none;fill:rgb(252,132,82)\"/><text x=\"391\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Stock B</text><path d=\"M 464 26\nL 479 26\nL 471 13\nL 464 26\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 479 13\nL 494 13\nL 486 26\nL 479 13\" style=\"stroke:none;fill:rgb(234,124,204)\"/><text x=\"496\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Stock C</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">148</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">136</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">124</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"18\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">88</text><text x=\"18\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">76</text><text x=\"18\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">64</text><text x=\"18\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">52</text><text x=\"18\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><path d=\"M 42 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 569\nL 46 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 194 569\nL 194 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 343 569\nL 343 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 492 569\nL 492 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 641 569\nL 641 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"101\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 1</text><text x=\"249\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 2</text><text x=\"398\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 3</text><text x=\"547\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 4</text><text x=\"696\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 5</text><path d=\"M 75.8 228.26\nL 75.8 252.24\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 75.8 276.22\nL 75.8 300.2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 65.88 228.26\nL 85.72 228.26\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 65.88 300.2\nL 85.72 300.2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 56 252.24\nL 95.6 252.24\nL 95.6 276.22\nL 56 276.22\nL 56 252.24\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 224.6 204.28\nL 224.6 218.67\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 224.6 252.24\nL 224.6 276.22\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 214.68 204.28\nL 234.52 204.28\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 214.68 276.22\nL 234.52 276.22\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 204.8 218.67\nL 244.4 218.67\nL 244.4 252.24\nL 204.8 252.24\nL 204.8 218.67\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 373.4 189.89\nL 373.4 204.28\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 373.4 218.67\nL 373.4 237.85\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 363.48 189.89\nL 383.32 189.89\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 363.48 237.85\nL 383.32 237.85\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 353.6 204.28\nL 393.2 204.28\nL 393.2 218.67\nL 353.6 218.67\nL 353.6 204.28\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 671 213.87\nL 671 233.06\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 671 237.85\nL 671 252.24\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 661.08 213.87\nL 680.92 213.87\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 661.08 252.24\nL 680.92 252.24\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 651.2 233.06\nL 690.8 233.06\nL 690.8 237.85\nL 651.2 237.85\nL 651.2 233.06\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 120.4 132.33\nL 120.4 156.31\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 120.4 180.3\nL 120.4 204.28\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 110.48 132.33\nL 130.32 132.33\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 110.48 204.28\nL 130.32 204.28\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 100.6 156.31\nL 140.2 156.31\nL 140.2 180.3\nL 100.6 180.3\nL 100.6 156.31\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 269.2 108.35\nL 269.2 122.74\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 269.2 156.31\nL 269.2 180.3\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 259.28 108.35\nL 279.12 108.35\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 259.28 180.3\nL 279.12 180.3\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 249.4 122.74\nL 289 122.74\nL 289 156.31\nL 249.4 156.31\nL 249.4 122.74\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 418 93.96\nL 418 108.35\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 418 122.74\nL 418 141.93\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 408.08 93.96\nL 427.92 93.96\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 408.08 141.93\nL 427.92 141.93\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 398.2 108.35\nL 437.8 108.35\nL 437.8 122.74\nL 398.2 122.74\nL 398.2 108.35\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 715.6 117.94\nL 715.6 137.13\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 715.6 141.93\nL 715.6 156.31\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 705.68 117.94\nL 725.52 117.94\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 705.68 156.31\nL 725.52 156.31\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 695.8 137.13\nL 735.4 137.13\nL 735.4 141.93\nL 695.8 141.93\nL 695.8 137.13\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 165 228.26\nL 165 348.17\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 165 372.15\nL 165 540.02\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 155.08 228.26\nL 174.92 228.26\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 155.08 540.02\nL 174.92 540.02\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 145.2 348.17\nL 184.8 348.17\nL 184.8 372.15\nL 145.2 372.15\nL 145.2 348.17\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 313.8 204.28\nL 313.8 348.17\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 313.8 362.56\nL 313.8 564\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 303.88 204.28\nL 323.72 204.28\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 303.88 564\nL 323.72 564\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 294 348.17\nL 333.6 348.17\nL 333.6 362.56\nL 294 362.56\nL 294 348.17\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 462.6 189.89\nL 462.6 348.17\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 462.6 362.56\nL 462.6 525.63\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 452.68 189.89\nL 472.52 189.89\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 452.68 525.63\nL 472.52 525.63\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 442.8 348.17\nL 482.4 348.17\nL 482.4 362.56\nL 442.8 362.56\nL 442.8 348.17\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 611.4 180.3\nL 611.4 333.78\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 611.4 348.17\nL 611.4 564\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 601.48 180.3\nL 621.32 180.3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 601.48 564\nL 621.32 564\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 591.6 333.78\nL 631.2 333.78\nL 631.2 348.17\nL 591.6 348.17\nL 591.6 333.78\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 760.2 213.87\nL 760.2 328.98\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 760.2 333.78\nL 760.2 540.02\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 750.28 213.87\nL 770.12 213.87\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 750.28 540.02\nL 770.12 540.02\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 740.4 328.98\nL 780 328.98\nL 780 333.78\nL 740.4 333.78\nL 740.4 328.98\" style=\"stroke:none;fill:rgb(84,112,198)\"/></svg>",
 			pngCRC: 0x7ed89e2b,
 		},
 		{
@@ -356,7 +356,7 @@ func TestCandlestickChart(t *testing.T) {
 					Padding: NewBoxEqual(10),
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">143</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">137.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">131.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">119.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.67</text><text x=\"9\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 139 569\nL 139 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 283 569\nL 283 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 573 569\nL 573 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 717 569\nL 717 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"99\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"171\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"243\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"315\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"388\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"460\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"532\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"605\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"677\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"744\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><path d=\"M 103 355\nL 103 408\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 103 460\nL 103 512\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 89 355\nL 117 355\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 89 512\nL 117 512\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 75 408\nL 131 408\nL 131 460\nL 75 460\nL 75 408\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 175 303\nL 175 335\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 175 408\nL 175 460\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 161 303\nL 189 303\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 161 460\nL 189 460\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 147 335\nL 203 335\nL 203 408\nL 147 408\nL 147 335\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 247 272\nL 247 303\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 247 335\nL 247 376\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 233 272\nL 261 272\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 233 376\nL 261 376\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 219 303\nL 275 303\nL 275 335\nL 219 335\nL 219 303\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 319 251\nL 319 272\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 319 303\nL 319 355\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 305 251\nL 333 251\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 305 355\nL 333 355\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 291 272\nL 347 272\nL 347 303\nL 291 303\nL 291 272\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 392 199\nL 392 230\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 392 272\nL 392 303\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 378 199\nL 406 199\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 378 303\nL 406 303\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 364 230\nL 420 230\nL 420 272\nL 364 272\nL 364 230\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 464 167\nL 464 199\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 464 230\nL 464 261\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 450 167\nL 478 167\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 450 261\nL 478 261\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 436 199\nL 492 199\nL 492 230\nL 436 230\nL 436 199\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 536 146\nL 536 178\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 536 199\nL 536 230\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 522 146\nL 550 146\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 522 230\nL 550 230\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 508 178\nL 564 178\nL 564 199\nL 508 199\nL 508 178\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 609 125\nL 609 157\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 609 178\nL 609 209\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 595 125\nL 623 125\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 595 209\nL 623 209\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 581 157\nL 637 157\nL 637 178\nL 581 178\nL 581 157\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 681 94\nL 681 136\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 681 157\nL 681 188\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 667 94\nL 695 94\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 667 188\nL 695 188\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 653 136\nL 709 136\nL 709 157\nL 653 157\nL 653 136\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 753 63\nL 753 94\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 753 136\nL 753 167\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 739 63\nL 767 63\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 739 167\nL 767 167\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 725 94\nL 781 94\nL 781 136\nL 725 136\nL 725 94\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 103 261\nL 175 229\nL 247 189\nL 319 170\nL 392 144\nL 464 126\nL 536 115\nL 609 81\nL 681 79\nL 753 77\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/><path d=\"M 103 348\nL 175 329\nL 247 309\nL 319 268\nL 392 236\nL 464 207\nL 536 180\nL 609 153\nL 681 141\nL 753 129\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/><path d=\"M 103 436\nL 175 430\nL 247 430\nL 319 365\nL 392 328\nL 464 288\nL 536 245\nL 609 225\nL 681 203\nL 753 181\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">143</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">137.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">131.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">119.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.67</text><text x=\"9\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 139 569\nL 139 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 283 569\nL 283 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 573 569\nL 573 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 717 569\nL 717 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"99\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"171\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"243\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"315\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"388\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"460\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"532\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"605\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"677\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"744\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><path d=\"M 103.15 354.94\nL 103.15 407.21\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 103.15 459.47\nL 103.15 511.74\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 88.69 354.94\nL 117.61 354.94\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 88.69 511.74\nL 117.61 511.74\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 74.23 407.21\nL 132.07 407.21\nL 132.07 459.47\nL 74.23 459.47\nL 74.23 407.21\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 175.45 302.68\nL 175.45 334.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 175.45 407.21\nL 175.45 459.47\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 160.99 302.68\nL 189.91 302.68\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 160.99 459.47\nL 189.91 459.47\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 146.53 334.04\nL 204.37 334.04\nL 204.37 407.21\nL 146.53 407.21\nL 146.53 334.04\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 247.75 271.32\nL 247.75 302.68\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 247.75 334.04\nL 247.75 375.85\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 233.29 271.32\nL 262.21 271.32\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 233.29 375.85\nL 262.21 375.85\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 218.83 302.68\nL 276.67 302.68\nL 276.67 334.04\nL 218.83 334.04\nL 218.83 302.68\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 320.05 250.42\nL 320.05 271.32\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 320.05 302.68\nL 320.05 354.94\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 305.59 250.42\nL 334.51 250.42\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 305.59 354.94\nL 334.51 354.94\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 291.13 271.32\nL 348.97 271.32\nL 348.97 302.68\nL 291.13 302.68\nL 291.13 271.32\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 392.35 198.15\nL 392.35 229.51\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 392.35 271.32\nL 392.35 302.68\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 377.89 198.15\nL 406.81 198.15\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 377.89 302.68\nL 406.81 302.68\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 363.43 229.51\nL 421.27 229.51\nL 421.27 271.32\nL 363.43 271.32\nL 363.43 229.51\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 464.65 166.79\nL 464.65 198.15\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 464.65 229.51\nL 464.65 260.87\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 450.19 166.79\nL 479.11 166.79\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 450.19 260.87\nL 479.11 260.87\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 435.73 198.15\nL 493.57 198.15\nL 493.57 229.51\nL 435.73 229.51\nL 435.73 198.15\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 536.95 145.89\nL 536.95 177.25\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 536.95 198.15\nL 536.95 229.51\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 522.49 145.89\nL 551.41 145.89\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 522.49 229.51\nL 551.41 229.51\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 508.03 177.25\nL 565.87 177.25\nL 565.87 198.15\nL 508.03 198.15\nL 508.03 177.25\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 609.25 124.98\nL 609.25 156.34\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 609.25 177.25\nL 609.25 208.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 594.79 124.98\nL 623.71 124.98\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 594.79 208.6\nL 623.71 208.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 580.33 156.34\nL 638.17 156.34\nL 638.17 177.25\nL 580.33 177.25\nL 580.33 156.34\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 681.55 93.62\nL 681.55 135.43\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 681.55 156.34\nL 681.55 187.7\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 667.09 93.62\nL 696.01 93.62\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 667.09 187.7\nL 696.01 187.7\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 652.63 135.43\nL 710.47 135.43\nL 710.47 156.34\nL 652.63 156.34\nL 652.63 135.43\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 753.85 62.26\nL 753.85 93.62\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 753.85 135.43\nL 753.85 166.79\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 739.39 62.26\nL 768.31 62.26\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 739.39 166.79\nL 768.31 166.79\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 724.93 93.62\nL 782.77 93.62\nL 782.77 135.43\nL 724.93 135.43\nL 724.93 93.62\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 103 261\nL 175 229\nL 248 189\nL 320 170\nL 392 144\nL 465 126\nL 537 115\nL 609 81\nL 682 79\nL 754 77\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/><path d=\"M 103 348\nL 175 329\nL 248 309\nL 320 268\nL 392 236\nL 465 207\nL 537 180\nL 609 153\nL 682 141\nL 754 129\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/><path d=\"M 103 436\nL 175 430\nL 248 430\nL 320 365\nL 392 328\nL 465 288\nL 537 245\nL 609 225\nL 682 203\nL 754 181\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/></svg>",
 			pngCRC: 0x3c24bc3f,
 		},
 		{
@@ -383,7 +383,7 @@ func TestCandlestickChart(t *testing.T) {
 					SeriesList: CandlestickSeriesList{aggregated},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 355 26\nL 370 26\nL 362 13\nL 355 26\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 370 13\nL 385 13\nL 377 26\nL 370 13\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"387\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1-Period</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"158\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Period 1</text><text x=\"399\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Period 2</text><text x=\"640\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Period 3</text><path d=\"M 187 263\nL 187 299\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 187 444\nL 187 504\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 139 263\nL 235 263\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 139 504\nL 235 504\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 91 299\nL 283 299\nL 283 444\nL 91 444\nL 91 299\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 428 203\nL 428 227\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 428 299\nL 428 348\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 380 203\nL 476 203\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 380 348\nL 476 348\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 332 227\nL 524 227\nL 524 299\nL 332 299\nL 332 227\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 669 107\nL 669 143\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 669 227\nL 669 263\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 621 107\nL 717 107\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 621 263\nL 717 263\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 573 143\nL 765 143\nL 765 227\nL 573 227\nL 573 143\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 355 26\nL 370 26\nL 362 13\nL 355 26\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 370 13\nL 385 13\nL 377 26\nL 370 13\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"387\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1-Period</text><text x=\"30\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"158\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Period 1</text><text x=\"399\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Period 2</text><text x=\"640\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Period 3</text><path d=\"M 187.5 262.84\nL 187.5 298.98\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 187.5 443.53\nL 187.5 503.77\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 139.3 262.84\nL 235.7 262.84\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 139.3 503.77\nL 235.7 503.77\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 91.1 298.98\nL 283.9 298.98\nL 283.9 443.53\nL 91.1 443.53\nL 91.1 298.98\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 428.5 202.6\nL 428.5 226.7\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 428.5 298.98\nL 428.5 347.16\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 380.3 202.6\nL 476.7 202.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 380.3 347.16\nL 476.7 347.16\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 332.1 226.7\nL 524.9 226.7\nL 524.9 298.98\nL 332.1 298.98\nL 332.1 226.7\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 669.5 106.23\nL 669.5 142.37\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 669.5 226.7\nL 669.5 262.84\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 621.3 106.23\nL 717.7 106.23\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 621.3 262.84\nL 717.7 262.84\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 573.1 142.37\nL 765.9 142.37\nL 765.9 226.7\nL 573.1 226.7\nL 573.1 142.37\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x2f6ce7f8,
 		},
 		{
@@ -433,7 +433,7 @@ func TestCandlestickChart(t *testing.T) {
 					Padding: NewBoxEqual(10),
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 10 26\nL 25 26\nL 17 13\nL 10 26\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 25 13\nL 40 13\nL 32 26\nL 25 13\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"42\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 1</text><path d=\"M 118 26\nL 133 26\nL 125 13\nL 118 26\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 133 13\nL 148 13\nL 140 26\nL 133 13\" style=\"stroke:none;fill:rgb(252,132,82)\"/><text x=\"150\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 2</text><path d=\"M 226 26\nL 241 26\nL 233 13\nL 226 26\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 241 13\nL 256 13\nL 248 26\nL 241 13\" style=\"stroke:none;fill:rgb(234,124,204)\"/><text x=\"258\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 3</text><path d=\"M 334 26\nL 349 26\nL 341 13\nL 334 26\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 349 13\nL 364 13\nL 356 26\nL 349 13\" style=\"stroke:none;fill:rgb(235,145,145)\"/><text x=\"366\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 4</text><path d=\"M 442 26\nL 457 26\nL 449 13\nL 442 26\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 457 13\nL 472 13\nL 464 26\nL 457 13\" style=\"stroke:none;fill:rgb(245,163,128)\"/><text x=\"474\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 5</text><path d=\"M 550 26\nL 565 26\nL 557 13\nL 550 26\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 565 13\nL 580 13\nL 572 26\nL 565 13\" style=\"stroke:none;fill:rgb(234,164,215)\"/><text x=\"582\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 6</text><path d=\"M 658 26\nL 673 26\nL 665 13\nL 658 26\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 673 13\nL 688 13\nL 680 26\nL 673 13\" style=\"stroke:none;fill:rgb(237,184,184)\"/><text x=\"690\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 7</text><path d=\"M 10 42\nL 25 42\nL 17 29\nL 10 42\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 25 29\nL 40 29\nL 32 42\nL 25 29\" style=\"stroke:none;fill:rgb(243,192,171)\"/><text x=\"42\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 8</text><path d=\"M 118 42\nL 133 42\nL 125 29\nL 118 42\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 133 29\nL 148 29\nL 140 42\nL 133 29\" style=\"stroke:none;fill:rgb(238,201,228)\"/><text x=\"150\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 9</text><path d=\"M 226 42\nL 241 42\nL 233 29\nL 226 42\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 241 29\nL 256 29\nL 248 42\nL 241 29\" style=\"stroke:none;fill:rgb(243,219,219)\"/><text x=\"258\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 10</text><text x=\"9\" y=\"68\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">315</text><text x=\"9\" y=\"123\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">290</text><text x=\"9\" y=\"179\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">265</text><text x=\"9\" y=\"234\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"9\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">215</text><text x=\"9\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">165</text><text x=\"9\" y=\"456\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">140</text><text x=\"9\" y=\"512\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"18\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 62\nL 790 62\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 117\nL 790 117\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 173\nL 790 173\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 229\nL 790 229\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 285\nL 790 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 340\nL 790 340\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 452\nL 790 452\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 508\nL 790 508\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 569\nL 46 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 194 569\nL 194 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 343 569\nL 343 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 492 569\nL 492 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 641 569\nL 641 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"111\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T1</text><text x=\"259\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T2</text><text x=\"408\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T3</text><text x=\"557\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T4</text><text x=\"706\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T5</text><path d=\"M 60 520\nL 60 542\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 58 520\nL 62 520\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 58 553\nL 62 553\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 56 542\nL 64 542\nL 64 553\nL 56 553\nL 56 542\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 208 509\nL 208 520\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 208 531\nL 208 542\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 206 509\nL 210 509\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 206 542\nL 210 542\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 204 520\nL 212 520\nL 212 531\nL 204 531\nL 204 520\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 357 498\nL 357 520\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 355 498\nL 359 498\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 355 531\nL 359 531\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 353 520\nL 361 520\nL 361 531\nL 353 531\nL 353 520\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 506 486\nL 506 498\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 506 509\nL 506 520\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 504 486\nL 508 486\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 504 520\nL 508 520\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 502 498\nL 510 498\nL 510 509\nL 502 509\nL 502 498\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 655 475\nL 655 498\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 653 475\nL 657 475\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 653 509\nL 657 509\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 651 498\nL 659 498\nL 659 509\nL 651 509\nL 651 498\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 73 475\nL 73 498\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 71 475\nL 75 475\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 71 509\nL 75 509\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 69 498\nL 77 498\nL 77 509\nL 69 509\nL 69 498\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 221 464\nL 221 475\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 221 486\nL 221 498\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 219 464\nL 223 464\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 219 498\nL 223 498\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 217 475\nL 225 475\nL 225 486\nL 217 486\nL 217 475\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 370 453\nL 370 475\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 368 453\nL 372 453\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 368 486\nL 372 486\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 366 475\nL 374 475\nL 374 486\nL 366 486\nL 366 475\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 519 442\nL 519 453\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 519 464\nL 519 475\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 517 442\nL 521 442\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 517 475\nL 521 475\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 515 453\nL 523 453\nL 523 464\nL 515 464\nL 515 453\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 668 431\nL 668 453\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 666 431\nL 670 431\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 666 464\nL 670 464\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 664 453\nL 672 453\nL 672 464\nL 664 464\nL 664 453\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 86 431\nL 86 453\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 84 431\nL 88 431\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 84 464\nL 88 464\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 82 453\nL 90 453\nL 90 464\nL 82 464\nL 82 453\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 234 419\nL 234 431\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 234 442\nL 234 453\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 232 419\nL 236 419\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 232 453\nL 236 453\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 230 431\nL 238 431\nL 238 442\nL 230 442\nL 230 431\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 383 408\nL 383 431\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 381 408\nL 385 408\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 381 442\nL 385 442\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 379 431\nL 387 431\nL 387 442\nL 379 442\nL 379 431\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 532 397\nL 532 408\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 532 419\nL 532 431\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 530 397\nL 534 397\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 530 431\nL 534 431\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 528 408\nL 536 408\nL 536 419\nL 528 419\nL 528 408\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 681 386\nL 681 408\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 679 386\nL 683 386\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 679 419\nL 683 419\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 677 408\nL 685 408\nL 685 419\nL 677 419\nL 677 408\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 99 386\nL 99 408\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 97 386\nL 101 386\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 97 419\nL 101 419\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 95 408\nL 103 408\nL 103 419\nL 95 419\nL 95 408\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 247 375\nL 247 386\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 247 397\nL 247 408\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 245 375\nL 249 375\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 245 408\nL 249 408\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 243 386\nL 251 386\nL 251 397\nL 243 397\nL 243 386\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 396 364\nL 396 386\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 394 364\nL 398 364\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 394 397\nL 398 397\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 392 386\nL 400 386\nL 400 397\nL 392 397\nL 392 386\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 545 353\nL 545 364\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 545 375\nL 545 386\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 543 353\nL 547 353\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 543 386\nL 547 386\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 541 364\nL 549 364\nL 549 375\nL 541 375\nL 541 364\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 694 341\nL 694 364\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 692 341\nL 696 341\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 692 375\nL 696 375\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 690 364\nL 698 364\nL 698 375\nL 690 375\nL 690 364\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 112 341\nL 112 364\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 110 341\nL 114 341\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 110 375\nL 114 375\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 108 364\nL 116 364\nL 116 375\nL 108 375\nL 108 364\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 260 330\nL 260 341\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 260 353\nL 260 364\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 258 330\nL 262 330\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 258 364\nL 262 364\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 256 341\nL 264 341\nL 264 353\nL 256 353\nL 256 341\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 409 319\nL 409 341\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 407 319\nL 411 319\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 407 353\nL 411 353\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 405 341\nL 413 341\nL 413 353\nL 405 353\nL 405 341\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 558 308\nL 558 319\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 558 330\nL 558 341\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 556 308\nL 560 308\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 556 341\nL 560 341\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 554 319\nL 562 319\nL 562 330\nL 554 330\nL 554 319\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 707 297\nL 707 319\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 705 297\nL 709 297\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 705 330\nL 709 330\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 703 319\nL 711 319\nL 711 330\nL 703 330\nL 703 319\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 125 297\nL 125 319\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 123 297\nL 127 297\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 123 330\nL 127 330\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 121 319\nL 129 319\nL 129 330\nL 121 330\nL 121 319\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 273 286\nL 273 297\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 273 308\nL 273 319\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 271 286\nL 275 286\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 271 319\nL 275 319\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 269 297\nL 277 297\nL 277 308\nL 269 308\nL 269 297\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 422 274\nL 422 297\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 420 274\nL 424 274\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 420 308\nL 424 308\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 418 297\nL 426 297\nL 426 308\nL 418 308\nL 418 297\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 571 263\nL 571 274\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 571 286\nL 571 297\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 569 263\nL 573 263\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 569 297\nL 573 297\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 567 274\nL 575 274\nL 575 286\nL 567 286\nL 567 274\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 720 252\nL 720 274\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 718 252\nL 722 252\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 718 286\nL 722 286\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 716 274\nL 724 274\nL 724 286\nL 716 286\nL 716 274\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 138 252\nL 138 274\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 136 252\nL 140 252\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 136 286\nL 140 286\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 134 274\nL 142 274\nL 142 286\nL 134 286\nL 134 274\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 286 241\nL 286 252\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 286 263\nL 286 274\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 284 241\nL 288 241\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 284 274\nL 288 274\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 282 252\nL 290 252\nL 290 263\nL 282 263\nL 282 252\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 435 230\nL 435 252\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 433 230\nL 437 230\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 433 263\nL 437 263\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 431 252\nL 439 252\nL 439 263\nL 431 263\nL 431 252\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 584 219\nL 584 230\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 584 241\nL 584 252\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 582 219\nL 586 219\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 582 252\nL 586 252\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 580 230\nL 588 230\nL 588 241\nL 580 241\nL 580 230\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 733 208\nL 733 230\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 731 208\nL 735 208\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 731 241\nL 735 241\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 729 230\nL 737 230\nL 737 241\nL 729 241\nL 729 230\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 151 208\nL 151 230\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 149 208\nL 153 208\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 149 241\nL 153 241\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 147 230\nL 155 230\nL 155 241\nL 147 241\nL 147 230\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 299 196\nL 299 208\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 299 219\nL 299 230\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 297 196\nL 301 196\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 297 230\nL 301 230\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 295 208\nL 303 208\nL 303 219\nL 295 219\nL 295 208\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 448 185\nL 448 208\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 446 185\nL 450 185\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 446 219\nL 450 219\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 444 208\nL 452 208\nL 452 219\nL 444 219\nL 444 208\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 597 174\nL 597 185\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 597 196\nL 597 208\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 595 174\nL 599 174\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 595 208\nL 599 208\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 593 185\nL 601 185\nL 601 196\nL 593 196\nL 593 185\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 746 163\nL 746 185\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 744 163\nL 748 163\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 744 196\nL 748 196\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 742 185\nL 750 185\nL 750 196\nL 742 196\nL 742 185\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 164 163\nL 164 185\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 162 163\nL 166 163\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 162 196\nL 166 196\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 160 185\nL 168 185\nL 168 196\nL 160 196\nL 160 185\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 312 152\nL 312 163\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 312 174\nL 312 185\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 310 152\nL 314 152\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 310 185\nL 314 185\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 308 163\nL 316 163\nL 316 174\nL 308 174\nL 308 163\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 461 141\nL 461 163\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 459 141\nL 463 141\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 459 174\nL 463 174\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 457 163\nL 465 163\nL 465 174\nL 457 174\nL 457 163\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 610 129\nL 610 141\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 610 152\nL 610 163\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 608 129\nL 612 129\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 608 163\nL 612 163\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 606 141\nL 614 141\nL 614 152\nL 606 152\nL 606 141\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 759 118\nL 759 141\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 757 118\nL 761 118\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 757 152\nL 761 152\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 755 141\nL 763 141\nL 763 152\nL 755 152\nL 755 141\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 177 118\nL 177 141\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 175 118\nL 179 118\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 175 152\nL 179 152\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 173 141\nL 181 141\nL 181 152\nL 173 152\nL 173 141\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 325 107\nL 325 118\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 325 129\nL 325 141\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 323 107\nL 327 107\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 323 141\nL 327 141\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 321 118\nL 329 118\nL 329 129\nL 321 129\nL 321 118\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 474 96\nL 474 118\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 472 96\nL 476 96\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 472 129\nL 476 129\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 470 118\nL 478 118\nL 478 129\nL 470 129\nL 470 118\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 623 85\nL 623 96\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 623 107\nL 623 118\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 621 85\nL 625 85\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 621 118\nL 625 118\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 619 96\nL 627 96\nL 627 107\nL 619 107\nL 619 96\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 772 74\nL 772 96\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 770 74\nL 774 74\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 770 107\nL 774 107\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 768 96\nL 776 96\nL 776 107\nL 768 107\nL 768 96\" style=\"stroke:none;fill:rgb(243,219,219)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 10 26\nL 25 26\nL 17 13\nL 10 26\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 25 13\nL 40 13\nL 32 26\nL 25 13\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"42\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 1</text><path d=\"M 118 26\nL 133 26\nL 125 13\nL 118 26\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 133 13\nL 148 13\nL 140 26\nL 133 13\" style=\"stroke:none;fill:rgb(252,132,82)\"/><text x=\"150\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 2</text><path d=\"M 226 26\nL 241 26\nL 233 13\nL 226 26\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 241 13\nL 256 13\nL 248 26\nL 241 13\" style=\"stroke:none;fill:rgb(234,124,204)\"/><text x=\"258\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 3</text><path d=\"M 334 26\nL 349 26\nL 341 13\nL 334 26\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 349 13\nL 364 13\nL 356 26\nL 349 13\" style=\"stroke:none;fill:rgb(235,145,145)\"/><text x=\"366\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 4</text><path d=\"M 442 26\nL 457 26\nL 449 13\nL 442 26\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 457 13\nL 472 13\nL 464 26\nL 457 13\" style=\"stroke:none;fill:rgb(245,163,128)\"/><text x=\"474\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 5</text><path d=\"M 550 26\nL 565 26\nL 557 13\nL 550 26\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 565 13\nL 580 13\nL 572 26\nL 565 13\" style=\"stroke:none;fill:rgb(234,164,215)\"/><text x=\"582\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 6</text><path d=\"M 658 26\nL 673 26\nL 665 13\nL 658 26\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 673 13\nL 688 13\nL 680 26\nL 673 13\" style=\"stroke:none;fill:rgb(237,184,184)\"/><text x=\"690\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 7</text><path d=\"M 10 42\nL 25 42\nL 17 29\nL 10 42\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 25 29\nL 40 29\nL 32 42\nL 25 29\" style=\"stroke:none;fill:rgb(243,192,171)\"/><text x=\"42\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 8</text><path d=\"M 118 42\nL 133 42\nL 125 29\nL 118 42\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 133 29\nL 148 29\nL 140 42\nL 133 29\" style=\"stroke:none;fill:rgb(238,201,228)\"/><text x=\"150\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 9</text><path d=\"M 226 42\nL 241 42\nL 233 29\nL 226 42\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 241 29\nL 256 29\nL 248 42\nL 241 29\" style=\"stroke:none;fill:rgb(243,219,219)\"/><text x=\"258\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series 10</text><text x=\"9\" y=\"68\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">315</text><text x=\"9\" y=\"123\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">290</text><text x=\"9\" y=\"179\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">265</text><text x=\"9\" y=\"234\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"9\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">215</text><text x=\"9\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">165</text><text x=\"9\" y=\"456\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">140</text><text x=\"9\" y=\"512\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"18\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 62\nL 790 62\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 117\nL 790 117\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 173\nL 790 173\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 229\nL 790 229\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 285\nL 790 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 340\nL 790 340\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 452\nL 790 452\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 508\nL 790 508\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 569\nL 46 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 194 569\nL 194 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 343 569\nL 343 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 492 569\nL 492 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 641 569\nL 641 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"111\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T1</text><text x=\"259\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T2</text><text x=\"408\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T3</text><text x=\"557\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T4</text><text x=\"706\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">T5</text><path d=\"M 60.19 519.38\nL 60.19 541.69\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 57.21 519.38\nL 63.17 519.38\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 57.21 552.84\nL 63.17 552.84\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 56 541.69\nL 64.38 541.69\nL 64.38 552.84\nL 56 552.84\nL 56 541.69\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 208.99 508.22\nL 208.99 519.38\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 208.99 530.53\nL 208.99 541.69\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 206.01 508.22\nL 211.97 508.22\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 206.01 541.69\nL 211.97 541.69\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 204.8 519.38\nL 213.18 519.38\nL 213.18 530.53\nL 204.8 530.53\nL 204.8 519.38\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 357.79 497.07\nL 357.79 519.38\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 354.81 497.07\nL 360.77 497.07\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 354.81 530.53\nL 360.77 530.53\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 353.6 519.38\nL 361.98 519.38\nL 361.98 530.53\nL 353.6 530.53\nL 353.6 519.38\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 506.59 485.91\nL 506.59 497.07\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 506.59 508.22\nL 506.59 519.38\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 503.61 485.91\nL 509.57 485.91\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 503.61 519.38\nL 509.57 519.38\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 502.4 497.07\nL 510.78 497.07\nL 510.78 508.22\nL 502.4 508.22\nL 502.4 497.07\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 655.39 474.76\nL 655.39 497.07\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 652.41 474.76\nL 658.37 474.76\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 652.41 508.22\nL 658.37 508.22\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 651.2 497.07\nL 659.58 497.07\nL 659.58 508.22\nL 651.2 508.22\nL 651.2 497.07\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 73.57 474.76\nL 73.57 497.07\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 70.59 474.76\nL 76.55 474.76\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 70.59 508.22\nL 76.55 508.22\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 69.38 497.07\nL 77.76 497.07\nL 77.76 508.22\nL 69.38 508.22\nL 69.38 497.07\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 222.37 463.6\nL 222.37 474.76\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 222.37 485.91\nL 222.37 497.07\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 219.39 463.6\nL 225.35 463.6\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 219.39 497.07\nL 225.35 497.07\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 218.18 474.76\nL 226.56 474.76\nL 226.56 485.91\nL 218.18 485.91\nL 218.18 474.76\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 371.17 452.44\nL 371.17 474.76\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 368.19 452.44\nL 374.15 452.44\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 368.19 485.91\nL 374.15 485.91\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 366.98 474.76\nL 375.36 474.76\nL 375.36 485.91\nL 366.98 485.91\nL 366.98 474.76\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 519.97 441.29\nL 519.97 452.44\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 519.97 463.6\nL 519.97 474.76\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 516.99 441.29\nL 522.95 441.29\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 516.99 474.76\nL 522.95 474.76\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 515.78 452.44\nL 524.16 452.44\nL 524.16 463.6\nL 515.78 463.6\nL 515.78 452.44\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 668.77 430.13\nL 668.77 452.44\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 665.79 430.13\nL 671.75 430.13\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 665.79 463.6\nL 671.75 463.6\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 664.58 452.44\nL 672.96 452.44\nL 672.96 463.6\nL 664.58 463.6\nL 664.58 452.44\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 86.95 430.13\nL 86.95 452.44\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 83.97 430.13\nL 89.93 430.13\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 83.97 463.6\nL 89.93 463.6\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 82.76 452.44\nL 91.14 452.44\nL 91.14 463.6\nL 82.76 463.6\nL 82.76 452.44\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 235.75 418.98\nL 235.75 430.13\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 235.75 441.29\nL 235.75 452.44\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 232.77 418.98\nL 238.73 418.98\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 232.77 452.44\nL 238.73 452.44\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 231.56 430.13\nL 239.94 430.13\nL 239.94 441.29\nL 231.56 441.29\nL 231.56 430.13\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 384.55 407.82\nL 384.55 430.13\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 381.57 407.82\nL 387.53 407.82\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 381.57 441.29\nL 387.53 441.29\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 380.36 430.13\nL 388.74 430.13\nL 388.74 441.29\nL 380.36 441.29\nL 380.36 430.13\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 533.35 396.67\nL 533.35 407.82\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 533.35 418.98\nL 533.35 430.13\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 530.37 396.67\nL 536.33 396.67\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 530.37 430.13\nL 536.33 430.13\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 529.16 407.82\nL 537.54 407.82\nL 537.54 418.98\nL 529.16 418.98\nL 529.16 407.82\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 682.15 385.51\nL 682.15 407.82\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 679.17 385.51\nL 685.13 385.51\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 679.17 418.98\nL 685.13 418.98\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><path d=\"M 677.96 407.82\nL 686.34 407.82\nL 686.34 418.98\nL 677.96 418.98\nL 677.96 407.82\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 100.33 385.51\nL 100.33 407.82\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 97.35 385.51\nL 103.31 385.51\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 97.35 418.98\nL 103.31 418.98\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 96.14 407.82\nL 104.52 407.82\nL 104.52 418.98\nL 96.14 418.98\nL 96.14 407.82\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 249.13 374.36\nL 249.13 385.51\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 249.13 396.67\nL 249.13 407.82\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 246.15 374.36\nL 252.11 374.36\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 246.15 407.82\nL 252.11 407.82\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 244.94 385.51\nL 253.32 385.51\nL 253.32 396.67\nL 244.94 396.67\nL 244.94 385.51\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 397.93 363.2\nL 397.93 385.51\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 394.95 363.2\nL 400.91 363.2\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 394.95 396.67\nL 400.91 396.67\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 393.74 385.51\nL 402.12 385.51\nL 402.12 396.67\nL 393.74 396.67\nL 393.74 385.51\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 546.73 352.04\nL 546.73 363.2\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 546.73 374.36\nL 546.73 385.51\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 543.75 352.04\nL 549.71 352.04\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 543.75 385.51\nL 549.71 385.51\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><path d=\"M 542.54 363.2\nL 550.92 363.2\nL 550.92 374.36\nL 542.54 374.36\nL 542.54 363.2\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 695.53 340.89\nL 695.53 363.2\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 692.55 340.89\nL 698.51 340.89\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 692.55 374.36\nL 698.51 374.36\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><path d=\"M 691.34 363.2\nL 699.72 363.2\nL 699.72 374.36\nL 691.34 374.36\nL 691.34 363.2\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 113.71 340.89\nL 113.71 363.2\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 110.73 340.89\nL 116.69 340.89\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 110.73 374.36\nL 116.69 374.36\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 109.52 363.2\nL 117.9 363.2\nL 117.9 374.36\nL 109.52 374.36\nL 109.52 363.2\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 262.51 329.73\nL 262.51 340.89\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 262.51 352.04\nL 262.51 363.2\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 259.53 329.73\nL 265.49 329.73\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 259.53 363.2\nL 265.49 363.2\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 258.32 340.89\nL 266.7 340.89\nL 266.7 352.04\nL 258.32 352.04\nL 258.32 340.89\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 411.31 318.58\nL 411.31 340.89\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 408.33 318.58\nL 414.29 318.58\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 408.33 352.04\nL 414.29 352.04\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 407.12 340.89\nL 415.5 340.89\nL 415.5 352.04\nL 407.12 352.04\nL 407.12 340.89\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 560.11 307.42\nL 560.11 318.58\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 560.11 329.73\nL 560.11 340.89\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 557.13 307.42\nL 563.09 307.42\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 557.13 340.89\nL 563.09 340.89\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><path d=\"M 555.92 318.58\nL 564.3 318.58\nL 564.3 329.73\nL 555.92 329.73\nL 555.92 318.58\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 708.91 296.27\nL 708.91 318.58\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 705.93 296.27\nL 711.89 296.27\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 705.93 329.73\nL 711.89 329.73\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><path d=\"M 704.72 318.58\nL 713.1 318.58\nL 713.1 329.73\nL 704.72 329.73\nL 704.72 318.58\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 127.09 296.27\nL 127.09 318.58\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 124.11 296.27\nL 130.07 296.27\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 124.11 329.73\nL 130.07 329.73\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 122.9 318.58\nL 131.28 318.58\nL 131.28 329.73\nL 122.9 329.73\nL 122.9 318.58\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 275.89 285.11\nL 275.89 296.27\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 275.89 307.42\nL 275.89 318.58\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 272.91 285.11\nL 278.87 285.11\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 272.91 318.58\nL 278.87 318.58\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 271.7 296.27\nL 280.08 296.27\nL 280.08 307.42\nL 271.7 307.42\nL 271.7 296.27\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 424.69 273.96\nL 424.69 296.27\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 421.71 273.96\nL 427.67 273.96\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 421.71 307.42\nL 427.67 307.42\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 420.5 296.27\nL 428.88 296.27\nL 428.88 307.42\nL 420.5 307.42\nL 420.5 296.27\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 573.49 262.8\nL 573.49 273.96\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 573.49 285.11\nL 573.49 296.27\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 570.51 262.8\nL 576.47 262.8\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 570.51 296.27\nL 576.47 296.27\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><path d=\"M 569.3 273.96\nL 577.68 273.96\nL 577.68 285.11\nL 569.3 285.11\nL 569.3 273.96\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 722.29 251.64\nL 722.29 273.96\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 719.31 251.64\nL 725.27 251.64\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 719.31 285.11\nL 725.27 285.11\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><path d=\"M 718.1 273.96\nL 726.48 273.96\nL 726.48 285.11\nL 718.1 285.11\nL 718.1 273.96\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 140.47 251.64\nL 140.47 273.96\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 137.49 251.64\nL 143.45 251.64\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 137.49 285.11\nL 143.45 285.11\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 136.28 273.96\nL 144.66 273.96\nL 144.66 285.11\nL 136.28 285.11\nL 136.28 273.96\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 289.27 240.49\nL 289.27 251.64\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 289.27 262.8\nL 289.27 273.96\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 286.29 240.49\nL 292.25 240.49\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 286.29 273.96\nL 292.25 273.96\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 285.08 251.64\nL 293.46 251.64\nL 293.46 262.8\nL 285.08 262.8\nL 285.08 251.64\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 438.07 229.33\nL 438.07 251.64\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 435.09 229.33\nL 441.05 229.33\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 435.09 262.8\nL 441.05 262.8\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 433.88 251.64\nL 442.26 251.64\nL 442.26 262.8\nL 433.88 262.8\nL 433.88 251.64\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 586.87 218.18\nL 586.87 229.33\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 586.87 240.49\nL 586.87 251.64\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 583.89 218.18\nL 589.85 218.18\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 583.89 251.64\nL 589.85 251.64\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><path d=\"M 582.68 229.33\nL 591.06 229.33\nL 591.06 240.49\nL 582.68 240.49\nL 582.68 229.33\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 735.67 207.02\nL 735.67 229.33\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 732.69 207.02\nL 738.65 207.02\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 732.69 240.49\nL 738.65 240.49\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><path d=\"M 731.48 229.33\nL 739.86 229.33\nL 739.86 240.49\nL 731.48 240.49\nL 731.48 229.33\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 153.85 207.02\nL 153.85 229.33\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 150.87 207.02\nL 156.83 207.02\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 150.87 240.49\nL 156.83 240.49\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 149.66 229.33\nL 158.04 229.33\nL 158.04 240.49\nL 149.66 240.49\nL 149.66 229.33\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 302.65 195.87\nL 302.65 207.02\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 302.65 218.18\nL 302.65 229.33\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 299.67 195.87\nL 305.63 195.87\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 299.67 229.33\nL 305.63 229.33\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 298.46 207.02\nL 306.84 207.02\nL 306.84 218.18\nL 298.46 218.18\nL 298.46 207.02\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 451.45 184.71\nL 451.45 207.02\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 448.47 184.71\nL 454.43 184.71\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 448.47 218.18\nL 454.43 218.18\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 447.26 207.02\nL 455.64 207.02\nL 455.64 218.18\nL 447.26 218.18\nL 447.26 207.02\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 600.25 173.56\nL 600.25 184.71\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 600.25 195.87\nL 600.25 207.02\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 597.27 173.56\nL 603.23 173.56\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 597.27 207.02\nL 603.23 207.02\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><path d=\"M 596.06 184.71\nL 604.44 184.71\nL 604.44 195.87\nL 596.06 195.87\nL 596.06 184.71\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 749.05 162.4\nL 749.05 184.71\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 746.07 162.4\nL 752.03 162.4\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 746.07 195.87\nL 752.03 195.87\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><path d=\"M 744.86 184.71\nL 753.24 184.71\nL 753.24 195.87\nL 744.86 195.87\nL 744.86 184.71\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 167.23 162.4\nL 167.23 184.71\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 164.25 162.4\nL 170.21 162.4\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 164.25 195.87\nL 170.21 195.87\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 163.04 184.71\nL 171.42 184.71\nL 171.42 195.87\nL 163.04 195.87\nL 163.04 184.71\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 316.03 151.24\nL 316.03 162.4\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 316.03 173.56\nL 316.03 184.71\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 313.05 151.24\nL 319.01 151.24\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 313.05 184.71\nL 319.01 184.71\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 311.84 162.4\nL 320.22 162.4\nL 320.22 173.56\nL 311.84 173.56\nL 311.84 162.4\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 464.83 140.09\nL 464.83 162.4\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 461.85 140.09\nL 467.81 140.09\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 461.85 173.56\nL 467.81 173.56\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 460.64 162.4\nL 469.02 162.4\nL 469.02 173.56\nL 460.64 173.56\nL 460.64 162.4\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 613.63 128.93\nL 613.63 140.09\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 613.63 151.24\nL 613.63 162.4\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 610.65 128.93\nL 616.61 128.93\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 610.65 162.4\nL 616.61 162.4\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><path d=\"M 609.44 140.09\nL 617.82 140.09\nL 617.82 151.24\nL 609.44 151.24\nL 609.44 140.09\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 762.43 117.78\nL 762.43 140.09\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 759.45 117.78\nL 765.41 117.78\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 759.45 151.24\nL 765.41 151.24\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><path d=\"M 758.24 140.09\nL 766.62 140.09\nL 766.62 151.24\nL 758.24 151.24\nL 758.24 140.09\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 180.61 117.78\nL 180.61 140.09\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 177.63 117.78\nL 183.59 117.78\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 177.63 151.24\nL 183.59 151.24\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 176.42 140.09\nL 184.8 140.09\nL 184.8 151.24\nL 176.42 151.24\nL 176.42 140.09\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 329.41 106.62\nL 329.41 117.78\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 329.41 128.93\nL 329.41 140.09\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 326.43 106.62\nL 332.39 106.62\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 326.43 140.09\nL 332.39 140.09\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 325.22 117.78\nL 333.6 117.78\nL 333.6 128.93\nL 325.22 128.93\nL 325.22 117.78\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 478.21 95.47\nL 478.21 117.78\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 475.23 95.47\nL 481.19 95.47\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 475.23 128.93\nL 481.19 128.93\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 474.02 117.78\nL 482.4 117.78\nL 482.4 128.93\nL 474.02 128.93\nL 474.02 117.78\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 627.01 84.31\nL 627.01 95.47\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 627.01 106.62\nL 627.01 117.78\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 624.03 84.31\nL 629.99 84.31\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 624.03 117.78\nL 629.99 117.78\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><path d=\"M 622.82 95.47\nL 631.2 95.47\nL 631.2 106.62\nL 622.82 106.62\nL 622.82 95.47\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 775.81 73.16\nL 775.81 95.47\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 772.83 73.16\nL 778.79 73.16\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 772.83 106.62\nL 778.79 106.62\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><path d=\"M 771.62 95.47\nL 780 95.47\nL 780 106.62\nL 771.62 106.62\nL 771.62 95.47\" style=\"stroke:none;fill:rgb(243,219,219)\"/></svg>",
 			pngCRC: 0x31d4c785,
 		},
 		{
@@ -471,7 +471,7 @@ func TestCandlestickChart(t *testing.T) {
 					Padding: NewBoxEqual(10),
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">171</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">162</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">153</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">135</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117</text><text x=\"9\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108</text><text x=\"18\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99</text><text x=\"18\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 569\nL 46 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 294 569\nL 294 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 542 569\nL 542 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"151\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 1</text><text x=\"399\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 2</text><text x=\"647\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 3</text><path d=\"M 117 428\nL 117 462\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 117 496\nL 117 530\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 93 428\nL 141 428\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 93 530\nL 141 530\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 68 462\nL 166 462\nL 166 496\nL 68 496\nL 68 462\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 365 394\nL 365 414\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 365 462\nL 365 496\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 341 394\nL 389 394\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 341 496\nL 389 496\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 316 414\nL 414 414\nL 414 462\nL 316 462\nL 316 414\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 613 373\nL 613 394\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 613 414\nL 613 441\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 589 373\nL 637 373\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 589 441\nL 637 441\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 564 394\nL 662 394\nL 662 414\nL 564 414\nL 564 394\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 221 86\nL 221 120\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 221 154\nL 221 188\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 197 86\nL 245 86\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 197 188\nL 245 188\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 172 120\nL 270 120\nL 270 154\nL 172 154\nL 172 120\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 469 52\nL 469 72\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 469 120\nL 469 154\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 445 52\nL 493 52\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 445 154\nL 493 154\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 420 72\nL 518 72\nL 518 120\nL 420 120\nL 420 72\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 717 31\nL 717 52\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 717 72\nL 717 99\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 693 31\nL 741 31\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 693 99\nL 741 99\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 668 52\nL 766 52\nL 766 72\nL 668 72\nL 668 52\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">171</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">162</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">153</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">135</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117</text><text x=\"9\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108</text><text x=\"18\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99</text><text x=\"18\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 569\nL 46 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 294 569\nL 294 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 542 569\nL 542 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"151\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 1</text><text x=\"399\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 2</text><text x=\"647\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 3</text><path d=\"M 117.9 427.21\nL 117.9 461.41\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 117.9 495.6\nL 117.9 529.8\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 93.1 427.21\nL 142.7 427.21\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 93.1 529.8\nL 142.7 529.8\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 68.3 461.41\nL 167.5 461.41\nL 167.5 495.6\nL 68.3 495.6\nL 68.3 461.41\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 365.9 393.01\nL 365.9 413.53\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 365.9 461.41\nL 365.9 495.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 341.1 393.01\nL 390.7 393.01\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 341.1 495.6\nL 390.7 495.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 316.3 413.53\nL 415.5 413.53\nL 415.5 461.41\nL 316.3 461.41\nL 316.3 413.53\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 613.9 372.49\nL 613.9 393.01\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 613.9 413.53\nL 613.9 440.89\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 589.1 372.49\nL 638.7 372.49\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 589.1 440.89\nL 638.7 440.89\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 564.3 393.01\nL 663.5 393.01\nL 663.5 413.53\nL 564.3 413.53\nL 564.3 393.01\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 222.1 85.23\nL 222.1 119.43\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 222.1 153.63\nL 222.1 187.83\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 197.3 85.23\nL 246.9 85.23\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 197.3 187.83\nL 246.9 187.83\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 172.5 119.43\nL 271.7 119.43\nL 271.7 153.63\nL 172.5 153.63\nL 172.5 119.43\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 470.1 51.04\nL 470.1 71.56\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 470.1 119.43\nL 470.1 153.63\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 445.3 51.04\nL 494.9 51.04\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 445.3 153.63\nL 494.9 153.63\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 420.5 71.56\nL 519.7 71.56\nL 519.7 119.43\nL 420.5 119.43\nL 420.5 71.56\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 718.1 30.52\nL 718.1 51.04\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 718.1 71.56\nL 718.1 98.91\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 693.3 30.52\nL 742.9 30.52\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 693.3 98.91\nL 742.9 98.91\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 668.5 51.04\nL 767.7 51.04\nL 767.7 71.56\nL 668.5 71.56\nL 668.5 51.04\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/></svg>",
 			pngCRC: 0x60a5d5cd,
 		},
 		{
@@ -502,7 +502,7 @@ func TestCandlestickChart(t *testing.T) {
 					Padding:      NewBoxEqual(10),
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"168\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 1</text><text x=\"409\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 2</text><text x=\"650\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 3</text><path d=\"M 139 248\nL 139 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 139 406\nL 139 485\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 115 248\nL 163 248\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 115 485\nL 163 485\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 91 327\nL 187 327\nL 187 406\nL 91 406\nL 91 327\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 380 169\nL 380 216\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 380 327\nL 380 406\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 356 169\nL 404 169\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 356 406\nL 404 406\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 332 216\nL 428 216\nL 428 327\nL 332 327\nL 332 216\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 621 121\nL 621 169\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 621 216\nL 621 280\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 597 121\nL 645 121\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 597 280\nL 645 280\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 573 169\nL 669 169\nL 669 216\nL 573 216\nL 573 169\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 235 216\nL 235 295\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 235 375\nL 235 454\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 211 216\nL 259 216\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 211 454\nL 259 454\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 187 295\nL 283 295\nL 283 375\nL 187 375\nL 187 295\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 476 137\nL 476 185\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 476 295\nL 476 375\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 452 137\nL 500 137\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 452 375\nL 500 375\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 428 185\nL 524 185\nL 524 295\nL 428 295\nL 428 185\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 717 90\nL 717 137\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 717 185\nL 717 248\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 693 90\nL 741 90\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 693 248\nL 741 248\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 669 137\nL 765 137\nL 765 185\nL 669 185\nL 669 137\" style=\"stroke:none;fill:rgb(115,192,222)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"168\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 1</text><text x=\"409\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 2</text><text x=\"650\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Day 3</text><path d=\"M 139.3 247.43\nL 139.3 326.57\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 139.3 405.71\nL 139.3 484.86\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 115.2 247.43\nL 163.4 247.43\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 115.2 484.86\nL 163.4 484.86\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 91.1 326.57\nL 187.5 326.57\nL 187.5 405.71\nL 91.1 405.71\nL 91.1 326.57\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 380.3 168.29\nL 380.3 215.77\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 380.3 326.57\nL 380.3 405.71\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 356.2 168.29\nL 404.4 168.29\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 356.2 405.71\nL 404.4 405.71\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 332.1 215.77\nL 428.5 215.77\nL 428.5 326.57\nL 332.1 326.57\nL 332.1 215.77\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 621.3 120.8\nL 621.3 168.29\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 621.3 215.77\nL 621.3 279.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 597.2 120.8\nL 645.4 120.8\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 597.2 279.09\nL 645.4 279.09\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 573.1 168.29\nL 669.5 168.29\nL 669.5 215.77\nL 573.1 215.77\nL 573.1 168.29\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 235.7 215.77\nL 235.7 294.91\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 235.7 374.06\nL 235.7 453.2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 211.6 215.77\nL 259.8 215.77\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 211.6 453.2\nL 259.8 453.2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 187.5 294.91\nL 283.9 294.91\nL 283.9 374.06\nL 187.5 374.06\nL 187.5 294.91\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 476.7 136.63\nL 476.7 184.11\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 476.7 294.91\nL 476.7 374.06\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 452.6 136.63\nL 500.8 136.63\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 452.6 374.06\nL 500.8 374.06\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 428.5 184.11\nL 524.9 184.11\nL 524.9 294.91\nL 428.5 294.91\nL 428.5 184.11\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 717.7 89.14\nL 717.7 136.63\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 717.7 184.11\nL 717.7 247.43\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 693.6 89.14\nL 741.8 89.14\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 693.6 247.43\nL 741.8 247.43\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 669.5 136.63\nL 765.9 136.63\nL 765.9 184.11\nL 669.5 184.11\nL 669.5 136.63\" style=\"stroke:none;fill:rgb(115,192,222)\"/></svg>",
 			pngCRC: 0x9bb012da,
 		},
 		{
@@ -519,7 +519,7 @@ func TestCandlestickChart(t *testing.T) {
 				}}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">119.15</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.47</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.78</text><text x=\"17\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111.1</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.42</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.73</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.05</text><text x=\"9\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100.37</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.68</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 569\nL 187 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 669 569\nL 669 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"114\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"234\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"354\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"476\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"594\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"725\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><path d=\"M 127 243\nL 127 350\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 127 457\nL 127 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 103 243\nL 151 243\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 103 564\nL 151 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 79 350\nL 175 350\nL 175 457\nL 79 457\nL 79 350\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 368 71\nL 368 136\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 368 200\nL 368 286\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 344 71\nL 392 71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 344 286\nL 392 286\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 320 136\nL 416 136\nL 416 200\nL 320 200\nL 320 136\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 729 178\nL 729 264\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 729 286\nL 729 350\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 705 178\nL 753 178\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 705 350\nL 753 350\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 681 264\nL 777 264\nL 777 286\nL 681 286\nL 681 264\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick Chart</text><path d=\"M 367 26\nL 382 26\nL 374 13\nL 367 26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 382 13\nL 397 13\nL 389 26\nL 382 13\" style=\"stroke:none;fill:rgb(239,68,68)\"/><text x=\"399\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">119.15</text><text x=\"9\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.47</text><text x=\"9\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.78</text><text x=\"17\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111.1</text><text x=\"9\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.42</text><text x=\"9\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.73</text><text x=\"9\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.05</text><text x=\"9\" y=\"453\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100.37</text><text x=\"17\" y=\"510\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.68</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 103\nL 790 103\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 161\nL 790 161\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 218\nL 790 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 276\nL 790 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 333\nL 790 333\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 391\nL 790 391\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 448\nL 790 448\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 506\nL 790 506\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 569\nL 187 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 669 569\nL 669 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"114\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"234\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"354\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"476\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"594\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"725\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><path d=\"M 127.25 242.26\nL 127.25 349.51\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 127.25 456.75\nL 127.25 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 103.15 242.26\nL 151.35 242.26\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 103.15 564\nL 151.35 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 79.05 349.51\nL 175.45 349.51\nL 175.45 456.75\nL 79.05 456.75\nL 79.05 349.51\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 368.25 70.67\nL 368.25 135.01\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 368.25 199.36\nL 368.25 285.16\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 344.15 70.67\nL 392.35 70.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 344.15 285.16\nL 392.35 285.16\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 320.05 135.01\nL 416.45 135.01\nL 416.45 199.36\nL 320.05 199.36\nL 320.05 135.01\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 729.75 177.91\nL 729.75 263.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 729.75 285.16\nL 729.75 349.51\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 705.65 177.91\nL 753.85 177.91\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 705.65 349.51\nL 753.85 349.51\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 681.55 263.71\nL 777.95 263.71\nL 777.95 285.16\nL 681.55 285.16\nL 681.55 263.71\" style=\"stroke:none;fill:rgb(34,197,94)\"/></svg>",
 			pngCRC: 0x8ec8a7fb,
 		},
 	}
@@ -662,7 +662,7 @@ func TestRenderCandlestickChart(t *testing.T) {
 	require.NoError(t, err)
 	data, err := painter.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><path d=\"M 267 36\nL 282 36\nL 274 23\nL 267 36\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 282 23\nL 297 23\nL 289 36\nL 282 23\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"299\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"27\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"27\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"49\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 73 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 177 359\nL 177 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 278 359\nL 278 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 378 359\nL 378 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 479 359\nL 479 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"114\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"214\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"314\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"416\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"514\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 127 184\nL 127 227\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 127 269\nL 127 312\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 107 184\nL 147 184\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 107 312\nL 147 312\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 87 227\nL 167 227\nL 167 269\nL 87 269\nL 87 227\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 227 142\nL 227 167\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 227 227\nL 227 269\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 207 142\nL 247 142\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 207 269\nL 247 269\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 187 167\nL 267 167\nL 267 227\nL 187 227\nL 187 167\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 328 116\nL 328 142\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 328 167\nL 328 201\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 308 116\nL 348 116\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 308 201\nL 348 201\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 288 142\nL 368 142\nL 368 167\nL 288 167\nL 288 142\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 428 99\nL 428 142\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 428 201\nL 428 227\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 408 99\nL 448 99\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 408 227\nL 448 227\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 388 142\nL 468 142\nL 468 201\nL 388 201\nL 388 142\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 529 159\nL 529 193\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 529 201\nL 529 227\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 509 159\nL 549 159\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 509 227\nL 549 227\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 489 193\nL 569 193\nL 569 201\nL 489 201\nL 489 193\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>", data)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><path d=\"M 267 36\nL 282 36\nL 274 23\nL 267 36\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 282 23\nL 297 23\nL 289 36\nL 282 23\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"299\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Price</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"27\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"27\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"49\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 73 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 177 359\nL 177 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 278 359\nL 278 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 378 359\nL 378 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 479 359\nL 479 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"114\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"214\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"314\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"416\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"514\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><path d=\"M 127.3 183.71\nL 127.3 226.29\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 127.3 268.86\nL 127.3 311.43\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 107.18 183.71\nL 147.42 183.71\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 107.18 311.43\nL 147.42 311.43\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 87.06 226.29\nL 167.54 226.29\nL 167.54 268.86\nL 87.06 268.86\nL 87.06 226.29\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 227.9 141.14\nL 227.9 166.69\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 227.9 226.29\nL 227.9 268.86\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 207.78 141.14\nL 248.02 141.14\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 207.78 268.86\nL 248.02 268.86\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 187.66 166.69\nL 268.14 166.69\nL 268.14 226.29\nL 187.66 226.29\nL 187.66 166.69\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 328.5 115.6\nL 328.5 141.14\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 328.5 166.69\nL 328.5 200.74\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 308.38 115.6\nL 348.62 115.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 308.38 200.74\nL 348.62 200.74\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 288.26 141.14\nL 368.74 141.14\nL 368.74 166.69\nL 288.26 166.69\nL 288.26 141.14\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 429.1 98.57\nL 429.1 141.14\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 429.1 200.74\nL 429.1 226.29\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 408.98 98.57\nL 449.22 98.57\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 408.98 226.29\nL 449.22 226.29\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 388.86 141.14\nL 469.34 141.14\nL 469.34 200.74\nL 388.86 200.74\nL 388.86 141.14\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 529.7 158.17\nL 529.7 192.23\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 529.7 200.74\nL 529.7 226.29\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 509.58 158.17\nL 549.82 158.17\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 509.58 226.29\nL 549.82 226.29\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 489.46 192.23\nL 569.94 192.23\nL 569.94 200.74\nL 489.46 200.74\nL 489.46 192.23\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>", data)
 }
 
 func TestCandlestickCandleWidthClamped(t *testing.T) {
@@ -718,7 +718,7 @@ func TestCandlestickYAxisScalingExtremes(t *testing.T) {
 			},
 			yMin:      1_000_000,
 			yMax:      1_500_000,
-			expectSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"17\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.5M</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44M</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.39M</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.33M</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28M</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.22M</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.17M</text><text x=\"9\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.11M</text><text x=\"9\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.06M</text><text x=\"30\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1M</text><path d=\"M 59 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 63 569\nL 63 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 426 569\nL 426 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"239\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"603\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 244 287\nL 244 343\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 244 454\nL 244 564\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 172 287\nL 316 287\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 172 564\nL 316 564\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 99 343\nL 389 343\nL 389 454\nL 99 454\nL 99 343\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 608 232\nL 608 254\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 608 343\nL 608 398\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 536 232\nL 680 232\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 536 398\nL 680 398\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 463 254\nL 753 254\nL 753 343\nL 463 343\nL 463 254\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			expectSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"17\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.5M</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44M</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.39M</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.33M</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28M</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.22M</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.17M</text><text x=\"9\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.11M</text><text x=\"9\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.06M</text><text x=\"30\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1M</text><path d=\"M 59 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 59 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 63 569\nL 63 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 426 569\nL 426 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"239\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"603\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 244.75 287\nL 244.75 342.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 244.75 453.2\nL 244.75 564\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 172.05 287\nL 317.45 287\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 172.05 564\nL 317.45 564\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 99.35 342.4\nL 390.15 342.4\nL 390.15 453.2\nL 99.35 453.2\nL 99.35 342.4\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 608.25 231.6\nL 608.25 253.76\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 608.25 342.4\nL 608.25 397.8\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 535.55 231.6\nL 680.95 231.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 535.55 397.8\nL 680.95 397.8\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 462.85 253.76\nL 753.65 253.76\nL 753.65 342.4\nL 462.85 342.4\nL 462.85 253.76\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 		},
 		{
 			name: "small_decimals",
@@ -728,7 +728,7 @@ func TestCandlestickYAxisScalingExtremes(t *testing.T) {
 			},
 			yMin:      0.0,
 			yMax:      0.05,
-			expectSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.05</text><text x=\"9\" y=\"126\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.04</text><text x=\"9\" y=\"236\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.03</text><text x=\"9\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.02</text><text x=\"9\" y=\"457\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.01</text><text x=\"31\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 46 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 120\nL 790 120\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 231\nL 790 231\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 342\nL 790 342\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 453\nL 790 453\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 50 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 50 569\nL 50 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 420 569\nL 420 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"230\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"600\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 235 343\nL 235 365\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 235 398\nL 235 454\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 161 343\nL 309 343\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 161 454\nL 309 454\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 87 365\nL 383 365\nL 383 398\nL 87 398\nL 87 365\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 605 287\nL 605 343\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 605 376\nL 605 432\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 531 287\nL 679 287\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 531 432\nL 679 432\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 457 343\nL 753 343\nL 753 376\nL 457 376\nL 457 343\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			expectSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.05</text><text x=\"9\" y=\"126\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.04</text><text x=\"9\" y=\"236\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.03</text><text x=\"9\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.02</text><text x=\"9\" y=\"457\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.01</text><text x=\"31\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 46 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 120\nL 790 120\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 231\nL 790 231\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 342\nL 790 342\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 453\nL 790 453\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 50 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 50 569\nL 50 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 420 569\nL 420 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"230\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"600\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 235 342.4\nL 235 364.56\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 235 397.8\nL 235 453.2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 161 342.4\nL 309 342.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 161 453.2\nL 309 453.2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 87 364.56\nL 383 364.56\nL 383 397.8\nL 87 397.8\nL 87 364.56\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 605 287\nL 605 342.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 605 375.64\nL 605 431.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 531 287\nL 679 287\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 531 431.04\nL 679 431.04\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 457 342.4\nL 753 342.4\nL 753 375.64\nL 457 375.64\nL 457 342.4\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 		},
 		{
 			name: "negative_values",
@@ -738,7 +738,7 @@ func TestCandlestickYAxisScalingExtremes(t *testing.T) {
 			},
 			yMin:      -2_000,
 			yMax:      -500,
-			expectSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"31\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-500</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-666.67</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-833.33</text><text x=\"41\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1k</text><text x=\"19\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.17k</text><text x=\"19\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.33k</text><text x=\"28\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.5k</text><text x=\"19\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.67k</text><text x=\"19\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.83k</text><text x=\"41\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-2k</text><path d=\"M 69 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 73 569\nL 73 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 431 569\nL 431 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"247\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"605\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 252 158\nL 252 177\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 252 195\nL 252 232\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 181 158\nL 323 158\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 181 232\nL 323 232\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 109 177\nL 395 177\nL 395 195\nL 109 195\nL 109 177\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 610 121\nL 610 129\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 610 177\nL 610 214\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 539 121\nL 681 121\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 539 214\nL 681 214\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 467 129\nL 753 129\nL 753 177\nL 467 177\nL 467 129\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			expectSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"31\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-500</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-666.67</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-833.33</text><text x=\"41\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1k</text><text x=\"19\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.17k</text><text x=\"19\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.33k</text><text x=\"28\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.5k</text><text x=\"19\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.67k</text><text x=\"19\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-1.83k</text><text x=\"41\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-2k</text><path d=\"M 69 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 73 569\nL 73 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 431 569\nL 431 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"247\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"605\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 252.25 157.73\nL 252.25 176.2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 252.25 194.67\nL 252.25 231.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 180.55 157.73\nL 323.95 157.73\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 180.55 231.6\nL 323.95 231.6\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 108.85 176.2\nL 395.65 176.2\nL 395.65 194.67\nL 108.85 194.67\nL 108.85 176.2\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 610.75 120.8\nL 610.75 128.19\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 610.75 176.2\nL 610.75 213.13\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 539.05 120.8\nL 682.45 120.8\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 539.05 213.13\nL 682.45 213.13\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 467.35 128.19\nL 754.15 128.19\nL 754.15 176.2\nL 467.35 176.2\nL 467.35 128.19\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 		},
 	}
 
@@ -867,47 +867,78 @@ func computeCenters(r *defaultRenderResult, opt CandlestickChartOption, seriesIn
 	if candleWidthRatio <= 0 {
 		candleWidthRatio = 0.8
 	}
-	candleWidth := int(float64(width) * candleWidthRatio / float64(maxDataCount))
+	candleWidth := float64(width) * candleWidthRatio / float64(maxDataCount)
 	if candleWidth < 1 {
 		candleWidth = 1
 	}
-	candleWidthPerSeries := candleWidth / seriesCount
+	candleWidthPerSeries := candleWidth / float64(seriesCount)
 	if candleWidthPerSeries < 1 {
 		candleWidthPerSeries = 1
 	}
-	divideValues := r.xaxisRange.autoDivide()
+	x0, x1 := r.xaxisRange.getRange(0)
+	sectionWidth := x1 - x0
 	centers := make([]int, len(opt.SeriesList.getSeries(seriesIndex).(*CandlestickSeries).Data))
 	for j := range centers {
-		if j >= len(divideValues) {
+		if j >= r.xaxisRange.divideCount {
 			continue
 		}
-		var sectionWidth int
-		if j < len(divideValues)-1 {
-			sectionWidth = divideValues[j+1] - divideValues[j]
-		} else if j > 0 {
-			sectionWidth = divideValues[j] - divideValues[j-1]
-		} else {
-			sectionWidth = width / maxDataCount
-		}
-		var groupMargin, candleMargin, cWidth int
+		var groupMargin, candleMargin, cWidth float64
 		if seriesCount == 1 {
 			cWidth = candleWidthPerSeries
 		} else {
 			var candleMarginFloat *float64
 			if opt.CandleMargin != nil {
-				marginPixels := float64(sectionWidth) * (*opt.CandleMargin)
+				marginPixels := sectionWidth * (*opt.CandleMargin)
 				candleMarginFloat = &marginPixels
 			}
 			groupMargin, candleMargin, cWidth = calculateCandleMarginsAndSize(seriesCount, sectionWidth, candleWidthPerSeries, candleMarginFloat)
 		}
-		var center int
+		var center float64
 		if seriesCount == 1 {
-			center = divideValues[j] + sectionWidth/2
+			center = float64(j)*sectionWidth + sectionWidth/2
 		} else {
-			x := divideValues[j] + groupMargin + seriesIndex*(cWidth+candleMargin)
+			x := float64(j)*sectionWidth + groupMargin + float64(seriesIndex)*(cWidth+candleMargin)
 			center = x + cWidth/2
 		}
-		centers[j] = center + r.seriesPainter.box.Left
+		centers[j] = roundFloatToInt(center) + r.seriesPainter.box.Left
 	}
 	return centers
 }
+
+func TestCandlestickVolumeIndicators(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 100, High: 110, Low: 90, Close: 105, Volume: 1000},
+		{Open: 105, High: 115, Low: 95, Close: 108, Volume: 1500},
+		{Open: 108, High: 118, Low: 100, Close: 112, Volume: 500},
+		{Open: 112, High: 120, Low: 104, Close: 106, Volume: 2000},
+	}
+	series := CandlestickSeries{Data: data}
+
+	assert.Equal(t, []float64{1000, 1500, 500, 2000}, series.ExtractVolumes())
+	assert.Nil(t, (&CandlestickSeries{Data: makeBasicCandlestickData()}).ExtractVolumes())
+
+	aggregated := AggregateCandlestick(series, 2)
+	require.Len(t, aggregated.Data, 2)
+	assert.InDelta(t, 2500.0, aggregated.Data[0].Volume, 0)
+	assert.InDelta(t, 2500.0, aggregated.Data[1].Volume, 0)
+
+	series.CloseTrendLine = []SeriesTrendLine{
+		{Type: SeriesTrendTypeVWAP},
+		{Type: SeriesTrendTypeKeltner, Period: 2, FillArea: Ptr(true)},
+		{Type: SeriesTrendTypeParabolicSAR},
+	}
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.CandlestickChart(NewCandlestickOptionWithSeries(series)))
+	_, err := p.Bytes()
+	require.NoError(t, err)
+
+	// vwap without volume data fails to render
+	p = NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	err = p.CandlestickChart(NewCandlestickOptionWithSeries(CandlestickSeries{
+		Data:           makeBasicCandlestickData(),
+		CloseTrendLine: NewTrendLine(SeriesTrendTypeVWAP),
+	}))
+	require.Error(t, err)
+}
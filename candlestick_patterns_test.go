@@ -677,7 +677,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.85</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110.87</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.88</text><text x=\"17\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.9</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.92</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102.93</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100.95</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.97</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.98</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187 99\nL 187 254\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187 409\nL 187 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 99\nL 235 99\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 564\nL 235 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 254\nL 283 254\nL 283 409\nL 91 409\nL 91 254\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 192\nL 428 254\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 254\nL 428 316\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 192\nL 476 192\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 316\nL 476 316\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332 254\nL 524 254\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 37\nL 669 161\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 254\nL 669 471\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 37\nL 717 37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 471\nL 717 471\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573 161\nL 765 161\nL 765 254\nL 573 254\nL 573 161\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 433 241\nL 466 241\nL 466 241\nA 4 4 90.00 0 1 470 245\nL 470 258\nL 470 258\nA 4 4 90.00 0 1 466 262\nL 433 262\nL 433 262\nA 4 4 90.00 0 1 429 258\nL 429 245\nL 429 245\nA 4 4 90.00 0 1 433 241\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"433\" y=\"258\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.85</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110.87</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.88</text><text x=\"17\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.9</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.92</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102.93</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100.95</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.97</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.98</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187.5 98.45\nL 187.5 253.64\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187.5 408.82\nL 187.5 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 98.45\nL 235.7 98.45\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 564\nL 235.7 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.1 253.64\nL 283.9 253.64\nL 283.9 408.82\nL 91.1 408.82\nL 91.1 253.64\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 191.56\nL 428.5 253.64\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 253.64\nL 428.5 315.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 191.56\nL 476.7 191.56\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 315.71\nL 476.7 315.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332.1 253.64\nL 524.9 253.64\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 36.38\nL 669.5 160.53\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 253.64\nL 669.5 470.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 36.38\nL 717.7 36.38\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 470.89\nL 717.7 470.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573.1 160.53\nL 765.9 160.53\nL 765.9 253.64\nL 573.1 253.64\nL 573.1 160.53\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 434 241\nL 467 241\nL 467 241\nA 4 4 90.00 0 1 471 245\nL 471 258\nL 471 258\nA 4 4 90.00 0 1 467 262\nL 434 262\nL 434 262\nA 4 4 90.00 0 1 430 258\nL 430 245\nL 430 245\nA 4 4 90.00 0 1 434 241\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"434\" y=\"258\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text></svg>",
 			pngCRC: 0xd067a55e,
 		},
 		{
@@ -693,7 +693,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.85</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110.87</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.88</text><text x=\"17\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.9</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.92</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102.93</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100.95</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.97</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.98</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187 99\nL 187 254\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187 409\nL 187 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 99\nL 235 99\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 564\nL 235 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 254\nL 283 254\nL 283 409\nL 91 409\nL 91 254\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 130\nL 428 161\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 428 192\nL 428 471\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 380 130\nL 476 130\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 380 471\nL 476 471\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 332 161\nL 524 161\nL 524 192\nL 332 192\nL 332 161\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 669 37\nL 669 99\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 192\nL 669 347\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 37\nL 717 37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 347\nL 717 347\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573 99\nL 765 99\nL 765 192\nL 573 192\nL 573 99\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 433 179\nL 493 179\nL 493 179\nA 4 4 90.00 0 1 497 183\nL 497 196\nL 497 196\nA 4 4 90.00 0 1 493 200\nL 433 200\nL 433 200\nA 4 4 90.00 0 1 429 196\nL 429 183\nL 429 183\nA 4 4 90.00 0 1 433 179\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"433\" y=\"196\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.85</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110.87</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.88</text><text x=\"17\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.9</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.92</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102.93</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100.95</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.97</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.98</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187.5 98.45\nL 187.5 253.64\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187.5 408.82\nL 187.5 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 98.45\nL 235.7 98.45\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 564\nL 235.7 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.1 253.64\nL 283.9 253.64\nL 283.9 408.82\nL 91.1 408.82\nL 91.1 253.64\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 129.49\nL 428.5 160.53\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 428.5 191.56\nL 428.5 470.89\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 380.3 129.49\nL 476.7 129.49\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 380.3 470.89\nL 476.7 470.89\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 332.1 160.53\nL 524.9 160.53\nL 524.9 191.56\nL 332.1 191.56\nL 332.1 160.53\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 669.5 36.38\nL 669.5 98.45\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 191.56\nL 669.5 346.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 36.38\nL 717.7 36.38\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 346.75\nL 717.7 346.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573.1 98.45\nL 765.9 98.45\nL 765.9 191.56\nL 573.1 191.56\nL 573.1 98.45\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 434 179\nL 494 179\nL 494 179\nA 4 4 90.00 0 1 498 183\nL 498 196\nL 498 196\nA 4 4 90.00 0 1 494 200\nL 434 200\nL 434 200\nA 4 4 90.00 0 1 430 196\nL 430 183\nL 430 183\nA 4 4 90.00 0 1 434 179\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"434\" y=\"196\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text></svg>",
 			pngCRC: 0x48755ffa,
 		},
 		{
@@ -709,7 +709,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.67</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.33</text><text x=\"30\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.33</text><text x=\"39\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.67</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">92.33</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187 37\nL 187 169\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187 301\nL 187 433\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 37\nL 235 37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 433\nL 235 433\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 169\nL 283 169\nL 283 301\nL 91 301\nL 91 169\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 116\nL 428 406\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 433\nL 428 459\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 116\nL 476 116\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 459\nL 476 459\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332 406\nL 524 406\nL 524 433\nL 332 433\nL 332 406\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 669 248\nL 669 353\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 406\nL 669 538\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 248\nL 717 248\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 538\nL 717 538\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573 353\nL 765 353\nL 765 406\nL 573 406\nL 573 353\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 433 387\nL 525 387\nL 525 387\nA 4 4 90.00 0 1 529 391\nL 529 417\nL 529 417\nA 4 4 90.00 0 1 525 421\nL 433 421\nL 433 421\nA 4 4 90.00 0 1 429 417\nL 429 391\nL 429 391\nA 4 4 90.00 0 1 433 387\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"433\" y=\"404\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"437\" y=\"417\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108.67</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.33</text><text x=\"30\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.33</text><text x=\"39\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.67</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">92.33</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187.5 36.38\nL 187.5 168.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187.5 300.19\nL 187.5 432.1\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 36.38\nL 235.7 36.38\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 432.1\nL 235.7 432.1\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.1 168.29\nL 283.9 168.29\nL 283.9 300.19\nL 91.1 300.19\nL 91.1 168.29\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 115.52\nL 428.5 405.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 432.1\nL 428.5 458.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 115.52\nL 476.7 115.52\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 458.48\nL 476.7 458.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332.1 405.71\nL 524.9 405.71\nL 524.9 432.1\nL 332.1 432.1\nL 332.1 405.71\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 669.5 247.43\nL 669.5 352.95\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 405.71\nL 669.5 537.62\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 247.43\nL 717.7 247.43\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 537.62\nL 717.7 537.62\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573.1 352.95\nL 765.9 352.95\nL 765.9 405.71\nL 573.1 405.71\nL 573.1 352.95\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 434 387\nL 526 387\nL 526 387\nA 4 4 90.00 0 1 530 391\nL 530 417\nL 530 417\nA 4 4 90.00 0 1 526 421\nL 434 421\nL 434 421\nA 4 4 90.00 0 1 430 417\nL 430 391\nL 430 391\nA 4 4 90.00 0 1 434 387\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"434\" y=\"404\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"438\" y=\"417\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text></svg>",
 			pngCRC: 0x1d694146,
 		},
 		{
@@ -725,7 +725,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.56</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.22</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.78</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.89</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.44</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187 287\nL 187 357\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187 426\nL 187 495\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 287\nL 235 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 495\nL 235 495\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 357\nL 283 357\nL 283 426\nL 91 426\nL 91 357\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 80\nL 428 315\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 329\nL 428 343\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 80\nL 476 80\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 343\nL 476 343\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332 315\nL 524 315\nL 524 329\nL 332 329\nL 332 315\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 669 260\nL 669 301\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 329\nL 669 398\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 260\nL 717 260\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 398\nL 717 398\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573 301\nL 765 301\nL 765 329\nL 573 329\nL 573 301\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 433 296\nL 525 296\nL 525 296\nA 4 4 90.00 0 1 529 300\nL 529 326\nL 529 326\nA 4 4 90.00 0 1 525 330\nL 433 330\nL 433 330\nA 4 4 90.00 0 1 429 326\nL 429 300\nL 429 300\nA 4 4 90.00 0 1 433 296\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"433\" y=\"313\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"437\" y=\"326\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.56</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.22</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.78</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.89</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.44</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187.5 287\nL 187.5 356.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187.5 425.5\nL 187.5 494.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 287\nL 235.7 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 494.75\nL 235.7 494.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.1 356.25\nL 283.9 356.25\nL 283.9 425.5\nL 91.1 425.5\nL 91.1 356.25\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 79.25\nL 428.5 314.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 328.55\nL 428.5 342.4\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 79.25\nL 476.7 79.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 342.4\nL 476.7 342.4\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332.1 314.7\nL 524.9 314.7\nL 524.9 328.55\nL 332.1 328.55\nL 332.1 314.7\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 669.5 259.3\nL 669.5 300.85\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 328.55\nL 669.5 397.8\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 259.3\nL 717.7 259.3\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 397.8\nL 717.7 397.8\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573.1 300.85\nL 765.9 300.85\nL 765.9 328.55\nL 573.1 328.55\nL 573.1 300.85\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 434 296\nL 526 296\nL 526 296\nA 4 4 90.00 0 1 530 300\nL 530 326\nL 530 326\nA 4 4 90.00 0 1 526 330\nL 434 330\nL 434 330\nA 4 4 90.00 0 1 430 326\nL 430 300\nL 430 300\nA 4 4 90.00 0 1 434 296\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"434\" y=\"313\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"438\" y=\"326\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text></svg>",
 			pngCRC: 0x2fd3f823,
 		},
 		{
@@ -741,7 +741,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.56</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.22</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.78</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.89</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.44</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187 287\nL 187 357\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187 426\nL 187 495\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 287\nL 235 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 495\nL 235 495\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 357\nL 283 357\nL 283 426\nL 91 426\nL 91 357\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 80\nL 428 315\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 80\nL 476 80\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 315\nL 476 315\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332 315\nL 524 315\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 218\nL 669 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 315\nL 669 384\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 218\nL 717 218\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 384\nL 717 384\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573 287\nL 765 287\nL 765 315\nL 573 315\nL 573 287\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 433 283\nL 525 283\nL 525 283\nA 4 4 90.00 0 1 529 287\nL 529 339\nL 529 339\nA 4 4 90.00 0 1 525 343\nL 433 343\nL 433 343\nA 4 4 90.00 0 1 429 339\nL 429 287\nL 429 287\nA 4 4 90.00 0 1 433 283\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"433\" y=\"300\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"441\" y=\"313\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><text x=\"462\" y=\"326\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><text x=\"437\" y=\"339\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.56</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.22</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.78</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.89</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.44</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187.5 287\nL 187.5 356.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187.5 425.5\nL 187.5 494.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 287\nL 235.7 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 494.75\nL 235.7 494.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.1 356.25\nL 283.9 356.25\nL 283.9 425.5\nL 91.1 425.5\nL 91.1 356.25\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 79.25\nL 428.5 314.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 79.25\nL 476.7 79.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 314.7\nL 476.7 314.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332.1 314.7\nL 524.9 314.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 217.75\nL 669.5 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 314.7\nL 669.5 383.95\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 217.75\nL 717.7 217.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 383.95\nL 717.7 383.95\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573.1 287\nL 765.9 287\nL 765.9 314.7\nL 573.1 314.7\nL 573.1 287\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 434 283\nL 526 283\nL 526 283\nA 4 4 90.00 0 1 530 287\nL 530 339\nL 530 339\nA 4 4 90.00 0 1 526 343\nL 434 343\nL 434 343\nA 4 4 90.00 0 1 430 339\nL 430 287\nL 430 287\nA 4 4 90.00 0 1 434 283\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"434\" y=\"300\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"442\" y=\"313\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><text x=\"463\" y=\"326\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><text x=\"438\" y=\"339\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text></svg>",
 			pngCRC: 0xedd1d2b9,
 		},
 		{
@@ -757,7 +757,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.25</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110.42</text><text x=\"17\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.5</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.58</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.75</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95.83</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">92.92</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187 142\nL 187 248\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187 353\nL 187 459\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 142\nL 235 142\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 459\nL 235 459\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 248\nL 283 248\nL 283 353\nL 91 353\nL 91 248\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 142\nL 428 164\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 164\nL 428 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 142\nL 476 142\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 564\nL 476 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332 164\nL 524 164\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 37\nL 669 100\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 164\nL 669 269\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 37\nL 717 37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 269\nL 717 269\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573 100\nL 765 100\nL 765 164\nL 573 164\nL 573 100\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 433 138\nL 501 138\nL 501 138\nA 4 4 90.00 0 1 505 142\nL 505 181\nL 505 181\nA 4 4 90.00 0 1 501 185\nL 433 185\nL 433 185\nA 4 4 90.00 0 1 429 181\nL 429 142\nL 429 142\nA 4 4 90.00 0 1 433 138\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"437\" y=\"155\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"433\" y=\"168\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><text x=\"450\" y=\"181\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.25</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110.42</text><text x=\"17\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.5</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.58</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.75</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95.83</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">92.92</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187.5 141.9\nL 187.5 247.43\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187.5 352.95\nL 187.5 458.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 141.9\nL 235.7 141.9\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 458.48\nL 235.7 458.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.1 247.43\nL 283.9 247.43\nL 283.9 352.95\nL 91.1 352.95\nL 91.1 247.43\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 141.9\nL 428.5 163.01\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 163.01\nL 428.5 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 141.9\nL 476.7 141.9\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 564\nL 476.7 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332.1 163.01\nL 524.9 163.01\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 36.38\nL 669.5 99.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 163.01\nL 669.5 268.53\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 36.38\nL 717.7 36.38\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 268.53\nL 717.7 268.53\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573.1 99.7\nL 765.9 99.7\nL 765.9 163.01\nL 573.1 163.01\nL 573.1 99.7\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 434 137\nL 502 137\nL 502 137\nA 4 4 90.00 0 1 506 141\nL 506 180\nL 506 180\nA 4 4 90.00 0 1 502 184\nL 434 184\nL 434 184\nA 4 4 90.00 0 1 430 180\nL 430 141\nL 430 141\nA 4 4 90.00 0 1 434 137\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"438\" y=\"154\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"434\" y=\"167\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><text x=\"451\" y=\"180\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text></svg>",
 			pngCRC: 0xcfd8e852,
 		},
 		{
@@ -773,7 +773,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.56</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.22</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.78</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.89</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.44</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187 287\nL 187 357\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187 426\nL 187 495\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 287\nL 235 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 495\nL 235 495\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 357\nL 283 357\nL 283 426\nL 91 426\nL 91 357\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 380 149\nL 476 149\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380 426\nL 476 426\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332 149\nL 524 149\nL 524 426\nL 332 426\nL 332 149\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 669 80\nL 669 121\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 149\nL 669 218\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 80\nL 717 80\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 218\nL 717 218\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573 121\nL 765 121\nL 765 149\nL 573 149\nL 573 121\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 433 136\nL 525 136\nL 525 136\nA 4 4 90.00 0 1 529 140\nL 529 153\nL 529 153\nA 4 4 90.00 0 1 525 157\nL 433 157\nL 433 157\nA 4 4 90.00 0 1 429 153\nL 429 140\nL 429 140\nA 4 4 90.00 0 1 433 136\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"433\" y=\"153\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.56</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.22</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.78</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.89</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.44</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187.5 287\nL 187.5 356.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187.5 425.5\nL 187.5 494.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 287\nL 235.7 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 494.75\nL 235.7 494.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.1 356.25\nL 283.9 356.25\nL 283.9 425.5\nL 91.1 425.5\nL 91.1 356.25\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 380.3 148.5\nL 476.7 148.5\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 380.3 425.5\nL 476.7 425.5\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 332.1 148.5\nL 524.9 148.5\nL 524.9 425.5\nL 332.1 425.5\nL 332.1 148.5\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 669.5 79.25\nL 669.5 120.8\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 148.5\nL 669.5 217.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 79.25\nL 717.7 79.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 217.75\nL 717.7 217.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573.1 120.8\nL 765.9 120.8\nL 765.9 148.5\nL 573.1 148.5\nL 573.1 120.8\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 434 136\nL 526 136\nL 526 136\nA 4 4 90.00 0 1 530 140\nL 530 153\nL 530 153\nA 4 4 90.00 0 1 526 157\nL 434 157\nL 434 157\nA 4 4 90.00 0 1 430 153\nL 430 140\nL 430 140\nA 4 4 90.00 0 1 434 136\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"434\" y=\"153\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text></svg>",
 			pngCRC: 0xd0f5d6dc,
 		},
 		{
@@ -789,7 +789,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187 248\nL 187 327\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187 406\nL 187 485\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 248\nL 235 248\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 485\nL 235 485\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 327\nL 283 327\nL 283 406\nL 91 406\nL 91 327\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 380 90\nL 476 90\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 380 406\nL 476 406\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 332 90\nL 524 90\nL 524 406\nL 332 406\nL 332 90\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 669 327\nL 669 375\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669 406\nL 669 485\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 327\nL 717 327\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621 485\nL 717 485\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573 375\nL 765 375\nL 765 406\nL 573 406\nL 573 375\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 433 393\nL 530 393\nL 530 393\nA 4 4 90.00 0 1 534 397\nL 534 410\nL 534 410\nA 4 4 90.00 0 1 530 414\nL 433 414\nL 433 414\nA 4 4 90.00 0 1 429 410\nL 429 397\nL 429 397\nA 4 4 90.00 0 1 433 393\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"433\" y=\"410\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 308 569\nL 308 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 569\nL 549 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"183\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"665\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><path d=\"M 187.5 247.43\nL 187.5 326.57\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 187.5 405.71\nL 187.5 484.86\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 247.43\nL 235.7 247.43\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 484.86\nL 235.7 484.86\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91.1 326.57\nL 283.9 326.57\nL 283.9 405.71\nL 91.1 405.71\nL 91.1 326.57\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 380.3 89.14\nL 476.7 89.14\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 380.3 405.71\nL 476.7 405.71\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 332.1 89.14\nL 524.9 89.14\nL 524.9 405.71\nL 332.1 405.71\nL 332.1 89.14\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 669.5 326.57\nL 669.5 374.06\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 669.5 405.71\nL 669.5 484.86\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 326.57\nL 717.7 326.57\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 621.3 484.86\nL 717.7 484.86\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573.1 374.06\nL 765.9 374.06\nL 765.9 405.71\nL 573.1 405.71\nL 573.1 374.06\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 434 393\nL 531 393\nL 531 393\nA 4 4 90.00 0 1 535 397\nL 535 410\nL 535 410\nA 4 4 90.00 0 1 531 414\nL 434 414\nL 434 414\nA 4 4 90.00 0 1 430 410\nL 430 397\nL 430 397\nA 4 4 90.00 0 1 434 393\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"434\" y=\"410\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text></svg>",
 			pngCRC: 0x7bcd015c,
 		},
 		{
@@ -807,7 +807,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					EngulfingMinSize: 0.8,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 247 569\nL 247 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 609 569\nL 609 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"153\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"333\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"514\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"695\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><path d=\"M 157 248\nL 157 327\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157 406\nL 157 485\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121 248\nL 193 248\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121 485\nL 193 485\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85 327\nL 229 327\nL 229 406\nL 85 406\nL 85 327\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 337 216\nL 337 248\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 337 311\nL 337 327\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 301 216\nL 373 216\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 301 327\nL 373 327\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 265 248\nL 409 248\nL 409 311\nL 265 311\nL 265 248\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 518 169\nL 518 185\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 518 343\nL 518 359\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 482 169\nL 554 169\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 482 359\nL 554 359\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446 185\nL 590 185\nL 590 343\nL 446 343\nL 446 185\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 699 90\nL 699 121\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 699 185\nL 699 216\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 663 90\nL 735 90\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 663 216\nL 735 216\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 627 121\nL 771 121\nL 771 185\nL 627 185\nL 627 121\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 523 172\nL 614 172\nL 614 172\nA 4 4 90.00 0 1 618 176\nL 618 189\nL 618 189\nA 4 4 90.00 0 1 614 193\nL 523 193\nL 523 193\nA 4 4 90.00 0 1 519 189\nL 519 176\nL 519 176\nA 4 4 90.00 0 1 523 172\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"523\" y=\"189\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">117.22</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.44</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101.67</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.78</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">93.89</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 247 569\nL 247 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 609 569\nL 609 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"153\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"333\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"514\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"695\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><path d=\"M 157.38 247.43\nL 157.38 326.57\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157.38 405.71\nL 157.38 484.86\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121.22 247.43\nL 193.53 247.43\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121.22 484.86\nL 193.53 484.86\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85.08 326.57\nL 229.68 326.57\nL 229.68 405.71\nL 85.08 405.71\nL 85.08 326.57\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 338.12 215.77\nL 338.12 247.43\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 338.12 310.74\nL 338.12 326.57\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 301.98 215.77\nL 374.27 215.77\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 301.98 326.57\nL 374.27 326.57\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 265.82 247.43\nL 410.43 247.43\nL 410.43 310.74\nL 265.82 310.74\nL 265.82 247.43\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 518.88 168.29\nL 518.88 184.11\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 518.88 342.4\nL 518.88 358.23\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 482.73 168.29\nL 555.02 168.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 482.73 358.23\nL 555.02 358.23\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446.57 184.11\nL 591.17 184.11\nL 591.17 342.4\nL 446.57 342.4\nL 446.57 184.11\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 699.62 89.14\nL 699.62 120.8\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 699.62 184.11\nL 699.62 215.77\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 663.48 89.14\nL 735.77 89.14\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 663.48 215.77\nL 735.77 215.77\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 627.33 120.8\nL 771.92 120.8\nL 771.92 184.11\nL 627.33 184.11\nL 627.33 120.8\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 524 171\nL 615 171\nL 615 171\nA 4 4 90.00 0 1 619 175\nL 619 188\nL 619 188\nA 4 4 90.00 0 1 615 192\nL 524 192\nL 524 192\nA 4 4 90.00 0 1 520 188\nL 520 175\nL 520 175\nA 4 4 90.00 0 1 524 171\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"524\" y=\"188\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text></svg>",
 			pngCRC: 0xd40689c8,
 		},
 		{
@@ -825,7 +825,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					EngulfingMinSize: 0.8,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.67</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111.33</text><text x=\"30\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.67</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"30\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.67</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.33</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 247 569\nL 247 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 609 569\nL 609 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"153\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"333\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"514\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"695\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><path d=\"M 157 169\nL 157 301\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157 433\nL 157 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121 169\nL 193 169\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121 564\nL 193 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85 301\nL 229 301\nL 229 433\nL 85 433\nL 85 301\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 337 116\nL 337 169\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 337 274\nL 337 301\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 301 116\nL 373 116\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 301 301\nL 373 301\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 265 169\nL 409 169\nL 409 274\nL 265 274\nL 265 169\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 518 37\nL 518 63\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 518 327\nL 518 353\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482 37\nL 554 37\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482 353\nL 554 353\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 446 63\nL 590 63\nL 590 327\nL 446 327\nL 446 63\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 699 222\nL 699 327\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 699 380\nL 699 433\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 663 222\nL 735 222\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 663 433\nL 735 433\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 627 327\nL 771 327\nL 771 380\nL 627 380\nL 627 327\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 523 314\nL 618 314\nL 618 314\nA 4 4 90.00 0 1 622 318\nL 622 331\nL 622 331\nA 4 4 90.00 0 1 618 335\nL 523 335\nL 523 335\nA 4 4 90.00 0 1 519 331\nL 519 318\nL 519 318\nA 4 4 90.00 0 1 523 314\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"523\" y=\"331\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V Bear Engulfing</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.67</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111.33</text><text x=\"30\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.67</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"30\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.67</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97.33</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">95</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 247 569\nL 247 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 609 569\nL 609 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"153\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"333\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"514\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"695\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><path d=\"M 157.38 168.29\nL 157.38 300.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157.38 432.1\nL 157.38 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121.22 168.29\nL 193.53 168.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121.22 564\nL 193.53 564\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85.08 300.19\nL 229.68 300.19\nL 229.68 432.1\nL 85.08 432.1\nL 85.08 300.19\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 338.12 115.52\nL 338.12 168.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 338.12 273.81\nL 338.12 300.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 301.98 115.52\nL 374.27 115.52\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 301.98 300.19\nL 374.27 300.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 265.82 168.29\nL 410.43 168.29\nL 410.43 273.81\nL 265.82 273.81\nL 265.82 168.29\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 518.88 36.38\nL 518.88 62.76\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 518.88 326.57\nL 518.88 352.95\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482.73 36.38\nL 555.02 36.38\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482.73 352.95\nL 555.02 352.95\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 446.57 62.76\nL 591.17 62.76\nL 591.17 326.57\nL 446.57 326.57\nL 446.57 62.76\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 699.62 221.05\nL 699.62 326.57\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 699.62 379.33\nL 699.62 432.1\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 663.48 221.05\nL 735.77 221.05\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 663.48 432.1\nL 735.77 432.1\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 627.33 326.57\nL 771.92 326.57\nL 771.92 379.33\nL 627.33 379.33\nL 627.33 326.57\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 524 314\nL 619 314\nL 619 314\nA 4 4 90.00 0 1 623 318\nL 623 331\nL 623 331\nA 4 4 90.00 0 1 619 335\nL 524 335\nL 524 335\nA 4 4 90.00 0 1 520 331\nL 520 318\nL 520 318\nA 4 4 90.00 0 1 524 314\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"524\" y=\"331\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V Bear Engulfing</text></svg>",
 			pngCRC: 0xa1cca1bc,
 		},
 		{
@@ -843,7 +843,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"135\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"279\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"568\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"713\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><path d=\"M 139 307\nL 139 371\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 436\nL 139 500\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 307\nL 167 307\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 500\nL 167 500\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 82 371\nL 196 371\nL 196 436\nL 82 436\nL 82 371\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283 114\nL 283 178\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 283 333\nL 283 371\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 255 114\nL 311 114\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 255 371\nL 311 371\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 226 178\nL 340 178\nL 340 333\nL 226 333\nL 226 178\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 428 384\nL 428 397\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 410\nL 428 436\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 384\nL 456 384\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 436\nL 456 436\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 371 397\nL 485 397\nL 485 410\nL 371 410\nL 371 397\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 572 114\nL 572 152\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 572 333\nL 572 358\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 544 114\nL 600 114\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 544 358\nL 600 358\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 515 152\nL 629 152\nL 629 333\nL 515 333\nL 515 152\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 717 75\nL 717 114\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717 152\nL 717 178\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 75\nL 745 75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 178\nL 745 178\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 660 114\nL 774 114\nL 774 152\nL 660 152\nL 660 114\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 577 139\nL 661 139\nL 661 139\nA 4 4 90.00 0 1 665 143\nL 665 156\nL 665 156\nA 4 4 90.00 0 1 661 160\nL 577 160\nL 577 160\nA 4 4 90.00 0 1 573 156\nL 573 143\nL 573 143\nA 4 4 90.00 0 1 577 139\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"577\" y=\"156\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"135\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"279\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"568\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"713\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><path d=\"M 139.3 306.33\nL 139.3 370.74\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 435.16\nL 139.3 499.58\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 306.33\nL 168.22 306.33\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 499.58\nL 168.22 499.58\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 81.46 370.74\nL 197.14 370.74\nL 197.14 435.16\nL 81.46 435.16\nL 81.46 370.74\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283.9 113.07\nL 283.9 177.49\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 283.9 332.09\nL 283.9 370.74\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 254.98 113.07\nL 312.82 113.07\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 254.98 370.74\nL 312.82 370.74\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 226.06 177.49\nL 341.74 177.49\nL 341.74 332.09\nL 226.06 332.09\nL 226.06 177.49\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 428.5 383.63\nL 428.5 396.51\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 409.4\nL 428.5 435.16\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 383.63\nL 457.42 383.63\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 435.16\nL 457.42 435.16\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 370.66 396.51\nL 486.34 396.51\nL 486.34 409.4\nL 370.66 409.4\nL 370.66 396.51\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 573.1 113.07\nL 573.1 151.72\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 573.1 332.09\nL 573.1 357.86\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 544.18 113.07\nL 602.02 113.07\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 544.18 357.86\nL 602.02 357.86\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 515.26 151.72\nL 630.94 151.72\nL 630.94 332.09\nL 515.26 332.09\nL 515.26 151.72\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 717.7 74.42\nL 717.7 113.07\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717.7 151.72\nL 717.7 177.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 74.42\nL 746.62 74.42\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 177.49\nL 746.62 177.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 659.86 113.07\nL 775.54 113.07\nL 775.54 151.72\nL 659.86 151.72\nL 659.86 113.07\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 578 139\nL 662 139\nL 662 139\nA 4 4 90.00 0 1 666 143\nL 666 156\nL 666 156\nA 4 4 90.00 0 1 662 160\nL 578 160\nL 578 160\nA 4 4 90.00 0 1 574 156\nL 574 143\nL 574 143\nA 4 4 90.00 0 1 578 139\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"578\" y=\"156\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text></svg>",
 			pngCRC: 0x64e44680,
 		},
 		{
@@ -861,7 +861,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">149</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">142.44</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">135.89</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">129.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122.78</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.22</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.67</text><text x=\"9\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.11</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.56</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"135\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"279\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"568\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"713\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><path d=\"M 139 377\nL 139 424\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139 471\nL 139 518\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 377\nL 167 377\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 111 518\nL 167 518\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 82 424\nL 196 424\nL 196 471\nL 82 471\nL 82 424\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283 95\nL 283 114\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283 264\nL 283 283\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 95\nL 311 95\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 283\nL 311 283\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226 114\nL 340 114\nL 340 264\nL 226 264\nL 226 114\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 57\nL 428 67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 76\nL 428 95\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 57\nL 456 57\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 400 95\nL 456 95\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 371 67\nL 485 67\nL 485 76\nL 371 76\nL 371 67\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 572 95\nL 572 114\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 572 302\nL 572 330\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 95\nL 600 95\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544 330\nL 600 330\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515 114\nL 629 114\nL 629 302\nL 515 302\nL 515 114\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717 264\nL 717 283\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717 302\nL 717 330\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 264\nL 745 264\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 689 330\nL 745 330\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 660 283\nL 774 283\nL 774 302\nL 660 302\nL 660 283\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 577 289\nL 659 289\nL 659 289\nA 4 4 90.00 0 1 663 293\nL 663 306\nL 663 306\nA 4 4 90.00 0 1 659 310\nL 577 310\nL 577 310\nA 4 4 90.00 0 1 573 306\nL 573 293\nL 573 293\nA 4 4 90.00 0 1 577 289\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"577\" y=\"306\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">⁎ Evening Star</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">149</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">142.44</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">135.89</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">129.33</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122.78</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.22</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.67</text><text x=\"9\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.11</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.56</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 569\nL 211 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 356 569\nL 356 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 500 569\nL 500 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 645 569\nL 645 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"135\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"279\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"424\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"568\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"713\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><path d=\"M 139.3 376.2\nL 139.3 423.15\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 139.3 470.1\nL 139.3 517.05\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 376.2\nL 168.22 376.2\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 110.38 517.05\nL 168.22 517.05\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 81.46 423.15\nL 197.14 423.15\nL 197.14 470.1\nL 81.46 470.1\nL 81.46 423.15\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283.9 94.51\nL 283.9 113.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283.9 263.53\nL 283.9 282.31\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 94.51\nL 312.82 94.51\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 254.98 282.31\nL 312.82 282.31\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 226.06 113.29\nL 341.74 113.29\nL 341.74 263.53\nL 226.06 263.53\nL 226.06 113.29\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 56.95\nL 428.5 66.34\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 75.73\nL 428.5 94.51\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 56.95\nL 457.42 56.95\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 399.58 94.51\nL 457.42 94.51\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 370.66 66.34\nL 486.34 66.34\nL 486.34 75.73\nL 370.66 75.73\nL 370.66 66.34\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 573.1 94.51\nL 573.1 113.29\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 573.1 301.08\nL 573.1 329.25\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 94.51\nL 602.02 94.51\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 544.18 329.25\nL 602.02 329.25\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 515.26 113.29\nL 630.94 113.29\nL 630.94 301.08\nL 515.26 301.08\nL 515.26 113.29\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 717.7 263.53\nL 717.7 282.31\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717.7 301.08\nL 717.7 329.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 263.53\nL 746.62 263.53\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.78 329.25\nL 746.62 329.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 659.86 282.31\nL 775.54 282.31\nL 775.54 301.08\nL 659.86 301.08\nL 659.86 282.31\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 578 288\nL 660 288\nL 660 288\nA 4 4 90.00 0 1 664 292\nL 664 305\nL 664 305\nA 4 4 90.00 0 1 660 309\nL 578 309\nL 578 309\nA 4 4 90.00 0 1 574 305\nL 574 292\nL 574 292\nA 4 4 90.00 0 1 578 288\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"578\" y=\"305\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">⁎ Evening Star</text></svg>",
 			pngCRC: 0x2047908e,
 		},
 		{
@@ -878,7 +878,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.56</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.22</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.78</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.89</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.44</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 247 569\nL 247 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 609 569\nL 609 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"153\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"333\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"514\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"695\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><path d=\"M 157 287\nL 157 357\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157 426\nL 157 495\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121 287\nL 193 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121 495\nL 193 495\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85 357\nL 229 357\nL 229 426\nL 85 426\nL 85 357\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 337 135\nL 337 149\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 301 135\nL 373 135\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 301 218\nL 373 218\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 265 149\nL 409 149\nL 409 218\nL 265 218\nL 265 149\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 518 163\nL 518 177\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 518 260\nL 518 274\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 482 163\nL 554 163\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 482 274\nL 554 274\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446 177\nL 590 177\nL 590 260\nL 446 260\nL 446 177\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 699 80\nL 699 121\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 699 177\nL 699 204\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 663 80\nL 735 80\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 663 204\nL 735 204\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 627 121\nL 771 121\nL 771 177\nL 627 177\nL 627 121\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 523 164\nL 604 164\nL 604 164\nA 4 4 90.00 0 1 608 168\nL 608 181\nL 608 181\nA 4 4 90.00 0 1 604 185\nL 523 185\nL 523 185\nA 4 4 90.00 0 1 519 181\nL 519 168\nL 519 168\nA 4 4 90.00 0 1 523 164\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"523\" y=\"181\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">| Piercing Line</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125.56</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">121.11</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112.22</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">107.78</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.89</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.44</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 247 569\nL 247 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 609 569\nL 609 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"153\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"333\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"514\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"695\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><path d=\"M 157.38 287\nL 157.38 356.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157.38 425.5\nL 157.38 494.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121.22 287\nL 193.53 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121.22 494.75\nL 193.53 494.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85.08 356.25\nL 229.68 356.25\nL 229.68 425.5\nL 85.08 425.5\nL 85.08 356.25\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 338.12 134.65\nL 338.12 148.5\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 301.98 134.65\nL 374.27 134.65\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 301.98 217.75\nL 374.27 217.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 265.82 148.5\nL 410.43 148.5\nL 410.43 217.75\nL 265.82 217.75\nL 265.82 148.5\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 518.88 162.35\nL 518.88 176.2\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 518.88 259.3\nL 518.88 273.15\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 482.73 162.35\nL 555.02 162.35\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 482.73 273.15\nL 555.02 273.15\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446.57 176.2\nL 591.17 176.2\nL 591.17 259.3\nL 446.57 259.3\nL 446.57 176.2\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 699.62 79.25\nL 699.62 120.8\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 699.62 176.2\nL 699.62 203.9\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 663.48 79.25\nL 735.77 79.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 663.48 203.9\nL 735.77 203.9\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 627.33 120.8\nL 771.92 120.8\nL 771.92 176.2\nL 627.33 176.2\nL 627.33 120.8\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 524 163\nL 605 163\nL 605 163\nA 4 4 90.00 0 1 609 167\nL 609 180\nL 609 180\nA 4 4 90.00 0 1 605 184\nL 524 184\nL 524 184\nA 4 4 90.00 0 1 520 180\nL 520 167\nL 520 167\nA 4 4 90.00 0 1 524 163\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"524\" y=\"180\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">| Piercing Line</text></svg>",
 			pngCRC: 0x957f0bb4,
 		},
 		{
@@ -895,7 +895,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 					ShadowRatio:   2.0,
 				})
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 247 569\nL 247 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 609 569\nL 609 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"153\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"333\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"514\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"695\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><path d=\"M 157 307\nL 157 371\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157 436\nL 157 500\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121 307\nL 193 307\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121 500\nL 193 500\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85 371\nL 229 371\nL 229 436\nL 85 436\nL 85 371\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 337 204\nL 337 217\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 301 114\nL 373 114\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 301 217\nL 373 217\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 265 114\nL 409 114\nL 409 204\nL 265 204\nL 265 114\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 518 75\nL 518 88\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 518 165\nL 518 178\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482 75\nL 554 75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482 178\nL 554 178\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 446 88\nL 590 88\nL 590 165\nL 446 165\nL 446 88\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 699 126\nL 699 165\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 699 178\nL 699 204\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 663 126\nL 735 126\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 663 204\nL 735 204\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 627 165\nL 771 165\nL 771 178\nL 627 178\nL 627 165\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 523 152\nL 597 152\nL 597 152\nA 4 4 90.00 0 1 601 156\nL 601 169\nL 601 169\nA 4 4 90.00 0 1 597 173\nL 523 173\nL 523 173\nA 4 4 90.00 0 1 519 169\nL 519 156\nL 519 156\nA 4 4 90.00 0 1 523 152\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"523\" y=\"169\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"506\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 71\nL 790 71\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 133\nL 790 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 194\nL 790 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 256\nL 790 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 317\nL 790 317\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 379\nL 790 379\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 440\nL 790 440\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 502\nL 790 502\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 67 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 67 569\nL 67 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 247 569\nL 247 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 428 569\nL 428 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 609 569\nL 609 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"153\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"333\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"514\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"695\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><path d=\"M 157.38 306.33\nL 157.38 370.74\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157.38 435.16\nL 157.38 499.58\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121.22 306.33\nL 193.53 306.33\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 121.22 499.58\nL 193.53 499.58\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85.08 370.74\nL 229.68 370.74\nL 229.68 435.16\nL 85.08 435.16\nL 85.08 370.74\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 338.12 203.26\nL 338.12 216.14\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 301.98 113.07\nL 374.27 113.07\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 301.98 216.14\nL 374.27 216.14\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 265.82 113.07\nL 410.43 113.07\nL 410.43 203.26\nL 265.82 203.26\nL 265.82 113.07\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 518.88 74.42\nL 518.88 87.3\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 518.88 164.6\nL 518.88 177.49\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482.73 74.42\nL 555.02 74.42\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482.73 177.49\nL 555.02 177.49\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 446.57 87.3\nL 591.17 87.3\nL 591.17 164.6\nL 446.57 164.6\nL 446.57 87.3\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 699.62 125.95\nL 699.62 164.6\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 699.62 177.49\nL 699.62 203.26\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 663.48 125.95\nL 735.77 125.95\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 663.48 203.26\nL 735.77 203.26\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 627.33 164.6\nL 771.92 164.6\nL 771.92 177.49\nL 627.33 177.49\nL 627.33 164.6\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 524 152\nL 598 152\nL 598 152\nA 4 4 90.00 0 1 602 156\nL 602 169\nL 602 169\nA 4 4 90.00 0 1 598 173\nL 524 173\nL 524 173\nA 4 4 90.00 0 1 520 169\nL 520 156\nL 520 156\nA 4 4 90.00 0 1 524 152\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"524\" y=\"169\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text></svg>",
 			pngCRC: 0x1f2e9bc3,
 		},
 		{
@@ -918,7 +918,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 				opt.XAxis = XAxisOption{Show: Ptr(false)}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 118 321\nL 118 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 118 456\nL 118 523\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 98 321\nL 138 321\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 98 523\nL 138 523\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 77 388\nL 159 388\nL 159 456\nL 77 456\nL 77 388\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 221 294\nL 221 321\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 221 375\nL 221 388\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 201 294\nL 241 294\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 201 388\nL 241 388\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 180 321\nL 262 321\nL 262 375\nL 180 375\nL 180 321\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 324 253\nL 324 267\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 324 402\nL 324 415\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304 253\nL 344 253\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304 415\nL 344 415\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283 267\nL 365 267\nL 365 402\nL 283 402\nL 283 267\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 118\nL 428 186\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 428 348\nL 428 388\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 408 118\nL 448 118\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 408 388\nL 448 388\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 387 186\nL 469 186\nL 469 348\nL 387 348\nL 387 186\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 531 402\nL 531 415\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 531 429\nL 531 456\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 511 402\nL 551 402\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 511 456\nL 551 456\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 490 415\nL 572 415\nL 572 429\nL 490 429\nL 490 415\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 634 118\nL 634 159\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 634 348\nL 634 375\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 614 118\nL 654 118\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 614 375\nL 654 375\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 593 159\nL 675 159\nL 675 348\nL 593 348\nL 593 159\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 738 78\nL 738 118\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 738 159\nL 738 186\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 718 78\nL 758 78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 718 186\nL 758 186\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 697 118\nL 779 118\nL 779 159\nL 697 159\nL 697 118\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 329 254\nL 420 254\nL 420 254\nA 4 4 90.00 0 1 424 258\nL 424 271\nL 424 271\nA 4 4 90.00 0 1 420 275\nL 329 275\nL 329 275\nA 4 4 90.00 0 1 325 271\nL 325 258\nL 325 258\nA 4 4 90.00 0 1 329 254\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"329\" y=\"271\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 433 335\nL 507 335\nL 507 335\nA 4 4 90.00 0 1 511 339\nL 511 352\nL 511 352\nA 4 4 90.00 0 1 507 356\nL 433 356\nL 433 356\nA 4 4 90.00 0 1 429 352\nL 429 339\nL 429 339\nA 4 4 90.00 0 1 433 335\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"433\" y=\"352\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text><path d=\"M 639 146\nL 723 146\nL 723 146\nA 4 4 90.00 0 1 727 150\nL 727 163\nL 727 163\nA 4 4 90.00 0 1 723 167\nL 639 167\nL 639 167\nA 4 4 90.00 0 1 635 163\nL 635 150\nL 635 150\nA 4 4 90.00 0 1 639 146\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"639\" y=\"163\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 118.64 320.23\nL 118.64 387.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 118.64 455.12\nL 118.64 522.56\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 97.99 320.23\nL 139.3 320.23\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 97.99 522.56\nL 139.3 522.56\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 77.33 387.67\nL 159.96 387.67\nL 159.96 455.12\nL 77.33 455.12\nL 77.33 387.67\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 221.93 293.26\nL 221.93 320.23\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 221.93 374.19\nL 221.93 387.67\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 201.27 293.26\nL 242.59 293.26\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 201.27 387.67\nL 242.59 387.67\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 180.61 320.23\nL 263.24 320.23\nL 263.24 374.19\nL 180.61 374.19\nL 180.61 320.23\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 325.21 252.79\nL 325.21 266.28\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 325.21 401.16\nL 325.21 414.65\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304.56 252.79\nL 345.87 252.79\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304.56 414.65\nL 345.87 414.65\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 283.9 266.28\nL 366.53 266.28\nL 366.53 401.16\nL 283.9 401.16\nL 283.9 266.28\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 117.91\nL 428.5 185.35\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 428.5 347.21\nL 428.5 387.67\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 407.84 117.91\nL 449.16 117.91\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 407.84 387.67\nL 449.16 387.67\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 387.19 185.35\nL 469.81 185.35\nL 469.81 347.21\nL 387.19 347.21\nL 387.19 185.35\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 531.79 401.16\nL 531.79 414.65\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 531.79 428.14\nL 531.79 455.12\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 511.13 401.16\nL 552.44 401.16\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 511.13 455.12\nL 552.44 455.12\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 490.47 414.65\nL 573.1 414.65\nL 573.1 428.14\nL 490.47 428.14\nL 490.47 414.65\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 635.07 117.91\nL 635.07 158.37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 635.07 347.21\nL 635.07 374.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 614.41 117.91\nL 655.73 117.91\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 614.41 374.19\nL 655.73 374.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 593.76 158.37\nL 676.39 158.37\nL 676.39 347.21\nL 593.76 347.21\nL 593.76 158.37\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 738.36 77.44\nL 738.36 117.91\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 738.36 158.37\nL 738.36 185.35\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717.7 77.44\nL 759.01 77.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 717.7 185.35\nL 759.01 185.35\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 697.04 117.91\nL 779.67 117.91\nL 779.67 158.37\nL 697.04 158.37\nL 697.04 117.91\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 330 253\nL 421 253\nL 421 253\nA 4 4 90.00 0 1 425 257\nL 425 270\nL 425 270\nA 4 4 90.00 0 1 421 274\nL 330 274\nL 330 274\nA 4 4 90.00 0 1 326 270\nL 326 257\nL 326 257\nA 4 4 90.00 0 1 330 253\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"330\" y=\"270\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 434 334\nL 508 334\nL 508 334\nA 4 4 90.00 0 1 512 338\nL 512 351\nL 512 351\nA 4 4 90.00 0 1 508 355\nL 434 355\nL 434 355\nA 4 4 90.00 0 1 430 351\nL 430 338\nL 430 338\nA 4 4 90.00 0 1 434 334\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"434\" y=\"351\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text><path d=\"M 640 145\nL 724 145\nL 724 145\nA 4 4 90.00 0 1 728 149\nL 728 162\nL 728 162\nA 4 4 90.00 0 1 724 166\nL 640 166\nL 640 166\nA 4 4 90.00 0 1 636 162\nL 636 149\nL 636 149\nA 4 4 90.00 0 1 640 145\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"640\" y=\"162\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text></svg>",
 			pngCRC: 0x9fdbf9bb,
 		},
 		{
@@ -953,7 +953,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 				opt.XAxis = XAxisOption{Show: Ptr(false)}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126.75</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122.67</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.58</text><text x=\"17\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114.5</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110.42</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.33</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102.25</text><text x=\"17\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.17</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.08</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 97 275\nL 97 354\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 97 433\nL 97 512\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85 275\nL 109 275\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85 512\nL 109 512\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 73 354\nL 121 354\nL 121 433\nL 73 433\nL 73 354\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 157 306\nL 157 353\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157 354\nL 157 401\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 145 306\nL 169 306\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 145 401\nL 169 401\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 133 353\nL 181 353\nL 181 354\nL 133 354\nL 133 353\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 217 322\nL 217 338\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 217 354\nL 217 512\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 205 322\nL 229 322\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 205 512\nL 229 512\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 193 338\nL 241 338\nL 241 354\nL 193 354\nL 193 338\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 277 38\nL 277 243\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 277 275\nL 277 512\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 265 38\nL 289 38\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 265 512\nL 289 512\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 253 243\nL 301 243\nL 301 275\nL 253 275\nL 253 243\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 326 117\nL 350 117\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 326 433\nL 350 433\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 314 117\nL 362 117\nL 362 433\nL 314 433\nL 314 117\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 386 117\nL 410 117\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 386 433\nL 410 433\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 374 117\nL 422 117\nL 422 433\nL 374 433\nL 374 117\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 458 243\nL 458 275\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 458 338\nL 458 354\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 446 243\nL 470 243\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 446 354\nL 470 354\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 434 275\nL 482 275\nL 482 338\nL 434 338\nL 434 275\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 518 196\nL 518 212\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 518 370\nL 518 385\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 506 196\nL 530 196\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 506 385\nL 530 385\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 494 212\nL 542 212\nL 542 370\nL 494 370\nL 494 212\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 579 38\nL 579 322\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 579 338\nL 579 354\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 567 38\nL 591 38\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 567 354\nL 591 354\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 555 322\nL 603 322\nL 603 338\nL 555 338\nL 555 322\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 639 275\nL 639 291\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 639 292\nL 639 590\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 627 275\nL 651 275\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 627 590\nL 651 590\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 615 291\nL 663 291\nL 663 292\nL 615 292\nL 615 291\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 699 117\nL 699 305\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 699 306\nL 699 322\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 687 117\nL 711 117\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 687 322\nL 711 322\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 675 305\nL 723 305\nL 723 306\nL 675 306\nL 675 305\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 759 196\nL 759 275\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 759 306\nL 759 385\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 747 196\nL 771 196\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 747 385\nL 771 385\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 735 275\nL 783 275\nL 783 306\nL 735 306\nL 735 275\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 222 325\nL 282 325\nL 282 325\nA 4 4 90.00 0 1 286 329\nL 286 342\nL 286 342\nA 4 4 90.00 0 1 282 346\nL 222 346\nL 222 346\nA 4 4 90.00 0 1 218 342\nL 218 329\nL 218 329\nA 4 4 90.00 0 1 222 325\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"222\" y=\"342\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><path d=\"M 343 104\nL 435 104\nL 435 104\nA 4 4 90.00 0 1 439 108\nL 439 121\nL 439 121\nA 4 4 90.00 0 1 435 125\nL 343 125\nL 343 125\nA 4 4 90.00 0 1 339 121\nL 339 108\nL 339 108\nA 4 4 90.00 0 1 343 104\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"343\" y=\"121\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text><path d=\"M 403 420\nL 500 420\nL 500 420\nA 4 4 90.00 0 1 504 424\nL 504 437\nL 504 437\nA 4 4 90.00 0 1 500 441\nL 403 441\nL 403 441\nA 4 4 90.00 0 1 399 437\nL 399 424\nL 399 424\nA 4 4 90.00 0 1 403 420\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"403\" y=\"437\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text><path d=\"M 523 199\nL 614 199\nL 614 199\nA 4 4 90.00 0 1 618 203\nL 618 216\nL 618 216\nA 4 4 90.00 0 1 614 220\nL 523 220\nL 523 220\nA 4 4 90.00 0 1 519 216\nL 519 203\nL 519 203\nA 4 4 90.00 0 1 523 199\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"523\" y=\"216\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 584 303\nL 676 303\nL 676 303\nA 4 4 90.00 0 1 680 307\nL 680 333\nL 680 333\nA 4 4 90.00 0 1 676 337\nL 584 337\nL 584 337\nA 4 4 90.00 0 1 580 333\nL 580 307\nL 580 307\nA 4 4 90.00 0 1 584 303\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"584\" y=\"320\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"588\" y=\"333\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text><path d=\"M 644 273\nL 712 273\nL 712 273\nA 4 4 90.00 0 1 716 277\nL 716 303\nL 716 303\nA 4 4 90.00 0 1 712 307\nL 644 307\nL 644 307\nA 4 4 90.00 0 1 640 303\nL 640 277\nL 640 277\nA 4 4 90.00 0 1 644 273\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"648\" y=\"290\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"644\" y=\"303\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><path d=\"M 704 279\nL 796 279\nL 796 279\nA 4 4 90.00 0 1 800 283\nL 800 322\nL 800 322\nA 4 4 90.00 0 1 796 326\nL 704 326\nL 704 326\nA 4 4 90.00 0 1 700 322\nL 700 283\nL 700 283\nA 4 4 90.00 0 1 704 279\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"704\" y=\"296\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"712\" y=\"309\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><text x=\"708\" y=\"322\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126.75</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122.67</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.58</text><text x=\"17\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114.5</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110.42</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106.33</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102.25</text><text x=\"17\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">98.17</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.08</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 97.12 274.35\nL 97.12 353.27\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 97.12 432.18\nL 97.12 511.09\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85.08 274.35\nL 109.17 274.35\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 85.08 511.09\nL 109.17 511.09\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 73.03 353.27\nL 121.22 353.27\nL 121.22 432.18\nL 73.03 432.18\nL 73.03 353.27\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 157.38 305.92\nL 157.38 352.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 157.38 353.27\nL 157.38 400.61\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 145.32 305.92\nL 169.43 305.92\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 145.32 400.61\nL 169.43 400.61\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 133.28 352.48\nL 181.47 352.48\nL 181.47 353.27\nL 133.28 353.27\nL 133.28 352.48\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 217.62 321.7\nL 217.62 337.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 217.62 353.27\nL 217.62 511.09\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 205.57 321.7\nL 229.68 321.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 205.57 511.09\nL 229.68 511.09\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 193.53 337.48\nL 241.72 337.48\nL 241.72 353.27\nL 193.53 353.27\nL 193.53 337.48\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 277.88 37.62\nL 277.88 242.79\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 277.88 274.35\nL 277.88 511.09\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 265.82 37.62\nL 289.93 37.62\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 265.82 511.09\nL 289.93 511.09\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 253.78 242.79\nL 301.98 242.79\nL 301.98 274.35\nL 253.78 274.35\nL 253.78 242.79\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 326.07 116.53\nL 350.18 116.53\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 326.07 432.18\nL 350.18 432.18\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 314.02 116.53\nL 362.23 116.53\nL 362.23 432.18\nL 314.02 432.18\nL 314.02 116.53\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 386.32 116.53\nL 410.43 116.53\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 386.32 432.18\nL 410.43 432.18\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 374.27 116.53\nL 422.48 116.53\nL 422.48 432.18\nL 374.27 432.18\nL 374.27 116.53\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 458.62 242.79\nL 458.62 274.35\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 458.62 337.48\nL 458.62 353.27\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 446.57 242.79\nL 470.68 242.79\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 446.57 353.27\nL 470.68 353.27\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 434.52 274.35\nL 482.73 274.35\nL 482.73 337.48\nL 434.52 337.48\nL 434.52 274.35\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 518.88 195.44\nL 518.88 211.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 518.88 369.05\nL 518.88 384.83\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 506.82 195.44\nL 530.92 195.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 506.82 384.83\nL 530.92 384.83\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 494.77 211.22\nL 542.98 211.22\nL 542.98 369.05\nL 494.77 369.05\nL 494.77 211.22\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 579.12 37.62\nL 579.12 321.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 579.12 337.48\nL 579.12 353.27\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 567.08 37.62\nL 591.17 37.62\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 567.08 353.27\nL 591.17 353.27\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 555.02 321.7\nL 603.23 321.7\nL 603.23 337.48\nL 555.02 337.48\nL 555.02 321.7\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 639.38 274.35\nL 639.38 290.14\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 639.38 291.71\nL 639.38 590\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 627.33 274.35\nL 651.42 274.35\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 627.33 590\nL 651.42 590\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 615.27 290.14\nL 663.48 290.14\nL 663.48 291.71\nL 615.27 291.71\nL 615.27 290.14\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 699.62 116.53\nL 699.62 304.34\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 699.62 305.92\nL 699.62 321.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 687.58 116.53\nL 711.67 116.53\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 687.58 321.7\nL 711.67 321.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 675.52 304.34\nL 723.73 304.34\nL 723.73 305.92\nL 675.52 305.92\nL 675.52 304.34\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 759.88 195.44\nL 759.88 274.35\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 759.88 305.92\nL 759.88 384.83\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 747.83 195.44\nL 771.92 195.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 747.83 384.83\nL 771.92 384.83\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 735.77 274.35\nL 783.98 274.35\nL 783.98 305.92\nL 735.77 305.92\nL 735.77 274.35\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 223 324\nL 283 324\nL 283 324\nA 4 4 90.00 0 1 287 328\nL 287 341\nL 287 341\nA 4 4 90.00 0 1 283 345\nL 223 345\nL 223 345\nA 4 4 90.00 0 1 219 341\nL 219 328\nL 219 328\nA 4 4 90.00 0 1 223 324\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"223\" y=\"341\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><path d=\"M 343 104\nL 435 104\nL 435 104\nA 4 4 90.00 0 1 439 108\nL 439 121\nL 439 121\nA 4 4 90.00 0 1 435 125\nL 343 125\nL 343 125\nA 4 4 90.00 0 1 339 121\nL 339 108\nL 339 108\nA 4 4 90.00 0 1 343 104\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"343\" y=\"121\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text><path d=\"M 403 419\nL 500 419\nL 500 419\nA 4 4 90.00 0 1 504 423\nL 504 436\nL 504 436\nA 4 4 90.00 0 1 500 440\nL 403 440\nL 403 440\nA 4 4 90.00 0 1 399 436\nL 399 423\nL 399 423\nA 4 4 90.00 0 1 403 419\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"403\" y=\"436\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text><path d=\"M 524 198\nL 615 198\nL 615 198\nA 4 4 90.00 0 1 619 202\nL 619 215\nL 619 215\nA 4 4 90.00 0 1 615 219\nL 524 219\nL 524 219\nA 4 4 90.00 0 1 520 215\nL 520 202\nL 520 202\nA 4 4 90.00 0 1 524 198\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"524\" y=\"215\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 584 303\nL 676 303\nL 676 303\nA 4 4 90.00 0 1 680 307\nL 680 333\nL 680 333\nA 4 4 90.00 0 1 676 337\nL 584 337\nL 584 337\nA 4 4 90.00 0 1 580 333\nL 580 307\nL 580 307\nA 4 4 90.00 0 1 584 303\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"584\" y=\"320\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"588\" y=\"333\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text><path d=\"M 644 273\nL 712 273\nL 712 273\nA 4 4 90.00 0 1 716 277\nL 716 303\nL 716 303\nA 4 4 90.00 0 1 712 307\nL 644 307\nL 644 307\nA 4 4 90.00 0 1 640 303\nL 640 277\nL 640 277\nA 4 4 90.00 0 1 644 273\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"648\" y=\"290\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"644\" y=\"303\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><path d=\"M 705 278\nL 797 278\nL 797 278\nA 4 4 90.00 0 1 801 282\nL 801 321\nL 801 321\nA 4 4 90.00 0 1 797 325\nL 705 325\nL 705 325\nA 4 4 90.00 0 1 701 321\nL 701 282\nL 701 282\nA 4 4 90.00 0 1 705 278\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"705\" y=\"295\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"713\" y=\"308\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><text x=\"709\" y=\"321\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text></svg>",
 			pngCRC: 0x95ca56a0,
 		},
 		{
@@ -989,7 +989,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">149</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">142.44</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">135.89</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">129.33</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122.78</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.22</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.67</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.11</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.56</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 94 394\nL 94 443\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 94 492\nL 94 541\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 83 394\nL 105 394\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 83 541\nL 105 541\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 72 443\nL 116 443\nL 116 492\nL 72 492\nL 72 443\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 150 246\nL 150 296\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 150 414\nL 150 443\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 139 246\nL 161 246\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 139 443\nL 161 443\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 128 296\nL 172 296\nL 172 414\nL 128 414\nL 128 296\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 205 453\nL 205 463\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 205 473\nL 205 492\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 194 453\nL 216 453\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 194 492\nL 216 492\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 183 463\nL 227 463\nL 227 473\nL 183 473\nL 183 463\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 261 246\nL 261 276\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 261 414\nL 261 433\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 250 246\nL 272 246\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 250 433\nL 272 433\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 239 276\nL 283 276\nL 283 414\nL 239 414\nL 239 276\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 317 345\nL 317 355\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 317 394\nL 317 404\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 306 345\nL 328 345\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 306 404\nL 328 404\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 295 355\nL 339 355\nL 339 394\nL 295 394\nL 295 355\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 372 315\nL 372 325\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 372 364\nL 372 374\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 361 315\nL 383 315\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 361 374\nL 383 374\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 350 325\nL 394 325\nL 394 364\nL 350 364\nL 350 325\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428 286\nL 428 296\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428 335\nL 428 345\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 417 286\nL 439 286\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 417 345\nL 439 345\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 406 296\nL 450 296\nL 450 335\nL 406 335\nL 406 296\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 483 99\nL 483 119\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 483 276\nL 483 296\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 472 99\nL 494 99\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 472 296\nL 494 296\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 461 119\nL 505 119\nL 505 276\nL 461 276\nL 461 119\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 539 60\nL 539 69\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 539 79\nL 539 99\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 528 60\nL 550 60\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 528 99\nL 550 99\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 517 69\nL 561 69\nL 561 79\nL 517 79\nL 517 69\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 595 99\nL 595 119\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 595 315\nL 595 345\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 584 99\nL 606 99\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 584 345\nL 606 345\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 573 119\nL 617 119\nL 617 315\nL 573 315\nL 573 119\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 650 286\nL 650 296\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 650 335\nL 650 345\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 639 286\nL 661 286\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 639 345\nL 661 345\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 628 296\nL 672 296\nL 672 335\nL 628 335\nL 628 296\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 706 315\nL 706 325\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 706 364\nL 706 374\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 695 315\nL 717 315\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 695 374\nL 717 374\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 684 325\nL 728 325\nL 728 364\nL 684 364\nL 684 325\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 762 178\nL 762 217\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 762 364\nL 762 433\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 751 178\nL 773 178\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 751 433\nL 773 433\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 740 217\nL 784 217\nL 784 364\nL 740 364\nL 740 217\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 266 263\nL 350 263\nL 350 263\nA 4 4 90.00 0 1 354 267\nL 354 280\nL 354 280\nA 4 4 90.00 0 1 350 284\nL 266 284\nL 266 284\nA 4 4 90.00 0 1 262 280\nL 262 267\nL 262 267\nA 4 4 90.00 0 1 266 263\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"266\" y=\"280\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text><path d=\"M 600 302\nL 682 302\nL 682 302\nA 4 4 90.00 0 1 686 306\nL 686 319\nL 686 319\nA 4 4 90.00 0 1 682 323\nL 600 323\nL 600 323\nA 4 4 90.00 0 1 596 319\nL 596 306\nL 596 306\nA 4 4 90.00 0 1 600 302\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"600\" y=\"319\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">⁎ Evening Star</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">149</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">142.44</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">135.89</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">129.33</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122.78</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">116.22</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.67</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">103.11</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.56</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 94.81 393.39\nL 94.81 442.54\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 94.81 491.69\nL 94.81 540.85\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 83.68 393.39\nL 105.93 393.39\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 83.68 540.85\nL 105.93 540.85\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 72.56 442.54\nL 117.05 442.54\nL 117.05 491.69\nL 72.56 491.69\nL 72.56 442.54\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 150.42 245.93\nL 150.42 295.08\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 150.42 413.05\nL 150.42 442.54\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 139.3 245.93\nL 161.55 245.93\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 139.3 442.54\nL 161.55 442.54\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 128.18 295.08\nL 172.67 295.08\nL 172.67 413.05\nL 128.18 413.05\nL 128.18 295.08\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 206.04 452.37\nL 206.04 462.2\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 206.04 472.03\nL 206.04 491.69\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 194.92 452.37\nL 217.16 452.37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 194.92 491.69\nL 217.16 491.69\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 183.79 462.2\nL 228.28 462.2\nL 228.28 472.03\nL 183.79 472.03\nL 183.79 462.2\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 261.65 245.93\nL 261.65 275.42\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 261.65 413.05\nL 261.65 432.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 250.53 245.93\nL 272.78 245.93\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 250.53 432.71\nL 272.78 432.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 239.41 275.42\nL 283.9 275.42\nL 283.9 413.05\nL 239.41 413.05\nL 239.41 275.42\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 317.27 344.24\nL 317.27 354.07\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 317.27 393.39\nL 317.27 403.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 306.15 344.24\nL 328.39 344.24\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 306.15 403.22\nL 328.39 403.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 295.02 354.07\nL 339.52 354.07\nL 339.52 393.39\nL 295.02 393.39\nL 295.02 354.07\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 372.88 314.75\nL 372.88 324.58\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 372.88 363.9\nL 372.88 373.73\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 361.76 314.75\nL 384.01 314.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 361.76 373.73\nL 384.01 373.73\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 350.64 324.58\nL 395.13 324.58\nL 395.13 363.9\nL 350.64 363.9\nL 350.64 324.58\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 428.5 285.25\nL 428.5 295.08\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.5 334.41\nL 428.5 344.24\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 417.38 285.25\nL 439.62 285.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 417.38 344.24\nL 439.62 344.24\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 406.25 295.08\nL 450.75 295.08\nL 450.75 334.41\nL 406.25 334.41\nL 406.25 295.08\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 484.12 98.47\nL 484.12 118.14\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 484.12 275.42\nL 484.12 295.08\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 472.99 98.47\nL 495.24 98.47\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 472.99 295.08\nL 495.24 295.08\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 461.87 118.14\nL 506.36 118.14\nL 506.36 275.42\nL 461.87 275.42\nL 461.87 118.14\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 539.73 59.15\nL 539.73 68.98\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 539.73 78.81\nL 539.73 98.47\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 528.61 59.15\nL 550.85 59.15\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 528.61 98.47\nL 550.85 98.47\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 517.48 68.98\nL 561.98 68.98\nL 561.98 78.81\nL 517.48 78.81\nL 517.48 68.98\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 595.35 98.47\nL 595.35 118.14\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 595.35 314.75\nL 595.35 344.24\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 584.22 98.47\nL 606.47 98.47\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 584.22 344.24\nL 606.47 344.24\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 573.1 118.14\nL 617.59 118.14\nL 617.59 314.75\nL 573.1 314.75\nL 573.1 118.14\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 650.96 285.25\nL 650.96 295.08\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 650.96 334.41\nL 650.96 344.24\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 639.84 285.25\nL 662.08 285.25\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 639.84 344.24\nL 662.08 344.24\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 628.72 295.08\nL 673.21 295.08\nL 673.21 334.41\nL 628.72 334.41\nL 628.72 295.08\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 706.58 314.75\nL 706.58 324.58\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 706.58 363.9\nL 706.58 373.73\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 695.45 314.75\nL 717.7 314.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 695.45 373.73\nL 717.7 373.73\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 684.33 324.58\nL 728.82 324.58\nL 728.82 363.9\nL 684.33 363.9\nL 684.33 324.58\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 762.19 177.12\nL 762.19 216.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 762.19 363.9\nL 762.19 432.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 751.07 177.12\nL 773.32 177.12\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 751.07 432.71\nL 773.32 432.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 739.95 216.44\nL 784.44 216.44\nL 784.44 363.9\nL 739.95 363.9\nL 739.95 216.44\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 267 262\nL 351 262\nL 351 262\nA 4 4 90.00 0 1 355 266\nL 355 279\nL 355 279\nA 4 4 90.00 0 1 351 283\nL 267 283\nL 267 283\nA 4 4 90.00 0 1 263 279\nL 263 266\nL 263 266\nA 4 4 90.00 0 1 267 262\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"267\" y=\"279\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text><path d=\"M 600 302\nL 682 302\nL 682 302\nA 4 4 90.00 0 1 686 306\nL 686 319\nL 686 319\nA 4 4 90.00 0 1 682 323\nL 600 323\nL 600 323\nA 4 4 90.00 0 1 596 319\nL 596 306\nL 596 306\nA 4 4 90.00 0 1 600 302\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"600\" y=\"319\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">⁎ Evening Star</text></svg>",
 			pngCRC: 0x2807f4b6,
 		},
 		{
@@ -1014,7 +1014,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 				opt.XAxis = XAxisOption{Show: Ptr(false)}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">138</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"18\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96</text><text x=\"18\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 92 376\nL 92 429\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 92 483\nL 92 537\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 74 376\nL 110 376\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 74 537\nL 110 537\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 55 429\nL 129 429\nL 129 483\nL 55 483\nL 55 429\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 185 354\nL 185 376\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 185 419\nL 185 429\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 167 354\nL 203 354\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 167 429\nL 203 429\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 148 376\nL 222 376\nL 222 419\nL 148 419\nL 148 376\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 278 322\nL 278 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 278 440\nL 278 451\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 260 322\nL 296 322\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 260 451\nL 296 451\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 241 333\nL 315 333\nL 315 440\nL 241 440\nL 241 333\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 371 386\nL 371 397\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 371 408\nL 371 505\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 353 386\nL 389 386\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 353 505\nL 389 505\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 334 397\nL 408 397\nL 408 408\nL 334 408\nL 334 397\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 446 268\nL 482 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446 483\nL 482 483\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 427 268\nL 501 268\nL 501 483\nL 427 483\nL 427 268\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 557 53\nL 557 75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 557 268\nL 557 290\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 539 53\nL 575 53\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 539 290\nL 575 290\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 520 75\nL 594 75\nL 594 268\nL 520 268\nL 520 75\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 650 279\nL 650 290\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 632 279\nL 668 279\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 632 376\nL 668 376\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 613 290\nL 687 290\nL 687 376\nL 613 376\nL 613 290\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 743 215\nL 743 247\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 743 290\nL 743 322\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 725 215\nL 761 215\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 725 322\nL 761 322\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 706 247\nL 780 247\nL 780 290\nL 706 290\nL 706 247\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283 320\nL 374 320\nL 374 320\nA 4 4 90.00 0 1 378 324\nL 378 337\nL 378 337\nA 4 4 90.00 0 1 374 341\nL 283 341\nL 283 341\nA 4 4 90.00 0 1 279 337\nL 279 324\nL 279 324\nA 4 4 90.00 0 1 283 320\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"283\" y=\"337\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 376 395\nL 436 395\nL 436 395\nA 4 4 90.00 0 1 440 399\nL 440 412\nL 440 412\nA 4 4 90.00 0 1 436 416\nL 376 416\nL 376 416\nA 4 4 90.00 0 1 372 412\nL 372 399\nL 372 399\nA 4 4 90.00 0 1 376 395\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"376\" y=\"412\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><path d=\"M 469 249\nL 561 249\nL 561 249\nA 4 4 90.00 0 1 565 253\nL 565 279\nL 565 279\nA 4 4 90.00 0 1 561 283\nL 469 283\nL 469 283\nA 4 4 90.00 0 1 465 279\nL 465 253\nL 465 253\nA 4 4 90.00 0 1 469 249\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"469\" y=\"266\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text><text x=\"469\" y=\"279\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">138</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"18\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96</text><text x=\"18\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 92.5 375.19\nL 92.5 428.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 92.5 482.59\nL 92.5 536.3\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 73.9 375.19\nL 111.1 375.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 73.9 536.3\nL 111.1 536.3\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 55.3 428.89\nL 129.7 428.89\nL 129.7 482.59\nL 55.3 482.59\nL 55.3 428.89\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 185.5 353.7\nL 185.5 375.19\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 185.5 418.15\nL 185.5 428.89\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 166.9 353.7\nL 204.1 353.7\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 166.9 428.89\nL 204.1 428.89\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 148.3 375.19\nL 222.7 375.19\nL 222.7 418.15\nL 148.3 418.15\nL 148.3 375.19\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 278.5 321.48\nL 278.5 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 278.5 439.63\nL 278.5 450.37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 259.9 321.48\nL 297.1 321.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 259.9 450.37\nL 297.1 450.37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 241.3 332.22\nL 315.7 332.22\nL 315.7 439.63\nL 241.3 439.63\nL 241.3 332.22\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 371.5 385.93\nL 371.5 396.67\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 371.5 407.41\nL 371.5 504.07\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 352.9 385.93\nL 390.1 385.93\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 352.9 504.07\nL 390.1 504.07\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 334.3 396.67\nL 408.7 396.67\nL 408.7 407.41\nL 334.3 407.41\nL 334.3 396.67\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 445.9 267.78\nL 483.1 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 445.9 482.59\nL 483.1 482.59\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 427.3 267.78\nL 501.7 267.78\nL 501.7 482.59\nL 427.3 482.59\nL 427.3 267.78\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 557.5 52.96\nL 557.5 74.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 557.5 267.78\nL 557.5 289.26\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 538.9 52.96\nL 576.1 52.96\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 538.9 289.26\nL 576.1 289.26\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 520.3 74.44\nL 594.7 74.44\nL 594.7 267.78\nL 520.3 267.78\nL 520.3 74.44\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 650.5 278.52\nL 650.5 289.26\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 631.9 278.52\nL 669.1 278.52\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 631.9 375.19\nL 669.1 375.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 613.3 289.26\nL 687.7 289.26\nL 687.7 375.19\nL 613.3 375.19\nL 613.3 289.26\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 743.5 214.07\nL 743.5 246.3\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 743.5 289.26\nL 743.5 321.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 724.9 214.07\nL 762.1 214.07\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 724.9 321.48\nL 762.1 321.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 706.3 246.3\nL 780.7 246.3\nL 780.7 289.26\nL 706.3 289.26\nL 706.3 246.3\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 284 319\nL 375 319\nL 375 319\nA 4 4 90.00 0 1 379 323\nL 379 336\nL 379 336\nA 4 4 90.00 0 1 375 340\nL 284 340\nL 284 340\nA 4 4 90.00 0 1 280 336\nL 280 323\nL 280 323\nA 4 4 90.00 0 1 284 319\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"284\" y=\"336\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 377 394\nL 437 394\nL 437 394\nA 4 4 90.00 0 1 441 398\nL 441 411\nL 441 411\nA 4 4 90.00 0 1 437 415\nL 377 415\nL 377 415\nA 4 4 90.00 0 1 373 411\nL 373 398\nL 373 398\nA 4 4 90.00 0 1 377 394\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"377\" y=\"411\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><path d=\"M 470 249\nL 562 249\nL 562 249\nA 4 4 90.00 0 1 566 253\nL 566 279\nL 566 279\nA 4 4 90.00 0 1 562 283\nL 470 283\nL 470 283\nA 4 4 90.00 0 1 466 279\nL 466 253\nL 466 253\nA 4 4 90.00 0 1 470 249\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"470\" y=\"266\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text><text x=\"470\" y=\"279\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text></svg>",
 			pngCRC: 0xbc37c30a,
 		},
 		{
@@ -1039,7 +1039,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 				opt.XAxis = XAxisOption{Show: Ptr(false)}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 112 321\nL 112 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 112 456\nL 112 523\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 94 321\nL 130 321\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 94 523\nL 130 523\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 76 388\nL 148 388\nL 148 456\nL 76 456\nL 76 388\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 202 294\nL 202 321\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 202 375\nL 202 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 184 294\nL 220 294\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 184 388\nL 220 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 166 321\nL 238 321\nL 238 375\nL 166 375\nL 166 321\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 292 253\nL 292 267\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 292 402\nL 292 415\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 274 253\nL 310 253\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 274 415\nL 310 415\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 256 267\nL 328 267\nL 328 402\nL 256 402\nL 256 267\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 383 118\nL 383 361\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 383 375\nL 383 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 365 118\nL 401 118\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 365 388\nL 401 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 347 361\nL 419 361\nL 419 375\nL 347 375\nL 347 361\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 455 186\nL 491 186\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 455 456\nL 491 456\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 437 186\nL 509 186\nL 509 456\nL 437 456\nL 437 186\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 563 213\nL 563 226\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 545 118\nL 581 118\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 545 226\nL 581 226\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 527 118\nL 599 118\nL 599 213\nL 527 213\nL 527 118\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 654 78\nL 654 91\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 654 172\nL 654 186\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 636 78\nL 672 78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 636 186\nL 672 186\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 618 91\nL 690 91\nL 690 172\nL 618 172\nL 618 91\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 744 132\nL 744 172\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 744 186\nL 744 213\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 726 132\nL 762 132\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 726 213\nL 762 213\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 708 172\nL 780 172\nL 780 186\nL 708 186\nL 708 172\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 297 389\nL 392 389\nL 392 389\nA 4 4 90.00 0 1 396 393\nL 396 406\nL 396 406\nA 4 4 90.00 0 1 392 410\nL 297 410\nL 297 410\nA 4 4 90.00 0 1 293 406\nL 293 393\nL 293 393\nA 4 4 90.00 0 1 297 389\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"297\" y=\"406\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V Bear Engulfing</text><path d=\"M 388 342\nL 480 342\nL 480 342\nA 4 4 90.00 0 1 484 346\nL 484 372\nL 484 372\nA 4 4 90.00 0 1 480 376\nL 388 376\nL 388 376\nA 4 4 90.00 0 1 384 372\nL 384 346\nL 384 346\nA 4 4 90.00 0 1 388 342\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"388\" y=\"359\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"396\" y=\"372\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><path d=\"M 478 437\nL 575 437\nL 575 437\nA 4 4 90.00 0 1 579 441\nL 579 467\nL 579 467\nA 4 4 90.00 0 1 575 471\nL 478 471\nL 478 471\nA 4 4 90.00 0 1 474 467\nL 474 441\nL 474 441\nA 4 4 90.00 0 1 478 437\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"478\" y=\"454\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text><text x=\"479\" y=\"467\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V Bear Engulfing</text><path d=\"M 659 159\nL 733 159\nL 733 159\nA 4 4 90.00 0 1 737 163\nL 737 176\nL 737 176\nA 4 4 90.00 0 1 733 180\nL 659 180\nL 659 180\nA 4 4 90.00 0 1 655 176\nL 655 163\nL 655 163\nA 4 4 90.00 0 1 659 159\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"659\" y=\"176\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"30\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">133</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128.22</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.44</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118.67</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">113.89</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">109.11</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104.33</text><text x=\"17\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99.56</text><text x=\"17\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">94.78</text><text x=\"39\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 63 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 112.19 320.23\nL 112.19 387.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 112.19 455.12\nL 112.19 522.56\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 94.11 320.23\nL 130.26 320.23\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 94.11 522.56\nL 130.26 522.56\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 76.04 387.67\nL 148.34 387.67\nL 148.34 455.12\nL 76.04 455.12\nL 76.04 387.67\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 202.56 293.26\nL 202.56 320.23\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 202.56 374.19\nL 202.56 387.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 184.49 293.26\nL 220.64 293.26\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 184.49 387.67\nL 220.64 387.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 166.41 320.23\nL 238.71 320.23\nL 238.71 374.19\nL 166.41 374.19\nL 166.41 320.23\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 292.94 252.79\nL 292.94 266.28\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 292.94 401.16\nL 292.94 414.65\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 274.86 252.79\nL 311.01 252.79\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 274.86 414.65\nL 311.01 414.65\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 256.79 266.28\nL 329.09 266.28\nL 329.09 401.16\nL 256.79 401.16\nL 256.79 266.28\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 383.31 117.91\nL 383.31 360.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 383.31 374.19\nL 383.31 387.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 365.24 117.91\nL 401.39 117.91\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 365.24 387.67\nL 401.39 387.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 347.16 360.7\nL 419.46 360.7\nL 419.46 374.19\nL 347.16 374.19\nL 347.16 360.7\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 455.61 185.35\nL 491.76 185.35\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 455.61 455.12\nL 491.76 455.12\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 437.54 185.35\nL 509.84 185.35\nL 509.84 455.12\nL 437.54 455.12\nL 437.54 185.35\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 564.06 212.33\nL 564.06 225.81\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 545.99 117.91\nL 582.14 117.91\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 545.99 225.81\nL 582.14 225.81\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 527.91 117.91\nL 600.21 117.91\nL 600.21 212.33\nL 527.91 212.33\nL 527.91 117.91\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 654.44 77.44\nL 654.44 90.93\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 654.44 171.86\nL 654.44 185.35\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 636.36 77.44\nL 672.51 77.44\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 636.36 185.35\nL 672.51 185.35\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 618.29 90.93\nL 690.59 90.93\nL 690.59 171.86\nL 618.29 171.86\nL 618.29 90.93\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 744.81 131.4\nL 744.81 171.86\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 744.81 185.35\nL 744.81 212.33\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 726.74 131.4\nL 762.89 131.4\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 726.74 212.33\nL 762.89 212.33\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 708.66 171.86\nL 780.96 171.86\nL 780.96 185.35\nL 708.66 185.35\nL 708.66 171.86\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 298 388\nL 393 388\nL 393 388\nA 4 4 90.00 0 1 397 392\nL 397 405\nL 397 405\nA 4 4 90.00 0 1 393 409\nL 298 409\nL 298 409\nA 4 4 90.00 0 1 294 405\nL 294 392\nL 294 392\nA 4 4 90.00 0 1 298 388\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"298\" y=\"405\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V Bear Engulfing</text><path d=\"M 388 342\nL 480 342\nL 480 342\nA 4 4 90.00 0 1 484 346\nL 484 372\nL 484 372\nA 4 4 90.00 0 1 480 376\nL 388 376\nL 388 376\nA 4 4 90.00 0 1 384 372\nL 384 346\nL 384 346\nA 4 4 90.00 0 1 388 342\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"388\" y=\"359\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"396\" y=\"372\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><path d=\"M 479 436\nL 576 436\nL 576 436\nA 4 4 90.00 0 1 580 440\nL 580 466\nL 580 466\nA 4 4 90.00 0 1 576 470\nL 479 470\nL 479 470\nA 4 4 90.00 0 1 475 466\nL 475 440\nL 475 440\nA 4 4 90.00 0 1 479 436\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"479\" y=\"453\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text><text x=\"480\" y=\"466\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V Bear Engulfing</text><path d=\"M 659 159\nL 733 159\nL 733 159\nA 4 4 90.00 0 1 737 163\nL 737 176\nL 737 176\nA 4 4 90.00 0 1 733 180\nL 659 180\nL 659 180\nA 4 4 90.00 0 1 655 176\nL 655 163\nL 655 163\nA 4 4 90.00 0 1 659 159\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"659\" y=\"176\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text></svg>",
 			pngCRC: 0xc29b71af,
 		},
 		{
@@ -1078,7 +1078,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 				opt.XAxis = XAxisOption{Show: Ptr(false)}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">152</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">136</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104</text><text x=\"18\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96</text><text x=\"18\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">88</text><text x=\"18\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63 349\nL 63 389\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 63 429\nL 63 470\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 56 349\nL 70 349\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 56 470\nL 70 470\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 49 389\nL 77 389\nL 77 429\nL 49 429\nL 49 389\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 98 260\nL 98 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 91 260\nL 105 260\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 91 309\nL 105 309\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 84 268\nL 112 268\nL 112 309\nL 84 309\nL 84 268\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 134 276\nL 134 284\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 127 276\nL 141 276\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 127 333\nL 141 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 120 284\nL 148 284\nL 148 333\nL 120 333\nL 120 284\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 162 228\nL 176 228\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 162 284\nL 176 284\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 155 228\nL 183 228\nL 183 284\nL 155 284\nL 155 228\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 205 260\nL 205 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 198 212\nL 212 212\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 198 268\nL 212 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 191 212\nL 219 212\nL 219 260\nL 191 260\nL 191 212\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 240 220\nL 240 228\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 240 413\nL 240 429\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 233 220\nL 247 220\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 233 429\nL 247 429\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 226 228\nL 254 228\nL 254 413\nL 226 413\nL 226 228\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 276 365\nL 276 373\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 276 413\nL 276 429\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 269 365\nL 283 365\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 269 429\nL 283 429\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 262 373\nL 290 373\nL 290 413\nL 262 413\nL 262 373\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 311 333\nL 311 349\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 311 373\nL 311 413\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304 333\nL 318 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304 413\nL 318 413\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 297 349\nL 325 349\nL 325 373\nL 297 373\nL 297 349\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 346 292\nL 346 309\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 346 317\nL 346 470\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 339 292\nL 353 292\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 339 470\nL 353 470\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 332 309\nL 360 309\nL 360 317\nL 332 317\nL 332 309\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 382 188\nL 382 325\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 382 333\nL 382 341\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 375 188\nL 389 188\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 375 341\nL 389 341\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 368 325\nL 396 325\nL 396 333\nL 368 333\nL 368 325\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 417 349\nL 417 364\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 417 365\nL 417 550\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 410 349\nL 424 349\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 410 550\nL 424 550\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 403 364\nL 431 364\nL 431 365\nL 403 365\nL 403 364\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 453 228\nL 453 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 453 389\nL 453 397\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446 228\nL 460 228\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446 397\nL 460 397\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 439 388\nL 467 388\nL 467 389\nL 439 389\nL 439 388\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 488 147\nL 488 188\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 488 309\nL 488 349\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 481 147\nL 495 147\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 481 349\nL 495 349\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 474 188\nL 502 188\nL 502 309\nL 474 309\nL 474 188\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 524 107\nL 524 123\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 524 349\nL 524 365\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 517 107\nL 531 107\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 517 365\nL 531 365\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 510 123\nL 538 123\nL 538 349\nL 510 349\nL 510 123\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 559 67\nL 559 107\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 559 349\nL 559 389\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 552 67\nL 566 67\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 552 389\nL 566 389\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 545 107\nL 573 107\nL 573 349\nL 545 349\nL 545 107\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 594 188\nL 594 228\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 594 349\nL 594 389\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 587 188\nL 601 188\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 587 389\nL 601 389\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 580 228\nL 608 228\nL 608 349\nL 580 349\nL 580 228\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 630 365\nL 630 381\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 630 389\nL 630 413\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 623 365\nL 637 365\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 623 413\nL 637 413\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 616 381\nL 644 381\nL 644 389\nL 616 389\nL 616 381\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 665 147\nL 665 188\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 665 349\nL 665 365\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 658 147\nL 672 147\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 658 365\nL 672 365\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 651 188\nL 679 188\nL 679 349\nL 651 349\nL 651 188\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 701 107\nL 701 147\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 701 309\nL 701 325\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 694 107\nL 708 107\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 694 325\nL 708 325\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 687 147\nL 715 147\nL 715 309\nL 687 309\nL 687 147\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 736 67\nL 736 107\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 736 123\nL 736 139\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 729 67\nL 743 67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 729 139\nL 743 139\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 722 107\nL 750 107\nL 750 123\nL 722 123\nL 722 107\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 772 139\nL 772 147\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 772 309\nL 772 349\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 765 139\nL 779 139\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 765 349\nL 779 349\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 758 147\nL 786 147\nL 786 309\nL 758 309\nL 758 147\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 139 271\nL 220 271\nL 220 271\nA 4 4 90.00 0 1 224 275\nL 224 288\nL 224 288\nA 4 4 90.00 0 1 220 292\nL 139 292\nL 139 292\nA 4 4 90.00 0 1 135 288\nL 135 275\nL 135 275\nA 4 4 90.00 0 1 139 271\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"139\" y=\"288\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">| Piercing Line</text><path d=\"M 210 247\nL 284 247\nL 284 247\nA 4 4 90.00 0 1 288 251\nL 288 264\nL 288 264\nA 4 4 90.00 0 1 284 268\nL 210 268\nL 210 268\nA 4 4 90.00 0 1 206 264\nL 206 251\nL 206 251\nA 4 4 90.00 0 1 210 247\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"210\" y=\"264\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text><path d=\"M 351 298\nL 419 298\nL 419 298\nA 4 4 90.00 0 1 423 302\nL 423 328\nL 423 328\nA 4 4 90.00 0 1 419 332\nL 351 332\nL 351 332\nA 4 4 90.00 0 1 347 328\nL 347 302\nL 347 302\nA 4 4 90.00 0 1 351 298\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"355\" y=\"315\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"351\" y=\"328\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><path d=\"M 387 312\nL 479 312\nL 479 312\nA 4 4 90.00 0 1 483 316\nL 483 329\nL 483 329\nA 4 4 90.00 0 1 479 333\nL 387 333\nL 387 333\nA 4 4 90.00 0 1 383 329\nL 383 316\nL 383 316\nA 4 4 90.00 0 1 387 312\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"387\" y=\"329\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><path d=\"M 422 345\nL 490 345\nL 490 345\nA 4 4 90.00 0 1 494 349\nL 494 375\nL 494 375\nA 4 4 90.00 0 1 490 379\nL 422 379\nL 422 379\nA 4 4 90.00 0 1 418 375\nL 418 349\nL 418 349\nA 4 4 90.00 0 1 422 345\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"426\" y=\"362\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"422\" y=\"375\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><path d=\"M 458 369\nL 550 369\nL 550 369\nA 4 4 90.00 0 1 554 373\nL 554 399\nL 554 399\nA 4 4 90.00 0 1 550 403\nL 458 403\nL 458 403\nA 4 4 90.00 0 1 454 399\nL 454 373\nL 454 373\nA 4 4 90.00 0 1 458 369\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"458\" y=\"386\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"466\" y=\"399\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><path d=\"M 529 110\nL 620 110\nL 620 110\nA 4 4 90.00 0 1 624 114\nL 624 127\nL 624 127\nA 4 4 90.00 0 1 620 131\nL 529 131\nL 529 131\nA 4 4 90.00 0 1 525 127\nL 525 114\nL 525 114\nA 4 4 90.00 0 1 529 110\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"529\" y=\"127\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 670 175\nL 754 175\nL 754 175\nA 4 4 90.00 0 1 758 179\nL 758 192\nL 758 192\nA 4 4 90.00 0 1 754 196\nL 670 196\nL 670 196\nA 4 4 90.00 0 1 666 192\nL 666 179\nL 666 179\nA 4 4 90.00 0 1 670 175\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"670\" y=\"192\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text><path d=\"M 718 296\nL 800 296\nL 800 296\nA 4 4 90.00 0 1 804 300\nL 804 313\nL 804 313\nA 4 4 90.00 0 1 800 317\nL 718 317\nL 718 317\nA 4 4 90.00 0 1 714 313\nL 714 300\nL 714 300\nA 4 4 90.00 0 1 718 296\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"718\" y=\"313\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">⁎ Evening Star</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">152</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">136</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">128</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">112</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104</text><text x=\"18\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96</text><text x=\"18\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">88</text><text x=\"18\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 63.71 348.33\nL 63.71 388.61\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 63.71 428.89\nL 63.71 469.17\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 56.63 348.33\nL 70.8 348.33\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 56.63 469.17\nL 70.8 469.17\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 49.54 388.61\nL 77.89 388.61\nL 77.89 428.89\nL 49.54 428.89\nL 49.54 388.61\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 99.14 259.72\nL 99.14 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 92.06 259.72\nL 106.23 259.72\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 92.06 308.06\nL 106.23 308.06\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 84.97 267.78\nL 113.31 267.78\nL 113.31 308.06\nL 84.97 308.06\nL 84.97 267.78\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 134.57 275.83\nL 134.57 283.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 127.49 275.83\nL 141.66 275.83\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 127.49 332.22\nL 141.66 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 120.4 283.89\nL 148.74 283.89\nL 148.74 332.22\nL 120.4 332.22\nL 120.4 283.89\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 162.91 227.5\nL 177.09 227.5\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 162.91 283.89\nL 177.09 283.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 155.83 227.5\nL 184.17 227.5\nL 184.17 283.89\nL 155.83 283.89\nL 155.83 227.5\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 205.43 259.72\nL 205.43 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 198.34 211.39\nL 212.51 211.39\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 198.34 267.78\nL 212.51 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 191.26 211.39\nL 219.6 211.39\nL 219.6 259.72\nL 191.26 259.72\nL 191.26 211.39\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 240.86 219.44\nL 240.86 227.5\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 240.86 412.78\nL 240.86 428.89\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 233.77 219.44\nL 247.94 219.44\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 233.77 428.89\nL 247.94 428.89\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 226.69 227.5\nL 255.03 227.5\nL 255.03 412.78\nL 226.69 412.78\nL 226.69 227.5\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 276.29 364.44\nL 276.29 372.5\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 276.29 412.78\nL 276.29 428.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 269.2 364.44\nL 283.37 364.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 269.2 428.89\nL 283.37 428.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 262.11 372.5\nL 290.46 372.5\nL 290.46 412.78\nL 262.11 412.78\nL 262.11 372.5\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 311.71 332.22\nL 311.71 348.33\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 311.71 372.5\nL 311.71 412.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304.63 332.22\nL 318.8 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304.63 412.78\nL 318.8 412.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 297.54 348.33\nL 325.89 348.33\nL 325.89 372.5\nL 297.54 372.5\nL 297.54 348.33\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 347.14 291.94\nL 347.14 308.06\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 347.14 316.11\nL 347.14 469.17\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 340.06 291.94\nL 354.23 291.94\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 340.06 469.17\nL 354.23 469.17\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 332.97 308.06\nL 361.31 308.06\nL 361.31 316.11\nL 332.97 316.11\nL 332.97 308.06\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 382.57 187.22\nL 382.57 324.17\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 382.57 332.22\nL 382.57 340.28\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 375.49 187.22\nL 389.66 187.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 375.49 340.28\nL 389.66 340.28\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 368.4 324.17\nL 396.74 324.17\nL 396.74 332.22\nL 368.4 332.22\nL 368.4 324.17\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 418 348.33\nL 418 363.64\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 418 364.44\nL 418 549.72\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 410.91 348.33\nL 425.09 348.33\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 410.91 549.72\nL 425.09 549.72\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 403.83 363.64\nL 432.17 363.64\nL 432.17 364.44\nL 403.83 364.44\nL 403.83 363.64\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 453.43 227.5\nL 453.43 387.81\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 453.43 388.61\nL 453.43 396.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446.34 227.5\nL 460.51 227.5\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446.34 396.67\nL 460.51 396.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 439.26 387.81\nL 467.6 387.81\nL 467.6 388.61\nL 439.26 388.61\nL 439.26 387.81\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 488.86 146.94\nL 488.86 187.22\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 488.86 308.06\nL 488.86 348.33\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 481.77 146.94\nL 495.94 146.94\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 481.77 348.33\nL 495.94 348.33\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 474.69 187.22\nL 503.03 187.22\nL 503.03 308.06\nL 474.69 308.06\nL 474.69 187.22\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 524.29 106.67\nL 524.29 122.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 524.29 348.33\nL 524.29 364.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 517.2 106.67\nL 531.37 106.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 517.2 364.44\nL 531.37 364.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 510.11 122.78\nL 538.46 122.78\nL 538.46 348.33\nL 510.11 348.33\nL 510.11 122.78\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 559.71 66.39\nL 559.71 106.67\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 559.71 348.33\nL 559.71 388.61\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 552.63 66.39\nL 566.8 66.39\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 552.63 388.61\nL 566.8 388.61\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 545.54 106.67\nL 573.89 106.67\nL 573.89 348.33\nL 545.54 348.33\nL 545.54 106.67\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 595.14 187.22\nL 595.14 227.5\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 595.14 348.33\nL 595.14 388.61\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 588.06 187.22\nL 602.23 187.22\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 588.06 388.61\nL 602.23 388.61\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 580.97 227.5\nL 609.31 227.5\nL 609.31 348.33\nL 580.97 348.33\nL 580.97 227.5\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 630.57 364.44\nL 630.57 380.56\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 630.57 388.61\nL 630.57 412.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 623.49 364.44\nL 637.66 364.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 623.49 412.78\nL 637.66 412.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 616.4 380.56\nL 644.74 380.56\nL 644.74 388.61\nL 616.4 388.61\nL 616.4 380.56\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 666 146.94\nL 666 187.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 666 348.33\nL 666 364.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 658.91 146.94\nL 673.09 146.94\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 658.91 364.44\nL 673.09 364.44\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 651.83 187.22\nL 680.17 187.22\nL 680.17 348.33\nL 651.83 348.33\nL 651.83 187.22\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 701.43 106.67\nL 701.43 146.94\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 701.43 308.06\nL 701.43 324.17\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 694.34 106.67\nL 708.51 106.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 694.34 324.17\nL 708.51 324.17\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 687.26 146.94\nL 715.6 146.94\nL 715.6 308.06\nL 687.26 308.06\nL 687.26 146.94\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 736.86 66.39\nL 736.86 106.67\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 736.86 122.78\nL 736.86 138.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 729.77 66.39\nL 743.94 66.39\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 729.77 138.89\nL 743.94 138.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 722.69 106.67\nL 751.03 106.67\nL 751.03 122.78\nL 722.69 122.78\nL 722.69 106.67\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 772.29 138.89\nL 772.29 146.94\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 772.29 308.06\nL 772.29 348.33\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 765.2 138.89\nL 779.37 138.89\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 765.2 348.33\nL 779.37 348.33\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 758.11 146.94\nL 786.46 146.94\nL 786.46 308.06\nL 758.11 308.06\nL 758.11 146.94\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 140 271\nL 221 271\nL 221 271\nA 4 4 90.00 0 1 225 275\nL 225 288\nL 225 288\nA 4 4 90.00 0 1 221 292\nL 140 292\nL 140 292\nA 4 4 90.00 0 1 136 288\nL 136 275\nL 136 275\nA 4 4 90.00 0 1 140 271\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"140\" y=\"288\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">| Piercing Line</text><path d=\"M 210 247\nL 284 247\nL 284 247\nA 4 4 90.00 0 1 288 251\nL 288 264\nL 288 264\nA 4 4 90.00 0 1 284 268\nL 210 268\nL 210 268\nA 4 4 90.00 0 1 206 264\nL 206 251\nL 206 251\nA 4 4 90.00 0 1 210 247\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"210\" y=\"264\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text><path d=\"M 352 297\nL 420 297\nL 420 297\nA 4 4 90.00 0 1 424 301\nL 424 327\nL 424 327\nA 4 4 90.00 0 1 420 331\nL 352 331\nL 352 331\nA 4 4 90.00 0 1 348 327\nL 348 301\nL 348 301\nA 4 4 90.00 0 1 352 297\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"356\" y=\"314\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"352\" y=\"327\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><path d=\"M 388 311\nL 480 311\nL 480 311\nA 4 4 90.00 0 1 484 315\nL 484 328\nL 484 328\nA 4 4 90.00 0 1 480 332\nL 388 332\nL 388 332\nA 4 4 90.00 0 1 384 328\nL 384 315\nL 384 315\nA 4 4 90.00 0 1 388 311\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"388\" y=\"328\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><path d=\"M 423 345\nL 491 345\nL 491 345\nA 4 4 90.00 0 1 495 349\nL 495 375\nL 495 375\nA 4 4 90.00 0 1 491 379\nL 423 379\nL 423 379\nA 4 4 90.00 0 1 419 375\nL 419 349\nL 419 349\nA 4 4 90.00 0 1 423 345\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"427\" y=\"362\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"423\" y=\"375\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><path d=\"M 458 369\nL 550 369\nL 550 369\nA 4 4 90.00 0 1 554 373\nL 554 399\nL 554 399\nA 4 4 90.00 0 1 550 403\nL 458 403\nL 458 403\nA 4 4 90.00 0 1 454 399\nL 454 373\nL 454 373\nA 4 4 90.00 0 1 458 369\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"458\" y=\"386\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"466\" y=\"399\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><path d=\"M 529 110\nL 620 110\nL 620 110\nA 4 4 90.00 0 1 624 114\nL 624 127\nL 624 127\nA 4 4 90.00 0 1 620 131\nL 529 131\nL 529 131\nA 4 4 90.00 0 1 525 127\nL 525 114\nL 525 114\nA 4 4 90.00 0 1 529 110\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"529\" y=\"127\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 671 174\nL 755 174\nL 755 174\nA 4 4 90.00 0 1 759 178\nL 759 191\nL 759 191\nA 4 4 90.00 0 1 755 195\nL 671 195\nL 671 195\nA 4 4 90.00 0 1 667 191\nL 667 178\nL 667 178\nA 4 4 90.00 0 1 671 174\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"671\" y=\"191\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text><path d=\"M 718 295\nL 800 295\nL 800 295\nA 4 4 90.00 0 1 804 299\nL 804 312\nL 804 312\nA 4 4 90.00 0 1 800 316\nL 718 316\nL 718 316\nA 4 4 90.00 0 1 714 312\nL 714 299\nL 714 299\nA 4 4 90.00 0 1 718 295\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"718\" y=\"312\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">⁎ Evening Star</text></svg>",
 			pngCRC: 0x1ffb0d5e,
 		},
 		{
@@ -1103,7 +1103,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 				opt.XAxis = XAxisOption{Show: Ptr(false)}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">138</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"18\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96</text><text x=\"18\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 92 376\nL 92 429\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 92 483\nL 92 537\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 74 376\nL 110 376\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 74 537\nL 110 537\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 55 429\nL 129 429\nL 129 483\nL 55 483\nL 55 429\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 185 376\nL 185 483\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 167 268\nL 203 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 167 483\nL 203 483\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 148 268\nL 222 268\nL 222 376\nL 148 376\nL 148 268\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 260 215\nL 296 215\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 260 322\nL 296 322\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 241 215\nL 315 215\nL 315 322\nL 241 322\nL 241 215\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 371 161\nL 371 215\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 371 268\nL 371 322\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 353 161\nL 389 161\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 353 322\nL 389 322\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 334 215\nL 408 215\nL 408 268\nL 334 268\nL 334 215\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 446 53\nL 482 53\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 446 268\nL 482 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 427 53\nL 501 53\nL 501 268\nL 427 268\nL 427 53\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 539 107\nL 575 107\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 539 322\nL 575 322\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 520 107\nL 594 107\nL 594 322\nL 520 322\nL 520 107\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 650 215\nL 650 247\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 650 290\nL 650 300\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 632 215\nL 668 215\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 632 300\nL 668 300\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 613 247\nL 687 247\nL 687 290\nL 613 290\nL 613 247\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 743 161\nL 743 182\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 743 247\nL 743 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 725 161\nL 761 161\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 725 268\nL 761 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 706 182\nL 780 182\nL 780 247\nL 706 247\nL 706 182\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 283 309\nL 380 309\nL 380 309\nA 4 4 90.00 0 1 384 313\nL 384 326\nL 384 326\nA 4 4 90.00 0 1 380 330\nL 283 330\nL 283 330\nA 4 4 90.00 0 1 279 326\nL 279 313\nL 279 313\nA 4 4 90.00 0 1 283 309\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"283\" y=\"326\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text><path d=\"M 469 40\nL 561 40\nL 561 40\nA 4 4 90.00 0 1 565 44\nL 565 57\nL 565 57\nA 4 4 90.00 0 1 561 61\nL 469 61\nL 469 61\nA 4 4 90.00 0 1 465 57\nL 465 44\nL 465 44\nA 4 4 90.00 0 1 469 40\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"469\" y=\"57\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text><path d=\"M 562 309\nL 659 309\nL 659 309\nA 4 4 90.00 0 1 663 313\nL 663 326\nL 663 326\nA 4 4 90.00 0 1 659 330\nL 562 330\nL 562 330\nA 4 4 90.00 0 1 558 326\nL 558 313\nL 558 313\nA 4 4 90.00 0 1 562 309\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"562\" y=\"326\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">138</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">108</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"18\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96</text><text x=\"18\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 92.5 375.19\nL 92.5 428.89\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 92.5 482.59\nL 92.5 536.3\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 73.9 375.19\nL 111.1 375.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 73.9 536.3\nL 111.1 536.3\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 55.3 428.89\nL 129.7 428.89\nL 129.7 482.59\nL 55.3 482.59\nL 55.3 428.89\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 185.5 375.19\nL 185.5 482.59\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 166.9 267.78\nL 204.1 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 166.9 482.59\nL 204.1 482.59\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 148.3 267.78\nL 222.7 267.78\nL 222.7 375.19\nL 148.3 375.19\nL 148.3 267.78\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 259.9 214.07\nL 297.1 214.07\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 259.9 321.48\nL 297.1 321.48\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 241.3 214.07\nL 315.7 214.07\nL 315.7 321.48\nL 241.3 321.48\nL 241.3 214.07\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 371.5 160.37\nL 371.5 214.07\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 371.5 267.78\nL 371.5 321.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 352.9 160.37\nL 390.1 160.37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 352.9 321.48\nL 390.1 321.48\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 334.3 214.07\nL 408.7 214.07\nL 408.7 267.78\nL 334.3 267.78\nL 334.3 214.07\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 445.9 52.96\nL 483.1 52.96\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 445.9 267.78\nL 483.1 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 427.3 52.96\nL 501.7 52.96\nL 501.7 267.78\nL 427.3 267.78\nL 427.3 52.96\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 538.9 106.67\nL 576.1 106.67\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 538.9 321.48\nL 576.1 321.48\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 520.3 106.67\nL 594.7 106.67\nL 594.7 321.48\nL 520.3 321.48\nL 520.3 106.67\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 650.5 214.07\nL 650.5 246.3\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 650.5 289.26\nL 650.5 300\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 631.9 214.07\nL 669.1 214.07\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 631.9 300\nL 669.1 300\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 613.3 246.3\nL 687.7 246.3\nL 687.7 289.26\nL 613.3 289.26\nL 613.3 246.3\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 743.5 160.37\nL 743.5 181.85\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 743.5 246.3\nL 743.5 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 724.9 160.37\nL 762.1 160.37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 724.9 267.78\nL 762.1 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 706.3 181.85\nL 780.7 181.85\nL 780.7 246.3\nL 706.3 246.3\nL 706.3 181.85\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 284 308\nL 381 308\nL 381 308\nA 4 4 90.00 0 1 385 312\nL 385 325\nL 385 325\nA 4 4 90.00 0 1 381 329\nL 284 329\nL 284 329\nA 4 4 90.00 0 1 280 325\nL 280 312\nL 280 312\nA 4 4 90.00 0 1 284 308\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"284\" y=\"325\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text><path d=\"M 470 40\nL 562 40\nL 562 40\nA 4 4 90.00 0 1 566 44\nL 566 57\nL 566 57\nA 4 4 90.00 0 1 562 61\nL 470 61\nL 470 61\nA 4 4 90.00 0 1 466 57\nL 466 44\nL 466 44\nA 4 4 90.00 0 1 470 40\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"470\" y=\"57\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text><path d=\"M 563 308\nL 660 308\nL 660 308\nA 4 4 90.00 0 1 664 312\nL 664 325\nL 664 325\nA 4 4 90.00 0 1 660 329\nL 563 329\nL 563 329\nA 4 4 90.00 0 1 559 325\nL 559 312\nL 559 312\nA 4 4 90.00 0 1 563 308\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"563\" y=\"325\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text></svg>",
 			pngCRC: 0x535890ac,
 		},
 		{
@@ -1231,7 +1231,7 @@ func TestCandlestickChartPatterns(t *testing.T) {
 				opt.XAxis = XAxisOption{Show: Ptr(false)}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">153</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">146</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">139</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104</text><text x=\"18\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97</text><text x=\"18\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 406\nL 52 452\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 52 498\nL 52 544\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 50 406\nL 54 406\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 50 544\nL 54 544\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 47 452\nL 57 452\nL 57 498\nL 47 498\nL 47 452\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 66 425\nL 66 434\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 66 452\nL 66 480\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 64 425\nL 68 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 64 480\nL 68 480\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 61 434\nL 71 434\nL 71 452\nL 61 452\nL 61 434\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 79 416\nL 79 425\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 79 434\nL 79 517\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 77 416\nL 81 416\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 77 517\nL 81 517\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 74 425\nL 84 425\nL 84 434\nL 74 434\nL 74 425\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 93 480\nL 93 498\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 93 544\nL 93 554\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 480\nL 95 480\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 91 554\nL 95 554\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 88 498\nL 98 498\nL 98 544\nL 88 544\nL 88 498\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 106 360\nL 106 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 106 443\nL 106 452\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 104 360\nL 108 360\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 104 452\nL 108 452\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 101 388\nL 111 388\nL 111 443\nL 101 443\nL 101 388\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 120 314\nL 120 424\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 120 425\nL 120 434\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 118 314\nL 122 314\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 118 434\nL 122 434\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 115 424\nL 125 424\nL 125 425\nL 115 425\nL 115 424\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 133 397\nL 133 416\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 133 425\nL 133 590\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 131 397\nL 135 397\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 131 590\nL 135 590\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 128 416\nL 138 416\nL 138 425\nL 128 425\nL 128 416\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 145 314\nL 149 314\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 145 498\nL 149 498\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 142 314\nL 152 314\nL 152 498\nL 142 498\nL 142 314\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 158 314\nL 162 314\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 158 498\nL 162 498\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 155 314\nL 165 314\nL 165 498\nL 155 498\nL 155 314\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 174 314\nL 174 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 174 406\nL 174 434\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 172 314\nL 176 314\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 172 434\nL 176 434\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 169 333\nL 179 333\nL 179 406\nL 169 406\nL 169 333\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 187 176\nL 187 222\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 187 360\nL 187 406\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 185 176\nL 189 176\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 185 406\nL 189 406\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 182 222\nL 192 222\nL 192 360\nL 182 360\nL 182 222\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 201 130\nL 201 149\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 201 406\nL 201 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 199 130\nL 203 130\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 199 425\nL 203 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 196 149\nL 206 149\nL 206 406\nL 196 406\nL 196 149\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 214 130\nL 214 149\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 214 406\nL 214 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 212 130\nL 216 130\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 212 425\nL 216 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 209 149\nL 219 149\nL 219 406\nL 209 406\nL 209 149\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 228 112\nL 228 130\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 228 434\nL 228 452\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 226 112\nL 230 112\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 226 452\nL 230 452\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 223 130\nL 233 130\nL 233 434\nL 223 434\nL 223 130\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 241 176\nL 241 222\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 241 452\nL 241 498\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 239 176\nL 243 176\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 239 498\nL 243 498\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 236 222\nL 246 222\nL 246 452\nL 236 452\nL 236 222\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 255 268\nL 255 314\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255 406\nL 255 544\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 253 268\nL 257 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 253 544\nL 257 544\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 250 314\nL 260 314\nL 260 406\nL 250 406\nL 250 314\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 268 176\nL 268 222\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 268 498\nL 268 517\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 266 176\nL 270 176\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 266 517\nL 270 517\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 263 222\nL 273 222\nL 273 498\nL 263 498\nL 263 222\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 282 241\nL 282 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 282 296\nL 282 314\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 280 241\nL 284 241\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 280 314\nL 284 314\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 277 268\nL 287 268\nL 287 296\nL 277 296\nL 277 268\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 296 268\nL 296 314\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 296 388\nL 296 406\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 294 268\nL 298 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 294 406\nL 298 406\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 291 314\nL 301 314\nL 301 388\nL 291 388\nL 291 314\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 309 268\nL 309 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 309 425\nL 309 434\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 307 268\nL 311 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 307 434\nL 311 434\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304 333\nL 314 333\nL 314 425\nL 304 425\nL 304 333\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 323 268\nL 323 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 323 406\nL 323 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 321 268\nL 325 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 321 425\nL 325 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 318 287\nL 328 287\nL 328 406\nL 318 406\nL 318 287\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 336 222\nL 336 241\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 336 360\nL 336 388\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 334 222\nL 338 222\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 334 388\nL 338 388\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 331 241\nL 341 241\nL 341 360\nL 331 360\nL 331 241\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 350 222\nL 350 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 350 406\nL 350 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 348 222\nL 352 222\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 348 425\nL 352 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 345 268\nL 355 268\nL 355 406\nL 345 406\nL 345 268\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 363 222\nL 363 287\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 363 333\nL 363 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 361 222\nL 365 222\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 361 360\nL 365 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 358 287\nL 368 287\nL 368 333\nL 358 333\nL 358 287\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 377 268\nL 377 314\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 377 452\nL 377 498\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 375 268\nL 379 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 375 498\nL 379 498\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 372 314\nL 382 314\nL 382 452\nL 372 452\nL 372 314\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 390 360\nL 390 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 390 425\nL 390 498\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 388 360\nL 392 360\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 388 498\nL 392 498\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 385 388\nL 395 388\nL 395 425\nL 385 425\nL 385 388\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 404 176\nL 404 222\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 404 360\nL 404 406\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 402 176\nL 406 176\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 402 406\nL 406 406\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 399 222\nL 409 222\nL 409 360\nL 399 360\nL 399 222\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 417 388\nL 417 406\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 417 425\nL 417 434\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 415 388\nL 419 388\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 415 434\nL 419 434\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 412 406\nL 422 406\nL 422 425\nL 412 425\nL 412 406\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 431 130\nL 431 176\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 431 360\nL 431 379\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 429 130\nL 433 130\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 429 379\nL 433 379\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 426 176\nL 436 176\nL 436 360\nL 426 360\nL 426 176\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 444 130\nL 444 176\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 444 406\nL 444 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 442 130\nL 446 130\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 442 425\nL 446 425\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 439 176\nL 449 176\nL 449 406\nL 439 406\nL 439 176\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 458 84\nL 458 130\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 458 158\nL 458 167\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 456 84\nL 460 84\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 456 167\nL 460 167\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 453 130\nL 463 130\nL 463 158\nL 453 158\nL 453 130\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 471 167\nL 471 176\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 471 314\nL 471 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 469 167\nL 473 167\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 469 360\nL 473 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 466 176\nL 476 176\nL 476 314\nL 466 314\nL 466 176\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 485 268\nL 485 314\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 485 360\nL 485 406\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 483 268\nL 487 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 483 406\nL 487 406\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 480 314\nL 490 314\nL 490 360\nL 480 360\nL 480 314\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 498 241\nL 498 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 498 333\nL 498 351\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 496 241\nL 500 241\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 496 351\nL 500 351\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 493 268\nL 503 268\nL 503 333\nL 493 333\nL 493 268\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 512 176\nL 512 204\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 512 250\nL 512 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 510 176\nL 514 176\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 510 268\nL 514 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 507 204\nL 517 204\nL 517 250\nL 507 250\nL 507 204\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 525 112\nL 525 130\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 525 185\nL 525 204\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 523 112\nL 527 112\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 523 204\nL 527 204\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 520 130\nL 530 130\nL 530 185\nL 520 185\nL 520 130\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 539 84\nL 539 112\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 539 222\nL 539 241\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 537 84\nL 541 84\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 537 241\nL 541 241\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 534 112\nL 544 112\nL 544 222\nL 534 222\nL 534 112\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 553 130\nL 553 149\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 553 204\nL 553 241\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 551 130\nL 555 130\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 551 241\nL 555 241\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 548 149\nL 558 149\nL 558 204\nL 548 204\nL 548 149\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 566 204\nL 566 222\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 566 268\nL 566 314\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 564 204\nL 568 204\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 564 314\nL 568 314\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 561 222\nL 571 222\nL 571 268\nL 561 268\nL 561 222\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 580 250\nL 580 277\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 580 333\nL 580 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 578 250\nL 582 250\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 578 360\nL 582 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 575 277\nL 585 277\nL 585 333\nL 575 333\nL 575 277\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 593 314\nL 593 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 593 360\nL 593 370\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 591 314\nL 595 314\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 591 370\nL 595 370\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 588 333\nL 598 333\nL 598 360\nL 588 360\nL 588 333\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 607 268\nL 607 296\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 607 333\nL 607 360\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 605 268\nL 609 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 605 360\nL 609 360\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 602 296\nL 612 296\nL 612 333\nL 602 333\nL 602 296\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 620 268\nL 620 277\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 620 314\nL 620 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 618 268\nL 622 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 618 333\nL 622 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 615 277\nL 625 277\nL 625 314\nL 615 314\nL 615 277\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 634 130\nL 634 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 634 287\nL 634 296\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 632 130\nL 636 130\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 632 296\nL 636 296\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 629 268\nL 639 268\nL 639 287\nL 629 287\nL 629 268\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 647 222\nL 647 241\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 647 268\nL 647 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 645 222\nL 649 222\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 645 287\nL 649 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 642 241\nL 652 241\nL 652 268\nL 642 268\nL 642 241\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 661 84\nL 661 240\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 661 241\nL 661 250\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 659 84\nL 663 84\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 659 250\nL 663 250\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 656 240\nL 666 240\nL 666 241\nL 656 241\nL 656 240\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 674 222\nL 674 241\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 674 259\nL 674 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 672 222\nL 676 222\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 672 268\nL 676 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 669 241\nL 679 241\nL 679 259\nL 669 259\nL 669 241\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 688 259\nL 688 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688 268\nL 688 406\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 686 259\nL 690 259\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 686 406\nL 690 406\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 683 268\nL 693 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 701 250\nL 701 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 701 333\nL 701 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 699 250\nL 703 250\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 699 360\nL 703 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 696 268\nL 706 268\nL 706 333\nL 696 333\nL 696 268\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 715 268\nL 715 287\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 715 314\nL 715 360\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 713 268\nL 717 268\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 713 360\nL 717 360\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 710 287\nL 720 287\nL 720 314\nL 710 314\nL 710 287\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 728 176\nL 728 195\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 728 314\nL 728 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 726 176\nL 730 176\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 726 333\nL 730 333\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 723 195\nL 733 195\nL 733 314\nL 723 314\nL 723 195\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 742 195\nL 742 204\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 742 259\nL 742 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 740 195\nL 744 195\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 740 268\nL 744 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 737 204\nL 747 204\nL 747 259\nL 737 259\nL 737 204\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 755 213\nL 755 222\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 755 296\nL 755 305\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 753 213\nL 757 213\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 753 305\nL 757 305\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 750 222\nL 760 222\nL 760 296\nL 750 296\nL 750 222\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 769 259\nL 769 268\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 769 351\nL 769 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 767 259\nL 771 259\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 767 360\nL 771 360\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 764 268\nL 774 268\nL 774 351\nL 764 351\nL 764 268\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 783 176\nL 783 332\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 783 333\nL 783 544\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 781 176\nL 785 176\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 781 544\nL 785 544\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 778 332\nL 788 332\nL 788 333\nL 778 333\nL 778 332\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 84 421\nL 144 421\nL 144 421\nA 4 4 90.00 0 1 148 425\nL 148 438\nL 148 438\nA 4 4 90.00 0 1 144 442\nL 84 442\nL 84 442\nA 4 4 90.00 0 1 80 438\nL 80 425\nL 80 425\nA 4 4 90.00 0 1 84 421\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"84\" y=\"438\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><path d=\"M 125 392\nL 217 392\nL 217 392\nA 4 4 90.00 0 1 221 396\nL 221 448\nL 221 448\nA 4 4 90.00 0 1 217 452\nL 125 452\nL 125 452\nA 4 4 90.00 0 1 121 448\nL 121 396\nL 121 396\nA 4 4 90.00 0 1 125 392\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"125\" y=\"409\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"133\" y=\"422\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><text x=\"154\" y=\"435\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><text x=\"129\" y=\"448\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text><path d=\"M 138 412\nL 198 412\nL 198 412\nA 4 4 90.00 0 1 202 416\nL 202 429\nL 202 429\nA 4 4 90.00 0 1 198 433\nL 138 433\nL 138 433\nA 4 4 90.00 0 1 134 429\nL 134 416\nL 134 416\nA 4 4 90.00 0 1 138 412\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"138\" y=\"429\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><path d=\"M 152 295\nL 244 295\nL 244 295\nA 4 4 90.00 0 1 248 299\nL 248 325\nL 248 325\nA 4 4 90.00 0 1 244 329\nL 152 329\nL 152 329\nA 4 4 90.00 0 1 148 325\nL 148 299\nL 148 299\nA 4 4 90.00 0 1 152 295\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"152\" y=\"312\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><text x=\"152\" y=\"325\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text><path d=\"M 165 485\nL 262 485\nL 262 485\nA 4 4 90.00 0 1 266 489\nL 266 502\nL 266 502\nA 4 4 90.00 0 1 262 506\nL 165 506\nL 165 506\nA 4 4 90.00 0 1 161 502\nL 161 489\nL 161 489\nA 4 4 90.00 0 1 165 485\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"165\" y=\"502\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text><path d=\"M 206 136\nL 297 136\nL 297 136\nA 4 4 90.00 0 1 301 140\nL 301 153\nL 301 153\nA 4 4 90.00 0 1 297 157\nL 206 157\nL 206 157\nA 4 4 90.00 0 1 202 153\nL 202 140\nL 202 140\nA 4 4 90.00 0 1 206 136\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"206\" y=\"153\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 233 421\nL 328 421\nL 328 421\nA 4 4 90.00 0 1 332 425\nL 332 438\nL 332 438\nA 4 4 90.00 0 1 328 442\nL 233 442\nL 233 442\nA 4 4 90.00 0 1 229 438\nL 229 425\nL 229 425\nA 4 4 90.00 0 1 233 421\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"233\" y=\"438\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V Bear Engulfing</text><path d=\"M 314 320\nL 395 320\nL 395 320\nA 4 4 90.00 0 1 399 324\nL 399 337\nL 399 337\nA 4 4 90.00 0 1 395 341\nL 314 341\nL 314 341\nA 4 4 90.00 0 1 310 337\nL 310 324\nL 310 324\nA 4 4 90.00 0 1 314 320\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"314\" y=\"337\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">| Piercing Line</text><path d=\"M 341 347\nL 415 347\nL 415 347\nA 4 4 90.00 0 1 419 351\nL 419 364\nL 419 364\nA 4 4 90.00 0 1 415 368\nL 341 368\nL 341 368\nA 4 4 90.00 0 1 337 364\nL 337 351\nL 337 351\nA 4 4 90.00 0 1 341 347\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"341\" y=\"364\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text><path d=\"M 355 255\nL 436 255\nL 436 255\nA 4 4 90.00 0 1 440 259\nL 440 272\nL 440 272\nA 4 4 90.00 0 1 436 276\nL 355 276\nL 355 276\nA 4 4 90.00 0 1 351 272\nL 351 259\nL 351 259\nA 4 4 90.00 0 1 355 255\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"355\" y=\"272\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">| Piercing Line</text><path d=\"M 436 163\nL 520 163\nL 520 163\nA 4 4 90.00 0 1 524 167\nL 524 180\nL 524 180\nA 4 4 90.00 0 1 520 184\nL 436 184\nL 436 184\nA 4 4 90.00 0 1 432 180\nL 432 167\nL 432 167\nA 4 4 90.00 0 1 436 163\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"436\" y=\"180\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text><path d=\"M 476 301\nL 558 301\nL 558 301\nA 4 4 90.00 0 1 562 305\nL 562 318\nL 562 318\nA 4 4 90.00 0 1 558 322\nL 476 322\nL 476 322\nA 4 4 90.00 0 1 472 318\nL 472 305\nL 472 305\nA 4 4 90.00 0 1 476 301\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"476\" y=\"318\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">⁎ Evening Star</text><path d=\"M 639 249\nL 731 249\nL 731 249\nA 4 4 90.00 0 1 735 253\nL 735 279\nL 735 279\nA 4 4 90.00 0 1 731 283\nL 639 283\nL 639 283\nA 4 4 90.00 0 1 635 279\nL 635 253\nL 635 253\nA 4 4 90.00 0 1 639 249\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"639\" y=\"266\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"643\" y=\"279\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text><path d=\"M 666 208\nL 758 208\nL 758 208\nA 4 4 90.00 0 1 762 212\nL 762 264\nL 762 264\nA 4 4 90.00 0 1 758 268\nL 666 268\nL 666 268\nA 4 4 90.00 0 1 662 264\nL 662 212\nL 662 212\nA 4 4 90.00 0 1 666 208\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"666\" y=\"225\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"674\" y=\"238\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><text x=\"695\" y=\"251\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><text x=\"670\" y=\"264\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text><path d=\"M 693 242\nL 761 242\nL 761 242\nA 4 4 90.00 0 1 765 246\nL 765 285\nL 765 285\nA 4 4 90.00 0 1 761 289\nL 693 289\nL 693 289\nA 4 4 90.00 0 1 689 285\nL 689 246\nL 689 246\nA 4 4 90.00 0 1 693 242\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"697\" y=\"259\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"693\" y=\"272\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><text x=\"710\" y=\"285\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><path d=\"M 767 319\nL 800 319\nL 800 319\nA 4 4 90.00 0 1 804 323\nL 804 336\nL 804 336\nA 4 4 90.00 0 1 800 340\nL 767 340\nL 767 340\nA 4 4 90.00 0 1 763 336\nL 763 323\nL 763 323\nA 4 4 90.00 0 1 767 319\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"767\" y=\"336\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">153</text><text x=\"9\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">146</text><text x=\"9\" y=\"144\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">139</text><text x=\"9\" y=\"208\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"9\" y=\"272\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">125</text><text x=\"9\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">118</text><text x=\"9\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111</text><text x=\"9\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">104</text><text x=\"18\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">97</text><text x=\"18\" y=\"594\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 790 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 74\nL 790 74\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 138\nL 790 138\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 203\nL 790 203\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 267\nL 790 267\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 332\nL 790 332\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 396\nL 790 396\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 461\nL 790 461\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 525\nL 790 525\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52.76 405.87\nL 52.76 451.9\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 52.76 497.94\nL 52.76 543.97\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 50.06 405.87\nL 55.47 405.87\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 50.06 543.97\nL 55.47 543.97\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 47.35 451.9\nL 58.17 451.9\nL 58.17 497.94\nL 47.35 497.94\nL 47.35 451.9\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 66.29 424.29\nL 66.29 433.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 66.29 451.9\nL 66.29 479.52\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 63.59 424.29\nL 69 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 63.59 479.52\nL 69 479.52\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 60.88 433.49\nL 71.7 433.49\nL 71.7 451.9\nL 60.88 451.9\nL 60.88 433.49\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 79.82 415.08\nL 79.82 424.29\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 79.82 433.49\nL 79.82 516.35\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 77.11 415.08\nL 82.52 415.08\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 77.11 516.35\nL 82.52 516.35\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 74.41 424.29\nL 85.23 424.29\nL 85.23 433.49\nL 74.41 433.49\nL 74.41 424.29\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 93.35 479.52\nL 93.35 497.94\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 93.35 543.97\nL 93.35 553.17\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 90.64 479.52\nL 96.05 479.52\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 90.64 553.17\nL 96.05 553.17\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 87.93 497.94\nL 98.76 497.94\nL 98.76 543.97\nL 87.93 543.97\nL 87.93 497.94\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 106.87 359.84\nL 106.87 387.46\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 106.87 442.7\nL 106.87 451.9\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 104.17 359.84\nL 109.58 359.84\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 104.17 451.9\nL 109.58 451.9\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 101.46 387.46\nL 112.28 387.46\nL 112.28 442.7\nL 101.46 442.7\nL 101.46 387.46\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 120.4 313.81\nL 120.4 423.37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 120.4 424.29\nL 120.4 433.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 117.69 313.81\nL 123.11 313.81\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 117.69 433.49\nL 123.11 433.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 114.99 423.37\nL 125.81 423.37\nL 125.81 424.29\nL 114.99 424.29\nL 114.99 423.37\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 133.93 396.67\nL 133.93 415.08\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 133.93 424.29\nL 133.93 590\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 131.22 396.67\nL 136.63 396.67\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 131.22 590\nL 136.63 590\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 128.52 415.08\nL 139.34 415.08\nL 139.34 424.29\nL 128.52 424.29\nL 128.52 415.08\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 144.75 313.81\nL 150.16 313.81\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 144.75 497.94\nL 150.16 497.94\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 142.04 313.81\nL 152.87 313.81\nL 152.87 497.94\nL 142.04 497.94\nL 142.04 313.81\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 158.28 313.81\nL 163.69 313.81\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 158.28 497.94\nL 163.69 497.94\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 155.57 313.81\nL 166.39 313.81\nL 166.39 497.94\nL 155.57 497.94\nL 155.57 313.81\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 174.51 313.81\nL 174.51 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 174.51 405.87\nL 174.51 433.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 171.8 313.81\nL 177.21 313.81\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 171.8 433.49\nL 177.21 433.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 169.1 332.22\nL 179.92 332.22\nL 179.92 405.87\nL 169.1 405.87\nL 169.1 332.22\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 188.04 175.71\nL 188.04 221.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 188.04 359.84\nL 188.04 405.87\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 185.33 175.71\nL 190.74 175.71\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 185.33 405.87\nL 190.74 405.87\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 182.63 221.75\nL 193.45 221.75\nL 193.45 359.84\nL 182.63 359.84\nL 182.63 221.75\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 201.56 129.68\nL 201.56 148.1\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 201.56 405.87\nL 201.56 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 198.86 129.68\nL 204.27 129.68\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 198.86 424.29\nL 204.27 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 196.15 148.1\nL 206.97 148.1\nL 206.97 405.87\nL 196.15 405.87\nL 196.15 148.1\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 215.09 129.68\nL 215.09 148.1\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 215.09 405.87\nL 215.09 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 212.39 129.68\nL 217.8 129.68\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 212.39 424.29\nL 217.8 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 209.68 148.1\nL 220.5 148.1\nL 220.5 405.87\nL 209.68 405.87\nL 209.68 148.1\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 228.62 111.27\nL 228.62 129.68\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 228.62 433.49\nL 228.62 451.9\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 225.91 111.27\nL 231.32 111.27\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 225.91 451.9\nL 231.32 451.9\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 223.21 129.68\nL 234.03 129.68\nL 234.03 433.49\nL 223.21 433.49\nL 223.21 129.68\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 242.15 175.71\nL 242.15 221.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 242.15 451.9\nL 242.15 497.94\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 239.44 175.71\nL 244.85 175.71\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 239.44 497.94\nL 244.85 497.94\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 236.73 221.75\nL 247.56 221.75\nL 247.56 451.9\nL 236.73 451.9\nL 236.73 221.75\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 255.67 267.78\nL 255.67 313.81\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 255.67 405.87\nL 255.67 543.97\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 252.97 267.78\nL 258.38 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 252.97 543.97\nL 258.38 543.97\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 250.26 313.81\nL 261.08 313.81\nL 261.08 405.87\nL 250.26 405.87\nL 250.26 313.81\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 269.2 175.71\nL 269.2 221.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 269.2 497.94\nL 269.2 516.35\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 266.49 175.71\nL 271.91 175.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 266.49 516.35\nL 271.91 516.35\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 263.79 221.75\nL 274.61 221.75\nL 274.61 497.94\nL 263.79 497.94\nL 263.79 221.75\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 282.73 240.16\nL 282.73 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 282.73 295.4\nL 282.73 313.81\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 280.02 240.16\nL 285.43 240.16\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 280.02 313.81\nL 285.43 313.81\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 277.32 267.78\nL 288.14 267.78\nL 288.14 295.4\nL 277.32 295.4\nL 277.32 267.78\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 296.25 267.78\nL 296.25 313.81\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 296.25 387.46\nL 296.25 405.87\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 293.55 267.78\nL 298.96 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 293.55 405.87\nL 298.96 405.87\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 290.84 313.81\nL 301.67 313.81\nL 301.67 387.46\nL 290.84 387.46\nL 290.84 313.81\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 309.78 267.78\nL 309.78 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 309.78 424.29\nL 309.78 433.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 307.08 267.78\nL 312.49 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 307.08 433.49\nL 312.49 433.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 304.37 332.22\nL 315.19 332.22\nL 315.19 424.29\nL 304.37 424.29\nL 304.37 332.22\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 323.31 267.78\nL 323.31 286.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 323.31 405.87\nL 323.31 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 320.6 267.78\nL 326.01 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 320.6 424.29\nL 326.01 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 317.9 286.19\nL 328.72 286.19\nL 328.72 405.87\nL 317.9 405.87\nL 317.9 286.19\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 336.84 221.75\nL 336.84 240.16\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 336.84 359.84\nL 336.84 387.46\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 334.13 221.75\nL 339.54 221.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 334.13 387.46\nL 339.54 387.46\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 331.43 240.16\nL 342.25 240.16\nL 342.25 359.84\nL 331.43 359.84\nL 331.43 240.16\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 350.36 221.75\nL 350.36 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 350.36 405.87\nL 350.36 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 347.66 221.75\nL 353.07 221.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 347.66 424.29\nL 353.07 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 344.95 267.78\nL 355.77 267.78\nL 355.77 405.87\nL 344.95 405.87\nL 344.95 267.78\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 363.89 221.75\nL 363.89 286.19\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 363.89 332.22\nL 363.89 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 361.19 221.75\nL 366.6 221.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 361.19 359.84\nL 366.6 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 358.48 286.19\nL 369.3 286.19\nL 369.3 332.22\nL 358.48 332.22\nL 358.48 286.19\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 377.42 267.78\nL 377.42 313.81\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 377.42 451.9\nL 377.42 497.94\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 374.71 267.78\nL 380.12 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 374.71 497.94\nL 380.12 497.94\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 372.01 313.81\nL 382.83 313.81\nL 382.83 451.9\nL 372.01 451.9\nL 372.01 313.81\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 390.95 359.84\nL 390.95 387.46\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 390.95 424.29\nL 390.95 497.94\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 388.24 359.84\nL 393.65 359.84\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 388.24 497.94\nL 393.65 497.94\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 385.53 387.46\nL 396.36 387.46\nL 396.36 424.29\nL 385.53 424.29\nL 385.53 387.46\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 404.47 175.71\nL 404.47 221.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 404.47 359.84\nL 404.47 405.87\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 401.77 175.71\nL 407.18 175.71\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 401.77 405.87\nL 407.18 405.87\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 399.06 221.75\nL 409.88 221.75\nL 409.88 359.84\nL 399.06 359.84\nL 399.06 221.75\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 418 387.46\nL 418 405.87\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 418 424.29\nL 418 433.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 415.29 387.46\nL 420.71 387.46\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 415.29 433.49\nL 420.71 433.49\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 412.59 405.87\nL 423.41 405.87\nL 423.41 424.29\nL 412.59 424.29\nL 412.59 405.87\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 431.53 129.68\nL 431.53 175.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 431.53 359.84\nL 431.53 378.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.82 129.68\nL 434.23 129.68\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 428.82 378.25\nL 434.23 378.25\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 426.12 175.71\nL 436.94 175.71\nL 436.94 359.84\nL 426.12 359.84\nL 426.12 175.71\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 445.05 129.68\nL 445.05 175.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 445.05 405.87\nL 445.05 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 442.35 129.68\nL 447.76 129.68\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 442.35 424.29\nL 447.76 424.29\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 439.64 175.71\nL 450.47 175.71\nL 450.47 405.87\nL 439.64 405.87\nL 439.64 175.71\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 458.58 83.65\nL 458.58 129.68\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 458.58 157.3\nL 458.58 166.51\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 455.88 83.65\nL 461.29 83.65\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 455.88 166.51\nL 461.29 166.51\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 453.17 129.68\nL 463.99 129.68\nL 463.99 157.3\nL 453.17 157.3\nL 453.17 129.68\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 472.11 166.51\nL 472.11 175.71\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 472.11 313.81\nL 472.11 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 469.4 166.51\nL 474.81 166.51\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 469.4 359.84\nL 474.81 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 466.7 175.71\nL 477.52 175.71\nL 477.52 313.81\nL 466.7 313.81\nL 466.7 175.71\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 485.64 267.78\nL 485.64 313.81\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 485.64 359.84\nL 485.64 405.87\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482.93 267.78\nL 488.34 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 482.93 405.87\nL 488.34 405.87\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 480.23 313.81\nL 491.05 313.81\nL 491.05 359.84\nL 480.23 359.84\nL 480.23 313.81\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 499.16 240.16\nL 499.16 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 499.16 332.22\nL 499.16 350.63\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 496.46 240.16\nL 501.87 240.16\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 496.46 350.63\nL 501.87 350.63\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 493.75 267.78\nL 504.57 267.78\nL 504.57 332.22\nL 493.75 332.22\nL 493.75 267.78\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 512.69 175.71\nL 512.69 203.33\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 512.69 249.37\nL 512.69 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 509.99 175.71\nL 515.4 175.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 509.99 267.78\nL 515.4 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 507.28 203.33\nL 518.1 203.33\nL 518.1 249.37\nL 507.28 249.37\nL 507.28 203.33\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 526.22 111.27\nL 526.22 129.68\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 526.22 184.92\nL 526.22 203.33\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 523.51 111.27\nL 528.92 111.27\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 523.51 203.33\nL 528.92 203.33\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 520.81 129.68\nL 531.63 129.68\nL 531.63 184.92\nL 520.81 184.92\nL 520.81 129.68\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 539.75 83.65\nL 539.75 111.27\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 539.75 221.75\nL 539.75 240.16\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 537.04 83.65\nL 542.45 83.65\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 537.04 240.16\nL 542.45 240.16\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 534.33 111.27\nL 545.16 111.27\nL 545.16 221.75\nL 534.33 221.75\nL 534.33 111.27\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 553.27 129.68\nL 553.27 148.1\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 553.27 203.33\nL 553.27 240.16\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 550.57 129.68\nL 555.98 129.68\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 550.57 240.16\nL 555.98 240.16\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 547.86 148.1\nL 558.68 148.1\nL 558.68 203.33\nL 547.86 203.33\nL 547.86 148.1\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 566.8 203.33\nL 566.8 221.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 566.8 267.78\nL 566.8 313.81\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 564.09 203.33\nL 569.51 203.33\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 564.09 313.81\nL 569.51 313.81\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 561.39 221.75\nL 572.21 221.75\nL 572.21 267.78\nL 561.39 267.78\nL 561.39 221.75\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 580.33 249.37\nL 580.33 276.98\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 580.33 332.22\nL 580.33 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 577.62 249.37\nL 583.03 249.37\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 577.62 359.84\nL 583.03 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 574.92 276.98\nL 585.74 276.98\nL 585.74 332.22\nL 574.92 332.22\nL 574.92 276.98\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 593.85 313.81\nL 593.85 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 593.85 359.84\nL 593.85 369.05\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 591.15 313.81\nL 596.56 313.81\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 591.15 369.05\nL 596.56 369.05\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 588.44 332.22\nL 599.27 332.22\nL 599.27 359.84\nL 588.44 359.84\nL 588.44 332.22\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 607.38 267.78\nL 607.38 295.4\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 607.38 332.22\nL 607.38 359.84\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 604.68 267.78\nL 610.09 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 604.68 359.84\nL 610.09 359.84\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 601.97 295.4\nL 612.79 295.4\nL 612.79 332.22\nL 601.97 332.22\nL 601.97 295.4\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 620.91 267.78\nL 620.91 276.98\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 620.91 313.81\nL 620.91 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 618.2 267.78\nL 623.61 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 618.2 332.22\nL 623.61 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 615.5 276.98\nL 626.32 276.98\nL 626.32 313.81\nL 615.5 313.81\nL 615.5 276.98\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 634.44 129.68\nL 634.44 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 634.44 286.19\nL 634.44 295.4\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 631.73 129.68\nL 637.14 129.68\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 631.73 295.4\nL 637.14 295.4\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 629.03 267.78\nL 639.85 267.78\nL 639.85 286.19\nL 629.03 286.19\nL 629.03 267.78\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 647.96 221.75\nL 647.96 240.16\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 647.96 267.78\nL 647.96 286.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 645.26 221.75\nL 650.67 221.75\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 645.26 286.19\nL 650.67 286.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 642.55 240.16\nL 653.37 240.16\nL 653.37 267.78\nL 642.55 267.78\nL 642.55 240.16\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 661.49 83.65\nL 661.49 239.7\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 661.49 240.16\nL 661.49 249.37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 658.79 83.65\nL 664.2 83.65\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 658.79 249.37\nL 664.2 249.37\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 656.08 239.7\nL 666.9 239.7\nL 666.9 240.16\nL 656.08 240.16\nL 656.08 239.7\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 675.02 221.75\nL 675.02 240.16\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 675.02 258.57\nL 675.02 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 672.31 221.75\nL 677.72 221.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 672.31 267.78\nL 677.72 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 669.61 240.16\nL 680.43 240.16\nL 680.43 258.57\nL 669.61 258.57\nL 669.61 240.16\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 688.55 258.57\nL 688.55 267.32\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 688.55 267.78\nL 688.55 405.87\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 685.84 258.57\nL 691.25 258.57\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 685.84 405.87\nL 691.25 405.87\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 683.13 267.32\nL 693.96 267.32\nL 693.96 267.78\nL 683.13 267.78\nL 683.13 267.32\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 702.07 249.37\nL 702.07 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 702.07 332.22\nL 702.07 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 699.37 249.37\nL 704.78 249.37\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 699.37 359.84\nL 704.78 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 696.66 267.78\nL 707.48 267.78\nL 707.48 332.22\nL 696.66 332.22\nL 696.66 267.78\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 715.6 267.78\nL 715.6 286.19\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 715.6 313.81\nL 715.6 359.84\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 712.89 267.78\nL 718.31 267.78\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 712.89 359.84\nL 718.31 359.84\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 710.19 286.19\nL 721.01 286.19\nL 721.01 313.81\nL 710.19 313.81\nL 710.19 286.19\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 729.13 175.71\nL 729.13 194.13\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 729.13 313.81\nL 729.13 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 726.42 175.71\nL 731.83 175.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 726.42 332.22\nL 731.83 332.22\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 723.72 194.13\nL 734.54 194.13\nL 734.54 313.81\nL 723.72 313.81\nL 723.72 194.13\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 742.65 194.13\nL 742.65 203.33\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 742.65 258.57\nL 742.65 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 739.95 194.13\nL 745.36 194.13\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 739.95 267.78\nL 745.36 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 737.24 203.33\nL 748.07 203.33\nL 748.07 258.57\nL 737.24 258.57\nL 737.24 203.33\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 756.18 212.54\nL 756.18 221.75\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 756.18 295.4\nL 756.18 304.6\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 753.48 212.54\nL 758.89 212.54\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 753.48 304.6\nL 758.89 304.6\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 750.77 221.75\nL 761.59 221.75\nL 761.59 295.4\nL 750.77 295.4\nL 750.77 221.75\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 769.71 258.57\nL 769.71 267.78\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 769.71 350.63\nL 769.71 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 767 258.57\nL 772.41 258.57\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 767 359.84\nL 772.41 359.84\" style=\"stroke-width:1;stroke:rgb(239,68,68);fill:none\"/><path d=\"M 764.3 267.78\nL 775.12 267.78\nL 775.12 350.63\nL 764.3 350.63\nL 764.3 267.78\" style=\"stroke:none;fill:rgb(239,68,68)\"/><path d=\"M 783.24 175.71\nL 783.24 331.3\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 783.24 332.22\nL 783.24 543.97\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 780.53 175.71\nL 785.94 175.71\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 780.53 543.97\nL 785.94 543.97\" style=\"stroke-width:1;stroke:rgb(34,197,94);fill:none\"/><path d=\"M 777.83 331.3\nL 788.65 331.3\nL 788.65 332.22\nL 777.83 332.22\nL 777.83 331.3\" style=\"stroke:none;fill:rgb(34,197,94)\"/><path d=\"M 85 420\nL 145 420\nL 145 420\nA 4 4 90.00 0 1 149 424\nL 149 437\nL 149 437\nA 4 4 90.00 0 1 145 441\nL 85 441\nL 85 441\nA 4 4 90.00 0 1 81 437\nL 81 424\nL 81 424\nA 4 4 90.00 0 1 85 420\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"85\" y=\"437\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><path d=\"M 125 391\nL 217 391\nL 217 391\nA 4 4 90.00 0 1 221 395\nL 221 447\nL 221 447\nA 4 4 90.00 0 1 217 451\nL 125 451\nL 125 451\nA 4 4 90.00 0 1 121 447\nL 121 395\nL 121 395\nA 4 4 90.00 0 1 125 391\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"125\" y=\"408\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"133\" y=\"421\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><text x=\"154\" y=\"434\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><text x=\"129\" y=\"447\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text><path d=\"M 139 411\nL 199 411\nL 199 411\nA 4 4 90.00 0 1 203 415\nL 203 428\nL 203 428\nA 4 4 90.00 0 1 199 432\nL 139 432\nL 139 432\nA 4 4 90.00 0 1 135 428\nL 135 415\nL 135 415\nA 4 4 90.00 0 1 139 411\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"139\" y=\"428\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><path d=\"M 152 295\nL 244 295\nL 244 295\nA 4 4 90.00 0 1 248 299\nL 248 325\nL 248 325\nA 4 4 90.00 0 1 244 329\nL 152 329\nL 152 329\nA 4 4 90.00 0 1 148 325\nL 148 299\nL 148 299\nA 4 4 90.00 0 1 152 295\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"152\" y=\"312\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><text x=\"152\" y=\"325\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">^ Bull Marubozu</text><path d=\"M 166 485\nL 263 485\nL 263 485\nA 4 4 90.00 0 1 267 489\nL 267 502\nL 267 502\nA 4 4 90.00 0 1 263 506\nL 166 506\nL 166 506\nA 4 4 90.00 0 1 162 502\nL 162 489\nL 162 489\nA 4 4 90.00 0 1 166 485\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"166\" y=\"502\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">v Bear Marubozu</text><path d=\"M 207 135\nL 298 135\nL 298 135\nA 4 4 90.00 0 1 302 139\nL 302 152\nL 302 152\nA 4 4 90.00 0 1 298 156\nL 207 156\nL 207 156\nA 4 4 90.00 0 1 203 152\nL 203 139\nL 203 139\nA 4 4 90.00 0 1 207 135\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"207\" y=\"152\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Λ Bull Engulfing</text><path d=\"M 234 420\nL 329 420\nL 329 420\nA 4 4 90.00 0 1 333 424\nL 333 437\nL 333 437\nA 4 4 90.00 0 1 329 441\nL 234 441\nL 234 441\nA 4 4 90.00 0 1 230 437\nL 230 424\nL 230 424\nA 4 4 90.00 0 1 234 420\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"234\" y=\"437\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V Bear Engulfing</text><path d=\"M 315 319\nL 396 319\nL 396 319\nA 4 4 90.00 0 1 400 323\nL 400 336\nL 400 336\nA 4 4 90.00 0 1 396 340\nL 315 340\nL 315 340\nA 4 4 90.00 0 1 311 336\nL 311 323\nL 311 323\nA 4 4 90.00 0 1 315 319\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"315\" y=\"336\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">| Piercing Line</text><path d=\"M 342 347\nL 416 347\nL 416 347\nA 4 4 90.00 0 1 420 351\nL 420 364\nL 420 364\nA 4 4 90.00 0 1 416 368\nL 342 368\nL 342 368\nA 4 4 90.00 0 1 338 364\nL 338 351\nL 338 351\nA 4 4 90.00 0 1 342 347\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"342\" y=\"364\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ξ Dark Cloud</text><path d=\"M 355 255\nL 436 255\nL 436 255\nA 4 4 90.00 0 1 440 259\nL 440 272\nL 440 272\nA 4 4 90.00 0 1 436 276\nL 355 276\nL 355 276\nA 4 4 90.00 0 1 351 272\nL 351 259\nL 351 259\nA 4 4 90.00 0 1 355 255\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"355\" y=\"272\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">| Piercing Line</text><path d=\"M 437 163\nL 521 163\nL 521 163\nA 4 4 90.00 0 1 525 167\nL 525 180\nL 525 180\nA 4 4 90.00 0 1 521 184\nL 437 184\nL 437 184\nA 4 4 90.00 0 1 433 180\nL 433 167\nL 433 167\nA 4 4 90.00 0 1 437 163\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"437\" y=\"180\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">* Morning Star</text><path d=\"M 477 301\nL 559 301\nL 559 301\nA 4 4 90.00 0 1 563 305\nL 563 318\nL 563 318\nA 4 4 90.00 0 1 559 322\nL 477 322\nL 477 322\nA 4 4 90.00 0 1 473 318\nL 473 305\nL 473 305\nA 4 4 90.00 0 1 477 301\nZ\" style=\"stroke-width:1.2;stroke:rgb(239,68,68);fill:rgba(255,255,255,0.7)\"/><text x=\"477\" y=\"318\" style=\"stroke:none;fill:rgb(151,12,12);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">⁎ Evening Star</text><path d=\"M 639 249\nL 731 249\nL 731 249\nA 4 4 90.00 0 1 735 253\nL 735 279\nL 735 279\nA 4 4 90.00 0 1 731 283\nL 639 283\nL 639 283\nA 4 4 90.00 0 1 635 279\nL 635 253\nL 635 253\nA 4 4 90.00 0 1 639 249\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"639\" y=\"266\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"643\" y=\"279\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text><path d=\"M 666 208\nL 758 208\nL 758 208\nA 4 4 90.00 0 1 762 212\nL 762 264\nL 762 264\nA 4 4 90.00 0 1 758 268\nL 666 268\nL 666 268\nA 4 4 90.00 0 1 662 264\nL 662 212\nL 662 212\nA 4 4 90.00 0 1 666 208\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"666\" y=\"225\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">※ Shooting Star</text><text x=\"674\" y=\"238\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">† Gravestone</text><text x=\"695\" y=\"251\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><text x=\"670\" y=\"264\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ʇ Inv. Hammer</text><path d=\"M 694 241\nL 762 241\nL 762 241\nA 4 4 90.00 0 1 766 245\nL 766 284\nL 766 284\nA 4 4 90.00 0 1 762 288\nL 694 288\nL 694 288\nA 4 4 90.00 0 1 690 284\nL 690 245\nL 690 245\nA 4 4 90.00 0 1 694 241\nZ\" style=\"stroke-width:1.2;stroke:rgb(34,197,94);fill:rgba(255,255,255,0.7)\"/><text x=\"698\" y=\"258\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Γ Hammer</text><text x=\"694\" y=\"271\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ψ Dragonfly</text><text x=\"711\" y=\"284\" style=\"stroke:none;fill:rgb(12,75,35);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text><path d=\"M 767 318\nL 800 318\nL 800 318\nA 4 4 90.00 0 1 804 322\nL 804 335\nL 804 335\nA 4 4 90.00 0 1 800 339\nL 767 339\nL 767 339\nA 4 4 90.00 0 1 763 335\nL 763 322\nL 763 322\nA 4 4 90.00 0 1 767 318\nZ\" style=\"stroke-width:1.2;stroke:rgb(200,200,200);fill:rgba(255,255,255,0.7)\"/><text x=\"767\" y=\"335\" style=\"stroke:none;fill:rgb(128,128,128);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">± Doji</text></svg>",
 			pngCRC: 0x3952a374,
 		},
 	}
@@ -2,6 +2,7 @@ package charts
 
 import (
 	"errors"
+	"image/draw"
 
 	"github.com/golang/freetype/truetype"
 )
@@ -9,12 +10,35 @@ import (
 // ChartOption represents a generic method of representing a chart. This can be useful when you want to render
 // different chart types with the same data and configuration.
 type ChartOption struct {
-	// OutputFormat specifies the output type of chart: "svg", "png", or "jpg". Default is "png".
+	// OutputFormat specifies the output type of chart: "svg", "png", "jpg", "pdf", or "terminal". Default is "png".
 	OutputFormat string
 	// Width is the width of the chart.
 	Width int
 	// Height is the height of the chart.
 	Height int
+	// AutoSize when set computes the chart dimensions from its content. Horizontal bar and funnel charts grow in
+	// height, and vertical bar and candlestick charts grow in width, so that each category has room. Width and
+	// Height specify the target size, with the dimension determined by the content only growing beyond it.
+	AutoSize *AutoSizeOption
+	// ScaleFactor renders PNG and JPG output at a multiplied pixel density, for example 2 for high-DPI displays,
+	// while the chart is laid out at Width and Height. Ignored for SVG and PDF output.
+	ScaleFactor float64
+	// Image when set renders PNG and JPG output directly onto the provided image, which can be a sub-image to render
+	// into a region of a larger image. Width and Height default to the image size divided by the ScaleFactor.
+	// Ignored for SVG and PDF output.
+	Image draw.Image
+	// TransparentBackground when set to *true skips drawing the chart background, leaving PNG and SVG output
+	// transparent. JPG output is composited over white.
+	TransparentBackground *bool
+	// Encoder configures the JPG quality and PNG compression of raster output.
+	Encoder EncoderOptions
+	// Terminal configures the character mode, width and colors for terminal output.
+	Terminal TerminalOptions
+	// Watermark draws an image, such as a logo, over or behind the chart.
+	Watermark *WatermarkOption
+	// LabelLayout when set places the labels of all series together, moving or hiding labels to avoid overlaps
+	// between series, chart types, and mark points.
+	LabelLayout *LabelLayoutOption
 	// Theme specifies the colors used for the chart. Built in themes can be loaded using GetTheme with
 	// "light", "dark", "vivid-light", "vivid-dark", "ant" or "grafana".
 	Theme ColorPalette
@@ -44,10 +68,14 @@ type ChartOption struct {
 	Symbol Symbol // TODO - v0.6 - consider combining symbol with size into a SymbolStyle struct
 	// LineStrokeWidth is the stroke width for line charts.
 	LineStrokeWidth float64
+	// StepMode renders line charts as step lines: LineStepBefore, LineStepAfter, or LineStepMiddle.
+	StepMode string
 	// FillArea when set to *true fills the area under the line in line charts.
 	FillArea *bool
 	// FillOpacity is the opacity or alpha channel (0-255) of the area fill in line charts.
 	FillOpacity uint8
+	// FillGradient when set to *true fills line chart areas with a vertical gradient fading to transparent.
+	FillGradient *bool
 	// Deprecated: BarWidth is deprecated, instead use BarSize.
 	BarWidth int
 	// Deprecated: BarHeight is deprecated, instead use BarSize.
@@ -61,8 +89,16 @@ type ChartOption struct {
 	// Children are child charts to render together.
 	Children []ChartOption
 	parent   *Painter
+	// plotProbe when set captures the plot area and stops the render before any series are drawn.
+	plotProbe *Box
 	// ValueFormatter formats numeric values into labels.
 	ValueFormatter ValueFormatter
+	// Interactive enables interactive SVG output, grouping chart elements with their data and optionally embedding
+	// tooltip and legend toggle scripts. Ignored for other output formats.
+	Interactive *InteractiveOption
+	// Accessible enables accessible output, setting the chart title and subtitle as the document title and
+	// description, labeling series and data elements with ARIA attributes, and optionally embedding a data table.
+	Accessible *AccessibleOption
 }
 
 // OptionFunc is a function that modifies ChartOption.
@@ -83,6 +119,73 @@ func JPGOutputOptionFunc() OptionFunc {
 	return outputFormatOptionFunc(ChartOutputJPG)
 }
 
+// PDFOutputOptionFunc sets PDF as the output format for the chart, producing a single page vector document.
+func PDFOutputOptionFunc() OptionFunc {
+	return outputFormatOptionFunc(ChartOutputPDF)
+}
+
+// TerminalOutputOptionFunc sets terminal text as the output format for the chart, rendered with Unicode braille or
+// block characters, or as Sixel graphics, based on the provided options.
+func TerminalOutputOptionFunc(opt TerminalOptions) OptionFunc {
+	return func(o *ChartOption) {
+		o.OutputFormat = ChartOutputTerminal
+		o.Terminal = opt
+	}
+}
+
+// InteractiveSVGOptionFunc sets SVG as the output format and enables interactive output with the provided options.
+func InteractiveSVGOptionFunc(opt InteractiveOption) OptionFunc {
+	return func(o *ChartOption) {
+		o.OutputFormat = ChartOutputSVG
+		o.Interactive = &opt
+	}
+}
+
+// ScaleFactorOptionFunc sets the pixel density multiplier for raster output, for example 2 renders a chart laid out
+// at 600x400 into a 1200x800 image.
+func ScaleFactorOptionFunc(scale float64) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.ScaleFactor = scale
+	}
+}
+
+// ImageOptionFunc sets an image for PNG and JPG output to be rendered onto directly. By default the chart is sized to
+// fill the image.
+func ImageOptionFunc(img draw.Image) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Image = img
+	}
+}
+
+// TransparentBackgroundOptionFunc sets the chart to render without a background.
+func TransparentBackgroundOptionFunc() OptionFunc {
+	return func(opt *ChartOption) {
+		opt.TransparentBackground = Ptr(true)
+	}
+}
+
+// EncoderOptionFunc sets the JPG quality and PNG compression options for raster output.
+func EncoderOptionFunc(encoder EncoderOptions) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Encoder = encoder
+	}
+}
+
+// WatermarkOptionFunc sets an image, such as a logo, to be drawn over or behind the chart.
+func WatermarkOptionFunc(watermark WatermarkOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Watermark = &watermark
+	}
+}
+
+// AccessibleSVGOptionFunc sets SVG as the output format and enables accessible output with the provided options.
+func AccessibleSVGOptionFunc(opt AccessibleOption) OptionFunc {
+	return func(o *ChartOption) {
+		o.OutputFormat = ChartOutputSVG
+		o.Accessible = &opt
+	}
+}
+
 // outputFormatOptionFunc sets the output format type for the chart.
 func outputFormatOptionFunc(t string) OptionFunc {
 	return func(opt *ChartOption) {
@@ -237,6 +340,11 @@ func MarkPointOptionFunc(seriesIndex int, markPointTypes ...string) OptionFunc {
 }
 
 func (o *ChartOption) fillDefault() error {
+	if img := rasterTargetImage(o.OutputFormat, o.Image); img != nil {
+		width, height := imageLayoutSize(img, o.ScaleFactor)
+		o.Width = getDefaultInt(o.Width, width)
+		o.Height = getDefaultInt(o.Height, height)
+	}
 	o.Width = getDefaultInt(o.Width, defaultChartWidth)
 	o.Height = getDefaultInt(o.Height, defaultChartHeight)
 
@@ -264,6 +372,24 @@ func (o *ChartOption) fillDefault() error {
 	return nil
 }
 
+// newPainter returns a painter for rendering the chart as a top level chart.
+func (o *ChartOption) newPainter() *Painter {
+	return NewPainter(PainterOptions{
+		OutputFormat: o.OutputFormat,
+		Width:        o.Width,
+		Height:       o.Height,
+		Font:         o.Font,
+		Interactive:  o.Interactive,
+		Accessible:   o.Accessible,
+		ScaleFactor:  o.ScaleFactor,
+		Image:        o.Image,
+
+		TransparentBackground: o.TransparentBackground,
+		Encoder:               o.Encoder,
+		Terminal:              o.Terminal,
+	})
+}
+
 func fillThemeDefaults(defaultTheme ColorPalette, title *TitleOption, legend *LegendOption,
 	xaxis *XAxisOption, yaxisOptions []YAxisOption) {
 	if title.Theme == nil {
@@ -1,9 +1,14 @@
 package charts
 
 import (
+	"bytes"
 	"fmt"
+	"image"
+	"image/png"
 	"strconv"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -117,7 +122,150 @@ func TestLineRender(t *testing.T) {
 	require.NoError(t, err)
 	data, err := p.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 21 45\nL 51 45\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"36\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"53\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 112 45\nL 142 45\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"127\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"144\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 235 45\nL 265 45\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"250\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"267\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 357 45\nL 387 45\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"372\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"389\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 450 45\nL 480 45\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"465\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"482\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><text x=\"19\" y=\"73\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1440</text><text x=\"19\" y=\"104\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1280</text><text x=\"19\" y=\"136\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1120</text><text x=\"27\" y=\"168\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"27\" y=\"199\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"27\" y=\"231\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"27\" y=\"263\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"27\" y=\"294\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"27\" y=\"326\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"45\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 60 67\nL 580 67\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 98\nL 580 98\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 130\nL 580 130\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 162\nL 580 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 194\nL 580 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 226\nL 580 226\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 258\nL 580 258\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 290\nL 580 290\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 322\nL 580 322\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 64 359\nL 64 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 137 359\nL 137 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 359\nL 211 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 285 359\nL 285 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 358 359\nL 358 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 432 359\nL 432 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 506 359\nL 506 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"85\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"161\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"233\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"308\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"386\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"458\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"530\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 100 331\nL 174 328\nL 248 334\nL 321 328\nL 395 337\nL 469 309\nL 543 313\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"100\" cy=\"331\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"174\" cy=\"328\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"334\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"321\" cy=\"328\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"395\" cy=\"337\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"469\" cy=\"309\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"543\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 100 311\nL 174 318\nL 248 316\nL 321 308\nL 395 297\nL 469 289\nL 543 293\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"100\" cy=\"311\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"174\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"316\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"321\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"395\" cy=\"297\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"469\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"543\" cy=\"293\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 100 325\nL 174 308\nL 248 314\nL 321 324\nL 395 317\nL 469 289\nL 543 273\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"100\" cy=\"325\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"174\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"248\" cy=\"314\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"321\" cy=\"324\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"395\" cy=\"317\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"469\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"543\" cy=\"273\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 100 291\nL 174 288\nL 248 295\nL 321 288\nL 395 277\nL 469 289\nL 543 291\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"100\" cy=\"291\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"174\" cy=\"288\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"248\" cy=\"295\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"321\" cy=\"288\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"395\" cy=\"277\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"469\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"543\" cy=\"291\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><path d=\"M 100 191\nL 174 169\nL 248 175\nL 321 168\nL 395 97\nL 469 89\nL 543 91\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"100\" cy=\"191\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"174\" cy=\"169\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"248\" cy=\"175\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"321\" cy=\"168\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"395\" cy=\"97\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"469\" cy=\"89\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"543\" cy=\"91\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/></svg>", data)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 21 45\nL 51 45\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"36\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"53\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 112 45\nL 142 45\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"127\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"144\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 235 45\nL 265 45\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"250\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"267\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 357 45\nL 387 45\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"372\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"389\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 450 45\nL 480 45\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"465\" cy=\"45\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"482\" y=\"51\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><text x=\"19\" y=\"73\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1440</text><text x=\"19\" y=\"104\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1280</text><text x=\"19\" y=\"136\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1120</text><text x=\"27\" y=\"168\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"27\" y=\"199\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"27\" y=\"231\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"27\" y=\"263\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"27\" y=\"294\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"27\" y=\"326\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"45\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 60 67\nL 580 67\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 98\nL 580 98\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 130\nL 580 130\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 162\nL 580 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 194\nL 580 194\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 226\nL 580 226\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 258\nL 580 258\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 290\nL 580 290\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 60 322\nL 580 322\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 64 359\nL 64 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 137 359\nL 137 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 359\nL 211 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 285 359\nL 285 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 358 359\nL 358 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 432 359\nL 432 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 506 359\nL 506 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"85\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"161\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"233\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"308\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"386\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"458\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"530\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 100.86 330.08\nL 174.57 327.69\nL 248.29 333.87\nL 322 327.29\nL 395.71 336.06\nL 469.43 308.16\nL 543.14 312.15\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"100.86\" cy=\"330.08\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"174.57\" cy=\"327.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248.29\" cy=\"333.87\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"322\" cy=\"327.29\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"395.71\" cy=\"336.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"469.43\" cy=\"308.16\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"543.14\" cy=\"312.15\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 100.86 310.15\nL 174.57 317.73\nL 248.29 315.93\nL 322 307.36\nL 395.71 296.2\nL 469.43 288.23\nL 543.14 292.22\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"100.86\" cy=\"310.15\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"174.57\" cy=\"317.73\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248.29\" cy=\"315.93\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"322\" cy=\"307.36\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"395.71\" cy=\"296.2\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"469.43\" cy=\"288.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"543.14\" cy=\"292.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 100.86 324.1\nL 174.57 307.76\nL 248.29 313.94\nL 322 323.31\nL 395.71 316.13\nL 469.43 288.23\nL 543.14 272.28\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"100.86\" cy=\"324.1\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"174.57\" cy=\"307.76\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"248.29\" cy=\"313.94\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"322\" cy=\"323.31\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"395.71\" cy=\"316.13\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"469.43\" cy=\"288.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"543.14\" cy=\"272.28\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 100.86 290.22\nL 174.57 287.83\nL 248.29 294.01\nL 322 287.43\nL 395.71 276.27\nL 469.43 288.23\nL 543.14 290.22\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"100.86\" cy=\"290.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"174.57\" cy=\"287.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"248.29\" cy=\"294.01\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"322\" cy=\"287.43\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"395.71\" cy=\"276.27\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"469.43\" cy=\"288.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"543.14\" cy=\"290.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><path d=\"M 100.86 190.57\nL 174.57 168.25\nL 248.29 174.43\nL 322 167.85\nL 395.71 96.9\nL 469.43 88.92\nL 543.14 90.92\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"100.86\" cy=\"190.57\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"174.57\" cy=\"168.25\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"248.29\" cy=\"174.43\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"322\" cy=\"167.85\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"395.71\" cy=\"96.9\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"469.43\" cy=\"88.92\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"543.14\" cy=\"90.92\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/></svg>", data)
+}
+
+func TestLineRenderPDF(t *testing.T) {
+	t.Parallel()
+
+	p, err := LineRender(
+		[][]float64{
+			{120, 132, 101, 134, 90, 230, 210},
+		},
+		PDFOutputOptionFunc(),
+		TitleTextOptionFunc("Line"),
+		XAxisLabelsOptionFunc([]string{
+			"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun",
+		}),
+	)
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "%PDF-1.4\n"))
+	assert.Contains(t, string(data), "/MediaBox [0 0 600 400]")
+	assert.Contains(t, string(data), "/Subtype /Type0")
+}
+
+func TestLineRenderScaleFactor(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{
+		{120, 132, 101, 134, 90, 230, 210},
+	}
+	p, err := LineRender(values, PNGOutputOptionFunc(), ScaleFactorOptionFunc(2), TitleTextOptionFunc("Line"))
+	require.NoError(t, err)
+	assert.Equal(t, 600, p.Width())
+	assert.Equal(t, 400, p.Height())
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	cfg, err := png.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 1200, cfg.Width)
+	assert.Equal(t, 800, cfg.Height)
+
+	// svg output is unaffected by the scale factor
+	p, err = LineRender(values, SVGOutputOptionFunc(), ScaleFactorOptionFunc(2), TitleTextOptionFunc("Line"))
+	require.NoError(t, err)
+	scaledSVG, err := p.Bytes()
+	require.NoError(t, err)
+	p, err = LineRender(values, SVGOutputOptionFunc(), TitleTextOptionFunc("Line"))
+	require.NoError(t, err)
+	svg, err := p.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, string(svg), string(scaledSVG))
+}
+
+func TestLineRenderImageOption(t *testing.T) {
+	t.Parallel()
+
+	dest := image.NewNRGBA(image.Rect(0, 0, 800, 600))
+	p, err := LineRender([][]float64{{120, 132, 101, 134, 90, 230, 210}},
+		ImageOptionFunc(dest.SubImage(image.Rect(0, 0, 800, 300)).(*image.NRGBA)), ScaleFactorOptionFunc(2))
+	require.NoError(t, err)
+	assert.Equal(t, 400, p.Width())
+	assert.Equal(t, 150, p.Height())
+	// the background is drawn within the sub-image only
+	assert.Equal(t, uint8(255), dest.NRGBAAt(799, 299).A)
+	assert.Equal(t, uint8(0), dest.NRGBAAt(799, 300).A)
+}
+
+func TestLineRenderTransparentBackground(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{{120, 132, 101, 134, 90, 230, 210}}
+	p, err := LineRender(values, PNGOutputOptionFunc(), TransparentBackgroundOptionFunc())
+	require.NoError(t, err)
+	img, err := p.Image()
+	require.NoError(t, err)
+	_, _, _, a := img.At(1, 1).RGBA()
+	assert.Equal(t, uint32(0), a)
+
+	p, err = LineRender(values, SVGOutputOptionFunc(), TransparentBackgroundOptionFunc())
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "<path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\"")
+}
+
+func TestTransparentBackgroundExplicitFill(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat:          ChartOutputPNG,
+		Width:                 200,
+		Height:                100,
+		TransparentBackground: Ptr(true),
+	})
+	opt := makeDefaultTableChartOptions()
+	opt.BackgroundColor = ColorRed
+	_, err := newTableChart(p, opt).Render()
+	require.NoError(t, err)
+	img, err := p.Image()
+	require.NoError(t, err)
+	// the table background is explicitly configured and still drawn
+	_, _, _, a := img.At(199, 99).RGBA()
+	assert.Equal(t, uint32(0xffff), a)
+}
+
+func TestLineRenderEncoderOptions(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{{120, 132, 101, 134, 90, 230, 210}}
+	render := func(opts ...OptionFunc) []byte {
+		p, err := LineRender(values, opts...)
+		require.NoError(t, err)
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		return data
+	}
+
+	defaultPNG := render(PNGOutputOptionFunc())
+	paletted := render(PNGOutputOptionFunc(), EncoderOptionFunc(EncoderOptions{PNGPaletted: true}))
+	assert.Less(t, len(paletted), len(defaultPNG))
+	img, err := png.Decode(bytes.NewReader(paletted))
+	require.NoError(t, err)
+	assert.IsType(t, &image.Paletted{}, img)
+
+	lowJPG := render(JPGOutputOptionFunc(), EncoderOptionFunc(EncoderOptions{JPGQuality: 20}))
+	defaultJPG := render(JPGOutputOptionFunc())
+	assert.Less(t, len(lowJPG), len(defaultJPG))
+}
+
+func TestLineRenderTerminal(t *testing.T) {
+	t.Parallel()
+
+	p, err := LineRender([][]float64{{120, 132, 101, 134, 90, 230, 210}},
+		TerminalOutputOptionFunc(TerminalOptions{Columns: 60, Color: TerminalColorNone}))
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	// braille cells are 2x4 pixels, so the 600x400 chart is scaled to 120x80 pixels
+	require.Len(t, lines, 20)
+	for _, line := range lines {
+		assert.Equal(t, 60, utf8.RuneCountInString(line))
+	}
+	assert.NotContains(t, string(data), "\x1b[")
 }
 
 func TestScatterRender(t *testing.T) {
@@ -148,7 +296,7 @@ func TestScatterRender(t *testing.T) {
 	require.NoError(t, err)
 	data, err := p.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Scatter</text><path d=\"M 156 23\nL 186 23\nL 186 36\nL 156 36\nL 156 23\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"188\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 219 23\nL 249 23\nL 249 36\nL 219 36\nL 219 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"251\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 281 23\nL 311 23\nL 311 36\nL 281 36\nL 281 23\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"313\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 343 23\nL 373 23\nL 373 36\nL 343 36\nL 343 23\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"375\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><path d=\"M 405 23\nL 435 23\nL 435 36\nL 405 36\nL 405 23\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"437\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"31\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"31\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"31\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"31\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"31\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"31\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"49\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 64 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 68 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 359\nL 68 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 141 359\nL 141 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 214 359\nL 214 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 287 359\nL 287 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 360 359\nL 360 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 433 359\nL 433 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 506 359\nL 506 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"100\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"173\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"246\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"319\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"392\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"465\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"539\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><path d=\"M 66 328\nL 70 328\nL 70 332\nL 66 332\nL 66 328\nM 151 325\nL 155 325\nL 155 329\nL 151 329\nL 151 325\nM 236 332\nL 240 332\nL 240 336\nL 236 336\nL 236 332\nM 322 325\nL 326 325\nL 326 329\nL 322 329\nL 322 325\nM 407 334\nL 411 334\nL 411 338\nL 407 338\nL 407 334\nM 492 305\nL 496 305\nL 496 309\nL 492 309\nL 492 305\nM 578 309\nL 582 309\nL 582 313\nL 578 313\nL 578 309\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 66 307\nL 70 307\nL 70 311\nL 66 311\nL 66 307\nM 151 315\nL 155 315\nL 155 319\nL 151 319\nL 151 315\nM 236 313\nL 240 313\nL 240 317\nL 236 317\nL 236 313\nM 322 304\nL 326 304\nL 326 308\nL 322 308\nL 322 304\nM 407 292\nL 411 292\nL 411 296\nL 407 296\nL 407 292\nM 492 284\nL 496 284\nL 496 288\nL 492 288\nL 492 284\nM 578 288\nL 582 288\nL 582 292\nL 578 292\nL 578 288\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path d=\"M 66 321\nL 70 321\nL 70 325\nL 66 325\nL 66 321\nM 151 304\nL 155 304\nL 155 308\nL 151 308\nL 151 304\nM 236 311\nL 240 311\nL 240 315\nL 236 315\nL 236 311\nM 322 321\nL 326 321\nL 326 325\nL 322 325\nL 322 321\nM 407 313\nL 411 313\nL 411 317\nL 407 317\nL 407 313\nM 492 284\nL 496 284\nL 496 288\nL 492 288\nL 492 284\nM 578 268\nL 582 268\nL 582 272\nL 578 272\nL 578 268\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><path d=\"M 66 286\nL 70 286\nL 70 290\nL 66 290\nL 66 286\nM 151 284\nL 155 284\nL 155 288\nL 151 288\nL 151 284\nM 236 290\nL 240 290\nL 240 294\nL 236 294\nL 236 290\nM 322 283\nL 326 283\nL 326 287\nL 322 287\nL 322 283\nM 407 272\nL 411 272\nL 411 276\nL 407 276\nL 407 272\nM 492 284\nL 496 284\nL 496 288\nL 492 288\nL 492 284\nM 578 286\nL 582 286\nL 582 290\nL 578 290\nL 578 286\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><path d=\"M 66 183\nL 70 183\nL 70 187\nL 66 187\nL 66 183\nM 151 160\nL 155 160\nL 155 164\nL 151 164\nL 151 160\nM 236 166\nL 240 166\nL 240 170\nL 236 170\nL 236 166\nM 322 159\nL 326 159\nL 326 163\nL 322 163\nL 322 159\nM 407 86\nL 411 86\nL 411 90\nL 407 90\nL 407 86\nM 492 77\nL 496 77\nL 496 81\nL 492 81\nL 492 77\nM 578 79\nL 582 79\nL 582 83\nL 578 83\nL 578 79\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/></svg>", data)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Scatter</text><path d=\"M 156 23\nL 186 23\nL 186 36\nL 156 36\nL 156 23\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"188\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 219 23\nL 249 23\nL 249 36\nL 219 36\nL 219 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"251\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 281 23\nL 311 23\nL 311 36\nL 281 36\nL 281 23\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"313\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 343 23\nL 373 23\nL 373 36\nL 343 36\nL 343 23\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"375\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><path d=\"M 405 23\nL 435 23\nL 435 36\nL 405 36\nL 405 23\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"437\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"31\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"31\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"31\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"31\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"31\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"31\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"49\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 64 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 64 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 68 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 359\nL 68 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 141 359\nL 141 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 214 359\nL 214 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 287 359\nL 287 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 360 359\nL 360 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 433 359\nL 433 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 506 359\nL 506 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"100\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"173\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"246\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"319\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"392\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"465\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"539\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><path d=\"M 66 327.17\nL 70 327.17\nL 70 331.17\nL 66 331.17\nL 66 327.17\nM 151.33 324.68\nL 155.33 324.68\nL 155.33 328.68\nL 151.33 328.68\nL 151.33 324.68\nM 236.67 331.1\nL 240.67 331.1\nL 240.67 335.1\nL 236.67 335.1\nL 236.67 331.1\nM 322 324.27\nL 326 324.27\nL 326 328.27\nL 322 328.27\nL 322 324.27\nM 407.33 333.38\nL 411.33 333.38\nL 411.33 337.38\nL 407.33 337.38\nL 407.33 333.38\nM 492.67 304.4\nL 496.67 304.4\nL 496.67 308.4\nL 492.67 308.4\nL 492.67 304.4\nM 578 308.54\nL 582 308.54\nL 582 312.54\nL 578 312.54\nL 578 308.54\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 66 306.47\nL 70 306.47\nL 70 310.47\nL 66 310.47\nL 66 306.47\nM 151.33 314.34\nL 155.33 314.34\nL 155.33 318.34\nL 151.33 318.34\nL 151.33 314.34\nM 236.67 312.47\nL 240.67 312.47\nL 240.67 316.47\nL 236.67 316.47\nL 236.67 312.47\nM 322 303.57\nL 326 303.57\nL 326 307.57\nL 322 307.57\nL 322 303.57\nM 407.33 291.99\nL 411.33 291.99\nL 411.33 295.99\nL 407.33 295.99\nL 407.33 291.99\nM 492.67 283.71\nL 496.67 283.71\nL 496.67 287.71\nL 492.67 287.71\nL 492.67 283.71\nM 578 287.85\nL 582 287.85\nL 582 291.85\nL 578 291.85\nL 578 287.85\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path d=\"M 66 320.96\nL 70 320.96\nL 70 324.96\nL 66 324.96\nL 66 320.96\nM 151.33 303.99\nL 155.33 303.99\nL 155.33 307.99\nL 151.33 307.99\nL 151.33 303.99\nM 236.67 310.4\nL 240.67 310.4\nL 240.67 314.4\nL 236.67 314.4\nL 236.67 310.4\nM 322 320.13\nL 326 320.13\nL 326 324.13\nL 322 324.13\nL 322 320.13\nM 407.33 312.68\nL 411.33 312.68\nL 411.33 316.68\nL 407.33 316.68\nL 407.33 312.68\nM 492.67 283.71\nL 496.67 283.71\nL 496.67 287.71\nL 492.67 287.71\nL 492.67 283.71\nM 578 267.15\nL 582 267.15\nL 582 271.15\nL 578 271.15\nL 578 267.15\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><path d=\"M 66 285.78\nL 70 285.78\nL 70 289.78\nL 66 289.78\nL 66 285.78\nM 151.33 283.29\nL 155.33 283.29\nL 155.33 287.29\nL 151.33 287.29\nL 151.33 283.29\nM 236.67 289.71\nL 240.67 289.71\nL 240.67 293.71\nL 236.67 293.71\nL 236.67 289.71\nM 322 282.88\nL 326 282.88\nL 326 286.88\nL 322 286.88\nL 322 282.88\nM 407.33 271.29\nL 411.33 271.29\nL 411.33 275.29\nL 407.33 275.29\nL 407.33 271.29\nM 492.67 283.71\nL 496.67 283.71\nL 496.67 287.71\nL 492.67 287.71\nL 492.67 283.71\nM 578 285.78\nL 582 285.78\nL 582 289.78\nL 578 289.78\nL 578 285.78\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><path d=\"M 66 182.31\nL 70 182.31\nL 70 186.31\nL 66 186.31\nL 66 182.31\nM 151.33 159.13\nL 155.33 159.13\nL 155.33 163.13\nL 151.33 163.13\nL 151.33 159.13\nM 236.67 165.54\nL 240.67 165.54\nL 240.67 169.54\nL 236.67 169.54\nL 236.67 165.54\nM 322 158.71\nL 326 158.71\nL 326 162.71\nL 322 162.71\nL 322 158.71\nM 407.33 85.04\nL 411.33 85.04\nL 411.33 89.04\nL 407.33 89.04\nL 407.33 85.04\nM 492.67 76.76\nL 496.67 76.76\nL 496.67 80.76\nL 492.67 80.76\nL 492.67 76.76\nM 578 78.83\nL 582 78.83\nL 582 82.83\nL 578 82.83\nL 578 78.83\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/></svg>", data)
 }
 
 func TestBarRender(t *testing.T) {
@@ -180,7 +328,7 @@ func TestBarRender(t *testing.T) {
 	require.NoError(t, err)
 	data, err := p.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 364 29\nL 394 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"379\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"396\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall</text><path d=\"M 468 29\nL 498 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"483\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"500\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Evaporation</text><text x=\"19\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">270</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"19\" y=\"99\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"19\" y=\"136\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"19\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"19\" y=\"210\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"28\" y=\"247\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><text x=\"28\" y=\"284\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"28\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30</text><text x=\"37\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 52 20\nL 580 20\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 57\nL 580 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 94\nL 580 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 131\nL 580 131\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 168\nL 580 168\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 205\nL 580 205\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 242\nL 580 242\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 279\nL 580 279\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 316\nL 580 316\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 56 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 56 359\nL 56 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 99 359\nL 99 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 143 359\nL 143 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 359\nL 187 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 230 359\nL 230 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 274 359\nL 274 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 359\nL 318 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 361 359\nL 361 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 405 359\nL 405 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 449 359\nL 449 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 492 359\nL 492 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 536 359\nL 536 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"64\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"108\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"151\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"196\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"237\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"283\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"329\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"369\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"414\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"458\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"500\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"545\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 61 352\nL 76 352\nL 76 353\nL 61 353\nL 61 352\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 104 348\nL 119 348\nL 119 353\nL 104 353\nL 104 348\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 148 346\nL 163 346\nL 163 353\nL 148 353\nL 148 346\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 192 326\nL 207 326\nL 207 353\nL 192 353\nL 192 326\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 235 323\nL 250 323\nL 250 353\nL 235 353\nL 235 323\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 279 260\nL 294 260\nL 294 353\nL 279 353\nL 279 260\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 323 187\nL 338 187\nL 338 353\nL 323 353\nL 323 187\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 366 154\nL 381 154\nL 381 353\nL 366 353\nL 366 154\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 410 314\nL 425 314\nL 425 353\nL 410 353\nL 410 314\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 454 330\nL 469 330\nL 469 353\nL 454 353\nL 454 330\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 497 347\nL 512 347\nL 512 353\nL 497 353\nL 497 347\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 541 350\nL 556 350\nL 556 353\nL 541 353\nL 541 350\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 79 351\nL 94 351\nL 94 353\nL 79 353\nL 79 351\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 122 347\nL 137 347\nL 137 353\nL 122 353\nL 122 347\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 166 343\nL 181 343\nL 181 353\nL 166 353\nL 166 343\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 210 322\nL 225 322\nL 225 353\nL 210 353\nL 210 322\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 253 319\nL 268 319\nL 268 353\nL 253 353\nL 253 319\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 297 267\nL 312 267\nL 312 353\nL 297 353\nL 297 267\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 341 137\nL 356 137\nL 356 353\nL 341 353\nL 341 137\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 384 129\nL 399 129\nL 399 353\nL 384 353\nL 384 129\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 428 294\nL 443 294\nL 443 353\nL 428 353\nL 428 294\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 472 331\nL 487 331\nL 487 353\nL 472 353\nL 472 331\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 515 347\nL 530 347\nL 530 353\nL 515 353\nL 515 347\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 559 352\nL 574 352\nL 574 353\nL 559 353\nL 559 352\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 369 147\nA 14 14 330.00 1 1 377 147\nL 373 133\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 359 133\nQ373,168 387,133\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"360\" y=\"138\" style=\"stroke:none;fill:rgb(238,238,238);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">162.2</text><path d=\"M 64 345\nA 14 14 330.00 1 1 72 345\nL 68 331\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 54 331\nQ68,366 82,331\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"64\" y=\"336\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 387 122\nA 14 14 330.00 1 1 395 122\nL 391 108\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 377 108\nQ391,143 405,108\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"378\" y=\"113\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">182.2</text><path d=\"M 562 345\nA 14 14 330.00 1 1 570 345\nL 566 331\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 552 331\nQ566,366 580,331\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"557\" y=\"336\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><circle cx=\"59\" cy=\"303\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 65 303\nL 562 303\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 298\nL 578 303\nL 562 308\nL 567 303\nL 562 298\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"580\" y=\"307\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">41.63</text><circle cx=\"59\" cy=\"295\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 65 295\nL 562 295\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 290\nL 578 295\nL 562 300\nL 567 295\nL 562 290\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"580\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.07</text></svg>", data)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 364 29\nL 394 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"379\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"396\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall</text><path d=\"M 468 29\nL 498 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"483\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"500\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Evaporation</text><text x=\"19\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">270</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"19\" y=\"99\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"19\" y=\"136\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"19\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"19\" y=\"210\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"28\" y=\"247\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><text x=\"28\" y=\"284\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"28\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30</text><text x=\"37\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 52 20\nL 580 20\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 57\nL 580 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 94\nL 580 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 131\nL 580 131\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 168\nL 580 168\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 205\nL 580 205\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 242\nL 580 242\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 279\nL 580 279\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 316\nL 580 316\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 56 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 56 359\nL 56 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 99 359\nL 99 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 143 359\nL 143 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 359\nL 187 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 230 359\nL 230 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 274 359\nL 274 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 359\nL 318 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 361 359\nL 361 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 405 359\nL 405 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 449 359\nL 449 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 492 359\nL 492 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 536 359\nL 536 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"64\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"108\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"151\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"196\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"237\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"283\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jun</text><text x=\"329\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"369\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"414\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sep</text><text x=\"458\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"500\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><text x=\"545\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 61 351.53\nL 76.33 351.53\nL 76.33 353\nL 61 353\nL 61 351.53\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 104.67 347.94\nL 120 347.94\nL 120 353\nL 104.67 353\nL 104.67 347.94\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 148.33 345.34\nL 163.67 345.34\nL 163.67 353\nL 148.33 353\nL 148.33 345.34\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 192 325.3\nL 207.33 325.3\nL 207.33 353\nL 192 353\nL 192 325.3\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 235.67 322.33\nL 251 322.33\nL 251 353\nL 235.67 353\nL 235.67 322.33\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 279.33 259.12\nL 294.67 259.12\nL 294.67 353\nL 279.33 353\nL 279.33 259.12\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 323 186.26\nL 338.33 186.26\nL 338.33 353\nL 323 353\nL 323 186.26\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 366.67 153.35\nL 382 153.35\nL 382 353\nL 366.67 353\nL 366.67 153.35\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 410.33 313.67\nL 425.67 313.67\nL 425.67 353\nL 410.33 353\nL 410.33 313.67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 454 329.26\nL 469.33 329.26\nL 469.33 353\nL 454 353\nL 454 329.26\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 497.67 346.08\nL 513 346.08\nL 513 353\nL 497.67 353\nL 497.67 346.08\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 541.33 349.92\nL 556.67 349.92\nL 556.67 353\nL 541.33 353\nL 541.33 349.92\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 79.33 350.78\nL 94.67 350.78\nL 94.67 353\nL 79.33 353\nL 79.33 350.78\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 123 346.7\nL 138.33 346.7\nL 138.33 353\nL 123 353\nL 123 346.7\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 166.67 342.87\nL 182 342.87\nL 182 353\nL 166.67 353\nL 166.67 342.87\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 210.33 321.34\nL 225.67 321.34\nL 225.67 353\nL 210.33 353\nL 210.33 321.34\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 254 318.5\nL 269.33 318.5\nL 269.33 353\nL 254 353\nL 254 318.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 297.67 266.54\nL 313 266.54\nL 313 353\nL 297.67 353\nL 297.67 266.54\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 341.33 136.78\nL 356.67 136.78\nL 356.67 353\nL 341.33 353\nL 341.33 136.78\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 385 128.61\nL 400.33 128.61\nL 400.33 353\nL 385 353\nL 385 128.61\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 428.67 293.76\nL 444 293.76\nL 444 353\nL 428.67 353\nL 428.67 293.76\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 472.33 330.74\nL 487.67 330.74\nL 487.67 353\nL 472.33 353\nL 472.33 330.74\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 516 346.58\nL 531.33 346.58\nL 531.33 353\nL 516 353\nL 516 346.58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 559.67 351.15\nL 575 351.15\nL 575 353\nL 559.67 353\nL 559.67 351.15\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 370 146\nA 14 14 330.00 1 1 378 146\nL 374 132\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 360 132\nQ374,167 388,132\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"361\" y=\"137\" style=\"stroke:none;fill:rgb(238,238,238);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">162.2</text><path d=\"M 64 344\nA 14 14 330.00 1 1 72 344\nL 68 330\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 54 330\nQ68,365 82,330\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"64\" y=\"335\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 388 121\nA 14 14 330.00 1 1 396 121\nL 392 107\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 378 107\nQ392,142 406,107\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"379\" y=\"112\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">182.2</text><path d=\"M 563 344\nA 14 14 330.00 1 1 571 344\nL 567 330\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 553 330\nQ567,365 581,330\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"558\" y=\"335\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><circle cx=\"59\" cy=\"303\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 65 303\nL 562 303\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 298\nL 578 303\nL 562 308\nL 567 303\nL 562 298\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"580\" y=\"307\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">41.63</text><circle cx=\"59\" cy=\"295\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 65 295\nL 562 295\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 290\nL 578 295\nL 562 300\nL 567 295\nL 562 290\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"580\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.07</text></svg>", data)
 }
 
 func TestHorizontalBarRender(t *testing.T) {
@@ -206,7 +354,7 @@ func TestHorizontalBarRender(t *testing.T) {
 	require.NoError(t, err)
 	data, err := p.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 214 29\nL 244 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"229\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"246\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 301 29\nL 331 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"316\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"333\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 97 56\nL 97 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 56\nL 97 56\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 106\nL 97 106\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 156\nL 97 156\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 206\nL 97 206\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 256\nL 97 256\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 306\nL 97 306\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 356\nL 97 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"46\" y=\"86\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"47\" y=\"136\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"53\" y=\"186\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"57\" y=\"235\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"19\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"48\" y=\"335\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"97\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"174\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120k</text><text x=\"251\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"328\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360k</text><text x=\"405\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"482\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600k</text><text x=\"525\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 175 56\nL 175 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 252 56\nL 252 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 329 56\nL 329 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 406 56\nL 406 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 483 56\nL 483 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 560 56\nL 560 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 98 316\nL 109 316\nL 109 328\nL 98 328\nL 98 316\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 266\nL 113 266\nL 113 278\nL 98 278\nL 98 266\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 216\nL 116 216\nL 116 228\nL 98 228\nL 98 216\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 166\nL 165 166\nL 165 178\nL 98 178\nL 98 166\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 116\nL 182 116\nL 182 128\nL 98 128\nL 98 116\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 66\nL 502 66\nL 502 78\nL 98 78\nL 98 66\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 333\nL 110 333\nL 110 345\nL 98 345\nL 98 333\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 283\nL 113 283\nL 113 295\nL 98 295\nL 98 283\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 233\nL 117 233\nL 117 245\nL 98 245\nL 98 233\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 183\nL 176 183\nL 176 195\nL 98 195\nL 98 183\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 133\nL 184 133\nL 184 145\nL 98 145\nL 98 133\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 83\nL 535 83\nL 535 95\nL 98 95\nL 98 83\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>", data)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 214 29\nL 244 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"229\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"246\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 301 29\nL 331 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"316\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"333\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 97 56\nL 97 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 56\nL 97 56\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 106\nL 97 106\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 156\nL 97 156\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 206\nL 97 206\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 256\nL 97 256\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 306\nL 97 306\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 356\nL 97 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"46\" y=\"86\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"47\" y=\"136\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"53\" y=\"186\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"57\" y=\"235\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"19\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"48\" y=\"335\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"97\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"174\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120k</text><text x=\"251\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"328\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360k</text><text x=\"405\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"482\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600k</text><text x=\"525\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 175 56\nL 175 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 252 56\nL 252 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 329 56\nL 329 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 406 56\nL 406 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 483 56\nL 483 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 560 56\nL 560 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 98 316\nL 109.68 316\nL 109.68 328.5\nL 98 328.5\nL 98 316\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 266\nL 113.07 266\nL 113.07 278.5\nL 98 278.5\nL 98 266\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 216\nL 116.63 216\nL 116.63 228.5\nL 98 228.5\nL 98 216\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 166\nL 165.36 166\nL 165.36 178.5\nL 98 178.5\nL 98 166\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 116\nL 182.54 116\nL 182.54 128.5\nL 98 128.5\nL 98 116\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 66\nL 502.4 66\nL 502.4 78.5\nL 98 78.5\nL 98 66\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 98 333.5\nL 110.4 333.5\nL 110.4 346\nL 98 346\nL 98 333.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 283.5\nL 113.04 283.5\nL 113.04 296\nL 98 296\nL 98 283.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 233.5\nL 117.89 233.5\nL 117.89 246\nL 98 246\nL 98 233.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 183.5\nL 176.02 183.5\nL 176.02 196\nL 98 196\nL 98 183.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 133.5\nL 184.07 133.5\nL 184.07 146\nL 98 146\nL 98 133.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 83.5\nL 535.49 83.5\nL 535.49 96\nL 98 96\nL 98 83.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>", data)
 }
 
 func TestPieRender(t *testing.T) {
@@ -231,7 +379,7 @@ func TestPieRender(t *testing.T) {
 	require.NoError(t, err)
 	data, err := p.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"222\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall vs Evaporation</text><text x=\"266\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fake Data</text><path d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"52\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path d=\"M 20 49\nL 50 49\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"35\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"52\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 20 69\nL 50 69\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"35\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"52\" y=\"75\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 20 89\nL 50 89\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"35\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"52\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 20 109\nL 50 109\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"35\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"52\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 300 223\nL 300 98\nA 125 125 119.89 0 1 409 285\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 408 161\nL 421 153\nM 421 153\nL 436 153\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"439\" y=\"158\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 33.3%</text><path d=\"M 300 223\nL 409 285\nA 125 125 84.08 0 1 249 337\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 338 342\nL 343 356\nM 343 356\nL 358 356\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"361\" y=\"361\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 23.35%</text><path d=\"M 300 223\nL 249 337\nA 125 125 66.35 0 1 175 222\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 195 290\nL 183 299\nM 183 299\nL 168 299\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"116\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 18.43%</text><path d=\"M 300 223\nL 175 222\nA 125 125 55.37 0 1 229 120\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 190 165\nL 177 158\nM 177 158\nL 162 158\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"110\" y=\"163\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 15.37%</text><path d=\"M 300 223\nL 229 120\nA 125 125 34.32 0 1 300 98\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 264 104\nL 259 90\nM 259 90\nL 244 90\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"199\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 9.53%</text></svg>", data)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"222\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall vs Evaporation</text><text x=\"266\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fake Data</text><path d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"52\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path d=\"M 20 49\nL 50 49\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"35\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"52\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 20 69\nL 50 69\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"35\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"52\" y=\"75\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 20 89\nL 50 89\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"35\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"52\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 20 109\nL 50 109\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"35\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"52\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 300 223\nL 300 97.8\nA 125.2 125.2 119.89 0 1 408.55 285.38\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 408 161\nL 421 153\nM 421 153\nL 436 153\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"439\" y=\"158\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 33.3%</text><path d=\"M 300 223\nL 408.55 285.38\nA 125.2 125.2 84.08 0 1 249.15 337.41\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 338 342\nL 343 356\nM 343 356\nL 358 356\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"361\" y=\"361\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 23.35%</text><path d=\"M 300 223\nL 249.15 337.41\nA 125.2 125.2 66.35 0 1 174.8 222.31\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 195 290\nL 183 299\nM 183 299\nL 168 299\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"116\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 18.43%</text><path d=\"M 300 223\nL 174.8 222.31\nA 125.2 125.2 55.37 0 1 229.41 119.6\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 190 165\nL 177 158\nM 177 158\nL 162 158\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"110\" y=\"163\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 15.37%</text><path d=\"M 300 223\nL 229.41 119.6\nA 125.2 125.2 34.32 0 1 300 97.8\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 264 104\nL 259 90\nM 259 90\nL 244 90\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"199\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 9.53%</text></svg>", data)
 }
 
 func TestDoughnutRender(t *testing.T) {
@@ -256,7 +404,7 @@ func TestDoughnutRender(t *testing.T) {
 	require.NoError(t, err)
 	data, err := p.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"285\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"266\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fake Data</text><path d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"52\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 20 49\nL 50 49\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"35\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"52\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 20 69\nL 50 69\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"35\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"52\" y=\"75\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 20 89\nL 50 89\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"35\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"52\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><path d=\"M 20 109\nL 50 109\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"35\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"52\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><path d=\"M 300 223\nL 300 98\nA 125 125 119.89 0 1 409 285\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 408 161\nL 421 153\nM 421 153\nL 436 153\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"439\" y=\"158\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 33.3%</text><path d=\"M 300 223\nL 409 285\nA 125 125 84.08 0 1 249 337\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 338 342\nL 343 356\nM 343 356\nL 358 356\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"361\" y=\"361\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 23.35%</text><path d=\"M 300 223\nL 249 337\nA 125 125 66.35 0 1 175 222\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 195 290\nL 183 299\nM 183 299\nL 168 299\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"116\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 18.43%</text><path d=\"M 300 223\nL 175 222\nA 125 125 55.37 0 1 229 120\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 190 165\nL 177 158\nM 177 158\nL 162 158\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"110\" y=\"163\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 15.37%</text><path d=\"M 300 223\nL 229 120\nA 125 125 34.32 0 1 300 98\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 264 104\nL 259 90\nM 259 90\nL 244 90\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"199\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 9.53%</text><circle cx=\"300\" cy=\"223\" r=\"75\" style=\"stroke:none;fill:white\"/></svg>", data)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"285\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"266\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fake Data</text><path d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"52\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 20 49\nL 50 49\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"35\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"52\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 20 69\nL 50 69\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"35\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"52\" y=\"75\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 20 89\nL 50 89\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"35\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"52\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><path d=\"M 20 109\nL 50 109\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"35\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"52\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><path d=\"M 300 223\nL 300 97.8\nA 125.2 125.2 119.89 0 1 408.55 285.38\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 408 161\nL 421 153\nM 421 153\nL 436 153\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"439\" y=\"158\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 33.3%</text><path d=\"M 300 223\nL 408.55 285.38\nA 125.2 125.2 84.08 0 1 249.15 337.41\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 338 342\nL 343 356\nM 343 356\nL 358 356\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"361\" y=\"361\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 23.35%</text><path d=\"M 300 223\nL 249.15 337.41\nA 125.2 125.2 66.35 0 1 174.8 222.31\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 195 290\nL 183 299\nM 183 299\nL 168 299\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"116\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 18.43%</text><path d=\"M 300 223\nL 174.8 222.31\nA 125.2 125.2 55.37 0 1 229.41 119.6\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 190 165\nL 177 158\nM 177 158\nL 162 158\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"110\" y=\"163\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 15.37%</text><path d=\"M 300 223\nL 229.41 119.6\nA 125.2 125.2 34.32 0 1 300 97.8\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 264 104\nL 259 90\nM 259 90\nL 244 90\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"199\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 9.53%</text><circle cx=\"300\" cy=\"223\" r=\"75.12\" style=\"stroke:none;fill:white\"/></svg>", data)
 }
 
 func TestRadarRender(t *testing.T) {
@@ -287,7 +435,7 @@ func TestRadarRender(t *testing.T) {
 	require.NoError(t, err)
 	data, err := p.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Basic Radar Chart</text><path d=\"M 143 29\nL 173 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"158\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"175\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Allocated Budget</text><path d=\"M 313 29\nL 343 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"328\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"345\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Actual Spending</text><path d=\"M 300 193\nL 321 206\nL 321 230\nL 300 243\nL 279 230\nL 279 206\nL 300 193\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 168\nL 343 193\nL 343 242\nL 300 268\nL 257 243\nL 257 194\nL 300 168\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 143\nL 364 181\nL 364 255\nL 300 293\nL 236 255\nL 236 181\nL 300 143\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 118\nL 386 168\nL 386 267\nL 300 318\nL 214 268\nL 214 169\nL 300 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 93\nL 408 156\nL 408 280\nL 300 343\nL 192 280\nL 192 156\nL 300 93\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 300 93\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 408 156\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 408 280\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 300 343\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 192 280\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 192 156\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><text x=\"284\" y=\"85\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sales</text><text x=\"413\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Administration</text><text x=\"413\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Information Technology</text><text x=\"248\" y=\"361\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Customer Support</text><text x=\"111\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Development</text><text x=\"129\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Marketing</text><path d=\"M 300 138\nL 320 207\nL 372 259\nL 300 333\nL 196 278\nL 223 174\nL 300 138\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 300 138\nL 320 207\nL 372 259\nL 300 333\nL 196 278\nL 223 174\nL 300 138\" style=\"stroke:none;fill:rgba(84,112,198,0.1)\"/><circle cx=\"300\" cy=\"138\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"320\" cy=\"207\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"372\" cy=\"259\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"333\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"196\" cy=\"278\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"223\" cy=\"174\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"138\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 300 122\nL 394 164\nL 401 276\nL 300 303\nL 213 268\nL 210 166\nL 300 122\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 300 122\nL 394 164\nL 401 276\nL 300 303\nL 213 268\nL 210 166\nL 300 122\" style=\"stroke:none;fill:rgba(145,204,117,0.1)\"/><circle cx=\"300\" cy=\"122\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"394\" cy=\"164\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"401\" cy=\"276\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"303\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"213\" cy=\"268\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"210\" cy=\"166\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"122\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/></svg>", data)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Basic Radar Chart</text><path d=\"M 143 29\nL 173 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"158\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"175\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Allocated Budget</text><path d=\"M 313 29\nL 343 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"328\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"345\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Actual Spending</text><path d=\"M 300 193\nL 321.65 205.5\nL 321.65 230.5\nL 300 243\nL 278.35 230.5\nL 278.35 205.5\nL 300 193\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 168\nL 343.3 193\nL 343.3 243\nL 300 268\nL 256.7 243\nL 256.7 193\nL 300 168\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 143\nL 364.95 180.5\nL 364.95 255.5\nL 300 293\nL 235.05 255.5\nL 235.05 180.5\nL 300 143\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 118\nL 386.6 168\nL 386.6 268\nL 300 318\nL 213.4 268\nL 213.4 168\nL 300 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 93\nL 408.25 155.5\nL 408.25 280.5\nL 300 343\nL 191.75 280.5\nL 191.75 155.5\nL 300 93\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 300 93\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 408.25 155.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 408.25 280.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 300 343\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 191.75 280.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 191.75 155.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><text x=\"284\" y=\"85\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sales</text><text x=\"413\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Administration</text><text x=\"413\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Information Technology</text><text x=\"248\" y=\"361\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Customer Support</text><text x=\"111\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Development</text><text x=\"129\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Marketing</text><path d=\"M 300 137.23\nL 320.3 206.28\nL 372.17 259.67\nL 300 333.13\nL 195.91 278.1\nL 222.06 173\nL 300 137.23\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 300 137.23\nL 320.3 206.28\nL 372.17 259.67\nL 300 333.13\nL 195.91 278.1\nL 222.06 173\nL 300 137.23\" style=\"stroke:none;fill:rgba(84,112,198,0.1)\"/><circle cx=\"300\" cy=\"137.23\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"320.3\" cy=\"206.28\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"372.17\" cy=\"259.67\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"333.13\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"195.91\" cy=\"278.1\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"222.06\" cy=\"173\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"137.23\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 300 121.85\nL 394.72 163.31\nL 401.04 276.33\nL 300 303.53\nL 212.56 268.48\nL 209.07 165.5\nL 300 121.85\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 300 121.85\nL 394.72 163.31\nL 401.04 276.33\nL 300 303.53\nL 212.56 268.48\nL 209.07 165.5\nL 300 121.85\" style=\"stroke:none;fill:rgba(145,204,117,0.1)\"/><circle cx=\"300\" cy=\"121.85\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"394.72\" cy=\"163.31\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"401.04\" cy=\"276.33\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"303.53\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"212.56\" cy=\"268.48\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"209.07\" cy=\"165.5\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"121.85\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/></svg>", data)
 }
 
 func TestFunnelRender(t *testing.T) {
@@ -338,7 +486,7 @@ func TestChildRender(t *testing.T) {
 	require.NoError(t, err)
 	data, err := p.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"19\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">450</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">410</text><text x=\"19\" y=\"99\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">370</text><text x=\"19\" y=\"136\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">330</text><text x=\"19\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">290</text><text x=\"19\" y=\"210\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">250</text><text x=\"19\" y=\"247\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"19\" y=\"284\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">170</text><text x=\"19\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"28\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 52 20\nL 580 20\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 57\nL 580 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 94\nL 580 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 131\nL 580 131\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 168\nL 580 168\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 205\nL 580 205\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 242\nL 580 242\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 279\nL 580 279\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 316\nL 580 316\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 56 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 56 359\nL 56 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 130 359\nL 130 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 205 359\nL 205 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 280 359\nL 280 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 355 359\nL 355 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 430 359\nL 430 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 505 359\nL 505 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"78\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"154\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"227\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"304\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"383\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"456\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"529\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 93 327\nL 167 316\nL 242 344\nL 317 314\nL 392 354\nL 467 225\nL 542 243\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"93\" cy=\"327\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"167\" cy=\"316\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"242\" cy=\"344\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"317\" cy=\"314\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"392\" cy=\"354\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"467\" cy=\"225\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"542\" cy=\"243\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 93 299\nL 167 223\nL 242 252\nL 317 295\nL 392 262\nL 467 132\nL 542 58\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"93\" cy=\"299\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"167\" cy=\"223\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"242\" cy=\"252\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"317\" cy=\"295\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"392\" cy=\"262\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"467\" cy=\"132\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"542\" cy=\"58\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 93 141\nL 167 130\nL 242 159\nL 317 128\nL 392 76\nL 467 132\nL 542 141\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"93\" cy=\"141\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"167\" cy=\"130\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"242\" cy=\"159\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"317\" cy=\"128\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"392\" cy=\"76\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"467\" cy=\"132\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"542\" cy=\"141\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 274 39\nL 304 39\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"289\" cy=\"39\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"306\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 361 39\nL 391 39\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"376\" cy=\"39\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"393\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 270 66\nL 270 156\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 66\nL 270 66\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 88\nL 270 88\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 111\nL 270 111\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 133\nL 270 133\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 156\nL 270 156\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"219\" y=\"83\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"220\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"226\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"230\" y=\"149\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"270\" y=\"175\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">70</text><text x=\"374\" y=\"175\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110</text><text x=\"453\" y=\"175\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><path d=\"M 375 66\nL 375 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 480 66\nL 480 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 271 138\nL 271 138\nL 271 142\nL 271 142\nL 271 138\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 271 116\nL 323 116\nL 323 120\nL 271 120\nL 271 116\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 271 93\nL 375 93\nL 375 97\nL 271 97\nL 271 93\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 271 71\nL 427 71\nL 427 75\nL 271 75\nL 271 71\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 271 145\nL 297 145\nL 297 149\nL 271 149\nL 271 145\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 271 123\nL 349 123\nL 349 127\nL 271 127\nL 271 123\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 271 100\nL 401 100\nL 401 104\nL 271 104\nL 271 100\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 271 78\nL 453 78\nL 453 82\nL 271 82\nL 271 78\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>", data)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"19\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">450</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">410</text><text x=\"19\" y=\"99\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">370</text><text x=\"19\" y=\"136\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">330</text><text x=\"19\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">290</text><text x=\"19\" y=\"210\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">250</text><text x=\"19\" y=\"247\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"19\" y=\"284\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">170</text><text x=\"19\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"28\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 52 20\nL 580 20\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 57\nL 580 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 94\nL 580 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 131\nL 580 131\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 168\nL 580 168\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 205\nL 580 205\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 242\nL 580 242\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 279\nL 580 279\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 316\nL 580 316\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 56 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 56 359\nL 56 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 130 359\nL 130 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 205 359\nL 205 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 280 359\nL 280 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 355 359\nL 355 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 430 359\nL 430 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 505 359\nL 505 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"78\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"154\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"227\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"304\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"383\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"456\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"529\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 93.43 326.17\nL 168.29 315.03\nL 243.14 343.79\nL 318 313.18\nL 392.86 354\nL 467.71 224.11\nL 542.57 242.67\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"93.43\" cy=\"326.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"168.29\" cy=\"315.03\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"243.14\" cy=\"343.79\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"318\" cy=\"313.18\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"392.86\" cy=\"354\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"467.71\" cy=\"224.11\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"542.57\" cy=\"242.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 93.43 298.33\nL 168.29 222.26\nL 243.14 251.02\nL 318 294.62\nL 392.86 261.22\nL 467.71 131.33\nL 542.57 57.11\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"93.43\" cy=\"298.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"168.29\" cy=\"222.26\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"243.14\" cy=\"251.02\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"318\" cy=\"294.62\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"392.86\" cy=\"261.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"467.71\" cy=\"131.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"542.57\" cy=\"57.11\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 93.43 140.61\nL 168.29 129.48\nL 243.14 158.24\nL 318 127.62\nL 392.86 75.67\nL 467.71 131.33\nL 542.57 140.61\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"93.43\" cy=\"140.61\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"168.29\" cy=\"129.48\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"243.14\" cy=\"158.24\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"318\" cy=\"127.62\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"392.86\" cy=\"75.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"467.71\" cy=\"131.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"542.57\" cy=\"140.61\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 274 39\nL 304 39\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"289\" cy=\"39\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"306\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 361 39\nL 391 39\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"376\" cy=\"39\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"393\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 270 66\nL 270 156\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 66\nL 270 66\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 88\nL 270 88\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 111\nL 270 111\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 133\nL 270 133\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 265 156\nL 270 156\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"219\" y=\"83\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"220\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"226\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"230\" y=\"149\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"270\" y=\"175\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">70</text><text x=\"374\" y=\"175\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110</text><text x=\"453\" y=\"175\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><path d=\"M 375 66\nL 375 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 480 66\nL 480 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 271 138.5\nL 271 138.5\nL 271 143.25\nL 271 143.25\nL 271 138.5\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 271 116\nL 323.25 116\nL 323.25 120.75\nL 271 120.75\nL 271 116\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 271 93.5\nL 375.5 93.5\nL 375.5 98.25\nL 271 98.25\nL 271 93.5\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 271 71\nL 427.75 71\nL 427.75 75.75\nL 271 75.75\nL 271 71\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 271 146.25\nL 297.12 146.25\nL 297.12 151\nL 271 151\nL 271 146.25\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 271 123.75\nL 349.38 123.75\nL 349.38 128.5\nL 271 128.5\nL 271 123.75\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 271 101.25\nL 401.62 101.25\nL 401.62 106\nL 271 106\nL 271 101.25\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 271 78.75\nL 453.88 78.75\nL 453.88 83.5\nL 271 83.5\nL 271 78.75\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>", data)
 }
 
 func TestChartCombos(t *testing.T) {
@@ -383,7 +531,7 @@ func TestChartCombos(t *testing.T) {
 					SeriesList: append(lineSeries, scatterSeries...),
 				}
 			},
-			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 184 29\nL 214 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"199\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"216\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><path d=\"M 301 29\nL 331 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"316\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"333\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Scatter Data</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">500</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">454.44</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">408.89</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">363.33</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">317.78</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">272.22</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">226.67</text><text x=\"19\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">181.11</text><text x=\"19\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">135.56</text><text x=\"49\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 73 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 148 359\nL 148 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 220 359\nL 220 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 292 359\nL 292 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 364 359\nL 364 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 436 359\nL 436 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 508 359\nL 508 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"97\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"171\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"241\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"315\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"391\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"461\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"531\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 112 333\nL 184 324\nL 256 347\nL 328 323\nL 400 354\nL 472 253\nL 544 267\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"112\" cy=\"333\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"184\" cy=\"324\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"256\" cy=\"347\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"328\" cy=\"323\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"354\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"472\" cy=\"253\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"544\" cy=\"267\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"77\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"160\" cy=\"275\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"244\" cy=\"311\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"328\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"412\" cy=\"304\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"496\" cy=\"216\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"580\" cy=\"238\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
+			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 184 29\nL 214 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"199\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"216\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><path d=\"M 301 29\nL 331 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"316\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"333\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Scatter Data</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">500</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">454.44</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">408.89</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">363.33</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">317.78</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">272.22</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">226.67</text><text x=\"19\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">181.11</text><text x=\"19\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">135.56</text><text x=\"49\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 73 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 148 359\nL 148 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 220 359\nL 220 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 292 359\nL 292 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 364 359\nL 364 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 436 359\nL 436 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 508 359\nL 508 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"97\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"171\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"241\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"315\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"391\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"461\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"531\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 112.93 332.2\nL 184.79 323.47\nL 256.64 346\nL 328.5 322.02\nL 400.36 354\nL 472.21 252.24\nL 544.07 266.78\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"112.93\" cy=\"332.2\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"184.79\" cy=\"323.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"256.64\" cy=\"346\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"328.5\" cy=\"322.02\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400.36\" cy=\"354\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"472.21\" cy=\"252.24\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"544.07\" cy=\"266.78\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"77\" cy=\"288.59\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"160.83\" cy=\"274.05\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"244.67\" cy=\"310.39\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"328.5\" cy=\"288.59\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"412.33\" cy=\"303.12\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"496.17\" cy=\"215.9\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"580\" cy=\"237.71\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
 		},
 		{
 			name: "line+bar",
@@ -419,7 +567,7 @@ func TestChartCombos(t *testing.T) {
 					SeriesList: append(lineSeries, barSeries...),
 				}
 			},
-			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 197 29\nL 227 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"212\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"229\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><path d=\"M 314 29\nL 344 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"329\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"346\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Bar Data</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">400</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">356.11</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">312.22</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">268.33</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">224.44</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180.56</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">136.67</text><text x=\"27\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">92.78</text><text x=\"27\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">48.89</text><text x=\"58\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><path d=\"M 73 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 148 359\nL 148 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 220 359\nL 220 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 292 359\nL 292 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 364 359\nL 364 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 436 359\nL 436 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 508 359\nL 508 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"97\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"171\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"241\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"315\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"391\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"461\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"531\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 87 305\nL 138 305\nL 138 353\nL 87 353\nL 87 305\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 158 290\nL 209 290\nL 209 353\nL 158 353\nL 158 290\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 230 275\nL 281 275\nL 281 353\nL 230 353\nL 230 275\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 302 260\nL 353 260\nL 353 353\nL 302 353\nL 302 260\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 374 298\nL 425 298\nL 425 353\nL 374 353\nL 374 298\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 446 283\nL 497 283\nL 497 353\nL 446 353\nL 446 283\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 518 268\nL 569 268\nL 569 353\nL 518 353\nL 518 268\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 112 268\nL 184 259\nL 256 282\nL 328 257\nL 400 290\nL 472 185\nL 544 200\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"112\" cy=\"268\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"184\" cy=\"259\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"256\" cy=\"282\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"328\" cy=\"257\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"290\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"472\" cy=\"185\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"544\" cy=\"200\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/></svg>",
+			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 197 29\nL 227 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"212\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"229\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><path d=\"M 314 29\nL 344 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"329\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"346\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Bar Data</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">400</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">356.11</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">312.22</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">268.33</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">224.44</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180.56</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">136.67</text><text x=\"27\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">92.78</text><text x=\"27\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">48.89</text><text x=\"58\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><path d=\"M 73 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 148 359\nL 148 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 220 359\nL 220 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 292 359\nL 292 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 364 359\nL 364 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 436 359\nL 436 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 508 359\nL 508 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"97\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"171\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"241\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"315\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"391\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"461\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"531\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 87 304.96\nL 138.86 304.96\nL 138.86 353\nL 87 353\nL 87 304.96\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 158.86 289.87\nL 210.71 289.87\nL 210.71 353\nL 158.86 353\nL 158.86 289.87\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 230.71 274.78\nL 282.57 274.78\nL 282.57 353\nL 230.71 353\nL 230.71 274.78\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 302.57 259.7\nL 354.43 259.7\nL 354.43 353\nL 302.57 353\nL 302.57 259.7\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 374.43 297.42\nL 426.29 297.42\nL 426.29 353\nL 374.43 353\nL 374.43 297.42\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 446.29 282.33\nL 498.14 282.33\nL 498.14 353\nL 446.29 353\nL 446.29 282.33\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 518.14 267.24\nL 570 267.24\nL 570 353\nL 518.14 353\nL 518.14 267.24\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 112.93 267.24\nL 184.79 258.19\nL 256.64 281.57\nL 328.5 256.68\nL 400.36 289.87\nL 472.21 184.25\nL 544.07 199.34\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"112.93\" cy=\"267.24\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"184.79\" cy=\"258.19\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"256.64\" cy=\"281.57\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"328.5\" cy=\"256.68\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400.36\" cy=\"289.87\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"472.21\" cy=\"184.25\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"544.07\" cy=\"199.34\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/></svg>",
 		},
 		{
 			name: "line+candlestick",
@@ -463,7 +611,7 @@ func TestChartCombos(t *testing.T) {
 					SeriesList: append(lineSeries, candlestickSeries...),
 				}
 			},
-			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 206 29\nL 236 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"221\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"238\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><path d=\"M 323 36\nL 338 36\nL 330 23\nL 323 36\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 338 23\nL 353 23\nL 345 36\nL 338 23\" style=\"stroke:none;fill:rgb(252,132,82)\"/><text x=\"355\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">OHLC</text><text x=\"41\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">37.56</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">35.11</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">32.67</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30.22</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">27.78</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">25.33</text><text x=\"19\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">22.89</text><text x=\"19\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20.44</text><text x=\"41\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">18</text><path d=\"M 65 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 69 359\nL 69 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 142 359\nL 142 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 215 359\nL 215 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 288 359\nL 288 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 361 359\nL 361 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 434 359\nL 434 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 507 359\nL 507 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"90\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"165\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"236\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"311\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"388\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"459\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"530\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 105 192\nL 105 260\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 105 273\nL 105 327\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 91 192\nL 119 192\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 91 327\nL 119 327\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 76 260\nL 134 260\nL 134 273\nL 76 273\nL 76 260\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 178 165\nL 178 219\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 178 260\nL 178 300\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 164 165\nL 192 165\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 164 300\nL 192 300\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 149 219\nL 207 219\nL 207 260\nL 149 260\nL 149 219\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 251 192\nL 251 219\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 251 287\nL 251 354\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 237 192\nL 265 192\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 237 354\nL 265 354\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 222 219\nL 280 219\nL 280 287\nL 222 287\nL 222 219\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 397 151\nL 397 192\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 397 233\nL 397 273\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 383 151\nL 411 151\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 383 273\nL 411 273\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 368 192\nL 426 192\nL 426 233\nL 368 233\nL 368 192\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 543 138\nL 543 165\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 543 205\nL 543 246\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 529 138\nL 557 138\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 529 246\nL 557 246\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 514 165\nL 572 165\nL 572 205\nL 514 205\nL 514 165\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 105 260\nL 178 219\nL 251 287\nL 324 192\nL 397 233\nL 470 165\nL 543 205\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"105\" cy=\"260\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"178\" cy=\"219\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"251\" cy=\"287\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"192\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"397\" cy=\"233\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"470\" cy=\"165\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"543\" cy=\"205\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/></svg>",
+			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 206 29\nL 236 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"221\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"238\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><path d=\"M 323 36\nL 338 36\nL 330 23\nL 323 36\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 338 23\nL 353 23\nL 345 36\nL 338 23\" style=\"stroke:none;fill:rgb(252,132,82)\"/><text x=\"355\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">OHLC</text><text x=\"41\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">37.56</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">35.11</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">32.67</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30.22</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">27.78</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">25.33</text><text x=\"19\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">22.89</text><text x=\"19\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20.44</text><text x=\"41\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">18</text><path d=\"M 65 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 69 359\nL 69 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 142 359\nL 142 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 215 359\nL 215 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 288 359\nL 288 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 361 359\nL 361 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 434 359\nL 434 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 507 359\nL 507 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"90\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"165\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"236\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"311\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"388\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"459\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"530\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 105.5 191.45\nL 105.5 259.18\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 105.5 272.73\nL 105.5 326.91\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 90.9 191.45\nL 120.1 191.45\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 90.9 326.91\nL 120.1 326.91\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 76.3 259.18\nL 134.7 259.18\nL 134.7 272.73\nL 76.3 272.73\nL 76.3 259.18\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 178.5 164.36\nL 178.5 218.55\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 178.5 259.18\nL 178.5 299.82\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 163.9 164.36\nL 193.1 164.36\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 163.9 299.82\nL 193.1 299.82\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><path d=\"M 149.3 218.55\nL 207.7 218.55\nL 207.7 259.18\nL 149.3 259.18\nL 149.3 218.55\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 251.5 191.45\nL 251.5 218.55\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 251.5 286.27\nL 251.5 354\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 236.9 191.45\nL 266.1 191.45\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 236.9 354\nL 266.1 354\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 222.3 218.55\nL 280.7 218.55\nL 280.7 286.27\nL 222.3 286.27\nL 222.3 218.55\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 397.5 150.82\nL 397.5 191.45\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 397.5 232.09\nL 397.5 272.73\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 382.9 150.82\nL 412.1 150.82\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 382.9 272.73\nL 412.1 272.73\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 368.3 191.45\nL 426.7 191.45\nL 426.7 232.09\nL 368.3 232.09\nL 368.3 191.45\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 543.5 137.27\nL 543.5 164.36\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 543.5 205\nL 543.5 245.64\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 528.9 137.27\nL 558.1 137.27\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 528.9 245.64\nL 558.1 245.64\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><path d=\"M 514.3 164.36\nL 572.7 164.36\nL 572.7 205\nL 514.3 205\nL 514.3 164.36\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 105.5 259.18\nL 178.5 218.55\nL 251.5 286.27\nL 324.5 191.45\nL 397.5 232.09\nL 470.5 164.36\nL 543.5 205\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"105.5\" cy=\"259.18\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"178.5\" cy=\"218.55\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"251.5\" cy=\"286.27\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324.5\" cy=\"191.45\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"397.5\" cy=\"232.09\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"470.5\" cy=\"164.36\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"543.5\" cy=\"205\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/></svg>",
 		},
 	}
 
@@ -527,7 +675,7 @@ func TestDualAxisChartCombos(t *testing.T) {
 					SeriesList: append(scatterSeries, lineSeries...),
 				}
 			},
-			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 184 29\nL 214 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"199\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"216\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Scatter Data</text><path d=\"M 321 29\nL 351 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"336\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"353\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><text x=\"559\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"559\" y=\"121\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"559\" y=\"180\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.2</text><text x=\"559\" y=\"239\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.8</text><text x=\"559\" y=\"298\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4</text><text x=\"559\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">300</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">283.33</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">266.67</text><text x=\"40\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">250</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">233.33</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">216.67</text><text x=\"40\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"19\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">183.33</text><text x=\"19\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">166.67</text><text x=\"40\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><path d=\"M 73 56\nL 549 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 549 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 549 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 549 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 549 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 549 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 549 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 549 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 549 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 549 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 144 359\nL 144 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 359\nL 211 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 279 359\nL 279 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 346 359\nL 346 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 414 359\nL 414 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 481 359\nL 481 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 359\nL 549 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"95\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"164\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"230\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"299\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"371\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"436\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"502\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 110 325\nL 177 280\nL 245 340\nL 312 235\nL 380 295\nL 447 191\nL 515 250\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"110\" cy=\"325\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"177\" cy=\"280\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"245\" cy=\"340\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"312\" cy=\"235\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"380\" cy=\"295\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"447\" cy=\"191\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"515\" cy=\"250\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"77\" cy=\"295\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"155\" cy=\"255\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"234\" cy=\"354\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"313\" cy=\"295\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"391\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"470\" cy=\"96\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"549\" cy=\"156\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/></svg>",
+			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 184 29\nL 214 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"199\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"216\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Scatter Data</text><path d=\"M 321 29\nL 351 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"336\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"353\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><text x=\"559\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"559\" y=\"121\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"559\" y=\"180\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.2</text><text x=\"559\" y=\"239\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.8</text><text x=\"559\" y=\"298\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4</text><text x=\"559\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">300</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">283.33</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">266.67</text><text x=\"40\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">250</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">233.33</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">216.67</text><text x=\"40\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"19\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">183.33</text><text x=\"19\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">166.67</text><text x=\"40\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><path d=\"M 73 56\nL 549 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 549 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 549 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 549 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 549 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 549 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 549 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 549 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 549 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 549 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 144 359\nL 144 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 359\nL 211 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 279 359\nL 279 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 346 359\nL 346 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 414 359\nL 414 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 481 359\nL 481 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 359\nL 549 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"95\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"164\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"230\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"299\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"371\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"436\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"502\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 110.71 324.2\nL 178.14 279.5\nL 245.57 339.1\nL 313 234.8\nL 380.43 294.4\nL 447.86 190.1\nL 515.29 249.7\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"110.71\" cy=\"324.2\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"178.14\" cy=\"279.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"245.57\" cy=\"339.1\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"313\" cy=\"234.8\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"380.43\" cy=\"294.4\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"447.86\" cy=\"190.1\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"515.29\" cy=\"249.7\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"77\" cy=\"294.4\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"155.67\" cy=\"254.67\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"234.33\" cy=\"354\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"313\" cy=\"294.4\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"391.67\" cy=\"334.13\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"470.33\" cy=\"95.73\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"549\" cy=\"155.33\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/></svg>",
 		},
 		{
 			name: "bar+line",
@@ -567,7 +715,7 @@ func TestDualAxisChartCombos(t *testing.T) {
 					SeriesList: append(barSeries, lineSeries...),
 				}
 			},
-			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 197 29\nL 227 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"212\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"229\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Bar Data</text><path d=\"M 308 29\nL 338 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"323\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"340\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><text x=\"559\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"559\" y=\"121\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"559\" y=\"180\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.2</text><text x=\"559\" y=\"239\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.8</text><text x=\"559\" y=\"298\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4</text><text x=\"559\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">141.11</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132.22</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.33</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114.44</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"27\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.67</text><text x=\"27\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">87.78</text><text x=\"27\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">78.89</text><text x=\"49\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">70</text><path d=\"M 73 56\nL 549 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 549 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 549 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 549 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 549 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 549 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 549 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 549 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 549 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 549 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 144 359\nL 144 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 359\nL 211 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 279 359\nL 279 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 346 359\nL 346 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 414 359\nL 414 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 481 359\nL 481 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 359\nL 549 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"95\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"164\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"230\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"299\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"371\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"436\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"502\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 87 354\nL 134 354\nL 134 353\nL 87 353\nL 87 354\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 154 280\nL 201 280\nL 201 353\nL 154 353\nL 154 280\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 221 205\nL 268 205\nL 268 353\nL 221 353\nL 221 205\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 289 131\nL 336 131\nL 336 353\nL 289 353\nL 289 131\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 356 317\nL 403 317\nL 403 353\nL 356 353\nL 356 317\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 424 243\nL 471 243\nL 471 353\nL 424 353\nL 424 243\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 491 168\nL 538 168\nL 538 353\nL 491 353\nL 491 168\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 110 325\nL 177 235\nL 245 161\nL 312 220\nL 380 131\nL 447 265\nL 515 191\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"110\" cy=\"325\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"177\" cy=\"235\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"245\" cy=\"161\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"312\" cy=\"220\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"380\" cy=\"131\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"447\" cy=\"265\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"515\" cy=\"191\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 197 29\nL 227 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"212\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"229\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Bar Data</text><path d=\"M 308 29\nL 338 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"323\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"340\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line Data</text><text x=\"559\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"559\" y=\"121\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"559\" y=\"180\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.2</text><text x=\"559\" y=\"239\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.8</text><text x=\"559\" y=\"298\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4</text><text x=\"559\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"40\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">141.11</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132.22</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">123.33</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">114.44</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105.56</text><text x=\"27\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">96.67</text><text x=\"27\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">87.78</text><text x=\"27\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">78.89</text><text x=\"49\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">70</text><path d=\"M 73 56\nL 549 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 89\nL 549 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 122\nL 549 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 155\nL 549 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 188\nL 549 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 221\nL 549 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 254\nL 549 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 287\nL 549 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 73 320\nL 549 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 77 354\nL 549 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 77 359\nL 77 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 144 359\nL 144 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 211 359\nL 211 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 279 359\nL 279 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 346 359\nL 346 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 414 359\nL 414 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 481 359\nL 481 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 549 359\nL 549 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"95\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"164\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"230\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"299\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"371\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"436\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"502\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 87 354\nL 134.43 354\nL 134.43 353\nL 87 353\nL 87 354\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 154.43 279.5\nL 201.86 279.5\nL 201.86 353\nL 154.43 353\nL 154.43 279.5\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 221.86 205\nL 269.29 205\nL 269.29 353\nL 221.86 353\nL 221.86 205\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 289.29 130.5\nL 336.71 130.5\nL 336.71 353\nL 289.29 353\nL 289.29 130.5\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 356.71 316.75\nL 404.14 316.75\nL 404.14 353\nL 356.71 353\nL 356.71 316.75\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 424.14 242.25\nL 471.57 242.25\nL 471.57 353\nL 424.14 353\nL 424.14 242.25\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 491.57 167.75\nL 539 167.75\nL 539 353\nL 491.57 353\nL 491.57 167.75\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 110.71 324.2\nL 178.14 234.8\nL 245.57 160.3\nL 313 219.9\nL 380.43 130.5\nL 447.86 264.6\nL 515.29 190.1\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"110.71\" cy=\"324.2\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"178.14\" cy=\"234.8\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"245.57\" cy=\"160.3\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"313\" cy=\"219.9\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"380.43\" cy=\"130.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"447.86\" cy=\"264.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"515.29\" cy=\"190.1\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 		},
 		{
 			name: "candlestick+line",
@@ -615,7 +763,7 @@ func TestDualAxisChartCombos(t *testing.T) {
 					SeriesList: append(candlestickSeries, lineSeries...),
 				}
 			},
-			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 212 36\nL 227 36\nL 219 23\nL 212 36\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 227 23\nL 242 23\nL 234 36\nL 227 23\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"244\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">OHLC</text><path d=\"M 304 29\nL 334 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"319\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"336\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Volume</text><text x=\"542\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2k</text><text x=\"542\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.89k</text><text x=\"542\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.78k</text><text x=\"542\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.67k</text><text x=\"542\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.56k</text><text x=\"542\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"542\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.33k</text><text x=\"542\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.22k</text><text x=\"542\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.11k</text><text x=\"542\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"41\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">37.56</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">35.11</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">32.67</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30.22</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">27.78</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">25.33</text><text x=\"19\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">22.89</text><text x=\"19\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20.44</text><text x=\"41\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">18</text><path d=\"M 65 56\nL 532 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 89\nL 532 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 122\nL 532 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 155\nL 532 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 188\nL 532 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 221\nL 532 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 254\nL 532 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 287\nL 532 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 320\nL 532 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 354\nL 532 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 69 359\nL 69 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 135 359\nL 135 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 201 359\nL 201 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 267 359\nL 267 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 333 359\nL 333 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 399 359\nL 399 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 465 359\nL 465 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 532 359\nL 532 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"87\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"155\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"219\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"287\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"357\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"421\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"485\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 102 192\nL 102 260\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 102 273\nL 102 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 89 192\nL 115 192\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 89 327\nL 115 327\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 76 260\nL 128 260\nL 128 273\nL 76 273\nL 76 260\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 168 165\nL 168 219\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 168 260\nL 168 300\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 155 165\nL 181 165\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 155 300\nL 181 300\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 142 219\nL 194 219\nL 194 260\nL 142 260\nL 142 219\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 234 192\nL 234 219\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 234 287\nL 234 354\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 221 192\nL 247 192\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 221 354\nL 247 354\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 208 219\nL 260 219\nL 260 287\nL 208 287\nL 208 219\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 366 151\nL 366 192\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 366 233\nL 366 273\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 353 151\nL 379 151\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 353 273\nL 379 273\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 340 192\nL 392 192\nL 392 233\nL 340 233\nL 340 192\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 498 138\nL 498 165\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 498 205\nL 498 246\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 485 138\nL 511 138\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 485 246\nL 511 246\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 472 165\nL 524 165\nL 524 205\nL 472 205\nL 472 165\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 102 295\nL 168 235\nL 234 325\nL 300 176\nL 366 265\nL 432 116\nL 498 205\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"102\" cy=\"295\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"168\" cy=\"235\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"234\" cy=\"325\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"176\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"366\" cy=\"265\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"432\" cy=\"116\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"498\" cy=\"205\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			expectedSVG: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 212 36\nL 227 36\nL 219 23\nL 212 36\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 227 23\nL 242 23\nL 234 36\nL 227 23\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"244\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">OHLC</text><path d=\"M 304 29\nL 334 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"319\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"336\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Volume</text><text x=\"542\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2k</text><text x=\"542\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.89k</text><text x=\"542\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.78k</text><text x=\"542\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.67k</text><text x=\"542\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.56k</text><text x=\"542\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"542\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.33k</text><text x=\"542\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.22k</text><text x=\"542\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.11k</text><text x=\"542\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"41\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">37.56</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">35.11</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">32.67</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30.22</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">27.78</text><text x=\"19\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">25.33</text><text x=\"19\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">22.89</text><text x=\"19\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20.44</text><text x=\"41\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">18</text><path d=\"M 65 56\nL 532 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 89\nL 532 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 122\nL 532 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 155\nL 532 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 188\nL 532 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 221\nL 532 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 254\nL 532 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 287\nL 532 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 320\nL 532 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 354\nL 532 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 69 359\nL 69 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 135 359\nL 135 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 201 359\nL 201 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 267 359\nL 267 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 333 359\nL 333 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 399 359\nL 399 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 465 359\nL 465 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 532 359\nL 532 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"87\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"155\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"219\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"287\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"357\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"421\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"485\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 102.07 191.45\nL 102.07 259.18\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 102.07 272.73\nL 102.07 326.91\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 88.84 191.45\nL 115.3 191.45\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 88.84 326.91\nL 115.3 326.91\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 75.61 259.18\nL 128.53 259.18\nL 128.53 272.73\nL 75.61 272.73\nL 75.61 259.18\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 168.21 164.36\nL 168.21 218.55\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 168.21 259.18\nL 168.21 299.82\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 154.99 164.36\nL 181.44 164.36\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 154.99 299.82\nL 181.44 299.82\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 141.76 218.55\nL 194.67 218.55\nL 194.67 259.18\nL 141.76 259.18\nL 141.76 218.55\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 234.36 191.45\nL 234.36 218.55\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 234.36 286.27\nL 234.36 354\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 221.13 191.45\nL 247.59 191.45\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 221.13 354\nL 247.59 354\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 207.9 218.55\nL 260.81 218.55\nL 260.81 286.27\nL 207.9 286.27\nL 207.9 218.55\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 366.64 150.82\nL 366.64 191.45\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 366.64 232.09\nL 366.64 272.73\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 353.41 150.82\nL 379.87 150.82\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 353.41 272.73\nL 379.87 272.73\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 340.19 191.45\nL 393.1 191.45\nL 393.1 232.09\nL 340.19 232.09\nL 340.19 191.45\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 498.93 137.27\nL 498.93 164.36\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 498.93 205\nL 498.93 245.64\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 485.7 137.27\nL 512.16 137.27\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 485.7 245.64\nL 512.16 245.64\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 472.47 164.36\nL 525.39 164.36\nL 525.39 205\nL 472.47 205\nL 472.47 164.36\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 102.07 294.4\nL 168.21 234.8\nL 234.36 324.2\nL 300.5 175.2\nL 366.64 264.6\nL 432.79 115.6\nL 498.93 205\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"102.07\" cy=\"294.4\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"168.21\" cy=\"234.8\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"234.36\" cy=\"324.2\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300.5\" cy=\"175.2\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"366.64\" cy=\"264.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"432.79\" cy=\"115.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"498.93\" cy=\"205\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 		},
 	}
 
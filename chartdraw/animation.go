@@ -0,0 +1,309 @@
+package chartdraw
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/gif"
+	"image/png"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultAnimationFrameDelay is the time each frame is shown when a delay is not specified.
+const DefaultAnimationFrameDelay = 500 * time.Millisecond
+
+// AnimationOptions configures the timing of an animation.
+type AnimationOptions struct {
+	// FrameDelay is the time each frame is shown, default is DefaultAnimationFrameDelay.
+	FrameDelay time.Duration
+	// PlayCount is the number of times the animation is played, zero repeats the animation forever.
+	PlayCount int
+}
+
+func (o AnimationOptions) frameDelay() time.Duration {
+	if o.FrameDelay <= 0 {
+		return DefaultAnimationFrameDelay
+	}
+	return o.FrameDelay
+}
+
+// EncodeGIF writes the frames as an animated GIF. Each frame is reduced to a palette of its 256 most frequent colors,
+// and as GIF images can't represent partial transparency frames are composited over white.
+func EncodeGIF(w io.Writer, frames []image.Image, opt AnimationOptions) error {
+	if len(frames) == 0 {
+		return errors.New("no animation frames")
+	}
+	// GIF delays are in hundredths of a second
+	delay := int(opt.frameDelay() / (10 * time.Millisecond))
+	anim := &gif.GIF{
+		Image: make([]*image.Paletted, len(frames)),
+		Delay: make([]int, len(frames)),
+	}
+	switch {
+	case opt.PlayCount == 1:
+		anim.LoopCount = -1
+	case opt.PlayCount > 1:
+		anim.LoopCount = opt.PlayCount - 1
+	}
+	for i, frame := range frames {
+		anim.Image[i] = palettedImage(opaqueImage(frame))
+		anim.Delay[i] = delay
+	}
+	return gif.EncodeAll(w, anim)
+}
+
+// pngSignature is the header starting every png file.
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// pngChunk is a single chunk read from a png file.
+type pngChunk struct {
+	typ  string
+	data []byte
+}
+
+// EncodeAPNG writes the frames as an animated PNG. All frames must be the same size.
+func EncodeAPNG(w io.Writer, frames []image.Image, opt AnimationOptions) error {
+	if len(frames) == 0 {
+		return errors.New("no animation frames")
+	}
+	// every frame must share the color type of the first, so if any frame has transparency all frames are encoded
+	// with an alpha channel
+	opaque := true
+	for _, frame := range frames {
+		if o, ok := frame.(interface{ Opaque() bool }); !ok || !o.Opaque() {
+			opaque = false
+			break
+		}
+	}
+
+	var header []byte
+	frameData := make([][][]byte, len(frames))
+	for i, frame := range frames {
+		if frame.Bounds().Size() != frames[0].Bounds().Size() {
+			return fmt.Errorf("animation frame %d size %v does not match first frame size %v",
+				i, frame.Bounds().Size(), frames[0].Bounds().Size())
+		}
+		if !opaque {
+			frame = translucentImage{frame}
+		}
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, frame); err != nil {
+			return err
+		}
+		chunks, err := readPNGChunks(buf.Bytes())
+		if err != nil {
+			return err
+		}
+		for _, c := range chunks {
+			switch c.typ {
+			case "IHDR":
+				if header == nil {
+					header = c.data
+				}
+			case "IDAT":
+				frameData[i] = append(frameData[i], c.data)
+			}
+		}
+	}
+
+	delayMs := opt.frameDelay().Milliseconds()
+	if delayMs > 0xffff {
+		delayMs = 0xffff
+	}
+	size := frames[0].Bounds().Size()
+
+	var buf bytes.Buffer
+	buf.Write(pngSignature)
+	writePNGChunk(&buf, "IHDR", header)
+	actl := make([]byte, 8)
+	binary.BigEndian.PutUint32(actl[0:], uint32(len(frames)))
+	binary.BigEndian.PutUint32(actl[4:], uint32(opt.PlayCount))
+	writePNGChunk(&buf, "acTL", actl)
+	var sequence uint32
+	for i, data := range frameData {
+		fctl := make([]byte, 26)
+		binary.BigEndian.PutUint32(fctl[0:], sequence)
+		binary.BigEndian.PutUint32(fctl[4:], uint32(size.X))
+		binary.BigEndian.PutUint32(fctl[8:], uint32(size.Y))
+		// x and y offsets are zero, as each frame replaces the full image
+		binary.BigEndian.PutUint16(fctl[20:], uint16(delayMs))
+		binary.BigEndian.PutUint16(fctl[22:], 1000)
+		// dispose and blend ops are zero, leaving the frame in place and replacing the prior frame pixels
+		writePNGChunk(&buf, "fcTL", fctl)
+		sequence++
+		for _, d := range data {
+			if i == 0 {
+				writePNGChunk(&buf, "IDAT", d)
+				continue
+			}
+			fdat := make([]byte, 4+len(d))
+			binary.BigEndian.PutUint32(fdat, sequence)
+			copy(fdat[4:], d)
+			writePNGChunk(&buf, "fdAT", fdat)
+			sequence++
+		}
+	}
+	writePNGChunk(&buf, "IEND", nil)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// translucentImage reports the image as not opaque, so the png encoder keeps its alpha channel.
+type translucentImage struct {
+	image.Image
+}
+
+func (translucentImage) Opaque() bool {
+	return false
+}
+
+// readPNGChunks splits an encoded png into its chunks.
+func readPNGChunks(data []byte) ([]pngChunk, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, errors.New("invalid png signature")
+	}
+	data = data[len(pngSignature):]
+	var chunks []pngChunk
+	for len(data) >= 12 {
+		length := int(binary.BigEndian.Uint32(data))
+		if len(data) < 12+length {
+			return nil, errors.New("truncated png chunk")
+		}
+		chunks = append(chunks, pngChunk{typ: string(data[4:8]), data: data[8 : 8+length]})
+		data = data[12+length:]
+	}
+	return chunks, nil
+}
+
+// writePNGChunk writes a chunk with its length and checksum.
+func writePNGChunk(buf *bytes.Buffer, typ string, data []byte) {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+	buf.Write(length[:])
+	crc := crc32.NewIEEE()
+	_, _ = crc.Write([]byte(typ))
+	_, _ = crc.Write(data)
+	buf.WriteString(typ)
+	buf.Write(data)
+	var sum [4]byte
+	binary.BigEndian.PutUint32(sum[:], crc.Sum32())
+	buf.Write(sum[:])
+}
+
+// svgAnimatedElementPattern matches the SVG elements which are compared between animation frames.
+var svgAnimatedElementPattern = regexp.MustCompile(`(?s)<path [^>]*/>|<circle [^>]*/>|<text [^>]*>.*?</text>`)
+
+// svgPathDataPattern matches the path data attribute of a path element.
+var svgPathDataPattern = regexp.MustCompile(` d="[^"]*"`)
+
+// AnimateSVG combines SVG documents rendered by the SVG renderer into a single document animated with SMIL. The first
+// frame is used as the document, path elements which only differ in their path data have their `d` attribute
+// animated between frames, while other changed elements are replaced with a copy for each distinct frame version
+// which is shown only during its frames. All frames must have the same sequence of path, circle and text elements.
+func AnimateSVG(frames [][]byte, opt AnimationOptions) ([]byte, error) {
+	if len(frames) == 0 {
+		return nil, errors.New("no animation frames")
+	} else if len(frames) == 1 {
+		return frames[0], nil
+	}
+	elements := make([][][]int, len(frames))
+	for i, frame := range frames {
+		elements[i] = svgAnimatedElementPattern.FindAllIndex(frame, -1)
+		if len(elements[i]) != len(elements[0]) {
+			return nil, fmt.Errorf("animation frame %d has %d elements, expected %d to match the first frame",
+				i, len(elements[i]), len(elements[0]))
+		}
+	}
+
+	count := len(frames)
+	dur := strconv.FormatFloat((opt.frameDelay()*time.Duration(count)).Seconds(), 'f', -1, 64) + "s"
+	repeat := `repeatCount="indefinite"`
+	if opt.PlayCount > 0 {
+		repeat = `repeatCount="` + strconv.Itoa(opt.PlayCount) + `" fill="freeze"`
+	}
+	// path data reaches each frame at the start of its interval, holding the final frame for its full interval
+	keyTimes := make([]string, count+1)
+	for i := range keyTimes {
+		keyTimes[i] = strconv.FormatFloat(float64(i)/float64(count), 'f', -1, 64)
+	}
+
+	var result bytes.Buffer
+	var last int
+	variants := make([]string, count)
+	for index, loc := range elements[0] {
+		result.Write(frames[0][last:loc[0]])
+		last = loc[1]
+		changed := false
+		for i, frame := range frames {
+			variants[i] = string(frame[elements[i][index][0]:elements[i][index][1]])
+			changed = changed || variants[i] != variants[0]
+		}
+		if !changed {
+			result.WriteString(variants[0])
+		} else if pathData, ok := svgPathDataValues(variants); ok {
+			result.WriteString(withSVGAnimation(variants[0], `<animate attributeName="d" values="`+
+				strings.Join(append(pathData, pathData[count-1]), ";")+`" keyTimes="`+strings.Join(keyTimes, ";")+
+				`" dur="`+dur+`" `+repeat+`/>`))
+		} else {
+			writeSVGFrameVariants(&result, variants, dur, repeat)
+		}
+	}
+	result.Write(frames[0][last:])
+	return result.Bytes(), nil
+}
+
+// svgPathDataValues returns the path data of each variant if they are all path elements which differ only in their
+// path data.
+func svgPathDataValues(variants []string) ([]string, bool) {
+	if !strings.HasPrefix(variants[0], "<path ") {
+		return nil, false
+	}
+	base := svgPathDataPattern.ReplaceAllString(variants[0], "")
+	values := make([]string, len(variants))
+	for i, v := range variants {
+		loc := svgPathDataPattern.FindStringIndex(v)
+		if loc == nil || svgPathDataPattern.ReplaceAllString(v, "") != base {
+			return nil, false
+		}
+		values[i] = v[loc[0]+len(` d="`) : loc[1]-1]
+	}
+	return values, true
+}
+
+// writeSVGFrameVariants writes each distinct element version, visible only during the frames it was rendered in.
+func writeSVGFrameVariants(w *bytes.Buffer, variants []string, dur, repeat string) {
+	written := make(map[string]bool)
+	for _, v := range variants {
+		if written[v] {
+			continue // already written with an earlier frame
+		}
+		written[v] = true
+		values := make([]string, len(variants))
+		for j, other := range variants {
+			if other == v {
+				values[j] = "visible"
+			} else {
+				values[j] = "hidden"
+			}
+		}
+		w.WriteString(withSVGAnimation(v, `<animate attributeName="visibility" values="`+strings.Join(values, ";")+
+			`" calcMode="discrete" dur="`+dur+`" `+repeat+`/>`))
+	}
+}
+
+// withSVGAnimation inserts the animation as a child of the element.
+func withSVGAnimation(element, animation string) string {
+	if strings.HasSuffix(element, "/>") {
+		name := element[1:strings.IndexByte(element, ' ')]
+		return element[:len(element)-2] + ">" + animation + "</" + name + ">"
+	}
+	end := strings.LastIndex(element, "</")
+	return element[:end] + animation + element[end:]
+}
@@ -0,0 +1,134 @@
+package chartdraw
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeAnimationFrames(colors ...color.RGBA) []image.Image {
+	frames := make([]image.Image, len(colors))
+	for i, c := range colors {
+		img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				img.SetRGBA(x, y, c)
+			}
+		}
+		frames[i] = img
+	}
+	return frames
+}
+
+func TestEncodeGIF(t *testing.T) {
+	t.Parallel()
+
+	frames := makeAnimationFrames(color.RGBA{R: 255, A: 255}, color.RGBA{B: 255, A: 255}, color.RGBA{G: 128, A: 128})
+	var buf bytes.Buffer
+	require.NoError(t, EncodeGIF(&buf, frames, AnimationOptions{FrameDelay: 250 * time.Millisecond, PlayCount: 2}))
+
+	anim, err := gif.DecodeAll(&buf)
+	require.NoError(t, err)
+	require.Len(t, anim.Image, 3)
+	assert.Equal(t, []int{25, 25, 25}, anim.Delay)
+	assert.Equal(t, 1, anim.LoopCount)
+	r, g, b, _ := anim.Image[1].At(0, 0).RGBA()
+	assert.Equal(t, []uint32{0, 0, 0xffff}, []uint32{r, g, b})
+	// partial transparency is composited over white
+	r, g, b, _ = anim.Image[2].At(0, 0).RGBA()
+	assert.Equal(t, []uint32{0x7f7f, 0xffff, 0x7f7f}, []uint32{r, g, b})
+
+	assert.Error(t, EncodeGIF(&buf, nil, AnimationOptions{}))
+}
+
+func TestEncodeAPNG(t *testing.T) {
+	t.Parallel()
+
+	frames := makeAnimationFrames(color.RGBA{R: 255, A: 255}, color.RGBA{B: 255, A: 255}, color.RGBA{G: 255, A: 255})
+	var buf bytes.Buffer
+	require.NoError(t, EncodeAPNG(&buf, frames, AnimationOptions{}))
+
+	chunks, err := readPNGChunks(buf.Bytes())
+	require.NoError(t, err)
+	types := make([]string, len(chunks))
+	for i, c := range chunks {
+		types[i] = c.typ
+	}
+	assert.Equal(t, []string{"IHDR", "acTL", "fcTL", "IDAT", "fcTL", "fdAT", "fcTL", "fdAT", "IEND"}, types)
+	assert.Equal(t, []byte{0, 0, 0, 3, 0, 0, 0, 0}, chunks[1].data)
+	// sequence numbers increase across the frame control and data chunks
+	assert.Equal(t, []byte{0, 0, 0, 2}, chunks[5].data[:4])
+	assert.Equal(t, []byte{0, 0, 0, 3}, chunks[6].data[:4])
+	assert.Equal(t, []byte{0x01, 0xf4, 0x03, 0xe8}, chunks[4].data[20:24])
+
+	// decoders without animation support show the first frame
+	img, err := png.Decode(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	r, _, _, _ := img.At(0, 0).RGBA()
+	assert.Equal(t, uint32(0xffff), r)
+
+	mismatched := append(frames, image.NewRGBA(image.Rect(0, 0, 2, 2)))
+	assert.Error(t, EncodeAPNG(&buf, mismatched, AnimationOptions{}))
+}
+
+func TestEncodeAPNGTranslucentFrame(t *testing.T) {
+	t.Parallel()
+
+	frames := makeAnimationFrames(color.RGBA{R: 255, A: 255}, color.RGBA{A: 0})
+	var buf bytes.Buffer
+	require.NoError(t, EncodeAPNG(&buf, frames, AnimationOptions{}))
+
+	img, err := png.Decode(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	_, ok := img.(*image.NRGBA)
+	assert.True(t, ok, "all frames should be encoded with an alpha channel")
+}
+
+func TestAnimateSVG(t *testing.T) {
+	t.Parallel()
+
+	renderFrame := func(y float64, label string) []byte {
+		vr := SVG(100, 100).(FloatRenderer)
+		vr.SetStrokeColor(ColorBlack)
+		vr.SetStrokeWidth(1)
+		vr.MoveTo(0, 0)
+		vr.LineTo(100, 100)
+		vr.Stroke()
+		vr.MoveToF(0, y)
+		vr.LineToF(100, y)
+		vr.Stroke()
+		vr.SetFont(GetDefaultFont())
+		vr.SetFontSize(12)
+		vr.SetFontColor(ColorBlack)
+		vr.Text(label, 10, 10)
+		var buf bytes.Buffer
+		require.NoError(t, vr.Save(&buf))
+		return buf.Bytes()
+	}
+
+	svg, err := AnimateSVG([][]byte{renderFrame(10, "a"), renderFrame(20, "b"), renderFrame(30, "a")},
+		AnimationOptions{FrameDelay: time.Second, PlayCount: 1})
+	require.NoError(t, err)
+	raw := string(svg)
+
+	assert.Equal(t, 1, strings.Count(raw, `d="M 0 0`), "unchanged path should be written once")
+	assert.Contains(t, raw, `<animate attributeName="d" values="M 0 10
+L 100 10;M 0 20
+L 100 20;M 0 30
+L 100 30;M 0 30
+L 100 30" keyTimes="0;0.3333333333333333;0.6666666666666666;1" dur="3s" repeatCount="1" fill="freeze"/></path>`)
+	assert.Contains(t, raw, `>a<animate attributeName="visibility" values="visible;hidden;visible" calcMode="discrete" dur="3s" repeatCount="1" fill="freeze"/></text>`)
+	assert.Contains(t, raw, `>b<animate attributeName="visibility" values="hidden;visible;hidden" calcMode="discrete" dur="3s" repeatCount="1" fill="freeze"/></text>`)
+	assert.True(t, strings.HasSuffix(raw, "</svg>"))
+
+	_, err = AnimateSVG([][]byte{renderFrame(10, "a"), renderFrame(10, "")}, AnimationOptions{})
+	assert.Error(t, err)
+}
@@ -429,3 +429,13 @@ func (p Point) Equals(other Point) bool {
 func (p Point) String() string {
 	return fmt.Sprintf("P{%d,%d}", p.X, p.Y)
 }
+
+// PointF is an X,Y pair with subpixel precision.
+type PointF struct {
+	X, Y float64
+}
+
+// String returns a string representation of the point.
+func (p PointF) String() string {
+	return fmt.Sprintf("P{%g,%g}", p.X, p.Y)
+}
@@ -73,6 +73,19 @@ func GetFont(fontFamily string) *truetype.Font {
 	return nil
 }
 
+// GetFontFamily returns the family name the font is installed under, or an empty string if it is not installed.
+func GetFontFamily(font *truetype.Font) string {
+	var family string
+	fonts.Range(func(key, value any) bool {
+		if value.(*truetype.Font) == font {
+			family = key.(string)
+			return false
+		}
+		return true
+	})
+	return family
+}
+
 func gzipDecompress(data []byte) ([]byte, error) {
 	r, err := gzip.NewReader(bytes.NewReader(data))
 	if err != nil {
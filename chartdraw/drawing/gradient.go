@@ -0,0 +1,172 @@
+package drawing
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/golang/freetype/raster"
+	"golang.org/x/image/draw"
+)
+
+// GradientStop defines the color at a relative offset (0 to 1) along a gradient.
+type GradientStop struct {
+	// Offset is the position of the stop along the gradient, from 0 (start) to 1 (end).
+	Offset float64
+	// Color is the color at this stop.
+	Color Color
+}
+
+// LinearGradient describes a linear color gradient. Coordinates are relative to the bounding box of the filled shape,
+// with (0, 0) at the top left and (1, 1) at the bottom right, matching SVG objectBoundingBox units.
+type LinearGradient struct {
+	// X1, Y1 define the start point of the gradient vector.
+	X1, Y1 float64
+	// X2, Y2 define the end point of the gradient vector.
+	X2, Y2 float64
+	// Stops are the colors along the gradient, ordered by offset.
+	Stops []GradientStop
+}
+
+// NewVerticalGradient returns a gradient from the top color at the top of the shape to the bottom color at the bottom.
+func NewVerticalGradient(top, bottom Color) LinearGradient {
+	return LinearGradient{
+		Y2: 1,
+		Stops: []GradientStop{
+			{Offset: 0, Color: top},
+			{Offset: 1, Color: bottom},
+		},
+	}
+}
+
+// IsZero returns true if the gradient has no color stops.
+func (g LinearGradient) IsZero() bool {
+	return len(g.Stops) == 0
+}
+
+// ColorAt returns the interpolated color at the offset t (clamped between 0 and 1) along the gradient.
+func (g LinearGradient) ColorAt(t float64) Color {
+	if len(g.Stops) == 0 {
+		return ColorTransparent
+	} else if t <= g.Stops[0].Offset {
+		return g.Stops[0].Color
+	}
+	for i := 1; i < len(g.Stops); i++ {
+		prev, next := g.Stops[i-1], g.Stops[i]
+		if t > next.Offset {
+			continue
+		}
+		span := next.Offset - prev.Offset
+		if span <= 0 {
+			return next.Color
+		}
+		ratio := (t - prev.Offset) / span
+		return Color{
+			R: lerpUint8(prev.Color.R, next.Color.R, ratio),
+			G: lerpUint8(prev.Color.G, next.Color.G, ratio),
+			B: lerpUint8(prev.Color.B, next.Color.B, ratio),
+			A: lerpUint8(prev.Color.A, next.Color.A, ratio),
+		}
+	}
+	return g.Stops[len(g.Stops)-1].Color
+}
+
+func lerpUint8(a, b uint8, ratio float64) uint8 {
+	return uint8(math.Round(float64(a) + (float64(b)-float64(a))*ratio))
+}
+
+// offsetAt projects the relative position (rx, ry) within the bounding box onto the gradient vector.
+func (g LinearGradient) offsetAt(rx, ry float64) float64 {
+	dx, dy := g.X2-g.X1, g.Y2-g.Y1
+	length := dx*dx + dy*dy
+	if length == 0 {
+		return 0
+	}
+	return ((rx-g.X1)*dx + (ry-g.Y1)*dy) / length
+}
+
+// gradientPainter is a raster.Painter which composites spans with a gradient color over the destination image.
+type gradientPainter struct {
+	img      draw.Image
+	gradient LinearGradient
+	// minX, minY, width, height define the bounding box the gradient is relative to.
+	minX, minY, width, height float64
+}
+
+// Paint satisfies the raster.Painter interface.
+func (gp *gradientPainter) Paint(ss []raster.Span, _ bool) {
+	bounds := gp.img.Bounds()
+	rgba, _ := gp.img.(*image.RGBA)
+	for _, s := range ss {
+		if s.Y < bounds.Min.Y || s.Y >= bounds.Max.Y {
+			continue
+		}
+		x0, x1 := s.X0, s.X1
+		if x0 < bounds.Min.X {
+			x0 = bounds.Min.X
+		}
+		if x1 > bounds.Max.X {
+			x1 = bounds.Max.X
+		}
+		ry := (float64(s.Y) + 0.5 - gp.minY) / gp.height
+		for x := x0; x < x1; x++ {
+			rx := (float64(x) + 0.5 - gp.minX) / gp.width
+			sr, sg, sb, sa := gp.gradient.ColorAt(gp.gradient.offsetAt(rx, ry)).RGBA()
+			// scale the premultiplied source by the span coverage
+			sr, sg, sb, sa = sr*s.Alpha/0xffff, sg*s.Alpha/0xffff, sb*s.Alpha/0xffff, sa*s.Alpha/0xffff
+			inv := 0xffff - sa
+			if rgba != nil {
+				i := rgba.PixOffset(x, s.Y)
+				pix := rgba.Pix[i : i+4 : i+4]
+				pix[0] = uint8((uint32(pix[0])*0x101*inv/0xffff + sr) >> 8)
+				pix[1] = uint8((uint32(pix[1])*0x101*inv/0xffff + sg) >> 8)
+				pix[2] = uint8((uint32(pix[2])*0x101*inv/0xffff + sb) >> 8)
+				pix[3] = uint8((uint32(pix[3])*0x101*inv/0xffff + sa) >> 8)
+				continue
+			}
+			dr, dg, db, da := gp.img.At(x, s.Y).RGBA()
+			gp.img.Set(x, s.Y, color.RGBA64{
+				R: uint16(dr*inv/0xffff + sr),
+				G: uint16(dg*inv/0xffff + sg),
+				B: uint16(db*inv/0xffff + sb),
+				A: uint16(da*inv/0xffff + sa),
+			})
+		}
+	}
+}
+
+// boundsFlattener forwards flattened segments while tracking the bounding box of all points.
+type boundsFlattener struct {
+	next                   Flattener
+	minX, minY, maxX, maxY float64
+}
+
+func newBoundsFlattener(next Flattener) *boundsFlattener {
+	return &boundsFlattener{
+		next: next,
+		minX: math.MaxFloat64, minY: math.MaxFloat64,
+		maxX: -math.MaxFloat64, maxY: -math.MaxFloat64,
+	}
+}
+
+func (bf *boundsFlattener) track(x, y float64) {
+	bf.minX, bf.maxX = math.Min(bf.minX, x), math.Max(bf.maxX, x)
+	bf.minY, bf.maxY = math.Min(bf.minY, y), math.Max(bf.maxY, y)
+}
+
+// MoveTo satisfies the Flattener interface.
+func (bf *boundsFlattener) MoveTo(x, y float64) {
+	bf.track(x, y)
+	bf.next.MoveTo(x, y)
+}
+
+// LineTo satisfies the Flattener interface.
+func (bf *boundsFlattener) LineTo(x, y float64) {
+	bf.track(x, y)
+	bf.next.LineTo(x, y)
+}
+
+// End satisfies the Flattener interface.
+func (bf *boundsFlattener) End() {
+	bf.next.End()
+}
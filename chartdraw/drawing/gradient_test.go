@@ -0,0 +1,64 @@
+package drawing
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinearGradientColorAt(t *testing.T) {
+	t.Parallel()
+
+	g := NewVerticalGradient(Color{R: 200, G: 100, B: 0, A: 255}, Color{R: 0, G: 100, B: 200, A: 0})
+
+	assert.Equal(t, Color{R: 200, G: 100, B: 0, A: 255}, g.ColorAt(-1))
+	assert.Equal(t, Color{R: 200, G: 100, B: 0, A: 255}, g.ColorAt(0))
+	assert.Equal(t, Color{R: 100, G: 100, B: 100, A: 128}, g.ColorAt(0.5))
+	assert.Equal(t, Color{R: 0, G: 100, B: 200, A: 0}, g.ColorAt(1))
+	assert.Equal(t, Color{R: 0, G: 100, B: 200, A: 0}, g.ColorAt(2))
+	assert.Equal(t, ColorTransparent, LinearGradient{}.ColorAt(0.5))
+}
+
+func TestLinearGradientMultipleStops(t *testing.T) {
+	t.Parallel()
+
+	g := LinearGradient{
+		X2: 1,
+		Stops: []GradientStop{
+			{Offset: 0, Color: ColorRed},
+			{Offset: 0.5, Color: ColorGreen},
+			{Offset: 1, Color: ColorBlue},
+		},
+	}
+
+	assert.False(t, g.IsZero())
+	assert.True(t, LinearGradient{}.IsZero())
+	assert.Equal(t, ColorGreen, g.ColorAt(0.5))
+	assert.Equal(t, Color{R: 0, G: 64, B: 128, A: 255}, g.ColorAt(0.75))
+	assert.InDelta(t, 0.25, g.offsetAt(0.25, 0.9), 0.0001)
+}
+
+func TestRasterGraphicContextFillGradient(t *testing.T) {
+	t.Parallel()
+
+	img := image.NewRGBA(image.Rect(0, 0, 20, 100))
+	gc := NewRasterGraphicContext(img)
+	gc.MoveTo(0, 0)
+	gc.LineTo(20, 0)
+	gc.LineTo(20, 100)
+	gc.LineTo(0, 100)
+	gc.Close()
+	gc.FillGradient(NewVerticalGradient(Color{R: 255, A: 255}, Color{R: 255, A: 0}))
+
+	top := img.RGBAAt(10, 0)
+	middle := img.RGBAAt(10, 50)
+	bottom := img.RGBAAt(10, 99)
+	assert.Equal(t, color.RGBA{R: 254, A: 254}, top)
+	assert.InDelta(t, 127, int(middle.A), 2)
+	assert.Less(t, bottom.A, uint8(5))
+	assert.Greater(t, top.A, middle.A)
+	assert.Greater(t, middle.A, bottom.A)
+	assert.Equal(t, top, img.RGBAAt(0, 0)) // uniform across the horizontal axis
+}
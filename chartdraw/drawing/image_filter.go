@@ -11,3 +11,6 @@ const (
 	// BicubicFilter defines a bicubic filter.
 	BicubicFilter
 )
+
+// NearestFilter defines a nearest neighbor filter, LinearFilter is also drawn using the nearest pixel.
+const NearestFilter = LinearFilter
@@ -20,12 +20,65 @@ type Painter interface {
 func DrawImage(src image.Image, dest draw.Image, tr Matrix, op draw.Op, filter ImageFilter) {
 	var transformer draw.Transformer
 	switch filter {
-	case LinearFilter:
-		transformer = draw.NearestNeighbor
 	case BilinearFilter:
 		transformer = draw.BiLinear
 	case BicubicFilter:
 		transformer = draw.CatmullRom
+	default:
+		transformer = draw.NearestNeighbor
 	}
 	transformer.Transform(dest, f64.Aff3{tr[0], tr[1], tr[4], tr[2], tr[3], tr[5]}, src, src.Bounds(), op, nil)
 }
+
+// NewImagePainter returns a Painter which composites spans over the provided image, using the freetype RGBAPainter
+// when the image is an *image.RGBA.
+func NewImagePainter(img draw.Image) Painter {
+	if rgba, ok := img.(*image.RGBA); ok {
+		return raster.NewRGBAPainter(rgba)
+	}
+	return &imagePainter{img: img}
+}
+
+// imagePainter is a Painter which composites spans over any draw.Image.
+type imagePainter struct {
+	img   draw.Image
+	color color.Color
+}
+
+// SetColor sets the color spans are painted with.
+func (ip *imagePainter) SetColor(c color.Color) {
+	ip.color = c
+}
+
+// Paint satisfies the raster.Painter interface.
+func (ip *imagePainter) Paint(ss []raster.Span, _ bool) {
+	if ip.color == nil {
+		return
+	}
+	bounds := ip.img.Bounds()
+	sr, sg, sb, sa := ip.color.RGBA()
+	for _, s := range ss {
+		if s.Y < bounds.Min.Y || s.Y >= bounds.Max.Y {
+			continue
+		}
+		x0, x1 := s.X0, s.X1
+		if x0 < bounds.Min.X {
+			x0 = bounds.Min.X
+		}
+		if x1 > bounds.Max.X {
+			x1 = bounds.Max.X
+		}
+		// scale the premultiplied source by the span coverage
+		r, g, b, a := sr*s.Alpha/0xffff, sg*s.Alpha/0xffff, sb*s.Alpha/0xffff, sa*s.Alpha/0xffff
+		inv := 0xffff - a
+		for x := x0; x < x1; x++ {
+			dr, dg, db, da := ip.img.At(x, s.Y).RGBA()
+			ip.img.Set(x, s.Y, color.RGBA64{
+				R: uint16(dr*inv/0xffff + r),
+				G: uint16(dg*inv/0xffff + g),
+				B: uint16(db*inv/0xffff + b),
+				A: uint16(da*inv/0xffff + a),
+			})
+		}
+	}
+}
@@ -47,3 +47,15 @@ func TestDrawImageFilters(t *testing.T) {
 	_, _, _, a = dst2.At(0, 0).RGBA()
 	assert.Equal(t, uint32(0xffff), a)
 }
+
+func TestDrawImageUnknownFilter(t *testing.T) {
+	t.Parallel()
+
+	src := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	src.Set(0, 0, color.White)
+	dst := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	// unknown filters fall back to the nearest pixel
+	DrawImage(src, dst, NewScaleMatrix(2, 2), draw.Over, ImageFilter(99))
+	_, _, _, a := dst.At(1, 1).RGBA()
+	assert.Equal(t, uint32(0xffff), a)
+}
@@ -344,6 +344,40 @@ func (rgc *RasterGraphicContext) Fill(paths ...*Path) {
 	rgc.paint(rgc.fillRasterizer, rgc.current.FillColor)
 }
 
+// FillGradient fills the paths with the provided linear gradient. The gradient coordinates are relative to the
+// bounding box of the paths.
+func (rgc *RasterGraphicContext) FillGradient(gradient LinearGradient, paths ...*Path) {
+	rgc.paintGradient(gradient, append(paths, rgc.current.Path))
+	rgc.current.Path.Clear()
+}
+
+// FillStrokeGradient fills the paths with the provided linear gradient and then strokes them.
+func (rgc *RasterGraphicContext) FillStrokeGradient(gradient LinearGradient, paths ...*Path) {
+	rgc.paintGradient(gradient, append(paths, rgc.current.Path))
+	rgc.Stroke(paths...)
+}
+
+func (rgc *RasterGraphicContext) paintGradient(gradient LinearGradient, paths []*Path) {
+	rgc.fillRasterizer.UseNonZeroWinding = rgc.current.FillRule == FillRuleWinding
+
+	bounds := newBoundsFlattener(FtLineBuilder{Adder: rgc.fillRasterizer})
+	flattener := Transformer{Tr: rgc.current.Tr, Flattener: bounds}
+	for _, p := range paths {
+		Flatten(p, flattener, rgc.current.Tr.GetScale())
+	}
+	if bounds.maxX >= bounds.minX && bounds.maxY >= bounds.minY {
+		rgc.fillRasterizer.Rasterize(&gradientPainter{
+			img:      rgc.img,
+			gradient: gradient,
+			minX:     bounds.minX,
+			minY:     bounds.minY,
+			width:    math.Max(bounds.maxX-bounds.minX, 1),
+			height:   math.Max(bounds.maxY-bounds.minY, 1),
+		})
+	}
+	rgc.fillRasterizer.Clear()
+}
+
 // FillStroke first fills the paths and then strokes them.
 func (rgc *RasterGraphicContext) FillStroke(paths ...*Path) {
 	paths = append(paths, rgc.current.Path)
@@ -0,0 +1,343 @@
+package chartdraw
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// pdfFont tracks the glyphs used from a font so that only those glyphs are embedded into the PDF.
+type pdfFont struct {
+	font *truetype.Font
+	// resourceName is the name the font is referenced by in the page resources, e.g. F1.
+	resourceName string
+	// glyphs holds the original glyph index for each subset glyph id, glyph 0 is always the .notdef glyph.
+	glyphs   []truetype.Index
+	glyphIDs map[truetype.Index]uint16
+	// runes records the character for each subset glyph id so text can be extracted from the PDF.
+	runes map[uint16]rune
+}
+
+func newPDFFont(f *truetype.Font, resourceName string) *pdfFont {
+	return &pdfFont{
+		font:         f,
+		resourceName: resourceName,
+		glyphs:       []truetype.Index{0},
+		glyphIDs:     map[truetype.Index]uint16{0: 0},
+		runes:        make(map[uint16]rune),
+	}
+}
+
+// unitsPerEm returns the font design units per em as a fixed scale, loading metrics at this scale returns values
+// in font design units.
+func (pf *pdfFont) unitsPerEm() fixed.Int26_6 {
+	return fixed.Int26_6(pf.font.FUnitsPerEm())
+}
+
+// glyphID returns the subset glyph id for the glyph index, adding it to the subset if not already included.
+func (pf *pdfFont) glyphID(index truetype.Index, r rune) uint16 {
+	gid, ok := pf.glyphIDs[index]
+	if !ok {
+		gid = uint16(len(pf.glyphs))
+		pf.glyphs = append(pf.glyphs, index)
+		pf.glyphIDs[index] = gid
+	}
+	if _, ok := pf.runes[gid]; !ok && index != 0 {
+		pf.runes[gid] = r
+	}
+	return gid
+}
+
+// toPDFUnits converts font design units to the 1000 unit glyph space used by PDF.
+func (pf *pdfFont) toPDFUnits(v fixed.Int26_6) int {
+	return int(int64(v) * 1000 / int64(pf.unitsPerEm()))
+}
+
+// baseFontName returns the PostScript name of the subset font, prefixed with a subset tag derived from the glyphs.
+func (pf *pdfFont) baseFontName() string {
+	name := pf.font.Name(truetype.NameIDPostscriptName)
+	if name == "" {
+		name = pf.font.Name(truetype.NameIDFontFamily)
+	}
+	name = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' {
+			return r
+		}
+		return -1
+	}, name)
+	if name == "" {
+		name = "Font"
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	for _, index := range pf.glyphs {
+		_, _ = fmt.Fprintf(h, ",%d", index)
+	}
+	sum := h.Sum32()
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = byte('A' + sum%26)
+		sum /= 26
+	}
+	return string(tag) + "+" + name
+}
+
+// widths returns the glyph advance widths in PDF glyph space, indexed by subset glyph id.
+func (pf *pdfFont) widths() []int {
+	scale := pf.unitsPerEm()
+	result := make([]int, len(pf.glyphs))
+	for gid, index := range pf.glyphs {
+		result[gid] = pf.toPDFUnits(pf.font.HMetric(scale, index).AdvanceWidth)
+	}
+	return result
+}
+
+// toUnicodeCMap returns a CMap program mapping the subset glyph ids back to their unicode characters.
+func (pf *pdfFont) toUnicodeCMap() []byte {
+	gids := make([]int, 0, len(pf.runes))
+	for gid := range pf.runes {
+		gids = append(gids, int(gid))
+	}
+	sort.Ints(gids)
+
+	var b bytes.Buffer
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n")
+	b.WriteString("/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n")
+	b.WriteString("/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n")
+	b.WriteString("1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	for start := 0; start < len(gids); start += 100 {
+		end := MinInt(start+100, len(gids))
+		_, _ = fmt.Fprintf(&b, "%d beginbfchar\n", end-start)
+		for _, gid := range gids[start:end] {
+			_, _ = fmt.Fprintf(&b, "<%04X> <", gid)
+			for _, u := range utf16Units(pf.runes[uint16(gid)]) {
+				_, _ = fmt.Fprintf(&b, "%04X", u)
+			}
+			b.WriteString(">\n")
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return b.Bytes()
+}
+
+func utf16Units(r rune) []uint16 {
+	if r < 0x10000 {
+		return []uint16{uint16(r)}
+	}
+	r -= 0x10000
+	return []uint16{uint16(0xD800 + (r>>10)&0x3FF), uint16(0xDC00 + r&0x3FF)}
+}
+
+// subsetMetrics holds the aggregate glyph metrics needed for the font tables.
+type subsetMetrics struct {
+	xMin, yMin, xMax, yMax int
+	advanceMax             int
+	minLSB, minRSB         int
+	xMaxExtent             int
+	maxPoints, maxContours int
+}
+
+// subset builds a TrueType font program containing only the used glyphs, in subset glyph id order. The glyph
+// outlines are rebuilt from the parsed font (composite glyphs are flattened), hinting instructions are dropped.
+func (pf *pdfFont) subset() ([]byte, error) {
+	scale := pf.unitsPerEm()
+	var glyf bytes.Buffer
+	var hmtx bytes.Buffer
+	loca := make([]uint32, 0, len(pf.glyphs)+1)
+	var m subsetMetrics
+	var boundsSet bool
+
+	var gb truetype.GlyphBuf
+	for _, index := range pf.glyphs {
+		loca = append(loca, uint32(glyf.Len()))
+		if err := gb.Load(pf.font, scale, index, font.HintingNone); err != nil {
+			return nil, err
+		}
+		advance := int(pf.font.HMetric(scale, index).AdvanceWidth)
+		m.advanceMax = MaxInt(m.advanceMax, advance)
+		if len(gb.Points) == 0 {
+			writeBE(&hmtx, uint16(advance), int16(0))
+			continue
+		}
+
+		xMin, yMin := int(gb.Bounds.Min.X), int(gb.Bounds.Min.Y)
+		xMax, yMax := int(gb.Bounds.Max.X), int(gb.Bounds.Max.Y)
+		writeBE(&hmtx, uint16(advance), int16(xMin))
+		if !boundsSet {
+			m.xMin, m.yMin, m.xMax, m.yMax = xMin, yMin, xMax, yMax
+			m.minLSB, m.minRSB = xMin, advance-xMax
+			boundsSet = true
+		} else {
+			m.xMin, m.yMin = MinInt(m.xMin, xMin), MinInt(m.yMin, yMin)
+			m.xMax, m.yMax = MaxInt(m.xMax, xMax), MaxInt(m.yMax, yMax)
+			m.minLSB, m.minRSB = MinInt(m.minLSB, xMin), MinInt(m.minRSB, advance-xMax)
+		}
+		m.xMaxExtent = MaxInt(m.xMaxExtent, xMax)
+		m.maxPoints = MaxInt(m.maxPoints, len(gb.Points))
+		m.maxContours = MaxInt(m.maxContours, len(gb.Ends))
+
+		writeBE(&glyf, int16(len(gb.Ends)), int16(xMin), int16(yMin), int16(xMax), int16(yMax))
+		for _, end := range gb.Ends {
+			writeBE(&glyf, uint16(end-1))
+		}
+		writeBE(&glyf, uint16(0)) // no instructions
+		for _, p := range gb.Points {
+			glyf.WriteByte(byte(p.Flags & 0x01)) // on curve flag, coordinates written as 16-bit deltas
+		}
+		var prev int16
+		for _, p := range gb.Points {
+			writeBE(&glyf, int16(p.X)-prev)
+			prev = int16(p.X)
+		}
+		prev = 0
+		for _, p := range gb.Points {
+			writeBE(&glyf, int16(p.Y)-prev)
+			prev = int16(p.Y)
+		}
+		for glyf.Len()%4 != 0 {
+			glyf.WriteByte(0)
+		}
+	}
+	loca = append(loca, uint32(glyf.Len()))
+
+	var locaTable bytes.Buffer
+	for _, offset := range loca {
+		writeBE(&locaTable, offset)
+	}
+
+	fontBounds := pf.font.Bounds(scale)
+	numGlyphs := uint16(len(pf.glyphs))
+
+	var head bytes.Buffer
+	writeBE(&head, uint32(0x00010000), uint32(0x00010000), uint32(0), uint32(0x5F0F3CF5),
+		uint16(0x000B), uint16(scale), int64(0), int64(0),
+		int16(m.xMin), int16(m.yMin), int16(m.xMax), int16(m.yMax),
+		uint16(0), uint16(8), int16(2), int16(1), int16(0))
+
+	var hhea bytes.Buffer
+	writeBE(&hhea, uint32(0x00010000), int16(fontBounds.Max.Y), int16(fontBounds.Min.Y), int16(0),
+		uint16(m.advanceMax), int16(m.minLSB), int16(m.minRSB), int16(m.xMaxExtent),
+		int16(1), int16(0), int16(0), int16(0), int16(0), int16(0), int16(0),
+		int16(0), numGlyphs)
+
+	var maxp bytes.Buffer
+	writeBE(&maxp, uint32(0x00010000), numGlyphs, uint16(m.maxPoints), uint16(m.maxContours), uint16(0), uint16(0),
+		uint16(2), uint16(0), uint16(0), uint16(0), uint16(0), uint16(0), uint16(0), uint16(0), uint16(0))
+
+	return buildSFNT([]sfntTable{
+		{tag: "cmap", data: pf.cmap()},
+		{tag: "glyf", data: glyf.Bytes()},
+		{tag: "head", data: head.Bytes()},
+		{tag: "hhea", data: hhea.Bytes()},
+		{tag: "hmtx", data: hmtx.Bytes()},
+		{tag: "loca", data: locaTable.Bytes()},
+		{tag: "maxp", data: maxp.Bytes()},
+	}), nil
+}
+
+// cmap builds a format 4 character map table for the subset, mapping each used BMP character to its subset glyph id.
+// PDF viewers select glyphs by id through CIDToGIDMap, but the table is required for the font program to be valid.
+func (pf *pdfFont) cmap() []byte {
+	mapping := make(map[uint16]uint16, len(pf.runes))
+	for gid, r := range pf.runes {
+		if r > 0 && r < 0xFFFF {
+			mapping[uint16(r)] = gid
+		}
+	}
+	codes := make([]int, 0, len(mapping))
+	for code := range mapping {
+		codes = append(codes, int(code))
+	}
+	sort.Ints(codes)
+
+	// one segment per character, followed by the required final 0xFFFF segment
+	segCount := uint16(len(codes) + 1)
+	var entrySelector uint16
+	for (1 << (entrySelector + 1)) <= segCount {
+		entrySelector++
+	}
+	searchRange := uint16(2 << entrySelector)
+
+	var b bytes.Buffer
+	writeBE(&b, uint16(0), uint16(1), uint16(3), uint16(1), uint32(12))
+	writeBE(&b, uint16(4), 16+8*segCount, uint16(0), 2*segCount, searchRange, entrySelector, 2*segCount-searchRange)
+	for _, code := range codes {
+		writeBE(&b, uint16(code))
+	}
+	writeBE(&b, uint16(0xFFFF), uint16(0))
+	for _, code := range codes {
+		writeBE(&b, uint16(code))
+	}
+	writeBE(&b, uint16(0xFFFF))
+	for _, code := range codes {
+		writeBE(&b, mapping[uint16(code)]-uint16(code))
+	}
+	writeBE(&b, uint16(1))
+	for i := uint16(0); i < segCount; i++ {
+		writeBE(&b, uint16(0))
+	}
+	return b.Bytes()
+}
+
+type sfntTable struct {
+	tag  string
+	data []byte
+}
+
+// buildSFNT assembles the font file from tables which must be provided sorted by tag.
+func buildSFNT(tables []sfntTable) []byte {
+	numTables := uint16(len(tables))
+	var entrySelector uint16
+	for (1 << (entrySelector + 1)) <= numTables {
+		entrySelector++
+	}
+	searchRange := uint16(16 << entrySelector)
+
+	var b bytes.Buffer
+	writeBE(&b, uint32(0x00010000), numTables, searchRange, entrySelector, numTables*16-searchRange)
+	offset := uint32(12 + 16*len(tables))
+	var headOffset uint32
+	for _, t := range tables {
+		b.WriteString(t.tag)
+		writeBE(&b, sfntChecksum(t.data), offset, uint32(len(t.data)))
+		if t.tag == "head" {
+			headOffset = offset
+		}
+		offset += (uint32(len(t.data)) + 3) &^ 3
+	}
+	for _, t := range tables {
+		b.Write(t.data)
+		for i := len(t.data); i%4 != 0; i++ {
+			b.WriteByte(0)
+		}
+	}
+
+	result := b.Bytes()
+	binary.BigEndian.PutUint32(result[headOffset+8:], 0xB1B0AFBA-sfntChecksum(result))
+	return result
+}
+
+func sfntChecksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+func writeBE(b *bytes.Buffer, values ...any) {
+	for _, v := range values {
+		_ = binary.Write(b, binary.BigEndian, v)
+	}
+}
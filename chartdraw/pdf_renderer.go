@@ -0,0 +1,760 @@
+package chartdraw
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/go-analyze/charts/chartdraw/drawing"
+)
+
+// PDF returns a new renderer which produces a single page vector PDF document. Fonts are embedded as subsets
+// containing only the glyphs used.
+func PDF(width, height int) Renderer {
+	pr := &pdfRenderer{
+		width:  width,
+		height: height,
+		dpi:    DefaultDPI,
+	}
+	// flip the coordinate space so the origin is at the top left, matching the other renderers
+	_, _ = fmt.Fprintf(&pr.content, "1 0 0 -1 0 %d cm\n", height)
+	return pr
+}
+
+// pdfRenderer renders chart commands to a PDF page content stream.
+type pdfRenderer struct {
+	width, height int
+	dpi           float64
+	s             Style
+	textTheta     *float64
+
+	content bytes.Buffer
+	// path holds the operators for the path currently being built, written to the content once painted.
+	path                   bytes.Buffer
+	pathX, pathY           float64
+	minX, minY, maxX, maxY float64
+	hasPath                bool
+
+	fonts       []*pdfFont
+	fontLookup  map[*truetype.Font]*pdfFont
+	alphaStates []pdfAlphaState
+	images      []image.Image
+	info        DocumentInfo
+}
+
+// pdfAlphaState is an ExtGState resource setting the fill and stroke opacity.
+type pdfAlphaState struct {
+	fill, stroke uint8
+}
+
+func (as pdfAlphaState) name() string {
+	return "GS" + strconv.Itoa(int(as.fill)) + "_" + strconv.Itoa(int(as.stroke))
+}
+
+// ResetStyle resets the style, preserving the font (for Renderer interface).
+func (pr *pdfRenderer) ResetStyle() {
+	pr.s = Style{
+		FontStyle: FontStyle{
+			Font: pr.s.Font,
+		},
+	}
+}
+
+// GetDPI returns the dpi.
+func (pr *pdfRenderer) GetDPI() float64 {
+	return pr.dpi
+}
+
+// SetDPI sets the DPI used to convert font point sizes to page units (for Renderer interface).
+func (pr *pdfRenderer) SetDPI(dpi float64) {
+	pr.dpi = dpi
+}
+
+// SetClassName is a no-op for PDF output.
+func (pr *pdfRenderer) SetClassName(_ string) {}
+
+// SetDocumentInfo sets the title and subject of the document information dictionary (for DocumentRenderer
+// interface). The data table is not included in PDF output.
+func (pr *pdfRenderer) SetDocumentInfo(info DocumentInfo) {
+	pr.info = info
+}
+
+// SetStrokeColor sets the stroke color (for Renderer interface).
+func (pr *pdfRenderer) SetStrokeColor(c drawing.Color) {
+	pr.s.StrokeColor = c
+}
+
+// SetFillColor sets the fill color (for Renderer interface).
+func (pr *pdfRenderer) SetFillColor(c drawing.Color) {
+	pr.s.FillColor = c
+}
+
+// SetFillGradient sets the gradient used to fill subsequent paths, taking precedence over the fill color.
+func (pr *pdfRenderer) SetFillGradient(gradient drawing.LinearGradient) {
+	pr.s.FillGradient = gradient
+}
+
+// SetStrokeWidth sets the stroke width (for Renderer interface).
+func (pr *pdfRenderer) SetStrokeWidth(width float64) {
+	pr.s.StrokeWidth = width
+}
+
+// SetStrokeDashArray sets the stroke dash array (for Renderer interface).
+func (pr *pdfRenderer) SetStrokeDashArray(dashArray []float64) {
+	pr.s.StrokeDashArray = dashArray
+}
+
+func (pr *pdfRenderer) trackPoint(x, y float64) {
+	if !pr.hasPath {
+		pr.minX, pr.maxX, pr.minY, pr.maxY = x, x, y, y
+		pr.hasPath = true
+	} else {
+		pr.minX, pr.maxX = math.Min(pr.minX, x), math.Max(pr.maxX, x)
+		pr.minY, pr.maxY = math.Min(pr.minY, y), math.Max(pr.maxY, y)
+	}
+	pr.pathX, pr.pathY = x, y
+}
+
+func (pr *pdfRenderer) moveTo(x, y float64) {
+	pr.trackPoint(x, y)
+	pr.path.WriteString(formatPDFFloat(x) + " " + formatPDFFloat(y) + " m\n")
+}
+
+func (pr *pdfRenderer) lineTo(x, y float64) {
+	if !pr.hasPath {
+		pr.moveTo(x, y)
+		return
+	}
+	pr.trackPoint(x, y)
+	pr.path.WriteString(formatPDFFloat(x) + " " + formatPDFFloat(y) + " l\n")
+}
+
+func (pr *pdfRenderer) curveTo(cx1, cy1, cx2, cy2, x, y float64) {
+	if !pr.hasPath {
+		pr.moveTo(pr.pathX, pr.pathY)
+	}
+	pr.trackPoint(cx1, cy1)
+	pr.trackPoint(cx2, cy2)
+	pr.trackPoint(x, y)
+	pr.path.WriteString(formatPDFFloat(cx1) + " " + formatPDFFloat(cy1) + " " +
+		formatPDFFloat(cx2) + " " + formatPDFFloat(cy2) + " " +
+		formatPDFFloat(x) + " " + formatPDFFloat(y) + " c\n")
+}
+
+// MoveTo starts a new sub path at the given point (for Renderer interface).
+func (pr *pdfRenderer) MoveTo(x, y int) {
+	pr.moveTo(float64(x), float64(y))
+}
+
+// LineTo adds a line to the given point (for Renderer interface).
+func (pr *pdfRenderer) LineTo(x, y int) {
+	pr.lineTo(float64(x), float64(y))
+}
+
+// QuadCurveTo adds a quadratic curve, converted to the equivalent cubic curve (for Renderer interface).
+func (pr *pdfRenderer) QuadCurveTo(cx, cy, x, y int) {
+	pr.QuadCurveToF(float64(cx), float64(cy), float64(x), float64(y))
+}
+
+// ArcTo adds an elliptical arc, approximated with cubic curves (for Renderer interface).
+func (pr *pdfRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	pr.arc(float64(cx), float64(cy), rx, ry, startAngle, delta)
+}
+
+// MoveToF starts a new sub path at the given point (for FloatRenderer interface).
+func (pr *pdfRenderer) MoveToF(x, y float64) {
+	pr.moveTo(x, y)
+}
+
+// LineToF adds a line to the given point (for FloatRenderer interface).
+func (pr *pdfRenderer) LineToF(x, y float64) {
+	pr.lineTo(x, y)
+}
+
+// QuadCurveToF adds a quadratic curve, converted to the equivalent cubic curve (for FloatRenderer interface).
+func (pr *pdfRenderer) QuadCurveToF(cx, cy, x, y float64) {
+	x0, y0 := pr.pathX, pr.pathY
+	pr.curveTo(x0+2.0/3.0*(cx-x0), y0+2.0/3.0*(cy-y0), x+2.0/3.0*(cx-x), y+2.0/3.0*(cy-y), x, y)
+}
+
+// ArcToF adds an elliptical arc, approximated with cubic curves (for FloatRenderer interface).
+func (pr *pdfRenderer) ArcToF(cx, cy, rx, ry, startAngle, delta float64) {
+	pr.arc(cx, cy, rx, ry, startAngle, delta)
+}
+
+func (pr *pdfRenderer) arc(cx, cy, rx, ry, startAngle, delta float64) {
+	startX, startY := cx+rx*math.Cos(startAngle), cy+ry*math.Sin(startAngle)
+	if pr.hasPath {
+		pr.lineTo(startX, startY)
+	} else {
+		pr.moveTo(startX, startY)
+	}
+
+	segments := int(math.Ceil(math.Abs(delta) / _pi2))
+	if segments == 0 {
+		return
+	}
+	step := delta / float64(segments)
+	k := 4.0 / 3.0 * math.Tan(step/4)
+	a0 := startAngle
+	for i := 0; i < segments; i++ {
+		a1 := a0 + step
+		cos0, sin0 := math.Cos(a0), math.Sin(a0)
+		cos1, sin1 := math.Cos(a1), math.Sin(a1)
+		pr.curveTo(cx+rx*(cos0-k*sin0), cy+ry*(sin0+k*cos0),
+			cx+rx*(cos1+k*sin1), cy+ry*(sin1-k*cos1),
+			cx+rx*cos1, cy+ry*sin1)
+		a0 = a1
+	}
+}
+
+// Close closes the current sub path (for Renderer interface).
+func (pr *pdfRenderer) Close() {
+	if pr.hasPath {
+		pr.path.WriteString("h\n")
+	}
+}
+
+// Stroke strokes the current path (for Renderer interface).
+func (pr *pdfRenderer) Stroke() {
+	pr.paint(false, pr.strokeVisible())
+}
+
+// Fill fills the current path (for Renderer interface).
+func (pr *pdfRenderer) Fill() {
+	pr.paint(true, false)
+}
+
+// FillStroke fills and strokes the current path (for Renderer interface).
+func (pr *pdfRenderer) FillStroke() {
+	pr.paint(true, pr.strokeVisible())
+}
+
+func (pr *pdfRenderer) strokeVisible() bool {
+	return pr.s.StrokeWidth > 0 && !pr.s.StrokeColor.IsTransparent()
+}
+
+// paint writes the current path to the content stream with the requested painting operations, then clears it.
+func (pr *pdfRenderer) paint(fill, stroke bool) {
+	defer pr.clearPath()
+	if !pr.hasPath {
+		return
+	}
+	if fill && !pr.s.FillGradient.IsZero() {
+		pr.fillGradient(pr.s.FillGradient)
+		fill = false
+	} else if fill && pr.s.FillColor.IsTransparent() {
+		fill = false
+	}
+	if !fill && !stroke {
+		return
+	}
+
+	var op string
+	var fillAlpha, strokeAlpha uint8 = 255, 255
+	pr.content.WriteString("q\n")
+	if fill {
+		op = "f"
+		fillAlpha = pr.s.FillColor.A
+		pr.content.WriteString(pdfColor(pr.s.FillColor) + " rg\n")
+	}
+	if stroke {
+		op = "S"
+		if fill {
+			op = "B"
+		}
+		strokeAlpha = pr.s.StrokeColor.A
+		pr.content.WriteString(pdfColor(pr.s.StrokeColor) + " RG\n")
+		pr.content.WriteString(formatPDFFloat(pr.s.StrokeWidth) + " w\n")
+		if len(pr.s.StrokeDashArray) > 0 {
+			pr.content.WriteString("[")
+			for i, v := range pr.s.StrokeDashArray {
+				if i > 0 {
+					pr.content.WriteString(" ")
+				}
+				pr.content.WriteString(formatPDFFloat(v))
+			}
+			pr.content.WriteString("] 0 d\n")
+		}
+	}
+	if fillAlpha != 255 || strokeAlpha != 255 {
+		pr.setAlpha(fillAlpha, strokeAlpha)
+	}
+	pr.content.Write(pr.path.Bytes())
+	pr.content.WriteString(op + "\nQ\n")
+}
+
+// fillGradient fills the current path by clipping to it and painting narrow bands of color across the bounding box.
+func (pr *pdfRenderer) fillGradient(gradient drawing.LinearGradient) {
+	width, height := math.Max(pr.maxX-pr.minX, 1), math.Max(pr.maxY-pr.minY, 1)
+	dx, dy := gradient.X2-gradient.X1, gradient.Y2-gradient.Y1
+	length := math.Sqrt(dx*dx + dy*dy)
+	if length == 0 {
+		length, dy = 1, 1 // degenerate vector, the first stop color is painted everywhere
+	}
+
+	// bands are painted in the unit square of the bounding box, matching the gradient coordinate space
+	bands := int(math.Ceil(math.Hypot(dx*width, dy*height) / 2))
+	bands = MinInt(MaxInt(bands, 2), 256)
+	extent := 4 / length // distance along the gradient vector that covers the unit square
+	nx, ny := -dy*extent, dx*extent
+
+	pr.content.WriteString("q\n")
+	pr.content.Write(pr.path.Bytes())
+	pr.content.WriteString("W n\n")
+	_, _ = fmt.Fprintf(&pr.content, "%s 0 0 %s %s %s cm\n",
+		formatPDFFloat(width), formatPDFFloat(height), formatPDFFloat(pr.minX), formatPDFFloat(pr.minY))
+	band := func(t0, t1 float64, c drawing.Color) {
+		x0, y0 := gradient.X1+dx*t0, gradient.Y1+dy*t0
+		x1, y1 := gradient.X1+dx*t1, gradient.Y1+dy*t1
+		pr.content.WriteString(pdfColor(c) + " rg\n")
+		pr.setAlpha(c.A, 255)
+		pr.content.WriteString(formatPDFUnitFloat(x0+nx) + " " + formatPDFUnitFloat(y0+ny) + " m " +
+			formatPDFUnitFloat(x1+nx) + " " + formatPDFUnitFloat(y1+ny) + " l " +
+			formatPDFUnitFloat(x1-nx) + " " + formatPDFUnitFloat(y1-ny) + " l " +
+			formatPDFUnitFloat(x0-nx) + " " + formatPDFUnitFloat(y0-ny) + " l h f\n")
+	}
+	band(-extent, 0, gradient.ColorAt(0))
+	for i := 0; i < bands; i++ {
+		t0, t1 := float64(i)/float64(bands), float64(i+1)/float64(bands)
+		band(t0, t1, gradient.ColorAt((t0+t1)/2))
+	}
+	band(1, 1+extent, gradient.ColorAt(1))
+	pr.content.WriteString("Q\n")
+}
+
+// setAlpha applies the opacity for following painting operations, registering the graphics state if needed.
+func (pr *pdfRenderer) setAlpha(fill, stroke uint8) {
+	state := pdfAlphaState{fill: fill, stroke: stroke}
+	pr.content.WriteString("/" + state.name() + " gs\n")
+	for _, as := range pr.alphaStates {
+		if as == state {
+			return
+		}
+	}
+	pr.alphaStates = append(pr.alphaStates, state)
+}
+
+func (pr *pdfRenderer) clearPath() {
+	pr.path.Reset()
+	pr.hasPath = false
+}
+
+// Circle adds a circle to the current path, to be drawn with the next Fill, Stroke, or FillStroke (for Renderer interface).
+func (pr *pdfRenderer) Circle(radius float64, x, y int) {
+	pr.CircleF(radius, float64(x), float64(y))
+}
+
+// CircleF adds a circle to the current path, to be drawn with the next Fill, Stroke, or FillStroke (for FloatRenderer
+// interface).
+func (pr *pdfRenderer) CircleF(radius, x, y float64) {
+	pr.moveTo(x+radius, y)
+	pr.arc(x, y, radius, radius, 0, _2pi)
+	pr.Close()
+}
+
+// DrawImage draws the image as an image XObject scaled to the box, the alpha channel is kept as a soft mask
+// (for DrawImageRenderer interface).
+func (pr *pdfRenderer) DrawImage(img image.Image, box Box, opacity float64, _ drawing.ImageFilter) {
+	if img.Bounds().Empty() || box.Width() <= 0 || box.Height() <= 0 || opacity <= 0 {
+		return
+	}
+	index := len(pr.images)
+	pr.images = append(pr.images, img)
+
+	pr.content.WriteString("q\n")
+	pr.setAlpha(uint8(math.Round(math.Min(opacity, 1)*255)), 255)
+	// the image space is flipped back, as the page coordinate space has the origin at the top left
+	_, _ = fmt.Fprintf(&pr.content, "%d 0 0 %d %d %d cm\n/Im%d Do\nQ\n",
+		box.Width(), -box.Height(), box.Left, box.Bottom, index)
+}
+
+// SetFont sets the font used for text (for Renderer interface).
+func (pr *pdfRenderer) SetFont(f *truetype.Font) {
+	pr.s.Font = f
+}
+
+// SetFontColor sets the color used for text (for Renderer interface).
+func (pr *pdfRenderer) SetFontColor(c drawing.Color) {
+	pr.s.FontColor = c
+}
+
+// SetFontSize sets the font size in points (for Renderer interface).
+func (pr *pdfRenderer) SetFontSize(size float64) {
+	pr.s.FontSize = size
+}
+
+// pdfTextRun is a sequence of characters rendered using the same font.
+type pdfTextRun struct {
+	font    *truetype.Font
+	runes   []rune
+	indices []truetype.Index
+}
+
+// textRuns splits the text into runs by font, characters missing from the style font use a fallback font which
+// contains the glyph when available.
+func (pr *pdfRenderer) textRuns(body string) []pdfTextRun {
+	baseFont := pr.s.GetFont()
+	var runs []pdfTextRun
+	for _, r := range body {
+		f := baseFont
+		index := baseFont.Index(r)
+		if index == 0 {
+			for _, fallbackName := range drawing.FallbackFonts {
+				if fallbackFont := drawing.GetFont(fallbackName); fallbackFont != nil {
+					if fallbackIndex := fallbackFont.Index(r); fallbackIndex != 0 {
+						f, index = fallbackFont, fallbackIndex
+						break
+					}
+				}
+			}
+		}
+		if len(runs) == 0 || runs[len(runs)-1].font != f {
+			runs = append(runs, pdfTextRun{font: f})
+		}
+		run := &runs[len(runs)-1]
+		run.runes = append(run.runes, r)
+		run.indices = append(run.indices, index)
+	}
+	return runs
+}
+
+// Text draws the text with the baseline starting at the given point (for Renderer interface).
+func (pr *pdfRenderer) Text(body string, x, y int) {
+	pr.TextF(body, float64(x), float64(y))
+}
+
+// TextRuns draws each styled run offset from the given baseline position (for TextRunsRenderer interface).
+func (pr *pdfRenderer) TextRuns(runs []TextRun, x, y int) {
+	style := pr.s // the run fonts are set on the style while drawing, then restored
+	defer func() { pr.s = style }()
+	for _, run := range runs {
+		dx, dy := run.rotatedOffset(pr.textTheta)
+		pr.s.Font = run.Font
+		pr.s.FontSize = run.FontSize
+		pr.s.FontColor = run.FontColor
+		pr.TextF(run.Text, float64(x)+dx, float64(y)+dy)
+	}
+}
+
+// TextF draws the text with the baseline starting at the given point (for FloatRenderer interface).
+func (pr *pdfRenderer) TextF(body string, x, y float64) {
+	if body == "" || pr.s.GetFont() == nil || pr.s.FontColor.IsTransparent() {
+		return
+	}
+	fontSize := drawing.PointsToPixels(pr.dpi, pr.s.FontSize)
+
+	pr.content.WriteString("q\n" + pdfColor(pr.s.FontColor) + " rg\n")
+	if pr.s.FontColor.A != 255 {
+		pr.setAlpha(pr.s.FontColor.A, 255)
+	}
+	pr.content.WriteString("1 0 0 1 " + formatPDFFloat(x) + " " + formatPDFFloat(y) + " cm\n")
+	if pr.textTheta != nil {
+		sin, cos := math.Sin(*pr.textTheta), math.Cos(*pr.textTheta)
+		pr.content.WriteString(formatPDFUnitFloat(cos) + " " + formatPDFUnitFloat(sin) + " " +
+			formatPDFUnitFloat(-sin) + " " + formatPDFUnitFloat(cos) + " 0 0 cm\n")
+	}
+	// text space is flipped back so glyphs are drawn upright
+	pr.content.WriteString("BT\n1 0 0 -1 0 0 Tm\n")
+	for _, run := range pr.textRuns(body) {
+		pf := pr.pdfFont(run.font)
+		pr.content.WriteString("/" + pf.resourceName + " " + formatPDFFloat(fontSize) + " Tf\n[<")
+		for i, index := range run.indices {
+			if i > 0 {
+				if kern := run.font.Kern(pf.unitsPerEm(), run.indices[i-1], index); kern != 0 {
+					// TJ adjustments are subtracted from the position, in thousandths of the font size
+					pr.content.WriteString("> " + strconv.Itoa(-pf.toPDFUnits(kern)) + " <")
+				}
+			}
+			_, _ = fmt.Fprintf(&pr.content, "%04X", pf.glyphID(index, run.runes[i]))
+		}
+		pr.content.WriteString(">] TJ\n")
+	}
+	pr.content.WriteString("ET\nQ\n")
+}
+
+// pdfFont returns the font subset tracker for the font, creating it if this is the first use.
+func (pr *pdfRenderer) pdfFont(f *truetype.Font) *pdfFont {
+	if pf, ok := pr.fontLookup[f]; ok {
+		return pf
+	} else if pr.fontLookup == nil {
+		pr.fontLookup = make(map[*truetype.Font]*pdfFont)
+	}
+	pf := newPDFFont(f, "F"+strconv.Itoa(len(pr.fonts)+1))
+	pr.fonts = append(pr.fonts, pf)
+	pr.fontLookup[f] = pf
+	return pf
+}
+
+// MeasureText measures the text using the same glyph advances and kerning the text is drawn with.
+func (pr *pdfRenderer) MeasureText(body string) (box Box) {
+	textFont := pr.s.GetFont()
+	if textFont == nil {
+		return
+	}
+	fontSize := drawing.PointsToPixels(pr.dpi, pr.s.FontSize)
+	var width float64
+	for _, run := range pr.textRuns(body) {
+		upe := fixed.Int26_6(run.font.FUnitsPerEm())
+		var units fixed.Int26_6
+		for i, index := range run.indices {
+			if i > 0 {
+				units += run.font.Kern(upe, run.indices[i-1], index)
+			}
+			units += run.font.HMetric(upe, index).AdvanceWidth
+		}
+		width += float64(units) * fontSize / float64(upe)
+	}
+	box.Right = int(math.Ceil(width))
+	box.Bottom = int(math.Ceil(fontSize))
+	box.IsSet = true
+	if pr.textTheta != nil {
+		box = box.Corners().Rotate(RadiansToDegrees(*pr.textTheta)).Box()
+	}
+	return
+}
+
+// SetTextRotation sets the text rotation (for Renderer interface).
+func (pr *pdfRenderer) SetTextRotation(radians float64) {
+	if radians == 0 {
+		pr.textTheta = nil
+	} else {
+		pr.textTheta = &radians
+	}
+}
+
+// ClearTextRotation clears the text rotation (for Renderer interface).
+func (pr *pdfRenderer) ClearTextRotation() {
+	pr.textTheta = nil
+}
+
+// Save writes the PDF document to the writer (for Renderer interface).
+func (pr *pdfRenderer) Save(w io.Writer) error {
+	pw := &pdfWriter{}
+	pw.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// object ids: 1 catalog, 2 page tree, 3 page, 4 content, followed by 5 objects per font and 2 per image
+	const fontObjectStart = 5
+	imageObjectStart := fontObjectStart + len(pr.fonts)*5
+	var resources strings.Builder
+	resources.WriteString("<< /ProcSet [/PDF /Text]")
+	if len(pr.fonts) > 0 {
+		resources.WriteString(" /Font <<")
+		for i, pf := range pr.fonts {
+			_, _ = fmt.Fprintf(&resources, " /%s %d 0 R", pf.resourceName, fontObjectStart+i*5)
+		}
+		resources.WriteString(" >>")
+	}
+	if len(pr.images) > 0 {
+		resources.WriteString(" /XObject <<")
+		for i := range pr.images {
+			_, _ = fmt.Fprintf(&resources, " /Im%d %d 0 R", i, imageObjectStart+i*2)
+		}
+		resources.WriteString(" >>")
+	}
+	if len(pr.alphaStates) > 0 {
+		resources.WriteString(" /ExtGState <<")
+		for _, as := range pr.alphaStates {
+			_, _ = fmt.Fprintf(&resources, " /%s << /ca %s /CA %s >>", as.name(),
+				formatPDFUnitFloat(float64(as.fill)/255), formatPDFUnitFloat(float64(as.stroke)/255))
+		}
+		resources.WriteString(" >>")
+	}
+	resources.WriteString(" >>")
+
+	pw.object(1, "<< /Type /Catalog /Pages 2 0 R >>")
+	pw.object(2, "<< /Type /Pages /Kids [3 0 R] /Count 1 >>")
+	pw.object(3, fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources %s /Contents 4 0 R >>",
+		pr.width, pr.height, resources.String()))
+	if err := pw.stream(4, "", pr.content.Bytes()); err != nil {
+		return err
+	}
+
+	for i, pf := range pr.fonts {
+		id := fontObjectStart + i*5
+		if err := pr.writeFont(pw, pf, id); err != nil {
+			return err
+		}
+	}
+
+	for i, img := range pr.images {
+		if err := writePDFImage(pw, img, imageObjectStart+i*2); err != nil {
+			return err
+		}
+	}
+
+	var infoID int
+	if pr.info.Title != "" || pr.info.Description != "" {
+		infoID = imageObjectStart + len(pr.images)*2
+		var info strings.Builder
+		info.WriteString("<<")
+		if pr.info.Title != "" {
+			info.WriteString(" /Title " + pdfTextString(pr.info.Title))
+		}
+		if pr.info.Description != "" {
+			info.WriteString(" /Subject " + pdfTextString(pr.info.Description))
+		}
+		info.WriteString(" >>")
+		pw.object(infoID, info.String())
+	}
+
+	pw.finish(1, infoID)
+	_, err := w.Write(pw.buf.Bytes())
+	return err
+}
+
+// writeFont writes the objects for the font starting at the given object id: the Type0 font, the CID font, the
+// font descriptor, the embedded font program, and the ToUnicode map.
+func (pr *pdfRenderer) writeFont(pw *pdfWriter, pf *pdfFont, id int) error {
+	fontProgram, err := pf.subset()
+	if err != nil {
+		return err
+	}
+	baseFont := pf.baseFontName()
+	bounds := pf.font.Bounds(pf.unitsPerEm())
+
+	var widths strings.Builder
+	widths.WriteString("[0 [")
+	for i, width := range pf.widths() {
+		if i > 0 {
+			widths.WriteString(" ")
+		}
+		widths.WriteString(strconv.Itoa(width))
+	}
+	widths.WriteString("]]")
+
+	pw.object(id, fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H "+
+		"/DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>", baseFont, id+1, id+4))
+	pw.object(id+1, fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s "+
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+		"/FontDescriptor %d 0 R /W %s /CIDToGIDMap /Identity >>", baseFont, id+2, widths.String()))
+	pw.object(id+2, fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [%d %d %d %d] "+
+		"/ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+		baseFont, pf.toPDFUnits(bounds.Min.X), pf.toPDFUnits(bounds.Min.Y),
+		pf.toPDFUnits(bounds.Max.X), pf.toPDFUnits(bounds.Max.Y),
+		pf.toPDFUnits(bounds.Max.Y), pf.toPDFUnits(bounds.Min.Y), pf.toPDFUnits(bounds.Max.Y), id+3))
+	if err := pw.stream(id+3, " /Length1 "+strconv.Itoa(len(fontProgram)), fontProgram); err != nil {
+		return err
+	}
+	return pw.stream(id+4, "", pf.toUnicodeCMap())
+}
+
+// writePDFImage writes the image color samples at the given object id, followed by its alpha channel as a soft mask.
+func writePDFImage(pw *pdfWriter, img image.Image, id int) error {
+	bounds := img.Bounds()
+	rgb := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	alpha := make([]byte, 0, bounds.Dx()*bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			rgb = append(rgb, c.R, c.G, c.B)
+			alpha = append(alpha, c.A)
+		}
+	}
+	dict := fmt.Sprintf(" /Type /XObject /Subtype /Image /Width %d /Height %d /BitsPerComponent 8",
+		bounds.Dx(), bounds.Dy())
+	if err := pw.stream(id, dict+" /ColorSpace /DeviceRGB /SMask "+strconv.Itoa(id+1)+" 0 R", rgb); err != nil {
+		return err
+	}
+	return pw.stream(id+1, dict+" /ColorSpace /DeviceGray", alpha)
+}
+
+// pdfWriter assembles PDF objects while tracking their offsets for the cross-reference table.
+type pdfWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func (pw *pdfWriter) startObject(id int) {
+	for len(pw.offsets) < id {
+		pw.offsets = append(pw.offsets, 0)
+	}
+	pw.offsets[id-1] = pw.buf.Len()
+	_, _ = fmt.Fprintf(&pw.buf, "%d 0 obj\n", id)
+}
+
+func (pw *pdfWriter) object(id int, body string) {
+	pw.startObject(id)
+	pw.buf.WriteString(body)
+	pw.buf.WriteString("\nendobj\n")
+}
+
+// stream writes a compressed stream object, extraDict is appended to the stream dictionary.
+func (pw *pdfWriter) stream(id int, extraDict string, data []byte) error {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write(data); err != nil {
+		return err
+	} else if err := zw.Close(); err != nil {
+		return err
+	}
+
+	pw.startObject(id)
+	_, _ = fmt.Fprintf(&pw.buf, "<< /Length %d /Filter /FlateDecode%s >>\nstream\n", compressed.Len(), extraDict)
+	pw.buf.Write(compressed.Bytes())
+	pw.buf.WriteString("\nendstream\nendobj\n")
+	return nil
+}
+
+// finish writes the cross-reference table and trailer, the info dictionary is only referenced when infoID is non-zero.
+func (pw *pdfWriter) finish(rootID, infoID int) {
+	xrefOffset := pw.buf.Len()
+	_, _ = fmt.Fprintf(&pw.buf, "xref\n0 %d\n0000000000 65535 f \n", len(pw.offsets)+1)
+	for _, offset := range pw.offsets {
+		_, _ = fmt.Fprintf(&pw.buf, "%010d 00000 n \n", offset)
+	}
+	_, _ = fmt.Fprintf(&pw.buf, "trailer\n<< /Size %d /Root %d 0 R", len(pw.offsets)+1, rootID)
+	if infoID != 0 {
+		_, _ = fmt.Fprintf(&pw.buf, " /Info %d 0 R", infoID)
+	}
+	_, _ = fmt.Fprintf(&pw.buf, " >>\nstartxref\n%d\n%%%%EOF\n", xrefOffset)
+}
+
+// pdfTextString encodes the text as a UTF-16BE hex string with a byte order mark, so any unicode text can be used.
+func pdfTextString(text string) string {
+	var sb strings.Builder
+	sb.WriteString("<FEFF")
+	for _, u := range utf16.Encode([]rune(text)) {
+		_, _ = fmt.Fprintf(&sb, "%04X", u)
+	}
+	sb.WriteString(">")
+	return sb.String()
+}
+
+// pdfColor returns the color components formatted for the rg and RG operators.
+func pdfColor(c drawing.Color) string {
+	return formatPDFUnitFloat(float64(c.R)/255) + " " +
+		formatPDFUnitFloat(float64(c.G)/255) + " " +
+		formatPDFUnitFloat(float64(c.B)/255)
+}
+
+// formatPDFFloat formats a coordinate value with up to two decimal places.
+func formatPDFFloat(v float64) string {
+	return trimFloatZeros(strconv.FormatFloat(v, 'f', 2, 64))
+}
+
+// formatPDFUnitFloat formats a value with up to four decimal places, for values where precision matters at a
+// small scale such as colors and unit square coordinates.
+func formatPDFUnitFloat(v float64) string {
+	return trimFloatZeros(strconv.FormatFloat(v, 'f', 4, 64))
+}
+
+func trimFloatZeros(s string) string {
+	if strings.ContainsRune(s, '.') {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,230 @@
+package chartdraw
+
+import (
+	"bytes"
+	"compress/zlib"
+	"image"
+	"image/color"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font"
+
+	"github.com/go-analyze/charts/chartdraw/drawing"
+)
+
+// pdfStreams returns the decompressed streams in the document keyed by object id.
+func pdfStreams(t *testing.T, doc []byte) map[int][]byte {
+	t.Helper()
+
+	streamPattern := regexp.MustCompile(`(\d+) 0 obj\n<< /Length (\d+) /Filter /FlateDecode[^>]*>>\nstream\n`)
+	result := make(map[int][]byte)
+	for _, m := range streamPattern.FindAllSubmatchIndex(doc, -1) {
+		id, err := strconv.Atoi(string(doc[m[2]:m[3]]))
+		require.NoError(t, err)
+		length, err := strconv.Atoi(string(doc[m[4]:m[5]]))
+		require.NoError(t, err)
+		zr, err := zlib.NewReader(bytes.NewReader(doc[m[1] : m[1]+length]))
+		require.NoError(t, err)
+		data, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		result[id] = data
+	}
+	return result
+}
+
+func renderTestPDF(t *testing.T, draw func(r Renderer)) []byte {
+	t.Helper()
+
+	r := PDF(200, 100)
+	draw(r)
+	var b bytes.Buffer
+	require.NoError(t, r.Save(&b))
+	return b.Bytes()
+}
+
+func TestPDFRendererDocumentStructure(t *testing.T) {
+	t.Parallel()
+
+	doc := renderTestPDF(t, func(r Renderer) {
+		r.SetFont(GetDefaultFont())
+		r.SetFontSize(12)
+		r.SetFontColor(drawing.ColorBlack)
+		r.Text("Hello", 10, 50)
+	})
+	raw := string(doc)
+
+	assert.True(t, strings.HasPrefix(raw, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(raw, "%%EOF\n"))
+	assert.Contains(t, raw, "/MediaBox [0 0 200 100]")
+	assert.Contains(t, raw, "/Subtype /CIDFontType2")
+	assert.Regexp(t, `/BaseFont /[A-Z]{6}\+Roboto-Medium`, raw)
+
+	// every xref entry must point at the start of its object
+	startXref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(raw)
+	require.Len(t, startXref, 2)
+	xrefOffset, err := strconv.Atoi(startXref[1])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(raw[xrefOffset:], "xref\n"))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(raw[xrefOffset:], -1)
+	require.Len(t, entries, 9)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(entry[1])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(raw[offset:], strconv.Itoa(i+1)+" 0 obj\n"), "object %d", i+1)
+	}
+
+	streams := pdfStreams(t, doc)
+	require.Len(t, streams, 3)
+	assert.Equal(t, "1 0 0 -1 0 100 cm\nq\n0 0 0 rg\n1 0 0 1 10 50 cm\nBT\n1 0 0 -1 0 0 Tm\n"+
+		"/F1 15.33 Tf\n[<00010002000300030004>] TJ\nET\nQ\n", string(streams[4]))
+	assert.Contains(t, string(streams[9]), "<0001> <0048>\n<0002> <0065>\n<0003> <006C>\n<0004> <006F>\n")
+}
+
+func TestPDFRendererPaths(t *testing.T) {
+	t.Parallel()
+
+	doc := renderTestPDF(t, func(r Renderer) {
+		r.SetFillColor(drawing.ColorRed.WithAlpha(128))
+		r.SetStrokeColor(drawing.ColorBlue)
+		r.SetStrokeWidth(2)
+		r.SetStrokeDashArray([]float64{4, 2})
+		r.MoveTo(0, 0)
+		r.LineTo(10, 0)
+		r.QuadCurveTo(10, 10, 0, 10)
+		r.Close()
+		r.FillStroke()
+		r.ResetStyle()
+
+		r.SetStrokeColor(drawing.ColorBlack)
+		r.MoveTo(0, 0)
+		r.LineTo(5, 5)
+		r.Stroke() // zero stroke width draws nothing
+		r.Circle(5, 50, 50)
+		r.SetFillColor(drawing.ColorGreen)
+		r.Fill()
+	})
+
+	streams := pdfStreams(t, doc)
+	require.Len(t, streams, 1)
+	assert.Equal(t, "1 0 0 -1 0 100 cm\n"+
+		"q\n1 0 0 rg\n0 0 1 RG\n2 w\n[4 2] 0 d\n/GS128_255 gs\n0 0 m\n10 0 l\n10 6.67 6.67 10 0 10 c\nh\nB\nQ\n"+
+		"q\n0 0.502 0 rg\n55 50 m\n55 50 l\n55 52.76 52.76 55 50 55 c\n47.24 55 45 52.76 45 50 c\n"+
+		"45 47.24 47.24 45 50 45 c\n52.76 45 55 47.24 55 50 c\nh\nf\nQ\n", string(streams[4]))
+	assert.Contains(t, string(doc), "/GS128_255 << /ca 0.502 /CA 1 >>")
+}
+
+func TestPDFFontSubset(t *testing.T) {
+	t.Parallel()
+
+	f := GetDefaultFont()
+	pf := newPDFFont(f, "F1")
+	text := "Chart 123 Ünïcode"
+	gids := make([]uint16, 0, len(text))
+	for _, r := range text {
+		gids = append(gids, pf.glyphID(f.Index(r), r))
+	}
+	assert.Equal(t, uint16(1), gids[0])
+	assert.Equal(t, gids[5], gids[9]) // repeated spaces reuse the glyph
+	assert.Less(t, len(pf.glyphs), len([]rune(text))+1)
+
+	program, err := pf.subset()
+	require.NoError(t, err)
+	subset, err := truetype.Parse(program)
+	require.NoError(t, err)
+	assert.Equal(t, f.FUnitsPerEm(), subset.FUnitsPerEm())
+
+	scale := pf.unitsPerEm()
+	var original, embedded truetype.GlyphBuf
+	for _, r := range text {
+		index := f.Index(r)
+		gid := truetype.Index(pf.glyphIDs[index])
+		require.NoError(t, original.Load(f, scale, index, font.HintingNone))
+		require.NoError(t, embedded.Load(subset, scale, gid, font.HintingNone))
+		assert.Equal(t, original.Ends, embedded.Ends, string(r))
+		require.Len(t, embedded.Points, len(original.Points), string(r))
+		for i, p := range original.Points {
+			// only the on curve flag is preserved, the other flags describe the original encoding
+			assert.Equal(t, truetype.Point{X: p.X, Y: p.Y, Flags: p.Flags & 0x01}, embedded.Points[i], string(r))
+		}
+		assert.Equal(t, gid, subset.Index(r), string(r))
+		assert.Equal(t, f.HMetric(scale, index).AdvanceWidth, subset.HMetric(scale, gid).AdvanceWidth, string(r))
+	}
+}
+
+func TestPDFRendererMeasureText(t *testing.T) {
+	t.Parallel()
+
+	r := PDF(100, 100)
+	r.SetFont(GetDefaultFont())
+	r.SetFontSize(12.0)
+
+	tb := r.MeasureText("Ljp")
+	assert.Equal(t, 21, tb.Width())
+	assert.Equal(t, 16, tb.Height())
+
+	r.SetTextRotation(_pi2)
+	rotated := r.MeasureText("Ljp")
+	assert.Equal(t, 16, rotated.Width())
+	assert.Equal(t, 21, rotated.Height())
+}
+
+func TestPDFRendererTextRunsRestoresStyle(t *testing.T) {
+	t.Parallel()
+
+	r := PDF(100, 100)
+	r.SetFont(GetDefaultFont())
+	r.SetFontSize(12.0)
+	r.SetFontColor(drawing.ColorBlack)
+	before := r.MeasureText("Ljp")
+
+	r.(TextRunsRenderer).TextRuns([]TextRun{
+		{Text: "a", Font: GetDefaultFont(), FontSize: 20, FontColor: drawing.ColorRed},
+	}, 10, 20)
+
+	pr := r.(*pdfRenderer)
+	assert.InDelta(t, 12.0, pr.s.FontSize, 0)
+	assert.Equal(t, drawing.ColorBlack, pr.s.FontColor)
+	assert.Equal(t, before, r.MeasureText("Ljp"))
+}
+
+func TestPDFRendererDocumentInfo(t *testing.T) {
+	t.Parallel()
+
+	doc := renderTestPDF(t, func(r Renderer) {
+		r.(DocumentRenderer).SetDocumentInfo(DocumentInfo{Title: "Hé", Description: "Sub"})
+	})
+	raw := string(doc)
+
+	assert.Contains(t, raw, "5 0 obj\n<< /Title <FEFF004800E9> /Subject <FEFF005300750062> >>\nendobj\n")
+	assert.Contains(t, raw, "trailer\n<< /Size 6 /Root 1 0 R /Info 5 0 R >>\n")
+
+	doc = renderTestPDF(t, func(r Renderer) {})
+	assert.Contains(t, string(doc), "trailer\n<< /Size 5 /Root 1 0 R >>\n")
+}
+
+func TestPDFRendererDrawImage(t *testing.T) {
+	t.Parallel()
+
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 255})
+	img.SetNRGBA(1, 0, color.NRGBA{B: 255, A: 128})
+
+	doc := renderTestPDF(t, func(r Renderer) {
+		r.(DrawImageRenderer).DrawImage(img, NewBox(20, 10, 50, 40), 1, drawing.NearestFilter)
+	})
+	raw := string(doc)
+
+	assert.Contains(t, raw, "/XObject << /Im0 5 0 R >>")
+	assert.Contains(t, raw, "/Width 2 /Height 1 /BitsPerComponent 8 /ColorSpace /DeviceRGB /SMask 6 0 R")
+	streams := pdfStreams(t, doc)
+	assert.Contains(t, string(streams[4]), "40 0 0 -20 10 40 cm\n/Im0 Do\n")
+	assert.Equal(t, []byte{255, 0, 0, 0, 0, 255}, streams[5])
+	assert.Equal(t, []byte{255, 128}, streams[6])
+}
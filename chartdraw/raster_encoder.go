@@ -0,0 +1,129 @@
+package chartdraw
+
+import (
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"sort"
+)
+
+// DefaultJPGQuality is the jpg quality used when one is not specified.
+const DefaultJPGQuality = 90
+
+// maxPaletteColors is the maximum number of colors in a paletted png.
+const maxPaletteColors = 256
+
+// EncoderOptions configures how raster images are encoded.
+type EncoderOptions struct {
+	// JPGQuality is the jpg quality from 1 to 100, zero uses DefaultJPGQuality.
+	JPGQuality int
+	// PNGCompression is the png compression level, the zero value is png.DefaultCompression.
+	PNGCompression png.CompressionLevel
+	// PNGPaletted encodes png images with a palette of at most 256 colors, reducing the file size. Images with more
+	// colors use the most frequent colors, mapping the remaining pixels to the nearest palette color.
+	PNGPaletted bool
+}
+
+// pngEncodeFunc returns the function used to encode png images with these options.
+func (o EncoderOptions) pngEncodeFunc() func(w io.Writer, i image.Image) error {
+	if !o.PNGPaletted && o.PNGCompression == png.DefaultCompression {
+		return png.Encode
+	}
+	encoder := &png.Encoder{CompressionLevel: o.PNGCompression}
+	return func(w io.Writer, i image.Image) error {
+		if o.PNGPaletted {
+			i = palettedImage(i)
+		}
+		return encoder.Encode(w, i)
+	}
+}
+
+// jpgEncodeFunc returns the function used to encode jpg images with these options.
+func (o EncoderOptions) jpgEncodeFunc() func(w io.Writer, i image.Image) error {
+	quality := o.JPGQuality
+	if quality <= 0 {
+		quality = DefaultJPGQuality
+	} else if quality > 100 {
+		quality = 100
+	}
+	return func(w io.Writer, i image.Image) error {
+		return jpeg.Encode(w, opaqueImage(i), &jpeg.Options{Quality: quality})
+	}
+}
+
+// opaqueImage returns the image composited over white if it contains any transparency, as jpg images can't
+// represent an alpha channel and transparent pixels would otherwise be encoded as black.
+func opaqueImage(i image.Image) image.Image {
+	if o, ok := i.(interface{ Opaque() bool }); ok && o.Opaque() {
+		return i
+	}
+	bounds := i.Bounds()
+	result := image.NewRGBA(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := i.At(x, y).RGBA()
+			inv := 0xffff - a
+			result.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(r + inv),
+				G: uint16(g + inv),
+				B: uint16(b + inv),
+				A: 0xffff,
+			})
+		}
+	}
+	return result
+}
+
+// palettedImage converts the image to a paletted image using its most frequent colors.
+func palettedImage(i image.Image) *image.Paletted {
+	bounds := i.Bounds()
+	counts := make(map[color.NRGBA]int)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			counts[color.NRGBAModel.Convert(i.At(x, y)).(color.NRGBA)]++
+		}
+	}
+	colors := make([]color.NRGBA, 0, len(counts))
+	for c := range counts {
+		colors = append(colors, c)
+	}
+	sort.Slice(colors, func(a, b int) bool {
+		if counts[colors[a]] != counts[colors[b]] {
+			return counts[colors[a]] > counts[colors[b]]
+		}
+		// order equally frequent colors deterministically
+		ca, cb := colors[a], colors[b]
+		if ca.A != cb.A {
+			return ca.A > cb.A
+		} else if ca.R != cb.R {
+			return ca.R < cb.R
+		} else if ca.G != cb.G {
+			return ca.G < cb.G
+		}
+		return ca.B < cb.B
+	})
+	if len(colors) > maxPaletteColors {
+		colors = colors[:maxPaletteColors]
+	}
+	pal := make(color.Palette, len(colors))
+	for index, c := range colors {
+		pal[index] = c
+	}
+
+	result := image.NewPaletted(bounds, pal)
+	indexCache := make(map[color.NRGBA]uint8, len(counts))
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(i.At(x, y)).(color.NRGBA)
+			index, ok := indexCache[c]
+			if !ok {
+				index = uint8(pal.Index(c))
+				indexCache[c] = index
+			}
+			result.SetColorIndex(x, y, index)
+		}
+	}
+	return result
+}
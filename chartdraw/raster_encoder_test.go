@@ -0,0 +1,85 @@
+package chartdraw
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-analyze/charts/chartdraw/drawing"
+)
+
+func renderEncoderTestImage(t *testing.T, newRenderer func(width, height int) Renderer) []byte {
+	t.Helper()
+
+	r := newRenderer(60, 40)
+	r.SetFillColor(drawing.ColorBlue)
+	r.MoveTo(10, 10)
+	r.LineTo(50, 10)
+	r.LineTo(30, 35)
+	r.Close()
+	r.Fill()
+	var buf bytes.Buffer
+	require.NoError(t, r.Save(&buf))
+	return buf.Bytes()
+}
+
+func TestEncoderOptionsJPGQuality(t *testing.T) {
+	t.Parallel()
+
+	low := renderEncoderTestImage(t, JPGWithOptions(RasterOptions{Encoder: EncoderOptions{JPGQuality: 10}}))
+	high := renderEncoderTestImage(t, JPGWithOptions(RasterOptions{Encoder: EncoderOptions{JPGQuality: 100}}))
+	assert.Less(t, len(low), len(high))
+
+	// transparent pixels are composited over white rather than encoded as black
+	img, err := jpeg.Decode(bytes.NewReader(high))
+	require.NoError(t, err)
+	r, g, b, _ := img.At(1, 1).RGBA()
+	assert.Greater(t, r, uint32(0xf000))
+	assert.Greater(t, g, uint32(0xf000))
+	assert.Greater(t, b, uint32(0xf000))
+}
+
+func TestEncoderOptionsPNG(t *testing.T) {
+	t.Parallel()
+
+	defaultPNG := renderEncoderTestImage(t, PNG)
+	uncompressed := renderEncoderTestImage(t, PNGWithOptions(RasterOptions{
+		Encoder: EncoderOptions{PNGCompression: png.NoCompression},
+	}))
+	assert.Greater(t, len(uncompressed), len(defaultPNG))
+
+	paletted := renderEncoderTestImage(t, PNGWithOptions(RasterOptions{Encoder: EncoderOptions{PNGPaletted: true}}))
+	img, err := png.Decode(bytes.NewReader(paletted))
+	require.NoError(t, err)
+	require.IsType(t, &image.Paletted{}, img)
+	assert.Equal(t, color.NRGBA{}, color.NRGBAModel.Convert(img.At(1, 1)))
+	assert.Equal(t, color.NRGBA{B: 255, A: 255}, color.NRGBAModel.Convert(img.At(30, 15)))
+}
+
+func TestPalettedImageLimitsColors(t *testing.T) {
+	t.Parallel()
+
+	src := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for y := 0; y < 20; y++ {
+		for x := 0; x < 40; x++ {
+			if y < 10 {
+				src.Set(x, y, color.RGBA{R: 200, A: 255})
+			} else {
+				// a gradient with more colors than fit in a palette
+				src.Set(x, y, color.RGBA{G: uint8(x*6 + y), B: uint8(y * 10), A: 255})
+			}
+		}
+	}
+
+	img := palettedImage(src)
+	assert.LessOrEqual(t, len(img.Palette), maxPaletteColors)
+	// the most frequent color is first in the palette
+	assert.Equal(t, color.NRGBA{R: 200, A: 255}, img.Palette[0])
+	assert.Equal(t, uint8(0), img.ColorIndexAt(5, 5))
+}
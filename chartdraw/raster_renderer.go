@@ -3,7 +3,8 @@ package chartdraw
 import (
 	"fmt"
 	"image"
-	"image/jpeg"
+	"image/color"
+	imagedraw "image/draw"
 	"image/png"
 	"io"
 	"math"
@@ -15,32 +16,133 @@ import (
 
 // PNG returns a new png raster renderer.
 func PNG(width, height int) Renderer {
-	i := image.NewRGBA(image.Rect(0, 0, width, height))
-	return &rasterRenderer{
-		i:          i,
-		gc:         drawing.NewRasterGraphicContext(i),
-		encodeFunc: png.Encode,
-	}
+	return newRasterRenderer(width, height, 1, png.Encode)
+}
+
+// PNGWithScale returns a png raster renderer constructor which lays out the chart at the provided width and height,
+// while rendering the image at the resolution multiplied by the scale factor (for example 2 for high-DPI displays).
+func PNGWithScale(scale float64) func(width, height int) Renderer {
+	return PNGWithOptions(RasterOptions{Scale: scale})
 }
 
 // JPG returns a new jpg raster renderer.
 func JPG(width, height int) Renderer {
-	i := image.NewRGBA(image.Rect(0, 0, width, height))
-	return &rasterRenderer{
-		i:  i,
-		gc: drawing.NewRasterGraphicContext(i),
-		encodeFunc: func(w io.Writer, i image.Image) error {
-			return jpeg.Encode(w, i, &jpeg.Options{Quality: 90})
-		},
+	return newRasterRenderer(width, height, 1, EncoderOptions{}.jpgEncodeFunc())
+}
+
+// JPGWithScale returns a jpg raster renderer constructor which lays out the chart at the provided width and height,
+// while rendering the image at the resolution multiplied by the scale factor.
+func JPGWithScale(scale float64) func(width, height int) Renderer {
+	return JPGWithOptions(RasterOptions{Scale: scale})
+}
+
+// PNGWithImage returns a png raster renderer which draws directly onto the provided image, for example to composite
+// a chart into a larger image. The chart should be laid out at the image size divided by the scale factor.
+func PNGWithImage(img imagedraw.Image, scale float64) ImageRenderer {
+	return newImageRasterRenderer(img, scale, png.Encode)
+}
+
+// JPGWithImage returns a jpg raster renderer which draws directly onto the provided image. The chart should be laid
+// out at the image size divided by the scale factor.
+func JPGWithImage(img imagedraw.Image, scale float64) ImageRenderer {
+	return newImageRasterRenderer(img, scale, EncoderOptions{}.jpgEncodeFunc())
+}
+
+// RasterOptions configures a raster renderer.
+type RasterOptions struct {
+	// Scale multiplies the image resolution relative to the chart layout, for example 2 for high-DPI displays.
+	Scale float64
+	// Image when set is drawn onto directly, the chart should be laid out at the image size divided by the scale.
+	Image imagedraw.Image
+	// Encoder configures how the image is encoded when saved.
+	Encoder EncoderOptions
+}
+
+// PNGWithOptions returns a png raster renderer constructor using the provided options.
+func PNGWithOptions(opt RasterOptions) func(width, height int) Renderer {
+	return opt.rendererFunc(opt.Encoder.pngEncodeFunc())
+}
+
+// JPGWithOptions returns a jpg raster renderer constructor using the provided options.
+func JPGWithOptions(opt RasterOptions) func(width, height int) Renderer {
+	return opt.rendererFunc(opt.Encoder.jpgEncodeFunc())
+}
+
+func (o RasterOptions) rendererFunc(encodeFunc func(w io.Writer, i image.Image) error) func(width, height int) Renderer {
+	return func(width, height int) Renderer {
+		if o.Image != nil {
+			return newImageRasterRenderer(o.Image, o.Scale, encodeFunc)
+		}
+		return newRasterRenderer(width, height, o.Scale, encodeFunc)
 	}
 }
 
+func newRasterRenderer(width, height int, scale float64, encodeFunc func(w io.Writer, i image.Image) error) *rasterRenderer {
+	if scale <= 0 {
+		scale = 1
+	}
+	i := image.NewRGBA(image.Rect(0, 0, int(math.Ceil(float64(width)*scale)), int(math.Ceil(float64(height)*scale))))
+	return newImageRasterRenderer(i, scale, encodeFunc)
+}
+
+func newImageRasterRenderer(img imagedraw.Image, scale float64, encodeFunc func(w io.Writer, i image.Image) error) *rasterRenderer {
+	if scale <= 0 {
+		scale = 1
+	}
+	canvas := originImage(img)
+	rr := &rasterRenderer{
+		i:          img,
+		canvas:     canvas,
+		gc:         drawing.NewRasterGraphicContextWithPainter(canvas, drawing.NewImagePainter(canvas)),
+		encodeFunc: encodeFunc,
+		scale:      scale,
+	}
+	if scale != 1 {
+		rr.gc.SetDPI(rr.gc.GetDPI() * scale)
+	}
+	return rr
+}
+
+// originImage returns the image with bounds starting at the origin, sub-images are shifted so drawing starts at their
+// minimum point.
+func originImage(img imagedraw.Image) imagedraw.Image {
+	offset := img.Bounds().Min
+	if offset == (image.Point{}) {
+		return img
+	} else if rgba, ok := img.(*image.RGBA); ok {
+		// the pixels of an RGBA sub-image already start at the minimum point
+		return &image.RGBA{Pix: rgba.Pix, Stride: rgba.Stride, Rect: rgba.Rect.Sub(offset)}
+	}
+	return &offsetImage{Image: img, offset: offset}
+}
+
+// offsetImage shifts the coordinates of an image by an offset.
+type offsetImage struct {
+	imagedraw.Image
+	offset image.Point
+}
+
+func (oi *offsetImage) Bounds() image.Rectangle {
+	return oi.Image.Bounds().Sub(oi.offset)
+}
+
+func (oi *offsetImage) At(x, y int) color.Color {
+	return oi.Image.At(x+oi.offset.X, y+oi.offset.Y)
+}
+
+func (oi *offsetImage) Set(x, y int, c color.Color) {
+	oi.Image.Set(x+oi.offset.X, y+oi.offset.Y, c)
+}
+
 // rasterRenderer renders chart commands to a bitmap.
 type rasterRenderer struct {
-	i          *image.RGBA
+	i          imagedraw.Image
+	canvas     imagedraw.Image // i shifted so its bounds start at the origin
 	gc         *drawing.RasterGraphicContext
 	encodeFunc func(w io.Writer, i image.Image) error
 	renderErrs []error
+	// scale is the ratio of image pixels to layout units, coordinates, strokes and fonts are multiplied by it.
+	scale float64
 
 	rotateRadians *float64
 
@@ -58,12 +160,12 @@ func (rr *rasterRenderer) ResetStyle() {
 
 // GetDPI returns the dpi.
 func (rr *rasterRenderer) GetDPI() float64 {
-	return rr.gc.GetDPI()
+	return rr.gc.GetDPI() / rr.scale
 }
 
 // SetDPI sets the rendering DPI (for Renderer interface).
 func (rr *rasterRenderer) SetDPI(dpi float64) {
-	rr.gc.SetDPI(dpi)
+	rr.gc.SetDPI(dpi * rr.scale)
 }
 
 // SetClassName is ignored because raster images have no class names (for Renderer interface).
@@ -89,24 +191,63 @@ func (rr *rasterRenderer) SetFillColor(c drawing.Color) {
 	rr.s.FillColor = c
 }
 
+// SetFillGradient sets the gradient used to fill subsequent paths, taking precedence over the fill color.
+func (rr *rasterRenderer) SetFillGradient(gradient drawing.LinearGradient) {
+	rr.s.FillGradient = gradient
+}
+
+// setStroke applies the stroke style to the graphic context, scaling the width and dash lengths to image pixels.
+func (rr *rasterRenderer) setStroke() {
+	rr.gc.SetStrokeColor(rr.s.StrokeColor)
+	rr.gc.SetLineWidth(rr.s.StrokeWidth * rr.scale)
+	dashArray := rr.s.StrokeDashArray
+	if rr.scale != 1 && len(dashArray) > 0 {
+		dashArray = make([]float64, len(rr.s.StrokeDashArray))
+		for i, v := range rr.s.StrokeDashArray {
+			dashArray[i] = v * rr.scale
+		}
+	}
+	rr.gc.SetLineDash(dashArray, 0)
+}
+
 // MoveTo moves the drawing cursor to the given position (for PathBuilder interface).
 func (rr *rasterRenderer) MoveTo(x, y int) {
-	rr.gc.MoveTo(float64(x), float64(y))
+	rr.MoveToF(float64(x), float64(y))
 }
 
 // LineTo adds a line to the current path (for PathBuilder interface).
 func (rr *rasterRenderer) LineTo(x, y int) {
-	rr.gc.LineTo(float64(x), float64(y))
+	rr.LineToF(float64(x), float64(y))
 }
 
 // QuadCurveTo adds a quadratic curve to the current path (for PathBuilder interface).
 func (rr *rasterRenderer) QuadCurveTo(cx, cy, x, y int) {
-	rr.gc.QuadCurveTo(float64(cx), float64(cy), float64(x), float64(y))
+	rr.QuadCurveToF(float64(cx), float64(cy), float64(x), float64(y))
 }
 
 // ArcTo appends an elliptical arc to the current path (for PathBuilder interface).
 func (rr *rasterRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
-	rr.gc.ArcTo(float64(cx), float64(cy), rx, ry, startAngle, delta)
+	rr.ArcToF(float64(cx), float64(cy), rx, ry, startAngle, delta)
+}
+
+// MoveToF moves the drawing cursor to the given position (for FloatRenderer interface).
+func (rr *rasterRenderer) MoveToF(x, y float64) {
+	rr.gc.MoveTo(x*rr.scale, y*rr.scale)
+}
+
+// LineToF adds a line to the current path (for FloatRenderer interface).
+func (rr *rasterRenderer) LineToF(x, y float64) {
+	rr.gc.LineTo(x*rr.scale, y*rr.scale)
+}
+
+// QuadCurveToF adds a quadratic curve to the current path (for FloatRenderer interface).
+func (rr *rasterRenderer) QuadCurveToF(cx, cy, x, y float64) {
+	rr.gc.QuadCurveTo(cx*rr.scale, cy*rr.scale, x*rr.scale, y*rr.scale)
+}
+
+// ArcToF appends an elliptical arc to the current path (for FloatRenderer interface).
+func (rr *rasterRenderer) ArcToF(cx, cy, rx, ry, startAngle, delta float64) {
+	rr.gc.ArcTo(cx*rr.scale, cy*rr.scale, rx*rr.scale, ry*rr.scale, startAngle, delta)
 }
 
 // Close closes the current path (for PathBuilder interface).
@@ -116,14 +257,16 @@ func (rr *rasterRenderer) Close() {
 
 // Stroke renders the path outline without filling it (for PathBuilder interface).
 func (rr *rasterRenderer) Stroke() {
-	rr.gc.SetStrokeColor(rr.s.StrokeColor)
-	rr.gc.SetLineWidth(rr.s.StrokeWidth)
-	rr.gc.SetLineDash(rr.s.StrokeDashArray, 0)
+	rr.setStroke()
 	rr.gc.Stroke()
 }
 
 // Fill renders the path fill without stroking it (for PathBuilder interface).
 func (rr *rasterRenderer) Fill() {
+	if !rr.s.FillGradient.IsZero() {
+		rr.gc.FillGradient(rr.s.FillGradient)
+		return
+	}
 	rr.gc.SetFillColor(rr.s.FillColor)
 	rr.gc.Fill()
 }
@@ -131,19 +274,58 @@ func (rr *rasterRenderer) Fill() {
 // FillStroke fills and then strokes the current path (for PathBuilder interface).
 func (rr *rasterRenderer) FillStroke() {
 	rr.gc.SetFillColor(rr.s.FillColor)
-	rr.gc.SetStrokeColor(rr.s.StrokeColor)
-	rr.gc.SetLineWidth(rr.s.StrokeWidth)
-	rr.gc.SetLineDash(rr.s.StrokeDashArray, 0)
+	rr.setStroke()
+	if !rr.s.FillGradient.IsZero() {
+		rr.gc.FillStrokeGradient(rr.s.FillGradient)
+		return
+	}
 	rr.gc.FillStroke()
 }
 
 // Circle fully draws a circle at a given point but does not apply the fill or stroke (for PathBuilder interface).
 func (rr *rasterRenderer) Circle(radius float64, x, y int) {
-	xf, yf := float64(x), float64(y)
+	rr.CircleF(radius, float64(x), float64(y))
+}
+
+// CircleF fully draws a circle at a given point but does not apply the fill or stroke (for FloatRenderer interface).
+func (rr *rasterRenderer) CircleF(radius, x, y float64) {
+	xf, yf := x*rr.scale, y*rr.scale
+	radius *= rr.scale
 	rr.gc.MoveTo(xf-radius, yf) // explicit MoveTo to avoid LineTo if components already on raster, see issue #78
 	rr.gc.ArcTo(xf, yf, radius, radius, 0, _2pi)
 }
 
+// DrawImage draws the image scaled to the box using the filter (for DrawImageRenderer interface).
+func (rr *rasterRenderer) DrawImage(img image.Image, box Box, opacity float64, filter drawing.ImageFilter) {
+	srcBounds := img.Bounds()
+	if srcBounds.Empty() || box.Width() <= 0 || box.Height() <= 0 || opacity <= 0 {
+		return
+	}
+	if opacity < 1 {
+		img = imageWithOpacity(img, opacity)
+	}
+	sx := float64(box.Width()) * rr.scale / float64(srcBounds.Dx())
+	sy := float64(box.Height()) * rr.scale / float64(srcBounds.Dy())
+	tr := drawing.Matrix{sx, 0, 0, sy,
+		float64(box.Left)*rr.scale - float64(srcBounds.Min.X)*sx,
+		float64(box.Top)*rr.scale - float64(srcBounds.Min.Y)*sy}
+	drawing.DrawImage(img, rr.canvas, tr, imagedraw.Over, filter)
+}
+
+// imageWithOpacity returns a copy of the image with the alpha channel multiplied by the opacity.
+func imageWithOpacity(img image.Image, opacity float64) image.Image {
+	bounds := img.Bounds()
+	result := image.NewNRGBA(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			c.A = uint8(math.Round(float64(c.A) * opacity))
+			result.SetNRGBA(x, y, c)
+		}
+	}
+	return result
+}
+
 // SetFont sets the font used for text drawing (for Renderer interface).
 func (rr *rasterRenderer) SetFont(f *truetype.Font) {
 	rr.s.Font = f
@@ -161,6 +343,11 @@ func (rr *rasterRenderer) SetFontColor(c drawing.Color) {
 
 // Text draws the provided string at the given coordinates (for Renderer interface).
 func (rr *rasterRenderer) Text(body string, x, y int) {
+	rr.TextF(body, float64(x), float64(y))
+}
+
+// TextF draws the provided string at the given coordinates (for FloatRenderer interface).
+func (rr *rasterRenderer) TextF(body string, x, y float64) {
 	if body == "" {
 		return
 	}
@@ -168,12 +355,29 @@ func (rr *rasterRenderer) Text(body string, x, y int) {
 	rr.gc.SetFont(rr.s.Font)
 	rr.gc.SetFontSize(rr.s.FontSize)
 	rr.gc.SetFillColor(rr.s.FontColor)
-	if _, err := rr.gc.CreateStringPath(body, float64(xf), float64(yf)); err != nil {
+	if _, err := rr.gc.CreateStringPath(body, xf, yf); err != nil {
 		rr.renderErrs = append(rr.renderErrs, err)
 	}
 	rr.gc.Fill()
 }
 
+// TextRuns draws each styled run offset from the given baseline position (for TextRunsRenderer interface).
+func (rr *rasterRenderer) TextRuns(runs []TextRun, x, y int) {
+	style := rr.s // the run fonts are set on the style while drawing, then restored
+	defer func() { rr.s = style }()
+	for _, run := range runs {
+		dx, dy := run.rotatedOffset(rr.rotateRadians)
+		if rr.rotateRadians != nil {
+			// each rotated run translates from the origin
+			rr.gc.SetMatrixTransform(drawing.NewIdentityMatrix())
+		}
+		rr.s.Font = run.Font
+		rr.s.FontSize = run.FontSize
+		rr.s.FontColor = run.FontColor
+		rr.TextF(run.Text, float64(x)+dx, float64(y)+dy)
+	}
+}
+
 // MeasureText returns the height and width in pixels of a string.
 func (rr *rasterRenderer) MeasureText(body string) Box {
 	rr.gc.SetFont(rr.s.Font)
@@ -197,6 +401,11 @@ func (rr *rasterRenderer) MeasureText(body string) Box {
 		b += t
 		t = 0
 	}
+	if rr.scale != 1 {
+		// measure in layout units
+		r /= rr.scale
+		b /= rr.scale
+	}
 
 	textBox := Box{
 		Top:    int(math.Ceil(t)),
@@ -217,14 +426,14 @@ func (rr *rasterRenderer) SetTextRotation(radians float64) {
 	rr.rotateRadians = &radians
 }
 
-func (rr *rasterRenderer) getCoords(x, y int) (xf, yf int) {
+func (rr *rasterRenderer) getCoords(x, y float64) (xf, yf float64) {
 	if rr.rotateRadians == nil {
-		xf = x
-		yf = y
+		xf = x * rr.scale
+		yf = y * rr.scale
 		return
 	}
 
-	rr.gc.Translate(float64(x), float64(y))
+	rr.gc.Translate(x*rr.scale, y*rr.scale)
 	rr.gc.Rotate(*rr.rotateRadians)
 	return
 }
@@ -235,12 +444,25 @@ func (rr *rasterRenderer) ClearTextRotation() {
 	rr.rotateRadians = nil
 }
 
+// Image returns the image the chart has been drawn onto.
+func (rr *rasterRenderer) Image() (image.Image, error) {
+	if len(rr.renderErrs) > 0 {
+		return nil, fmt.Errorf("queued rendering errors: %v", rr.renderErrs)
+	}
+	return rr.i, nil
+}
+
 // Save writes the rendered image to the provided writer (for Renderer interface).
 func (rr *rasterRenderer) Save(w io.Writer) error {
 	if len(rr.renderErrs) > 0 {
 		return fmt.Errorf("queued rendering errors: %v", rr.renderErrs)
 	} else if typed, isTyped := w.(RGBACollector); isTyped {
-		typed.SetRGBA(rr.i)
+		rgba, ok := rr.i.(*image.RGBA)
+		if !ok {
+			rgba = image.NewRGBA(rr.i.Bounds())
+			imagedraw.Draw(rgba, rgba.Bounds(), rr.i, rgba.Bounds().Min, imagedraw.Src)
+		}
+		typed.SetRGBA(rgba)
 		return nil
 	} else if rr.encodeFunc != nil {
 		return rr.encodeFunc(w, rr.i)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"hash/crc32"
 	"image"
+	"image/color"
 	"image/png"
 	"math"
 	"testing"
@@ -31,8 +32,8 @@ func TestRasterRendererRotationAndSave(t *testing.T) {
 
 	rr := PNG(20, 20).(*rasterRenderer)
 	x, y := rr.getCoords(5, 5)
-	assert.Equal(t, 5, x)
-	assert.Equal(t, 5, y)
+	assert.InDelta(t, 5.0, x, 0)
+	assert.InDelta(t, 5.0, y, 0)
 
 	rr.SetTextRotation(math.Pi / 2)
 	x, y = rr.getCoords(5, 5)
@@ -130,6 +131,21 @@ func TestRasterRendererTextHash(t *testing.T) {
 	assert.Equal(t, uint32(0x1b4a9b7d), h)
 }
 
+func TestRasterRendererTextRunsRestoresStyle(t *testing.T) {
+	t.Parallel()
+
+	rr := PNG(50, 20).(*rasterRenderer)
+	rr.SetFont(GetDefaultFont())
+	rr.SetFontSize(10)
+	rr.SetFontColor(drawing.ColorBlack)
+	rr.TextRuns([]TextRun{
+		{Text: "a", Font: GetDefaultFont(), FontSize: 14, FontColor: drawing.ColorRed},
+	}, 2, 12)
+
+	assert.InDelta(t, 10.0, rr.s.FontSize, 0)
+	assert.Equal(t, drawing.ColorBlack, rr.s.FontColor)
+}
+
 func BenchmarkRaterCircle(b *testing.B) {
 	testRadius := []float64{400, 200, 128, 64, 16, 8, 2}
 	bb := &bytes.Buffer{}
@@ -173,3 +189,112 @@ func TestRasterRendererSaveWithQueuedError(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "boom")
 }
+
+func TestRasterRendererScale(t *testing.T) {
+	t.Parallel()
+
+	base := PNG(20, 10).(*rasterRenderer)
+	scaled := PNGWithScale(2)(20, 10).(*rasterRenderer)
+	assert.Equal(t, image.Rect(0, 0, 40, 20), scaled.i.Bounds())
+	assert.InDelta(t, base.GetDPI(), scaled.GetDPI(), 0)
+
+	for _, r := range []Renderer{base, scaled} {
+		r.SetFont(GetDefaultFont())
+		r.SetFontSize(10)
+	}
+	// text measures the same in layout units, within rounding
+	baseBox := base.MeasureText("Hello")
+	scaledBox := scaled.MeasureText("Hello")
+	assert.InDelta(t, baseBox.Width(), scaledBox.Width(), 1)
+	assert.InDelta(t, baseBox.Height(), scaledBox.Height(), 1)
+
+	scaled.SetFillColor(drawing.ColorBlack)
+	scaled.MoveTo(10, 0)
+	scaled.LineTo(20, 0)
+	scaled.LineTo(20, 10)
+	scaled.LineTo(10, 10)
+	scaled.Close()
+	scaled.Fill()
+	img := scaled.i.(*image.RGBA)
+	assert.Equal(t, uint8(0), img.RGBAAt(9, 5).A)
+	assert.Equal(t, uint8(255), img.RGBAAt(20, 5).A)
+	assert.Equal(t, uint8(255), img.RGBAAt(39, 19).A)
+
+	jpg := JPGWithScale(3)(10, 10).(*rasterRenderer)
+	assert.Equal(t, 30, jpg.i.Bounds().Dx())
+}
+
+func TestRasterRendererWithImage(t *testing.T) {
+	t.Parallel()
+
+	fillSquare := func(r Renderer) {
+		r.SetFillColor(drawing.ColorBlack)
+		r.MoveTo(0, 0)
+		r.LineTo(10, 0)
+		r.LineTo(10, 10)
+		r.LineTo(0, 10)
+		r.Close()
+		r.Fill()
+	}
+
+	t.Run("rgba_sub_image", func(t *testing.T) {
+		dest := image.NewRGBA(image.Rect(0, 0, 40, 40))
+		sub := dest.SubImage(image.Rect(20, 20, 40, 40)).(*image.RGBA)
+		r := PNGWithImage(sub, 1)
+		fillSquare(r)
+
+		img, err := r.Image()
+		require.NoError(t, err)
+		assert.Same(t, sub, img)
+		assert.Equal(t, uint8(0), dest.RGBAAt(5, 5).A)
+		assert.Equal(t, uint8(255), dest.RGBAAt(25, 25).A)
+		assert.Equal(t, uint8(0), dest.RGBAAt(35, 35).A)
+	})
+
+	t.Run("non_rgba", func(t *testing.T) {
+		dest := image.NewNRGBA(image.Rect(10, 10, 30, 30))
+		r := JPGWithImage(dest, 1)
+		fillSquare(r)
+
+		assert.Equal(t, uint8(255), dest.NRGBAAt(15, 15).A)
+		assert.Equal(t, uint8(0), dest.NRGBAAt(25, 25).A)
+
+		var collector ImageWriter
+		require.NoError(t, r.Save(&collector))
+		img, err := collector.Image()
+		require.NoError(t, err)
+		assert.Equal(t, dest.Bounds(), img.Bounds())
+	})
+}
+
+func TestRasterRendererDrawImage(t *testing.T) {
+	t.Parallel()
+
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	src.Set(0, 0, drawing.ColorRed)
+	src.Set(1, 0, drawing.ColorBlue)
+	src.Set(0, 1, drawing.ColorBlue)
+	src.Set(1, 1, drawing.ColorRed)
+
+	t.Run("scaled", func(t *testing.T) {
+		r := PNG(40, 40).(*rasterRenderer)
+		r.DrawImage(src, NewBox(10, 10, 30, 30), 1, drawing.NearestFilter)
+		dest := r.i.(*image.RGBA)
+
+		assert.Equal(t, uint8(0), dest.RGBAAt(5, 5).A)
+		assert.Equal(t, color.RGBA{R: 255, A: 255}, dest.RGBAAt(15, 15))
+		assert.Equal(t, color.RGBA{B: 255, A: 255}, dest.RGBAAt(25, 15))
+		assert.Equal(t, color.RGBA{R: 255, A: 255}, dest.RGBAAt(25, 25))
+		assert.Equal(t, uint8(0), dest.RGBAAt(35, 35).A)
+	})
+
+	t.Run("opacity_and_scale", func(t *testing.T) {
+		r := PNGWithScale(2)(40, 40).(*rasterRenderer)
+		r.DrawImage(src, NewBox(0, 0, 20, 20), 0.5, drawing.NearestFilter)
+		dest := r.i.(*image.RGBA)
+
+		assert.InDelta(t, 128, int(dest.RGBAAt(10, 10).A), 1)
+		assert.InDelta(t, 128, int(dest.RGBAAt(30, 30).R), 1)
+		assert.Equal(t, uint8(0), dest.RGBAAt(50, 50).A)
+	})
+}
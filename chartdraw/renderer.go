@@ -1,7 +1,9 @@
 package chartdraw
 
 import (
+	"image"
 	"io"
+	"math"
 
 	"github.com/golang/freetype/truetype"
 
@@ -88,3 +90,145 @@ type Renderer interface {
 	// Save writes the image to the given writer.
 	Save(w io.Writer) error
 }
+
+// TextRun is a segment of text with its own font style, drawn as part of a line of styled text by
+// TextRunsRenderer.TextRuns.
+type TextRun struct {
+	// Text is the run content.
+	Text string
+	// Font is the run font.
+	Font *truetype.Font
+	// FontSize is the run font size in points.
+	FontSize float64
+	// FontColor is the run text color.
+	FontColor drawing.Color
+	// DX is the horizontal offset of the run start from the text position, before rotation.
+	DX float64
+	// DY is the vertical offset of the run baseline from the text baseline, before rotation. Negative values raise
+	// the run, for example for superscripts.
+	DY float64
+}
+
+// rotatedOffset returns the run offset from the text position after rotating by the text rotation.
+func (t TextRun) rotatedOffset(theta *float64) (float64, float64) {
+	if theta == nil {
+		return t.DX, t.DY
+	}
+	sin, cos := math.Sin(*theta), math.Cos(*theta)
+	return t.DX*cos - t.DY*sin, t.DX*sin + t.DY*cos
+}
+
+// Group describes metadata attached to a set of drawing elements, used to make SVG output interactive.
+type Group struct {
+	// ClassName is the CSS class name for the group.
+	ClassName string
+	// Title is a description of the group, most SVG viewers show it as a tooltip.
+	Title string
+	// Attributes are additional attributes set on the group, typically data-* attributes.
+	Attributes []GroupAttribute
+}
+
+// GroupAttribute is a single attribute name and value set on a Group.
+type GroupAttribute struct {
+	Name  string
+	Value string
+}
+
+// DocumentInfo describes a chart document for assistive technologies and document metadata.
+type DocumentInfo struct {
+	// Title is a short accessible name for the chart.
+	Title string
+	// Description is a longer description of the chart.
+	Description string
+	// DataTable optionally provides a tabular summary of the chart data, embedded hidden within SVG output.
+	DataTable *DataTable
+}
+
+// IsZero returns true if no document info is set.
+func (d DocumentInfo) IsZero() bool {
+	return d.Title == "" && d.Description == "" && d.DataTable == nil
+}
+
+// DataTable is a tabular summary of chart data.
+type DataTable struct {
+	// Header contains the column headings.
+	Header []string
+	// Rows contains the table cells, the first cell of each row is used as the row heading.
+	Rows [][]string
+}
+
+// ImageRenderer is a Renderer which draws onto an image that can be accessed directly without encoding.
+type ImageRenderer interface {
+	Renderer
+
+	// Image returns the image the chart has been drawn onto.
+	Image() (image.Image, error)
+}
+
+// GradientRenderer is a Renderer which can fill shapes with a linear gradient.
+type GradientRenderer interface {
+	Renderer
+
+	// SetFillGradient sets a linear gradient which takes precedence over the fill color, a zero gradient clears it.
+	SetFillGradient(drawing.LinearGradient)
+}
+
+// GroupRenderer is a Renderer which can group drawing elements with metadata, such as for interactive SVG output.
+type GroupRenderer interface {
+	Renderer
+
+	// StartGroup begins a group of elements with the provided metadata, groups may be nested.
+	StartGroup(Group)
+
+	// EndGroup closes the most recently started group.
+	EndGroup()
+}
+
+// DocumentRenderer is a Renderer which can describe the document for assistive technologies and document metadata.
+type DocumentRenderer interface {
+	Renderer
+
+	// SetDocumentInfo sets the accessible title, description and data summary for the document.
+	SetDocumentInfo(DocumentInfo)
+}
+
+// FloatRenderer is a Renderer which can draw with subpixel precision rather than rounding to whole pixels.
+type FloatRenderer interface {
+	Renderer
+
+	// MoveToF moves the cursor to a given point with subpixel precision.
+	MoveToF(x, y float64)
+
+	// LineToF draws a line to a given point from the previous point with subpixel precision.
+	LineToF(x, y float64)
+
+	// QuadCurveToF draws a quad curve with subpixel precision.
+	QuadCurveToF(cx, cy, x, y float64)
+
+	// ArcToF draws an arc with subpixel precision, see ArcTo.
+	ArcToF(cx, cy, rx, ry, startAngle, delta float64)
+
+	// CircleF draws a circle at the given coords with a given radius, with subpixel precision.
+	CircleF(radius, x, y float64)
+
+	// TextF draws a text blob with subpixel precision.
+	TextF(body string, x, y float64)
+}
+
+// DrawImageRenderer is a Renderer which can draw images into the output.
+type DrawImageRenderer interface {
+	Renderer
+
+	// DrawImage draws the image scaled to fill the box, with an opacity from 0 to 1. The filter selects the
+	// interpolation used when the image is scaled in raster output.
+	DrawImage(img image.Image, box Box, opacity float64, filter drawing.ImageFilter)
+}
+
+// TextRunsRenderer is a Renderer which can draw a line of text made of differently styled runs.
+type TextRunsRenderer interface {
+	Renderer
+
+	// TextRuns draws a sequence of styled text runs, each offset from the given baseline position. The runs are
+	// rotated together around the position by the text rotation.
+	TextRuns(runs []TextRun, x, y int)
+}
@@ -62,6 +62,8 @@ type Style struct {
 	DotColorProvider DotColorProvider
 
 	FillColor drawing.Color
+	// FillGradient, when set, is used to fill shapes in place of FillColor.
+	FillGradient drawing.LinearGradient
 
 	TextHorizontalAlign TextHorizontalAlign
 	TextVerticalAlign   TextVerticalAlign
@@ -75,6 +77,9 @@ type FontStyle struct {
 	FontSize  float64
 	FontColor drawing.Color
 	Font      *truetype.Font
+	// RichText when set to *true (via Ptr(true)) parses inline markup in the text, such as "{b|bold}", drawing the
+	// text as styled runs. By default text is drawn as written.
+	RichText *bool
 }
 
 // IsZero returns if the font style is set or not.
@@ -478,6 +483,7 @@ func (s Style) GetFillAndStrokeOptions() Style {
 		ClassName:       s.ClassName,
 		StrokeDashArray: s.StrokeDashArray,
 		FillColor:       s.FillColor,
+		FillGradient:    s.FillGradient,
 		StrokeColor:     s.StrokeColor,
 		StrokeWidth:     s.StrokeWidth,
 	}
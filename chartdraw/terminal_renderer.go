@@ -0,0 +1,296 @@
+package chartdraw
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+)
+
+const (
+	// TerminalBraille renders each character cell as a 2x4 grid of braille dots.
+	TerminalBraille = "braille"
+	// TerminalBlock renders each character cell as two vertically stacked half blocks.
+	TerminalBlock = "block"
+	// TerminalSixel encodes the full resolution raster image as Sixel graphics.
+	TerminalSixel = "sixel"
+)
+
+const (
+	// TerminalColorTrue colors characters with 24-bit ANSI escape sequences.
+	TerminalColorTrue = "truecolor"
+	// TerminalColor256 colors characters with the ANSI 256 color palette.
+	TerminalColor256 = "256"
+	// TerminalColorNone renders characters without any escape sequences.
+	TerminalColorNone = "none"
+)
+
+// defaultTerminalColumns is the number of character columns rendered when not specified.
+const defaultTerminalColumns = 80
+
+// TerminalOptions configures rendering a chart for display in a terminal.
+type TerminalOptions struct {
+	// Mode is TerminalBraille (default), TerminalBlock or TerminalSixel.
+	Mode string
+	// Columns is the width of the output in character cells for braille and block modes, default 80.
+	Columns int
+	// Color is TerminalColorTrue (default), TerminalColor256 or TerminalColorNone. Ignored for Sixel output.
+	Color string
+}
+
+// Terminal returns a renderer constructor which rasterizes the chart and saves it as text for display in a terminal.
+// Braille and block modes downscale the chart to the configured number of columns, while Sixel output is encoded at
+// the chart resolution.
+func Terminal(opt TerminalOptions) func(width, height int) Renderer {
+	return func(width, height int) Renderer {
+		scale := 1.0
+		if opt.Mode != TerminalSixel && width > 0 {
+			columns := opt.Columns
+			if columns <= 0 {
+				columns = defaultTerminalColumns
+			}
+			scale = float64(columns*terminalCellWidth(opt.Mode)) / float64(width)
+		}
+		rr := newRasterRenderer(width, height, scale, nil)
+		rr.encodeFunc = func(w io.Writer, i image.Image) error {
+			return encodeTerminal(w, i, opt)
+		}
+		return rr
+	}
+}
+
+// terminalCellWidth returns the horizontal pixels represented by each character cell.
+func terminalCellWidth(mode string) int {
+	if mode == TerminalBlock {
+		return 1
+	}
+	return 2
+}
+
+// encodeTerminal writes the image as terminal text.
+func encodeTerminal(w io.Writer, i image.Image, opt TerminalOptions) error {
+	bw := bufio.NewWriter(w)
+	switch opt.Mode {
+	case TerminalSixel:
+		writeSixel(bw, opaqueImage(i))
+	case TerminalBlock:
+		writeBlocks(bw, opaqueImage(i), opt.Color)
+	default:
+		writeBraille(bw, opaqueImage(i), opt.Color)
+	}
+	return bw.Flush()
+}
+
+// brailleDots maps the x, y position within a 2x4 braille cell to the bit of its dot.
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// writeBraille writes a braille character for each 2x4 pixel cell, dots are set for pixels which differ from the
+// background and colored with the average of those pixels.
+func writeBraille(w *bufio.Writer, i image.Image, colorMode string) {
+	bounds := i.Bounds()
+	background := backgroundColor(i)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += 4 {
+		for x := bounds.Min.X; x < bounds.Max.X; x += 2 {
+			var dots rune
+			var r, g, b, count int
+			for dy := 0; dy < 4 && y+dy < bounds.Max.Y; dy++ {
+				for dx := 0; dx < 2 && x+dx < bounds.Max.X; dx++ {
+					c := color.RGBAModel.Convert(i.At(x+dx, y+dy)).(color.RGBA)
+					if !isForeground(c, background) {
+						continue
+					}
+					dots |= brailleDots[dy][dx]
+					r, g, b = r+int(c.R), g+int(c.G), b+int(c.B)
+					count++
+				}
+			}
+			if dots == 0 {
+				_ = w.WriteByte(' ')
+				continue
+			}
+			writeForeground(w, color.RGBA{R: uint8(r / count), G: uint8(g / count), B: uint8(b / count), A: 255}, colorMode)
+			_, _ = w.WriteRune(0x2800 + dots)
+		}
+		writeLineEnd(w, colorMode)
+	}
+}
+
+// writeBlocks writes an upper half block character for each 1x2 pixel cell, colored with the top pixel as the
+// foreground and bottom pixel as the background. Without color the block is chosen from the pixels which differ from
+// the background.
+func writeBlocks(w *bufio.Writer, i image.Image, colorMode string) {
+	bounds := i.Bounds()
+	background := backgroundColor(i)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += 2 {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			top := color.RGBAModel.Convert(i.At(x, y)).(color.RGBA)
+			bottom := background
+			if y+1 < bounds.Max.Y {
+				bottom = color.RGBAModel.Convert(i.At(x, y+1)).(color.RGBA)
+			}
+			if colorMode == TerminalColorNone {
+				topSet, bottomSet := isForeground(top, background), isForeground(bottom, background)
+				switch {
+				case topSet && bottomSet:
+					_, _ = w.WriteString("█")
+				case topSet:
+					_, _ = w.WriteString("▀")
+				case bottomSet:
+					_, _ = w.WriteString("▄")
+				default:
+					_ = w.WriteByte(' ')
+				}
+				continue
+			}
+			writeForeground(w, top, colorMode)
+			writeBackground(w, bottom, colorMode)
+			_, _ = w.WriteString("▀")
+		}
+		writeLineEnd(w, colorMode)
+	}
+}
+
+// backgroundColor returns the most frequent color of the image.
+func backgroundColor(i image.Image) color.RGBA {
+	bounds := i.Bounds()
+	counts := make(map[color.RGBA]int)
+	var result color.RGBA
+	var maxCount int
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.RGBAModel.Convert(i.At(x, y)).(color.RGBA)
+			counts[c]++
+			if counts[c] > maxCount {
+				maxCount = counts[c]
+				result = c
+			}
+		}
+	}
+	return result
+}
+
+// isForeground returns true if the color is distinct enough from the background to be drawn.
+func isForeground(c, background color.RGBA) bool {
+	diff := math.Abs(float64(c.R)-float64(background.R)) +
+		math.Abs(float64(c.G)-float64(background.G)) +
+		math.Abs(float64(c.B)-float64(background.B))
+	return diff > 96
+}
+
+func writeForeground(w *bufio.Writer, c color.RGBA, colorMode string) {
+	writeColor(w, c, colorMode, "38")
+}
+
+func writeBackground(w *bufio.Writer, c color.RGBA, colorMode string) {
+	writeColor(w, c, colorMode, "48")
+}
+
+// writeColor writes the ANSI escape sequence setting the foreground (38) or background (48) color.
+func writeColor(w *bufio.Writer, c color.RGBA, colorMode, target string) {
+	switch colorMode {
+	case TerminalColorNone:
+		return
+	case TerminalColor256:
+		_, _ = fmt.Fprintf(w, "\x1b[%s;5;%dm", target, ansi256(c))
+	default:
+		_, _ = fmt.Fprintf(w, "\x1b[%s;2;%d;%d;%dm", target, c.R, c.G, c.B)
+	}
+}
+
+func writeLineEnd(w *bufio.Writer, colorMode string) {
+	if colorMode != TerminalColorNone {
+		_, _ = w.WriteString("\x1b[0m")
+	}
+	_ = w.WriteByte('\n')
+}
+
+// ansi256 returns the closest color within the ANSI 256 color cube or grayscale ramp.
+func ansi256(c color.RGBA) int {
+	if c.R == c.G && c.G == c.B {
+		if c.R < 8 {
+			return 16
+		} else if c.R > 248 {
+			return 231
+		}
+		return 232 + int(math.Round((float64(c.R)-8)/247*24))
+	}
+	toCube := func(v uint8) int {
+		return int(math.Round(float64(v) / 255 * 5))
+	}
+	return 16 + 36*toCube(c.R) + 6*toCube(c.G) + toCube(c.B)
+}
+
+// writeSixel encodes the image as Sixel graphics using a palette of its most frequent colors.
+func writeSixel(w *bufio.Writer, i image.Image) {
+	img := palettedImage(i)
+	bounds := img.Bounds()
+	_, _ = fmt.Fprintf(w, "\x1bPq\"1;1;%d;%d", bounds.Dx(), bounds.Dy())
+	for index, c := range img.Palette {
+		r, g, b, _ := c.RGBA()
+		_, _ = fmt.Fprintf(w, "#%d;2;%d;%d;%d", index,
+			int(math.Round(float64(r)/0xffff*100)),
+			int(math.Round(float64(g)/0xffff*100)),
+			int(math.Round(float64(b)/0xffff*100)))
+	}
+
+	sixels := make([]byte, bounds.Dx())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += 6 {
+		// each band of six rows is drawn once per color used within it
+		used := make([]bool, len(img.Palette))
+		for dy := 0; dy < 6 && y+dy < bounds.Max.Y; dy++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				used[img.ColorIndexAt(x, y+dy)] = true
+			}
+		}
+		first := true
+		for index, isUsed := range used {
+			if !isUsed {
+				continue
+			}
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				var bits byte
+				for dy := 0; dy < 6 && y+dy < bounds.Max.Y; dy++ {
+					if int(img.ColorIndexAt(x, y+dy)) == index {
+						bits |= 1 << uint(dy)
+					}
+				}
+				sixels[x-bounds.Min.X] = '?' + bits
+			}
+			if !first {
+				_ = w.WriteByte('$')
+			}
+			first = false
+			_, _ = w.WriteString("#" + strconv.Itoa(index))
+			writeSixelRuns(w, sixels)
+		}
+		_ = w.WriteByte('-')
+	}
+	_, _ = w.WriteString("\x1b\\")
+}
+
+// writeSixelRuns writes the sixel characters using run length encoding for repeated characters.
+func writeSixelRuns(w *bufio.Writer, sixels []byte) {
+	for start := 0; start < len(sixels); {
+		end := start + 1
+		for end < len(sixels) && sixels[end] == sixels[start] {
+			end++
+		}
+		if count := end - start; count > 3 {
+			_, _ = w.WriteString("!" + strconv.Itoa(count))
+			_ = w.WriteByte(sixels[start])
+		} else {
+			for j := start; j < end; j++ {
+				_ = w.WriteByte(sixels[j])
+			}
+		}
+		start = end
+	}
+}
@@ -0,0 +1,79 @@
+package chartdraw
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-analyze/charts/chartdraw/drawing"
+)
+
+func renderTerminalTestRect(t *testing.T, opt TerminalOptions) string {
+	t.Helper()
+
+	r := Terminal(opt)(8, 8)
+	r.SetFillColor(drawing.ColorWhite)
+	r.MoveTo(0, 0)
+	r.LineTo(8, 0)
+	r.LineTo(8, 8)
+	r.LineTo(0, 8)
+	r.Close()
+	r.Fill()
+	r.SetFillColor(drawing.ColorBlack)
+	r.MoveTo(0, 0)
+	r.LineTo(4, 0)
+	r.LineTo(4, 4)
+	r.LineTo(0, 4)
+	r.Close()
+	r.Fill()
+
+	var buf bytes.Buffer
+	require.NoError(t, r.Save(&buf))
+	return buf.String()
+}
+
+func TestTerminalRendererBraille(t *testing.T) {
+	t.Parallel()
+
+	out := renderTerminalTestRect(t, TerminalOptions{Columns: 4, Color: TerminalColorNone})
+	assert.Equal(t, "⣿⣿  \n    \n", out)
+
+	out = renderTerminalTestRect(t, TerminalOptions{Columns: 4})
+	assert.Equal(t, "\x1b[38;2;0;0;0m⣿\x1b[38;2;0;0;0m⣿  \x1b[0m\n    \x1b[0m\n", out)
+}
+
+func TestTerminalRendererBlock(t *testing.T) {
+	t.Parallel()
+
+	out := renderTerminalTestRect(t, TerminalOptions{Mode: TerminalBlock, Columns: 4, Color: TerminalColorNone})
+	assert.Equal(t, "██  \n    \n", out)
+
+	out = renderTerminalTestRect(t, TerminalOptions{Mode: TerminalBlock, Columns: 2, Color: TerminalColor256})
+	assert.Equal(t, "\x1b[38;5;16m\x1b[48;5;231m▀\x1b[38;5;231m\x1b[48;5;231m▀\x1b[0m\n", out)
+}
+
+func TestTerminalRendererSixel(t *testing.T) {
+	t.Parallel()
+
+	out := renderTerminalTestRect(t, TerminalOptions{Mode: TerminalSixel})
+	assert.True(t, strings.HasPrefix(out, "\x1bPq\"1;1;8;8"))
+	assert.True(t, strings.HasSuffix(out, "\x1b\\"))
+	// white is the most frequent color, so it is the first palette entry
+	assert.Contains(t, out, "#0;2;100;100;100#1;2;0;0;0")
+	// the top band has black in the first four rows of the first four columns, and white elsewhere
+	assert.Contains(t, out, "#0!4o!4~$#1!4N!4?-#0!8B-")
+	assert.Equal(t, 2, strings.Count(out, "-"))
+}
+
+func TestANSI256(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 16, ansi256(color.RGBA{A: 255}))
+	assert.Equal(t, 231, ansi256(color.RGBA{R: 255, G: 255, B: 255, A: 255}))
+	assert.Equal(t, 196, ansi256(color.RGBA{R: 255, A: 255}))
+	assert.Equal(t, 244, ansi256(color.RGBA{R: 128, G: 128, B: 128, A: 255}))
+}
@@ -2,7 +2,12 @@ package chartdraw
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/xml"
 	"fmt"
+	"hash/fnv"
+	"image"
+	"image/png"
 	"io"
 	"math"
 	"strconv"
@@ -20,7 +25,7 @@ import (
 func SVG(width, height int) Renderer {
 	buffer := bytes.NewBuffer([]byte{})
 	canvas := newCanvas(buffer)
-	canvas.Start(width, height)
+	canvas.width, canvas.height = width, height
 	return &vectorRenderer{
 		b: buffer,
 		c: canvas,
@@ -37,7 +42,26 @@ func SVGWithCSS(css string, nonce string) func(width, height int) Renderer {
 		canvas := newCanvas(buffer)
 		canvas.css = css
 		canvas.nonce = nonce
-		canvas.Start(width, height)
+		canvas.width, canvas.height = width, height
+		return &vectorRenderer{
+			b: buffer,
+			c: canvas,
+			s: &Style{},
+			p: []string{},
+		}
+	}
+}
+
+// SVGWithScript returns a new SVG renderer with attached custom CSS and JavaScript. The script is written at the end
+// of the document so that all chart elements exist when it runs. The optional nonce argument sets a CSP nonce on both.
+func SVGWithScript(css, script, nonce string) func(width, height int) Renderer {
+	return func(width, height int) Renderer {
+		buffer := bytes.NewBuffer([]byte{})
+		canvas := newCanvas(buffer)
+		canvas.css = css
+		canvas.script = script
+		canvas.nonce = nonce
+		canvas.width, canvas.height = width, height
 		return &vectorRenderer{
 			b: buffer,
 			c: canvas,
@@ -61,6 +85,8 @@ type vectorRenderer struct {
 	s         *Style
 	p         []string
 	faceCache map[fontFaceKey]font.Face
+	// ended is set once the closing tag has been written, so the document can be saved multiple times.
+	ended bool
 }
 
 // measureStringWithFallback is a custom MeasureString that provides estimated sizes for missing glyphs.
@@ -202,6 +228,11 @@ func (vr *vectorRenderer) SetFillColor(c drawing.Color) {
 	vr.s.FillColor = c
 }
 
+// SetFillGradient sets the gradient used to fill subsequent paths, taking precedence over the fill color.
+func (vr *vectorRenderer) SetFillGradient(gradient drawing.LinearGradient) {
+	vr.s.FillGradient = gradient
+}
+
 // SetStrokeWidth sets the width of drawn lines (for Renderer interface).
 func (vr *vectorRenderer) SetStrokeWidth(width float64) {
 	vr.s.StrokeWidth = width
@@ -227,6 +258,51 @@ func (vr *vectorRenderer) QuadCurveTo(cx, cy, x, y int) {
 	vr.p = append(vr.p, "Q"+strconv.Itoa(cx)+","+strconv.Itoa(cy)+" "+strconv.Itoa(x)+","+strconv.Itoa(y))
 }
 
+// MoveToF starts a new path at the specified coordinates (for FloatRenderer interface).
+func (vr *vectorRenderer) MoveToF(x, y float64) {
+	vr.p = append(vr.p, "M "+formatSVGFloat(x)+" "+formatSVGFloat(y))
+}
+
+// LineToF adds a line segment to the current path (for FloatRenderer interface).
+func (vr *vectorRenderer) LineToF(x, y float64) {
+	vr.p = append(vr.p, "L "+formatSVGFloat(x)+" "+formatSVGFloat(y))
+}
+
+// QuadCurveToF draws a quad curve (for FloatRenderer interface).
+func (vr *vectorRenderer) QuadCurveToF(cx, cy, x, y float64) {
+	vr.p = append(vr.p, "Q"+formatSVGFloat(cx)+","+formatSVGFloat(cy)+" "+formatSVGFloat(x)+","+formatSVGFloat(y))
+}
+
+// ArcToF draws an arc without rounding the end points (for FloatRenderer interface).
+func (vr *vectorRenderer) ArcToF(cx, cy, rx, ry, startAngle, delta float64) {
+	startAngle = RadianAdd(startAngle, _pi2)
+	endAngle := RadianAdd(startAngle, delta)
+
+	startx := cx + rx*math.Sin(startAngle)
+	starty := cy - ry*math.Cos(startAngle)
+	if len(vr.p) > 0 {
+		vr.LineToF(startx, starty)
+	} else {
+		vr.MoveToF(startx, starty)
+	}
+
+	endx := cx + rx*math.Sin(endAngle)
+	endy := cy - ry*math.Cos(endAngle)
+
+	largeArcFlag := "0"
+	if delta > _pi {
+		largeArcFlag = "1"
+	}
+	vr.p = append(vr.p, "A "+formatSVGFloat(rx)+" "+formatSVGFloat(ry)+" "+
+		strconv.FormatFloat(RadiansToDegrees(delta), 'f', 2, 64)+" "+largeArcFlag+" 1 "+
+		formatSVGFloat(endx)+" "+formatSVGFloat(endy))
+}
+
+// formatSVGFloat formats a coordinate with up to two decimal places, whole numbers are formatted as integers.
+func formatSVGFloat(v float64) string {
+	return trimFloatZeros(strconv.FormatFloat(v, 'f', 2, 64))
+}
+
 func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
 	startAngle = RadianAdd(startAngle, _pi2)
 	endAngle := RadianAdd(startAngle, delta)
@@ -282,7 +358,21 @@ func (vr *vectorRenderer) drawPath() {
 
 // Circle draws a circle with the current style (for PathBuilder interface).
 func (vr *vectorRenderer) Circle(radius float64, x, y int) {
-	vr.c.Circle(x, y, int(math.Round(radius)), vr.s.GetFillAndStrokeOptions())
+	vr.c.Circle(float64(x), float64(y), math.Round(radius), vr.s.GetFillAndStrokeOptions())
+}
+
+// CircleF draws a circle with the current style without rounding the position or radius (for FloatRenderer interface).
+func (vr *vectorRenderer) CircleF(radius, x, y float64) {
+	vr.c.Circle(x, y, radius, vr.s.GetFillAndStrokeOptions())
+}
+
+// DrawImage embeds the image as a base64 encoded png (for DrawImageRenderer interface).
+func (vr *vectorRenderer) DrawImage(img image.Image, box Box, opacity float64, _ drawing.ImageFilter) {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, img); err != nil {
+		return // images with invalid bounds can't be drawn
+	}
+	vr.c.Image(box, opacity, encoded.Bytes())
 }
 
 // SetFont specifies the font used for text operations (for Renderer interface).
@@ -302,9 +392,19 @@ func (vr *vectorRenderer) SetFontSize(size float64) {
 
 // Text draws a text blob (for Renderer interface).
 func (vr *vectorRenderer) Text(body string, x, y int) {
+	vr.c.Text(float64(x), float64(y), body, vr.s.GetTextOptions())
+}
+
+// TextF draws a text blob without rounding the position (for FloatRenderer interface).
+func (vr *vectorRenderer) TextF(body string, x, y float64) {
 	vr.c.Text(x, y, body, vr.s.GetTextOptions())
 }
 
+// TextRuns draws the styled runs as spans of a single text element (for TextRunsRenderer interface).
+func (vr *vectorRenderer) TextRuns(runs []TextRun, x, y int) {
+	vr.c.TextRuns(float64(x), float64(y), runs, vr.s.GetTextOptions())
+}
+
 // MeasureText uses the truetype font drawer to measure the width of text.
 func (vr *vectorRenderer) MeasureText(body string) (box Box) {
 	textFont := vr.s.GetFont()
@@ -337,13 +437,22 @@ func (vr *vectorRenderer) ClearTextRotation() {
 
 // Save saves the renderer's contents to a writer.
 func (vr *vectorRenderer) Save(w io.Writer) error {
-	vr.c.End()
+	if !vr.ended {
+		vr.c.End()
+		vr.ended = true
+	}
 	for _, face := range vr.faceCache {
 		if err := face.Close(); err != nil {
 			return err
 		}
 	}
 	vr.faceCache = nil
+	// the header is written last so that it can include the document info set while drawing
+	var header bytes.Buffer
+	vr.c.writeHeader(&header)
+	if _, err := w.Write(header.Bytes()); err != nil {
+		return err
+	}
 	_, err := w.Write(vr.b.Bytes())
 	return err
 }
@@ -365,21 +474,114 @@ type canvas struct {
 	height    int
 	css       string
 	nonce     string
+	script    string
+	info      DocumentInfo
+	gradients map[string]bool // ids of gradient definitions already written
 }
 
 func (c *canvas) Start(width, height int) {
 	c.width = width
 	c.height = height
-	_, _ = c.w.Write([]byte(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 ` + strconv.Itoa(c.width) + ` ` + strconv.Itoa(c.height) + `">`))
+	c.writeHeader(c.w)
+}
+
+// writeHeader writes the opening svg element, followed by the accessible title, description, custom CSS and data
+// table if set.
+func (c *canvas) writeHeader(w io.Writer) {
+	bb := bytes.NewBuffer(make([]byte, 0, 200))
+	bb.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 ` + strconv.Itoa(c.width) + ` ` + strconv.Itoa(c.height) + `"`)
+	info := c.info
+	var id string
+	if !info.IsZero() {
+		id = documentID(info)
+		bb.WriteString(` role="graphics-document document"`)
+		if info.Title != "" {
+			bb.WriteString(` aria-labelledby="` + id + `-title"`)
+		}
+		if info.Description != "" {
+			bb.WriteString(` aria-describedby="` + id + `-desc"`)
+		}
+	}
+	bb.WriteRune('>')
+	if info.Title != "" {
+		bb.WriteString(`<title id="` + id + `-title">`)
+		_ = xml.EscapeText(bb, []byte(info.Title))
+		bb.WriteString(`</title>`)
+	}
+	if info.Description != "" {
+		bb.WriteString(`<desc id="` + id + `-desc">`)
+		_ = xml.EscapeText(bb, []byte(info.Description))
+		bb.WriteString(`</desc>`)
+	}
 	if c.css != "" {
-		_, _ = c.w.Write([]byte(`<style type="text/css"`))
+		bb.WriteString(`<style type="text/css"`)
 		if c.nonce != "" {
 			// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Security-Policy
-			_, _ = c.w.Write([]byte(` nonce="` + c.nonce + `"`))
+			bb.WriteString(` nonce="` + c.nonce + `"`)
 		}
 		// To avoid compatibility issues between XML and CSS (f.e. with child selectors) we should encapsulate the CSS with CDATA.
-		_, _ = c.w.Write([]byte(`><![CDATA[` + c.css + `]]></style>`))
+		bb.WriteString(`><![CDATA[` + c.css + `]]></style>`)
 	}
+	if info.DataTable != nil {
+		writeDataTable(bb, info.Title, *info.DataTable)
+	}
+	_, _ = w.Write(bb.Bytes())
+}
+
+// writeDataTable writes the table as XHTML within a foreignObject which is fully clipped, so it is not drawn but
+// remains in the accessibility tree for screen readers. Viewers without foreignObject support omit the table.
+func writeDataTable(bb *bytes.Buffer, caption string, table DataTable) {
+	bb.WriteString(`<foreignObject x="0" y="0" width="1" height="1" clip-path="inset(50%)" style="overflow:hidden"`)
+	if caption != "" {
+		bb.WriteString(` aria-label="`)
+		_ = xml.EscapeText(bb, []byte(caption))
+		bb.WriteString(`"`)
+	}
+	bb.WriteRune('>')
+	bb.WriteString(`<table xmlns="http://www.w3.org/1999/xhtml">`)
+	if caption != "" {
+		bb.WriteString(`<caption>`)
+		_ = xml.EscapeText(bb, []byte(caption))
+		bb.WriteString(`</caption>`)
+	}
+	if len(table.Header) > 0 {
+		bb.WriteString(`<thead><tr>`)
+		for _, h := range table.Header {
+			bb.WriteString(`<th scope="col">`)
+			_ = xml.EscapeText(bb, []byte(h))
+			bb.WriteString(`</th>`)
+		}
+		bb.WriteString(`</tr></thead>`)
+	}
+	bb.WriteString(`<tbody>`)
+	for _, row := range table.Rows {
+		bb.WriteString(`<tr>`)
+		for i, cell := range row {
+			if i == 0 {
+				bb.WriteString(`<th scope="row">`)
+			} else {
+				bb.WriteString(`<td>`)
+			}
+			_ = xml.EscapeText(bb, []byte(cell))
+			if i == 0 {
+				bb.WriteString(`</th>`)
+			} else {
+				bb.WriteString(`</td>`)
+			}
+		}
+		bb.WriteString(`</tr>`)
+	}
+	bb.WriteString(`</tbody></table></foreignObject>`)
+}
+
+// documentID returns a stable id prefix for the document title and description elements, derived from their
+// content so that multiple charts can be inlined into the same page.
+func documentID(info DocumentInfo) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(info.Title))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(info.Description))
+	return fmt.Sprintf("chart-%08x", h.Sum32())
 }
 
 func (c *canvas) Path(parts []string, style Style) {
@@ -389,6 +591,9 @@ func (c *canvas) Path(parts []string, style Style) {
 	bb := c.bb
 	defer c.bb.Reset()
 
+	if !style.FillGradient.IsZero() && style.ClassName == "" {
+		c.writeGradient(bb, style.FillGradient)
+	}
 	bb.WriteString(`<path`)
 	if len(style.StrokeDashArray) > 0 {
 		bb.WriteString(" stroke-dasharray=\"")
@@ -414,7 +619,7 @@ func (c *canvas) Path(parts []string, style Style) {
 	_, _ = c.w.Write(bb.Bytes())
 }
 
-func (c *canvas) Text(x, y int, body string, style Style) {
+func (c *canvas) Text(x, y float64, body string, style Style) {
 	if body == "" {
 		return
 	}
@@ -422,13 +627,14 @@ func (c *canvas) Text(x, y int, body string, style Style) {
 	defer c.bb.Reset()
 
 	bb.WriteString(`<text x="`)
-	bb.WriteString(strconv.Itoa(x))
+	bb.WriteString(formatSVGFloat(x))
 	bb.WriteString(`" y="`)
-	bb.WriteString(strconv.Itoa(y))
+	bb.WriteString(formatSVGFloat(y))
 	bb.WriteString(`" `)
 	styleAsSVG(bb, style, c.dpi, true)
 	if c.textTheta != nil {
-		_, _ = fmt.Fprintf(bb, ` transform="rotate(%0.2f,%d,%d)"`, RadiansToDegrees(*c.textTheta), x, y)
+		_, _ = fmt.Fprintf(bb, ` transform="rotate(%0.2f,%s,%s)"`, RadiansToDegrees(*c.textTheta),
+			formatSVGFloat(x), formatSVGFloat(y))
 	}
 	bb.WriteRune('>')
 	bb.WriteString(body)
@@ -437,16 +643,61 @@ func (c *canvas) Text(x, y int, body string, style Style) {
 	_, _ = c.w.Write(bb.Bytes())
 }
 
-func (c *canvas) Circle(x, y, r int, style Style) {
+// TextRuns writes a text element with a tspan for each run, positioned relative to the text position so the
+// element transform rotates the runs together.
+func (c *canvas) TextRuns(x, y float64, runs []TextRun, style Style) {
+	if len(runs) == 0 {
+		return
+	}
 	bb := c.bb
 	defer c.bb.Reset()
 
+	bb.WriteString(`<text x="`)
+	bb.WriteString(formatSVGFloat(x))
+	bb.WriteString(`" y="`)
+	bb.WriteString(formatSVGFloat(y))
+	bb.WriteRune('"')
+	if c.textTheta != nil {
+		_, _ = fmt.Fprintf(bb, ` transform="rotate(%0.2f,%s,%s)"`, RadiansToDegrees(*c.textTheta),
+			formatSVGFloat(x), formatSVGFloat(y))
+	}
+	bb.WriteRune('>')
+	for _, run := range runs {
+		if run.Text == "" {
+			continue
+		}
+		runStyle := style
+		runStyle.Font = run.Font
+		runStyle.FontSize = run.FontSize
+		runStyle.FontColor = run.FontColor
+		bb.WriteString(`<tspan x="`)
+		bb.WriteString(formatSVGFloat(x + run.DX))
+		bb.WriteString(`" y="`)
+		bb.WriteString(formatSVGFloat(y + run.DY))
+		bb.WriteString(`" `)
+		styleAsSVG(bb, runStyle, c.dpi, true)
+		bb.WriteRune('>')
+		_ = xml.EscapeText(bb, []byte(run.Text))
+		bb.WriteString("</tspan>")
+	}
+	bb.WriteString("</text>")
+
+	_, _ = c.w.Write(bb.Bytes())
+}
+
+func (c *canvas) Circle(x, y, r float64, style Style) {
+	bb := c.bb
+	defer c.bb.Reset()
+
+	if !style.FillGradient.IsZero() && style.ClassName == "" {
+		c.writeGradient(bb, style.FillGradient)
+	}
 	bb.WriteString(`<circle cx="`)
-	bb.WriteString(strconv.Itoa(x))
+	bb.WriteString(formatSVGFloat(x))
 	bb.WriteString(`" cy="`)
-	bb.WriteString(strconv.Itoa(y))
+	bb.WriteString(formatSVGFloat(y))
 	bb.WriteString(`" r="`)
-	bb.WriteString(strconv.Itoa(r))
+	bb.WriteString(formatSVGFloat(r))
 	bb.WriteString(`" `)
 	styleAsSVG(bb, style, c.dpi, true)
 	bb.WriteString(`/>`)
@@ -454,7 +705,135 @@ func (c *canvas) Circle(x, y, r int, style Style) {
 	_, _ = c.w.Write(bb.Bytes())
 }
 
+// Image writes an image element with the png data inlined as a data URI.
+func (c *canvas) Image(box Box, opacity float64, pngData []byte) {
+	bb := c.bb
+	defer c.bb.Reset()
+
+	bb.WriteString(`<image x="`)
+	bb.WriteString(strconv.Itoa(box.Left))
+	bb.WriteString(`" y="`)
+	bb.WriteString(strconv.Itoa(box.Top))
+	bb.WriteString(`" width="`)
+	bb.WriteString(strconv.Itoa(box.Width()))
+	bb.WriteString(`" height="`)
+	bb.WriteString(strconv.Itoa(box.Height()))
+	bb.WriteString(`" preserveAspectRatio="none"`)
+	if opacity < 1 {
+		bb.WriteString(` opacity="`)
+		bb.WriteString(formatSVGFloat(math.Max(opacity, 0)))
+		bb.WriteString(`"`)
+	}
+	bb.WriteString(` xlink:href="data:image/png;base64,`)
+	bb.WriteString(base64.StdEncoding.EncodeToString(pngData))
+	bb.WriteString(`"/>`)
+
+	_, _ = c.w.Write(bb.Bytes())
+}
+
+// writeGradient writes the definition for the gradient if it has not already been defined in the document.
+func (c *canvas) writeGradient(bb *bytes.Buffer, g drawing.LinearGradient) {
+	id := gradientID(g)
+	if c.gradients[id] {
+		return
+	} else if c.gradients == nil {
+		c.gradients = make(map[string]bool)
+	}
+	c.gradients[id] = true
+
+	bb.WriteString(`<defs><linearGradient id="`)
+	bb.WriteString(id)
+	bb.WriteString(`" x1="`)
+	bb.WriteString(strconv.FormatFloat(g.X1, 'f', -1, 64))
+	bb.WriteString(`" y1="`)
+	bb.WriteString(strconv.FormatFloat(g.Y1, 'f', -1, 64))
+	bb.WriteString(`" x2="`)
+	bb.WriteString(strconv.FormatFloat(g.X2, 'f', -1, 64))
+	bb.WriteString(`" y2="`)
+	bb.WriteString(strconv.FormatFloat(g.Y2, 'f', -1, 64))
+	bb.WriteString(`">`)
+	for _, stop := range g.Stops {
+		bb.WriteString(`<stop offset="`)
+		bb.WriteString(strconv.FormatFloat(stop.Offset, 'f', -1, 64))
+		bb.WriteString(`" stop-color="`)
+		bb.WriteString(stop.Color.WithAlpha(255).String())
+		bb.WriteString(`"`)
+		if stop.Color.A != 255 {
+			bb.WriteString(` stop-opacity="`)
+			bb.WriteString(strconv.FormatFloat(math.Round(float64(stop.Color.A)/2.55)/100, 'f', -1, 64))
+			bb.WriteString(`"`)
+		}
+		bb.WriteString(`/>`)
+	}
+	bb.WriteString(`</linearGradient></defs>`)
+}
+
+// gradientID returns a stable element id derived from the gradient definition.
+func gradientID(g drawing.LinearGradient) string {
+	h := fnv.New32a()
+	_, _ = fmt.Fprintf(h, "%v,%v,%v,%v", g.X1, g.Y1, g.X2, g.Y2)
+	for _, stop := range g.Stops {
+		_, _ = fmt.Fprintf(h, ";%v,%v", stop.Offset, stop.Color)
+	}
+	return "gradient-" + strconv.FormatUint(uint64(h.Sum32()), 16)
+}
+
+// SetDocumentInfo sets the title, description and data table written at the start of the document (for
+// DocumentRenderer interface).
+func (vr *vectorRenderer) SetDocumentInfo(info DocumentInfo) {
+	vr.c.info = info
+}
+
+// StartGroup writes the opening tag of a group element, with the title as the first child (for GroupRenderer
+// interface).
+func (vr *vectorRenderer) StartGroup(g Group) {
+	vr.c.StartGroup(g)
+}
+
+// EndGroup closes the current group element (for GroupRenderer interface).
+func (vr *vectorRenderer) EndGroup() {
+	vr.c.EndGroup()
+}
+
+func (c *canvas) StartGroup(g Group) {
+	bb := c.bb
+	defer c.bb.Reset()
+
+	bb.WriteString(`<g`)
+	if g.ClassName != "" {
+		bb.WriteString(` class="`)
+		_ = xml.EscapeText(bb, []byte(g.ClassName))
+		bb.WriteRune('"')
+	}
+	for _, attr := range g.Attributes {
+		bb.WriteRune(' ')
+		bb.WriteString(attr.Name)
+		bb.WriteString(`="`)
+		_ = xml.EscapeText(bb, []byte(attr.Value))
+		bb.WriteRune('"')
+	}
+	bb.WriteRune('>')
+	if g.Title != "" {
+		bb.WriteString(`<title>`)
+		_ = xml.EscapeText(bb, []byte(g.Title))
+		bb.WriteString(`</title>`)
+	}
+
+	_, _ = c.w.Write(bb.Bytes())
+}
+
+func (c *canvas) EndGroup() {
+	_, _ = c.w.Write([]byte("</g>"))
+}
+
 func (c *canvas) End() {
+	if c.script != "" {
+		_, _ = c.w.Write([]byte(`<script type="text/javascript"`))
+		if c.nonce != "" {
+			_, _ = c.w.Write([]byte(` nonce="` + c.nonce + `"`))
+		}
+		_, _ = c.w.Write([]byte(`><![CDATA[` + c.script + `]]></script>`))
+	}
 	_, _ = c.w.Write([]byte("</svg>"))
 }
 
@@ -497,6 +876,10 @@ func styleAsSVG(bb *bytes.Buffer, s Style, dpi float64, applyText bool) {
 	if applyText && !fnc.IsTransparent() {
 		bb.WriteString(";fill:")
 		bb.WriteString(fnc.String())
+	} else if !s.FillGradient.IsZero() {
+		bb.WriteString(";fill:url(#")
+		bb.WriteString(gradientID(s.FillGradient))
+		bb.WriteRune(')')
 	} else if !fc.IsTransparent() {
 		bb.WriteString(";fill:")
 		bb.WriteString(fc.String())
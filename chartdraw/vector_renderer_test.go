@@ -3,6 +3,7 @@ package chartdraw
 import (
 	"bytes"
 	"fmt"
+	"image"
 	"math"
 	"strings"
 	"testing"
@@ -35,6 +36,34 @@ func TestVectorRendererPath(t *testing.T) {
 	assert.True(t, strings.HasSuffix(raw, "</svg>"))
 }
 
+func TestVectorRendererFillGradient(t *testing.T) {
+	t.Parallel()
+
+	vr := SVG(100, 100).(GradientRenderer)
+	gradient := drawing.NewVerticalGradient(drawing.ColorRed, drawing.ColorRed.WithAlpha(64))
+	for i := 0; i < 2; i++ {
+		vr.SetFillGradient(gradient)
+		vr.MoveTo(0, 0)
+		vr.LineTo(100, 100)
+		vr.LineTo(0, 100)
+		vr.Close()
+		vr.Fill()
+	}
+	vr.ResetStyle()
+	vr.SetFillColor(drawing.ColorBlue)
+	vr.Circle(5, 50, 50)
+
+	buffer := bytes.NewBuffer([]byte{})
+	require.NoError(t, vr.Save(buffer))
+
+	raw := buffer.String()
+	id := gradientID(gradient)
+	assert.Equal(t, 1, strings.Count(raw, "<linearGradient"))
+	assert.Contains(t, raw, `<defs><linearGradient id="`+id+`" x1="0" y1="0" x2="0" y2="1"><stop offset="0" stop-color="red"/><stop offset="1" stop-color="red" stop-opacity="0.25"/></linearGradient></defs>`)
+	assert.Equal(t, 2, strings.Count(raw, `style="stroke:none;fill:url(#`+id+`)"`))
+	assert.Contains(t, raw, `<circle cx="50" cy="50" r="5" style="stroke:none;fill:blue"/>`)
+}
+
 func TestVectorRendererMeasureText(t *testing.T) {
 	t.Parallel()
 
@@ -167,6 +196,26 @@ func TestCanvasBasicElements(t *testing.T) {
 	assert.True(t, strings.HasSuffix(out, "</svg>"))
 }
 
+func TestCanvasTextRuns(t *testing.T) {
+	t.Parallel()
+
+	b := strings.Builder{}
+	c := &canvas{w: &b, bb: bytes.NewBuffer(make([]byte, 0, 80))}
+	theta := math.Pi / 2
+	c.textTheta = &theta
+	c.TextRuns(10, 20, []TextRun{
+		{Text: "a", Font: GetDefaultFont(), FontSize: 10, FontColor: drawing.ColorBlack},
+		{Text: ""},
+		{Text: "2", Font: GetDefaultFont(), FontSize: 7, FontColor: drawing.ColorRed, DX: 6, DY: -4},
+	}, Style{})
+
+	out := b.String()
+	assert.True(t, strings.HasPrefix(out, `<text x="10" y="20" transform="rotate(90.00,10,20)">`))
+	assert.Equal(t, 2, strings.Count(out, "<tspan"))
+	assert.Contains(t, out, `<tspan x="16" y="16"`)
+	assert.True(t, strings.HasSuffix(out, ">2</tspan></text>"))
+}
+
 func TestFormatFloatMinimized(t *testing.T) {
 	t.Parallel()
 
@@ -194,3 +243,132 @@ func TestVectorRendererTextRotation(t *testing.T) {
 	assert.Contains(t, out, "rotate(90.00")
 	assert.Contains(t, out, "B</text>")
 }
+
+func TestVectorRendererGroup(t *testing.T) {
+	t.Parallel()
+
+	vr := SVG(20, 20).(GroupRenderer)
+	vr.StartGroup(Group{
+		ClassName: "point",
+		Title:     `A & "B" <1>`,
+		Attributes: []GroupAttribute{
+			{Name: "data-series", Value: `A & "B"`},
+			{Name: "data-value", Value: "1"},
+		},
+	})
+	vr.StartGroup(Group{})
+	vr.Circle(2, 10, 10)
+	vr.EndGroup()
+	vr.EndGroup()
+
+	buf := bytes.Buffer{}
+	require.NoError(t, vr.Save(&buf))
+	assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 20 20">`+
+		`<g class="point" data-series="A &amp; &#34;B&#34;" data-value="1"><title>A &amp; &#34;B&#34; &lt;1&gt;</title>`+
+		`<g><circle cx="10" cy="10" r="2" style="stroke:none;fill:none"/></g></g></svg>`, buf.String())
+}
+
+func TestSVGWithScript(t *testing.T) {
+	t.Parallel()
+
+	vr := SVGWithScript(".a{fill:red}", "var a=1;", "abc")(20, 20)
+	vr.Circle(2, 10, 10)
+
+	buf := bytes.Buffer{}
+	require.NoError(t, vr.Save(&buf))
+	out := buf.String()
+	assert.Contains(t, out, `<style type="text/css" nonce="abc"><![CDATA[.a{fill:red}]]></style>`)
+	assert.True(t, strings.HasSuffix(out,
+		`<script type="text/javascript" nonce="abc"><![CDATA[var a=1;]]></script></svg>`))
+}
+
+func TestVectorRendererDocumentInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("title_and_table", func(t *testing.T) {
+		vr := SVGWithCSS(".a{fill:red}", "")(20, 20).(DocumentRenderer)
+		vr.SetDocumentInfo(DocumentInfo{
+			Title:       "Sales & Costs",
+			Description: "Monthly <totals>",
+			DataTable: &DataTable{
+				Header: []string{"Category", "Sales"},
+				Rows:   [][]string{{"Jan", "1"}, {"Feb", "2"}},
+			},
+		})
+		vr.Circle(2, 10, 10)
+
+		buf := bytes.Buffer{}
+		require.NoError(t, vr.Save(&buf))
+		out := buf.String()
+		id := documentID(DocumentInfo{Title: "Sales & Costs", Description: "Monthly <totals>"})
+		assert.True(t, strings.HasPrefix(out, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 20 20" `+
+			`role="graphics-document document" aria-labelledby="`+id+`-title" aria-describedby="`+id+`-desc">`+
+			`<title id="`+id+`-title">Sales &amp; Costs</title><desc id="`+id+`-desc">Monthly &lt;totals&gt;</desc>`+
+			`<style type="text/css"><![CDATA[.a{fill:red}]]></style>`+
+			`<foreignObject x="0" y="0" width="1" height="1" clip-path="inset(50%)" style="overflow:hidden" aria-label="Sales &amp; Costs">`+
+			`<table xmlns="http://www.w3.org/1999/xhtml">`+
+			`<caption>Sales &amp; Costs</caption><thead><tr><th scope="col">Category</th><th scope="col">Sales</th></tr></thead>`+
+			`<tbody><tr><th scope="row">Jan</th><td>1</td></tr><tr><th scope="row">Feb</th><td>2</td></tr></tbody>`+
+			`</table></foreignObject><circle`), out)
+	})
+	t.Run("description_only", func(t *testing.T) {
+		vr := SVG(20, 20).(DocumentRenderer)
+		vr.SetDocumentInfo(DocumentInfo{Description: "desc"})
+
+		buf := bytes.Buffer{}
+		require.NoError(t, vr.Save(&buf))
+		out := buf.String()
+		assert.NotContains(t, out, "aria-labelledby")
+		assert.NotContains(t, out, "<title")
+		assert.Contains(t, out, `role="graphics-document document" aria-describedby="`)
+	})
+	t.Run("unset", func(t *testing.T) {
+		vr := SVG(20, 20)
+
+		buf := bytes.Buffer{}
+		require.NoError(t, vr.Save(&buf))
+		assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 20 20"></svg>`,
+			buf.String())
+	})
+}
+
+func TestVectorRendererFloatCoordinates(t *testing.T) {
+	t.Parallel()
+
+	vr := SVG(100, 100).(FloatRenderer)
+	vr.SetFillColor(drawing.ColorBlack)
+	vr.MoveToF(10.5, 20.25)
+	vr.LineToF(30, 40.126)
+	vr.QuadCurveToF(40.5, 50, 60.75, 70)
+	vr.Fill()
+	vr.CircleF(4.5, 50.25, 50)
+	vr.SetFont(GetDefaultFont())
+	vr.SetFontSize(12)
+	vr.TextF("hi", 10.5, 20.25)
+
+	buffer := bytes.NewBuffer([]byte{})
+	require.NoError(t, vr.Save(buffer))
+
+	raw := buffer.String()
+	assert.Contains(t, raw, "M 10.5 20.25\nL 30 40.13\nQ40.5,50 60.75,70")
+	assert.Contains(t, raw, `<circle cx="50.25" cy="50" r="4.5"`)
+	assert.Contains(t, raw, `<text x="10.5" y="20.25"`)
+}
+
+func TestVectorRendererDrawImage(t *testing.T) {
+	t.Parallel()
+
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, drawing.ColorRed)
+
+	vr := SVG(100, 100).(DrawImageRenderer)
+	vr.DrawImage(img, NewBox(20, 10, 50, 40), 0.5, drawing.NearestFilter)
+	vr.DrawImage(img, NewBox(0, 0, 2, 2), 1, drawing.NearestFilter)
+	buffer := bytes.NewBuffer([]byte{})
+	require.NoError(t, vr.Save(buffer))
+
+	raw := buffer.String()
+	assert.Contains(t, raw, `<image x="10" y="20" width="40" height="20" preserveAspectRatio="none" opacity="0.5" xlink:href="data:image/png;base64,`)
+	assert.Contains(t, raw, `<image x="0" y="0" width="2" height="2" preserveAspectRatio="none" xlink:href="data:image/png;base64,`)
+	assert.Contains(t, raw, `xmlns:xlink="http://www.w3.org/1999/xlink"`)
+}
@@ -79,6 +79,8 @@ type defaultRenderOption struct {
 	axisReversed bool
 	// valueFormatter formats numeric values into labels.
 	valueFormatter ValueFormatter
+	// categoryLabels overrides the axis labels used to name data points in the accessible data table.
+	categoryLabels []string
 }
 
 type defaultRenderResult struct {
@@ -102,41 +104,36 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 	}
 	top := p
 
-	if !opt.backgroundIsFilled {
+	if !opt.backgroundIsFilled && !p.transparentBackground {
 		p.drawBackground(opt.theme.GetBackgroundColor())
 	}
 	if !opt.padding.IsZero() {
 		p = p.Child(PainterPaddingOption(opt.padding))
 	}
 
-	// association between legend and series name
-	if len(opt.legend.SeriesNames) == 0 {
-		opt.legend.SeriesNames = opt.seriesList.names()
-	} else {
-		seriesCount := opt.seriesList.len()
-		setAllSeriesNames := true
-		for index, name := range opt.legend.SeriesNames {
-			if index >= seriesCount {
-				setAllSeriesNames = false
-				break
-			} else if opt.seriesList.getSeriesName(index) == "" {
-				opt.seriesList.setSeriesName(index, name)
+	associateLegendSeriesNames(opt.legend, opt.seriesList)
+	if p.accessible != nil {
+		categoryLabels := opt.categoryLabels
+		if categoryLabels == nil {
+			if opt.axisReversed {
+				if len(opt.yAxis) != 0 {
+					categoryLabels = opt.yAxis[0].Labels
+				}
 			} else {
-				setAllSeriesNames = false
+				categoryLabels = opt.xAxis.Labels
 			}
 		}
-		if !setAllSeriesNames {
-			// if the series had names already set, make sure they are consistent ordered with the legend
-			nameIndexDict := map[string]int{}
-			for index, name := range opt.legend.SeriesNames {
-				nameIndexDict[name] = index
-			}
-			opt.seriesList.sortByNameIndex(nameIndexDict)
+		p.setDocumentInfo(opt.title, opt.seriesList, categoryLabels, opt.valueFormatter)
+	}
+	// symbols and summaries are already set if hidden series were removed from the series list
+	if opt.legend.seriesSymbols == nil {
+		opt.legend.seriesSymbols = make([]Symbol, opt.seriesList.len())
+		for index := range opt.legend.seriesSymbols {
+			opt.legend.seriesSymbols[index] = opt.seriesList.getSeriesSymbol(index)
 		}
 	}
-	opt.legend.seriesSymbols = make([]Symbol, opt.seriesList.len())
-	for index := range opt.legend.seriesSymbols {
-		opt.legend.seriesSymbols[index] = opt.seriesList.getSeriesSymbol(index)
+	if opt.legend.seriesSummaries == nil && len(opt.legend.SummaryValues) != 0 {
+		opt.legend.setSeriesSummaries(opt.seriesList, opt.valueFormatter)
 	}
 
 	const legendTitlePadding = 15
@@ -146,6 +143,9 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 
 	// make a local copy of legend options before we modify position during collision handling
 	legendOpt := *opt.legend
+	if legendOpt.Position == PositionTop || legendOpt.Position == PositionBottom {
+		legendOpt.Offset.Top = legendOpt.Position
+	}
 
 	// helper to check if legend can be repositioned to avoid title collision
 	// repositioning is allowed when no explicit numeric offset is set
@@ -186,6 +186,12 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 		return nil, err
 	}
 
+	// legends beside the plot start below a title at the top
+	if legendOpt.isSidePosition() && legendOpt.Offset.Top == "" &&
+		!titleBox.IsZero() && titleBox.Bottom < p.Height()/2 {
+		legendOpt.Offset.Top = strconv.Itoa(titleBox.Bottom + legendTitlePadding)
+		legendPainter = newLegendPainter(p, legendOpt)
+	}
 	// check for collision and reposition if needed
 	// skip if both-at-bottom was handled via canvas adjustment
 	if !adjustedForBottom &&
@@ -203,10 +209,19 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 
 	// reserve space for the legend if not in overlay mode
 	// - horizontal legends reserve space (top or bottom)
-	// - vertical legends always overlay from the side
+	// - legends positioned to the right or left shrink the plot from that side
+	// - other vertical legends always overlay from the side
 	// - skip for adjustedForBottom since title reservation handles combined space
-	if !legendResult.IsZero() && !flagIs(true, legendOpt.OverlayChart) && !adjustedForBottom &&
-		!flagIs(true, legendOpt.Vertical) {
+	if !legendResult.IsZero() && !flagIs(true, legendOpt.OverlayChart) && legendOpt.isSidePosition() {
+		sidePadBox := Box{IsSet: true}
+		if legendOpt.Position == PositionRight {
+			sidePadBox.Right = p.Width() - legendResult.Left + legendTitlePadding
+		} else {
+			sidePadBox.Left = legendResult.Right + legendTitlePadding
+		}
+		p = p.Child(PainterPaddingOption(sidePadBox))
+	} else if !legendResult.IsZero() && !flagIs(true, legendOpt.OverlayChart) && !adjustedForBottom &&
+		(!flagIs(true, legendOpt.Vertical) || legendOpt.Position != "") {
 		if legendResult.Bottom < p.Height()/2 {
 			// horizontal legend at top - reserve top space
 			legendTopSpacing = chartdraw.MaxInt(legendResult.Height(), legendResult.Bottom) + legendTitlePadding
@@ -252,7 +267,7 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 			nil, nil, nil,
 			opt.xAxis.Labels, opt.xAxis.DataStartIndex,
 			opt.xAxis.LabelCount, opt.xAxis.Unit, opt.xAxis.LabelCountAdjustment,
-			opt.seriesList, 0, opt.stackSeries,
+			opt.seriesList, 0, opt.stackSeries, opt.xAxis.dataExtent,
 			getPreferredValueFormatter(opt.xAxis.ValueFormatter, opt.valueFormatter),
 			opt.xAxis.LabelRotation, opt.xAxis.LabelFontStyle)
 		xAxisOpts = opt.xAxis.toAxisOption(xAxisRange)
@@ -261,7 +276,7 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 			opt.xAxis.Labels, opt.xAxis.DataStartIndex,
 			opt.xAxis.LabelCount, opt.xAxis.LabelCountAdjustment, opt.xAxis.Unit,
 			opt.seriesList,
-			opt.xAxis.LabelRotation, opt.xAxis.LabelFontStyle)
+			opt.xAxis.LabelRotation, opt.xAxis.LabelFontStyle, opt.xAxis.labelOverflow())
 		xAxisOpts = opt.xAxis.toAxisOption(xAxisRange)
 	}
 	if top.Height() < 100 {
@@ -297,7 +312,7 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 				yAxisOption.Labels, 0,
 				yAxisOption.LabelCount, yAxisOption.LabelCountAdjustment, yAxisOption.Unit,
 				opt.seriesList,
-				yAxisOption.LabelRotation, yAxisOption.LabelFontStyle)
+				yAxisOption.LabelRotation, yAxisOption.LabelFontStyle, yAxisOption.labelOverflow())
 		} else { // Standard Y value axis
 			floatFormatter := getPreferredValueFormatter(yAxisOption.ValueFormatter, opt.valueFormatter)
 			valueFormatter := floatFormatter
@@ -310,7 +325,7 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 				yAxisOption.Min, yAxisOption.Max, yAxisOption.RangeValuePaddingScale,
 				yAxisOption.Labels, 0,
 				yAxisOption.LabelCount, yAxisOption.Unit, yAxisOption.LabelCountAdjustment,
-				opt.seriesList, yIndex, opt.stackSeries,
+				opt.seriesList, yIndex, opt.stackSeries, yAxisOption.dataExtent,
 				valueFormatter,
 				yAxisOption.LabelRotation, yAxisOption.LabelFontStyle)
 		}
@@ -380,32 +395,77 @@ func doRender(renderers ...renderer) error {
 	return nil
 }
 
+// associateLegendSeriesNames sets the legend names from the series, or sets unset series names from the legend.
+func associateLegendSeriesNames(legend *LegendOption, sl seriesList) {
+	if len(legend.SeriesNames) == 0 {
+		legend.SeriesNames = sl.names()
+		return
+	}
+	seriesCount := sl.len()
+	setAllSeriesNames := true
+	for index, name := range legend.SeriesNames {
+		if index >= seriesCount {
+			setAllSeriesNames = false
+			break
+		} else if sl.getSeriesName(index) == "" {
+			sl.setSeriesName(index, name)
+		} else {
+			setAllSeriesNames = false
+		}
+	}
+	if !setAllSeriesNames {
+		// if the series had names already set, make sure they are consistent ordered with the legend
+		nameIndexDict := map[string]int{}
+		for index, name := range legend.SeriesNames {
+			nameIndexDict[name] = index
+		}
+		sl.sortByNameIndex(nameIndexDict)
+	}
+}
+
 // Render creates and renders a chart based on the provided options.
 func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	for _, fn := range opts {
 		fn(&opt)
 	}
+	if opt.AutoSize != nil && opt.parent == nil {
+		if err := opt.applyAutoSize(); err != nil {
+			return nil, err
+		}
+	}
 	if err := opt.fillDefault(); err != nil {
 		return nil, err
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		opt.hideLegendSeries()
+	}
 
 	isChild := opt.parent != nil
 	if !isChild {
-		opt.parent = NewPainter(PainterOptions{
-			OutputFormat: opt.OutputFormat,
-			Width:        opt.Width,
-			Height:       opt.Height,
-			Font:         opt.Font,
-		})
+		opt.parent = opt.newPainter()
 	}
 	p := opt.parent
 	if !opt.Box.IsZero() {
 		p = p.Child(PainterBoxOption(opt.Box))
 	}
-	if !isChild {
+	if !isChild && !p.transparentBackground {
 		p.drawBackground(opt.Theme.GetBackgroundColor())
 	}
+	if opt.Watermark != nil && flagIs(true, opt.Watermark.Background) {
+		if err := renderWatermark(p, opt.Watermark); err != nil {
+			return nil, err
+		}
+	}
+	if opt.LabelLayout != nil {
+		// labels from every chart type are collected while rendering, then placed together
+		p = p.Child(painterLabelLayoutOption(*opt.LabelLayout))
+	}
 
+	if (opt.Interactive != nil || opt.Accessible != nil) && opt.OutputFormat == ChartOutputSVG &&
+		len(opt.Legend.SeriesNames) != 0 {
+		// name the series before they are split by chart type, so grouped elements can be matched to the legend
+		associateLegendSeriesNames(&opt.Legend, opt.SeriesList)
+	}
 	seriesList := opt.SeriesList
 	lineSeriesList := filterSeriesList[LineSeriesList](opt.SeriesList, ChartTypeLine)
 	scatterSeriesList := filterSeriesList[ScatterSeriesList](opt.SeriesList, ChartTypeScatter)
@@ -461,10 +521,16 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	if len(horizontalBarSeriesList) != 0 {
 		renderOpt.yAxis[0].Unit = 1
 	}
+	if len(radarSeriesList) != 0 {
+		renderOpt.categoryLabels = radarIndicatorNames(opt.RadarIndicators)
+	}
 
 	renderResult, err := defaultRender(p, renderOpt)
 	if err != nil {
 		return nil, err
+	} else if opt.plotProbe != nil {
+		*opt.plotProbe = renderResult.seriesPainter.box
+		return p, nil
 	}
 
 	handler := renderHandler{}
@@ -544,8 +610,10 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 				StackSeries:     opt.StackSeries,
 				Symbol:          opt.Symbol,
 				LineStrokeWidth: opt.LineStrokeWidth,
+				StepMode:        opt.StepMode,
 				FillArea:        opt.FillArea,
 				FillOpacity:     opt.FillOpacity,
+				FillGradient:    opt.FillGradient,
 			}).renderChart(renderResult)
 			return err
 		})
@@ -619,6 +687,10 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	if err = handler.Do(); err != nil {
 		return nil, err
 	}
+	if opt.LabelLayout != nil {
+		p.labelLayout.render()
+		p.labelLayout = nil // child charts manage their own label layout
+	}
 
 	for _, item := range opt.Children {
 		item.parent = p
@@ -633,5 +705,11 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		}
 	}
 
+	if opt.Watermark != nil && !flagIs(true, opt.Watermark.Background) {
+		if err = renderWatermark(p, opt.Watermark); err != nil {
+			return nil, err
+		}
+	}
+
 	return p, nil
 }
@@ -0,0 +1,219 @@
+package charts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// DashboardOption defines multiple charts arranged on a single image by a layout. The option is serializable, with
+// the charts defined using the ECharts JSON format, allowing dashboards to be configured from JSON (or YAML converted
+// to JSON).
+type DashboardOption struct {
+	// Type specifies the output format: "png" (default), "jpg", or "svg".
+	Type string `json:"type"`
+	// Theme is the name of the theme used for the dashboard background, and for charts without a theme.
+	Theme string `json:"theme"`
+	// FontFamily is the name of the default font for charts without a font.
+	FontFamily string `json:"fontFamily"`
+	// Width is the dashboard width, default is 600.
+	Width int `json:"width"`
+	// Height is the dashboard height, default is 400.
+	Height int `json:"height"`
+	// Padding specifies the space between the dashboard edge and the layout.
+	Padding EChartsPadding `json:"padding"`
+	// BackgroundColor overrides the theme background color.
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+	// Charts are the chart definitions referenced by name from the layout cells.
+	Charts map[string]EChartsOption `json:"charts"`
+	// Layout positions the charts within the dashboard.
+	Layout DashboardLayout `json:"layout"`
+}
+
+// DashboardLayout positions the dashboard charts. Either Grid or Rows must be set, matching Painter.LayoutByGrid
+// and Painter.LayoutByRows respectively. Sizes, gaps, and offsets accept pixels ("20") or percentages ("10%").
+type DashboardLayout struct {
+	// Grid divides the dashboard into equally sized cells, with charts placed on cells spanning one or more of them.
+	Grid *DashboardGrid `json:"grid,omitempty"`
+	// Rows divides the dashboard into rows, each containing a sequence of columns.
+	Rows []DashboardRow `json:"rows,omitempty"`
+	// RowGap is the vertical space inserted between each row of a Rows layout.
+	RowGap string `json:"rowGap,omitempty"`
+	// ColumnGap is the horizontal space inserted between each column of a Rows layout.
+	ColumnGap string `json:"columnGap,omitempty"`
+}
+
+// DashboardGrid is a grid based dashboard layout.
+type DashboardGrid struct {
+	// Cols is the number of grid columns.
+	Cols int `json:"cols"`
+	// Rows is the number of grid rows.
+	Rows int `json:"rows"`
+	// Cells place the charts on the grid.
+	Cells []DashboardCell `json:"cells"`
+}
+
+// DashboardCell places a chart on a grid layout.
+type DashboardCell struct {
+	// Chart is the name of the chart rendered in the cell.
+	Chart string `json:"chart"`
+	// Col is the zero based column of the cell.
+	Col int `json:"col"`
+	// Row is the zero based row of the cell.
+	Row int `json:"row"`
+	// ColSpan is the number of columns the cell spans, default is 1.
+	ColSpan int `json:"colSpan,omitempty"`
+	// RowSpan is the number of rows the cell spans, default is 1.
+	RowSpan int `json:"rowSpan,omitempty"`
+	// OffsetX adjusts the horizontal cell position, percentages are relative to the cell width.
+	OffsetX string `json:"offsetX,omitempty"`
+	// OffsetY adjusts the vertical cell position, percentages are relative to the cell height.
+	OffsetY string `json:"offsetY,omitempty"`
+}
+
+// DashboardRow is a single row of a row based dashboard layout. A row without columns adds empty vertical space.
+type DashboardRow struct {
+	// Height is the row height, rows without a height share the remaining space equally.
+	Height string `json:"height,omitempty"`
+	// Offset adjusts the vertical position of this and all following rows.
+	Offset string `json:"offset,omitempty"`
+	// Columns are the cells of the row, from left to right.
+	Columns []DashboardColumn `json:"columns"`
+}
+
+// DashboardColumn is a single cell of a dashboard row. A column without a chart adds empty horizontal space.
+type DashboardColumn struct {
+	// Chart is the name of the chart rendered in the column.
+	Chart string `json:"chart,omitempty"`
+	// Width is the column width, columns without a width share the remaining row width equally.
+	Width string `json:"width,omitempty"`
+	// OffsetX adjusts the horizontal cell position, percentages are relative to the cell width.
+	OffsetX string `json:"offsetX,omitempty"`
+	// OffsetY adjusts the vertical cell position, percentages are relative to the row height.
+	OffsetY string `json:"offsetY,omitempty"`
+}
+
+// dashboardCellName returns the layout cell name for the chart placed at the given index, names are assigned by
+// position so the same chart definition can be used in multiple cells.
+func dashboardCellName(index int) string {
+	return "cell" + strconv.Itoa(index)
+}
+
+// buildLayout divides the painter by the layout, returning the cell painters and the chart rendered in each cell.
+func (l DashboardLayout) buildLayout(p *Painter) (map[string]*Painter, map[string]string, error) {
+	cellCharts := make(map[string]string)
+	if l.Grid != nil {
+		if len(l.Rows) != 0 {
+			return nil, nil, errors.New("dashboard layout must specify only one of grid or rows")
+		} else if l.RowGap != "" || l.ColumnGap != "" {
+			return nil, nil, errors.New("dashboard layout gaps are only supported for rows layouts")
+		}
+		builder := p.LayoutByGrid(l.Grid.Cols, l.Grid.Rows)
+		for i, cell := range l.Grid.Cells {
+			name := dashboardCellName(i)
+			cellCharts[name] = cell.Chart
+			builder.CellAt(name, cell.Col, cell.Row).
+				Span(getDefaultInt(cell.ColSpan, 1), getDefaultInt(cell.RowSpan, 1)).
+				Offset(cell.OffsetX, cell.OffsetY)
+		}
+		cells, err := builder.Build()
+		return cells, cellCharts, err
+	} else if len(l.Rows) == 0 {
+		return nil, nil, errors.New("dashboard layout must specify a grid or rows")
+	}
+
+	builder := p.LayoutByRows()
+	for rowIndex, row := range l.Rows {
+		if rowIndex > 0 && l.RowGap != "" {
+			builder.RowGap(l.RowGap)
+		}
+		if len(row.Columns) == 0 {
+			builder.RowGap(row.Height)
+			continue
+		}
+		builder.Row().Height(row.Height).RowOffset(row.Offset)
+		for colIndex, col := range row.Columns {
+			if colIndex > 0 && l.ColumnGap != "" {
+				builder.ColGap(l.ColumnGap)
+			}
+			if col.Chart == "" {
+				builder.ColGap(col.Width)
+				continue
+			}
+			name := dashboardCellName(len(cellCharts))
+			cellCharts[name] = col.Chart
+			builder.Col(name, col.Width).Offset(col.OffsetX, col.OffsetY)
+		}
+		builder.Row()
+	}
+	cells, err := builder.Build()
+	return cells, cellCharts, err
+}
+
+// RenderDashboard renders each chart of the dashboard within its layout cell.
+func RenderDashboard(opt DashboardOption) (*Painter, error) {
+	if len(opt.Charts) == 0 {
+		return nil, errors.New("dashboard must define at least one chart")
+	}
+	theme := GetTheme(opt.Theme)
+	if backgroundColor := ParseColor(opt.BackgroundColor); !backgroundColor.IsZero() {
+		theme = theme.WithBackgroundColor(backgroundColor)
+	}
+	outputFormat := opt.Type
+	if outputFormat == "" {
+		outputFormat = ChartOutputPNG
+	}
+	font := GetFont(opt.FontFamily)
+	p := NewPainter(PainterOptions{
+		OutputFormat: outputFormat,
+		Width:        getDefaultInt(opt.Width, defaultChartWidth),
+		Height:       getDefaultInt(opt.Height, defaultChartHeight),
+		Font:         font,
+	})
+	p.drawBackground(theme.GetBackgroundColor())
+
+	cells, cellCharts, err := opt.Layout.buildLayout(p.Child(PainterPaddingOption(opt.Padding.Box)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid dashboard layout: %w", err)
+	}
+	for i := 0; i < len(cellCharts); i++ {
+		chartName := cellCharts[dashboardCellName(i)]
+		if _, ok := opt.Charts[chartName]; !ok {
+			return nil, fmt.Errorf("dashboard layout references undefined chart '%s'", chartName)
+		}
+	}
+	for i := 0; i < len(cellCharts); i++ {
+		name := dashboardCellName(i)
+		chartName := cellCharts[name]
+		chart := opt.Charts[chartName]
+		chartOpt := chart.ToOption()
+		if chart.Theme == "" && chart.BackgroundColor == "" {
+			chartOpt.Theme = theme
+		} else if chartBackground := chartOpt.Theme.GetBackgroundColor(); chartBackground != theme.GetBackgroundColor() {
+			// child charts don't draw a background, fill the cell so the chart theme is shown
+			cells[name].drawBackground(chartBackground)
+		}
+		if chart.FontFamily == "" && chart.Title.TextStyle.FontFamily == "" {
+			chartOpt.Font = font
+		}
+		chartOpt.parent = cells[name]
+		if _, err := Render(chartOpt); err != nil {
+			return nil, fmt.Errorf("error rendering dashboard chart '%s': %w", chartName, err)
+		}
+	}
+	return p, nil
+}
+
+// RenderDashboardJSON renders a dashboard defined as a JSON DashboardOption, returning the encoded image bytes.
+func RenderDashboardJSON(spec string) ([]byte, error) {
+	var opt DashboardOption
+	if err := json.Unmarshal([]byte(spec), &opt); err != nil {
+		return nil, fmt.Errorf("invalid dashboard spec: %w", err)
+	}
+	p, err := RenderDashboard(opt)
+	if err != nil {
+		return nil, err
+	}
+	return p.Bytes()
+}
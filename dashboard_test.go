@@ -0,0 +1,146 @@
+package charts
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDashboardSpec = `{
+	"type": "svg",
+	"width": 800,
+	"height": 600,
+	"charts": {
+		"sales": {
+			"title": {"text": "Sales"},
+			"xAxis": {"data": ["Mon", "Tue", "Wed"]},
+			"series": [{"type": "bar", "data": [120, 200, 150]}]
+		},
+		"share": {
+			"title": {"text": "Share"},
+			"series": [{"type": "pie", "name": "A", "data": [40]}, {"type": "pie", "name": "B", "data": [60]}]
+		}
+	},
+	"layout": {
+		"rowGap": "20",
+		"columnGap": "5%",
+		"rows": [
+			{"height": "40%", "columns": [{"chart": "sales"}, {"chart": "share", "width": "30%"}]},
+			{"columns": [{"chart": "sales"}]}
+		]
+	}
+}`
+
+func TestDashboardLayoutRows(t *testing.T) {
+	t.Parallel()
+
+	var opt DashboardOption
+	require.NoError(t, json.Unmarshal([]byte(testDashboardSpec), &opt))
+	p := NewPainter(PainterOptions{Width: 800, Height: 600, OutputFormat: ChartOutputSVG})
+
+	cells, cellCharts, err := opt.Layout.buildLayout(p)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cell0": "sales", "cell1": "share", "cell2": "sales"}, cellCharts)
+	require.Len(t, cells, 3)
+	assert.Equal(t, NewBox(0, 0, 520, 240), cells["cell0"].box)
+	assert.Equal(t, NewBox(560, 0, 800, 240), cells["cell1"].box)
+	assert.Equal(t, NewBox(0, 260, 800, 600), cells["cell2"].box)
+}
+
+func TestDashboardLayoutGrid(t *testing.T) {
+	t.Parallel()
+
+	layout := DashboardLayout{
+		Grid: &DashboardGrid{
+			Cols: 2,
+			Rows: 2,
+			Cells: []DashboardCell{
+				{Chart: "a", Col: 0, Row: 0, ColSpan: 2},
+				{Chart: "b", Col: 0, Row: 1},
+				{Chart: "a", Col: 1, Row: 1, OffsetX: "10"},
+			},
+		},
+	}
+	p := NewPainter(PainterOptions{Width: 800, Height: 600, OutputFormat: ChartOutputSVG})
+
+	cells, cellCharts, err := layout.buildLayout(p)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cell0": "a", "cell1": "b", "cell2": "a"}, cellCharts)
+	assert.Equal(t, NewBox(0, 0, 800, 300), cells["cell0"].box)
+	assert.Equal(t, NewBox(0, 300, 400, 600), cells["cell1"].box)
+	assert.Equal(t, NewBox(410, 300, 810, 600), cells["cell2"].box)
+}
+
+func TestRenderDashboardJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := RenderDashboardJSON(testDashboardSpec)
+	require.NoError(t, err)
+	svg := string(data)
+	assert.True(t, strings.HasPrefix(svg, "<svg"))
+	assert.Contains(t, svg, `viewBox="0 0 800 600"`)
+	assert.Equal(t, 2, strings.Count(svg, ">Sales</text>"))
+	assert.Equal(t, 1, strings.Count(svg, ">Share</text>"))
+}
+
+func TestRenderDashboardError(t *testing.T) {
+	t.Parallel()
+
+	const charts = `"charts": {"a": {"series": [{"type": "bar", "data": [1, 2]}]}}`
+	tests := []struct {
+		name   string
+		spec   string
+		errMsg string
+	}{
+		{
+			name:   "invalid_json",
+			spec:   `{"charts": [}`,
+			errMsg: "invalid dashboard spec",
+		},
+		{
+			name:   "no_charts",
+			spec:   `{"layout": {"rows": [{"columns": [{"chart": "a"}]}]}}`,
+			errMsg: "dashboard must define at least one chart",
+		},
+		{
+			name:   "no_layout",
+			spec:   `{` + charts + `}`,
+			errMsg: "invalid dashboard layout: dashboard layout must specify a grid or rows",
+		},
+		{
+			name:   "grid_and_rows",
+			spec:   `{` + charts + `, "layout": {"grid": {"cols": 1, "rows": 1}, "rows": [{"columns": [{"chart": "a"}]}]}}`,
+			errMsg: "dashboard layout must specify only one of grid or rows",
+		},
+		{
+			name:   "undefined_chart",
+			spec:   `{` + charts + `, "layout": {"rows": [{"columns": [{"chart": "a"}, {"chart": "b"}]}]}}`,
+			errMsg: "dashboard layout references undefined chart 'b'",
+		},
+		{
+			name:   "invalid_width",
+			spec:   `{` + charts + `, "layout": {"rows": [{"columns": [{"chart": "a", "width": "wide"}]}]}}`,
+			errMsg: "invalid dashboard layout: row 1: invalid width 'wide'",
+		},
+		{
+			name:   "grid_out_of_bounds",
+			spec:   `{` + charts + `, "layout": {"grid": {"cols": 1, "rows": 1, "cells": [{"chart": "a", "col": 1}]}}}`,
+			errMsg: "cell 'cell0' position (1, 0) exceeds grid dimensions (1, 1)",
+		},
+		{
+			name:   "chart_error",
+			spec:   `{"charts": {"a": {"series": [{"type": "bar", "data": [1]}, {"type": "pie", "data": [1]}]}}, "layout": {"grid": {"cols": 1, "rows": 1, "cells": [{"chart": "a"}]}}}`,
+			errMsg: "error rendering dashboard chart 'a': pie can not mix other charts",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RenderDashboardJSON(tt.spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
@@ -65,6 +65,11 @@ func (d *doughnutChart) Render() (Box, error) {
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(d.p.theme)
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		var visible []int
+		visible, opt.Theme = opt.Legend.hideSeries(opt.SeriesList, opt.Theme, opt.ValueFormatter)
+		opt.SeriesList = selectSeriesIndexes(opt.SeriesList, visible)
+	}
 	if opt.Legend.Symbol == "" {
 		opt.Legend.Symbol = SymbolSquare // default symbol for doughnut charts
 	}
@@ -143,7 +148,7 @@ func (d *doughnutChart) renderChart(result *defaultRenderResult) (Box, error) {
 	} else if circleColor.A != 255 {
 		circleColor = circleColor.WithAlpha(255)
 	}
-	seriesPainter.Circle(radiusCenter, cx, cy, circleColor, circleColor, 0.0)
+	seriesPainter.CircleF(radiusCenter, float64(cx), float64(cy), circleColor, circleColor, 0.0)
 
 	if centerLabels {
 		placements := placeCenterLabelsWithCollisionResolution(seriesPainter, opt, cx, cy, radiusCenter, sectors)
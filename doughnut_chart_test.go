@@ -74,14 +74,14 @@ func TestDoughnutChart(t *testing.T) {
 				opt.Legend.Vertical = nil
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 40 43\nL 70 43\nL 70 56\nL 40 56\nL 40 43\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"72\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 151 43\nL 181 43\nL 181 56\nL 151 56\nL 151 43\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"183\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 261 43\nL 291 43\nL 291 56\nL 261 56\nL 261 43\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"293\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 372 43\nL 402 43\nL 402 56\nL 372 56\nL 372 43\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"404\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 40 59\nL 70 59\nL 70 72\nL 40 72\nL 40 59\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"72\" y=\"71\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 226\nL 300 119\nA 107 107 119.89 0 1 393 279\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 392 173\nL 405 165\nM 405 165\nL 420 165\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"423\" y=\"170\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 226\nL 393 279\nA 107 107 84.08 0 1 256 324\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 333 327\nL 337 342\nM 337 342\nL 352 342\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"355\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 226\nL 256 324\nA 107 107 66.35 0 1 193 225\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 210 284\nL 198 292\nM 198 292\nL 183 292\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"82\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 226\nL 193 225\nA 107 107 55.37 0 1 240 137\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 206 176\nL 193 169\nM 193 169\nL 178 169\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"77\" y=\"174\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 226\nL 240 137\nA 107 107 34.32 0 1 300 119\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 269 124\nL 264 110\nM 264 110\nL 249 110\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"157\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"226\" r=\"64\" style=\"stroke:none;fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 40 43\nL 70 43\nL 70 56\nL 40 56\nL 40 43\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"72\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 151 43\nL 181 43\nL 181 56\nL 151 56\nL 151 43\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"183\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 261 43\nL 291 43\nL 291 56\nL 261 56\nL 261 43\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"293\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 372 43\nL 402 43\nL 402 56\nL 372 56\nL 372 43\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"404\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 40 59\nL 70 59\nL 70 72\nL 40 72\nL 40 59\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"72\" y=\"71\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 226\nL 300 118.8\nA 107.2 107.2 119.89 0 1 392.94 279.41\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 392 173\nL 405 165\nM 405 165\nL 420 165\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"423\" y=\"170\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 226\nL 392.94 279.41\nA 107.2 107.2 84.08 0 1 256.46 323.96\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 333 327\nL 337 342\nM 337 342\nL 352 342\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"355\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 226\nL 256.46 323.96\nA 107.2 107.2 66.35 0 1 192.8 225.41\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 210 284\nL 198 292\nM 198 292\nL 183 292\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"82\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 226\nL 192.8 225.41\nA 107.2 107.2 55.37 0 1 239.56 137.46\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 206 176\nL 193 169\nM 193 169\nL 178 169\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"77\" y=\"174\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 226\nL 239.56 137.46\nA 107.2 107.2 34.32 0 1 300 118.8\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 269 124\nL 264 110\nM 264 110\nL 249 110\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"157\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"226\" r=\"64.32\" style=\"stroke:none;fill:white\"/></svg>",
 			pngCRC: 0xc2978e0c,
 		},
 		{
 			name:        "basic_themed",
 			themed:      true,
 			makeOptions: makeBasicDoughnutChartOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"285\" y=\"36\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"287\" y=\"52\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"52\" y=\"35\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 20 49\nL 50 49\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"35\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"52\" y=\"55\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 20 69\nL 50 69\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:none\"/><circle cx=\"35\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:rgb(100,180,210)\"/><text x=\"52\" y=\"75\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 20 89\nL 50 89\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:none\"/><circle cx=\"35\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:rgb(64,160,110)\"/><text x=\"52\" y=\"95\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 20 109\nL 50 109\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:none\"/><circle cx=\"35\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:rgb(154,96,180)\"/><text x=\"52\" y=\"115\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 223\nL 300 98\nA 125 125 119.89 0 1 409 285\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 408 161\nL 421 153\nM 421 153\nL 436 153\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:none\"/><text x=\"439\" y=\"158\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 223\nL 409 285\nA 125 125 84.08 0 1 249 337\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 338 342\nL 343 356\nM 343 356\nL 358 356\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:none\"/><text x=\"361\" y=\"361\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 223\nL 249 337\nA 125 125 66.35 0 1 175 222\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(100,180,210)\"/><path d=\"M 195 290\nL 183 299\nM 183 299\nL 168 299\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:none\"/><text x=\"67\" y=\"304\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 223\nL 175 222\nA 125 125 55.37 0 1 229 120\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 190 165\nL 177 158\nM 177 158\nL 162 158\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><text x=\"61\" y=\"163\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 223\nL 229 120\nA 125 125 34.32 0 1 300 98\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 264 104\nL 259 90\nM 259 90\nL 244 90\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"152\" y=\"95\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"223\" r=\"75\" style=\"stroke:none;fill:rgb(40,40,40)\"/></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"285\" y=\"36\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"287\" y=\"52\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"52\" y=\"35\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 20 49\nL 50 49\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"35\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"52\" y=\"55\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 20 69\nL 50 69\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:none\"/><circle cx=\"35\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:rgb(100,180,210)\"/><text x=\"52\" y=\"75\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 20 89\nL 50 89\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:none\"/><circle cx=\"35\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:rgb(64,160,110)\"/><text x=\"52\" y=\"95\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 20 109\nL 50 109\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:none\"/><circle cx=\"35\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:rgb(154,96,180)\"/><text x=\"52\" y=\"115\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 223\nL 300 97.8\nA 125.2 125.2 119.89 0 1 408.55 285.38\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 408 161\nL 421 153\nM 421 153\nL 436 153\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:none\"/><text x=\"439\" y=\"158\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 223\nL 408.55 285.38\nA 125.2 125.2 84.08 0 1 249.15 337.41\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 338 342\nL 343 356\nM 343 356\nL 358 356\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:none\"/><text x=\"361\" y=\"361\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 223\nL 249.15 337.41\nA 125.2 125.2 66.35 0 1 174.8 222.31\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(100,180,210)\"/><path d=\"M 195 290\nL 183 299\nM 183 299\nL 168 299\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:none\"/><text x=\"67\" y=\"304\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 223\nL 174.8 222.31\nA 125.2 125.2 55.37 0 1 229.41 119.6\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 190 165\nL 177 158\nM 177 158\nL 162 158\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><text x=\"61\" y=\"163\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 223\nL 229.41 119.6\nA 125.2 125.2 34.32 0 1 300 97.8\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 264 104\nL 259 90\nM 259 90\nL 244 90\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"152\" y=\"95\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"223\" r=\"75.12\" style=\"stroke:none;fill:rgb(40,40,40)\"/></svg>",
 			pngCRC:      0xeed84398,
 		},
 		{
@@ -94,7 +94,7 @@ func TestDoughnutChart(t *testing.T) {
 				opt.Title.FontStyle = customFont
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"295\" y=\"46\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"296\" y=\"52\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 40 49\nL 70 49\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"55\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"72\" y=\"55\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 40 69\nL 70 69\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"55\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"72\" y=\"75\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 40 89\nL 70 89\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"55\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"72\" y=\"95\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 40 109\nL 70 109\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"55\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"72\" y=\"115\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 40 129\nL 70 129\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"55\" cy=\"129\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"72\" y=\"135\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 213\nL 300 96\nA 117 117 119.89 0 1 402 271\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 401 155\nL 414 147\nM 414 147\nL 429 147\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"432\" y=\"149\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 213\nL 402 271\nA 117 117 84.08 0 1 252 320\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 336 324\nL 341 338\nM 341 338\nL 356 338\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"359\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 213\nL 252 320\nA 117 117 66.35 0 1 183 212\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 202 276\nL 189 284\nM 189 284\nL 174 284\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"73\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 213\nL 183 212\nA 117 117 55.37 0 1 234 116\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 197 158\nL 184 151\nM 184 151\nL 169 151\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"68\" y=\"156\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 213\nL 234 116\nA 117 117 34.32 0 1 300 96\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 266 102\nL 261 87\nM 261 87\nL 246 87\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"154\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"213\" r=\"70\" style=\"stroke:none;fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"295\" y=\"46\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"296\" y=\"52\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 40 49\nL 70 49\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"55\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"72\" y=\"55\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 40 69\nL 70 69\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"55\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"72\" y=\"75\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 40 89\nL 70 89\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"55\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"72\" y=\"95\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 40 109\nL 70 109\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"55\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"72\" y=\"115\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 40 129\nL 70 129\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"55\" cy=\"129\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"72\" y=\"135\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 213\nL 300 95.8\nA 117.2 117.2 119.89 0 1 401.61 271.4\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 401 155\nL 414 147\nM 414 147\nL 429 147\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"432\" y=\"149\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 213\nL 401.61 271.4\nA 117.2 117.2 84.08 0 1 252.39 320.1\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 336 324\nL 341 338\nM 341 338\nL 356 338\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"359\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 213\nL 252.39 320.1\nA 117.2 117.2 66.35 0 1 182.8 212.36\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 202 276\nL 189 284\nM 189 284\nL 174 284\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"73\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 213\nL 182.8 212.36\nA 117.2 117.2 55.37 0 1 233.92 116.2\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 197 158\nL 184 151\nM 184 151\nL 169 151\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"68\" y=\"156\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 213\nL 233.92 116.2\nA 117.2 117.2 34.32 0 1 300 95.8\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 266 102\nL 261 87\nM 261 87\nL 246 87\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"154\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"213\" r=\"70.32\" style=\"stroke:none;fill:white\"/></svg>",
 			pngCRC: 0x3baaad19,
 		},
 		{
@@ -106,7 +106,7 @@ func TestDoughnutChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 168\nA 32 32 119.89 0 1 328 216\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 355 232\nA 64 64 84.08 0 1 274 258\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 261 288\nA 96 96 66.35 0 1 204 199\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 172 199\nA 128 128 55.37 0 1 228 94\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 210 68\nA 160 160 34.32 0 1 300 40\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"19\" style=\"stroke:none;fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 168\nA 32 32 119.89 0 1 327.74 215.94\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 355.49 231.89\nA 64 64 84.08 0 1 274 258.48\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 261.01 287.72\nA 96 96 66.35 0 1 204 199.47\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 172 199.3\nA 128 128 55.37 0 1 227.83 94.28\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 209.79 67.85\nA 160 160 34.32 0 1 300 40\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"19.2\" style=\"stroke:none;fill:white\"/></svg>",
 			pngCRC: 0x35cb31f8,
 		},
 		{
@@ -119,7 +119,7 @@ func TestDoughnutChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 119.89 0 1 411 264\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 410 136\nL 423 129\nM 423 129\nL 438 129\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"441\" y=\"134\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 200\nL 411 264\nA 128 128 84.08 0 1 248 317\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 339 321\nL 344 335\nM 344 335\nL 359 335\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"362\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 200\nL 248 317\nA 128 128 66.35 0 1 172 199\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 193 269\nL 180 277\nM 180 277\nL 165 277\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"64\" y=\"282\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 200\nL 172 199\nA 128 128 55.37 0 1 228 94\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 187 140\nL 174 133\nM 174 133\nL 159 133\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"58\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 200\nL 228 94\nA 128 128 34.32 0 1 300 72\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 263 78\nL 258 64\nM 258 64\nL 243 64\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"151\" y=\"69\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"200\" r=\"20\" style=\"stroke:none;fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 119.89 0 1 410.98 263.78\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 410 136\nL 423 129\nM 423 129\nL 438 129\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"441\" y=\"134\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 200\nL 410.98 263.78\nA 128 128 84.08 0 1 248.01 316.96\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 339 321\nL 344 335\nM 344 335\nL 359 335\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"362\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 200\nL 248.01 316.96\nA 128 128 66.35 0 1 172 199.3\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 193 269\nL 180 277\nM 180 277\nL 165 277\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"64\" y=\"282\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 200\nL 172 199.3\nA 128 128 55.37 0 1 227.83 94.28\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 187 140\nL 174 133\nM 174 133\nL 159 133\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"58\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 200\nL 227.83 94.28\nA 128 128 34.32 0 1 300 72\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 263 78\nL 258 64\nM 258 64\nL 243 64\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"151\" y=\"69\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"200\" r=\"20\" style=\"stroke:none;fill:white\"/></svg>",
 			pngCRC: 0x7c33df8b,
 		},
 		{
@@ -133,7 +133,7 @@ func TestDoughnutChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 66\nA 134 134 119.89 0 1 417 267\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 416 133\nL 429 126\nM 429 126\nL 444 126\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"447\" y=\"131\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 200\nL 417 267\nA 134 134 84.08 0 1 245 323\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 341 327\nL 346 342\nM 346 342\nL 361 342\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"364\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 200\nL 245 323\nA 134 134 66.35 0 1 166 199\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 188 272\nL 175 281\nM 175 281\nL 160 281\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"59\" y=\"286\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 200\nL 166 199\nA 134 134 55.37 0 1 224 89\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 182 137\nL 169 130\nM 169 130\nL 154 130\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"53\" y=\"135\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 200\nL 224 89\nA 134 134 34.32 0 1 300 66\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 261 72\nL 256 58\nM 256 58\nL 241 58\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"149\" y=\"63\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"200\" r=\"124\" style=\"stroke:none;fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 65.6\nA 134.4 134.4 119.89 0 1 416.53 266.97\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 416 133\nL 429 126\nM 429 126\nL 444 126\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"447\" y=\"131\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 200\nL 416.53 266.97\nA 134.4 134.4 84.08 0 1 245.41 322.81\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 341 327\nL 346 342\nM 346 342\nL 361 342\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"364\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 200\nL 245.41 322.81\nA 134.4 134.4 66.35 0 1 165.6 199.26\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 188 272\nL 175 281\nM 175 281\nL 160 281\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"59\" y=\"286\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 200\nL 165.6 199.26\nA 134.4 134.4 55.37 0 1 224.23 89\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 182 137\nL 169 130\nM 169 130\nL 154 130\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"53\" y=\"135\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 200\nL 224.23 89\nA 134.4 134.4 34.32 0 1 300 65.6\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 261 72\nL 256 58\nM 256 58\nL 241 58\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"149\" y=\"63\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><circle cx=\"300\" cy=\"200\" r=\"124.4\" style=\"stroke:none;fill:white\"/></svg>",
 			pngCRC: 0x88346256,
 		},
 		{
@@ -143,7 +143,7 @@ func TestDoughnutChart(t *testing.T) {
 				opt.SegmentGap = 20.0
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 119.89 0 1 411 264\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 411 264\nA 128 128 84.08 0 1 248 317\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 248 317\nA 128 128 66.35 0 1 172 199\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 172 199\nA 128 128 55.37 0 1 228 94\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 228 94\nA 128 128 34.32 0 1 300 72\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"77\" style=\"stroke:none;fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 119.89 0 1 410.98 263.78\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 410.98 263.78\nA 128 128 84.08 0 1 248.01 316.96\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 248.01 316.96\nA 128 128 66.35 0 1 172 199.3\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 172 199.3\nA 128 128 55.37 0 1 227.83 94.28\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 227.83 94.28\nA 128 128 34.32 0 1 300 72\nL 300 200\nZ\" style=\"stroke-width:20;stroke:white;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"76.8\" style=\"stroke:none;fill:white\"/></svg>",
 			pngCRC: 0xc5e85f35,
 		},
 		{
@@ -155,7 +155,7 @@ func TestDoughnutChart(t *testing.T) {
 				opt.CenterValuesFontStyle.FontColor = ColorNavy
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 119.89 0 1 411 264\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 411 264\nA 128 128 84.08 0 1 248 317\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 248 317\nA 128 128 66.35 0 1 172 199\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 172 199\nA 128 128 55.37 0 1 228 94\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 228 94\nA 128 128 34.32 0 1 300 72\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"77\" style=\"stroke:none;fill:white\"/><text x=\"262\" y=\"215\" style=\"stroke:none;fill:navy;font-size:30.7px;font-family:'Roboto Medium',sans-serif\">3.15k</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 119.89 0 1 410.98 263.78\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 410.98 263.78\nA 128 128 84.08 0 1 248.01 316.96\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 248.01 316.96\nA 128 128 66.35 0 1 172 199.3\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 172 199.3\nA 128 128 55.37 0 1 227.83 94.28\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 227.83 94.28\nA 128 128 34.32 0 1 300 72\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"76.8\" style=\"stroke:none;fill:white\"/><text x=\"262\" y=\"215\" style=\"stroke:none;fill:navy;font-size:30.7px;font-family:'Roboto Medium',sans-serif\">3.15k</text></svg>",
 			pngCRC: 0xff9189f4,
 		},
 		{
@@ -168,7 +168,7 @@ func TestDoughnutChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 40\nA 160 160 119.89 0 1 439 280\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 439 280\nA 160 160 84.08 0 1 235 346\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 235 346\nA 160 160 66.35 0 1 140 199\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 140 199\nA 160 160 55.37 0 1 210 68\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 210 68\nA 160 160 34.32 0 1 300 40\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"96\" style=\"stroke:none;fill:white\"/><path d=\"M 378 146\nL 366 154\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><text x=\"276\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 356 277\nL 350 269\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><text x=\"253\" y=\"269\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 215 244\nL 235 234\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><text x=\"235\" y=\"241\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 213 162\nL 227 168\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><text x=\"227\" y=\"175\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 253 117\nL 255 120\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><text x=\"255\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 40\nA 160 160 119.89 0 1 438.72 279.72\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 438.72 279.72\nA 160 160 84.08 0 1 235.01 346.21\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 235.01 346.21\nA 160 160 66.35 0 1 140 199.12\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 140 199.12\nA 160 160 55.37 0 1 209.79 67.85\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 209.79 67.85\nA 160 160 34.32 0 1 300 40\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"96\" style=\"stroke:none;fill:white\"/><path d=\"M 378 146\nL 366 154\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><text x=\"276\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 356 277\nL 350 269\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><text x=\"253\" y=\"269\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 215 244\nL 235 234\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><text x=\"235\" y=\"241\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 213 162\nL 227 168\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><text x=\"227\" y=\"175\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 253 117\nL 255 120\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><text x=\"255\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
 			pngCRC: 0x5c692a2,
 		},
 		{
@@ -191,7 +191,7 @@ func TestDoughnutChart(t *testing.T) {
 					},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 440 87\nA 180 180 26.62 0 1 476 162\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 369 34\nA 180 180 28.90 0 1 440 87\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 300 20\nA 180 180 22.39 0 1 369 34\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 476 162\nA 180 180 25.56 0 1 475 242\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 475 242\nA 180 180 23.60 0 1 444 308\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 300 200\nL 444 308\nA 180 180 44.13 0 1 328 378\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 300 200\nL 328 378\nA 180 180 90.37 0 1 122 226\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 300 200\nL 122 226\nA 180 180 25.74 0 1 128 147\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 300 200\nL 128 147\nA 180 180 46.84 0 1 221 38\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 300 200\nL 221 38\nA 180 180 25.87 0 1 300 20\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><circle cx=\"300\" cy=\"200\" r=\"108\" style=\"stroke:none;fill:white\"/><path d=\"M 341 101\nL 342 106\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><text x=\"260\" y=\"119\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Cyprus: 6.21%</text><path d=\"M 359 111\nL 354 119\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><text x=\"261\" y=\"132\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Denmark: 8.02%</text><path d=\"M 393 147\nL 378 155\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><text x=\"294\" y=\"162\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Estonia: 7.39%</text><path d=\"M 407 193\nL 386 194\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><text x=\"302\" y=\"201\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Finland: 7.09%</text><path d=\"M 401 237\nL 378 229\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><text x=\"297\" y=\"236\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">France: 6.55%</text><path d=\"M 362 288\nL 352 274\" style=\"stroke-width:2;stroke:rgb(59,162,114);fill:none\"/><text x=\"251\" y=\"274\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Germany: 12.25%</text><path d=\"M 224 276\nL 239 261\" style=\"stroke-width:2;stroke:rgb(252,132,82);fill:none\"/><text x=\"239\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Greece: 25.1%</text><path d=\"M 195 177\nL 214 181\" style=\"stroke-width:2;stroke:rgb(154,96,180);fill:none\"/><text x=\"214\" y=\"188\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Hungary: 7.14%</text><path d=\"M 224 125\nL 232 133\" style=\"stroke-width:2;stroke:rgb(234,124,204);fill:none\"/><text x=\"232\" y=\"146\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ireland: 13.01%</text><path d=\"M 266 98\nL 266 98\" style=\"stroke-width:2;stroke:rgb(123,142,198);fill:none\"/><text x=\"266\" y=\"111\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Italy: 7.18%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 440.45 87.42\nA 180 180 26.62 0 1 476.01 162.29\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 368.55 33.56\nA 180 180 28.90 0 1 440.45 87.42\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 300 20\nA 180 180 22.39 0 1 368.55 33.56\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 476.01 162.29\nA 180 180 25.56 0 1 475.05 241.91\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 475.05 241.91\nA 180 180 23.60 0 1 443.64 308.48\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 300 200\nL 443.64 308.48\nA 180 180 44.13 0 1 327.58 377.87\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 300 200\nL 327.58 377.87\nA 180 180 90.37 0 1 121.95 226.44\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 300 200\nL 121.95 226.44\nA 180 180 25.74 0 1 128.13 146.51\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 300 200\nL 128.13 146.51\nA 180 180 46.84 0 1 221.46 38.04\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 300 200\nL 221.46 38.04\nA 180 180 25.87 0 1 300 20\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><circle cx=\"300\" cy=\"200\" r=\"108\" style=\"stroke:none;fill:white\"/><path d=\"M 341 101\nL 342 106\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><text x=\"260\" y=\"119\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Cyprus: 6.21%</text><path d=\"M 359 111\nL 354 119\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><text x=\"261\" y=\"132\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Denmark: 8.02%</text><path d=\"M 393 147\nL 378 155\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><text x=\"294\" y=\"162\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Estonia: 7.39%</text><path d=\"M 407 193\nL 386 194\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><text x=\"302\" y=\"201\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Finland: 7.09%</text><path d=\"M 401 237\nL 378 229\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><text x=\"297\" y=\"236\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">France: 6.55%</text><path d=\"M 362 288\nL 352 274\" style=\"stroke-width:2;stroke:rgb(59,162,114);fill:none\"/><text x=\"251\" y=\"274\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Germany: 12.25%</text><path d=\"M 224 276\nL 239 261\" style=\"stroke-width:2;stroke:rgb(252,132,82);fill:none\"/><text x=\"239\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Greece: 25.1%</text><path d=\"M 195 177\nL 214 181\" style=\"stroke-width:2;stroke:rgb(154,96,180);fill:none\"/><text x=\"214\" y=\"188\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Hungary: 7.14%</text><path d=\"M 224 125\nL 232 133\" style=\"stroke-width:2;stroke:rgb(234,124,204);fill:none\"/><text x=\"232\" y=\"146\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ireland: 13.01%</text><path d=\"M 266 98\nL 266 98\" style=\"stroke-width:2;stroke:rgb(123,142,198);fill:none\"/><text x=\"266\" y=\"111\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Italy: 7.18%</text></svg>",
 			pngCRC: 0xc9f5c160,
 		},
 		{
@@ -244,7 +244,7 @@ func TestDoughnutChart(t *testing.T) {
 					},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 56\nA 144 144 119.89 0 1 425 272\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 424 128\nL 437 121\nM 437 121\nL 452 121\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 455 106\nL 535 106\nL 535 106\nA 4 4 90.00 0 1 539 110\nL 539 126\nL 539 126\nA 4 4 90.00 0 1 535 130\nL 455 130\nL 455 130\nA 4 4 90.00 0 1 451 126\nL 451 110\nL 451 110\nA 4 4 90.00 0 1 455 106\nZ\" style=\"stroke:none;fill:blue\"/><text x=\"455\" y=\"126\" style=\"stroke:none;fill:white;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">📊 Analytics</text><path d=\"M 300 200\nL 425 272\nA 144 144 84.08 0 1 242 332\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 344 336\nL 349 351\nM 349 351\nL 364 351\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 369 336\nL 435 336\nL 435 336\nA 6 6 90.00 0 1 441 342\nL 441 354\nL 441 354\nA 6 6 90.00 0 1 435 360\nL 369 360\nL 369 360\nA 6 6 90.00 0 1 363 354\nL 363 342\nL 363 342\nA 6 6 90.00 0 1 369 336\nZ\" style=\"stroke:none;fill:red\"/><text x=\"367\" y=\"356\" style=\"stroke:none;fill:white;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Marketing</text><path d=\"M 300 200\nL 242 332\nA 144 144 66.35 0 1 156 199\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 180 278\nL 167 286\nM 167 286\nL 152 286\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><path d=\"M 103 272\nL 154 272\nL 154 272\nA 3 3 90.00 0 1 157 275\nL 157 292\nL 157 292\nA 3 3 90.00 0 1 154 295\nL 103 295\nL 103 295\nA 3 3 90.00 0 1 100 292\nL 100 275\nL 100 275\nA 3 3 90.00 0 1 103 272\nZ\" style=\"stroke-width:2;stroke:red;fill:lime\"/><text x=\"104\" y=\"291\" style=\"stroke:none;fill:black;font-size:14.1px;font-family:'Roboto Medium',sans-serif\">💰 Sales</text><path d=\"M 300 200\nL 156 199\nA 144 144 55.37 0 1 219 81\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 173 133\nL 160 126\nM 160 126\nL 145 126\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"83\" y=\"131\" style=\"stroke:none;fill:rgb(255,165,0);font-size:16.6px;font-family:'Roboto Medium',sans-serif\">❓ Support</text><path d=\"M 300 200\nL 219 81\nA 144 144 34.32 0 1 300 56\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"86\" style=\"stroke:none;fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 56\nA 144 144 119.89 0 1 424.85 271.75\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 424 128\nL 437 121\nM 437 121\nL 452 121\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 455 106\nL 535 106\nL 535 106\nA 4 4 90.00 0 1 539 110\nL 539 126\nL 539 126\nA 4 4 90.00 0 1 535 130\nL 455 130\nL 455 130\nA 4 4 90.00 0 1 451 126\nL 451 110\nL 451 110\nA 4 4 90.00 0 1 455 106\nZ\" style=\"stroke:none;fill:blue\"/><text x=\"455\" y=\"126\" style=\"stroke:none;fill:white;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">📊 Analytics</text><path d=\"M 300 200\nL 424.85 271.75\nA 144 144 84.08 0 1 241.51 331.59\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 344 336\nL 349 351\nM 349 351\nL 364 351\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 369 336\nL 435 336\nL 435 336\nA 6 6 90.00 0 1 441 342\nL 441 354\nL 441 354\nA 6 6 90.00 0 1 435 360\nL 369 360\nL 369 360\nA 6 6 90.00 0 1 363 354\nL 363 342\nL 363 342\nA 6 6 90.00 0 1 369 336\nZ\" style=\"stroke:none;fill:red\"/><text x=\"367\" y=\"356\" style=\"stroke:none;fill:white;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Marketing</text><path d=\"M 300 200\nL 241.51 331.59\nA 144 144 66.35 0 1 156 199.21\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 180 278\nL 167 286\nM 167 286\nL 152 286\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><path d=\"M 103 272\nL 154 272\nL 154 272\nA 3 3 90.00 0 1 157 275\nL 157 292\nL 157 292\nA 3 3 90.00 0 1 154 295\nL 103 295\nL 103 295\nA 3 3 90.00 0 1 100 292\nL 100 275\nL 100 275\nA 3 3 90.00 0 1 103 272\nZ\" style=\"stroke-width:2;stroke:red;fill:lime\"/><text x=\"104\" y=\"291\" style=\"stroke:none;fill:black;font-size:14.1px;font-family:'Roboto Medium',sans-serif\">💰 Sales</text><path d=\"M 300 200\nL 156 199.21\nA 144 144 55.37 0 1 218.81 81.07\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 173 133\nL 160 126\nM 160 126\nL 145 126\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"83\" y=\"131\" style=\"stroke:none;fill:rgb(255,165,0);font-size:16.6px;font-family:'Roboto Medium',sans-serif\">❓ Support</text><path d=\"M 300 200\nL 218.81 81.07\nA 144 144 34.32 0 1 300 56\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><circle cx=\"300\" cy=\"200\" r=\"86.4\" style=\"stroke:none;fill:white\"/></svg>",
 			pngCRC: 0x5d5f7cfb,
 		},
 	}
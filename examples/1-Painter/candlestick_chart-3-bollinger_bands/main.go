@@ -34,9 +34,8 @@ func main() {
 		SeriesList: []charts.CandlestickSeries{{
 			Data: ohlcData,
 			CloseTrendLine: []charts.SeriesTrendLine{
-				{Type: charts.SeriesTrendTypeBollingerUpper, Period: 10},
-				{Type: charts.SeriesTrendTypeSMA, Period: 10}, // Middle band
-				{Type: charts.SeriesTrendTypeBollingerLower, Period: 10},
+				// Upper, middle, and lower bands with the envelope filled
+				{Type: charts.SeriesTrendTypeBollingerBand, Period: 10, Multiplier: 2},
 			},
 		}},
 		Title: charts.TitleOption{
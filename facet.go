@@ -0,0 +1,217 @@
+package charts
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/go-analyze/charts/chartdraw"
+)
+
+// facetTitlePadding is the space between the figure title and legend, and the panels below them.
+const facetTitlePadding = 15
+
+// FacetOption configures small multiples, a grid of panels each rendering the series of one facet from a single
+// dataset. By default the axis ranges are shared between panels so that they can be compared directly, with axis
+// labels only drawn on the outer panels.
+type FacetOption struct {
+	// Chart provides the dataset and the options used for each panel. The Title and Legend are rendered once above
+	// the panels, with the legend listing the series across all facets.
+	Chart ChartOption
+	// FacetKeys specifies the facet of each series in the Chart SeriesList. A panel is rendered for each distinct key
+	// in the order the keys first appear, titled with the key.
+	FacetKeys []string
+	// Columns is the number of panel columns. Default is the square root of the panel count, rounded up.
+	Columns int
+	// PanelTitle configures the title of each panel, the text is set to the facet key.
+	PanelTitle TitleOption
+	// PanelPadding specifies the padding within each panel. Default is 10 on each side.
+	PanelPadding Box
+	// FreeXAxis when set to *true calculates the value x-axis range of each horizontal bar panel independently, and
+	// draws the x-axis labels on every panel.
+	FreeXAxis *bool
+	// FreeYAxis when set to *true calculates the y-axis ranges of each panel independently, and draws the y-axis
+	// labels on every panel.
+	FreeYAxis *bool
+}
+
+// facetPanel is the chart rendered for a single facet key.
+type facetPanel struct {
+	key        string
+	seriesList GenericSeriesList
+	// themeIndexes are the series color index of each series, matching the series across panels by name.
+	themeIndexes []int
+}
+
+// RenderFacets renders the series of each facet as a separate panel, laid out in a grid.
+func RenderFacets(opt FacetOption, opts ...OptionFunc) (*Painter, error) {
+	for _, fn := range opts {
+		fn(&opt.Chart)
+	}
+	chart := opt.Chart
+	if len(opt.FacetKeys) != len(chart.SeriesList) {
+		return nil, errors.New("a facet key must be specified for each series")
+	} else if len(chart.SeriesList) == 0 {
+		return nil, errors.New("empty series list")
+	}
+	if err := chart.fillDefault(); err != nil {
+		return nil, err
+	}
+
+	panels, seriesNames, seriesSymbols := splitFacetPanels(chart.SeriesList, opt.FacetKeys)
+	panelOpts := make([]ChartOption, len(panels))
+	for i, panel := range panels {
+		panelOpts[i] = chart
+		panelOpts[i].SeriesList = panel.seriesList
+	}
+	setSharedAxisRanges(panelOpts, !flagIs(true, opt.FreeXAxis), !flagIs(true, opt.FreeYAxis))
+
+	p := chart.newPainter()
+	if !p.transparentBackground {
+		p.drawBackground(chart.Theme.GetBackgroundColor())
+	}
+	if chart.Watermark != nil && flagIs(true, chart.Watermark.Background) {
+		if err := renderWatermark(p, chart.Watermark); err != nil {
+			return nil, err
+		}
+	}
+	content := p.Child(PainterPaddingOption(chart.Padding))
+
+	titleBox, err := newTitlePainter(content, chart.Title).Render()
+	if err != nil {
+		return nil, err
+	}
+	legendOpt := chart.Legend
+	if len(legendOpt.SeriesNames) == 0 {
+		legendOpt.SeriesNames = seriesNames
+		legendOpt.seriesSymbols = seriesSymbols
+	}
+	if !titleBox.IsZero() && legendOpt.Offset.Top == "" {
+		legendOpt.Offset.Top = strconv.Itoa(titleBox.Bottom + facetTitlePadding)
+	}
+	legendBox, err := newLegendPainter(content, legendOpt).Render()
+	if err != nil {
+		return nil, err
+	}
+	panelArea := Box{IsSet: true}
+	for _, b := range []Box{titleBox, legendBox} {
+		if b.IsZero() {
+			continue
+		} else if b.Top < content.Height()/2 {
+			panelArea.Top = chartdraw.MaxInt(panelArea.Top, b.Bottom+facetTitlePadding)
+		} else {
+			panelArea.Bottom = chartdraw.MaxInt(panelArea.Bottom, content.Height()-b.Top+facetTitlePadding)
+		}
+	}
+
+	cols := opt.Columns
+	if cols <= 0 {
+		cols = int(math.Ceil(math.Sqrt(float64(len(panels)))))
+	}
+	rows := (len(panels) + cols - 1) / cols
+	grid := content.Child(PainterPaddingOption(panelArea)).LayoutByGrid(cols, rows)
+	for i := range panels {
+		grid.CellAt(strconv.Itoa(i), i%cols, i/cols)
+	}
+	cells, err := grid.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	panelPadding := opt.PanelPadding
+	if panelPadding.IsZero() {
+		panelPadding = NewBoxEqual(10)
+	}
+	panelTitle := opt.PanelTitle
+	if panelTitle.Offset.Left == "" {
+		panelTitle.Offset.Left = PositionCenter
+	}
+	for i, panel := range panels {
+		panelOpt := panelOpts[i]
+		panelOpt.parent = cells[strconv.Itoa(i)]
+		panelOpt.Padding = panelPadding
+		panelOpt.Title = panelTitle
+		panelOpt.Title.Text = panel.key
+		panelOpt.Legend = LegendOption{Show: Ptr(false)}
+		panelOpt.Children = nil
+		panelOpt.Watermark = nil
+		// color each series consistently with the legend, regardless of its position within the panel
+		colors := make([]Color, len(panel.themeIndexes))
+		trendColors := make([]Color, len(panel.themeIndexes))
+		for j, themeIndex := range panel.themeIndexes {
+			colors[j] = chart.Theme.GetSeriesColor(themeIndex)
+			trendColors[j] = chart.Theme.GetSeriesTrendColor(themeIndex)
+		}
+		panelOpt.Theme = chart.Theme.WithSeriesColors(colors).WithSeriesTrendColors(trendColors)
+		hideFacetAxisLabels(&panelOpt, opt, i, cols, len(panels))
+
+		if _, err := Render(panelOpt); err != nil {
+			return nil, err
+		}
+	}
+
+	if chart.Watermark != nil && !flagIs(true, chart.Watermark.Background) {
+		if err := renderWatermark(p, chart.Watermark); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// splitFacetPanels groups the series by their facet key. Series are matched between panels by name, or by their
+// position within the panel if unnamed, returning the names and symbols of the matched series for the legend.
+func splitFacetPanels(seriesList GenericSeriesList, facetKeys []string) ([]facetPanel, []string, []Symbol) {
+	var panels []facetPanel
+	panelIndexes := make(map[string]int)
+	themeIndexes := make(map[string]int)
+	var names []string
+	var symbols []Symbol
+	for i, series := range seriesList {
+		key := facetKeys[i]
+		panelIndex, ok := panelIndexes[key]
+		if !ok {
+			panelIndex = len(panels)
+			panelIndexes[key] = panelIndex
+			panels = append(panels, facetPanel{key: key})
+		}
+		panel := &panels[panelIndex]
+
+		seriesKey := series.Name
+		if seriesKey == "" {
+			seriesKey = "\x00" + strconv.Itoa(len(panel.seriesList))
+		}
+		themeIndex, ok := themeIndexes[seriesKey]
+		if !ok {
+			themeIndex = len(themeIndexes)
+			themeIndexes[seriesKey] = themeIndex
+			names = append(names, series.Name)
+			symbols = append(symbols, seriesList.getSeriesSymbol(i))
+		}
+		panel.seriesList = append(panel.seriesList, series)
+		panel.themeIndexes = append(panel.themeIndexes, themeIndex)
+	}
+	return panels, names, symbols
+}
+
+// hideFacetAxisLabels hides the axis labels and titles of shared axes on inner panels, so they are only drawn on the
+// outer panels of the grid. The hidden labels still reserve their space so that every panel has the same plot area.
+func hideFacetAxisLabels(panelOpt *ChartOption, opt FacetOption, index, cols, count int) {
+	isHorizontal := hasHorizontalBarSeries(panelOpt.SeriesList)
+	col := index % cols
+	// the y-axis is the category axis for horizontal bars, which is always shared
+	showY := !isHorizontal && flagIs(true, opt.FreeYAxis)
+	showX := isHorizontal && flagIs(true, opt.FreeXAxis)
+
+	yAxis := make([]YAxisOption, chartdraw.MaxInt(len(panelOpt.YAxis), getSeriesYAxisCount(panelOpt.SeriesList)))
+	copy(yAxis, panelOpt.YAxis)
+	for yIndex := range yAxis {
+		outer := col == 0
+		if yIndex == 1 { // secondary axis is drawn on the right
+			outer = col == cols-1 || index == count-1
+		}
+		yAxis[yIndex].labelsHidden = !outer && !showY
+	}
+	panelOpt.YAxis = yAxis
+	// a panel is on the bottom edge if there is no panel below it
+	panelOpt.XAxis.labelsHidden = index+cols < count && !showX
+}
@@ -0,0 +1,127 @@
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeFacetOption() FacetOption {
+	seriesList := NewSeriesListLine([][]float64{
+		{10, 20, 30}, {30, 20, 10},
+		{100, 200, 300}, {300, 200, 100},
+		{50, 50, 50}, {60, 60, 60},
+	}).ToGenericSeriesList()
+	for i := range seriesList {
+		if i%2 == 0 {
+			seriesList[i].Name = "Sales"
+		} else {
+			seriesList[i].Name = "Cost"
+		}
+	}
+	return FacetOption{
+		Chart: ChartOption{
+			OutputFormat: ChartOutputSVG,
+			Width:        800,
+			Height:       600,
+			Title:        TitleOption{Text: "Regions"},
+			XAxis:        XAxisOption{Labels: []string{"Q1", "Q2", "Q3"}},
+			SeriesList:   seriesList,
+		},
+		FacetKeys: []string{"East", "East", "West", "West", "North", "North"},
+	}
+}
+
+func renderFacetSVG(t *testing.T, opt FacetOption) string {
+	t.Helper()
+
+	p, err := RenderFacets(opt)
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestSplitFacetPanels(t *testing.T) {
+	t.Parallel()
+
+	seriesList := GenericSeriesList{
+		{Name: "a", Values: []float64{1}},
+		{Name: "b", Values: []float64{2}},
+		{Name: "b", Values: []float64{3}},
+		{Name: "c", Values: []float64{4}},
+		{Values: []float64{5}},
+	}
+	panels, names, symbols := splitFacetPanels(seriesList, []string{"x", "x", "y", "y", "y"})
+
+	require.Len(t, panels, 2)
+	assert.Equal(t, "x", panels[0].key)
+	assert.Equal(t, []int{0, 1}, panels[0].themeIndexes)
+	assert.Equal(t, "y", panels[1].key)
+	assert.Equal(t, []int{1, 2, 3}, panels[1].themeIndexes)
+	assert.Equal(t, 3.0, panels[1].seriesList[0].Values[0])
+	assert.Equal(t, []string{"a", "b", "c", ""}, names)
+	assert.Len(t, symbols, 4)
+}
+
+func TestRenderFacets(t *testing.T) {
+	t.Parallel()
+
+	svg := renderFacetSVG(t, makeFacetOption())
+	assert.Equal(t, 1, strings.Count(svg, ">Regions</text>"))
+	assert.Equal(t, 1, strings.Count(svg, ">Sales</text>"))
+	for _, key := range []string{"East", "West", "North"} {
+		assert.Contains(t, svg, ">"+key+"</text>")
+	}
+	// the y-axis labels are only drawn on the first column, with the same range for both panels
+	assert.Equal(t, 2, strings.Count(svg, ">306</text>"))
+	// the x-axis labels are only drawn on the bottom panel of each column
+	assert.Equal(t, 2, strings.Count(svg, ">Q1</text>"))
+	assert.Equal(t, strings.Count(svg, "<g"), strings.Count(svg, "</g>"))
+}
+
+func TestRenderFacetsFreeYAxis(t *testing.T) {
+	t.Parallel()
+
+	opt := makeFacetOption()
+	opt.FreeYAxis = Ptr(true)
+	opt.Columns = 3
+	svg := renderFacetSVG(t, opt)
+
+	// every panel draws labels for its own range
+	assert.Contains(t, svg, ">31</text>")
+	assert.Contains(t, svg, ">307</text>")
+	assert.Contains(t, svg, ">60.5</text>")
+	assert.Equal(t, 3, strings.Count(svg, ">Q1</text>"))
+}
+
+func TestRenderFacetsHorizontalBar(t *testing.T) {
+	t.Parallel()
+
+	seriesList := NewSeriesListHorizontalBar([][]float64{{10, 20}, {100, 200}}).ToGenericSeriesList()
+	svg := renderFacetSVG(t, FacetOption{
+		Chart: ChartOption{
+			OutputFormat: ChartOutputSVG,
+			SeriesList:   seriesList,
+			YAxis:        []YAxisOption{{Labels: []string{"A", "B"}}},
+		},
+		FacetKeys: []string{"first", "second"},
+		Columns:   1,
+	})
+
+	// the x-axis value range is shared, with labels only on the bottom panel
+	assert.Equal(t, 1, strings.Count(svg, ">204</text>"))
+	// the category labels are drawn on each panel in the first column
+	assert.Equal(t, 2, strings.Count(svg, ">A</text>"))
+}
+
+func TestRenderFacetsError(t *testing.T) {
+	t.Parallel()
+
+	opt := makeFacetOption()
+	opt.FacetKeys = opt.FacetKeys[1:]
+	_, err := RenderFacets(opt)
+	require.Error(t, err)
+}
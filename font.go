@@ -1,9 +1,12 @@
 package charts
 
 import (
+	"strings"
+
 	"github.com/golang/freetype/truetype"
 
 	"github.com/go-analyze/charts/chartdraw"
+	"github.com/go-analyze/charts/chartdraw/drawing"
 )
 
 // FontFamilyRoboto is the default chart font (Roboto Medium), it provides a well spaced Sans style font with good latin character support.
@@ -18,6 +21,9 @@ const FontFamilyNotoSansBold = "notosans-bold"
 
 const defaultFontSize = 12.0
 
+// boldFontSuffix is appended to a font family name to find the installed bold variant of the font.
+const boldFontSuffix = "-bold"
+
 // InstallFont installs a font for chart rendering.
 func InstallFont(fontFamily string, data []byte) error {
 	return chartdraw.InstallFont(fontFamily, data)
@@ -46,3 +52,18 @@ func SetDefaultFont(fontFamily string) error {
 func GetFont(fontFamily string) *truetype.Font {
 	return chartdraw.GetFont(fontFamily)
 }
+
+// getBoldFont returns the bold variant of the font, which is the font family installed with a "-bold" suffix. If no
+// bold variant is installed FontFamilyNotoSansBold is returned.
+func getBoldFont(font *truetype.Font) *truetype.Font {
+	font = getPreferredFont(font)
+	family := drawing.GetFontFamily(font)
+	if strings.HasSuffix(family, boldFontSuffix) {
+		return font // already bold
+	} else if family != "" {
+		if bold := drawing.GetFont(family + boldFontSuffix); bold != nil {
+			return bold
+		}
+	}
+	return GetFont(FontFamilyNotoSansBold)
+}
@@ -133,6 +133,9 @@ func (f *funnelChart) renderChart(result *defaultRenderResult) (Box, error) {
 			},
 		}
 
+		series := opt.SeriesList[index]
+		seriesPainter.startValueGroup(seriesNames[index], "", series.Value,
+			getPreferredValueFormatter(series.Label.ValueFormatter, opt.ValueFormatter))
 		seriesPainter.FillArea(points, theme.GetSeriesColor(index))
 
 		text := textList[index]
@@ -156,6 +159,7 @@ func (f *funnelChart) renderChart(result *defaultRenderResult) (Box, error) {
 		textX := width>>1 - textBox.Width()>>1
 		textY := y + h>>1
 		drawLabelWithBackground(seriesPainter, text, textX, textY, 0, fontStyle, backgroundColor, cornerRadius, borderColor, borderWidth)
+		seriesPainter.endGroup()
 		y += h + gap
 	}
 
@@ -168,6 +172,11 @@ func (f *funnelChart) Render() (Box, error) {
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		var visible []int
+		visible, opt.Theme = opt.Legend.hideSeries(opt.SeriesList, opt.Theme, opt.ValueFormatter)
+		opt.SeriesList = selectSeriesIndexes(opt.SeriesList, visible)
+	}
 	if opt.Legend.Symbol == "" {
 		opt.Legend.Symbol = SymbolSquare
 	}
@@ -36,6 +36,9 @@ type HeatMapOption struct {
 	ScaleMaxValue *float64
 	// ValuesLabel contains configuration for displaying numeric values on heat map cells.
 	ValuesLabel SeriesLabel
+	// VisualMap when set renders a color scale showing the values of the cell colors. The scale is vertical and right
+	// of the cells by default, or below the x-axis when horizontal. The visual map Colors and Pieces also apply to the cells.
+	VisualMap *VisualMapOption
 }
 
 // HeatMapAxis contains configuration options for an axis on a heat map chart.
@@ -91,22 +94,13 @@ func (h *heatMap) renderChart(result *defaultRenderResult) (Box, error) {
 	}
 	seriesPainter := result.seriesPainter.Child(PainterPaddingOption(NewBoxEqual(1)))
 
-	// determine scale for map colors
-	minVal, maxVal := computeMinMax(opt.Values, numCols)
-	if opt.ScaleMinValue != nil {
-		minVal = *opt.ScaleMinValue
-	}
-	if opt.ScaleMaxValue != nil {
-		maxVal = *opt.ScaleMaxValue
-	}
+	minVal, maxVal := h.scaleRange(numCols)
 	valueRange := maxVal - minVal
-	if math.Abs(valueRange) <= matrix.DefaultEpsilon {
-		minVal = 0
-		maxVal = 1
-		valueRange = maxVal - minVal
+	cellColor := h.colorAtRatio
+	if opt.VisualMap != nil {
+		cellColor = h.visualMapOption(numCols).colorAtRatio
 	}
 
-	baseColor := opt.Theme.GetSeriesColor(opt.BaseColorIndex)
 	cellWidth := seriesPainter.Width() / numCols
 	cellHeight := seriesPainter.Height() / numRows
 	if cellWidth < 2 || cellHeight < 2 {
@@ -120,20 +114,14 @@ func (h *heatMap) renderChart(result *defaultRenderResult) (Box, error) {
 			if x < len(opt.Values[y]) {
 				value = opt.Values[y][x]
 			}
-			ratio := (value - minVal) / valueRange
-			lightDelta := (1 - ratio) * 0.4
-			satDelta := (1 - ratio) * 0.1
-			if opt.Theme.IsDark() {
-				lightDelta *= -1
-			}
-			cellColor := baseColor.WithAdjustHSL(0, satDelta, lightDelta)
+			color := cellColor((value - minVal) / valueRange)
 
 			x1 := x * cellWidth
 			y1 := y * cellHeight
 			x2 := x1 + cellWidth
 			y2 := y1 + cellHeight
 
-			seriesPainter.FilledRect(x1, y1, x2, y2, cellColor, cellColor, 0)
+			seriesPainter.FilledRect(x1, y1, x2, y2, color, color, 0)
 		}
 	}
 
@@ -168,6 +156,47 @@ func (h *heatMap) renderChart(result *defaultRenderResult) (Box, error) {
 	return seriesPainter.box, nil
 }
 
+// scaleRange returns the values mapped to the lightest and darkest cell colors.
+func (h *heatMap) scaleRange(numCols int) (float64, float64) {
+	minVal, maxVal := computeMinMax(h.opt.Values, numCols)
+	if h.opt.ScaleMinValue != nil {
+		minVal = *h.opt.ScaleMinValue
+	}
+	if h.opt.ScaleMaxValue != nil {
+		maxVal = *h.opt.ScaleMaxValue
+	}
+	if math.Abs(maxVal-minVal) <= matrix.DefaultEpsilon {
+		return 0, 1
+	}
+	return minVal, maxVal
+}
+
+// colorAtRatio returns the cell color for the ratio of the value range, adjusting the lightness of the base color.
+func (h *heatMap) colorAtRatio(ratio float64) Color {
+	lightDelta := (1 - ratio) * 0.4
+	satDelta := (1 - ratio) * 0.1
+	if h.opt.Theme.IsDark() {
+		lightDelta *= -1
+	}
+	return h.opt.Theme.GetSeriesColor(h.opt.BaseColorIndex).WithAdjustHSL(0, satDelta, lightDelta)
+}
+
+// visualMapOption returns the configured visual map with the scale range and colors of the heat map.
+func (h *heatMap) visualMapOption(numCols int) *VisualMapOption {
+	vm := *h.opt.VisualMap
+	vm.Min, vm.Max = h.scaleRange(numCols)
+	if len(vm.Colors) == 0 {
+		vm.colorAt = h.colorAtRatio
+	}
+	if vm.Vertical == nil {
+		vm.Vertical = Ptr(true)
+	}
+	if vm.Theme == nil {
+		vm.Theme = h.opt.Theme
+	}
+	return &vm
+}
+
 func computeMinMax(values [][]float64, numCol int) (float64, float64) {
 	if len(values) == 0 || numCol == 0 {
 		return 0, 0
@@ -242,7 +271,20 @@ func (h *heatMap) Render() (Box, error) {
 		isCategoryAxis:         true,
 	}}
 
-	renderResult, err := defaultRender(p, defaultRenderOption{
+	// reserve space for the visual map beside or below the cells
+	var visualMap *visualMapPainter
+	chartPainter := p
+	if opt.VisualMap != nil && !flagIs(false, opt.VisualMap.Show) {
+		visualMap = newVisualMapPainter(p, *h.visualMapOption(numCols))
+		_, _, width, height := visualMap.layout()
+		if flagIs(true, visualMap.opt.Vertical) {
+			chartPainter = p.Child(PainterPaddingOption(Box{Right: width + opt.Padding.Right}))
+		} else {
+			chartPainter = p.Child(PainterPaddingOption(Box{Bottom: height + opt.Padding.Bottom}))
+		}
+	}
+
+	renderResult, err := defaultRender(chartPainter, defaultRenderOption{
 		theme:   opt.Theme,
 		padding: opt.Padding,
 		seriesList: heatMapFakeSeries{
@@ -258,7 +300,30 @@ func (h *heatMap) Render() (Box, error) {
 		return BoxZero, err
 	}
 
-	return h.renderChart(renderResult)
+	box, err := h.renderChart(renderResult)
+	if err != nil || visualMap == nil {
+		return box, err
+	}
+	// align the visual map color bar with the cells, the end labels extend past the bar ends
+	if flagIs(true, visualMap.opt.Vertical) {
+		visualMap.opt.Length = box.Height()
+		_, _, _, height := visualMap.layout()
+		visualMap.opt.Offset = OffsetStr{
+			Left: strconv.Itoa(box.Right - p.box.Left + opt.Padding.Right),
+			Top:  strconv.Itoa(box.Top - p.box.Top - (height-box.Height())/2),
+		}
+	} else {
+		visualMap.opt.Length = box.Width()
+		_, _, width, height := visualMap.layout()
+		visualMap.opt.Offset = OffsetStr{
+			Left: strconv.Itoa(box.Left - p.box.Left - (width-box.Width())/2),
+			Top:  strconv.Itoa(p.Height() - opt.Padding.Bottom - height),
+		}
+	}
+	if _, err := visualMap.Render(); err != nil {
+		return BoxZero, err
+	}
+	return box, nil
 }
 
 // heatMapFakeSeries is a dummy series type used solely to satisfy defaultRender's needs and notably drive axis rendering.
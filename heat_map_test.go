@@ -126,6 +126,30 @@ func TestHeatMapChart(t *testing.T) {
 			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 38 20\nL 38 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 20\nL 38 20\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 103\nL 38 103\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 187\nL 38 187\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 270\nL 38 270\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 354\nL 38 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"19\" y=\"67\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"19\" y=\"150\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"19\" y=\"233\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"19\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 39 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 39 359\nL 39 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 219 359\nL 219 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 399 359\nL 399 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"125\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"305\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"485\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 40 21\nL 219 21\nL 219 131\nL 40 131\nL 40 21\" style=\"stroke:none;fill:rgb(235,239,250)\"/><path d=\"M 219 21\nL 398 21\nL 398 131\nL 219 131\nL 219 21\" style=\"stroke:none;fill:rgb(215,222,244)\"/><path d=\"M 398 21\nL 577 21\nL 577 131\nL 398 131\nL 398 21\" style=\"stroke:none;fill:rgb(195,206,239)\"/><path d=\"M 40 131\nL 219 131\nL 219 241\nL 40 241\nL 40 131\" style=\"stroke:none;fill:rgb(176,190,233)\"/><path d=\"M 219 131\nL 398 131\nL 398 241\nL 219 241\nL 219 131\" style=\"stroke:none;fill:rgb(157,174,226)\"/><path d=\"M 398 131\nL 577 131\nL 577 241\nL 398 241\nL 398 131\" style=\"stroke:none;fill:rgb(138,158,219)\"/><path d=\"M 40 241\nL 219 241\nL 219 351\nL 40 351\nL 40 241\" style=\"stroke:none;fill:rgb(120,142,212)\"/><path d=\"M 219 241\nL 398 241\nL 398 351\nL 219 351\nL 219 241\" style=\"stroke:none;fill:rgb(101,127,205)\"/><path d=\"M 398 241\nL 577 241\nL 577 351\nL 398 351\nL 398 241\" style=\"stroke:none;fill:rgb(83,111,198)\"/><text x=\"134\" y=\"83\" style=\"stroke:none;fill:blue;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"313\" y=\"83\" style=\"stroke:none;fill:blue;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"492\" y=\"83\" style=\"stroke:none;fill:blue;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"134\" y=\"193\" style=\"stroke:none;fill:blue;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"313\" y=\"193\" style=\"stroke:none;fill:blue;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"492\" y=\"193\" style=\"stroke:none;fill:blue;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"134\" y=\"303\" style=\"stroke:none;fill:blue;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"313\" y=\"303\" style=\"stroke:none;fill:blue;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"492\" y=\"303\" style=\"stroke:none;fill:blue;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">9</text></svg>",
 			pngCRC: 0x3c6cead7,
 		},
+		{
+			name: "visual_map",
+			makeOptions: func() HeatMapOption {
+				opt := makeMinimalHeatMapOption()
+				opt.VisualMap = &VisualMapOption{}
+				return opt
+			},
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 552 0\nL 552 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 38 20\nL 38 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 20\nL 38 20\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 103\nL 38 103\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 187\nL 38 187\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 270\nL 38 270\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 354\nL 38 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"19\" y=\"67\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"19\" y=\"150\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"19\" y=\"233\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"19\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 39 354\nL 532 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 39 359\nL 39 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 203 359\nL 203 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 367 359\nL 367 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 532 359\nL 532 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"117\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"281\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"445\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 40 21\nL 203 21\nL 203 131\nL 40 131\nL 40 21\" style=\"stroke:none;fill:rgb(235,239,250)\"/><path d=\"M 203 21\nL 366 21\nL 366 131\nL 203 131\nL 203 21\" style=\"stroke:none;fill:rgb(215,222,244)\"/><path d=\"M 366 21\nL 529 21\nL 529 131\nL 366 131\nL 366 21\" style=\"stroke:none;fill:rgb(195,206,239)\"/><path d=\"M 40 131\nL 203 131\nL 203 241\nL 40 241\nL 40 131\" style=\"stroke:none;fill:rgb(176,190,233)\"/><path d=\"M 203 131\nL 366 131\nL 366 241\nL 203 241\nL 203 131\" style=\"stroke:none;fill:rgb(157,174,226)\"/><path d=\"M 366 131\nL 529 131\nL 529 241\nL 366 241\nL 366 131\" style=\"stroke:none;fill:rgb(138,158,219)\"/><path d=\"M 40 241\nL 203 241\nL 203 351\nL 40 351\nL 40 241\" style=\"stroke:none;fill:rgb(120,142,212)\"/><path d=\"M 203 241\nL 366 241\nL 366 351\nL 203 351\nL 203 241\" style=\"stroke:none;fill:rgb(101,127,205)\"/><path d=\"M 366 241\nL 529 241\nL 529 351\nL 366 351\nL 366 241\" style=\"stroke:none;fill:rgb(83,111,198)\"/><defs><linearGradient id=\"gradient-2b49d8ed\" x1=\"0\" y1=\"1\" x2=\"0\" y2=\"0\"><stop offset=\"0\" stop-color=\"rgb(235,239,250)\"/><stop offset=\"0.1\" stop-color=\"rgb(219,226,245)\"/><stop offset=\"0.2\" stop-color=\"rgb(203,213,241)\"/><stop offset=\"0.3\" stop-color=\"rgb(188,200,236)\"/><stop offset=\"0.4\" stop-color=\"rgb(172,187,231)\"/><stop offset=\"0.5\" stop-color=\"rgb(157,174,226)\"/><stop offset=\"0.6\" stop-color=\"rgb(142,161,221)\"/><stop offset=\"0.7\" stop-color=\"rgb(127,149,215)\"/><stop offset=\"0.8\" stop-color=\"rgb(112,136,210)\"/><stop offset=\"0.9\" stop-color=\"rgb(98,124,204)\"/><stop offset=\"1\" stop-color=\"rgb(83,111,198)\"/></linearGradient></defs><path d=\"M 551 21\nL 565 21\nL 565 353\nL 551 353\nL 551 21\" style=\"stroke:none;fill:url(#gradient-2b49d8ed)\"/><text x=\"571\" y=\"359\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"571\" y=\"27\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text></svg>",
+			pngCRC: 0xa086c475,
+		},
+		{
+			name: "visual_map_horizontal_pieces",
+			makeOptions: func() HeatMapOption {
+				opt := makeMinimalHeatMapOption()
+				opt.VisualMap = &VisualMapOption{
+					Vertical: Ptr(false),
+					Pieces:   4,
+					Colors:   []Color{ColorBlue, ColorRed},
+				}
+				return opt
+			},
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 347\nL 0 347\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 38 20\nL 38 301\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 20\nL 38 20\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 90\nL 38 90\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 160\nL 38 160\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 230\nL 38 230\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 301\nL 38 301\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"19\" y=\"60\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"19\" y=\"130\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"19\" y=\"200\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"19\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 39 301\nL 580 301\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 39 306\nL 39 301\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 219 306\nL 219 301\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 399 306\nL 399 301\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 306\nL 580 301\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"125\" y=\"327\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"305\" y=\"327\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"485\" y=\"327\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 40 21\nL 219 21\nL 219 114\nL 40 114\nL 40 21\" style=\"stroke:none;fill:rgb(31,0,223)\"/><path d=\"M 219 21\nL 398 21\nL 398 114\nL 219 114\nL 219 21\" style=\"stroke:none;fill:rgb(31,0,223)\"/><path d=\"M 398 21\nL 577 21\nL 577 114\nL 398 114\nL 398 21\" style=\"stroke:none;fill:rgb(95,0,159)\"/><path d=\"M 40 114\nL 219 114\nL 219 207\nL 40 207\nL 40 114\" style=\"stroke:none;fill:rgb(95,0,159)\"/><path d=\"M 219 114\nL 398 114\nL 398 207\nL 219 207\nL 219 114\" style=\"stroke:none;fill:rgb(159,0,95)\"/><path d=\"M 398 114\nL 577 114\nL 577 207\nL 398 207\nL 398 114\" style=\"stroke:none;fill:rgb(159,0,95)\"/><path d=\"M 40 207\nL 219 207\nL 219 300\nL 40 300\nL 40 207\" style=\"stroke:none;fill:rgb(223,0,31)\"/><path d=\"M 219 207\nL 398 207\nL 398 300\nL 219 300\nL 219 207\" style=\"stroke:none;fill:rgb(223,0,31)\"/><path d=\"M 398 207\nL 577 207\nL 577 300\nL 398 300\nL 398 207\" style=\"stroke:none;fill:rgb(223,0,31)\"/><path d=\"M 40 347\nL 175 347\nL 175 361\nL 40 361\nL 40 347\" style=\"stroke:none;fill:rgb(31,0,223)\"/><path d=\"M 175 347\nL 310 347\nL 310 361\nL 175 361\nL 175 347\" style=\"stroke:none;fill:rgb(95,0,159)\"/><path d=\"M 310 347\nL 444 347\nL 444 361\nL 310 361\nL 310 347\" style=\"stroke:none;fill:rgb(159,0,95)\"/><path d=\"M 444 347\nL 579 347\nL 579 361\nL 444 361\nL 444 347\" style=\"stroke:none;fill:rgb(223,0,31)\"/><text x=\"36\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"171\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"306\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"440\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"575\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text></svg>",
+			pngCRC: 0x21f6b6f0,
+		},
 		{
 			name: "varying_row_lengths",
 			makeOptions: func() HeatMapOption {
@@ -54,6 +54,8 @@ type HorizontalBarChartOption struct {
 	BarMargin *float64 // TODO - v0.6 - Update to be percent based
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
+	// LabelLayout when set places the series labels together, moving or hiding labels to avoid overlaps.
+	LabelLayout *LabelLayoutOption
 }
 
 // newHorizontalBarChart returns a horizontal bar chart renderer.
@@ -74,20 +76,19 @@ func (h *horizontalBarChart) renderChart(result *defaultRenderResult) (Box, erro
 	seriesPainter := result.seriesPainter
 	yRange := result.yaxisRanges[0]
 	y0, y1 := yRange.getRange(0)
-	height := int(y1 - y0)
+	height := y1 - y0
 	stackedSeries := flagIs(true, opt.StackSeries)
 	// If stacking, keep track of accumulated widths for each data index (after the “reverse” logic).
-	var accumulatedWidths []int
-	var margin, barMargin, barHeight int
+	var accumulatedWidths []float64
+	var margin, barMargin, barHeight float64
 	if stackedSeries {
-		accumulatedWidths = make([]int, yRange.divideCount)
+		accumulatedWidths = make([]float64, yRange.divideCount)
 		margin, _, barHeight = calculateBarMarginsAndSize(1, height, opt.BarHeight, nil)
 	} else {
 		margin, barMargin, barHeight = calculateBarMarginsAndSize(seriesCount, height, opt.BarHeight, opt.BarMargin)
 	}
 
 	seriesNames := opt.SeriesList.names()
-	divideValues := yRange.autoDivide()
 
 	markLinePainter := newMarkLinePainter(seriesPainter)
 	rendererList := []renderer{markLinePainter}
@@ -101,6 +102,8 @@ func (h *horizontalBarChart) renderChart(result *defaultRenderResult) (Box, erro
 			rendererList = append(rendererList, labelPainter)
 		}
 
+		valueFormatter := getPreferredValueFormatter(series.Label.ValueFormatter, opt.ValueFormatter)
+		seriesPainter.startSeriesGroup(series.Name)
 		for j, item := range series.Values {
 			if j >= yRange.divideCount {
 				break
@@ -109,12 +112,12 @@ func (h *horizontalBarChart) renderChart(result *defaultRenderResult) (Box, erro
 			reversedJ := yRange.divideCount - j - 1
 
 			// Compute the top of this bar “row”
-			y := divideValues[reversedJ] + margin
+			y := float64(reversedJ)*height + margin
 
 			// Determine the width (horizontal length) of the bar based on the data value
-			w := result.xaxisRange.getHeight(item)
+			w := result.xaxisRange.getHeightF(item)
 
-			var left, right int
+			var left, right float64
 			if stackedSeries {
 				// Start where the previous series ended
 				left = accumulatedWidths[reversedJ]
@@ -123,18 +126,20 @@ func (h *horizontalBarChart) renderChart(result *defaultRenderResult) (Box, erro
 			} else {
 				// Offset each series in its own lane
 				if index != 0 {
-					y += index * (barHeight + barMargin)
+					y += float64(index) * (barHeight + barMargin)
 				}
 				left = 0
 				right = w
 			}
 
-			seriesPainter.FilledRect(left, y, right, y+barHeight, seriesColor, seriesColor, 0.0)
+			seriesPainter.startValueGroup(series.Name, labelAt(opt.YAxis.Labels, j), item, valueFormatter)
+			seriesPainter.FilledRectF(left, y, right, y+barHeight, seriesColor, seriesColor, 0.0)
+			seriesPainter.endGroup()
 
 			if labelPainter != nil {
 				fontStyle := series.Label.FontStyle
-				labelX := right
-				labelY := y + (barHeight >> 1)
+				labelX := int(right)
+				labelY := int(y + barHeight/2)
 				labelLeft := opt.SeriesLabelPosition == PositionLeft && !stackedSeries
 				if labelLeft {
 					labelX = 0
@@ -169,6 +174,7 @@ func (h *horizontalBarChart) renderChart(result *defaultRenderResult) (Box, erro
 				})
 			}
 		}
+		seriesPainter.endGroup()
 
 		var globalSeriesData []float64 // lazily initialized
 		if len(series.MarkLine.Lines) > 0 {
@@ -222,9 +228,17 @@ func (h *horizontalBarChart) renderChart(result *defaultRenderResult) (Box, erro
 func (h *horizontalBarChart) Render() (Box, error) {
 	p := h.p
 	opt := h.opt
+	if opt.LabelLayout != nil {
+		p = p.Child(painterLabelLayoutOption(*opt.LabelLayout))
+	}
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		var visible []int
+		visible, opt.Theme = opt.Legend.hideSeries(opt.SeriesList, opt.Theme, opt.ValueFormatter)
+		opt.SeriesList = selectSeriesIndexes(opt.SeriesList, visible)
+	}
 	if opt.Legend.Symbol == "" {
 		// default to rectangle symbol for this chart type
 		opt.Legend.Symbol = SymbolSquare
@@ -245,5 +259,9 @@ func (h *horizontalBarChart) Render() (Box, error) {
 	if err != nil {
 		return BoxZero, err
 	}
-	return h.renderChart(renderResult)
+	box, err := h.renderChart(renderResult)
+	if err == nil && opt.LabelLayout != nil {
+		p.labelLayout.render()
+	}
+	return box, err
 }
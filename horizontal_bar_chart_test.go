@@ -98,7 +98,7 @@ func TestHorizontalBarChart(t *testing.T) {
 			name:        "basic_themed",
 			themed:      true,
 			makeOptions: makeBasicHorizontalBarChartOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 224 19\nL 254 19\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"239\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"256\" y=\"25\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 87 46\nL 87 366\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 46\nL 87 46\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 99\nL 87 99\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 152\nL 87 152\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 206\nL 87 206\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 259\nL 87 259\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 312\nL 87 312\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 366\nL 87 366\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><text x=\"36\" y=\"78\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"37\" y=\"131\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"43\" y=\"184\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"47\" y=\"237\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"9\" y=\"290\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"38\" y=\"343\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"87\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"170\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120k</text><text x=\"254\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"338\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360k</text><text x=\"421\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"505\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600k</text><text x=\"555\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 171 46\nL 171 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 255 46\nL 255 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 339 46\nL 339 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 422 46\nL 422 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 506 46\nL 506 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 590 46\nL 590 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 88 322\nL 100 322\nL 100 336\nL 88 336\nL 88 322\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 269\nL 104 269\nL 104 283\nL 88 283\nL 88 269\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 216\nL 108 216\nL 108 230\nL 88 230\nL 88 216\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 162\nL 161 162\nL 161 176\nL 88 176\nL 88 162\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 109\nL 179 109\nL 179 123\nL 88 123\nL 88 109\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 56\nL 527 56\nL 527 70\nL 88 70\nL 88 56\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 341\nL 101 341\nL 101 355\nL 88 355\nL 88 341\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 288\nL 104 288\nL 104 302\nL 88 302\nL 88 288\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 235\nL 109 235\nL 109 249\nL 88 249\nL 88 235\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 181\nL 172 181\nL 172 195\nL 88 195\nL 88 181\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 128\nL 181 128\nL 181 142\nL 88 142\nL 88 128\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 75\nL 563 75\nL 563 89\nL 88 89\nL 88 75\" style=\"stroke:none;fill:rgb(255,210,100)\"/></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 224 19\nL 254 19\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"239\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"256\" y=\"25\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 87 46\nL 87 366\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 46\nL 87 46\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 99\nL 87 99\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 152\nL 87 152\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 206\nL 87 206\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 259\nL 87 259\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 312\nL 87 312\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 82 366\nL 87 366\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><text x=\"36\" y=\"78\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"37\" y=\"131\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"43\" y=\"184\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"47\" y=\"237\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"9\" y=\"290\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"38\" y=\"343\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"87\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"170\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120k</text><text x=\"254\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"338\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360k</text><text x=\"421\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"505\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600k</text><text x=\"555\" y=\"385\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 171 46\nL 171 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 255 46\nL 255 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 339 46\nL 339 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 422 46\nL 422 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 506 46\nL 506 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 590 46\nL 590 362\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 88 322.67\nL 100.69 322.67\nL 100.69 336.83\nL 88 336.83\nL 88 322.67\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 269.33\nL 104.38 269.33\nL 104.38 283.5\nL 88 283.5\nL 88 269.33\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 216\nL 108.24 216\nL 108.24 230.17\nL 88 230.17\nL 88 216\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 162.67\nL 161.19 162.67\nL 161.19 176.83\nL 88 176.83\nL 88 162.67\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 109.33\nL 179.85 109.33\nL 179.85 123.5\nL 88 123.5\nL 88 109.33\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 56\nL 527.41 56\nL 527.41 70.17\nL 88 70.17\nL 88 56\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 88 341.83\nL 101.47 341.83\nL 101.47 356\nL 88 356\nL 88 341.83\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 288.5\nL 104.34 288.5\nL 104.34 302.67\nL 88 302.67\nL 88 288.5\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 235.17\nL 109.61 235.17\nL 109.61 249.33\nL 88 249.33\nL 88 235.17\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 181.83\nL 172.78 181.83\nL 172.78 196\nL 88 196\nL 88 181.83\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 128.5\nL 181.53 128.5\nL 181.53 142.67\nL 88 142.67\nL 88 128.5\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 88 75.17\nL 563.37 75.17\nL 563.37 89.33\nL 88 89.33\nL 88 75.17\" style=\"stroke:none;fill:rgb(255,210,100)\"/></svg>",
 			pngCRC:      0xfb168012,
 		},
 		{
@@ -112,7 +112,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.Title.FontStyle = customFont
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"16\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 247 19\nL 277 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"262\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"279\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 42 36\nL 42 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 36\nL 42 36\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 91\nL 42 91\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 146\nL 42 146\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 201\nL 42 201\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 256\nL 42 256\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 311\nL 42 311\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 366\nL 42 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"18\" y=\"64\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"18\" y=\"118\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"20\" y=\"173\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"22\" y=\"228\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"9\" y=\"282\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"19\" y=\"337\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"42\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"102\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">80k</text><text x=\"163\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">160k</text><text x=\"224\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"285\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">320k</text><text x=\"345\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">400k</text><text x=\"406\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"467\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">560k</text><text x=\"528\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">640k</text><text x=\"578\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 103 36\nL 103 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 164 36\nL 164 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 225 36\nL 225 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 286 36\nL 286 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 346 36\nL 346 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 407 36\nL 407 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 468 36\nL 468 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 529 36\nL 529 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 590 36\nL 590 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 43 321\nL 56 321\nL 56 336\nL 43 336\nL 43 321\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 266\nL 60 266\nL 60 281\nL 43 281\nL 43 266\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 211\nL 65 211\nL 65 226\nL 43 226\nL 43 211\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 156\nL 122 156\nL 122 171\nL 43 171\nL 43 156\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 101\nL 143 101\nL 143 116\nL 43 116\nL 43 101\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 46\nL 521 46\nL 521 61\nL 43 61\nL 43 46\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 341\nL 57 341\nL 57 356\nL 43 356\nL 43 341\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 286\nL 60 286\nL 60 301\nL 43 301\nL 43 286\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 231\nL 66 231\nL 66 246\nL 43 246\nL 43 231\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 176\nL 135 176\nL 135 191\nL 43 191\nL 43 176\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 121\nL 144 121\nL 144 136\nL 43 136\nL 43 121\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 66\nL 560 66\nL 560 81\nL 43 81\nL 43 66\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"16\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 247 19\nL 277 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"262\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"279\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 42 36\nL 42 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 36\nL 42 36\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 91\nL 42 91\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 146\nL 42 146\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 201\nL 42 201\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 256\nL 42 256\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 311\nL 42 311\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 37 366\nL 42 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"18\" y=\"64\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"18\" y=\"118\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"20\" y=\"173\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"22\" y=\"228\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"9\" y=\"282\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"19\" y=\"337\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"42\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"102\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">80k</text><text x=\"163\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">160k</text><text x=\"224\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"285\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">320k</text><text x=\"345\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">400k</text><text x=\"406\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"467\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">560k</text><text x=\"528\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">640k</text><text x=\"578\" y=\"375\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 103 36\nL 103 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 164 36\nL 164 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 225 36\nL 225 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 286 36\nL 286 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 346 36\nL 346 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 407 36\nL 407 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 468 36\nL 468 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 529 36\nL 529 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 590 36\nL 590 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 43 321\nL 56.83 321\nL 56.83 336\nL 43 336\nL 43 321\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 266\nL 60.85 266\nL 60.85 281\nL 43 281\nL 43 266\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 211\nL 65.06 211\nL 65.06 226\nL 43 226\nL 43 211\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 156\nL 122.75 156\nL 122.75 171\nL 43 171\nL 43 156\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 101\nL 143.09 101\nL 143.09 116\nL 43 116\nL 43 101\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 46\nL 521.8 46\nL 521.8 61\nL 43 61\nL 43 46\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 43 341\nL 57.68 341\nL 57.68 356\nL 43 356\nL 43 341\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 286\nL 60.81 286\nL 60.81 301\nL 43 301\nL 43 286\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 231\nL 66.55 231\nL 66.55 246\nL 43 246\nL 43 231\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 176\nL 135.38 176\nL 135.38 191\nL 43 191\nL 43 176\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 121\nL 144.91 121\nL 144.91 136\nL 43 136\nL 43 121\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 43 66\nL 560.98 66\nL 560.98 81\nL 43 81\nL 43 66\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x7d773c06,
 		},
 		{
@@ -128,7 +128,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 224 19\nL 254 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"239\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"256\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 87 46\nL 87 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 46\nL 87 46\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 99\nL 87 99\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 152\nL 87 152\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 206\nL 87 206\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 259\nL 87 259\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 312\nL 87 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 366\nL 87 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"36\" y=\"78\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"37\" y=\"131\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"43\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"47\" y=\"237\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"9\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"38\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"87\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"170\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120k</text><text x=\"254\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"338\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360k</text><text x=\"421\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"505\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600k</text><text x=\"555\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 171 46\nL 171 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 255 46\nL 255 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 339 46\nL 339 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 422 46\nL 422 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 506 46\nL 506 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 590 46\nL 590 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 88 322\nL 100 322\nL 100 336\nL 88 336\nL 88 322\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 269\nL 104 269\nL 104 283\nL 88 283\nL 88 269\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 216\nL 108 216\nL 108 230\nL 88 230\nL 88 216\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 162\nL 161 162\nL 161 176\nL 88 176\nL 88 162\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 109\nL 179 109\nL 179 123\nL 88 123\nL 88 109\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 56\nL 527 56\nL 527 70\nL 88 70\nL 88 56\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 341\nL 101 341\nL 101 355\nL 88 355\nL 88 341\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 288\nL 104 288\nL 104 302\nL 88 302\nL 88 288\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 235\nL 109 235\nL 109 249\nL 88 249\nL 88 235\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 181\nL 172 181\nL 172 195\nL 88 195\nL 88 181\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 128\nL 181 128\nL 181 142\nL 88 142\nL 88 128\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 75\nL 563 75\nL 563 89\nL 88 89\nL 88 75\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"105\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18203</text><text x=\"109\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23489</text><text x=\"113\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">29034</text><text x=\"166\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">104970</text><text x=\"184\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">131744</text><text x=\"532\" y=\"67\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">630230</text><text x=\"106\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">19325</text><text x=\"109\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23438</text><text x=\"114\" y=\"246\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">31000</text><text x=\"177\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">121594</text><text x=\"186\" y=\"139\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">134141</text><text x=\"556\" y=\"86\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">681807</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 224 19\nL 254 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"239\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"256\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 87 46\nL 87 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 46\nL 87 46\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 99\nL 87 99\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 152\nL 87 152\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 206\nL 87 206\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 259\nL 87 259\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 312\nL 87 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 366\nL 87 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"36\" y=\"78\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"37\" y=\"131\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"43\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"47\" y=\"237\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"9\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"38\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"87\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"170\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120k</text><text x=\"254\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"338\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360k</text><text x=\"421\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"505\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600k</text><text x=\"555\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 171 46\nL 171 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 255 46\nL 255 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 339 46\nL 339 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 422 46\nL 422 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 506 46\nL 506 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 590 46\nL 590 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 88 322.67\nL 100.69 322.67\nL 100.69 336.83\nL 88 336.83\nL 88 322.67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 269.33\nL 104.38 269.33\nL 104.38 283.5\nL 88 283.5\nL 88 269.33\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 216\nL 108.24 216\nL 108.24 230.17\nL 88 230.17\nL 88 216\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 162.67\nL 161.19 162.67\nL 161.19 176.83\nL 88 176.83\nL 88 162.67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 109.33\nL 179.85 109.33\nL 179.85 123.5\nL 88 123.5\nL 88 109.33\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 56\nL 527.41 56\nL 527.41 70.17\nL 88 70.17\nL 88 56\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 341.83\nL 101.47 341.83\nL 101.47 356\nL 88 356\nL 88 341.83\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 288.5\nL 104.34 288.5\nL 104.34 302.67\nL 88 302.67\nL 88 288.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 235.17\nL 109.61 235.17\nL 109.61 249.33\nL 88 249.33\nL 88 235.17\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 181.83\nL 172.78 181.83\nL 172.78 196\nL 88 196\nL 88 181.83\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 128.5\nL 181.53 128.5\nL 181.53 142.67\nL 88 142.67\nL 88 128.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 75.17\nL 563.37 75.17\nL 563.37 89.33\nL 88 89.33\nL 88 75.17\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"105\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18203</text><text x=\"109\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23489</text><text x=\"113\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">29034</text><text x=\"166\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">104970</text><text x=\"184\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">131744</text><text x=\"532\" y=\"67\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">630230</text><text x=\"106\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">19325</text><text x=\"109\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23438</text><text x=\"114\" y=\"246\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">31000</text><text x=\"177\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">121594</text><text x=\"186\" y=\"139\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">134141</text><text x=\"556\" y=\"86\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">681807</text></svg>",
 			pngCRC: 0xdda92f8,
 		},
 		{
@@ -145,7 +145,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 224 19\nL 254 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"239\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"256\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 87 46\nL 87 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 46\nL 87 46\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 99\nL 87 99\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 152\nL 87 152\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 206\nL 87 206\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 259\nL 87 259\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 312\nL 87 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 366\nL 87 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"36\" y=\"78\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"37\" y=\"131\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"43\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"47\" y=\"237\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"9\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"38\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"87\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"142\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"198\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"254\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"310\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"365\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"421\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"477\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"533\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"584\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><path d=\"M 143 46\nL 143 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 199 46\nL 199 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 255 46\nL 255 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 311 46\nL 311 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 366 46\nL 366 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 422 46\nL 422 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 478 46\nL 478 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 534 46\nL 534 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 590 46\nL 590 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 88 322\nL 100 322\nL 100 336\nL 88 336\nL 88 322\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 269\nL 104 269\nL 104 283\nL 88 283\nL 88 269\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 216\nL 108 216\nL 108 230\nL 88 230\nL 88 216\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 162\nL 161 162\nL 161 176\nL 88 176\nL 88 162\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 109\nL 179 109\nL 179 123\nL 88 123\nL 88 109\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 56\nL 527 56\nL 527 70\nL 88 70\nL 88 56\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 341\nL 101 341\nL 101 355\nL 88 355\nL 88 341\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 288\nL 104 288\nL 104 302\nL 88 302\nL 88 288\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 235\nL 109 235\nL 109 249\nL 88 249\nL 88 235\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 181\nL 172 181\nL 172 195\nL 88 195\nL 88 181\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 128\nL 181 128\nL 181 142\nL 88 142\nL 88 128\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 75\nL 563 75\nL 563 89\nL 88 89\nL 88 75\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"105\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"109\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"113\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"166\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"184\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"532\" y=\"67\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"106\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"109\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"114\" y=\"246\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"177\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"186\" y=\"139\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"568\" y=\"86\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path d=\"M 224 19\nL 254 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"239\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"256\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><path d=\"M 87 46\nL 87 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 46\nL 87 46\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 99\nL 87 99\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 152\nL 87 152\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 206\nL 87 206\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 259\nL 87 259\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 312\nL 87 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 82 366\nL 87 366\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"36\" y=\"78\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"37\" y=\"131\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"43\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"47\" y=\"237\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"9\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"38\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"87\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"142\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"198\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"254\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"310\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"365\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"421\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"477\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"533\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"584\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><path d=\"M 143 46\nL 143 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 199 46\nL 199 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 255 46\nL 255 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 311 46\nL 311 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 366 46\nL 366 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 422 46\nL 422 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 478 46\nL 478 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 534 46\nL 534 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 590 46\nL 590 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 88 322.67\nL 100.69 322.67\nL 100.69 336.83\nL 88 336.83\nL 88 322.67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 269.33\nL 104.38 269.33\nL 104.38 283.5\nL 88 283.5\nL 88 269.33\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 216\nL 108.24 216\nL 108.24 230.17\nL 88 230.17\nL 88 216\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 162.67\nL 161.19 162.67\nL 161.19 176.83\nL 88 176.83\nL 88 162.67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 109.33\nL 179.85 109.33\nL 179.85 123.5\nL 88 123.5\nL 88 109.33\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 56\nL 527.41 56\nL 527.41 70.17\nL 88 70.17\nL 88 56\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 88 341.83\nL 101.47 341.83\nL 101.47 356\nL 88 356\nL 88 341.83\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 288.5\nL 104.34 288.5\nL 104.34 302.67\nL 88 302.67\nL 88 288.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 235.17\nL 109.61 235.17\nL 109.61 249.33\nL 88 249.33\nL 88 235.17\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 181.83\nL 172.78 181.83\nL 172.78 196\nL 88 196\nL 88 181.83\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 128.5\nL 181.53 128.5\nL 181.53 142.67\nL 88 142.67\nL 88 128.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 88 75.17\nL 563.37 75.17\nL 563.37 89.33\nL 88 89.33\nL 88 75.17\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"105\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"109\" y=\"280\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"113\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"166\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"184\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"532\" y=\"67\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"106\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"109\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"114\" y=\"246\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"177\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"186\" y=\"139\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"568\" y=\"86\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">f</text></svg>",
 			pngCRC: 0xd72c917f,
 		},
 		{
@@ -159,7 +159,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.BarHeight = 1000
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 10 336\nL 24 336\nL 24 355\nL 10 355\nL 10 336\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 273\nL 28 273\nL 28 292\nL 10 292\nL 10 273\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 210\nL 33 210\nL 33 229\nL 10 229\nL 10 210\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 146\nL 94 146\nL 94 165\nL 10 165\nL 10 146\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 83\nL 116 83\nL 116 102\nL 10 102\nL 10 83\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 20\nL 517 20\nL 517 39\nL 10 39\nL 10 20\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 360\nL 25 360\nL 25 379\nL 10 379\nL 10 360\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 297\nL 28 297\nL 28 316\nL 10 316\nL 10 297\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 234\nL 34 234\nL 34 253\nL 10 253\nL 10 234\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 170\nL 107 170\nL 107 189\nL 10 189\nL 10 170\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 107\nL 118 107\nL 118 126\nL 10 126\nL 10 107\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 44\nL 559 44\nL 559 63\nL 10 63\nL 10 44\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 10 336.67\nL 24.66 336.67\nL 24.66 355.83\nL 10 355.83\nL 10 336.67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 273.33\nL 28.92 273.33\nL 28.92 292.5\nL 10 292.5\nL 10 273.33\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 210\nL 33.39 210\nL 33.39 229.17\nL 10 229.17\nL 10 210\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 146.67\nL 94.56 146.67\nL 94.56 165.83\nL 10 165.83\nL 10 146.67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 83.33\nL 116.13 83.33\nL 116.13 102.5\nL 10 102.5\nL 10 83.33\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 20\nL 517.69 20\nL 517.69 39.17\nL 10 39.17\nL 10 20\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 360.83\nL 25.57 360.83\nL 25.57 380\nL 10 380\nL 10 360.83\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 297.5\nL 28.88 297.5\nL 28.88 316.67\nL 10 316.67\nL 10 297.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 234.17\nL 34.97 234.17\nL 34.97 253.33\nL 10 253.33\nL 10 234.17\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 170.83\nL 107.95 170.83\nL 107.95 190\nL 10 190\nL 10 170.83\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 107.5\nL 118.06 107.5\nL 118.06 126.67\nL 10 126.67\nL 10 107.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 44.17\nL 559.23 44.17\nL 559.23 63.33\nL 10 63.33\nL 10 44.17\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0xb35224f4,
 		},
 		{
@@ -171,7 +171,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><text x=\"9\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"105\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120k</text><text x=\"202\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"299\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360k</text><text x=\"395\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"492\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600k</text><text x=\"555\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 106 41\nL 106 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 203 41\nL 203 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 41\nL 300 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 396 41\nL 396 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 493 41\nL 493 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 590 41\nL 590 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 321\nL 24 321\nL 24 335\nL 10 335\nL 10 321\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 267\nL 28 267\nL 28 281\nL 10 281\nL 10 267\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 213\nL 33 213\nL 33 227\nL 10 227\nL 10 213\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 159\nL 94 159\nL 94 173\nL 10 173\nL 10 159\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 105\nL 116 105\nL 116 119\nL 10 119\nL 10 105\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 51\nL 517 51\nL 517 65\nL 10 65\nL 10 51\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 340\nL 25 340\nL 25 354\nL 10 354\nL 10 340\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 286\nL 28 286\nL 28 300\nL 10 300\nL 10 286\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 232\nL 34 232\nL 34 246\nL 10 246\nL 10 232\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 178\nL 107 178\nL 107 192\nL 10 192\nL 10 178\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 124\nL 118 124\nL 118 138\nL 10 138\nL 10 124\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 70\nL 559 70\nL 559 84\nL 10 84\nL 10 70\" style=\"stroke:none;fill:rgb(145,204,117)\"/><circle cx=\"517\" cy=\"363\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 517 43\nL 517 366\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 512 59\nL 517 43\nL 522 59\nL 517 54\nL 512 59\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"493\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">630.23k</text><circle cx=\"135\" cy=\"363\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 135 43\nL 135 366\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 130 59\nL 135 43\nL 140 59\nL 135 54\nL 130 59\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"111\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">156.28k</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><text x=\"9\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"105\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120k</text><text x=\"202\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240k</text><text x=\"299\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360k</text><text x=\"395\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480k</text><text x=\"492\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600k</text><text x=\"555\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720k</text><path d=\"M 106 41\nL 106 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 203 41\nL 203 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 41\nL 300 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 396 41\nL 396 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 493 41\nL 493 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 590 41\nL 590 362\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 321.83\nL 24.66 321.83\nL 24.66 336.42\nL 10 336.42\nL 10 321.83\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 267.67\nL 28.92 267.67\nL 28.92 282.25\nL 10 282.25\nL 10 267.67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 213.5\nL 33.39 213.5\nL 33.39 228.08\nL 10 228.08\nL 10 213.5\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 159.33\nL 94.56 159.33\nL 94.56 173.92\nL 10 173.92\nL 10 159.33\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 105.17\nL 116.13 105.17\nL 116.13 119.75\nL 10 119.75\nL 10 105.17\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 51\nL 517.69 51\nL 517.69 65.58\nL 10 65.58\nL 10 51\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 10 341.42\nL 25.57 341.42\nL 25.57 356\nL 10 356\nL 10 341.42\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 287.25\nL 28.88 287.25\nL 28.88 301.83\nL 10 301.83\nL 10 287.25\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 233.08\nL 34.97 233.08\nL 34.97 247.67\nL 10 247.67\nL 10 233.08\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 178.92\nL 107.95 178.92\nL 107.95 193.5\nL 10 193.5\nL 10 178.92\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 124.75\nL 118.06 124.75\nL 118.06 139.33\nL 10 139.33\nL 10 124.75\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 10 70.58\nL 559.23 70.58\nL 559.23 85.17\nL 10 85.17\nL 10 70.58\" style=\"stroke:none;fill:rgb(145,204,117)\"/><circle cx=\"517\" cy=\"363\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 517 43\nL 517 366\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 512 59\nL 517 43\nL 522 59\nL 517 54\nL 512 59\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"493\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">630.23k</text><circle cx=\"135\" cy=\"363\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 135 43\nL 135 366\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 130 59\nL 135 43\nL 140 59\nL 135 54\nL 130 59\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"111\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">156.28k</text></svg>",
 			pngCRC: 0x56386ea2,
 		},
 		{
@@ -181,7 +181,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.BarHeight = 2
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 285\nL 98 285\nL 98 287\nL 20 287\nL 20 285\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 105\nL 232 105\nL 232 107\nL 20 107\nL 20 105\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 292\nL 232 292\nL 232 294\nL 20 294\nL 20 292\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 112\nL 501 112\nL 501 114\nL 20 114\nL 20 112\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 285.5\nL 98.4 285.5\nL 98.4 287.5\nL 20 287.5\nL 20 285.5\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 105.5\nL 232.8 105.5\nL 232.8 107.5\nL 20 107.5\nL 20 105.5\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 292.5\nL 232.8 292.5\nL 232.8 294.5\nL 20 294.5\nL 20 292.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 112.5\nL 501.6 112.5\nL 501.6 114.5\nL 20 114.5\nL 20 112.5\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0xedf8c602,
 		},
 		{
@@ -191,7 +191,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.BarMargin = Ptr(0.0)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 210\nL 98 210\nL 98 290\nL 20 290\nL 20 210\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 30\nL 232 30\nL 232 110\nL 20 110\nL 20 30\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 290\nL 232 290\nL 232 370\nL 20 370\nL 20 290\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 110\nL 501 110\nL 501 190\nL 20 190\nL 20 110\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 210\nL 98.4 210\nL 98.4 290\nL 20 290\nL 20 210\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 30\nL 232.8 30\nL 232.8 110\nL 20 110\nL 20 30\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 290\nL 232.8 290\nL 232.8 370\nL 20 370\nL 20 290\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 110\nL 501.6 110\nL 501.6 190\nL 20 190\nL 20 110\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x62e361ae,
 		},
 		{
@@ -201,7 +201,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.BarMargin = Ptr(1000.0) // will be limited to fit graph
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 210\nL 98 210\nL 98 245\nL 20 245\nL 20 210\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 30\nL 232 30\nL 232 65\nL 20 65\nL 20 30\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 335\nL 232 335\nL 232 370\nL 20 370\nL 20 335\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 155\nL 501 155\nL 501 190\nL 20 190\nL 20 155\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 210\nL 98.4 210\nL 98.4 245\nL 20 245\nL 20 210\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 30\nL 232.8 30\nL 232.8 65\nL 20 65\nL 20 30\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 335\nL 232.8 335\nL 232.8 370\nL 20 370\nL 20 335\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 155\nL 501.6 155\nL 501.6 190\nL 20 190\nL 20 155\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0xe22b0ccd,
 		},
 		{
@@ -212,7 +212,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.BarMargin = Ptr(0.0)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 280\nL 98 280\nL 98 290\nL 20 290\nL 20 280\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 100\nL 232 100\nL 232 110\nL 20 110\nL 20 100\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 290\nL 232 290\nL 232 300\nL 20 300\nL 20 290\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 110\nL 501 110\nL 501 120\nL 20 120\nL 20 110\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 280\nL 98.4 280\nL 98.4 290\nL 20 290\nL 20 280\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 100\nL 232.8 100\nL 232.8 110\nL 20 110\nL 20 100\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 290\nL 232.8 290\nL 232.8 300\nL 20 300\nL 20 290\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 110\nL 501.6 110\nL 501.6 120\nL 20 120\nL 20 110\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x8a6043ab,
 		},
 		{
@@ -223,13 +223,13 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.BarMargin = Ptr(1000.0) // will be limited for readability
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 240\nL 98 240\nL 98 250\nL 20 250\nL 20 240\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 60\nL 232 60\nL 232 70\nL 20 70\nL 20 60\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 330\nL 232 330\nL 232 340\nL 20 340\nL 20 330\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 150\nL 501 150\nL 501 160\nL 20 160\nL 20 150\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 20 240\nL 98.4 240\nL 98.4 250\nL 20 250\nL 20 240\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 60\nL 232.8 60\nL 232.8 70\nL 20 70\nL 20 60\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 330\nL 232.8 330\nL 232.8 340\nL 20 340\nL 20 330\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 20 150\nL 501.6 150\nL 501.6 160\nL 20 160\nL 20 150\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x56436af8,
 		},
 		{
 			name:        "stack_series",
 			makeOptions: makeFullHorizontalBarChartStackedOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 38 20\nL 38 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 20\nL 38 20\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 62\nL 38 62\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 104\nL 38 104\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 146\nL 38 146\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 188\nL 38 188\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 230\nL 38 230\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 272\nL 38 272\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 314\nL 38 314\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 356\nL 38 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"19\" y=\"46\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"19\" y=\"88\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"19\" y=\"130\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"19\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"19\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"19\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"19\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"19\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"38\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"115\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"192\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"269\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"347\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"424\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"501\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">252</text><text x=\"553\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">294</text><path d=\"M 116 20\nL 116 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 193 20\nL 193 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 270 20\nL 270 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 348 20\nL 348 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 425 20\nL 425 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 502 20\nL 502 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 580 20\nL 580 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 39 319\nL 48 319\nL 48 351\nL 39 351\nL 39 319\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 277\nL 81 277\nL 81 309\nL 39 309\nL 39 277\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 235\nL 86 235\nL 86 267\nL 39 267\nL 39 235\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 193\nL 227 193\nL 227 225\nL 39 225\nL 39 193\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 151\nL 300 151\nL 300 183\nL 39 183\nL 39 151\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 109\nL 98 109\nL 98 141\nL 39 141\nL 39 109\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 67\nL 75 67\nL 75 99\nL 39 99\nL 39 67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 25\nL 45 25\nL 45 57\nL 39 57\nL 39 25\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 48 319\nL 82 319\nL 82 351\nL 48 351\nL 48 319\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 81 277\nL 129 277\nL 129 309\nL 81 309\nL 81 277\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 86 235\nL 138 235\nL 138 267\nL 86 267\nL 86 235\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 227 193\nL 493 193\nL 493 225\nL 227 225\nL 227 193\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 151\nL 524 151\nL 524 183\nL 300 183\nL 300 151\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98 109\nL 187 109\nL 187 141\nL 98 141\nL 98 109\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 75 67\nL 127 67\nL 127 99\nL 75 99\nL 75 67\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 45 25\nL 86 25\nL 86 57\nL 45 57\nL 45 25\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 82 319\nL 229 319\nL 229 351\nL 82 351\nL 82 319\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 129 277\nL 203 277\nL 203 309\nL 129 309\nL 129 277\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 138 235\nL 190 235\nL 190 267\nL 138 267\nL 138 235\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 493 193\nL 545 193\nL 545 225\nL 493 225\nL 493 193\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 524 151\nL 568 151\nL 568 183\nL 524 183\nL 524 151\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 187 109\nL 231 109\nL 231 141\nL 187 141\nL 187 109\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 127 67\nL 202 67\nL 202 99\nL 127 99\nL 127 67\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 86 25\nL 234 25\nL 234 57\nL 86 57\nL 86 25\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"53\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"86\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23</text><text x=\"91\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"232\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"305\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><text x=\"103\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"80\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"50\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"87\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">19</text><text x=\"134\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26</text><text x=\"143\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"498\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"529\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"192\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"132\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"91\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">22</text><text x=\"234\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"208\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"195\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"550\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"573\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"236\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"207\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"239\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 38 20\nL 38 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 20\nL 38 20\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 62\nL 38 62\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 104\nL 38 104\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 146\nL 38 146\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 188\nL 38 188\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 230\nL 38 230\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 272\nL 38 272\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 314\nL 38 314\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 33 356\nL 38 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"19\" y=\"46\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"19\" y=\"88\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"19\" y=\"130\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"19\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"19\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"19\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"19\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"19\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"38\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"115\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"192\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"269\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"347\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"424\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"501\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">252</text><text x=\"553\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">294</text><path d=\"M 116 20\nL 116 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 193 20\nL 193 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 270 20\nL 270 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 348 20\nL 348 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 425 20\nL 425 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 502 20\nL 502 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 580 20\nL 580 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 39 319\nL 48.02 319\nL 48.02 351\nL 39 351\nL 39 319\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 277\nL 81.69 277\nL 81.69 309\nL 39 309\nL 39 277\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 235\nL 86.11 235\nL 86.11 267\nL 39 267\nL 39 235\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 193\nL 227.8 193\nL 227.8 225\nL 39 225\nL 39 193\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 151\nL 300.67 151\nL 300.67 183\nL 39 183\nL 39 151\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 109\nL 98.99 109\nL 98.99 141\nL 39 141\nL 39 109\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 67\nL 75.8 67\nL 75.8 99\nL 39 99\nL 39 67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 39 25\nL 45.07 25\nL 45.07 57\nL 39 57\nL 39 25\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 48.02 319\nL 82.98 319\nL 82.98 351\nL 48.02 351\nL 48.02 319\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 81.69 277\nL 130.27 277\nL 130.27 309\nL 81.69 309\nL 81.69 277\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 86.11 235\nL 138.92 235\nL 138.92 267\nL 86.11 267\nL 86.11 235\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 227.8 193\nL 493.88 193\nL 493.88 225\nL 227.8 225\nL 227.8 193\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300.67 151\nL 525.53 151\nL 525.53 183\nL 300.67 183\nL 300.67 151\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 98.99 109\nL 188.6 109\nL 188.6 141\nL 98.99 141\nL 98.99 109\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 75.8 67\nL 128.8 67\nL 128.8 99\nL 75.8 99\nL 75.8 67\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 45.07 25\nL 86.11 25\nL 86.11 57\nL 45.07 57\nL 45.07 25\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 82.98 319\nL 230.19 319\nL 230.19 351\nL 82.98 351\nL 82.98 319\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 130.27 277\nL 204.61 277\nL 204.61 309\nL 130.27 309\nL 130.27 277\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 138.92 235\nL 191.18 235\nL 191.18 267\nL 138.92 267\nL 138.92 235\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 493.88 193\nL 546.88 193\nL 546.88 225\nL 493.88 225\nL 493.88 193\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 525.53 151\nL 570.43 151\nL 570.43 183\nL 525.53 183\nL 525.53 151\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 188.6 109\nL 233.13 109\nL 233.13 141\nL 188.6 141\nL 188.6 109\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 128.8 67\nL 203.88 67\nL 203.88 99\nL 128.8 99\nL 128.8 67\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 86.11 25\nL 234.79 25\nL 234.79 57\nL 86.11 57\nL 86.11 25\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"53\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"86\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23</text><text x=\"91\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"232\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"305\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><text x=\"103\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"80\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"50\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"87\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">19</text><text x=\"135\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26</text><text x=\"143\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"498\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"530\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"193\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"133\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"91\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">22</text><text x=\"235\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"209\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"196\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"551\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"575\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"238\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"208\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"239\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text></svg>",
 			pngCRC:      0xf75e263c,
 		},
 		{
@@ -242,7 +242,7 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.YAxis.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"19\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"205\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"392\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"571\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><path d=\"M 206 20\nL 206 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 393 20\nL 393 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 580 20\nL 580 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 20 30\nL 393 30\nL 393 346\nL 20 346\nL 20 30\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 393 30\nL 486 30\nL 486 346\nL 393 346\nL 393 30\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"19\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"205\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"392\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"571\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><path d=\"M 206 20\nL 206 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 393 20\nL 393 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 580 20\nL 580 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 20 30\nL 393.33 30\nL 393.33 346\nL 20 346\nL 20 30\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 393.33 30\nL 486.67 30\nL 486.67 346\nL 393.33 346\nL 393.33 30\" style=\"stroke:none;fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x2f4f3f65,
 		},
 		{
@@ -256,9 +256,21 @@ func TestHorizontalBarChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"19\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"99\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"179\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"259\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"339\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"419\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"499\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">252</text><text x=\"553\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">294</text><path d=\"M 100 20\nL 100 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 180 20\nL 180 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 260 20\nL 260 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 340 20\nL 340 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 420 20\nL 420 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 500 20\nL 500 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 580 20\nL 580 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 20 319\nL 29 319\nL 29 351\nL 20 351\nL 20 319\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 277\nL 64 277\nL 64 309\nL 20 309\nL 20 277\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 235\nL 68 235\nL 68 267\nL 20 267\nL 20 235\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 193\nL 215 193\nL 215 225\nL 20 225\nL 20 193\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 151\nL 290 151\nL 290 183\nL 20 183\nL 20 151\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 109\nL 82 109\nL 82 141\nL 20 141\nL 20 109\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 67\nL 58 67\nL 58 99\nL 20 99\nL 20 67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 25\nL 26 25\nL 26 57\nL 20 57\nL 20 25\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 29 319\nL 65 319\nL 65 351\nL 29 351\nL 29 319\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 64 277\nL 114 277\nL 114 309\nL 64 309\nL 64 277\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 68 235\nL 122 235\nL 122 267\nL 68 267\nL 68 235\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 215 193\nL 490 193\nL 490 225\nL 215 225\nL 215 193\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 290 151\nL 522 151\nL 522 183\nL 290 183\nL 290 151\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 82 109\nL 174 109\nL 174 141\nL 82 141\nL 82 109\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 58 67\nL 112 67\nL 112 99\nL 58 99\nL 58 67\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 26 25\nL 68 25\nL 68 57\nL 26 57\nL 26 25\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 65 319\nL 217 319\nL 217 351\nL 65 351\nL 65 319\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 114 277\nL 190 277\nL 190 309\nL 114 309\nL 114 277\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 122 235\nL 176 235\nL 176 267\nL 122 267\nL 122 235\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 490 193\nL 544 193\nL 544 225\nL 490 225\nL 490 193\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 522 151\nL 568 151\nL 568 183\nL 522 183\nL 522 151\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 174 109\nL 220 109\nL 220 141\nL 174 141\nL 174 109\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 112 67\nL 189 67\nL 189 99\nL 112 99\nL 112 67\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 68 25\nL 221 25\nL 221 57\nL 68 57\nL 68 25\" style=\"stroke:none;fill:rgb(250,200,88)\"/><circle cx=\"290\" cy=\"353\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 290 22\nL 290 356\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 285 38\nL 290 22\nL 295 38\nL 290 33\nL 285 38\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"278\" y=\"20\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><circle cx=\"104\" cy=\"353\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 104 22\nL 104 356\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 99 38\nL 104 22\nL 109 38\nL 104 33\nL 99 38\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"96\" y=\"20\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">44</text><circle cx=\"570\" cy=\"353\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 570 22\nL 570 356\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 565 38\nL 570 22\nL 575 38\nL 570 33\nL 565 38\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"558\" y=\"20\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">288</text><text x=\"34\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"69\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23</text><text x=\"73\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"220\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"295\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><text x=\"87\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"63\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"31\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"70\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">19</text><text x=\"119\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26</text><text x=\"127\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"495\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"527\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"179\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"117\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"73\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">22</text><text x=\"222\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"195\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"181\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"549\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"573\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"225\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"194\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"226\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"19\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"99\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"179\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"259\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"339\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"419\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"499\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">252</text><text x=\"553\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">294</text><path d=\"M 100 20\nL 100 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 180 20\nL 180 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 260 20\nL 260 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 340 20\nL 340 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 420 20\nL 420 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 500 20\nL 500 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 580 20\nL 580 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 20 319\nL 29.33 319\nL 29.33 351\nL 20 351\nL 20 319\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 277\nL 64.19 277\nL 64.19 309\nL 20 309\nL 20 277\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 235\nL 68.76 235\nL 68.76 267\nL 20 267\nL 20 235\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 193\nL 215.43 193\nL 215.43 225\nL 20 225\nL 20 193\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 151\nL 290.86 151\nL 290.86 183\nL 20 183\nL 20 151\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 109\nL 82.1 109\nL 82.1 141\nL 20 141\nL 20 109\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 67\nL 58.1 67\nL 58.1 99\nL 20 99\nL 20 67\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 20 25\nL 26.29 25\nL 26.29 57\nL 20 57\nL 20 25\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 29.33 319\nL 65.52 319\nL 65.52 351\nL 29.33 351\nL 29.33 319\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 64.19 277\nL 114.48 277\nL 114.48 309\nL 64.19 309\nL 64.19 277\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 68.76 235\nL 123.43 235\nL 123.43 267\nL 68.76 267\nL 68.76 235\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 215.43 193\nL 490.86 193\nL 490.86 225\nL 215.43 225\nL 215.43 193\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 290.86 151\nL 523.62 151\nL 523.62 183\nL 290.86 183\nL 290.86 151\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 82.1 109\nL 174.86 109\nL 174.86 141\nL 82.1 141\nL 82.1 109\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 58.1 67\nL 112.95 67\nL 112.95 99\nL 58.1 99\nL 58.1 67\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 26.29 25\nL 68.76 25\nL 68.76 57\nL 26.29 57\nL 26.29 25\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 65.52 319\nL 217.9 319\nL 217.9 351\nL 65.52 351\nL 65.52 319\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 114.48 277\nL 191.43 277\nL 191.43 309\nL 114.48 309\nL 114.48 277\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 123.43 235\nL 177.52 235\nL 177.52 267\nL 123.43 267\nL 123.43 235\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 490.86 193\nL 545.71 193\nL 545.71 225\nL 490.86 225\nL 490.86 193\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 523.62 151\nL 570.1 151\nL 570.1 183\nL 523.62 183\nL 523.62 151\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 174.86 109\nL 220.95 109\nL 220.95 141\nL 174.86 141\nL 174.86 109\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 112.95 67\nL 190.67 67\nL 190.67 99\nL 112.95 99\nL 112.95 67\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 68.76 25\nL 222.67 25\nL 222.67 57\nL 68.76 57\nL 68.76 25\" style=\"stroke:none;fill:rgb(250,200,88)\"/><circle cx=\"290\" cy=\"353\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 290 22\nL 290 356\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 285 38\nL 290 22\nL 295 38\nL 290 33\nL 285 38\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"278\" y=\"20\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><circle cx=\"104\" cy=\"353\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 104 22\nL 104 356\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 99 38\nL 104 22\nL 109 38\nL 104 33\nL 99 38\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"96\" y=\"20\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">44</text><circle cx=\"570\" cy=\"353\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 570 22\nL 570 356\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 565 38\nL 570 22\nL 575 38\nL 570 33\nL 565 38\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"558\" y=\"20\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">288</text><text x=\"34\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"69\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23</text><text x=\"73\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"220\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102</text><text x=\"295\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">142</text><text x=\"87\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"63\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"31\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"70\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">19</text><text x=\"119\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26</text><text x=\"128\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"495\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">144</text><text x=\"528\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"179\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"117\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"73\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">22</text><text x=\"222\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"196\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"182\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"550\" y=\"213\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28</text><text x=\"575\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"225\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24</text><text x=\"195\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"227\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text></svg>",
 			pngCRC: 0x2e00befd,
 		},
+		{
+			name: "label_wrap",
+			makeOptions: func() HorizontalBarChartOption {
+				opt := NewHorizontalBarChartOptionWithData([][]float64{{120, 200, 150, 80, 70, 110}})
+				opt.YAxis.Labels = []string{"North America Region", "South America Region", "Western Europe",
+					"Eastern Europe", "Asia Pacific Markets", "Middle East and Africa"}
+				opt.YAxis.LabelWrapWidth = 80
+				return opt
+			},
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 105 20\nL 105 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 100 20\nL 105 20\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 100 76\nL 105 76\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 100 132\nL 105 132\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 100 188\nL 105 188\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 100 244\nL 105 244\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 100 300\nL 105 300\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 100 356\nL 105 356\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"48\" y=\"42\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Middle</text><text x=\"24\" y=\"63\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">East and…</text><text x=\"64\" y=\"98\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Asia</text><text x=\"19\" y=\"119\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Pacific M…</text><text x=\"42\" y=\"154\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Eastern</text><text x=\"46\" y=\"175\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Europe</text><text x=\"38\" y=\"209\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Western</text><text x=\"46\" y=\"230\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Europe</text><text x=\"54\" y=\"265\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">South</text><text x=\"26\" y=\"286\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">America…</text><text x=\"56\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">North</text><text x=\"26\" y=\"342\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">America…</text><text x=\"105\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">70</text><text x=\"172\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><text x=\"240\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">110</text><text x=\"308\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"375\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"443\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">170</text><text x=\"511\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"553\" y=\"375\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><path d=\"M 173 20\nL 173 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 241 20\nL 241 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 309 20\nL 309 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 376 20\nL 376 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 444 20\nL 444 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 512 20\nL 512 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 580 20\nL 580 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 106 310\nL 275.29 310\nL 275.29 346\nL 106 346\nL 106 310\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 106 254\nL 546.14 254\nL 546.14 290\nL 106 290\nL 106 254\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 106 198\nL 376.86 198\nL 376.86 234\nL 106 234\nL 106 198\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 106 142\nL 139.86 142\nL 139.86 178\nL 106 178\nL 106 142\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 106 86\nL 106 86\nL 106 122\nL 106 122\nL 106 86\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 106 30\nL 241.43 30\nL 241.43 66\nL 106 66\nL 106 30\" style=\"stroke:none;fill:rgb(84,112,198)\"/></svg>",
+			pngCRC: 0xa3904fd6,
+		},
 	}
 
 	for i, tt := range tests {
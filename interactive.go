@@ -0,0 +1,256 @@
+package charts
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/go-analyze/charts/chartdraw"
+)
+
+const (
+	// interactiveSeriesClass is the class of groups containing all elements of a series.
+	interactiveSeriesClass = "chart-series"
+	// interactiveDataClass is the class of groups containing the elements of a single data point, bar, slice or candle.
+	interactiveDataClass = "chart-data"
+	// interactiveLegendClass is the class of groups containing a legend icon and label.
+	interactiveLegendClass = "chart-legend-item"
+)
+
+// InteractiveOption enables interactive SVG output. Each data point, bar, slice and candle is grouped together with
+// its series name and values as data-* attributes, along with a title which SVG viewers show as a tooltip.
+// Interactive options are ignored for raster and PDF output.
+type InteractiveOption struct {
+	// Tooltip when set to *true embeds CSS and JavaScript which show a styled tooltip while hovering a data element,
+	// and highlight the hovered element.
+	Tooltip *bool
+	// LegendToggle when set to *true embeds CSS and JavaScript which hide or show a series when its legend entry is
+	// clicked.
+	LegendToggle *bool
+	// Nonce optionally sets a CSP nonce on the embedded style and script elements.
+	Nonce string
+}
+
+const interactiveTooltipCSS = `.chart-data{cursor:pointer}.chart-data:hover{opacity:.7}` +
+	`.chart-tooltip{pointer-events:none}.chart-tooltip rect{fill:rgba(50,50,50,.9)}` +
+	`.chart-tooltip text{fill:#fff;font-size:12px;font-family:sans-serif;white-space:pre}`
+
+const interactiveLegendCSS = `.chart-legend-item{cursor:pointer}.chart-legend-item.chart-off{opacity:.35}` +
+	`.chart-hidden{display:none}`
+
+const interactiveScriptStart = `(function(){` +
+	`var svg=(document.currentScript&&document.currentScript.parentNode)||document.documentElement;`
+
+const interactiveTooltipScript = `var ns='http://www.w3.org/2000/svg';` +
+	`var tip=document.createElementNS(ns,'g');tip.setAttribute('class','chart-tooltip');tip.style.display='none';` +
+	`var bg=document.createElementNS(ns,'rect');bg.setAttribute('rx','4');` +
+	`var tx=document.createElementNS(ns,'text');tip.appendChild(bg);tip.appendChild(tx);svg.appendChild(tip);` +
+	`svg.querySelectorAll('.chart-data').forEach(function(g){` +
+	`var t=g.querySelector('title');if(!t)return;var text=t.textContent;g.removeChild(t);` +
+	`g.addEventListener('mouseenter',function(){tx.textContent=text;tip.style.display='';var b=tx.getBBox();` +
+	`bg.setAttribute('x',b.x-6);bg.setAttribute('y',b.y-4);` +
+	`bg.setAttribute('width',b.width+12);bg.setAttribute('height',b.height+8);});` +
+	`g.addEventListener('mousemove',function(e){var p=svg.createSVGPoint();p.x=e.clientX;p.y=e.clientY;` +
+	`p=p.matrixTransform(svg.getScreenCTM().inverse());var b=bg.getBBox(),vb=svg.viewBox.baseVal;` +
+	`var x=p.x+12,y=p.y+24;if(x+b.width>vb.width)x=p.x-b.width-6;if(y+b.height>vb.height)y=p.y-b.height;` +
+	`tip.setAttribute('transform','translate('+x+','+y+')');});` +
+	`g.addEventListener('mouseleave',function(){tip.style.display='none';});});`
+
+const interactiveLegendScript = `svg.querySelectorAll('.chart-legend-item').forEach(function(l){` +
+	`l.addEventListener('click',function(){var name=l.getAttribute('data-series');` +
+	`var off=l.classList.toggle('chart-off');` +
+	`svg.querySelectorAll('.chart-series,.chart-data').forEach(function(el){` +
+	`if(el.getAttribute('data-series')===name)el.classList.toggle('chart-hidden',off);});});});`
+
+const interactiveScriptEnd = `})();`
+
+// rendererFunc returns the SVG renderer constructor with the CSS and JavaScript needed for the enabled features.
+func (o *InteractiveOption) rendererFunc() func(width, height int) chartdraw.Renderer {
+	tooltip := flagIs(true, o.Tooltip)
+	legendToggle := flagIs(true, o.LegendToggle)
+	if !tooltip && !legendToggle {
+		return chartdraw.SVG
+	}
+	var css, script strings.Builder
+	script.WriteString(interactiveScriptStart)
+	if tooltip {
+		css.WriteString(interactiveTooltipCSS)
+		script.WriteString(interactiveTooltipScript)
+	}
+	if legendToggle {
+		css.WriteString(interactiveLegendCSS)
+		script.WriteString(interactiveLegendScript)
+	}
+	script.WriteString(interactiveScriptEnd)
+	return chartdraw.SVGWithScript(css.String(), script.String(), o.Nonce)
+}
+
+// interactiveValue is a named value attached to an interactive data group.
+type interactiveValue struct {
+	// key is the data attribute suffix, e.g. "value" produces data-value.
+	key string
+	// label prefixes the value in the title, empty for a single unlabeled value.
+	label     string
+	value     float64
+	formatted string
+}
+
+// isGrouped returns true if elements should be grouped with their metadata for interactive or accessible SVG output.
+func (p *Painter) isGrouped() bool {
+	return p.interactive != nil || p.isAccessible()
+}
+
+// startSeriesGroup begins a group containing the elements of a series when rendering interactive or accessible SVG
+// output.
+func (p *Painter) startSeriesGroup(seriesName string) {
+	if !p.isGrouped() {
+		return
+	}
+	attrs := []chartdraw.GroupAttribute{{Name: "data-series", Value: seriesName}}
+	if p.isAccessible() {
+		attrs = append(attrs, chartdraw.GroupAttribute{Name: "role", Value: "graphics-object"})
+		if seriesName != "" {
+			attrs = append(attrs, chartdraw.GroupAttribute{Name: "aria-label", Value: seriesName})
+		}
+	}
+	p.renderStartGroup(chartdraw.Group{
+		ClassName:  interactiveSeriesClass,
+		Attributes: attrs,
+	})
+}
+
+// startLegendGroup begins a group containing a legend icon and label when rendering interactive SVG output.
+func (p *Painter) startLegendGroup(seriesName string) {
+	if p.interactive == nil {
+		return
+	}
+	p.renderStartGroup(chartdraw.Group{
+		ClassName:  interactiveLegendClass,
+		Attributes: []chartdraw.GroupAttribute{{Name: "data-series", Value: seriesName}},
+	})
+}
+
+// startDataGroup begins a group for a single data element when rendering interactive or accessible SVG output. The series name,
+// category name and values are set as data attributes, and summarized in the group title.
+func (p *Painter) startDataGroup(seriesName, name string, values ...interactiveValue) {
+	if !p.isGrouped() {
+		return
+	}
+	attrs := make([]chartdraw.GroupAttribute, 0, len(values)+2)
+	var prefix []string
+	if seriesName != "" {
+		attrs = append(attrs, chartdraw.GroupAttribute{Name: "data-series", Value: seriesName})
+		prefix = append(prefix, seriesName)
+	}
+	if name != "" {
+		attrs = append(attrs, chartdraw.GroupAttribute{Name: "data-name", Value: name})
+		prefix = append(prefix, name)
+	}
+	valueText := make([]string, len(values))
+	for i, v := range values {
+		attrs = append(attrs, chartdraw.GroupAttribute{
+			Name:  "data-" + v.key,
+			Value: strconv.FormatFloat(v.value, 'f', -1, 64),
+		})
+		if v.label == "" {
+			valueText[i] = v.formatted
+		} else {
+			valueText[i] = v.label + " " + v.formatted
+		}
+	}
+	title := strings.Join(prefix, ", ")
+	if len(valueText) > 0 {
+		if title != "" {
+			title += ": "
+		}
+		title += strings.Join(valueText, ", ")
+	}
+	if p.isAccessible() {
+		attrs = append(attrs,
+			chartdraw.GroupAttribute{Name: "role", Value: "graphics-symbol"},
+			chartdraw.GroupAttribute{Name: "aria-label", Value: title})
+	}
+	p.renderStartGroup(chartdraw.Group{
+		ClassName:  interactiveDataClass,
+		Title:      title,
+		Attributes: attrs,
+	})
+}
+
+// startValueGroup begins a data group for a single value, formatted with the provided formatter.
+func (p *Painter) startValueGroup(seriesName, name string, value float64, formatter ValueFormatter) {
+	if !p.isGrouped() {
+		return
+	}
+	p.startDataGroup(seriesName, name, interactiveValue{key: "value", value: value, formatted: formatter(value)})
+}
+
+// endLegendGroup closes the group started by startLegendGroup.
+func (p *Painter) endLegendGroup() {
+	if p.interactive == nil {
+		return
+	}
+	p.renderEndGroup()
+}
+
+// endGroup closes the group started by startSeriesGroup, startDataGroup or startValueGroup.
+func (p *Painter) endGroup() {
+	if !p.isGrouped() {
+		return
+	}
+	p.renderEndGroup()
+}
+
+// renderStartGroup begins the group if the renderer supports groups, otherwise the elements are drawn ungrouped.
+func (p *Painter) renderStartGroup(group chartdraw.Group) {
+	if r, ok := p.render.(chartdraw.GroupRenderer); ok {
+		r.StartGroup(group)
+	}
+}
+
+// renderEndGroup closes the group started by renderStartGroup.
+func (p *Painter) renderEndGroup() {
+	if r, ok := p.render.(chartdraw.GroupRenderer); ok {
+		r.EndGroup()
+	}
+}
+
+// labelAt returns the label at the index, or an empty string if there is no label for the index.
+func labelAt(labels []string, index int) string {
+	if index < 0 || index >= len(labels) {
+		return ""
+	}
+	return labels[index]
+}
+
+// startSectorGroup begins a data group for a pie or doughnut slice, including the value and its percent of the total.
+func (p *Painter) startSectorGroup(s sector, valueFormatter ValueFormatter, total float64) {
+	if !p.isGrouped() {
+		return
+	}
+	percent := s.value / total * 100
+	p.startDataGroup(s.name, "",
+		interactiveValue{
+			key:       "value",
+			value:     s.value,
+			formatted: getPreferredValueFormatter(s.seriesLabel.ValueFormatter, valueFormatter)(s.value),
+		},
+		interactiveValue{
+			key:       "percent",
+			value:     percent,
+			formatted: humanize.FtoaWithDigits(percent, 2) + "%",
+		})
+}
+
+// startOHLCGroup begins a data group for a candlestick, including the open, high, low and close values.
+func (p *Painter) startOHLCGroup(seriesName, name string, ohlc OHLCData, valueFormatter ValueFormatter) {
+	if !p.isGrouped() {
+		return
+	}
+	p.startDataGroup(seriesName, name,
+		interactiveValue{key: "open", label: "open", value: ohlc.Open, formatted: valueFormatter(ohlc.Open)},
+		interactiveValue{key: "high", label: "high", value: ohlc.High, formatted: valueFormatter(ohlc.High)},
+		interactiveValue{key: "low", label: "low", value: ohlc.Low, formatted: valueFormatter(ohlc.Low)},
+		interactiveValue{key: "close", label: "close", value: ohlc.Close, formatted: valueFormatter(ohlc.Close)})
+}
@@ -0,0 +1,130 @@
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func renderInteractiveSVG(t *testing.T, interactive InteractiveOption, draw func(p *Painter) error) string {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+		Interactive:  &interactive,
+	})
+	require.NoError(t, draw(p))
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestInteractiveLineChart(t *testing.T) {
+	t.Parallel()
+
+	svg := renderInteractiveSVG(t, InteractiveOption{}, func(p *Painter) error {
+		return p.LineChart(makeBasicLineChartOption())
+	})
+	assert.Equal(t, 2, strings.Count(svg, `<g class="chart-series"`))
+	assert.Equal(t, 14, strings.Count(svg, `<g class="chart-data"`))
+	assert.Contains(t, svg, `<g class="chart-series" data-series="1"><path`)
+	assert.Contains(t, svg, `<g class="chart-data" data-series="1" data-name="A" data-value="120"><title>1, A: 120</title><circle`)
+	assert.Contains(t, svg, `<g class="chart-data" data-series="2" data-name="G" data-value="1320"><title>2, G: 1.32k</title><circle`)
+	assert.Contains(t, svg, `<g class="chart-legend-item" data-series="2"><path`)
+	assert.Equal(t, strings.Count(svg, "<g"), strings.Count(svg, "</g>"))
+	// without tooltip or legend toggle no style or script is embedded
+	assert.NotContains(t, svg, "<style")
+	assert.NotContains(t, svg, "<script")
+}
+
+func TestInteractiveScripts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tooltip", func(t *testing.T) {
+		svg := renderInteractiveSVG(t, InteractiveOption{Tooltip: Ptr(true), Nonce: "abc"}, func(p *Painter) error {
+			return p.BarChart(makeBasicBarChartOption())
+		})
+		assert.Contains(t, svg, `<style type="text/css" nonce="abc"><![CDATA[.chart-data{cursor:pointer}`)
+		assert.Contains(t, svg, `<script type="text/javascript" nonce="abc">`)
+		assert.Contains(t, svg, "chart-tooltip")
+		assert.NotContains(t, svg, "chart-off")
+		assert.True(t, strings.HasSuffix(svg, "]]></script></svg>"))
+	})
+	t.Run("legend_toggle", func(t *testing.T) {
+		svg := renderInteractiveSVG(t, InteractiveOption{LegendToggle: Ptr(true)}, func(p *Painter) error {
+			return p.BarChart(makeBasicBarChartOption())
+		})
+		assert.Contains(t, svg, ".chart-hidden{display:none}")
+		assert.Contains(t, svg, "chart-off")
+		assert.NotContains(t, svg, "chart-tooltip")
+	})
+}
+
+func TestInteractiveBarChart(t *testing.T) {
+	t.Parallel()
+
+	opt := makeBasicBarChartOption()
+	svg := renderInteractiveSVG(t, InteractiveOption{}, func(p *Painter) error {
+		return p.BarChart(opt)
+	})
+	assert.Equal(t, len(opt.SeriesList), strings.Count(svg, `<g class="chart-series"`))
+	assert.Equal(t, len(opt.SeriesList)*len(opt.XAxis.Labels), strings.Count(svg, `<g class="chart-data"`))
+	assert.Contains(t, svg, `data-name="`+opt.XAxis.Labels[0]+`"`)
+	assert.Equal(t, strings.Count(svg, "<g"), strings.Count(svg, "</g>"))
+}
+
+func TestInteractivePieChart(t *testing.T) {
+	t.Parallel()
+
+	svg := renderInteractiveSVG(t, InteractiveOption{}, func(p *Painter) error {
+		return p.PieChart(makeBasicPieChartOption())
+	})
+	assert.Equal(t, 5, strings.Count(svg, `<g class="chart-data"`))
+	assert.Contains(t, svg, `<g class="chart-data" data-series="Series-A" data-value="1048" data-percent="33.30155703844932">`+
+		`<title>Series-A: 1.05k, 33.3%</title><path`)
+	assert.Equal(t, strings.Count(svg, "<g"), strings.Count(svg, "</g>"))
+}
+
+func TestInteractiveCandlestickChart(t *testing.T) {
+	t.Parallel()
+
+	svg := renderInteractiveSVG(t, InteractiveOption{}, func(p *Painter) error {
+		return p.CandlestickChart(makeBasicCandlestickChartOption())
+	})
+	assert.Equal(t, 5, strings.Count(svg, `<g class="chart-data"`))
+	assert.Contains(t, svg, `<g class="chart-data" data-series="Price" data-name="Jan" data-open="100" data-high="110" data-low="95" data-close="105">`+
+		`<title>Price, Jan: open 100, high 110, low 95, close 105</title>`)
+	assert.Equal(t, strings.Count(svg, "<g"), strings.Count(svg, "</g>"))
+}
+
+func TestInteractiveRender(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{{120, 132, 101}}
+	p, err := LineRender(values,
+		InteractiveSVGOptionFunc(InteractiveOption{Tooltip: Ptr(true), LegendToggle: Ptr(true)}),
+		XAxisLabelsOptionFunc([]string{"Mon", "Tue", "Wed"}),
+		LegendLabelsOptionFunc([]string{"Email"}),
+	)
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	svg := string(data)
+	assert.Contains(t, svg, `<g class="chart-data" data-series="Email" data-name="Tue" data-value="132"><title>Email, Tue: 132</title>`)
+	assert.Contains(t, svg, `<g class="chart-legend-item" data-series="Email">`)
+	assert.Contains(t, svg, "<script")
+
+	// interactive options are ignored for raster output
+	p, err = LineRender(values,
+		func(opt *ChartOption) {
+			opt.Interactive = &InteractiveOption{Tooltip: Ptr(true)}
+		},
+		PNGOutputOptionFunc(),
+	)
+	require.NoError(t, err)
+	assert.Nil(t, p.interactive)
+}
@@ -0,0 +1,169 @@
+package charts
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-analyze/charts/chartdraw"
+)
+
+const defaultLabelLayoutSpacing = 2
+
+// LabelLayoutOption configures placing the series labels of a chart together so that labels avoid overlapping each
+// other, mark points, and the axes. Each label is first tried at its default position, then at an alternate position
+// on the opposite side of its data point, and then nudged further from either position. Labels are placed in order of
+// their absolute value, so when no free position is found the smaller values are hidden first.
+type LabelLayoutOption struct {
+	// MaxShift is the maximum distance in pixels a label may be nudged from its default or alternate position,
+	// default is twice the label height.
+	MaxShift int
+	// Spacing is the minimum space in pixels between labels, default is 2.
+	Spacing int
+	// HideOverlap when set to *false (via Ptr(false)) draws labels which have no free position at their default
+	// position, rather than hiding them.
+	HideOverlap *bool
+	// LeaderLine when set to *true draws a line from each nudged label back to its data point.
+	LeaderLine *bool
+}
+
+// painterLabelLayoutOption collects the series labels rendered to the painter (and child painters) so they can be
+// placed together by calling labelLayout.render.
+func painterLabelLayoutOption(opt LabelLayoutOption) PainterOptionFunc {
+	return func(p *Painter) {
+		p.labelLayout = &labelLayout{opt: opt}
+	}
+}
+
+type labelLayoutItem struct {
+	p     *Painter
+	value labelRenderValue
+}
+
+// labelLayout collects labels across series and chart types, then places them to avoid collisions. Positions are
+// stored relative to the painter each label was added from, and compared in absolute coordinates.
+type labelLayout struct {
+	opt       LabelLayoutOption
+	obstacles []Box
+	items     []labelLayoutItem
+}
+
+// addObstacle reserves an area relative to the painter which labels must not be placed over.
+func (l *labelLayout) addObstacle(p *Painter, box Box) {
+	l.obstacles = append(l.obstacles, box.Shift(p.box.Left, p.box.Top))
+}
+
+// add collects the labels to be placed when the layout is rendered.
+func (l *labelLayout) add(p *Painter, values []labelRenderValue) {
+	for _, v := range values {
+		if v.text != "" {
+			l.items = append(l.items, labelLayoutItem{p: p, value: v})
+		}
+	}
+}
+
+// labelBox returns the area covered by a label drawn at the x and y position.
+func labelBox(v labelRenderValue, x, y int) Box {
+	box := Box{Left: x, Top: y - v.height, Right: x + v.width, Bottom: y, IsSet: true}
+	if v.radians != 0 {
+		// rotated text is turned around the start of its baseline
+		corners := []Point{{X: x, Y: y - v.textHeight}, {X: x + v.textWidth, Y: y - v.textHeight},
+			{X: x + v.textWidth, Y: y}, {X: x, Y: y}}
+		box = Box{Left: x, Top: y, Right: x, Bottom: y, IsSet: true}
+		for _, c := range corners {
+			rx, ry := chartdraw.RotateCoordinate(x, y, c.X, c.Y, v.radians)
+			box.Left, box.Right = chartdraw.MinInt(box.Left, rx), chartdraw.MaxInt(box.Right, rx)
+			box.Top, box.Bottom = chartdraw.MinInt(box.Top, ry), chartdraw.MaxInt(box.Bottom, ry)
+		}
+	}
+	if !v.backgroundColor.IsTransparent() || (!v.borderColor.IsTransparent() && v.borderWidth > 0) {
+		const padding = 4 // matching drawLabelWithBackground
+		box = Box{Left: box.Left - padding, Top: box.Top - padding,
+			Right: box.Right + padding, Bottom: box.Bottom + padding, IsSet: true}
+	}
+	return box
+}
+
+// candidates returns the label positions to try in order of preference, along with the distance each is nudged.
+func (l *labelLayout) candidates(v labelRenderValue) ([]Point, []int) {
+	positions := []Point{{X: v.x, Y: v.y}}
+	if v.vertical {
+		// below the data point rather than above
+		positions = append(positions, Point{X: v.x, Y: v.anchorY + v.distance + v.height})
+	} else {
+		// left of the data point rather than right
+		positions = append(positions, Point{X: v.anchorX - v.distance - v.width, Y: v.y})
+	}
+	shifts := []int{0, 0}
+	maxShift := l.opt.MaxShift
+	if maxShift <= 0 {
+		maxShift = v.height * 2
+	}
+	step := chartdraw.MaxInt(v.height/2, 2)
+	for shift := step; shift <= maxShift; shift += step {
+		for _, base := range positions[:2] {
+			positions = append(positions, Point{X: base.X, Y: base.Y - shift}, Point{X: base.X, Y: base.Y + shift})
+			shifts = append(shifts, shift, shift)
+		}
+	}
+	return positions, shifts
+}
+
+// render places and draws the collected labels.
+func (l *labelLayout) render() {
+	spacing := l.opt.Spacing
+	if spacing <= 0 {
+		spacing = defaultLabelLayoutSpacing
+	}
+	sort.SliceStable(l.items, func(i, j int) bool {
+		return math.Abs(l.items[i].value.value) > math.Abs(l.items[j].value.value)
+	})
+	placed := make([]Box, 0, len(l.items)+len(l.obstacles))
+	placed = append(placed, l.obstacles...)
+	collides := func(box Box) bool {
+		expanded := Box{Left: box.Left - spacing, Top: box.Top - spacing,
+			Right: box.Right + spacing, Bottom: box.Bottom + spacing}
+		for _, b := range placed {
+			if expanded.Overlaps(b) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, item := range l.items {
+		v := item.value
+		p := item.p
+		// labels are kept out of the axes to the left and below the plot, and within the right padding
+		inBounds := func(box Box) bool {
+			return box.Left >= p.box.Left && box.Bottom <= p.box.Bottom && box.Top >= p.box.Top &&
+				box.Right <= p.box.Right+v.rightPadding
+		}
+		positions, shifts := l.candidates(v)
+		found := -1
+		for i, pos := range positions {
+			box := labelBox(v, pos.X, pos.Y).Shift(p.box.Left, p.box.Top)
+			if inBounds(box) && !collides(box) {
+				found = i
+				break
+			}
+		}
+		if found == -1 {
+			if flagIs(false, l.opt.HideOverlap) {
+				found = 0
+			} else {
+				continue
+			}
+		}
+		pos := positions[found]
+		box := labelBox(v, pos.X, pos.Y)
+		placed = append(placed, box.Shift(p.box.Left, p.box.Top))
+		if shifts[found] > 0 && flagIs(true, l.opt.LeaderLine) {
+			// connect the data point to the nearest point on the label edge
+			edgeX := chartdraw.MinInt(chartdraw.MaxInt(v.anchorX, box.Left), box.Right)
+			edgeY := chartdraw.MinInt(chartdraw.MaxInt(v.anchorY, box.Top), box.Bottom)
+			p.LineStroke([]Point{{X: v.anchorX, Y: v.anchorY}, {X: edgeX, Y: edgeY}}, v.fontStyle.FontColor, 1)
+		}
+		drawLabelWithBackground(p, v.text, pos.X, pos.Y, v.radians,
+			v.fontStyle, v.backgroundColor, v.cornerRadius, v.borderColor, v.borderWidth)
+	}
+}
@@ -0,0 +1,185 @@
+package charts
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var labelLayoutTextPattern = regexp.MustCompile(`<text x="(-?\d+)" y="(-?\d+)"[^>]*>([^<]+)</text>`)
+
+// renderLabelLayoutPositions places labels above the same data point, returning the drawn position of each label.
+func renderLabelLayoutPositions(t *testing.T, opt LabelLayoutOption, pointY int, obstacle Box,
+	labels map[string]float64) map[string]Point {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 200, Height: 100})
+	p = p.Child(painterLabelLayoutOption(opt))
+	if !obstacle.IsZero() {
+		p.labelLayout.addObstacle(p, obstacle)
+	}
+	labelPainter := newSeriesLabelPainter(p, nil, SeriesLabel{
+		LabelFormatter: func(index int, name string, val float64) (string, *LabelStyle) {
+			for text, v := range labels {
+				if v == val {
+					return text, nil
+				}
+			}
+			return "", nil
+		},
+	}, GetDefaultTheme(), 0)
+	for _, value := range labels {
+		labelPainter.Add(labelValue{value: value, x: 100, y: pointY, vertical: true})
+	}
+	_, err := labelPainter.Render()
+	require.NoError(t, err)
+	p.labelLayout.render()
+
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	positions := make(map[string]Point)
+	for _, match := range labelLayoutTextPattern.FindAllStringSubmatch(string(data), -1) {
+		x, _ := strconv.Atoi(match[1])
+		y, _ := strconv.Atoi(match[2])
+		positions[match[3]] = Point{X: x, Y: y}
+	}
+	return positions
+}
+
+func TestLabelLayoutRender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("alternate_position", func(t *testing.T) {
+		positions := renderLabelLayoutPositions(t, LabelLayoutOption{}, 50, BoxZero,
+			map[string]float64{"high": 20, "low": 10})
+		require.Len(t, positions, 2)
+		assert.Less(t, positions["high"].Y, 50) // default position above the point
+		assert.Greater(t, positions["low"].Y, 50)
+	})
+	t.Run("nudged", func(t *testing.T) {
+		positions := renderLabelLayoutPositions(t, LabelLayoutOption{}, 50, BoxZero,
+			map[string]float64{"a": 30, "b": 20, "c": 10})
+		require.Len(t, positions, 3)
+		assert.NotEqual(t, positions["a"], positions["b"])
+		assert.NotEqual(t, positions["a"], positions["c"])
+		assert.NotEqual(t, positions["b"], positions["c"])
+	})
+	t.Run("hidden", func(t *testing.T) {
+		positions := renderLabelLayoutPositions(t, LabelLayoutOption{MaxShift: 1}, 50, BoxZero,
+			map[string]float64{"a": 30, "b": 20, "c": 10})
+		require.Len(t, positions, 2)
+		assert.NotContains(t, positions, "c")
+	})
+	t.Run("show_overlap", func(t *testing.T) {
+		positions := renderLabelLayoutPositions(t, LabelLayoutOption{MaxShift: 1, HideOverlap: Ptr(false)}, 50,
+			BoxZero, map[string]float64{"a": 30, "b": 20, "c": 10})
+		require.Len(t, positions, 3)
+		assert.Equal(t, positions["a"], positions["c"])
+	})
+	t.Run("obstacle", func(t *testing.T) {
+		positions := renderLabelLayoutPositions(t, LabelLayoutOption{}, 50, NewBox(0, 0, 200, 50),
+			map[string]float64{"a": 10})
+		require.Len(t, positions, 1)
+		assert.Greater(t, positions["a"].Y, 50)
+	})
+	t.Run("axis_bounds", func(t *testing.T) {
+		// the plot bottom prevents the alternate position below the point
+		positions := renderLabelLayoutPositions(t, LabelLayoutOption{MaxShift: 1}, 95, BoxZero,
+			map[string]float64{"a": 20, "b": 10})
+		require.Len(t, positions, 1)
+		assert.Contains(t, positions, "a")
+	})
+}
+
+func TestLabelLayoutPlotTop(t *testing.T) {
+	t.Parallel()
+
+	// the label is kept below the top of the plot rather than drawn over the area above it
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 200, Height: 100})
+	p = p.Child(PainterPaddingOption(Box{Top: 40}), painterLabelLayoutOption(LabelLayoutOption{}))
+	labelPainter := newSeriesLabelPainter(p, nil, SeriesLabel{Show: Ptr(true)}, GetDefaultTheme(), 0)
+	labelPainter.Add(labelValue{value: 10, x: 100, y: 5, vertical: true})
+	_, err := labelPainter.Render()
+	require.NoError(t, err)
+	p.labelLayout.render()
+
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	match := labelLayoutTextPattern.FindStringSubmatch(string(data))
+	require.NotNil(t, match)
+	y, _ := strconv.Atoi(match[2])
+	assert.Greater(t, y, 45)
+}
+
+func TestLabelBox(t *testing.T) {
+	t.Parallel()
+
+	v := labelRenderValue{width: 20, height: 10, textWidth: 20, textHeight: 10,
+		backgroundColor: ColorTransparent, borderColor: ColorTransparent}
+	assert.Equal(t, NewBox(50, 40, 70, 50), labelBox(v, 50, 50))
+
+	// rotated a quarter turn around the baseline start the text extends down from the position
+	v.width, v.height = 10, 20
+	v.radians = DegreesToRadians(90)
+	assert.Equal(t, NewBox(50, 50, 60, 70), labelBox(v, 50, 50))
+}
+
+func TestRenderLabelLayout(t *testing.T) {
+	t.Parallel()
+
+	makeOption := func(labelLayout *LabelLayoutOption) ChartOption {
+		opt := ChartOption{
+			OutputFormat: ChartOutputSVG,
+			LabelLayout:  labelLayout,
+			SeriesList: NewSeriesListLine([][]float64{
+				{120, 132, 101, 134, 90, 230, 210},
+				{125, 130, 105, 130, 95, 225, 215},
+				{110, 140, 100, 140, 85, 235, 205},
+			}, LineSeriesOption{Label: SeriesLabel{Show: Ptr(true)}}).ToGenericSeriesList(),
+		}
+		opt.SeriesList[0].MarkPoint = NewMarkPoint(SeriesMarkTypeMax)
+		return opt
+	}
+	countLabels := func(opt ChartOption) int {
+		p, err := Render(opt)
+		require.NoError(t, err)
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		return len(labelLayoutTextPattern.FindAllString(string(data), -1))
+	}
+
+	overlapping := countLabels(makeOption(nil))
+	placed := countLabels(makeOption(&LabelLayoutOption{MaxShift: 1}))
+	assert.Less(t, placed, overlapping)
+	assert.Equal(t, overlapping, countLabels(makeOption(&LabelLayoutOption{MaxShift: 1, HideOverlap: Ptr(false)})))
+
+	p, err := Render(makeOption(&LabelLayoutOption{}))
+	require.NoError(t, err)
+	assert.Nil(t, p.labelLayout)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	p, err = Render(makeOption(&LabelLayoutOption{LeaderLine: Ptr(true)}))
+	require.NoError(t, err)
+	leaderData, err := p.Bytes()
+	require.NoError(t, err)
+	assert.Greater(t, strings.Count(string(leaderData), "<path"), strings.Count(string(data), "<path"))
+}
+
+func TestLineChartLabelLayout(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	opt := makeMinimalLineChartOption()
+	opt.SeriesList[0].Label.Show = Ptr(true)
+	opt.SeriesList[1].Label.Show = Ptr(true)
+	opt.LabelLayout = &LabelLayoutOption{}
+	require.NoError(t, p.LineChart(opt))
+	assert.Nil(t, p.labelLayout)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assert.NotEmpty(t, labelLayoutTextPattern.FindAllString(string(data), -1))
+}
@@ -2,9 +2,26 @@ package charts
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/go-analyze/charts/chartdraw"
 )
 
 const (
+	// LegendSummaryLast appends the last value of each series to the legend entry.
+	LegendSummaryLast = "last"
+	// LegendSummaryMin appends the minimum value of each series to the legend entry.
+	LegendSummaryMin = "min"
+	// LegendSummaryMax appends the maximum value of each series to the legend entry.
+	LegendSummaryMax = "max"
+	// LegendSummaryAverage appends the average value of each series to the legend entry.
+	LegendSummaryAverage = "avg"
+	// LegendSummarySum appends the sum of the values of each series to the legend entry.
+	LegendSummarySum = "sum"
+)
+
+const (
+	legendHiddenAlpha       = 80
 	legendBuiltInSpacing    = 20
 	legendTextOffset        = 2
 	legendIconStandardWidth = 30
@@ -48,15 +65,33 @@ type LegendOption struct {
 	Align string
 	// Vertical when set to *true makes the legend orientation vertical.
 	Vertical *bool
+	// Position places the legend outside the plot: 'right', 'left', 'bottom', or 'top'. The plot is shrunk to make
+	// room for the legend unless OverlayChart is set. Right and left legends are vertical, wrapping into multiple
+	// columns when the series don't fit the chart height. Default is empty, positioning the legend using Offset.
+	Position string
+	// MaxTextWidth truncates series names wider than this width with an ellipsis. Default is no limit, or a quarter
+	// of the chart width for legends positioned to the right or left.
+	MaxTextWidth int
 	// Symbol defines the icon shape next to each label. Options: 'square', 'dot', 'diamond', 'circle'.
 	Symbol Symbol // TODO - should Symbol configuration be changed now that we support per-series symbols
 	// TODO - v0.6 - consider combining symbol with size into a SymbolStyle struct
-	// OverlayChart when set to *true renders the legend over the chart. Ignored if Vertical is true (Vertical always forces overlay).
+	// OverlayChart when set to *true renders the legend over the chart. Ignored if Vertical is true without a right
+	// or left Position (Vertical always forces overlay).
 	OverlayChart *bool
 	// BorderWidth can be set to a non-zero value to render a box around the legend.
 	BorderWidth float64
+	// SummaryValues appends summary values of each series to its legend entry, in the order specified:
+	// LegendSummaryLast, LegendSummaryMin, LegendSummaryMax, LegendSummaryAverage, or LegendSummarySum.
+	SummaryValues []string
+	// ValueFormatter formats the summary values, defaulting to the chart ValueFormatter.
+	ValueFormatter ValueFormatter
+	// HiddenSeries specifies the names of series which are excluded from rendering and the axis range calculations,
+	// while remaining in the legend as a dimmed entry.
+	HiddenSeries []string
 	// seriesSymbols provides custom symbols for each series.
 	seriesSymbols []Symbol
+	// seriesSummaries provides the summary text appended to each series name.
+	seriesSummaries []string
 }
 
 // IsEmpty checks if the legend is empty.
@@ -77,24 +112,46 @@ func newLegendPainter(p *Painter, opt LegendOption) *legendPainter {
 	}
 }
 
+// legendLayout holds the common layout parameters for legend rendering.
+type legendLayout struct {
+	theme     ColorPalette
+	fontStyle FontStyle
+	vertical  bool
+	padding   Box
+	// p is the painter within the legend padding.
+	p *Painter
+	// names are the display text of each item, including any series summary.
+	names       []string
+	measureList []Box
+	iconWidths  []int
+	// maxTextWidth and itemMaxHeight are the largest text width and height across the items.
+	maxTextWidth, itemMaxHeight int
+	width, left, top            int
+	// columnRows and columnWidth set the wrapping of vertical legends into additional columns.
+	columnRows, columnWidth int
+}
+
 // computeLayoutParams calculates common layout parameters for legend rendering.
-func (l *legendPainter) computeLayoutParams() (
-	theme ColorPalette,
-	fontStyle FontStyle,
-	vertical bool,
-	padding Box,
-	p *Painter,
-	measureList []Box,
-	iconWidths []int,
-	maxTextWidth, itemMaxHeight, width, left, top int,
-	err error,
-) {
+func (l *legendPainter) computeLayoutParams() (legendLayout, error) {
 	opt := l.opt
-	theme = getPreferredTheme(opt.Theme, l.p.theme)
-	fontStyle = fillFontStyleDefaults(opt.FontStyle, defaultFontSize, theme.GetLegendTextColor(), l.p.font)
-	vertical = flagIs(true, opt.Vertical)
+	theme := getPreferredTheme(opt.Theme, l.p.theme)
+	fontStyle := fillFontStyleDefaults(opt.FontStyle, defaultFontSize, theme.GetLegendTextColor(), l.p.font)
+	vertical := flagIs(true, opt.Vertical)
 
 	offset := opt.Offset
+	textLimit := opt.MaxTextWidth
+	sidePosition := opt.isSidePosition()
+	switch opt.Position {
+	case PositionRight, PositionLeft:
+		vertical = true
+		offset.Left = opt.Position
+		if textLimit == 0 {
+			textLimit = l.p.Width() / 4
+		}
+	case PositionTop, PositionBottom:
+		vertical = false
+		offset.Top = opt.Position
+	}
 	if offset.Left == "" {
 		if vertical {
 			// in the vertical orientation it's more visually appealing to default to the right side or left side
@@ -108,17 +165,26 @@ func (l *legendPainter) computeLayoutParams() (
 		}
 	}
 
-	padding = opt.Padding
+	padding := opt.Padding
 	if padding.IsZero() {
 		padding.Top = 5
 	}
-	p = l.p.Child(PainterPaddingOption(padding))
+	p := l.p.Child(PainterPaddingOption(padding))
 
 	// measure items and resolve per-series icon widths
-	measureList = make([]Box, len(opt.SeriesNames))
-	iconWidths = make([]int, len(opt.SeriesNames))
+	names := make([]string, len(opt.SeriesNames))
+	measureList := make([]Box, len(opt.SeriesNames))
+	iconWidths := make([]int, len(opt.SeriesNames))
+	var maxTextWidth, itemMaxHeight, width int
 	var totalIconWidth, maxIconWidth int
 	for index, text := range opt.SeriesNames {
+		if textLimit > 0 {
+			text = truncateText(p, text, textLimit, fontStyle)
+		}
+		if index < len(opt.seriesSummaries) && opt.seriesSummaries[index] != "" {
+			text += " " + opt.seriesSummaries[index]
+		}
+		names[index] = text
 		b := p.MeasureText(text, 0, fontStyle)
 		if b.Width() > maxTextWidth {
 			maxTextWidth = b.Width()
@@ -142,14 +208,31 @@ func (l *legendPainter) computeLayoutParams() (
 		}
 	}
 
+	columnRows := len(opt.SeriesNames)
+	var columnWidth int
 	if vertical {
 		width = maxTextWidth + legendTextOffset + maxIconWidth
+		columnWidth = width + legendBuiltInSpacing
+		if sidePosition {
+			// wrap into additional columns when the items don't fit below the legend top
+			var startTop int
+			if offset.Top != "" && offset.Top != PositionTop {
+				if v, parseErr := parseFlexibleValue(offset.Top, float64(p.Height())); parseErr == nil {
+					startTop = int(v)
+				}
+			}
+			columnRows = chartdraw.MaxInt(1, (p.Height()-startTop)/legendBuiltInSpacing)
+			columns := (len(opt.SeriesNames) + columnRows - 1) / columnRows
+			columnRows = (len(opt.SeriesNames) + columns - 1) / columns // balance the items between columns
+			width += (columns - 1) * columnWidth
+		}
 	} else {
 		offsetValue := (len(opt.SeriesNames) - 1) * (legendBuiltInSpacing + legendTextOffset)
 		width += offsetValue + totalIconWidth
 	}
 
 	// calculate left position
+	var left int
 	switch offset.Left {
 	case PositionLeft:
 		// leave default of zero
@@ -159,8 +242,7 @@ func (l *legendPainter) computeLayoutParams() (
 		left = (p.Width() - width) >> 1
 	default:
 		if v, parseErr := parseFlexibleValue(offset.Left, float64(p.Width())); parseErr != nil {
-			err = fmt.Errorf("error parsing legend position: %w", parseErr)
-			return
+			return legendLayout{}, fmt.Errorf("error parsing legend position: %w", parseErr)
 		} else {
 			left = int(v)
 		}
@@ -170,9 +252,9 @@ func (l *legendPainter) computeLayoutParams() (
 	}
 
 	// calculate top position
-	var height int
+	var top, height int
 	if vertical {
-		height = legendBuiltInSpacing * len(opt.SeriesNames)
+		height = legendBuiltInSpacing * columnRows
 	} else {
 		height = legendIconHeight
 	}
@@ -180,17 +262,177 @@ func (l *legendPainter) computeLayoutParams() (
 	case "", PositionTop:
 		// leave default of zero
 	case PositionBottom:
+		if !vertical {
+			// raise the legend by any additional rows it wraps into
+			y0 := l.iterateLegendLayout(p, measureList, iconWidths, maxTextWidth, itemMaxHeight, left, 0,
+				columnRows, columnWidth, false, nil)
+			height += y0 - 10
+		}
 		top = p.Height() - height
 	default:
 		if v, parseErr := parseFlexibleValue(offset.Top, float64(p.Height())); parseErr != nil {
-			err = fmt.Errorf("error parsing legend position: %w", parseErr)
-			return
+			return legendLayout{}, fmt.Errorf("error parsing legend position: %w", parseErr)
 		} else {
 			top = int(v)
 		}
 	}
 
-	return
+	return legendLayout{
+		theme:         theme,
+		fontStyle:     fontStyle,
+		vertical:      vertical,
+		padding:       padding,
+		p:             p,
+		names:         names,
+		measureList:   measureList,
+		iconWidths:    iconWidths,
+		maxTextWidth:  maxTextWidth,
+		itemMaxHeight: itemMaxHeight,
+		width:         width,
+		left:          left,
+		top:           top,
+		columnRows:    columnRows,
+		columnWidth:   columnWidth,
+	}, nil
+}
+
+// box returns the bounding box of the legend, given the final y position from the layout iteration.
+func (ll legendLayout) box(y0 int) Box {
+	bottom := y0 + ll.padding.Bottom - 10
+	if !ll.vertical {
+		bottom += ll.itemMaxHeight
+	}
+	return Box{
+		Top:    ll.top - ll.padding.Top,
+		Bottom: bottom,
+		Left:   ll.left - ll.padding.Left,
+		Right:  ll.left + ll.width + ll.padding.Right,
+		IsSet:  true,
+	}
+}
+
+// isHidden returns true if the series name is listed in HiddenSeries.
+func (opt *LegendOption) isHidden(name string) bool {
+	for _, hidden := range opt.HiddenSeries {
+		if hidden == name {
+			return true
+		}
+	}
+	return false
+}
+
+// setSeriesSummaries sets the summary text for each legend entry from the values of the matching series.
+func (opt *LegendOption) setSeriesSummaries(sl seriesList, valueFormatter ValueFormatter) {
+	valueFormatter = getPreferredValueFormatter(opt.ValueFormatter, valueFormatter)
+	opt.seriesSummaries = make([]string, len(opt.SeriesNames))
+	for i := 0; i < sl.len(); i++ {
+		index := i
+		if index >= len(opt.SeriesNames) || opt.SeriesNames[index] != sl.getSeriesName(i) {
+			index = -1
+			for j, name := range opt.SeriesNames {
+				if name == sl.getSeriesName(i) {
+					index = j
+					break
+				}
+			}
+			if index < 0 {
+				continue
+			}
+		}
+		values := sl.getSeriesValues(i)
+		summary := summarizePopulationData(values)
+		if summary.MinIndex < 0 {
+			continue // no values to summarize
+		}
+		var last, sum float64
+		for _, v := range values {
+			if v != GetNullValue() {
+				last = v
+				sum += v
+			}
+		}
+		parts := make([]string, 0, len(opt.SummaryValues))
+		for _, summaryValue := range opt.SummaryValues {
+			var v float64
+			switch summaryValue {
+			case LegendSummaryLast:
+				v = last
+			case LegendSummaryMin:
+				v = summary.Min
+			case LegendSummaryMax:
+				v = summary.Max
+			case LegendSummaryAverage:
+				v = summary.Average
+			case LegendSummarySum:
+				v = sum
+			default:
+				continue
+			}
+			parts = append(parts, summaryValue+": "+valueFormatter(v))
+		}
+		if len(parts) != 0 {
+			opt.seriesSummaries[index] = "(" + strings.Join(parts, ", ") + ")"
+		}
+	}
+}
+
+// hideLegendSeries removes the series hidden by the legend from the chart. The series colors and symbols are
+// remapped so that the remaining series and the legend entries are unchanged.
+func (o *ChartOption) hideLegendSeries() {
+	seriesList := append(GenericSeriesList(nil), o.SeriesList...)
+	var visible []int
+	visible, o.Theme = o.Legend.hideSeries(seriesList, o.Theme, o.ValueFormatter)
+	o.SeriesList = selectSeriesIndexes(seriesList, visible)
+}
+
+// selectSeriesIndexes returns a new series list containing only the series at the provided indexes.
+func selectSeriesIndexes[S ~[]T, T any](sl S, indexes []int) S {
+	result := make(S, len(indexes))
+	for i, index := range indexes {
+		result[i] = sl[index]
+	}
+	return result
+}
+
+// hideSeries returns the indexes of the series which are not hidden by the legend, along with a theme where the
+// series colors are remapped to those indexes. The legend symbols and summaries are set from the full series list
+// so that the legend entries are unchanged.
+func (opt *LegendOption) hideSeries(sl seriesList, theme ColorPalette,
+	valueFormatter ValueFormatter) ([]int, ColorPalette) {
+	associateLegendSeriesNames(opt, sl)
+	if opt.Theme == nil {
+		opt.Theme = theme // the legend entries keep the colors of the full series list
+	}
+	symbols := make([]Symbol, sl.len())
+	visible := make([]int, 0, sl.len())
+	var colors, trendColors []Color
+	for i := range symbols {
+		symbols[i] = sl.getSeriesSymbol(i)
+		if opt.isHidden(sl.getSeriesName(i)) {
+			continue
+		}
+		visible = append(visible, i)
+		colors = append(colors, theme.GetSeriesColor(i))
+		trendColors = append(trendColors, theme.GetSeriesTrendColor(i))
+	}
+	if len(opt.SummaryValues) != 0 {
+		opt.setSeriesSummaries(sl, valueFormatter)
+		for i, name := range opt.SeriesNames {
+			if i < len(opt.seriesSummaries) && opt.isHidden(name) {
+				opt.seriesSummaries[i] = "" // hidden series have no values to summarize
+			}
+		}
+	}
+	opt.seriesSymbols = symbols
+	if len(colors) != 0 {
+		theme = theme.WithSeriesColors(colors).WithSeriesTrendColors(trendColors)
+	}
+	return visible, theme
+}
+
+// isSidePosition returns true if the legend is positioned outside the plot on the right or left side.
+func (opt *LegendOption) isSidePosition() bool {
+	return opt.Position == PositionRight || opt.Position == PositionLeft
 }
 
 // iterateLegendLayout walks through legend item positions, calling onItem for each if provided.
@@ -199,7 +441,7 @@ func (l *legendPainter) iterateLegendLayout(
 	p *Painter,
 	measureList []Box,
 	iconWidths []int,
-	maxTextWidth, itemMaxHeight, left, top int,
+	maxTextWidth, itemMaxHeight, left, top, columnRows, columnWidth int,
 	vertical bool,
 	onItem func(index, x0, y0, iconWidth int),
 ) int {
@@ -208,11 +450,19 @@ func (l *legendPainter) iterateLegendLayout(
 	y := top + 10
 	x0 := left
 	y0 := y
+	columnLeft := left
+	bottomY0 := y0
 
 	lastIndex := len(opt.SeriesNames) - 1
 	for index := range opt.SeriesNames {
 		iconWidth := iconWidths[index]
 		if vertical {
+			if index > 0 && index%columnRows == 0 {
+				// start the next column
+				columnLeft += columnWidth
+				x0 = columnLeft
+				y0 = y
+			}
 			if opt.Align == AlignRight {
 				// adjust x0 so that the text will start with a right alignment to the longest line
 				x0 += maxTextWidth - measureList[index].Width()
@@ -255,14 +505,16 @@ func (l *legendPainter) iterateLegendLayout(
 		// advance to next row/position
 		if vertical {
 			y0 += legendBuiltInSpacing
-			x0 = left
+			x0 = columnLeft
+			bottomY0 = chartdraw.MaxInt(bottomY0, y0)
 		} else {
 			x0 += legendBuiltInSpacing
 			y0 = y
+			bottomY0 = y0
 		}
 	}
 
-	return y0
+	return bottomY0
 }
 
 // calculateBox returns the bounding box without rendering.
@@ -272,25 +524,15 @@ func (l *legendPainter) calculateBox() (Box, error) {
 		return BoxZero, nil
 	}
 
-	_, _, vertical, padding, p, measureList, iconWidths, maxTextWidth, itemMaxHeight, width, left, top, err := l.computeLayoutParams()
+	layout, err := l.computeLayoutParams()
 	if err != nil {
 		return BoxZero, err
 	}
 
-	y0 := l.iterateLegendLayout(p, measureList, iconWidths, maxTextWidth, itemMaxHeight, left, top, vertical, nil)
-
-	bottom := y0 + padding.Bottom - 10
-	if !vertical {
-		bottom += itemMaxHeight
-	}
+	y0 := l.iterateLegendLayout(layout.p, layout.measureList, layout.iconWidths, layout.maxTextWidth,
+		layout.itemMaxHeight, layout.left, layout.top, layout.columnRows, layout.columnWidth, layout.vertical, nil)
 
-	return Box{
-		Top:    top - padding.Top,
-		Bottom: bottom,
-		Left:   left - padding.Left,
-		Right:  left + width + padding.Right,
-		IsSet:  true,
-	}, nil
+	return layout.box(y0), nil
 }
 
 func (l *legendPainter) Render() (Box, error) {
@@ -299,43 +541,46 @@ func (l *legendPainter) Render() (Box, error) {
 		return BoxZero, nil
 	}
 
-	theme, fontStyle, vertical, padding, p, measureList, iconWidths, maxTextWidth, itemMaxHeight, width, left, top, err := l.computeLayoutParams()
+	layout, err := l.computeLayoutParams()
 	if err != nil {
 		return BoxZero, err
 	}
+	theme, fontStyle, p := layout.theme, layout.fontStyle, layout.p
 
 	// draw each legend item and capture final y0 for bounding box
-	y0 := l.iterateLegendLayout(p, measureList, iconWidths, maxTextWidth, itemMaxHeight, left, top, vertical,
+	y0 := l.iterateLegendLayout(p, layout.measureList, layout.iconWidths, layout.maxTextWidth,
+		layout.itemMaxHeight, layout.left, layout.top, layout.columnRows, layout.columnWidth, layout.vertical,
 		func(index, x0, y0, iconWidth int) {
-			text := opt.SeriesNames[index]
+			text := layout.names[index]
 			seriesSymbol := opt.Symbol
 			if index < len(opt.seriesSymbols) && opt.seriesSymbols[index] != "" {
 				seriesSymbol = opt.seriesSymbols[index]
 			}
 
-			drawIcon := l.makeIconDrawer(p, theme, index, seriesSymbol)
+			itemFontStyle := fontStyle
+			var drawIcon func(top, left int)
+			if opt.isHidden(opt.SeriesNames[index]) {
+				// hidden series are drawn with a dimmed icon and text
+				itemFontStyle.FontColor = fontStyle.FontColor.WithAlpha(legendHiddenAlpha)
+				dimTheme := theme.WithSeriesColors([]Color{itemFontStyle.FontColor})
+				drawIcon = l.makeIconDrawer(p, dimTheme, 0, seriesSymbol)
+			} else {
+				drawIcon = l.makeIconDrawer(p, theme, index, seriesSymbol)
+			}
 
+			p.startLegendGroup(opt.SeriesNames[index])
 			if opt.Align != AlignRight {
 				drawIcon(y0, x0)
 				x0 += iconWidth + legendTextOffset
 			}
-			p.Text(text, x0, y0, 0, fontStyle)
+			p.Text(text, x0, y0, 0, itemFontStyle)
 			if opt.Align == AlignRight {
-				x0 += measureList[index].Width() + legendTextOffset
+				x0 += layout.measureList[index].Width() + legendTextOffset
 				drawIcon(y0, x0)
 			}
+			p.endLegendGroup()
 		})
-	bottom := y0 + padding.Bottom - 10
-	if !vertical {
-		bottom += itemMaxHeight
-	}
-	result := Box{
-		Top:    top - padding.Top,
-		Bottom: bottom,
-		Left:   left - padding.Left,
-		Right:  left + width + padding.Right,
-		IsSet:  true,
-	}
+	result := layout.box(y0)
 
 	if opt.BorderWidth > 0 {
 		// TODO - if drawn over the chart this can look awkward, we should try to draw this first
@@ -2,6 +2,7 @@ package charts
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -547,6 +548,51 @@ func TestLegendCalculateBox(t *testing.T) {
 				IsSet:  true,
 			},
 		},
+		{
+			name: "position_right",
+			opt: LegendOption{
+				Theme:       GetDefaultTheme(),
+				SeriesNames: []string{"One", "Two", "A very long series name which is truncated"},
+				Position:    PositionRight,
+			},
+			expectedBox: Box{
+				Top:    -5,
+				Bottom: 60,
+				Left:   423,
+				Right:  600,
+				IsSet:  true,
+			},
+		},
+		{
+			name: "position_left_columns",
+			opt: LegendOption{
+				Theme:       GetDefaultTheme(),
+				SeriesNames: makeLegendSeriesNames(30),
+				Position:    PositionLeft,
+			},
+			expectedBox: Box{
+				Top:    -5,
+				Bottom: 300,
+				Left:   0,
+				Right:  214,
+				IsSet:  true,
+			},
+		},
+		{
+			name: "position_bottom_wrapped",
+			opt: LegendOption{
+				Theme:       GetDefaultTheme(),
+				SeriesNames: makeLegendSeriesNames(12),
+				Position:    PositionBottom,
+			},
+			expectedBox: Box{
+				Top:    338,
+				Bottom: 391,
+				Left:   0,
+				Right:  1301,
+				IsSet:  true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -564,3 +610,138 @@ func TestLegendCalculateBox(t *testing.T) {
 		})
 	}
 }
+
+func makeLegendSeriesNames(count int) []string {
+	names := make([]string, count)
+	for i := range names {
+		names[i] = "Series " + strconv.Itoa(i+1)
+	}
+	return names
+}
+
+func TestLegendPositionRender(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{{1, 2, 3}, {3, 2, 1}}
+	names := []string{"Revenue", "A long series name for expenses"}
+	for _, position := range []string{PositionRight, PositionLeft, PositionBottom} {
+		t.Run(position, func(t *testing.T) {
+			p, err := Render(ChartOption{
+				OutputFormat: ChartOutputSVG,
+				Title:        TitleOption{Text: "Title"},
+				Legend:       LegendOption{SeriesNames: names, Position: position, MaxTextWidth: 100},
+				XAxis:        XAxisOption{Labels: []string{"A", "B", "C"}},
+				SeriesList:   NewSeriesListLine(values).ToGenericSeriesList(),
+			})
+			require.NoError(t, err)
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			svg := string(data)
+			assert.Contains(t, svg, ">Revenue</text>")
+			assert.NotContains(t, svg, ">A long series name for expenses</text>")
+			assert.Contains(t, svg, "…</text>")
+		})
+	}
+}
+
+func TestLegendSeriesSummaries(t *testing.T) {
+	t.Parallel()
+
+	seriesList := NewSeriesListLine([][]float64{{1, 2, 3, 4}, {10, GetNullValue(), 30}, {}}, LineSeriesOption{
+		Names: []string{"A", "B", "C"},
+	})
+	opt := LegendOption{
+		SeriesNames:   []string{"B", "A", "C"},
+		SummaryValues: []string{LegendSummaryLast, LegendSummaryMin, LegendSummaryMax, LegendSummaryAverage, LegendSummarySum},
+	}
+	opt.setSeriesSummaries(seriesList, nil)
+	assert.Equal(t, []string{
+		"(last: 30, min: 10, max: 30, avg: 20, sum: 40)",
+		"(last: 4, min: 1, max: 4, avg: 2.5, sum: 10)",
+		"",
+	}, opt.seriesSummaries)
+
+	opt.SummaryValues = []string{LegendSummaryLast}
+	opt.ValueFormatter = func(f float64) string {
+		return "$" + strconv.Itoa(int(f))
+	}
+	opt.setSeriesSummaries(seriesList, nil)
+	assert.Equal(t, []string{"(last: $30)", "(last: $4)", ""}, opt.seriesSummaries)
+}
+
+func TestRenderLegendSummaryAndHiddenSeries(t *testing.T) {
+	t.Parallel()
+
+	render := func(legend LegendOption) string {
+		p, err := Render(ChartOption{
+			OutputFormat: ChartOutputSVG,
+			Legend:       legend,
+			XAxis:        XAxisOption{Labels: []string{"A", "B", "C"}},
+			SeriesList: NewSeriesListLine([][]float64{{1, 2, 3}, {1000, 2000, 3000}, {3, 2, 1}}, LineSeriesOption{
+				Names: []string{"Low", "High", "Falling"},
+			}).ToGenericSeriesList(),
+		})
+		require.NoError(t, err)
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	svg := render(LegendOption{SummaryValues: []string{LegendSummaryLast, LegendSummaryMax}})
+	assert.Contains(t, svg, ">Low (last: 3, max: 3)</text>")
+	assert.Contains(t, svg, ">High (last: 3k, max: 3k)</text>")
+	assert.Contains(t, svg, ">3.15k</text>")
+
+	svg = render(LegendOption{HiddenSeries: []string{"High"}})
+	// the hidden series remains in the legend, but is excluded from the range and the rendered series
+	assert.Contains(t, svg, ">High</text>")
+	assert.NotContains(t, svg, ">3.15k</text>")
+	assert.Contains(t, svg, "fill:rgba(70,70,70,0.3)")
+	assert.Positive(t, strings.Count(svg, "stroke:rgb(84,112,198)"))
+	assert.Equal(t, 0, strings.Count(svg, "stroke:rgb(145,204,117)")) // hidden series color is not drawn
+	// the third series keeps its color
+	assert.Equal(t, strings.Count(svg, "stroke:rgb(84,112,198)"), strings.Count(svg, "stroke:rgb(250,200,88)"))
+}
+
+func TestPainterChartHiddenSeries(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{{1, 2, 3}, {1000, 2000, 3000}, {3, 2, 1}}
+	names := []string{"Low", "High", "Falling"}
+	legend := LegendOption{HiddenSeries: []string{"High"}}
+
+	t.Run("line", func(t *testing.T) {
+		opt := NewLineChartOptionWithData(values)
+		opt.XAxis.Labels = []string{"A", "B", "C"}
+		opt.Legend = legend
+		opt.Legend.SeriesNames = names
+
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.LineChart(opt))
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		svg := string(data)
+		assert.Contains(t, svg, ">High</text>")
+		assert.NotContains(t, svg, ">3k</text>")
+		assert.Contains(t, svg, "fill:rgba(70,70,70,0.3)")
+		assert.Equal(t, 0, strings.Count(svg, "stroke:rgb(145,204,117)"))
+		assert.Equal(t, strings.Count(svg, "stroke:rgb(84,112,198)"), strings.Count(svg, "stroke:rgb(250,200,88)"))
+	})
+
+	t.Run("bar", func(t *testing.T) {
+		opt := NewBarChartOptionWithData(values)
+		opt.XAxis.Labels = []string{"A", "B", "C"}
+		opt.Legend = legend
+		opt.Legend.SeriesNames = names
+
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.BarChart(opt))
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		svg := string(data)
+		assert.Contains(t, svg, ">High</text>")
+		assert.NotContains(t, svg, ">3k</text>")
+		assert.Equal(t, 0, strings.Count(svg, "fill:rgb(145,204,117)"))
+		assert.Equal(t, strings.Count(svg, "fill:rgb(84,112,198)"), strings.Count(svg, "fill:rgb(250,200,88)"))
+	})
+}
@@ -73,16 +73,63 @@ type LineChartOption struct {
 	// Smoothing the line may move it from hitting points exactly.
 	// Higher tension values move the line further from exact data points.
 	StrokeSmoothingTension float64
+	// StepMode renders the lines as steps between data points: LineStepBefore, LineStepAfter, or LineStepMiddle.
+	// Empty (default) connects points directly. Step lines take priority over StrokeSmoothingTension.
+	// Can be overridden per series.
+	StepMode string
 	// FillArea when set to *true fills the area below the line.
 	FillArea *bool
 	// FillOpacity is the opacity/alpha (0-255) of the area fill.
 	FillOpacity uint8
+	// FillGradient when set to *true fills the area with a vertical gradient, fading from the series color at
+	// FillOpacity at the top to transparent at the bottom. Can be overridden per series.
+	FillGradient *bool
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
+	// LabelLayout when set places the series labels together, moving or hiding labels to avoid overlaps.
+	LabelLayout *LabelLayoutOption
 }
 
+const (
+	// LineStepBefore renders step lines which change to the next value at the prior point, the vertical step is
+	// drawn first.
+	LineStepBefore = "before"
+	// LineStepAfter renders step lines which hold the value until the next point, the horizontal step is drawn first.
+	LineStepAfter = "after"
+	// LineStepMiddle renders step lines which change value halfway between points.
+	LineStepMiddle = "middle"
+)
+
 const showSymbolDefaultThreshold = 100
 
+// stepLinePoints expands the points into the step shape for the given mode, preserving null break points. The
+// original points remain vertices of the returned line. Points are returned unmodified if the mode is not a step mode.
+func stepLinePoints(points []PointF, mode string) []PointF {
+	switch mode {
+	case LineStepBefore, LineStepAfter, LineStepMiddle:
+	default:
+		return points
+	}
+
+	result := make([]PointF, 0, len(points)*3)
+	for i, pt := range points {
+		if i > 0 && pt.Y != math.MaxInt32 && points[i-1].Y != math.MaxInt32 {
+			prev := points[i-1]
+			switch mode {
+			case LineStepBefore:
+				result = append(result, PointF{X: prev.X, Y: pt.Y})
+			case LineStepAfter:
+				result = append(result, PointF{X: pt.X, Y: prev.Y})
+			case LineStepMiddle:
+				midX := (prev.X + pt.X) / 2
+				result = append(result, PointF{X: midX, Y: prev.Y}, PointF{X: midX, Y: pt.Y})
+			}
+		}
+		result = append(result, pt)
+	}
+	return result
+}
+
 func boundaryGapAxisPositions(painterWidth int, boundaryGap bool, xDivideCount int) []int {
 	if !boundaryGap {
 		xDivideCount--
@@ -100,6 +147,35 @@ func boundaryGapAxisPositions(painterWidth int, boundaryGap bool, xDivideCount i
 	return xValues
 }
 
+// boundaryGapAxisPositionsF returns the same positions as boundaryGapAxisPositions without truncating to whole
+// pixels, so lines with many points are evenly spaced.
+func boundaryGapAxisPositionsF(painterWidth int, boundaryGap bool, xDivideCount int) []float64 {
+	width := float64(painterWidth)
+	if boundaryGap {
+		if xDivideCount <= 0 {
+			return []float64{}
+		}
+		unit := width / float64(xDivideCount)
+		xValues := make([]float64, xDivideCount)
+		for i := range xValues {
+			xValues[i] = (float64(i) + 0.5) * unit
+		}
+		return xValues
+	}
+	switch xDivideCount {
+	case 0:
+		return []float64{}
+	case 1:
+		return []float64{width}
+	}
+	unit := width / float64(xDivideCount-1)
+	xValues := make([]float64, xDivideCount)
+	for i := range xValues {
+		xValues[i] = float64(i) * unit
+	}
+	return xValues
+}
+
 func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 	p := l.p
 	opt := l.opt
@@ -127,6 +203,7 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 		boundaryGap = false
 	}
 	xValues := boundaryGapAxisPositions(seriesPainter.Width(), boundaryGap, xDivideCount)
+	xValuesF := boundaryGapAxisPositionsF(seriesPainter.Width(), boundaryGap, xDivideCount)
 	dataCount := getSeriesMaxDataCount(opt.SeriesList)
 	// accumulatedValues is used for stacking: it holds the summed data values at each X index
 	var accumulatedValues []float64
@@ -157,7 +234,7 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 	rendererList := []renderer{markPointPainter, markLinePainter, trendLinePainter}
 
 	seriesNames := opt.SeriesList.names()
-	var priorSeriesPoints []Point
+	var priorSeriesPoints []PointF
 	for index, series := range opt.SeriesList {
 		stackSeries := stackedSeries && series.YAxisIndex == 0
 		seriesThemeIndex := index
@@ -166,7 +243,24 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 		}
 		seriesColor := opt.Theme.GetSeriesColor(seriesThemeIndex)
 		yRange := result.yaxisRanges[series.YAxisIndex]
-		points := make([]Point, len(series.Values))
+		stepMode := opt.StepMode
+		if series.StepMode != "" {
+			stepMode = series.StepMode
+		}
+		seriesStrokeWidth := strokeWidth
+		if series.LineStrokeWidth > 0 {
+			seriesStrokeWidth = series.LineStrokeWidth
+		}
+		lineColor := seriesColor
+		if series.LineOpacity > 0 {
+			lineColor = seriesColor.WithAlpha(series.LineOpacity)
+		}
+		fillArea := (series.YAxisIndex == 0 && fillAreaY0) || fillAreaY1
+		if series.FillArea != nil {
+			fillArea = *series.FillArea
+		}
+		points := make([]Point, len(series.Values))   // used for labels and mark points
+		pointsF := make([]PointF, len(series.Values)) // used for drawing the line
 		var labelPainter *seriesLabelPainter
 		if flagIs(true, series.Label.Show) {
 			labelPainter = newSeriesLabelPainter(seriesPainter, seriesNames, series.Label, opt.Theme, opt.Padding.Right)
@@ -176,16 +270,20 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 		for i, item := range series.Values {
 			if item == GetNullValue() {
 				points[i] = Point{X: xValues[i], Y: math.MaxInt32}
-			} else if stackSeries {
-				accumulatedValues[i] += item
-				points[i] = Point{
-					X: xValues[i],
-					Y: yRange.getRestHeight(accumulatedValues[i]),
-				}
+				pointsF[i] = PointF{X: xValuesF[i], Y: math.MaxInt32}
 			} else {
+				value := item
+				if stackSeries {
+					accumulatedValues[i] += item
+					value = accumulatedValues[i]
+				}
 				points[i] = Point{
 					X: xValues[i],
-					Y: yRange.getRestHeight(item),
+					Y: yRange.getRestHeight(value),
+				}
+				pointsF[i] = PointF{
+					X: xValuesF[i],
+					Y: yRange.getRestHeightF(value),
 				}
 			}
 
@@ -199,9 +297,14 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 			}
 		}
 
-		if (series.YAxisIndex == 0 && fillAreaY0) || fillAreaY1 {
-			areaPoints := make([]Point, len(points))
-			copy(areaPoints, points)
+		// linePoints are the rendered line vertices, which include the data points
+		linePoints := stepLinePoints(pointsF, stepMode)
+		smoothLine := opt.StrokeSmoothingTension > 0 && len(linePoints) == len(points)
+
+		seriesPainter.startSeriesGroup(series.Name)
+		if fillArea {
+			areaPoints := make([]PointF, len(linePoints))
+			copy(areaPoints, linePoints)
 			for i, p := range areaPoints {
 				if p.Y != math.MaxInt32 {
 					if i > 0 {
@@ -210,7 +313,7 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 					break
 				}
 			}
-			bottomY := yRange.getRestHeight(yRange.min)
+			bottomY := yRange.getRestHeightF(yRange.min)
 			if stackSeries && len(priorSeriesPoints) > 0 {
 				// Fill between current line (areaPoints) and priorSeriesPoints
 				for i := len(priorSeriesPoints) - 1; i >= 0; i-- {
@@ -221,10 +324,10 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 			} else {
 				// Not stacked or first stacked series: fill down to bottom and then back to the first point
 				areaPoints = append(areaPoints,
-					Point{
+					PointF{
 						X: areaPoints[len(areaPoints)-1].X,
 						Y: bottomY,
-					}, Point{
+					}, PointF{
 						X: areaPoints[0].X,
 						Y: bottomY,
 					},
@@ -233,24 +336,37 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 			}
 
 			var opacity uint8 = 200
-			if opt.FillOpacity > 0 {
+			if series.FillOpacity > 0 {
+				opacity = series.FillOpacity
+			} else if opt.FillOpacity > 0 {
 				opacity = opt.FillOpacity
 			}
 			fillColor := seriesColor.WithAlpha(opacity)
+			var fillGradient LinearGradient
+			if flagIs(true, series.FillGradient) || (series.FillGradient == nil && flagIs(true, opt.FillGradient)) {
+				fillGradient = NewVerticalGradient(fillColor, fillColor.WithAlpha(0))
+			}
 
 			// If smoothing is enabled, do a smooth fill (not currently supported for stacked series)
-			if !stackSeries && opt.StrokeSmoothingTension > 0 {
-				seriesPainter.smoothFillChartArea(areaPoints, opt.StrokeSmoothingTension, fillColor)
+			if !stackSeries && smoothLine {
+				seriesPainter.smoothFillChartArea(areaPoints, opt.StrokeSmoothingTension, fillColor, fillGradient)
 			} else {
-				seriesPainter.FillArea(areaPoints, fillColor)
+				seriesPainter.fillArea(areaPoints, fillColor, fillGradient)
 			}
 		}
 
 		// Draw the line
-		if opt.StrokeSmoothingTension > 0 {
-			seriesPainter.SmoothLineStroke(points, opt.StrokeSmoothingTension, seriesColor, strokeWidth)
+		if len(series.StrokeDashArray) > 0 {
+			if smoothLine {
+				seriesPainter.SmoothDashedLineStrokeF(linePoints, opt.StrokeSmoothingTension, lineColor, seriesStrokeWidth,
+					series.StrokeDashArray)
+			} else {
+				seriesPainter.DashedLineStrokeF(linePoints, lineColor, seriesStrokeWidth, series.StrokeDashArray)
+			}
+		} else if smoothLine {
+			seriesPainter.SmoothLineStrokeF(linePoints, opt.StrokeSmoothingTension, lineColor, seriesStrokeWidth)
 		} else {
-			seriesPainter.LineStroke(points, seriesColor, strokeWidth)
+			seriesPainter.LineStrokeF(linePoints, lineColor, seriesStrokeWidth)
 		}
 
 		// Draw symbols if enabled
@@ -258,32 +374,49 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 		if series.Symbol != "" {
 			seriesSymbol = series.Symbol
 		}
-		switch seriesSymbol {
-		case SymbolCircle:
-			radius := 1.2
-			if strokeWidth > 1 {
-				radius = strokeWidth * 1.2
-			}
-			seriesPainter.Dots(points, opt.Theme.GetBackgroundColor(), seriesColor, 1, radius)
-		case SymbolDot:
-			radius := 1.5
-			if strokeWidth > 1 {
-				radius = strokeWidth * 1.5
-			}
-			seriesPainter.Dots(points, seriesColor, seriesColor, 1, radius)
-		case SymbolSquare:
-			size := 2
-			if strokeWidth > 1 {
-				size = ceilFloatToInt(strokeWidth * 2.8)
+		// drawSymbols draws the symbols for the data points in the index range [start, end)
+		drawSymbols := func(start, end int) {
+			switch seriesSymbol {
+			case SymbolCircle:
+				radius := 1.2
+				if seriesStrokeWidth > 1 {
+					radius = seriesStrokeWidth * 1.2
+				}
+				seriesPainter.DotsF(pointsF[start:end], opt.Theme.GetBackgroundColor(), lineColor, 1, radius)
+			case SymbolDot:
+				radius := 1.5
+				if seriesStrokeWidth > 1 {
+					radius = seriesStrokeWidth * 1.5
+				}
+				seriesPainter.DotsF(pointsF[start:end], lineColor, lineColor, 1, radius)
+			case SymbolSquare:
+				size := 2.0
+				if seriesStrokeWidth > 1 {
+					size = seriesStrokeWidth * 2.8
+				}
+				seriesPainter.squares(pointsF[start:end], lineColor, lineColor, 1, size)
+			case SymbolDiamond:
+				size := 4.0
+				if seriesStrokeWidth > 1 {
+					size = seriesStrokeWidth * 4.0
+				}
+				seriesPainter.diamonds(pointsF[start:end], lineColor, lineColor, 1, size)
 			}
-			seriesPainter.squares(points, seriesColor, seriesColor, 1, size)
-		case SymbolDiamond:
-			size := 4
-			if strokeWidth > 1 {
-				size = ceilFloatToInt(strokeWidth * 4.0)
+		}
+		if seriesPainter.isGrouped() && seriesSymbol != SymbolNone {
+			valueFormatter := getPreferredValueFormatter(series.Label.ValueFormatter, opt.ValueFormatter)
+			for i, item := range series.Values {
+				if item == GetNullValue() {
+					continue
+				}
+				seriesPainter.startValueGroup(series.Name, labelAt(opt.XAxis.Labels, i), item, valueFormatter)
+				drawSymbols(i, i+1)
+				seriesPainter.endGroup()
 			}
-			seriesPainter.diamonds(points, seriesColor, seriesColor, 1, size)
+		} else {
+			drawSymbols(0, len(points))
 		}
+		seriesPainter.endGroup()
 
 		var globalSeriesData []float64 // lazily initialized
 		if len(series.MarkLine.Lines) > 0 {
@@ -373,7 +506,7 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 		}
 
 		// Save these points as "priorSeriesPoints" for the next series to stack onto (if needed)
-		priorSeriesPoints = points
+		priorSeriesPoints = linePoints
 	}
 
 	if err := doRender(rendererList...); err != nil {
@@ -385,9 +518,17 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 func (l *lineChart) Render() (Box, error) {
 	p := l.p
 	opt := l.opt
+	if opt.LabelLayout != nil {
+		p = p.Child(painterLabelLayoutOption(*opt.LabelLayout))
+	}
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		var visible []int
+		visible, opt.Theme = opt.Legend.hideSeries(opt.SeriesList, opt.Theme, opt.ValueFormatter)
+		opt.SeriesList = selectSeriesIndexes(opt.SeriesList, visible)
+	}
 	// boundary gap default must be set here as it's used by the x-axis as well
 	if opt.XAxis.BoundaryGap == nil {
 		fillArea := flagIs(true, opt.StackSeries) // fill area default based on StackedSeries state
@@ -422,5 +563,9 @@ func (l *lineChart) Render() (Box, error) {
 	if err != nil {
 		return BoxZero, err
 	}
-	return l.renderChart(renderResult)
+	box, err := l.renderChart(renderResult)
+	if err == nil && opt.LabelLayout != nil {
+		p.labelLayout.render()
+	}
+	return box, err
 }
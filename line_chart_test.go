@@ -1,7 +1,11 @@
 package charts
 
 import (
+	"bytes"
+	"image/png"
+	"math"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -145,7 +149,7 @@ func TestLineChart(t *testing.T) {
 			name:        "basic_themed",
 			themed:      true,
 			makeOptions: makeFullLineChartOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 21 35\nL 51 35\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"36\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"53\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 112 35\nL 142 35\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"127\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"144\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 235 35\nL 265 35\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:none\"/><circle cx=\"250\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:rgb(100,180,210)\"/><text x=\"267\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 357 35\nL 387 35\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:none\"/><circle cx=\"372\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:rgb(64,160,110)\"/><text x=\"389\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 450 35\nL 480 35\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:none\"/><circle cx=\"465\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:rgb(154,96,180)\"/><text x=\"482\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><text x=\"9\" y=\"63\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"96\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"130\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"164\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"198\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"232\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"266\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"300\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"334\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 57\nL 590 57\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 91\nL 590 91\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 125\nL 590 125\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 159\nL 590 159\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 193\nL 590 193\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 227\nL 590 227\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 261\nL 590 261\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 295\nL 590 295\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 329\nL 590 329\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><text x=\"81\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"159\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"233\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"311\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"391\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"465\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"539\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 96 339\nL 172 336\nL 248 343\nL 324 336\nL 400 345\nL 476 315\nL 552 320\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"96\" cy=\"339\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"336\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"343\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"336\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"345\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"315\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"320\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><path d=\"M 96 318\nL 172 326\nL 248 324\nL 324 315\nL 400 303\nL 476 294\nL 552 298\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"96\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"326\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"324\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"315\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"303\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"294\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"298\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><path d=\"M 96 333\nL 172 315\nL 248 322\nL 324 332\nL 400 324\nL 476 294\nL 552 277\" style=\"stroke-width:2;stroke:rgb(100,180,210);fill:none\"/><circle cx=\"96\" cy=\"333\" r=\"2\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"315\" r=\"2\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"322\" r=\"2\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"324\" r=\"2\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"294\" r=\"2\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"277\" r=\"2\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><path d=\"M 96 296\nL 172 294\nL 248 300\nL 324 293\nL 400 281\nL 476 294\nL 552 296\" style=\"stroke-width:2;stroke:rgb(64,160,110);fill:none\"/><circle cx=\"96\" cy=\"296\" r=\"2\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"294\" r=\"2\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"300\" r=\"2\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"293\" r=\"2\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"281\" r=\"2\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"294\" r=\"2\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"296\" r=\"2\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><path d=\"M 96 190\nL 172 166\nL 248 172\nL 324 165\nL 400 89\nL 476 81\nL 552 83\" style=\"stroke-width:2;stroke:rgb(154,96,180);fill:none\"/><circle cx=\"96\" cy=\"190\" r=\"2\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"166\" r=\"2\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"172\" r=\"2\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"165\" r=\"2\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"89\" r=\"2\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"81\" r=\"2\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"83\" r=\"2\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 21 35\nL 51 35\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"36\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"53\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 112 35\nL 142 35\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"127\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"144\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 235 35\nL 265 35\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:none\"/><circle cx=\"250\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:rgb(100,180,210)\"/><text x=\"267\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 357 35\nL 387 35\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:none\"/><circle cx=\"372\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:rgb(64,160,110)\"/><text x=\"389\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 450 35\nL 480 35\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:none\"/><circle cx=\"465\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:rgb(154,96,180)\"/><text x=\"482\" y=\"41\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><text x=\"9\" y=\"63\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"96\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"130\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"164\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"198\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"232\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"266\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"300\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"334\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 57\nL 590 57\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 91\nL 590 91\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 125\nL 590 125\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 159\nL 590 159\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 193\nL 590 193\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 227\nL 590 227\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 261\nL 590 261\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 295\nL 590 295\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 54 329\nL 590 329\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(185,184,206);fill:none\"/><text x=\"81\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"159\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"233\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"311\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"391\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"465\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"539\" y=\"390\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 96 338.42\nL 172 335.86\nL 248 342.47\nL 324 335.43\nL 400 344.81\nL 476 314.97\nL 552 319.23\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"96\" cy=\"338.42\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"335.86\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"342.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"335.43\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"344.81\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"314.97\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"319.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:rgb(40,40,40)\"/><path d=\"M 96 317.1\nL 172 325.2\nL 248 323.28\nL 324 314.11\nL 400 302.17\nL 476 293.65\nL 552 297.91\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"96\" cy=\"317.1\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"325.2\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"323.28\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"314.11\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"302.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"293.65\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"297.91\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:rgb(40,40,40)\"/><path d=\"M 96 332.02\nL 172 314.54\nL 248 321.15\nL 324 331.17\nL 400 323.49\nL 476 293.65\nL 552 276.59\" style=\"stroke-width:2;stroke:rgb(100,180,210);fill:none\"/><circle cx=\"96\" cy=\"332.02\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"314.54\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"321.15\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"331.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"323.49\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"293.65\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"276.59\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:rgb(40,40,40)\"/><path d=\"M 96 295.78\nL 172 293.22\nL 248 299.83\nL 324 292.79\nL 400 280.85\nL 476 293.65\nL 552 295.78\" style=\"stroke-width:2;stroke:rgb(64,160,110);fill:none\"/><circle cx=\"96\" cy=\"295.78\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"293.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"299.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"292.79\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"280.85\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"293.65\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"295.78\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:rgb(40,40,40)\"/><path d=\"M 96 189.18\nL 172 165.3\nL 248 171.91\nL 324 164.88\nL 400 88.98\nL 476 80.45\nL 552 82.58\" style=\"stroke-width:2;stroke:rgb(154,96,180);fill:none\"/><circle cx=\"96\" cy=\"189.18\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"172\" cy=\"165.3\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"248\" cy=\"171.91\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"324\" cy=\"164.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"400\" cy=\"88.98\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"476\" cy=\"80.45\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/><circle cx=\"552\" cy=\"82.58\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:rgb(40,40,40)\"/></svg>",
 			pngCRC:      0xad65d23b,
 		},
 		{
@@ -158,7 +162,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 146 369\nL 146 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 235 369\nL 235 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 324 369\nL 324 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 412 369\nL 412 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 501 369\nL 501 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"57\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"145\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"234\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"323\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"411\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"500\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"563\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 58 335\nL 146 332\nL 235 340\nL 324 332\nL 412 342\nL 501 308\nL 590 313\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"58\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"146\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"235\" cy=\"340\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"412\" cy=\"342\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"501\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"590\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 58 310\nL 146 320\nL 235 318\nL 324 307\nL 412 293\nL 501 283\nL 590 288\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"58\" cy=\"310\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"146\" cy=\"320\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"235\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"307\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"412\" cy=\"293\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"501\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"590\" cy=\"288\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 58 328\nL 146 307\nL 235 315\nL 324 327\nL 412 318\nL 501 283\nL 590 264\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"58\" cy=\"328\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"146\" cy=\"307\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"235\" cy=\"315\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"324\" cy=\"327\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"412\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"501\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"590\" cy=\"264\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 58 286\nL 146 283\nL 235 291\nL 324 282\nL 412 269\nL 501 283\nL 590 286\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"58\" cy=\"286\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"146\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"235\" cy=\"291\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"324\" cy=\"282\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"412\" cy=\"269\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"501\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"590\" cy=\"286\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><path d=\"M 58 163\nL 146 135\nL 235 143\nL 324 135\nL 412 47\nL 501 38\nL 590 40\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"58\" cy=\"163\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"146\" cy=\"135\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"235\" cy=\"143\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"324\" cy=\"135\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"412\" cy=\"47\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"501\" cy=\"38\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"590\" cy=\"40\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 146 369\nL 146 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 235 369\nL 235 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 324 369\nL 324 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 412 369\nL 412 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 501 369\nL 501 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"57\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"145\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"234\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"323\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"411\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"500\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"563\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 58 334.5\nL 146.67 331.55\nL 235.33 339.17\nL 324 331.06\nL 412.67 341.88\nL 501.33 307.46\nL 590 312.38\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"58\" cy=\"334.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"146.67\" cy=\"331.55\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"235.33\" cy=\"339.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"331.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"412.67\" cy=\"341.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"501.33\" cy=\"307.46\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"590\" cy=\"312.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 58 309.92\nL 146.67 319.26\nL 235.33 317.05\nL 324 306.48\nL 412.67 292.71\nL 501.33 282.88\nL 590 287.79\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"58\" cy=\"309.92\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"146.67\" cy=\"319.26\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"235.33\" cy=\"317.05\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"306.48\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"412.67\" cy=\"292.71\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"501.33\" cy=\"282.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"590\" cy=\"287.79\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 58 327.12\nL 146.67 306.97\nL 235.33 314.59\nL 324 326.14\nL 412.67 317.29\nL 501.33 282.88\nL 590 263.21\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"58\" cy=\"327.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"146.67\" cy=\"306.97\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"235.33\" cy=\"314.59\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"324\" cy=\"326.14\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"412.67\" cy=\"317.29\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"501.33\" cy=\"282.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"590\" cy=\"263.21\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 58 285.33\nL 146.67 282.38\nL 235.33 290\nL 324 281.89\nL 412.67 268.12\nL 501.33 282.88\nL 590 285.33\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"58\" cy=\"285.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"146.67\" cy=\"282.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"235.33\" cy=\"290\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"324\" cy=\"281.89\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"412.67\" cy=\"268.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"501.33\" cy=\"282.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"590\" cy=\"285.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><path d=\"M 58 162.42\nL 146.67 134.88\nL 235.33 142.5\nL 324 134.39\nL 412.67 46.88\nL 501.33 37.04\nL 590 39.5\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"58\" cy=\"162.42\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"146.67\" cy=\"134.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"235.33\" cy=\"142.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"324\" cy=\"134.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"412.67\" cy=\"46.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"501.33\" cy=\"37.04\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"590\" cy=\"39.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/></svg>",
 			pngCRC: 0x6eca1d2,
 		},
 		{
@@ -168,7 +172,7 @@ func TestLineChart(t *testing.T) {
 				opt.XAxis.BoundaryGap = Ptr(true)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\nL 324 355\nL 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\nL 324 354.64\nL 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xff04a0c2,
 		},
 		{
@@ -183,7 +187,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4k</text><text x=\"22\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"12\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600</text><text x=\"12\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 45 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 64\nL 590 64\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 118\nL 590 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 172\nL 590 172\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 227\nL 590 227\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 281\nL 590 281\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 335\nL 590 335\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 87 358\nL 164 355\nL 241 363\nL 319 354\nL 396 366\nL 473 328\nL 551 333\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 87 168\nL 164 138\nL 241 146\nL 319 137\nL 396 40\nL 473 29\nL 551 32\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4k</text><text x=\"22\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"12\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600</text><text x=\"12\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 45 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 64\nL 590 64\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 118\nL 590 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 172\nL 590 172\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 227\nL 590 227\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 281\nL 590 281\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 335\nL 590 335\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 87.64 357.43\nL 164.93 354.17\nL 242.21 362.59\nL 319.5 353.63\nL 396.79 365.57\nL 474.07 327.57\nL 551.36 333\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 87.64 167.43\nL 164.93 137.03\nL 242.21 145.44\nL 319.5 136.49\nL 396.79 39.86\nL 474.07 29\nL 551.36 31.71\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xc4b6c46e,
 		},
 		{
@@ -198,7 +202,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"110\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.08k</text><text x=\"21\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720</text><text x=\"21\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 57\nL 590 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 105\nL 590 105\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 247\nL 590 247\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 295\nL 590 295\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 342\nL 590 342\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\nL 324 355\nL 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"110\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.08k</text><text x=\"21\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720</text><text x=\"21\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 57\nL 590 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 105\nL 590 105\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 247\nL 590 247\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 295\nL 590 295\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 342\nL 590 342\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\nL 324 354.64\nL 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x4be7d06b,
 		},
 		{
@@ -213,7 +217,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4k</text><text x=\"12\" y=\"178\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"12\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 45 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 64\nL 590 64\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 118\nL 590 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 172\nL 590 172\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 227\nL 590 227\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 281\nL 590 281\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 335\nL 590 335\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 87 358\nL 164 355\nL 241 363\nL 319 354\nL 396 366\nL 473 328\nL 551 333\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 87 168\nL 164 138\nL 241 146\nL 319 137\nL 396 40\nL 473 29\nL 551 32\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4k</text><text x=\"12\" y=\"178\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"12\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 45 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 64\nL 590 64\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 118\nL 590 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 172\nL 590 172\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 227\nL 590 227\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 281\nL 590 281\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 335\nL 590 335\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 87.64 357.43\nL 164.93 354.17\nL 242.21 362.59\nL 319.5 353.63\nL 396.79 365.57\nL 474.07 327.57\nL 551.36 333\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 87.64 167.43\nL 164.93 137.03\nL 242.21 145.44\nL 319.5 136.49\nL 396.79 39.86\nL 474.07 29\nL 551.36 31.71\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x11756954,
 		},
 		{
@@ -228,7 +232,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"21\" y=\"157\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">900</text><text x=\"21\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 57\nL 590 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 105\nL 590 105\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 247\nL 590 247\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 295\nL 590 295\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 342\nL 590 342\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\nL 324 355\nL 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"21\" y=\"157\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">900</text><text x=\"21\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 57\nL 590 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 105\nL 590 105\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 247\nL 590 247\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 295\nL 590 295\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 342\nL 590 342\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\nL 324 354.64\nL 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x40214911,
 		},
 		{
@@ -243,7 +247,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\nL 324 355\nL 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\nL 324 354.64\nL 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x3d920ed,
 		},
 		{
@@ -258,7 +262,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4k</text><text x=\"12\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 45 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 64\nL 590 64\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 118\nL 590 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 172\nL 590 172\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 227\nL 590 227\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 281\nL 590 281\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 335\nL 590 335\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 87 358\nL 164 355\nL 241 363\nL 319 354\nL 396 366\nL 473 328\nL 551 333\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 87 168\nL 164 138\nL 241 146\nL 319 137\nL 396 40\nL 473 29\nL 551 32\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4k</text><text x=\"12\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 45 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 64\nL 590 64\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 118\nL 590 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 172\nL 590 172\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 227\nL 590 227\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 281\nL 590 281\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 335\nL 590 335\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 87.64 357.43\nL 164.93 354.17\nL 242.21 362.59\nL 319.5 353.63\nL 396.79 365.57\nL 474.07 327.57\nL 551.36 333\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 87.64 167.43\nL 164.93 137.03\nL 242.21 145.44\nL 319.5 136.49\nL 396.79 39.86\nL 474.07 29\nL 551.36 31.71\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x1ac94fa1,
 		},
 		{
@@ -273,7 +277,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"21\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 57\nL 590 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 105\nL 590 105\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 247\nL 590 247\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 295\nL 590 295\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 342\nL 590 342\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\nL 324 355\nL 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"21\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 57\nL 590 57\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 105\nL 590 105\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 247\nL 590 247\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 295\nL 590 295\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 342\nL 590 342\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\nL 324 354.64\nL 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x2424201c,
 		},
 		{
@@ -288,7 +292,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\nL 324 355\nL 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\nL 324 354.64\nL 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x377f49de,
 		},
 		{
@@ -303,7 +307,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"18\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4k</text><text x=\"21\" y=\"167\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">840</text><text x=\"21\" y=\"318\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">280</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 48\nL 590 48\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 86\nL 590 86\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 124\nL 590 124\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 162\nL 590 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 238\nL 590 238\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 276\nL 590 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 314\nL 590 314\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 352\nL 590 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358\nL 172 355\nL 248 363\nL 324 354\nL 400 366\nL 476 328\nL 552 333\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 168\nL 172 138\nL 248 146\nL 324 137\nL 400 40\nL 476 29\nL 552 32\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"18\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4k</text><text x=\"21\" y=\"167\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">840</text><text x=\"21\" y=\"318\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">280</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 48\nL 590 48\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 86\nL 590 86\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 124\nL 590 124\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 162\nL 590 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 238\nL 590 238\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 276\nL 590 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 314\nL 590 314\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 352\nL 590 352\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 357.43\nL 172 354.17\nL 248 362.59\nL 324 353.63\nL 400 365.57\nL 476 327.57\nL 552 333\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 167.43\nL 172 137.03\nL 248 145.44\nL 324 136.49\nL 400 39.86\nL 476 29\nL 552 31.71\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x12fda160,
 		},
 		{
@@ -318,7 +322,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.5k</text><text x=\"22\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"12\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">500</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 87 360\nL 164 357\nL 241 365\nL 319 357\nL 396 368\nL 473 332\nL 551 337\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 87 183\nL 164 154\nL 241 162\nL 319 154\nL 396 64\nL 473 54\nL 551 56\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.5k</text><text x=\"22\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"12\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">500</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 87.64 359.6\nL 164.93 356.56\nL 242.21 364.41\nL 319.5 356.05\nL 396.79 367.2\nL 474.07 331.73\nL 551.36 336.8\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 87.64 182.27\nL 164.93 153.89\nL 242.21 161.75\nL 319.5 153.39\nL 396.79 63.2\nL 474.07 53.07\nL 551.36 55.6\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x3bcddb42,
 		},
 		{
@@ -333,7 +337,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 49 10\nL 49 390\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 44 10\nL 49 10\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 44 136\nL 49 136\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 44 263\nL 49 263\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 44 390\nL 49 390\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.5k</text><text x=\"22\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"12\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">500</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 45 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 88 360\nL 165 357\nL 242 365\nL 319 357\nL 396 368\nL 473 332\nL 551 337\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 88 183\nL 165 154\nL 242 162\nL 319 154\nL 396 64\nL 473 54\nL 551 56\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 49 10\nL 49 390\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 44 10\nL 49 10\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 44 136\nL 49 136\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 44 263\nL 49 263\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 44 390\nL 49 390\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.5k</text><text x=\"22\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"12\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">500</text><text x=\"30\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 45 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 45 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 88.57 359.6\nL 165.71 356.56\nL 242.86 364.41\nL 320 356.05\nL 397.14 367.2\nL 474.29 331.73\nL 551.43 336.8\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 88.57 182.27\nL 165.71 153.89\nL 242.86 161.75\nL 320 153.39\nL 397.14 63.2\nL 474.29 53.07\nL 551.43 55.6\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x54b19a85,
 		},
 		{
@@ -350,7 +354,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"552\" y=\"16\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.43k</text><text x=\"552\" y=\"55\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.36k</text><text x=\"552\" y=\"94\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.29k</text><text x=\"552\" y=\"133\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.22k</text><text x=\"552\" y=\"172\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.15k</text><text x=\"552\" y=\"211\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.08k</text><text x=\"552\" y=\"250\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.01k</text><text x=\"552\" y=\"289\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">940</text><text x=\"552\" y=\"328\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">870</text><text x=\"552\" y=\"368\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">234</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">218</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">202</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">186</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">170</text><text x=\"9\" y=\"211\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">154</text><text x=\"9\" y=\"250\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">138</text><text x=\"9\" y=\"289\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"9\" y=\"328\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106</text><text x=\"18\" y=\"368\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 542 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 49\nL 542 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 88\nL 542 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 128\nL 542 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 167\nL 542 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 206\nL 542 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 246\nL 542 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 285\nL 542 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 324\nL 542 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 364\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 369\nL 46 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 116 369\nL 116 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 369\nL 187 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 258 369\nL 258 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 329 369\nL 329 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 400 369\nL 400 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 471 369\nL 471 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 542 369\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"76\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"146\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"217\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"288\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"360\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"431\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"501\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 81 291\nL 151 261\nL 222 337\nL 293 256\nL 364 364\nL 435 20\nL 506 69\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"81\" cy=\"291\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"151\" cy=\"261\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"222\" cy=\"337\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"293\" cy=\"256\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"364\" cy=\"364\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"435\" cy=\"20\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"506\" cy=\"69\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 81 353\nL 151 290\nL 222 308\nL 293 289\nL 364 89\nL 435 67\nL 506 72\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"81\" cy=\"353\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"151\" cy=\"290\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"222\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"293\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"364\" cy=\"89\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"435\" cy=\"67\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"506\" cy=\"72\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"552\" y=\"16\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.43k</text><text x=\"552\" y=\"55\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.36k</text><text x=\"552\" y=\"94\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.29k</text><text x=\"552\" y=\"133\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.22k</text><text x=\"552\" y=\"172\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.15k</text><text x=\"552\" y=\"211\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.08k</text><text x=\"552\" y=\"250\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.01k</text><text x=\"552\" y=\"289\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">940</text><text x=\"552\" y=\"328\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">870</text><text x=\"552\" y=\"368\" style=\"stroke:none;fill:rgb(145,204,117);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">234</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">218</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">202</text><text x=\"9\" y=\"133\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">186</text><text x=\"9\" y=\"172\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">170</text><text x=\"9\" y=\"211\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">154</text><text x=\"9\" y=\"250\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">138</text><text x=\"9\" y=\"289\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"9\" y=\"328\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106</text><text x=\"18\" y=\"368\" style=\"stroke:none;fill:rgb(84,112,198);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 42 10\nL 542 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 49\nL 542 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 88\nL 542 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 128\nL 542 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 167\nL 542 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 206\nL 542 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 246\nL 542 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 285\nL 542 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 324\nL 542 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 46 364\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 46 369\nL 46 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 116 369\nL 116 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 369\nL 187 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 258 369\nL 258 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 329 369\nL 329 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 400 369\nL 400 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 471 369\nL 471 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 542 369\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"76\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"146\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"217\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"288\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"360\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"431\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"501\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 81.43 290.25\nL 152.29 260.75\nL 223.14 336.96\nL 294 255.83\nL 364.86 364\nL 435.71 19.83\nL 506.57 69\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"81.43\" cy=\"290.25\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"152.29\" cy=\"260.75\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"223.14\" cy=\"336.96\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"294\" cy=\"255.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"364.86\" cy=\"364\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"435.71\" cy=\"19.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"506.57\" cy=\"69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 81.43 352.76\nL 152.29 289.83\nL 223.14 307.25\nL 294 288.7\nL 364.86 88.67\nL 435.71 66.19\nL 506.57 71.81\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"81.43\" cy=\"352.76\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"152.29\" cy=\"289.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"223.14\" cy=\"307.25\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"294\" cy=\"288.7\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"364.86\" cy=\"88.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"435.71\" cy=\"66.19\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"506.57\" cy=\"71.81\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x4a285a46,
 		},
 		{
@@ -364,7 +368,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"552\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"552\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"552\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"552\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"552\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"552\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"552\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"552\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"552\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"552\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 10 10\nL 542 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 49\nL 542 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 88\nL 542 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 128\nL 542 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 167\nL 542 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 206\nL 542 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 246\nL 542 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 285\nL 542 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 324\nL 542 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 364\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 369\nL 10 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 86 369\nL 86 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 162 369\nL 162 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 238 369\nL 238 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 314 369\nL 314 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 390 369\nL 390 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 466 369\nL 466 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 542 369\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"43\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"119\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"195\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"271\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"348\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"424\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"499\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 48 335\nL 124 332\nL 200 340\nL 276 332\nL 352 342\nL 428 308\nL 504 313\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"48\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"124\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"200\" cy=\"340\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"276\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"352\" cy=\"342\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"428\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"504\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 48 163\nL 124 135\nL 200 143\nL 276 135\nL 352 47\nL 428 38\nL 504 40\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"48\" cy=\"163\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"124\" cy=\"135\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"200\" cy=\"143\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"276\" cy=\"135\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"352\" cy=\"47\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"428\" cy=\"38\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"504\" cy=\"40\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"552\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"552\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"552\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"552\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"552\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"552\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"552\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"552\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"552\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"552\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 10 10\nL 542 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 49\nL 542 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 88\nL 542 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 128\nL 542 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 167\nL 542 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 206\nL 542 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 246\nL 542 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 285\nL 542 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 324\nL 542 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 10 364\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 369\nL 10 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 86 369\nL 86 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 162 369\nL 162 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 238 369\nL 238 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 314 369\nL 314 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 390 369\nL 390 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 466 369\nL 466 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 542 369\nL 542 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"43\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"119\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"195\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"271\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"348\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"424\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"499\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 48 334.5\nL 124 331.55\nL 200 339.17\nL 276 331.06\nL 352 341.88\nL 428 307.46\nL 504 312.38\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"48\" cy=\"334.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"124\" cy=\"331.55\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"200\" cy=\"339.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"276\" cy=\"331.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"352\" cy=\"341.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"428\" cy=\"307.46\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"504\" cy=\"312.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 48 162.42\nL 124 134.88\nL 200 142.5\nL 276 134.39\nL 352 46.88\nL 428 37.04\nL 504 39.5\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"48\" cy=\"162.42\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"124\" cy=\"134.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"200\" cy=\"142.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"276\" cy=\"134.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"352\" cy=\"46.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"428\" cy=\"37.04\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"504\" cy=\"39.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0xa0cf8dff,
 		},
 		{
@@ -378,7 +382,7 @@ func TestLineChart(t *testing.T) {
 				opt.SeriesList = NewSeriesListLine(values)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 24 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 24 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 68 390\nL 148 390\nL 228 390\nL 308 390\nL 389 390\nL 469 390\nL 549 390\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 68 390\nL 148 390\nL 228 390\nL 308 390\nL 389 390\nL 469 390\nL 549 390\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 24 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 24 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 68.14 390\nL 148.43 390\nL 228.71 390\nL 309 390\nL 389.29 390\nL 469.57 390\nL 549.86 390\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 68.14 390\nL 148.43 390\nL 228.71 390\nL 309 390\nL 389.29 390\nL 469.57 390\nL 549.86 390\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x58ea4a38,
 		},
 		{
@@ -392,7 +396,7 @@ func TestLineChart(t *testing.T) {
 				opt.SeriesList = NewSeriesListLine(values)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"22\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"91\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.6</text><text x=\"9\" y=\"167\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.2</text><text x=\"9\" y=\"242\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.8</text><text x=\"9\" y=\"318\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.4</text><text x=\"22\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 37 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 37 86\nL 590 86\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 37 162\nL 590 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 37 238\nL 590 238\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 37 314\nL 590 314\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 80 371\nL 158 352\nL 236 371\nL 315 352\nL 393 314\nL 472 352\nL 550 371\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 80 352\nL 158 314\nL 236 238\nL 315 314\nL 393 352\nL 472 371\nL 550 352\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"22\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"91\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.6</text><text x=\"9\" y=\"167\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.2</text><text x=\"9\" y=\"242\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.8</text><text x=\"9\" y=\"318\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0.4</text><text x=\"22\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 37 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 37 86\nL 590 86\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 37 162\nL 590 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 37 238\nL 590 238\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 37 314\nL 590 314\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 80.21 371\nL 158.64 352\nL 237.07 371\nL 315.5 352\nL 393.93 314\nL 472.36 352\nL 550.79 371\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 80.21 352\nL 158.64 314\nL 237.07 238\nL 315.5 314\nL 393.93 352\nL 472.36 371\nL 550.79 352\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xd20c1dd3,
 		},
 		{
@@ -405,7 +409,7 @@ func TestLineChart(t *testing.T) {
 				opt.Title.FontStyle = customFont
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"16\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 127 19\nL 157 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"142\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"159\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 192 19\nL 222 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"207\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"224\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 268 19\nL 298 19\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"283\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"300\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 344 19\nL 374 19\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"359\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"376\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 410 19\nL 440 19\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"425\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"442\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><text x=\"9\" y=\"42\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"78\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"114\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"151\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"187\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"223\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"260\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"296\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"369\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 36\nL 590 36\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 72\nL 590 72\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 109\nL 590 109\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 145\nL 590 145\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 182\nL 590 182\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 218\nL 590 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 255\nL 590 255\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 291\nL 590 291\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 365\nL 590 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 370\nL 58 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 370\nL 134 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 370\nL 210 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 370\nL 286 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 370\nL 362 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 370\nL 438 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 370\nL 514 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 370\nL 590 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"168\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"243\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"320\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"397\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"472\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"548\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 96 338\nL 172 335\nL 248 342\nL 324 335\nL 400 345\nL 476 313\nL 552 318\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"338\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"342\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"345\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 315\nL 172 324\nL 248 322\nL 324 312\nL 400 299\nL 476 290\nL 552 295\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"315\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"324\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"322\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"312\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"299\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"290\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"295\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 96 331\nL 172 312\nL 248 320\nL 324 330\nL 400 322\nL 476 290\nL 552 272\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"96\" cy=\"331\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"172\" cy=\"312\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"248\" cy=\"320\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"324\" cy=\"330\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"400\" cy=\"322\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"476\" cy=\"290\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"552\" cy=\"272\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 96 292\nL 172 290\nL 248 297\nL 324 289\nL 400 276\nL 476 290\nL 552 292\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"96\" cy=\"292\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"172\" cy=\"290\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"248\" cy=\"297\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"324\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"400\" cy=\"276\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"476\" cy=\"290\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"552\" cy=\"292\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><path d=\"M 96 178\nL 172 153\nL 248 160\nL 324 152\nL 400 71\nL 476 62\nL 552 64\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"96\" cy=\"178\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"172\" cy=\"153\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"248\" cy=\"160\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"324\" cy=\"152\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"400\" cy=\"71\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"476\" cy=\"62\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"552\" cy=\"64\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"16\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 127 19\nL 157 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"142\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"159\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 192 19\nL 222 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"207\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"224\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 268 19\nL 298 19\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"283\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"300\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 344 19\nL 374 19\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"359\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"376\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 410 19\nL 440 19\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"425\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"442\" y=\"25\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><text x=\"9\" y=\"42\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"78\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"114\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"151\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"187\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"223\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"260\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"296\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"369\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 36\nL 590 36\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 72\nL 590 72\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 109\nL 590 109\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 145\nL 590 145\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 182\nL 590 182\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 218\nL 590 218\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 255\nL 590 255\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 291\nL 590 291\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 365\nL 590 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 370\nL 58 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 370\nL 134 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 370\nL 210 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 370\nL 286 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 370\nL 362 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 370\nL 438 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 370\nL 514 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 370\nL 590 365\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"168\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"243\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"320\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"397\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"472\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"548\" y=\"381\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 96 337.58\nL 172 334.84\nL 248 341.92\nL 324 334.38\nL 400 344.44\nL 476 312.45\nL 552 317.02\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"337.58\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"334.84\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"341.92\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"334.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"344.44\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"312.45\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"317.02\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 314.74\nL 172 323.42\nL 248 321.36\nL 324 311.54\nL 400 298.74\nL 476 289.6\nL 552 294.17\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"314.74\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"323.42\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"321.36\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"311.54\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"298.74\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"289.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"294.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 96 330.73\nL 172 311.99\nL 248 319.08\nL 324 329.82\nL 400 321.59\nL 476 289.6\nL 552 271.33\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"96\" cy=\"330.73\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"172\" cy=\"311.99\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"248\" cy=\"319.08\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"324\" cy=\"329.82\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"400\" cy=\"321.59\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"476\" cy=\"289.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"552\" cy=\"271.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 96 291.89\nL 172 289.15\nL 248 296.23\nL 324 288.69\nL 400 275.9\nL 476 289.6\nL 552 291.89\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"96\" cy=\"291.89\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"172\" cy=\"289.15\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"248\" cy=\"296.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"324\" cy=\"288.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"400\" cy=\"275.9\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"476\" cy=\"289.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"552\" cy=\"291.89\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><path d=\"M 96 177.65\nL 172 152.06\nL 248 159.15\nL 324 151.61\nL 400 70.27\nL 476 61.13\nL 552 63.42\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"96\" cy=\"177.65\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"172\" cy=\"152.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"248\" cy=\"159.15\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"324\" cy=\"151.61\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"400\" cy=\"70.27\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"476\" cy=\"61.13\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"552\" cy=\"63.42\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/></svg>",
 			pngCRC: 0xe26d9dc9,
 		},
 		{
@@ -416,7 +420,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.Offset = OffsetRight
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"286\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 490 19\nL 520 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"505\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"522\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 551 19\nL 581 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"566\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"583\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"122\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"157\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"262\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 46\nL 590 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 81\nL 590 81\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 116\nL 590 116\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 187\nL 590 187\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 222\nL 590 222\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 258\nL 590 258\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 293\nL 590 293\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 338\nL 172 335\nL 248 342\nL 324 335\nL 400 345\nL 476 314\nL 552 318\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"338\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"342\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"345\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"314\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 183\nL 172 159\nL 248 166\nL 324 158\nL 400 80\nL 476 71\nL 552 73\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"183\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"159\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"166\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"158\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"80\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"71\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"73\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"286\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 490 19\nL 520 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"505\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"522\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 551 19\nL 581 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"566\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"583\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"122\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"157\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"262\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 46\nL 590 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 81\nL 590 81\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 116\nL 590 116\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 187\nL 590 187\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 222\nL 590 222\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 258\nL 590 258\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 293\nL 590 293\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 337.5\nL 172 334.85\nL 248 341.7\nL 324 334.41\nL 400 344.12\nL 476 313.21\nL 552 317.62\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"337.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"334.85\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"341.7\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"334.41\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"344.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"313.21\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"317.62\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 182.92\nL 172 158.18\nL 248 165.03\nL 324 157.74\nL 400 79.12\nL 476 70.29\nL 552 72.5\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"182.92\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"158.18\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"165.03\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"157.74\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"79.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"70.29\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"72.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x8cbd4630,
 		},
 		{
@@ -426,7 +430,7 @@ func TestLineChart(t *testing.T) {
 				opt.Title.Offset = OffsetRight
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"561\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 19\nL 280 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"122\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"157\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"262\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 46\nL 590 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 81\nL 590 81\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 116\nL 590 116\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 187\nL 590 187\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 222\nL 590 222\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 258\nL 590 258\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 293\nL 590 293\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 338\nL 172 335\nL 248 342\nL 324 335\nL 400 345\nL 476 314\nL 552 318\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"338\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"342\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"345\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"314\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 183\nL 172 159\nL 248 166\nL 324 158\nL 400 80\nL 476 71\nL 552 73\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"183\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"159\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"166\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"158\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"80\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"71\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"73\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"561\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 19\nL 280 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"122\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"157\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"262\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 46\nL 590 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 81\nL 590 81\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 116\nL 590 116\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 152\nL 590 152\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 187\nL 590 187\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 222\nL 590 222\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 258\nL 590 258\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 293\nL 590 293\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 337.5\nL 172 334.85\nL 248 341.7\nL 324 334.41\nL 400 344.12\nL 476 313.21\nL 552 317.62\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"337.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"334.85\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"341.7\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"334.41\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"344.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"313.21\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"317.62\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 182.92\nL 172 158.18\nL 248 165.03\nL 324 157.74\nL 400 79.12\nL 476 70.29\nL 552 72.5\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"182.92\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"158.18\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"165.03\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"157.74\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"79.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"70.29\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"72.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x63fb45bf,
 		},
 		{
@@ -439,7 +443,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"286\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 19\nL 280 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"85\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"118\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"152\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"185\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"218\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"252\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"318\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 46\nL 590 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 79\nL 590 79\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 113\nL 590 113\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 146\nL 590 146\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 180\nL 590 180\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 213\nL 590 213\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 247\nL 590 247\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 280\nL 590 280\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 314\nL 590 314\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 348\nL 590 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 353\nL 58 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 353\nL 134 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 353\nL 210 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 353\nL 286 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 353\nL 362 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 353\nL 438 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 353\nL 514 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 353\nL 590 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 323\nL 172 321\nL 248 327\nL 324 320\nL 400 330\nL 476 300\nL 552 304\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"323\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"321\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"327\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"320\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"330\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"300\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"304\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 177\nL 172 153\nL 248 160\nL 324 153\nL 400 78\nL 476 70\nL 552 72\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"177\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"153\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"160\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"153\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"78\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"70\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"72\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"286\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 19\nL 280 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"85\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"118\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"152\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"185\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"218\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"252\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"318\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 46\nL 590 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 79\nL 590 79\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 113\nL 590 113\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 146\nL 590 146\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 180\nL 590 180\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 213\nL 590 213\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 247\nL 590 247\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 280\nL 590 280\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 314\nL 590 314\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 348\nL 590 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 353\nL 58 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 353\nL 134 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 353\nL 210 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 353\nL 286 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 353\nL 362 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 353\nL 438 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 353\nL 514 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 353\nL 590 348\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"374\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 322.83\nL 172 320.32\nL 248 326.82\nL 324 319.9\nL 400 329.12\nL 476 299.76\nL 552 303.96\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"322.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"320.32\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"326.82\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"319.9\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"329.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"299.76\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"303.96\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 176.03\nL 172 152.54\nL 248 159.04\nL 324 152.12\nL 400 77.46\nL 476 69.07\nL 552 71.17\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"176.03\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"152.54\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"159.04\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"152.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"77.46\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"69.07\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"71.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x984eeb5b,
 		},
 		{
@@ -451,7 +455,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 374\nL 280 374\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 374\nL 341 374\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"47\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"78\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"110\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"237\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"300\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 41\nL 590 41\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 72\nL 590 72\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 104\nL 590 104\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 168\nL 590 168\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 232\nL 590 232\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 264\nL 590 264\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 296\nL 590 296\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 333\nL 58 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 333\nL 134 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 333\nL 210 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 333\nL 286 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 333\nL 362 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 333\nL 438 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 333\nL 514 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 333\nL 590 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 305\nL 172 302\nL 248 308\nL 324 302\nL 400 311\nL 476 283\nL 552 287\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"305\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"302\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"302\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"311\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"287\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 165\nL 172 143\nL 248 149\nL 324 142\nL 400 71\nL 476 63\nL 552 65\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"165\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"143\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"149\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"142\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"71\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"63\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"65\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 374\nL 280 374\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 374\nL 341 374\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"47\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"78\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"110\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"237\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"300\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 41\nL 590 41\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 72\nL 590 72\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 104\nL 590 104\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 168\nL 590 168\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 200\nL 590 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 232\nL 590 232\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 264\nL 590 264\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 296\nL 590 296\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 333\nL 58 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 333\nL 134 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 333\nL 210 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 333\nL 286 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 333\nL 362 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 333\nL 438 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 333\nL 514 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 333\nL 590 328\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 304.08\nL 172 301.69\nL 248 307.87\nL 324 301.29\nL 400 310.06\nL 476 282.16\nL 552 286.15\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"304.08\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"301.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"307.87\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"301.29\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"310.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"282.16\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"286.15\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 164.57\nL 172 142.25\nL 248 148.43\nL 324 141.85\nL 400 70.9\nL 476 62.92\nL 552 64.92\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"164.57\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"142.25\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"148.43\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"141.85\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"70.9\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"62.92\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"64.92\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x9180746d,
 		},
 		{
@@ -464,7 +468,7 @@ func TestLineChart(t *testing.T) {
 				opt.YAxis[0].Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 64\nL 280 64\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"64\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"70\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 64\nL 341 64\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"64\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"70\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 190 136\nL 190 0\nL 410 0\nL 410 136\nL 190 136\" style=\"stroke-width:1;stroke:black;fill:none\"/><path d=\"M 10 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 369\nL 10 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 369\nL 92 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 175 369\nL 175 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 258 369\nL 258 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 341 369\nL 341 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 424 369\nL 424 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 507 369\nL 507 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"46\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"128\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"211\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"294\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"378\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"461\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"543\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 51 346\nL 133 344\nL 216 349\nL 299 344\nL 382 351\nL 465 329\nL 548 332\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51\" cy=\"346\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"133\" cy=\"344\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"216\" cy=\"349\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"299\" cy=\"344\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"382\" cy=\"351\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"465\" cy=\"329\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"548\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 51 238\nL 133 220\nL 216 225\nL 299 220\nL 382 165\nL 465 159\nL 548 160\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51\" cy=\"238\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"133\" cy=\"220\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"216\" cy=\"225\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"299\" cy=\"220\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"382\" cy=\"165\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"465\" cy=\"159\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"548\" cy=\"160\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 64\nL 280 64\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"64\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"70\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 64\nL 341 64\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"64\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"70\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 190 136\nL 190 0\nL 410 0\nL 410 136\nL 190 136\" style=\"stroke-width:1;stroke:black;fill:none\"/><path d=\"M 10 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 369\nL 10 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 369\nL 92 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 175 369\nL 175 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 258 369\nL 258 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 341 369\nL 341 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 424 369\nL 424 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 507 369\nL 507 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"46\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"128\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"211\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"294\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"378\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"461\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"543\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 51.43 345.42\nL 134.29 343.56\nL 217.14 348.36\nL 300 343.25\nL 382.86 350.06\nL 465.71 328.38\nL 548.57 331.48\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51.43\" cy=\"345.42\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"134.29\" cy=\"343.56\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"217.14\" cy=\"348.36\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"343.25\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"382.86\" cy=\"350.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"465.71\" cy=\"328.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"548.57\" cy=\"331.48\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 51.43 237.01\nL 134.29 219.67\nL 217.14 224.47\nL 300 219.36\nL 382.86 164.23\nL 465.71 158.03\nL 548.57 159.58\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51.43\" cy=\"237.01\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"134.29\" cy=\"219.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"217.14\" cy=\"224.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"219.36\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"382.86\" cy=\"164.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"465.71\" cy=\"158.03\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"548.57\" cy=\"159.58\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x397639c4,
 		},
 		{
@@ -480,7 +484,7 @@ func TestLineChart(t *testing.T) {
 				opt.YAxis[0].Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 324\nL 280 324\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"324\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"330\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 324\nL 341 324\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"324\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"330\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 190 396\nL 190 260\nL 410 260\nL 410 396\nL 190 396\" style=\"stroke-width:1;stroke:black;fill:none\"/><path d=\"M 10 233\nL 590 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 238\nL 10 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 238\nL 92 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 175 238\nL 175 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 258 238\nL 258 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 341 238\nL 341 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 424 238\nL 424 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 507 238\nL 507 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 238\nL 590 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"46\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"128\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"211\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"294\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"378\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"461\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"543\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 51 217\nL 133 216\nL 216 220\nL 299 216\nL 382 221\nL 465 203\nL 548 205\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51\" cy=\"217\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"133\" cy=\"216\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"216\" cy=\"220\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"299\" cy=\"216\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"382\" cy=\"221\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"465\" cy=\"203\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"548\" cy=\"205\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 51 124\nL 133 109\nL 216 113\nL 299 109\nL 382 61\nL 465 56\nL 548 57\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51\" cy=\"124\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"133\" cy=\"109\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"216\" cy=\"113\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"299\" cy=\"109\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"382\" cy=\"61\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"465\" cy=\"56\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"548\" cy=\"57\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 324\nL 280 324\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"324\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"330\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 324\nL 341 324\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"324\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"330\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 190 396\nL 190 260\nL 410 260\nL 410 396\nL 190 396\" style=\"stroke-width:1;stroke:black;fill:none\"/><path d=\"M 10 233\nL 590 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 238\nL 10 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 238\nL 92 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 175 238\nL 175 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 258 238\nL 258 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 341 238\nL 341 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 424 238\nL 424 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 507 238\nL 507 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 238\nL 590 233\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"46\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"128\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"211\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"294\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"378\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"461\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"543\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 51.43 217\nL 134.29 215.4\nL 217.14 219.53\nL 300 215.13\nL 382.86 221\nL 465.71 202.33\nL 548.57 205\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51.43\" cy=\"217\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"134.29\" cy=\"215.4\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"217.14\" cy=\"219.53\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"215.13\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"382.86\" cy=\"221\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"465.71\" cy=\"202.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"548.57\" cy=\"205\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 51.43 123.67\nL 134.29 108.73\nL 217.14 112.87\nL 300 108.47\nL 382.86 61\nL 465.71 55.67\nL 548.57 57\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51.43\" cy=\"123.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"134.29\" cy=\"108.73\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"217.14\" cy=\"112.87\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"108.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"382.86\" cy=\"61\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"465.71\" cy=\"55.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"548.57\" cy=\"57\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x4c06efb5,
 		},
 		{
@@ -495,7 +499,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.Offset = bottomOffset
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"286\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 374\nL 280 374\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 374\nL 341 374\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"49\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"116\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"149\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"182\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"216\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"249\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"282\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 43\nL 590 43\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 77\nL 590 77\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 110\nL 590 110\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 144\nL 590 144\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 177\nL 590 177\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 211\nL 590 211\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 244\nL 590 244\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 278\nL 590 278\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 312\nL 590 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 317\nL 58 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 317\nL 134 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 317\nL 210 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 317\nL 286 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 317\nL 362 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 317\nL 438 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 317\nL 514 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 317\nL 590 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 287\nL 172 285\nL 248 291\nL 324 284\nL 400 294\nL 476 264\nL 552 268\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"287\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"285\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"291\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"284\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"294\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"264\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"268\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 141\nL 172 117\nL 248 124\nL 324 117\nL 400 42\nL 476 34\nL 552 36\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"141\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"117\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"124\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"117\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"42\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"34\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"36\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"286\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 250 374\nL 280 374\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 374\nL 341 374\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"49\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"116\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"149\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"182\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"216\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"249\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"282\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 43\nL 590 43\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 77\nL 590 77\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 110\nL 590 110\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 144\nL 590 144\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 177\nL 590 177\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 211\nL 590 211\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 244\nL 590 244\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 278\nL 590 278\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 312\nL 590 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 317\nL 58 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 317\nL 134 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 317\nL 210 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 317\nL 286 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 317\nL 362 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 317\nL 438 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 317\nL 514 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 317\nL 590 312\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 286.83\nL 172 284.32\nL 248 290.82\nL 324 283.9\nL 400 293.12\nL 476 263.76\nL 552 267.96\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"286.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"284.32\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"290.82\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"283.9\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"293.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"263.76\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"267.96\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 140.03\nL 172 116.54\nL 248 123.04\nL 324 116.12\nL 400 41.46\nL 476 33.07\nL 552 35.17\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"140.03\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"116.54\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"123.04\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"116.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"41.46\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"33.07\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"35.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x49a8d802,
 		},
 		{
@@ -506,7 +510,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.Offset = OffsetRight
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 549 19\nL 579 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"564\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"581\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 549 39\nL 579 39\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"564\" cy=\"39\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"581\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"47\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"118\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"154\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"189\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"225\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"296\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 41\nL 590 41\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 76\nL 590 76\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 112\nL 590 112\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 148\nL 590 148\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 184\nL 590 184\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 220\nL 590 220\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 256\nL 590 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 292\nL 590 292\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 338\nL 172 335\nL 248 342\nL 324 334\nL 400 344\nL 476 313\nL 552 317\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"338\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"342\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"334\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"344\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"317\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 181\nL 172 155\nL 248 162\nL 324 155\nL 400 75\nL 476 66\nL 552 68\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"181\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"155\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"162\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"155\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"75\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"66\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"68\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 549 19\nL 579 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"564\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"581\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 549 39\nL 579 39\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"564\" cy=\"39\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"581\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"47\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"118\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"154\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"189\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"225\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"261\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"296\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 41\nL 590 41\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 76\nL 590 76\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 112\nL 590 112\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 148\nL 590 148\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 184\nL 590 184\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 220\nL 590 220\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 256\nL 590 256\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 292\nL 590 292\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 328\nL 590 328\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 337.08\nL 172 334.39\nL 248 341.35\nL 324 333.94\nL 400 343.81\nL 476 312.41\nL 552 316.9\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"337.08\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"334.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"341.35\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"333.94\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"343.81\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"312.41\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"316.9\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 180.07\nL 172 154.95\nL 248 161.9\nL 324 154.5\nL 400 74.65\nL 476 65.67\nL 552 67.92\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"180.07\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"154.95\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"161.9\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"154.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"74.65\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"65.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"67.92\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x6b7c90f8,
 		},
 		{
@@ -521,7 +525,7 @@ func TestLineChart(t *testing.T) {
 				opt.XAxis.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 490 19\nL 520 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"505\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"522\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 551 19\nL 581 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"566\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"583\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\nL 324 355\nL 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"359\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"356\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"364\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"355\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"367\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"330\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"174\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"145\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"153\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"144\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"50\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"40\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"42\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 490 19\nL 520 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"505\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"522\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 551 19\nL 581 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"566\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"583\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\nL 324 354.64\nL 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"358.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"355.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"363.35\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"354.64\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"366.25\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"329.31\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"334.58\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"173.61\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"144.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"152.24\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"143.53\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"49.58\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"39.03\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"41.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x1dab9f79,
 		},
 		{
@@ -534,7 +538,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.BorderWidth = 2.0
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 250 374\nL 280 374\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 374\nL 341 374\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 240 396\nL 240 355\nL 360 355\nL 360 396\nL 240 396\" style=\"stroke-width:2;stroke:black;fill:none\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"54\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"130\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"168\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"206\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"244\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"282\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"320\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 48\nL 590 48\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 86\nL 590 86\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 124\nL 590 124\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 162\nL 590 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 201\nL 590 201\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 239\nL 590 239\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 277\nL 590 277\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 315\nL 590 315\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 326\nL 172 323\nL 248 330\nL 324 322\nL 400 333\nL 476 300\nL 552 304\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 159\nL 172 132\nL 248 139\nL 324 131\nL 400 46\nL 476 37\nL 552 39\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 250 374\nL 280 374\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 374\nL 341 374\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"374\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 240 396\nL 240 355\nL 360 355\nL 360 396\nL 240 396\" style=\"stroke-width:2;stroke:black;fill:none\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"54\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"130\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"168\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"206\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"244\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"282\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"320\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 48\nL 590 48\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 86\nL 590 86\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 124\nL 590 124\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 162\nL 590 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 201\nL 590 201\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 239\nL 590 239\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 277\nL 590 277\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 315\nL 590 315\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 325.33\nL 172 322.47\nL 248 329.87\nL 324 321.99\nL 400 332.5\nL 476 299.06\nL 552 303.83\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 158.11\nL 172 131.36\nL 248 138.76\nL 324 130.88\nL 400 45.83\nL 476 36.28\nL 552 38.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x4e58442e,
 		},
 		{
@@ -546,7 +550,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.BorderWidth = 2.0
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 549 19\nL 579 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"564\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"581\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 549 39\nL 579 39\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"564\" cy=\"39\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"581\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 539 65\nL 539 0\nL 600 0\nL 600 65\nL 539 65\" style=\"stroke-width:2;stroke:black;fill:none\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\nL 324 355\nL 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 549 19\nL 579 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"564\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"581\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 549 39\nL 579 39\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"564\" cy=\"39\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"581\" y=\"45\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 539 65\nL 539 0\nL 600 0\nL 600 65\nL 539 65\" style=\"stroke-width:2;stroke:black;fill:none\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\nL 324 354.64\nL 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xed31a234,
 		},
 		{
@@ -559,7 +563,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.BorderWidth = 2.0
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 490 19\nL 520 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"505\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"522\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 551 19\nL 581 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"566\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"583\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 480 41\nL 480 0\nL 600 0\nL 600 41\nL 480 41\" style=\"stroke-width:2;stroke:black;fill:none\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\nL 324 355\nL 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 490 19\nL 520 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"505\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"522\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 551 19\nL 581 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"566\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"583\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 480 41\nL 480 0\nL 600 0\nL 600 41\nL 480 41\" style=\"stroke-width:2;stroke:black;fill:none\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\nL 324 354.64\nL 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x7eed1f52,
 		},
 		{
@@ -569,7 +573,7 @@ func TestLineChart(t *testing.T) {
 				opt.StrokeSmoothingTension = 0.8
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nQ172,356 202,359\nQ248,364 278,360\nQ324,355 354,359\nQ400,367 430,352\nQ476,330 506,332\nQ476,330 552,335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nQ172,145 202,148\nQ248,153 278,149\nQ324,144 354,106\nQ400,50 430,46\nQ476,40 506,40\nQ476,40 552,42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nQ172,355.17 202.4,358.44\nQ248,363.35 278.4,359.86\nQ324,354.64 354.4,359.28\nQ400,366.25 430.4,351.47\nQ476,329.31 506.4,331.42\nQ476,329.31 552,334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nQ172,144.06 202.4,147.33\nQ248,152.24 278.4,148.75\nQ324,143.53 354.4,105.95\nQ400,49.58 430.4,45.36\nQ476,39.03 506.4,40.08\nQ476,39.03 552,41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xdda7c425,
 		},
 		{
@@ -579,7 +583,7 @@ func TestLineChart(t *testing.T) {
 				opt.SeriesList[0].Values[3] = GetNullValue()
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 400 367\nL 476 330\nL 552 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 400 366.25\nL 476 329.31\nL 552 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xa6e81eeb,
 		},
 		{
@@ -591,7 +595,7 @@ func TestLineChart(t *testing.T) {
 				opt.SeriesList[0].Values[5] = GetNullValue()
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nL 172 356\nL 248 364\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"400\" cy=\"367\" r=\"2\" style=\"stroke:none;fill:none\"/><circle cx=\"552\" cy=\"335\" r=\"2\" style=\"stroke:none;fill:none\"/><circle cx=\"96\" cy=\"359\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"172\" cy=\"356\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"248\" cy=\"364\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"324\" cy=\"2147483657\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"400\" cy=\"367\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"476\" cy=\"2147483657\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"552\" cy=\"335\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 96 174\nL 172 145\nL 248 153\nL 324 144\nL 400 50\nL 476 40\nL 552 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"174\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"172\" cy=\"145\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"248\" cy=\"153\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"324\" cy=\"144\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"400\" cy=\"50\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"476\" cy=\"40\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"552\" cy=\"42\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nL 172 355.17\nL 248 363.35\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"400\" cy=\"366.25\" r=\"2\" style=\"stroke:none;fill:none\"/><circle cx=\"552\" cy=\"334.58\" r=\"2\" style=\"stroke:none;fill:none\"/><circle cx=\"96\" cy=\"358.33\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"172\" cy=\"355.17\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"248\" cy=\"363.35\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"324\" cy=\"2147483657\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"400\" cy=\"366.25\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"476\" cy=\"2147483657\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"552\" cy=\"334.58\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 96 173.61\nL 172 144.06\nL 248 152.24\nL 324 143.53\nL 400 49.58\nL 476 39.03\nL 552 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"173.61\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"172\" cy=\"144.06\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"248\" cy=\"152.24\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"324\" cy=\"143.53\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"400\" cy=\"49.58\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"476\" cy=\"39.03\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"552\" cy=\"41.67\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x4e2d3981,
 		},
 		{
@@ -602,7 +606,7 @@ func TestLineChart(t *testing.T) {
 				opt.FillArea = Ptr(true)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 359\nL 146 356\nL 235 364\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 412 367\nL 501 330\nL 590 335\nL 590 390\nL 58 390\nL 58 359\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 58 359\nL 146 356\nL 235 364\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 412 367\nL 501 330\nL 590 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 58 174\nL 146 145\nL 235 153\nL 324 144\nL 412 50\nL 501 40\nL 590 42\nL 590 390\nL 58 390\nL 58 174\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 58 174\nL 146 145\nL 235 153\nL 324 144\nL 412 50\nL 501 40\nL 590 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 358.33\nL 146.67 355.17\nL 235.33 363.35\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 412.67 366.25\nL 501.33 329.31\nL 590 334.58\nL 590 390\nL 58 390\nL 58 358.33\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 58 358.33\nL 146.67 355.17\nL 235.33 363.35\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 412.67 366.25\nL 501.33 329.31\nL 590 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 58 173.61\nL 146.67 144.06\nL 235.33 152.24\nL 324 143.53\nL 412.67 49.58\nL 501.33 39.03\nL 590 41.67\nL 590 390\nL 58 390\nL 58 173.61\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 58 173.61\nL 146.67 144.06\nL 235.33 152.24\nL 324 143.53\nL 412.67 49.58\nL 501.33 39.03\nL 590 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x4d01a02d,
 		},
 		{
@@ -615,7 +619,7 @@ func TestLineChart(t *testing.T) {
 				opt.FillArea = Ptr(true)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 235 364\nL 324 355\nL 412 367\nL 501 330\nL 590 335\nL 590 390\nL 235 390\nL 235 364\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 235 364\nL 324 355\nL 412 367\nL 501 330\nL 590 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 146 145\nL 235 153\nL 324 144\nL 412 50\nL 501 40\nL 590 42\nL 590 390\nL 146 390\nL 146 145\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 146 145\nL 235 153\nL 324 144\nL 412 50\nL 501 40\nL 590 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 235.33 363.35\nL 324 354.64\nL 412.67 366.25\nL 501.33 329.31\nL 590 334.58\nL 590 390\nL 235.33 390\nL 235.33 363.35\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 235.33 363.35\nL 324 354.64\nL 412.67 366.25\nL 501.33 329.31\nL 590 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 146.67 144.06\nL 235.33 152.24\nL 324 143.53\nL 412.67 49.58\nL 501.33 39.03\nL 590 41.67\nL 590 390\nL 146.67 390\nL 146.67 144.06\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 146.67 144.06\nL 235.33 152.24\nL 324 143.53\nL 412.67 49.58\nL 501.33 39.03\nL 590 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x95c0eac9,
 		},
 		{
@@ -626,7 +630,7 @@ func TestLineChart(t *testing.T) {
 				opt.SeriesList[0].Values[3] = GetNullValue()
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nQ172,356 202,359\nQ172,356 248,364\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 400 367\nQ476,330 506,332\nQ476,330 552,335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nQ172,145 202,148\nQ248,153 278,149\nQ324,144 354,106\nQ400,50 430,46\nQ476,40 506,40\nQ476,40 552,42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nQ172,355.17 202.4,358.44\nQ172,355.17 248,363.35\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 400 366.25\nQ476,329.31 506.4,331.42\nQ476,329.31 552,334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nQ172,144.06 202.4,147.33\nQ248,152.24 278.4,148.75\nQ324,143.53 354.4,105.95\nQ400,49.58 430.4,45.36\nQ476,39.03 506.4,40.08\nQ476,39.03 552,41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xeecc662,
 		},
 		{
@@ -638,7 +642,7 @@ func TestLineChart(t *testing.T) {
 				opt.FillArea = Ptr(true)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 359\nQ146,356 181,359\nQ146,356 235,364\nM 412 367\nQ501,330 536,332\nQ501,330 590,335\nL 590 390\nL 58 390\nL 58 359\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 58 359\nQ146,356 181,359\nQ146,356 235,364\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 412 367\nQ501,330 536,332\nQ501,330 590,335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 58 174\nQ146,145 181,148\nQ235,153 270,149\nQ324,144 359,106\nQ412,50 447,46\nQ501,40 536,40\nQ501,40 590,42\nL 590 390\nL 58 390\nL 58 174\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 58 174\nQ146,145 181,148\nQ235,153 270,149\nQ324,144 359,106\nQ412,50 447,46\nQ501,40 536,40\nQ501,40 590,42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 358.33\nQ146.67,355.17 182.13,358.44\nQ146.67,355.17 235.33,363.35\nM 412.67 366.25\nQ501.33,329.31 536.8,331.42\nQ501.33,329.31 590,334.58\nL 590 390\nL 58 390\nL 58 358.33\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 58 358.33\nQ146.67,355.17 182.13,358.44\nQ146.67,355.17 235.33,363.35\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 412.67 366.25\nQ501.33,329.31 536.8,331.42\nQ501.33,329.31 590,334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 58 173.61\nQ146.67,144.06 182.13,147.33\nQ235.33,152.24 270.8,148.75\nQ324,143.53 359.47,105.95\nQ412.67,49.58 448.13,45.36\nQ501.33,39.03 536.8,40.08\nQ501.33,39.03 590,41.67\nL 590 390\nL 58 390\nL 58 173.61\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 58 173.61\nQ146.67,144.06 182.13,147.33\nQ235.33,152.24 270.8,148.75\nQ324,143.53 359.47,105.95\nQ412.67,49.58 448.13,45.36\nQ501.33,39.03 536.8,40.08\nQ501.33,39.03 590,41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xf2eb1180,
 		},
 		{
@@ -649,7 +653,7 @@ func TestLineChart(t *testing.T) {
 				opt.FillOpacity = 100
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 359\nL 146 356\nL 235 364\nL 324 355\nL 412 367\nL 501 330\nL 590 335\nL 590 390\nL 58 390\nL 58 359\" style=\"stroke:none;fill:rgba(84,112,198,0.4)\"/><path d=\"M 58 359\nL 146 356\nL 235 364\nL 324 355\nL 412 367\nL 501 330\nL 590 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 58 174\nL 146 145\nL 235 153\nL 324 144\nL 412 50\nL 501 40\nL 590 42\nL 590 390\nL 58 390\nL 58 174\" style=\"stroke:none;fill:rgba(145,204,117,0.4)\"/><path d=\"M 58 174\nL 146 145\nL 235 153\nL 324 144\nL 412 50\nL 501 40\nL 590 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 358.33\nL 146.67 355.17\nL 235.33 363.35\nL 324 354.64\nL 412.67 366.25\nL 501.33 329.31\nL 590 334.58\nL 590 390\nL 58 390\nL 58 358.33\" style=\"stroke:none;fill:rgba(84,112,198,0.4)\"/><path d=\"M 58 358.33\nL 146.67 355.17\nL 235.33 363.35\nL 324 354.64\nL 412.67 366.25\nL 501.33 329.31\nL 590 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 58 173.61\nL 146.67 144.06\nL 235.33 152.24\nL 324 143.53\nL 412.67 49.58\nL 501.33 39.03\nL 590 41.67\nL 590 390\nL 58 390\nL 58 173.61\" style=\"stroke:none;fill:rgba(145,204,117,0.4)\"/><path d=\"M 58 173.61\nL 146.67 144.06\nL 235.33 152.24\nL 324 143.53\nL 412.67 49.58\nL 501.33 39.03\nL 590 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x1be5236d,
 		},
 		{
@@ -666,7 +670,7 @@ func TestLineChart(t *testing.T) {
 				opt.YAxis[0].Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 10 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 369\nL 10 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 369\nL 92 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 175 369\nL 175 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 258 369\nL 258 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 341 369\nL 341 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 424 369\nL 424 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 507 369\nL 507 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"36\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"120\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"201\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"286\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"373\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"454\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"535\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 51 335\nL 133 332\nL 216 340\nL 299 332\nL 382 342\nL 465 308\nL 548 313\nL 548 364\nL 51 364\nL 51 335\" style=\"stroke:none;fill:rgba(84,112,198,0.4)\"/><path d=\"M 51 335\nL 133 332\nL 216 340\nL 299 332\nL 382 342\nL 465 308\nL 548 313\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 51 310\nL 133 320\nL 216 318\nL 299 307\nL 382 293\nL 465 283\nL 548 288\nL 548 364\nL 51 364\nL 51 310\" style=\"stroke:none;fill:rgba(145,204,117,0.4)\"/><path d=\"M 51 310\nL 133 320\nL 216 318\nL 299 307\nL 382 293\nL 465 283\nL 548 288\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 51 328\nL 133 307\nL 216 315\nL 299 327\nL 382 318\nL 465 283\nL 548 264\nL 548 364\nL 51 364\nL 51 328\" style=\"stroke:none;fill:rgba(250,200,88,0.4)\"/><path d=\"M 51 328\nL 133 307\nL 216 315\nL 299 327\nL 382 318\nL 465 283\nL 548 264\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><path d=\"M 51 286\nL 133 283\nL 216 291\nL 299 282\nL 382 269\nL 465 283\nL 548 286\nL 548 364\nL 51 364\nL 51 286\" style=\"stroke:none;fill:rgba(238,102,102,0.4)\"/><path d=\"M 51 286\nL 133 283\nL 216 291\nL 299 282\nL 382 269\nL 465 283\nL 548 286\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 51 163\nL 133 135\nL 216 143\nL 299 135\nL 382 47\nL 465 38\nL 548 40\nL 548 364\nL 51 364\nL 51 163\" style=\"stroke:none;fill:rgba(115,192,222,0.4)\"/><path d=\"M 51 163\nL 133 135\nL 216 143\nL 299 135\nL 382 47\nL 465 38\nL 548 40\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 10 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 10 369\nL 10 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 92 369\nL 92 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 175 369\nL 175 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 258 369\nL 258 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 341 369\nL 341 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 424 369\nL 424 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 507 369\nL 507 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"36\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"120\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"201\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"286\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"373\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"454\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"535\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 51.43 334.5\nL 134.29 331.55\nL 217.14 339.17\nL 300 331.06\nL 382.86 341.88\nL 465.71 307.46\nL 548.57 312.38\nL 548.57 364\nL 51.43 364\nL 51.43 334.5\" style=\"stroke:none;fill:rgba(84,112,198,0.4)\"/><path d=\"M 51.43 334.5\nL 134.29 331.55\nL 217.14 339.17\nL 300 331.06\nL 382.86 341.88\nL 465.71 307.46\nL 548.57 312.38\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 51.43 309.92\nL 134.29 319.26\nL 217.14 317.05\nL 300 306.48\nL 382.86 292.71\nL 465.71 282.88\nL 548.57 287.79\nL 548.57 364\nL 51.43 364\nL 51.43 309.92\" style=\"stroke:none;fill:rgba(145,204,117,0.4)\"/><path d=\"M 51.43 309.92\nL 134.29 319.26\nL 217.14 317.05\nL 300 306.48\nL 382.86 292.71\nL 465.71 282.88\nL 548.57 287.79\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 51.43 327.12\nL 134.29 306.97\nL 217.14 314.59\nL 300 326.14\nL 382.86 317.29\nL 465.71 282.88\nL 548.57 263.21\nL 548.57 364\nL 51.43 364\nL 51.43 327.12\" style=\"stroke:none;fill:rgba(250,200,88,0.4)\"/><path d=\"M 51.43 327.12\nL 134.29 306.97\nL 217.14 314.59\nL 300 326.14\nL 382.86 317.29\nL 465.71 282.88\nL 548.57 263.21\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><path d=\"M 51.43 285.33\nL 134.29 282.38\nL 217.14 290\nL 300 281.89\nL 382.86 268.12\nL 465.71 282.88\nL 548.57 285.33\nL 548.57 364\nL 51.43 364\nL 51.43 285.33\" style=\"stroke:none;fill:rgba(238,102,102,0.4)\"/><path d=\"M 51.43 285.33\nL 134.29 282.38\nL 217.14 290\nL 300 281.89\nL 382.86 268.12\nL 465.71 282.88\nL 548.57 285.33\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><path d=\"M 51.43 162.42\nL 134.29 134.88\nL 217.14 142.5\nL 300 134.39\nL 382.86 46.88\nL 465.71 37.04\nL 548.57 39.5\nL 548.57 364\nL 51.43 364\nL 51.43 162.42\" style=\"stroke:none;fill:rgba(115,192,222,0.4)\"/><path d=\"M 51.43 162.42\nL 134.29 134.88\nL 217.14 142.5\nL 300 134.39\nL 382.86 46.88\nL 465.71 37.04\nL 548.57 39.5\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/></svg>",
 			pngCRC: 0x659774e3,
 		},
 		{
@@ -678,7 +682,7 @@ func TestLineChart(t *testing.T) {
 				opt.XAxis.BoundaryGap = Ptr(true)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 359\nQ172,356 202,359\nQ248,364 278,360\nQ324,355 354,359\nQ400,367 430,352\nQ476,330 506,332\nQ476,330 552,335\nL 552 390\nL 96 390\nL 96 359\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 96 359\nQ172,356 202,359\nQ248,364 278,360\nQ324,355 354,359\nQ400,367 430,352\nQ476,330 506,332\nQ476,330 552,335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 174\nQ172,145 202,148\nQ248,153 278,149\nQ324,144 354,106\nQ400,50 430,46\nQ476,40 506,40\nQ476,40 552,42\nL 552 390\nL 96 390\nL 96 174\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 96 174\nQ172,145 202,148\nQ248,153 278,149\nQ324,144 354,106\nQ400,50 430,46\nQ476,40 506,40\nQ476,40 552,42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 96 358.33\nQ172,355.17 202.4,358.44\nQ248,363.35 278.4,359.86\nQ324,354.64 354.4,359.28\nQ400,366.25 430.4,351.47\nQ476,329.31 506.4,331.42\nQ476,329.31 552,334.58\nL 552 390\nL 96 390\nL 96 358.33\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 96 358.33\nQ172,355.17 202.4,358.44\nQ248,363.35 278.4,359.86\nQ324,354.64 354.4,359.28\nQ400,366.25 430.4,351.47\nQ476,329.31 506.4,331.42\nQ476,329.31 552,334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 96 173.61\nQ172,144.06 202.4,147.33\nQ248,152.24 278.4,148.75\nQ324,143.53 354.4,105.95\nQ400,49.58 430.4,45.36\nQ476,39.03 506.4,40.08\nQ476,39.03 552,41.67\nL 552 390\nL 96 390\nL 96 173.61\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 96 173.61\nQ172,144.06 202.4,147.33\nQ248,152.24 278.4,148.75\nQ324,143.53 354.4,105.95\nQ400,49.58 430.4,45.36\nQ476,39.03 506.4,40.08\nQ476,39.03 552,41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xdeac437f,
 		},
 		{
@@ -690,7 +694,7 @@ func TestLineChart(t *testing.T) {
 				opt.XAxis.BoundaryGap = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 359\nQ146,356 181,359\nQ235,364 270,360\nQ324,355 359,359\nQ412,367 447,352\nQ501,330 536,332\nQ501,330 590,335\nL 590 390\nL 58 390\nL 58 359\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 58 359\nQ146,356 181,359\nQ235,364 270,360\nQ324,355 359,359\nQ412,367 447,352\nQ501,330 536,332\nQ501,330 590,335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 58 174\nQ146,145 181,148\nQ235,153 270,149\nQ324,144 359,106\nQ412,50 447,46\nQ501,40 536,40\nQ501,40 590,42\nL 590 390\nL 58 390\nL 58 174\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 58 174\nQ146,145 181,148\nQ235,153 270,149\nQ324,144 359,106\nQ412,50 447,46\nQ501,40 536,40\nQ501,40 590,42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 358.33\nQ146.67,355.17 182.13,358.44\nQ235.33,363.35 270.8,359.86\nQ324,354.64 359.47,359.28\nQ412.67,366.25 448.13,351.47\nQ501.33,329.31 536.8,331.42\nQ501.33,329.31 590,334.58\nL 590 390\nL 58 390\nL 58 358.33\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 58 358.33\nQ146.67,355.17 182.13,358.44\nQ235.33,363.35 270.8,359.86\nQ324,354.64 359.47,359.28\nQ412.67,366.25 448.13,351.47\nQ501.33,329.31 536.8,331.42\nQ501.33,329.31 590,334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 58 173.61\nQ146.67,144.06 182.13,147.33\nQ235.33,152.24 270.8,148.75\nQ324,143.53 359.47,105.95\nQ412.67,49.58 448.13,45.36\nQ501.33,39.03 536.8,40.08\nQ501.33,39.03 590,41.67\nL 590 390\nL 58 390\nL 58 173.61\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 58 173.61\nQ146.67,144.06 182.13,147.33\nQ235.33,152.24 270.8,148.75\nQ324,143.53 359.47,105.95\nQ412.67,49.58 448.13,45.36\nQ501.33,39.03 536.8,40.08\nQ501.33,39.03 590,41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xb444ca9b,
 		},
 		{
@@ -702,7 +706,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><path d=\"M 21 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65 359\nL 145 356\nL 226 364\nL 307 355\nL 387 367\nL 468 330\nL 549 335\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 65 174\nL 145 145\nL 226 153\nL 307 144\nL 387 50\nL 468 40\nL 549 42\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">f</text><path d=\"M 21 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 21 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 65.36 358.33\nL 146.07 355.17\nL 226.79 363.35\nL 307.5 354.64\nL 388.21 366.25\nL 468.93 329.31\nL 549.64 334.58\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 65.36 173.61\nL 146.07 144.06\nL 226.79 152.24\nL 307.5 143.53\nL 388.21 49.58\nL 468.93 39.03\nL 549.64 41.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0x810a26f7,
 		},
 		{
@@ -720,7 +724,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 77 334\nL 151 331\nL 225 338\nL 299 331\nL 374 340\nL 448 309\nL 522 314\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 77 178\nL 151 153\nL 225 160\nL 299 153\nL 374 74\nL 448 65\nL 522 67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"43\" cy=\"340\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 340\nL 542 340\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 335\nL 558 340\nL 542 345\nL 547 340\nL 542 335\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"560\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><circle cx=\"43\" cy=\"309\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 309\nL 542 309\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 304\nL 558 309\nL 542 314\nL 547 309\nL 542 304\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"560\" y=\"313\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">230</text><circle cx=\"43\" cy=\"328\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 328\nL 542 328\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 323\nL 558 328\nL 542 333\nL 547 328\nL 542 323\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"560\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">145</text><circle cx=\"43\" cy=\"178\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 178\nL 542 178\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 173\nL 558 178\nL 542 183\nL 547 178\nL 542 173\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"560\" y=\"182\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">820</text><circle cx=\"43\" cy=\"65\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 65\nL 542 65\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 60\nL 558 65\nL 542 70\nL 547 65\nL 542 60\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"560\" y=\"69\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1k</text><circle cx=\"43\" cy=\"122\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 122\nL 542 122\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 117\nL 558 122\nL 542 127\nL 547 122\nL 542 117\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"560\" y=\"126\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1k</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 77.14 333.33\nL 151.43 330.67\nL 225.71 337.56\nL 300 330.22\nL 374.29 340\nL 448.57 308.89\nL 522.86 313.33\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 77.14 177.78\nL 151.43 152.89\nL 225.71 159.78\nL 300 152.44\nL 374.29 73.33\nL 448.57 64.44\nL 522.86 66.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"43\" cy=\"340\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 340\nL 542 340\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 335\nL 558 340\nL 542 345\nL 547 340\nL 542 335\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"560\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><circle cx=\"43\" cy=\"309\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 309\nL 542 309\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 304\nL 558 309\nL 542 314\nL 547 309\nL 542 304\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"560\" y=\"313\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">230</text><circle cx=\"43\" cy=\"328\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 328\nL 542 328\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 323\nL 558 328\nL 542 333\nL 547 328\nL 542 323\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"560\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">145</text><circle cx=\"43\" cy=\"178\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 178\nL 542 178\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 173\nL 558 178\nL 542 183\nL 547 178\nL 542 173\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"560\" y=\"182\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">820</text><circle cx=\"43\" cy=\"65\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 65\nL 542 65\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 60\nL 558 65\nL 542 70\nL 547 65\nL 542 60\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"560\" y=\"69\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1k</text><circle cx=\"43\" cy=\"122\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 49 122\nL 542 122\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 117\nL 558 122\nL 542 127\nL 547 122\nL 542 117\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"560\" y=\"126\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1k</text></svg>",
 			pngCRC: 0xf7526cf0,
 		},
 		{
@@ -737,7 +741,7 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 51 365\nL 133 363\nL 216 369\nL 299 362\nL 382 371\nL 465 342\nL 548 346\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 51 217\nL 133 194\nL 216 200\nL 299 193\nL 382 118\nL 465 110\nL 548 112\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 378 364\nA 14 14 330.00 1 1 386 364\nL 382 350\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 368 350\nQ382,385 396,350\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"375\" y=\"355\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 461 335\nA 14 14 330.00 1 1 469 335\nL 465 321\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 451 321\nQ465,356 479,321\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"454\" y=\"326\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">230</text><path d=\"M 47 210\nA 14 14 330.00 1 1 55 210\nL 51 196\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 37 196\nQ51,231 65,196\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"40\" y=\"201\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">820</text><path d=\"M 461 103\nA 14 14 330.00 1 1 469 103\nL 465 89\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 451 89\nQ465,124 479,89\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"458\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1k</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 51.43 364.67\nL 134.29 362.13\nL 217.14 368.68\nL 300 361.71\nL 382.86 371\nL 465.71 341.44\nL 548.57 345.67\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 51.43 216.89\nL 134.29 193.24\nL 217.14 199.79\nL 300 192.82\nL 382.86 117.67\nL 465.71 109.22\nL 548.57 111.33\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 378 364\nA 14 14 330.00 1 1 386 364\nL 382 350\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 368 350\nQ382,385 396,350\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"375\" y=\"355\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 461 335\nA 14 14 330.00 1 1 469 335\nL 465 321\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 451 321\nQ465,356 479,321\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"454\" y=\"326\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">230</text><path d=\"M 47 210\nA 14 14 330.00 1 1 55 210\nL 51 196\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 37 196\nQ51,231 65,196\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"40\" y=\"201\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">820</text><path d=\"M 461 103\nA 14 14 330.00 1 1 469 103\nL 465 89\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 451 89\nQ465,124 479,89\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"458\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1k</text></svg>",
 			pngCRC: 0xb93e40ce,
 		},
 		{
@@ -759,13 +763,13 @@ func TestLineChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 51 365\nL 133 363\nL 216 369\nL 299 362\nL 382 371\nL 465 342\nL 548 346\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 51 217\nL 133 194\nL 216 200\nL 299 193\nL 382 118\nL 465 110\nL 548 112\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 378 364\nA 14 14 330.00 1 1 386 364\nL 382 350\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 368 350\nQ382,385 396,350\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"375\" y=\"355\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 461 335\nA 14 14 330.00 1 1 469 335\nL 465 321\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 451 321\nQ465,356 479,321\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"454\" y=\"326\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">230</text><path d=\"M 47 210\nA 14 14 330.00 1 1 55 210\nL 51 196\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 37 196\nQ51,231 65,196\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"40\" y=\"201\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">820</text><path d=\"M 461 103\nA 14 14 330.00 1 1 469 103\nL 465 89\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 451 89\nQ465,124 479,89\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"452\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">1.33k</text><text x=\"56\" y=\"371\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"138\" y=\"369\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"221\" y=\"375\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101</text><text x=\"304\" y=\"368\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">134</text><text x=\"553\" y=\"352\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"138\" y=\"200\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">932</text><text x=\"221\" y=\"206\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">901</text><text x=\"304\" y=\"199\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">934</text><text x=\"387\" y=\"124\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.29k</text><text x=\"553\" y=\"118\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.32k</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 51.43 364.67\nL 134.29 362.13\nL 217.14 368.68\nL 300 361.71\nL 382.86 371\nL 465.71 341.44\nL 548.57 345.67\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 51.43 216.89\nL 134.29 193.24\nL 217.14 199.79\nL 300 192.82\nL 382.86 117.67\nL 465.71 109.22\nL 548.57 111.33\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 378 364\nA 14 14 330.00 1 1 386 364\nL 382 350\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 368 350\nQ382,385 396,350\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"375\" y=\"355\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 461 335\nA 14 14 330.00 1 1 469 335\nL 465 321\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 451 321\nQ465,356 479,321\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"454\" y=\"326\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">230</text><path d=\"M 47 210\nA 14 14 330.00 1 1 55 210\nL 51 196\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 37 196\nQ51,231 65,196\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"40\" y=\"201\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">820</text><path d=\"M 461 103\nA 14 14 330.00 1 1 469 103\nL 465 89\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 451 89\nQ465,124 479,89\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"452\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">1.33k</text><text x=\"56\" y=\"371\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"138\" y=\"369\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"221\" y=\"375\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">101</text><text x=\"304\" y=\"368\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">134</text><text x=\"553\" y=\"352\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"138\" y=\"200\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">932</text><text x=\"221\" y=\"206\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">901</text><text x=\"304\" y=\"199\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">934</text><text x=\"387\" y=\"124\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.29k</text><text x=\"553\" y=\"118\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.32k</text></svg>",
 			pngCRC: 0x2da3a047,
 		},
 		{
 			name:        "stack_series",
 			makeOptions: makeFullLineChartStackedOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 217 29\nL 247 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"232\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"249\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 280 29\nL 310 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"295\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"312\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 342 29\nL 372 29\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"357\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"374\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">297</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">264</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">231</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">198</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">165</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"28\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99</text><text x=\"28\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">66</text><text x=\"28\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">33</text><text x=\"37\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 52 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 56 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 56 359\nL 56 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 121 359\nL 121 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 359\nL 187 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 252 359\nL 252 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 359\nL 318 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 383 359\nL 383 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 449 359\nL 449 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 359\nL 514 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"84\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"150\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"215\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"281\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"346\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"412\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"477\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"543\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 88 350\nL 154 331\nL 219 329\nL 285 252\nL 350 212\nL 416 322\nL 481 334\nL 547 351\nL 547 354\nL 88 354\nL 88 350\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 88 350\nL 154 331\nL 219 329\nL 285 252\nL 350 212\nL 416 322\nL 481 334\nL 547 351\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"88\" cy=\"350\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"154\" cy=\"331\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"219\" cy=\"329\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"285\" cy=\"252\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"350\" cy=\"212\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"416\" cy=\"322\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"481\" cy=\"334\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"547\" cy=\"351\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 88 341\nL 154 305\nL 219 300\nL 285 106\nL 350 89\nL 416 273\nL 481 316\nL 547 349\nL 547 351\nL 481 334\nL 416 322\nL 350 212\nL 285 252\nL 219 329\nL 154 331\nL 88 350\nL 88 341\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 88 341\nL 154 305\nL 219 300\nL 285 106\nL 350 89\nL 416 273\nL 481 316\nL 547 349\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"88\" cy=\"341\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"154\" cy=\"305\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"219\" cy=\"300\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"285\" cy=\"106\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"350\" cy=\"89\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"416\" cy=\"273\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"481\" cy=\"316\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"547\" cy=\"349\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 88 260\nL 154 264\nL 219 272\nL 285 78\nL 350 65\nL 416 249\nL 481 275\nL 547 268\nL 547 349\nL 481 316\nL 416 273\nL 350 89\nL 285 106\nL 219 300\nL 154 305\nL 88 341\nL 88 260\" style=\"stroke:none;fill:rgba(250,200,88,0.8)\"/><path d=\"M 88 260\nL 154 264\nL 219 272\nL 285 78\nL 350 65\nL 416 249\nL 481 275\nL 547 268\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"88\" cy=\"260\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"154\" cy=\"264\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"219\" cy=\"272\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"285\" cy=\"78\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"350\" cy=\"65\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"416\" cy=\"249\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"481\" cy=\"275\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"547\" cy=\"268\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 543 344\nA 14 14 330.00 1 1 551 344\nL 547 330\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 533 330\nQ547,365 561,330\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"538\" y=\"335\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><path d=\"M 346 205\nA 14 14 330.00 1 1 354 205\nL 350 191\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 336 191\nQ350,226 364,191\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"337\" y=\"196\" style=\"stroke:none;fill:rgb(238,238,238);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">142.2</text><path d=\"M 543 342\nA 14 14 330.00 1 1 551 342\nL 547 328\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 533 328\nQ547,363 561,328\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"538\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><path d=\"M 281 99\nA 14 14 330.00 1 1 289 99\nL 285 85\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 271 85\nQ285,120 299,85\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"272\" y=\"90\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">144.6</text><path d=\"M 412 242\nA 14 14 330.00 1 1 420 242\nL 416 228\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 402 228\nQ416,263 430,228\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"403\" y=\"233\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24.2</text><path d=\"M 543 261\nA 14 14 330.00 1 1 551 261\nL 547 247\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 533 247\nQ547,282 561,247\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"534\" y=\"252\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80.8</text><text x=\"93\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"159\" y=\"335\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"224\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"290\" y=\"256\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102.6</text><text x=\"421\" y=\"326\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"486\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"93\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"159\" y=\"309\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"224\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"355\" y=\"93\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122.2</text><text x=\"421\" y=\"277\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"486\" y=\"320\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"93\" y=\"264\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"159\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40.4</text><text x=\"224\" y=\"276\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.4</text><text x=\"290\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.8</text><text x=\"355\" y=\"69\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24.4</text><text x=\"486\" y=\"279\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40.8</text></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 217 29\nL 247 29\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"232\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"249\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 280 29\nL 310 29\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"295\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"312\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 342 29\nL 372 29\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"357\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"374\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"19\" y=\"62\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">297</text><text x=\"19\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">264</text><text x=\"19\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">231</text><text x=\"19\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">198</text><text x=\"19\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">165</text><text x=\"19\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">132</text><text x=\"28\" y=\"259\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">99</text><text x=\"28\" y=\"292\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">66</text><text x=\"28\" y=\"325\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">33</text><text x=\"37\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 52 56\nL 580 56\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 89\nL 580 89\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 122\nL 580 122\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 155\nL 580 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 188\nL 580 188\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 221\nL 580 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 254\nL 580 254\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 287\nL 580 287\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 320\nL 580 320\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 56 354\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 56 359\nL 56 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 121 359\nL 121 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 187 359\nL 187 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 252 359\nL 252 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 318 359\nL 318 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 383 359\nL 383 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 449 359\nL 449 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 359\nL 514 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 359\nL 580 354\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"84\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"150\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"215\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"281\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"346\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"412\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"477\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"543\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><path d=\"M 88.75 349.08\nL 154.25 330.72\nL 219.75 328.31\nL 285.25 251.05\nL 350.75 211.32\nL 416.25 321.29\nL 481.75 333.93\nL 547.25 350.69\nL 547.25 354\nL 88.75 354\nL 88.75 349.08\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 88.75 349.08\nL 154.25 330.72\nL 219.75 328.31\nL 285.25 251.05\nL 350.75 211.32\nL 416.25 321.29\nL 481.75 333.93\nL 547.25 350.69\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"88.75\" cy=\"349.08\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"154.25\" cy=\"330.72\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"219.75\" cy=\"328.31\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"285.25\" cy=\"251.05\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"350.75\" cy=\"211.32\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"416.25\" cy=\"321.29\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"481.75\" cy=\"333.93\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"547.25\" cy=\"350.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 88.75 340.05\nL 154.25 304.23\nL 219.75 299.52\nL 285.25 105.97\nL 350.75 88.71\nL 416.25 272.43\nL 481.75 315.07\nL 547.25 348.38\nL 547.25 350.69\nL 481.75 333.93\nL 416.25 321.29\nL 350.75 211.32\nL 285.25 251.05\nL 219.75 328.31\nL 154.25 330.72\nL 88.75 349.08\nL 88.75 340.05\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 88.75 340.05\nL 154.25 304.23\nL 219.75 299.52\nL 285.25 105.97\nL 350.75 88.71\nL 416.25 272.43\nL 481.75 315.07\nL 547.25 348.38\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"88.75\" cy=\"340.05\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"154.25\" cy=\"304.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"219.75\" cy=\"299.52\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"285.25\" cy=\"105.97\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"350.75\" cy=\"88.71\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"416.25\" cy=\"272.43\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"481.75\" cy=\"315.07\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"547.25\" cy=\"348.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 88.75 259.78\nL 154.25 263.7\nL 219.75 271.02\nL 285.25 77.07\nL 350.75 64.23\nL 416.25 248.14\nL 481.75 274.13\nL 547.25 267.31\nL 547.25 348.38\nL 481.75 315.07\nL 416.25 272.43\nL 350.75 88.71\nL 285.25 105.97\nL 219.75 299.52\nL 154.25 304.23\nL 88.75 340.05\nL 88.75 259.78\" style=\"stroke:none;fill:rgba(250,200,88,0.8)\"/><path d=\"M 88.75 259.78\nL 154.25 263.7\nL 219.75 271.02\nL 285.25 77.07\nL 350.75 64.23\nL 416.25 248.14\nL 481.75 274.13\nL 547.25 267.31\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"88.75\" cy=\"259.78\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"154.25\" cy=\"263.7\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"219.75\" cy=\"271.02\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"285.25\" cy=\"77.07\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"350.75\" cy=\"64.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"416.25\" cy=\"248.14\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"481.75\" cy=\"274.13\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"547.25\" cy=\"267.31\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 543 344\nA 14 14 330.00 1 1 551 344\nL 547 330\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 533 330\nQ547,365 561,330\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"538\" y=\"335\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><path d=\"M 346 205\nA 14 14 330.00 1 1 354 205\nL 350 191\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 336 191\nQ350,226 364,191\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"337\" y=\"196\" style=\"stroke:none;fill:rgb(238,238,238);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">142.2</text><path d=\"M 543 342\nA 14 14 330.00 1 1 551 342\nL 547 328\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 533 328\nQ547,363 561,328\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"538\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><path d=\"M 281 99\nA 14 14 330.00 1 1 289 99\nL 285 85\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 271 85\nQ285,120 299,85\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"272\" y=\"90\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">144.6</text><path d=\"M 412 242\nA 14 14 330.00 1 1 420 242\nL 416 228\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 402 228\nQ416,263 430,228\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"403\" y=\"233\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24.2</text><path d=\"M 543 261\nA 14 14 330.00 1 1 551 261\nL 547 247\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 533 247\nQ547,282 561,247\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"534\" y=\"252\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80.8</text><text x=\"93\" y=\"354\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"159\" y=\"335\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"224\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"290\" y=\"256\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102.6</text><text x=\"421\" y=\"326\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"486\" y=\"338\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"93\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"159\" y=\"309\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"224\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"355\" y=\"93\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122.2</text><text x=\"421\" y=\"277\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"486\" y=\"320\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"93\" y=\"264\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"159\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40.4</text><text x=\"224\" y=\"276\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.4</text><text x=\"290\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.8</text><text x=\"355\" y=\"69\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24.4</text><text x=\"486\" y=\"279\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40.8</text></svg>",
 			pngCRC:      0x5da602e5,
 		},
 		{
@@ -781,7 +785,7 @@ func TestLineChart(t *testing.T) {
 				opt.XAxis.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 55 375\nL 125 354\nL 195 351\nL 265 263\nL 335 218\nL 405 343\nL 475 358\nL 545 377\nL 545 380\nL 55 380\nL 55 375\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 55 375\nL 125 354\nL 195 351\nL 265 263\nL 335 218\nL 405 343\nL 475 358\nL 545 377\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"55\" cy=\"375\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"125\" cy=\"354\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"195\" cy=\"351\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"265\" cy=\"263\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"335\" cy=\"218\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"405\" cy=\"343\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"475\" cy=\"358\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"545\" cy=\"377\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 55 365\nL 125 324\nL 195 318\nL 265 98\nL 335 78\nL 405 287\nL 475 336\nL 545 374\nL 545 377\nL 475 358\nL 405 343\nL 335 218\nL 265 263\nL 195 351\nL 125 354\nL 55 375\nL 55 365\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 55 365\nL 125 324\nL 195 318\nL 265 98\nL 335 78\nL 405 287\nL 475 336\nL 545 374\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"55\" cy=\"365\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"125\" cy=\"324\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"195\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"265\" cy=\"98\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"335\" cy=\"78\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"405\" cy=\"287\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"475\" cy=\"336\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"545\" cy=\"374\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 55 273\nL 125 277\nL 195 286\nL 265 65\nL 335 50\nL 405 260\nL 475 289\nL 545 282\nL 545 374\nL 475 336\nL 405 287\nL 335 78\nL 265 98\nL 195 318\nL 125 324\nL 55 365\nL 55 273\" style=\"stroke:none;fill:rgba(250,200,88,0.8)\"/><path d=\"M 55 273\nL 125 277\nL 195 286\nL 265 65\nL 335 50\nL 405 260\nL 475 289\nL 545 282\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"55\" cy=\"273\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"125\" cy=\"277\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"195\" cy=\"286\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"265\" cy=\"65\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"335\" cy=\"50\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"405\" cy=\"260\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"475\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"545\" cy=\"282\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 541 370\nA 14 14 330.00 1 1 549 370\nL 545 356\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 531 356\nQ545,391 559,356\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"536\" y=\"361\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><path d=\"M 331 211\nA 14 14 330.00 1 1 339 211\nL 335 197\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 321 197\nQ335,232 349,197\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"322\" y=\"202\" style=\"stroke:none;fill:rgb(238,238,238);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">142.2</text><path d=\"M 541 367\nA 14 14 330.00 1 1 549 367\nL 545 353\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 531 353\nQ545,388 559,353\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"536\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><path d=\"M 261 91\nA 14 14 330.00 1 1 269 91\nL 265 77\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 251 77\nQ265,112 279,77\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"252\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">144.6</text><path d=\"M 401 253\nA 14 14 330.00 1 1 409 253\nL 405 239\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 391 239\nQ405,274 419,239\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"392\" y=\"244\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24.2</text><path d=\"M 541 275\nA 14 14 330.00 1 1 549 275\nL 545 261\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 531 261\nQ545,296 559,261\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"532\" y=\"266\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80.8</text><path d=\"M 471 282\nA 14 14 330.00 1 1 479 282\nL 475 268\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><path d=\"M 461 268\nQ475,303 489,268\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><text x=\"462\" y=\"273\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">79.6</text><path d=\"M 331 43\nA 14 14 330.00 1 1 339 43\nL 335 29\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><path d=\"M 321 29\nQ335,64 349,29\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><text x=\"322\" y=\"34\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">288.8</text><text x=\"60\" y=\"379\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"130\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"200\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"270\" y=\"267\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102.6</text><text x=\"410\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"480\" y=\"362\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"60\" y=\"369\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"130\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"200\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"340\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122.2</text><text x=\"410\" y=\"291\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"480\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"60\" y=\"277\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"130\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40.4</text><text x=\"200\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.4</text><text x=\"270\" y=\"69\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.8</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 55 374.39\nL 125 353.44\nL 195 350.69\nL 265 262.55\nL 335 217.21\nL 405 342.68\nL 475 357.1\nL 545 376.22\nL 545 380\nL 55 380\nL 55 374.39\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 55 374.39\nL 125 353.44\nL 195 350.69\nL 265 262.55\nL 335 217.21\nL 405 342.68\nL 475 357.1\nL 545 376.22\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"55\" cy=\"374.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"125\" cy=\"353.44\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"195\" cy=\"350.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"265\" cy=\"262.55\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"335\" cy=\"217.21\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"405\" cy=\"342.68\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"475\" cy=\"357.1\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"545\" cy=\"376.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 55 364.09\nL 125 323.22\nL 195 317.84\nL 265 97.01\nL 335 77.32\nL 405 286.93\nL 475 335.58\nL 545 373.59\nL 545 376.22\nL 475 357.1\nL 405 342.68\nL 335 217.21\nL 265 262.55\nL 195 350.69\nL 125 353.44\nL 55 374.39\nL 55 364.09\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 55 364.09\nL 125 323.22\nL 195 317.84\nL 265 97.01\nL 335 77.32\nL 405 286.93\nL 475 335.58\nL 545 373.59\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"55\" cy=\"364.09\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"125\" cy=\"323.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"195\" cy=\"317.84\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"265\" cy=\"97.01\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"335\" cy=\"77.32\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"405\" cy=\"286.93\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"475\" cy=\"335.58\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"545\" cy=\"373.59\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 55 272.51\nL 125 276.97\nL 195 285.33\nL 265 64.04\nL 335 49.39\nL 405 259.23\nL 475 288.88\nL 545 281.09\nL 545 373.59\nL 475 335.58\nL 405 286.93\nL 335 77.32\nL 265 97.01\nL 195 317.84\nL 125 323.22\nL 55 364.09\nL 55 272.51\" style=\"stroke:none;fill:rgba(250,200,88,0.8)\"/><path d=\"M 55 272.51\nL 125 276.97\nL 195 285.33\nL 265 64.04\nL 335 49.39\nL 405 259.23\nL 475 288.88\nL 545 281.09\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"55\" cy=\"272.51\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"125\" cy=\"276.97\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"195\" cy=\"285.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"265\" cy=\"64.04\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"335\" cy=\"49.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"405\" cy=\"259.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"475\" cy=\"288.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"545\" cy=\"281.09\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 541 370\nA 14 14 330.00 1 1 549 370\nL 545 356\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 531 356\nQ545,391 559,356\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"536\" y=\"361\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><path d=\"M 331 211\nA 14 14 330.00 1 1 339 211\nL 335 197\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 321 197\nQ335,232 349,197\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"322\" y=\"202\" style=\"stroke:none;fill:rgb(238,238,238);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">142.2</text><path d=\"M 541 367\nA 14 14 330.00 1 1 549 367\nL 545 353\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 531 353\nQ545,388 559,353\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"536\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><path d=\"M 261 91\nA 14 14 330.00 1 1 269 91\nL 265 77\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 251 77\nQ265,112 279,77\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"252\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">144.6</text><path d=\"M 401 253\nA 14 14 330.00 1 1 409 253\nL 405 239\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 391 239\nQ405,274 419,239\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"392\" y=\"244\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">24.2</text><path d=\"M 541 275\nA 14 14 330.00 1 1 549 275\nL 545 261\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 531 261\nQ545,296 559,261\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"532\" y=\"266\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80.8</text><path d=\"M 471 282\nA 14 14 330.00 1 1 479 282\nL 475 268\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><path d=\"M 461 268\nQ475,303 489,268\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><text x=\"462\" y=\"273\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">79.6</text><path d=\"M 331 43\nA 14 14 330.00 1 1 339 43\nL 335 29\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><path d=\"M 321 29\nQ335,64 349,29\nZ\" style=\"stroke:none;fill:rgb(211,211,211)\"/><text x=\"322\" y=\"34\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">288.8</text><text x=\"60\" y=\"379\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"130\" y=\"358\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"200\" y=\"355\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"270\" y=\"267\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">102.6</text><text x=\"410\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"480\" y=\"362\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"60\" y=\"369\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"130\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"200\" y=\"322\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"340\" y=\"82\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">122.2</text><text x=\"410\" y=\"291\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"480\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"60\" y=\"277\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"130\" y=\"281\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40.4</text><text x=\"200\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.4</text><text x=\"270\" y=\"69\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.8</text></svg>",
 			pngCRC: 0x5e435b12,
 		},
 		{
@@ -802,7 +806,7 @@ func TestLineChart(t *testing.T) {
 				opt.XAxis.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 55 375\nL 125 354\nL 195 351\nL 265 263\nL 335 218\nL 405 343\nL 475 358\nL 545 377\nL 545 380\nL 55 380\nL 55 375\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 55 375\nL 125 354\nL 195 351\nL 265 263\nL 335 218\nL 405 343\nL 475 358\nL 545 377\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"55\" cy=\"375\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"125\" cy=\"354\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"195\" cy=\"351\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"265\" cy=\"263\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"335\" cy=\"218\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"405\" cy=\"343\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"475\" cy=\"358\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"545\" cy=\"377\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 55 365\nL 125 324\nL 195 318\nL 265 98\nL 335 78\nL 405 287\nL 475 336\nL 545 374\nL 545 377\nL 475 358\nL 405 343\nL 335 218\nL 265 263\nL 195 351\nL 125 354\nL 55 375\nL 55 365\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 55 365\nL 125 324\nL 195 318\nL 265 98\nL 335 78\nL 405 287\nL 475 336\nL 545 374\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"55\" cy=\"365\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"125\" cy=\"324\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"195\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"265\" cy=\"98\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"335\" cy=\"78\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"405\" cy=\"287\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"475\" cy=\"336\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"545\" cy=\"374\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 55 273\nL 125 277\nL 195 286\nL 265 65\nL 335 50\nL 405 260\nL 475 289\nL 545 282\nL 545 374\nL 475 336\nL 405 287\nL 335 78\nL 265 98\nL 195 318\nL 125 324\nL 55 365\nL 55 273\" style=\"stroke:none;fill:rgba(250,200,88,0.8)\"/><path d=\"M 55 273\nL 125 277\nL 195 286\nL 265 65\nL 335 50\nL 405 260\nL 475 289\nL 545 282\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"55\" cy=\"273\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"125\" cy=\"277\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"195\" cy=\"286\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"265\" cy=\"65\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"335\" cy=\"50\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"405\" cy=\"260\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"475\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"545\" cy=\"282\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"23\" cy=\"223\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 223\nL 562 223\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 218\nL 578 223\nL 562 228\nL 567 223\nL 562 218\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">137.86</text><circle cx=\"23\" cy=\"289\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 289\nL 562 289\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 284\nL 578 289\nL 562 294\nL 567 289\nL 562 284\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"293\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">79.6</text><circle cx=\"23\" cy=\"50\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 50\nL 562 50\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 45\nL 578 50\nL 562 55\nL 567 50\nL 562 45\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"54\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">288.8</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 55 374.39\nL 125 353.44\nL 195 350.69\nL 265 262.55\nL 335 217.21\nL 405 342.68\nL 475 357.1\nL 545 376.22\nL 545 380\nL 55 380\nL 55 374.39\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 55 374.39\nL 125 353.44\nL 195 350.69\nL 265 262.55\nL 335 217.21\nL 405 342.68\nL 475 357.1\nL 545 376.22\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"55\" cy=\"374.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"125\" cy=\"353.44\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"195\" cy=\"350.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"265\" cy=\"262.55\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"335\" cy=\"217.21\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"405\" cy=\"342.68\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"475\" cy=\"357.1\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"545\" cy=\"376.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 55 364.09\nL 125 323.22\nL 195 317.84\nL 265 97.01\nL 335 77.32\nL 405 286.93\nL 475 335.58\nL 545 373.59\nL 545 376.22\nL 475 357.1\nL 405 342.68\nL 335 217.21\nL 265 262.55\nL 195 350.69\nL 125 353.44\nL 55 374.39\nL 55 364.09\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 55 364.09\nL 125 323.22\nL 195 317.84\nL 265 97.01\nL 335 77.32\nL 405 286.93\nL 475 335.58\nL 545 373.59\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"55\" cy=\"364.09\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"125\" cy=\"323.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"195\" cy=\"317.84\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"265\" cy=\"97.01\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"335\" cy=\"77.32\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"405\" cy=\"286.93\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"475\" cy=\"335.58\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"545\" cy=\"373.59\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 55 272.51\nL 125 276.97\nL 195 285.33\nL 265 64.04\nL 335 49.39\nL 405 259.23\nL 475 288.88\nL 545 281.09\nL 545 373.59\nL 475 335.58\nL 405 286.93\nL 335 77.32\nL 265 97.01\nL 195 317.84\nL 125 323.22\nL 55 364.09\nL 55 272.51\" style=\"stroke:none;fill:rgba(250,200,88,0.8)\"/><path d=\"M 55 272.51\nL 125 276.97\nL 195 285.33\nL 265 64.04\nL 335 49.39\nL 405 259.23\nL 475 288.88\nL 545 281.09\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"55\" cy=\"272.51\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"125\" cy=\"276.97\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"195\" cy=\"285.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"265\" cy=\"64.04\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"335\" cy=\"49.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"405\" cy=\"259.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"475\" cy=\"288.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"545\" cy=\"281.09\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"23\" cy=\"223\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 223\nL 562 223\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 218\nL 578 223\nL 562 228\nL 567 223\nL 562 218\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">137.86</text><circle cx=\"23\" cy=\"289\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 289\nL 562 289\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 284\nL 578 289\nL 562 294\nL 567 289\nL 562 284\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"293\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">79.6</text><circle cx=\"23\" cy=\"50\" r=\"3\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 29 50\nL 562 50\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 45\nL 578 50\nL 562 55\nL 567 50\nL 562 45\" style=\"stroke-width:1;stroke:rgb(211,211,211);fill:rgb(211,211,211)\"/><text x=\"580\" y=\"54\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">288.8</text></svg>",
 			pngCRC: 0x991cde84,
 		},
 		{
@@ -820,7 +824,7 @@ func TestLineChart(t *testing.T) {
 				opt.XAxis.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"563\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">83</text><text x=\"563\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">76</text><text x=\"563\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">69</text><text x=\"563\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">62</text><text x=\"563\" y=\"185\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">55</text><text x=\"563\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"563\" y=\"264\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">41</text><text x=\"563\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">34</text><text x=\"563\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">27</text><text x=\"563\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"19\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">270</text><text x=\"19\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"19\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"19\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"19\" y=\"185\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"19\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"28\" y=\"264\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><text x=\"28\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"28\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30</text><text x=\"37\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 52 20\nL 553 20\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 60\nL 553 60\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 100\nL 553 100\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 140\nL 553 140\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 180\nL 553 180\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 220\nL 553 220\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 260\nL 553 260\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 300\nL 553 300\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 340\nL 553 340\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 87 374\nL 149 350\nL 211 346\nL 273 244\nL 335 191\nL 397 337\nL 459 354\nL 521 376\nL 521 380\nL 87 380\nL 87 374\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 87 374\nL 149 350\nL 211 346\nL 273 244\nL 335 191\nL 397 337\nL 459 354\nL 521 376\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"87\" cy=\"374\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"149\" cy=\"350\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"211\" cy=\"346\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"273\" cy=\"244\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"335\" cy=\"191\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"397\" cy=\"337\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"459\" cy=\"354\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"521\" cy=\"376\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 87 362\nL 149 314\nL 211 308\nL 273 51\nL 335 28\nL 397 272\nL 459 329\nL 521 373\nL 521 376\nL 459 354\nL 397 337\nL 335 191\nL 273 244\nL 211 346\nL 149 350\nL 87 374\nL 87 362\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 87 362\nL 149 314\nL 211 308\nL 273 51\nL 335 28\nL 397 272\nL 459 329\nL 521 373\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"87\" cy=\"362\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"149\" cy=\"314\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"211\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"273\" cy=\"51\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"335\" cy=\"28\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"397\" cy=\"272\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"459\" cy=\"329\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"521\" cy=\"373\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 87 38\nL 149 264\nL 211 333\nL 273 330\nL 335 355\nL 397 357\nL 459 262\nL 521 33\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"87\" cy=\"38\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"149\" cy=\"264\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"211\" cy=\"333\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"273\" cy=\"330\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"335\" cy=\"355\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"397\" cy=\"357\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"459\" cy=\"262\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"521\" cy=\"33\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"563\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">83</text><text x=\"563\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">76</text><text x=\"563\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">69</text><text x=\"563\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">62</text><text x=\"563\" y=\"185\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">55</text><text x=\"563\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">48</text><text x=\"563\" y=\"264\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">41</text><text x=\"563\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">34</text><text x=\"563\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">27</text><text x=\"563\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"19\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">270</text><text x=\"19\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"19\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"19\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"19\" y=\"185\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"19\" y=\"224\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"28\" y=\"264\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><text x=\"28\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"28\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30</text><text x=\"37\" y=\"384\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 52 20\nL 553 20\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 60\nL 553 60\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 100\nL 553 100\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 140\nL 553 140\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 180\nL 553 180\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 220\nL 553 220\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 260\nL 553 260\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 300\nL 553 300\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 52 340\nL 553 340\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 87.06 373.47\nL 149.19 349.07\nL 211.31 345.87\nL 273.44 243.2\nL 335.56 190.4\nL 397.69 336.53\nL 459.81 353.33\nL 521.94 375.6\nL 521.94 380\nL 87.06 380\nL 87.06 373.47\" style=\"stroke:none;fill:rgba(84,112,198,0.8)\"/><path d=\"M 87.06 373.47\nL 149.19 349.07\nL 211.31 345.87\nL 273.44 243.2\nL 335.56 190.4\nL 397.69 336.53\nL 459.81 353.33\nL 521.94 375.6\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"87.06\" cy=\"373.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"149.19\" cy=\"349.07\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"211.31\" cy=\"345.87\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"273.44\" cy=\"243.2\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"335.56\" cy=\"190.4\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"397.69\" cy=\"336.53\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"459.81\" cy=\"353.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"521.94\" cy=\"375.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 87.06 361.47\nL 149.19 313.87\nL 211.31 307.6\nL 273.44 50.4\nL 335.56 27.47\nL 397.69 271.6\nL 459.81 328.27\nL 521.94 372.53\nL 521.94 375.6\nL 459.81 353.33\nL 397.69 336.53\nL 335.56 190.4\nL 273.44 243.2\nL 211.31 345.87\nL 149.19 349.07\nL 87.06 373.47\nL 87.06 361.47\" style=\"stroke:none;fill:rgba(145,204,117,0.8)\"/><path d=\"M 87.06 361.47\nL 149.19 313.87\nL 211.31 307.6\nL 273.44 50.4\nL 335.56 27.47\nL 397.69 271.6\nL 459.81 328.27\nL 521.94 372.53\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"87.06\" cy=\"361.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"149.19\" cy=\"313.87\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"211.31\" cy=\"307.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"273.44\" cy=\"50.4\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"335.56\" cy=\"27.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"397.69\" cy=\"271.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"459.81\" cy=\"328.27\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"521.94\" cy=\"372.53\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 87.06 37.14\nL 149.19 263.43\nL 211.31 332\nL 273.44 329.71\nL 335.56 354.86\nL 397.69 356\nL 459.81 261.14\nL 521.94 32.57\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"87.06\" cy=\"37.14\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"149.19\" cy=\"263.43\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"211.31\" cy=\"332\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"273.44\" cy=\"329.71\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"335.56\" cy=\"354.86\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"397.69\" cy=\"356\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"459.81\" cy=\"261.14\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"521.94\" cy=\"32.57\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/></svg>",
 			pngCRC: 0x419291da,
 		},
 		{
@@ -839,7 +843,7 @@ func TestLineChart(t *testing.T) {
 				opt.XAxis.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 21 35\nL 51 35\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"36\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"53\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 112 35\nL 142 35\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"127\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"144\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 235 35\nL 265 35\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"250\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"267\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 357 35\nL 387 35\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"372\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"389\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 450 35\nL 480 35\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"465\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"482\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path d=\"M 51 356\nL 133 337\nL 216 344\nL 299 355\nL 382 347\nL 465 314\nL 548 296\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51\" cy=\"356\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"133\" cy=\"337\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"216\" cy=\"344\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"299\" cy=\"355\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"382\" cy=\"347\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"465\" cy=\"314\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"548\" cy=\"296\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 51 316\nL 133 314\nL 216 321\nL 299 313\nL 382 300\nL 465 314\nL 548 316\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51\" cy=\"316\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"133\" cy=\"314\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"216\" cy=\"321\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"299\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"382\" cy=\"300\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"465\" cy=\"314\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"548\" cy=\"316\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 51 201\nL 133 175\nL 216 182\nL 299 175\nL 382 92\nL 465 83\nL 548 85\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"51\" cy=\"201\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"133\" cy=\"175\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"216\" cy=\"182\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"299\" cy=\"175\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"382\" cy=\"92\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"465\" cy=\"83\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"548\" cy=\"85\" r=\"2\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 51 363\nL 133 360\nL 216 367\nL 299 360\nL 382 370\nL 465 337\nL 548 342\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"51\" cy=\"363\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"133\" cy=\"360\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"216\" cy=\"367\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"299\" cy=\"360\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"382\" cy=\"370\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"465\" cy=\"337\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"548\" cy=\"342\" r=\"2\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><path d=\"M 51 340\nL 133 348\nL 216 346\nL 299 336\nL 382 323\nL 465 314\nL 548 319\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"51\" cy=\"340\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"133\" cy=\"348\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"216\" cy=\"346\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"299\" cy=\"336\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"382\" cy=\"323\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"465\" cy=\"314\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"548\" cy=\"319\" r=\"2\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"13\" cy=\"201\" r=\"3\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 19 201\nL 572 201\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 572 196\nL 588 201\nL 572 206\nL 577 201\nL 572 196\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"590\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">820</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 21 35\nL 51 35\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"36\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"53\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 112 35\nL 142 35\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"127\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"144\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 235 35\nL 265 35\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"250\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"267\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 357 35\nL 387 35\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"372\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"389\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 450 35\nL 480 35\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"465\" cy=\"35\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"482\" y=\"41\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path d=\"M 51.43 355.31\nL 134.29 336.35\nL 217.14 343.52\nL 300 354.39\nL 382.86 346.06\nL 465.71 313.69\nL 548.57 295.19\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51.43\" cy=\"355.31\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"134.29\" cy=\"336.35\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"217.14\" cy=\"343.52\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"354.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"382.86\" cy=\"346.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"465.71\" cy=\"313.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"548.57\" cy=\"295.19\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 51.43 316\nL 134.29 313.23\nL 217.14 320.39\nL 300 312.76\nL 382.86 299.81\nL 465.71 313.69\nL 548.57 316\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51.43\" cy=\"316\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"134.29\" cy=\"313.23\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"217.14\" cy=\"320.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"312.76\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"382.86\" cy=\"299.81\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"465.71\" cy=\"313.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"548.57\" cy=\"316\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path d=\"M 51.43 200.38\nL 134.29 174.47\nL 217.14 181.64\nL 300 174.01\nL 382.86 91.69\nL 465.71 82.44\nL 548.57 84.75\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"51.43\" cy=\"200.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"134.29\" cy=\"174.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"217.14\" cy=\"181.64\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"300\" cy=\"174.01\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"382.86\" cy=\"91.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"465.71\" cy=\"82.44\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><circle cx=\"548.57\" cy=\"84.75\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:white\"/><path d=\"M 51.43 362.25\nL 134.29 359.48\nL 217.14 366.64\nL 300 359.01\nL 382.86 369.19\nL 465.71 336.81\nL 548.57 341.44\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"51.43\" cy=\"362.25\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"134.29\" cy=\"359.48\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"217.14\" cy=\"366.64\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"300\" cy=\"359.01\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"382.86\" cy=\"369.19\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"465.71\" cy=\"336.81\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><circle cx=\"548.57\" cy=\"341.44\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:white\"/><path d=\"M 51.43 339.12\nL 134.29 347.91\nL 217.14 345.83\nL 300 335.89\nL 382.86 322.94\nL 465.71 313.69\nL 548.57 318.31\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"51.43\" cy=\"339.12\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"134.29\" cy=\"347.91\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"217.14\" cy=\"345.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"300\" cy=\"335.89\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"382.86\" cy=\"322.94\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"465.71\" cy=\"313.69\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"548.57\" cy=\"318.31\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:white\"/><circle cx=\"13\" cy=\"201\" r=\"3\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 19 201\nL 572 201\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 572 196\nL 588 201\nL 572 206\nL 577 201\nL 572 196\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"590\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">820</text></svg>",
 			pngCRC: 0x8e307036,
 		},
 		{
@@ -853,7 +857,7 @@ func TestLineChart(t *testing.T) {
 				opt.YAxis[0].Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 250 19\nL 280 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 51 362\nL 133 359\nL 216 366\nL 299 358\nL 382 369\nL 465 336\nL 548 340\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51\" cy=\"362\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"133\" cy=\"359\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"216\" cy=\"366\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"299\" cy=\"358\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"382\" cy=\"369\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"465\" cy=\"336\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"548\" cy=\"340\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 51 195\nL 133 168\nL 216 175\nL 299 167\nL 382 82\nL 465 73\nL 548 75\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51\" cy=\"195\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"133\" cy=\"168\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"216\" cy=\"175\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"299\" cy=\"167\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"382\" cy=\"82\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"465\" cy=\"73\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"548\" cy=\"75\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 250 19\nL 280 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 51.43 361.33\nL 134.29 358.47\nL 217.14 365.87\nL 300 357.99\nL 382.86 368.5\nL 465.71 335.06\nL 548.57 339.83\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51.43\" cy=\"361.33\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"134.29\" cy=\"358.47\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"217.14\" cy=\"365.87\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"300\" cy=\"357.99\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"382.86\" cy=\"368.5\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"465.71\" cy=\"335.06\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"548.57\" cy=\"339.83\" r=\"3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 51.43 194.11\nL 134.29 167.36\nL 217.14 174.76\nL 300 166.88\nL 382.86 81.83\nL 465.71 72.28\nL 548.57 74.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51.43\" cy=\"194.11\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"134.29\" cy=\"167.36\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"217.14\" cy=\"174.76\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"300\" cy=\"166.88\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"382.86\" cy=\"81.83\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"465.71\" cy=\"72.28\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"548.57\" cy=\"74.67\" r=\"3\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0xd6c1a8b7,
 		},
 		{
@@ -867,7 +871,7 @@ func TestLineChart(t *testing.T) {
 				opt.YAxis[0].Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 250 19\nL 280 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"265\" cy=\"19\" r=\"2\" style=\"stroke-width:3;stroke:white;fill:white\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"326\" cy=\"19\" r=\"2\" style=\"stroke-width:3;stroke:white;fill:white\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 51 362\nL 133 359\nL 216 366\nL 299 358\nL 382 369\nL 465 336\nL 548 340\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51\" cy=\"362\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"133\" cy=\"359\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"216\" cy=\"366\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"299\" cy=\"358\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"382\" cy=\"369\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"465\" cy=\"336\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"548\" cy=\"340\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 51 195\nL 133 168\nL 216 175\nL 299 167\nL 382 82\nL 465 73\nL 548 75\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51\" cy=\"195\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"133\" cy=\"168\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"216\" cy=\"175\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"299\" cy=\"167\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"382\" cy=\"82\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"465\" cy=\"73\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"548\" cy=\"75\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 250 19\nL 280 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"265\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"265\" cy=\"19\" r=\"2\" style=\"stroke-width:3;stroke:white;fill:white\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><circle cx=\"326\" cy=\"19\" r=\"2\" style=\"stroke-width:3;stroke:white;fill:white\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 51.43 361.33\nL 134.29 358.47\nL 217.14 365.87\nL 300 357.99\nL 382.86 368.5\nL 465.71 335.06\nL 548.57 339.83\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"51.43\" cy=\"361.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"134.29\" cy=\"358.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"217.14\" cy=\"365.87\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"357.99\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"382.86\" cy=\"368.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"465.71\" cy=\"335.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"548.57\" cy=\"339.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 51.43 194.11\nL 134.29 167.36\nL 217.14 174.76\nL 300 166.88\nL 382.86 81.83\nL 465.71 72.28\nL 548.57 74.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"51.43\" cy=\"194.11\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"134.29\" cy=\"167.36\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"217.14\" cy=\"174.76\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"166.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"382.86\" cy=\"81.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"465.71\" cy=\"72.28\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"548.57\" cy=\"74.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0x7f4ddd68,
 		},
 		{
@@ -881,7 +885,7 @@ func TestLineChart(t *testing.T) {
 				opt.YAxis[0].Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 250 13\nL 280 13\nL 280 26\nL 250 26\nL 250 13\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 13\nL 341 13\nL 341 26\nL 311 26\nL 311 13\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 51 362\nL 133 359\nL 216 366\nL 299 358\nL 382 369\nL 465 336\nL 548 340\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 48 359\nL 54 359\nL 54 365\nL 48 365\nL 48 359\nM 130 356\nL 136 356\nL 136 362\nL 130 362\nL 130 356\nM 213 363\nL 219 363\nL 219 369\nL 213 369\nL 213 363\nM 296 355\nL 302 355\nL 302 361\nL 296 361\nL 296 355\nM 379 366\nL 385 366\nL 385 372\nL 379 372\nL 379 366\nM 462 333\nL 468 333\nL 468 339\nL 462 339\nL 462 333\nM 545 337\nL 551 337\nL 551 343\nL 545 343\nL 545 337\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 51 195\nL 133 168\nL 216 175\nL 299 167\nL 382 82\nL 465 73\nL 548 75\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 48 192\nL 54 192\nL 54 198\nL 48 198\nL 48 192\nM 130 165\nL 136 165\nL 136 171\nL 130 171\nL 130 165\nM 213 172\nL 219 172\nL 219 178\nL 213 178\nL 213 172\nM 296 164\nL 302 164\nL 302 170\nL 296 170\nL 296 164\nM 379 79\nL 385 79\nL 385 85\nL 379 85\nL 379 79\nM 462 70\nL 468 70\nL 468 76\nL 462 76\nL 462 70\nM 545 72\nL 551 72\nL 551 78\nL 545 78\nL 545 72\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 250 13\nL 280 13\nL 280 26\nL 250 26\nL 250 13\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 311 13\nL 341 13\nL 341 26\nL 311 26\nL 311 13\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"343\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 51.43 361.33\nL 134.29 358.47\nL 217.14 365.87\nL 300 357.99\nL 382.86 368.5\nL 465.71 335.06\nL 548.57 339.83\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 48.63 358.53\nL 54.23 358.53\nL 54.23 364.13\nL 48.63 364.13\nL 48.63 358.53\nM 131.49 355.67\nL 137.09 355.67\nL 137.09 361.27\nL 131.49 361.27\nL 131.49 355.67\nM 214.34 363.07\nL 219.94 363.07\nL 219.94 368.67\nL 214.34 368.67\nL 214.34 363.07\nM 297.2 355.19\nL 302.8 355.19\nL 302.8 360.79\nL 297.2 360.79\nL 297.2 355.19\nM 380.06 365.7\nL 385.66 365.7\nL 385.66 371.3\nL 380.06 371.3\nL 380.06 365.7\nM 462.91 332.26\nL 468.51 332.26\nL 468.51 337.86\nL 462.91 337.86\nL 462.91 332.26\nM 545.77 337.03\nL 551.37 337.03\nL 551.37 342.63\nL 545.77 342.63\nL 545.77 337.03\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 51.43 194.11\nL 134.29 167.36\nL 217.14 174.76\nL 300 166.88\nL 382.86 81.83\nL 465.71 72.28\nL 548.57 74.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 48.63 191.31\nL 54.23 191.31\nL 54.23 196.91\nL 48.63 196.91\nL 48.63 191.31\nM 131.49 164.56\nL 137.09 164.56\nL 137.09 170.16\nL 131.49 170.16\nL 131.49 164.56\nM 214.34 171.96\nL 219.94 171.96\nL 219.94 177.56\nL 214.34 177.56\nL 214.34 171.96\nM 297.2 164.08\nL 302.8 164.08\nL 302.8 169.68\nL 297.2 169.68\nL 297.2 164.08\nM 380.06 79.03\nL 385.66 79.03\nL 385.66 84.63\nL 380.06 84.63\nL 380.06 79.03\nM 462.91 69.48\nL 468.51 69.48\nL 468.51 75.08\nL 462.91 75.08\nL 462.91 69.48\nM 545.77 71.87\nL 551.37 71.87\nL 551.37 77.47\nL 545.77 77.47\nL 545.77 71.87\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x324a2a11,
 		},
 		{
@@ -895,7 +899,7 @@ func TestLineChart(t *testing.T) {
 				opt.YAxis[0].Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 265 10\nL 272 20\nL 265 30\nL 258 20\nL 265 10\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 316 10\nL 323 20\nL 316 30\nL 309 20\nL 316 10\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"333\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 51 362\nL 133 359\nL 216 366\nL 299 358\nL 382 369\nL 465 336\nL 548 340\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 51 358\nL 55 362\nL 51 366\nL 47 362\nL 51 358\nM 133 355\nL 137 359\nL 133 363\nL 129 359\nL 133 355\nM 216 362\nL 220 366\nL 216 370\nL 212 366\nL 216 362\nM 299 354\nL 303 358\nL 299 362\nL 295 358\nL 299 354\nM 382 365\nL 386 369\nL 382 373\nL 378 369\nL 382 365\nM 465 332\nL 469 336\nL 465 340\nL 461 336\nL 465 332\nM 548 336\nL 552 340\nL 548 344\nL 544 340\nL 548 336\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 51 195\nL 133 168\nL 216 175\nL 299 167\nL 382 82\nL 465 73\nL 548 75\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 51 191\nL 55 195\nL 51 199\nL 47 195\nL 51 191\nM 133 164\nL 137 168\nL 133 172\nL 129 168\nL 133 164\nM 216 171\nL 220 175\nL 216 179\nL 212 175\nL 216 171\nM 299 163\nL 303 167\nL 299 171\nL 295 167\nL 299 163\nM 382 78\nL 386 82\nL 382 86\nL 378 82\nL 382 78\nM 465 69\nL 469 73\nL 465 77\nL 461 73\nL 465 69\nM 548 71\nL 552 75\nL 548 79\nL 544 75\nL 548 71\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 265 10\nL 272 20\nL 265 30\nL 258 20\nL 265 10\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"282\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 316 10\nL 323 20\nL 316 30\nL 309 20\nL 316 10\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"333\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><path d=\"M 51.43 361.33\nL 134.29 358.47\nL 217.14 365.87\nL 300 357.99\nL 382.86 368.5\nL 465.71 335.06\nL 548.57 339.83\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 51.43 357.33\nL 55.43 361.33\nL 51.43 365.33\nL 47.43 361.33\nL 51.43 357.33\nM 134.29 354.47\nL 138.29 358.47\nL 134.29 362.47\nL 130.29 358.47\nL 134.29 354.47\nM 217.14 361.87\nL 221.14 365.87\nL 217.14 369.87\nL 213.14 365.87\nL 217.14 361.87\nM 300 353.99\nL 304 357.99\nL 300 361.99\nL 296 357.99\nL 300 353.99\nM 382.86 364.5\nL 386.86 368.5\nL 382.86 372.5\nL 378.86 368.5\nL 382.86 364.5\nM 465.71 331.06\nL 469.71 335.06\nL 465.71 339.06\nL 461.71 335.06\nL 465.71 331.06\nM 548.57 335.83\nL 552.57 339.83\nL 548.57 343.83\nL 544.57 339.83\nL 548.57 335.83\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 51.43 194.11\nL 134.29 167.36\nL 217.14 174.76\nL 300 166.88\nL 382.86 81.83\nL 465.71 72.28\nL 548.57 74.67\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 51.43 190.11\nL 55.43 194.11\nL 51.43 198.11\nL 47.43 194.11\nL 51.43 190.11\nM 134.29 163.36\nL 138.29 167.36\nL 134.29 171.36\nL 130.29 167.36\nL 134.29 163.36\nM 217.14 170.76\nL 221.14 174.76\nL 217.14 178.76\nL 213.14 174.76\nL 217.14 170.76\nM 300 162.88\nL 304 166.88\nL 300 170.88\nL 296 166.88\nL 300 162.88\nM 382.86 77.83\nL 386.86 81.83\nL 382.86 85.83\nL 378.86 81.83\nL 382.86 77.83\nM 465.71 68.28\nL 469.71 72.28\nL 465.71 76.28\nL 461.71 72.28\nL 465.71 68.28\nM 548.57 70.67\nL 552.57 74.67\nL 548.57 78.67\nL 544.57 74.67\nL 548.57 70.67\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/></svg>",
 			pngCRC: 0x2d50e1d9,
 		},
 		{
@@ -916,7 +920,7 @@ func TestLineChart(t *testing.T) {
 				opt.YAxis[0].Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 26 19\nL 56 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"41\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"41\" cy=\"19\" r=\"2\" style=\"stroke-width:3;stroke:white;fill:white\"/><text x=\"58\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 117 13\nL 147 13\nL 147 26\nL 117 26\nL 117 13\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"149\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 245 10\nL 252 20\nL 245 30\nL 238 20\nL 245 10\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"262\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 352 19\nL 382 19\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"367\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"384\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 445 19\nL 475 19\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"460\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"477\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path d=\"M 68 362\nL 184 359\nL 300 366\nL 416 358\nL 532 369\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"68\" cy=\"362\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"184\" cy=\"359\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"366\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"416\" cy=\"358\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"532\" cy=\"369\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 68 338\nL 184 347\nL 300 345\nL 416 335\nL 532 321\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 65 335\nL 71 335\nL 71 341\nL 65 341\nL 65 335\nM 181 344\nL 187 344\nL 187 350\nL 181 350\nL 181 344\nM 297 342\nL 303 342\nL 303 348\nL 297 348\nL 297 342\nM 413 332\nL 419 332\nL 419 338\nL 413 338\nL 413 332\nM 529 318\nL 535 318\nL 535 324\nL 529 324\nL 529 318\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path d=\"M 68 355\nL 184 335\nL 300 342\nL 416 354\nL 532 345\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><path d=\"M 68 351\nL 72 355\nL 68 359\nL 64 355\nL 68 351\nM 184 331\nL 188 335\nL 184 339\nL 180 335\nL 184 331\nM 300 338\nL 304 342\nL 300 346\nL 296 342\nL 300 338\nM 416 350\nL 420 354\nL 416 358\nL 412 354\nL 416 350\nM 532 341\nL 536 345\nL 532 349\nL 528 345\nL 532 341\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><path d=\"M 68 314\nL 184 311\nL 300 319\nL 416 311\nL 532 297\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"68\" cy=\"314\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><circle cx=\"184\" cy=\"311\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><circle cx=\"300\" cy=\"319\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><circle cx=\"416\" cy=\"311\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><circle cx=\"532\" cy=\"297\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><path d=\"M 68 195\nL 184 168\nL 300 175\nL 416 167\nL 532 82\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 26 19\nL 56 19\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"41\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><circle cx=\"41\" cy=\"19\" r=\"2\" style=\"stroke-width:3;stroke:white;fill:white\"/><text x=\"58\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 117 13\nL 147 13\nL 147 26\nL 117 26\nL 117 13\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"149\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 245 10\nL 252 20\nL 245 30\nL 238 20\nL 245 10\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"262\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 352 19\nL 382 19\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"367\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"384\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 445 19\nL 475 19\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"460\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"477\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path d=\"M 68 361.33\nL 184 358.47\nL 300 365.87\nL 416 357.99\nL 532 368.5\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"68\" cy=\"361.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"184\" cy=\"358.47\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"365.87\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"416\" cy=\"357.99\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"532\" cy=\"368.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 68 337.44\nL 184 346.52\nL 300 344.37\nL 416 334.1\nL 532 320.72\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 65.2 334.64\nL 70.8 334.64\nL 70.8 340.24\nL 65.2 340.24\nL 65.2 334.64\nM 181.2 343.72\nL 186.8 343.72\nL 186.8 349.32\nL 181.2 349.32\nL 181.2 343.72\nM 297.2 341.57\nL 302.8 341.57\nL 302.8 347.17\nL 297.2 347.17\nL 297.2 341.57\nM 413.2 331.3\nL 418.8 331.3\nL 418.8 336.9\nL 413.2 336.9\nL 413.2 331.3\nM 529.2 317.92\nL 534.8 317.92\nL 534.8 323.52\nL 529.2 323.52\nL 529.2 317.92\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><path d=\"M 68 354.17\nL 184 334.58\nL 300 341.98\nL 416 353.21\nL 532 344.61\" style=\"stroke-width:2;stroke:rgb(250,200,88);fill:none\"/><path d=\"M 68 350.17\nL 72 354.17\nL 68 358.17\nL 64 354.17\nL 68 350.17\nM 184 330.58\nL 188 334.58\nL 184 338.58\nL 180 334.58\nL 184 330.58\nM 300 337.98\nL 304 341.98\nL 300 345.98\nL 296 341.98\nL 300 337.98\nM 416 349.21\nL 420 353.21\nL 416 357.21\nL 412 353.21\nL 416 349.21\nM 532 340.61\nL 536 344.61\nL 532 348.61\nL 528 344.61\nL 532 340.61\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><path d=\"M 68 313.56\nL 184 310.69\nL 300 318.09\nL 416 310.21\nL 532 296.83\" style=\"stroke-width:2;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"68\" cy=\"313.56\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><circle cx=\"184\" cy=\"310.69\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><circle cx=\"300\" cy=\"318.09\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><circle cx=\"416\" cy=\"310.21\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><circle cx=\"532\" cy=\"296.83\" r=\"3\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><path d=\"M 68 194.11\nL 184 167.36\nL 300 174.76\nL 416 166.88\nL 532 81.83\" style=\"stroke-width:2;stroke:rgb(115,192,222);fill:none\"/></svg>",
 			pngCRC: 0x40c435ba,
 		},
 		{
@@ -938,7 +942,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.SeriesNames = opt.Legend.SeriesNames[:2]
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"40\" y=\"56\" style=\"stroke:none;fill:red;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 204 49\nL 234 49\" style=\"stroke-width:3;stroke:rgb(91,143,249);fill:none\"/><circle cx=\"219\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(91,143,249);fill:rgb(91,143,249)\"/><text x=\"236\" y=\"55\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 295 49\nL 325 49\" style=\"stroke-width:3;stroke:rgb(90,216,166);fill:none\"/><circle cx=\"310\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(90,216,166);fill:rgb(90,216,166)\"/><text x=\"327\" y=\"55\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><text x=\"39\" y=\"82\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360</text><text x=\"39\" y=\"110\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">330</text><text x=\"39\" y=\"138\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">300</text><text x=\"39\" y=\"167\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">270</text><text x=\"39\" y=\"195\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"39\" y=\"224\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"39\" y=\"252\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"39\" y=\"281\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"39\" y=\"309\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"48\" y=\"338\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 72 76\nL 560 76\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 104\nL 560 104\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 133\nL 560 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 162\nL 560 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 190\nL 560 190\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 219\nL 560 219\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 248\nL 560 248\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 276\nL 560 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 305\nL 560 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 76 334\nL 560 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 76 339\nL 76 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 145 339\nL 145 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 214 339\nL 214 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 283 339\nL 283 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 352 339\nL 352 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 421 339\nL 421 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 490 339\nL 490 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 560 339\nL 560 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"95\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"166\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"233\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"304\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"377\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"444\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"512\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 110 306\nL 179 294\nL 248 324\nL 317 292\nL 386 334\nL 455 201\nL 525 220\" style=\"stroke-width:2;stroke:rgb(91,143,249);fill:none\"/><circle cx=\"110\" cy=\"306\" r=\"2\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"179\" cy=\"294\" r=\"2\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"248\" cy=\"324\" r=\"2\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"317\" cy=\"292\" r=\"2\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"386\" cy=\"334\" r=\"2\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"455\" cy=\"201\" r=\"2\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"525\" cy=\"220\" r=\"2\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><path d=\"M 110 210\nL 179 247\nL 248 238\nL 317 197\nL 386 143\nL 455 105\nL 525 124\" style=\"stroke-width:2;stroke:rgb(90,216,166);fill:none\"/><circle cx=\"110\" cy=\"210\" r=\"2\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"179\" cy=\"247\" r=\"2\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"248\" cy=\"238\" r=\"2\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"317\" cy=\"197\" r=\"2\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"386\" cy=\"143\" r=\"2\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"455\" cy=\"105\" r=\"2\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"525\" cy=\"124\" r=\"2\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"79\" cy=\"282\" r=\"3\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:rgb(91,143,249)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 85 282\nL 542 282\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:rgb(91,143,249)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 277\nL 558 282\nL 542 287\nL 547 282\nL 542 277\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:rgb(91,143,249)\"/><text x=\"560\" y=\"286\" style=\"stroke:none;fill:purple;font-size:12.8px;font-family:'Roboto Medium',sans-serif\">145</text><circle cx=\"79\" cy=\"181\" r=\"3\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:rgb(90,216,166)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 85 181\nL 542 181\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:rgb(90,216,166)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 176\nL 558 181\nL 542 186\nL 547 181\nL 542 176\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:rgb(90,216,166)\"/><text x=\"560\" y=\"185\" style=\"stroke:none;fill:purple;font-size:12.8px;font-family:'Roboto Medium',sans-serif\">251</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"40\" y=\"56\" style=\"stroke:none;fill:red;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 204 49\nL 234 49\" style=\"stroke-width:3;stroke:rgb(91,143,249);fill:none\"/><circle cx=\"219\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(91,143,249);fill:rgb(91,143,249)\"/><text x=\"236\" y=\"55\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 295 49\nL 325 49\" style=\"stroke-width:3;stroke:rgb(90,216,166);fill:none\"/><circle cx=\"310\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(90,216,166);fill:rgb(90,216,166)\"/><text x=\"327\" y=\"55\" style=\"stroke:none;fill:blue;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><text x=\"39\" y=\"82\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360</text><text x=\"39\" y=\"110\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">330</text><text x=\"39\" y=\"138\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">300</text><text x=\"39\" y=\"167\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">270</text><text x=\"39\" y=\"195\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"39\" y=\"224\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">210</text><text x=\"39\" y=\"252\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"39\" y=\"281\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"39\" y=\"309\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"48\" y=\"338\" style=\"stroke:none;fill:green;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 72 76\nL 560 76\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 104\nL 560 104\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 133\nL 560 133\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 162\nL 560 162\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 190\nL 560 190\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 219\nL 560 219\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 248\nL 560 248\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 276\nL 560 276\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 72 305\nL 560 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 76 334\nL 560 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 76 339\nL 76 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 145 339\nL 145 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 214 339\nL 214 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 283 339\nL 283 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 352 339\nL 352 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 421 339\nL 421 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 490 339\nL 490 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 560 339\nL 560 334\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"95\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"166\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"233\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"304\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"377\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"444\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"512\" y=\"360\" style=\"stroke:none;fill:aqua;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path d=\"M 110.57 305.33\nL 179.71 293.87\nL 248.86 323.49\nL 318 291.96\nL 387.14 334\nL 456.29 200.22\nL 525.43 219.33\" style=\"stroke-width:2;stroke:rgb(91,143,249);fill:none\"/><circle cx=\"110.57\" cy=\"305.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"179.71\" cy=\"293.87\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"248.86\" cy=\"323.49\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"318\" cy=\"291.96\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"387.14\" cy=\"334\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"456.29\" cy=\"200.22\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><circle cx=\"525.43\" cy=\"219.33\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:white\"/><path d=\"M 110.57 209.78\nL 179.71 246.09\nL 248.86 237.49\nL 318 196.4\nL 387.14 142.89\nL 456.29 104.67\nL 525.43 123.78\" style=\"stroke-width:2;stroke:rgb(90,216,166);fill:none\"/><circle cx=\"110.57\" cy=\"209.78\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"179.71\" cy=\"246.09\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"248.86\" cy=\"237.49\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"318\" cy=\"196.4\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"387.14\" cy=\"142.89\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"456.29\" cy=\"104.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"525.43\" cy=\"123.78\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:white\"/><circle cx=\"79\" cy=\"282\" r=\"3\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:rgb(91,143,249)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 85 282\nL 542 282\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:rgb(91,143,249)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 277\nL 558 282\nL 542 287\nL 547 282\nL 542 277\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:rgb(91,143,249)\"/><text x=\"560\" y=\"286\" style=\"stroke:none;fill:purple;font-size:12.8px;font-family:'Roboto Medium',sans-serif\">145</text><circle cx=\"79\" cy=\"181\" r=\"3\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:rgb(90,216,166)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 85 181\nL 542 181\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:rgb(90,216,166)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 542 176\nL 558 181\nL 542 186\nL 547 181\nL 542 176\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:rgb(90,216,166)\"/><text x=\"560\" y=\"185\" style=\"stroke:none;fill:purple;font-size:12.8px;font-family:'Roboto Medium',sans-serif\">251</text></svg>",
 			pngCRC: 0xebf2e985,
 		},
 		{
@@ -962,7 +966,7 @@ func TestLineChart(t *testing.T) {
 				opt.YAxis[1].SpineLineShow = Ptr(true)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"573\" y=\"116\" style=\"stroke:none;fill:blue;font-size:23px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(90.00,573,116)\">right y-axis</text><path d=\"M 515 10\nL 515 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 10\nL 520 10\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 46\nL 520 46\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 82\nL 520 82\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 119\nL 520 119\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 155\nL 520 155\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 191\nL 520 191\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 228\nL 520 228\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 264\nL 520 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 300\nL 520 300\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 337\nL 520 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"525\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.43k</text><text x=\"525\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.36k</text><text x=\"525\" y=\"88\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.29k</text><text x=\"525\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.22k</text><text x=\"525\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.15k</text><text x=\"525\" y=\"196\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.08k</text><text x=\"525\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.01k</text><text x=\"525\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">940</text><text x=\"525\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">870</text><text x=\"525\" y=\"341\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"27\" y=\"223\" style=\"stroke:none;fill:blue;font-size:23px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(270.00,27,223)\">left y-axis</text><path d=\"M 73 10\nL 73 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 10\nL 73 10\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 46\nL 73 46\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 82\nL 73 82\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 119\nL 73 119\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 155\nL 73 155\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 191\nL 73 191\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 228\nL 73 228\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 264\nL 73 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 300\nL 73 300\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 337\nL 73 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"36\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">234</text><text x=\"36\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">218</text><text x=\"36\" y=\"88\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">202</text><text x=\"36\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">186</text><text x=\"36\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">170</text><text x=\"36\" y=\"196\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">154</text><text x=\"36\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">138</text><text x=\"36\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"36\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106</text><text x=\"45\" y=\"341\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 69 10\nL 514 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 46\nL 514 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 82\nL 514 82\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 119\nL 514 119\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 155\nL 514 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 191\nL 514 191\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 228\nL 514 228\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 264\nL 514 264\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 300\nL 514 300\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><text x=\"263\" y=\"386\" style=\"stroke:none;fill:blue;font-size:23px;font-family:'Roboto Medium',sans-serif\">x-axis</text><path d=\"M 74 337\nL 514 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 74 342\nL 74 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 136 342\nL 136 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 199 342\nL 199 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 262 342\nL 262 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 325 342\nL 325 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 388 342\nL 388 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 451 342\nL 451 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 342\nL 514 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"100\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"162\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"225\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"288\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"352\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"415\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"477\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 105 269\nL 167 242\nL 230 313\nL 293 238\nL 356 337\nL 419 20\nL 482 65\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"105\" cy=\"269\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"167\" cy=\"242\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"230\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"293\" cy=\"238\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"356\" cy=\"337\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"419\" cy=\"20\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"482\" cy=\"65\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 105 327\nL 167 269\nL 230 285\nL 293 268\nL 356 83\nL 419 62\nL 482 68\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"105\" cy=\"327\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"167\" cy=\"269\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"230\" cy=\"285\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"293\" cy=\"268\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"356\" cy=\"83\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"419\" cy=\"62\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"482\" cy=\"68\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"573\" y=\"116\" style=\"stroke:none;fill:blue;font-size:23px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(90.00,573,116)\">right y-axis</text><path d=\"M 515 10\nL 515 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 10\nL 520 10\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 46\nL 520 46\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 82\nL 520 82\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 119\nL 520 119\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 155\nL 520 155\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 191\nL 520 191\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 228\nL 520 228\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 264\nL 520 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 300\nL 520 300\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 515 337\nL 520 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"525\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.43k</text><text x=\"525\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.36k</text><text x=\"525\" y=\"88\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.29k</text><text x=\"525\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.22k</text><text x=\"525\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.15k</text><text x=\"525\" y=\"196\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.08k</text><text x=\"525\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.01k</text><text x=\"525\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">940</text><text x=\"525\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">870</text><text x=\"525\" y=\"341\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"27\" y=\"223\" style=\"stroke:none;fill:blue;font-size:23px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(270.00,27,223)\">left y-axis</text><path d=\"M 73 10\nL 73 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 10\nL 73 10\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 46\nL 73 46\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 82\nL 73 82\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 119\nL 73 119\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 155\nL 73 155\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 191\nL 73 191\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 228\nL 73 228\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 264\nL 73 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 300\nL 73 300\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 68 337\nL 73 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"36\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">234</text><text x=\"36\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">218</text><text x=\"36\" y=\"88\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">202</text><text x=\"36\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">186</text><text x=\"36\" y=\"160\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">170</text><text x=\"36\" y=\"196\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">154</text><text x=\"36\" y=\"232\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">138</text><text x=\"36\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122</text><text x=\"36\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">106</text><text x=\"45\" y=\"341\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">90</text><path d=\"M 69 10\nL 514 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 46\nL 514 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 82\nL 514 82\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 119\nL 514 119\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 155\nL 514 155\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 191\nL 514 191\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 228\nL 514 228\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 264\nL 514 264\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 69 300\nL 514 300\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><text x=\"263\" y=\"386\" style=\"stroke:none;fill:blue;font-size:23px;font-family:'Roboto Medium',sans-serif\">x-axis</text><path d=\"M 74 337\nL 514 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 74 342\nL 74 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 136 342\nL 136 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 199 342\nL 199 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 262 342\nL 262 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 325 342\nL 325 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 388 342\nL 388 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 451 342\nL 451 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 342\nL 514 337\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"100\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"162\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"225\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"288\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"352\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"415\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"477\" y=\"363\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 105.43 268.88\nL 168.29 241.62\nL 231.14 312.02\nL 294 237.08\nL 356.86 337\nL 419.71 19.08\nL 482.57 64.5\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"105.43\" cy=\"268.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"168.29\" cy=\"241.62\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"231.14\" cy=\"312.02\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"294\" cy=\"237.08\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"356.86\" cy=\"337\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"419.71\" cy=\"19.08\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"482.57\" cy=\"64.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 105.43 326.62\nL 168.29 268.49\nL 231.14 284.58\nL 294 267.45\nL 356.86 82.67\nL 419.71 61.9\nL 482.57 67.1\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"105.43\" cy=\"326.62\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"168.29\" cy=\"268.49\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"231.14\" cy=\"284.58\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"294\" cy=\"267.45\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"356.86\" cy=\"82.67\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"419.71\" cy=\"61.9\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"482.57\" cy=\"67.1\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/></svg>",
 			pngCRC: 0xf35320fd,
 		},
 		{
@@ -978,7 +982,7 @@ func TestLineChart(t *testing.T) {
 				})
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">235</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">205</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">175</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">145</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84 334\nL 162 300\nL 240 388\nL 317 295\nL 395 137\nL 473 25\nL 551 81\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84 390\nL 162 334\nL 240 279\nL 317 223\nL 395 167\nL 473 111\nL 551 55\" style=\"stroke-width:2;stroke:red;fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">235</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">205</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">175</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">145</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84.86 333.7\nL 162.57 299.93\nL 240.29 387.19\nL 318 294.3\nL 395.71 136.67\nL 473.43 24.07\nL 551.14 80.37\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84 390\nL 162 334\nL 240 279\nL 317 223\nL 395 167\nL 473 111\nL 551 55\" style=\"stroke-width:2;stroke:red;fill:none\"/></svg>",
 			pngCRC: 0xfb20dad1,
 		},
 		{
@@ -994,7 +998,7 @@ func TestLineChart(t *testing.T) {
 				})
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">235</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">205</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">175</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">145</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84 334\nL 162 300\nL 240 388\nL 317 295\nL 395 137\nL 473 25\nL 551 81\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84 304\nL 162 380\nL 240 348\nL 317 255\nL 395 146\nL 473 66\nL 551 61\" style=\"stroke-width:2;stroke:red;fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">235</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">205</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">175</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">145</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84.86 333.7\nL 162.57 299.93\nL 240.29 387.19\nL 318 294.3\nL 395.71 136.67\nL 473.43 24.07\nL 551.14 80.37\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84 304\nL 162 380\nL 240 348\nL 317 255\nL 395 146\nL 473 66\nL 551 61\" style=\"stroke-width:2;stroke:red;fill:none\"/></svg>",
 			pngCRC: 0x9a6459e8,
 		},
 		{
@@ -1010,7 +1014,7 @@ func TestLineChart(t *testing.T) {
 				})
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">235</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">205</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">175</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">145</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84 334\nL 162 300\nL 240 388\nL 317 295\nL 395 137\nL 473 25\nL 551 81\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84 317\nL 162 341\nL 240 328\nL 317 273\nL 395 152\nL 473 81\nL 551 53\" style=\"stroke-width:2;stroke:red;fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">235</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">205</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">175</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">145</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84.86 333.7\nL 162.57 299.93\nL 240.29 387.19\nL 318 294.3\nL 395.71 136.67\nL 473.43 24.07\nL 551.14 80.37\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84 317\nL 162 341\nL 240 328\nL 317 273\nL 395 152\nL 473 81\nL 551 53\" style=\"stroke-width:2;stroke:red;fill:none\"/></svg>",
 			pngCRC: 0xf8559dd2,
 		},
 		{
@@ -1026,7 +1030,7 @@ func TestLineChart(t *testing.T) {
 				})
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">235</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">205</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">175</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">145</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84 334\nL 162 300\nL 240 388\nL 317 295\nL 395 137\nL 473 25\nL 551 81\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84 317\nL 162 341\nL 240 328\nL 317 273\nL 395 152\nL 473 81\nL 551 53\" style=\"stroke-width:2;stroke:red;fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">235</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">205</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">175</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">145</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">115</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84.86 333.7\nL 162.57 299.93\nL 240.29 387.19\nL 318 294.3\nL 395.71 136.67\nL 473.43 24.07\nL 551.14 80.37\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84 317\nL 162 341\nL 240 328\nL 317 273\nL 395 152\nL 473 81\nL 551 53\" style=\"stroke-width:2;stroke:red;fill:none\"/></svg>",
 			pngCRC: 0xf8559dd2,
 		},
 		{
@@ -1045,7 +1049,7 @@ func TestLineChart(t *testing.T) {
 				})
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">370</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">340</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">310</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">280</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">250</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84 362\nL 162 345\nL 240 389\nL 317 343\nL 395 264\nL 473 208\nL 551 236\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84 222\nL 162 205\nL 240 248\nL 317 202\nL 395 123\nL 473 67\nL 551 95\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 84 390\nL 162 362\nL 240 335\nL 317 307\nL 395 279\nL 473 251\nL 551 223\" style=\"stroke-width:2;stroke:red;fill:none\"/><path stroke-dasharray=\"9.2, 7.4\" d=\"M 84 354\nL 162 366\nL 240 359\nL 317 332\nL 395 271\nL 473 236\nL 551 222\" style=\"stroke-width:2;stroke:rgb(165,42,42);fill:none\"/><path stroke-dasharray=\"9.2, 7.4\" d=\"M 84 347\nQ162,385 193,378\nQ240,369 270,350\nQ317,323 348,301\nQ395,268 426,252\nQ473,228 504,227\nQ473,228 551,226\" style=\"stroke-width:2;stroke:rgb(217,0,116);fill:none\"/><path d=\"M 84 250\nL 162 222\nL 240 194\nL 317 166\nL 395 138\nL 473 110\nL 551 82\" style=\"stroke-width:2;stroke:red;fill:none\"/><path stroke-dasharray=\"9.2, 7.4\" d=\"M 84 213\nL 162 225\nL 240 218\nL 317 191\nL 395 131\nL 473 95\nL 551 81\" style=\"stroke-width:2;stroke:rgb(165,42,42);fill:none\"/><path stroke-dasharray=\"9.2, 7.4\" d=\"M 84 207\nQ162,244 193,238\nQ240,229 270,210\nQ317,182 348,160\nQ395,127 426,111\nQ473,87 504,86\nQ473,87 551,85\" style=\"stroke-width:2;stroke:rgb(217,0,116);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">370</text><text x=\"9\" y=\"58\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">340</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">310</text><text x=\"9\" y=\"142\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">280</text><text x=\"9\" y=\"184\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">250</text><text x=\"9\" y=\"226\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">220</text><text x=\"9\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">190</text><text x=\"9\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"9\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">130</text><text x=\"9\" y=\"394\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path d=\"M 42 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 52\nL 590 52\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 94\nL 590 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 136\nL 590 136\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 178\nL 590 178\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 221\nL 590 221\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 263\nL 590 263\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 305\nL 590 305\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 42 347\nL 590 347\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 84.86 361.85\nL 162.57 344.96\nL 240.29 388.59\nL 318 342.15\nL 395.71 263.33\nL 473.43 207.04\nL 551.14 235.19\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 84.86 221.11\nL 162.57 204.22\nL 240.29 247.85\nL 318 201.41\nL 395.71 122.59\nL 473.43 66.3\nL 551.14 94.44\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 84 390\nL 162 362\nL 240 335\nL 317 307\nL 395 279\nL 473 251\nL 551 223\" style=\"stroke-width:2;stroke:red;fill:none\"/><path stroke-dasharray=\"9.2, 7.4\" d=\"M 84 354\nL 162 366\nL 240 359\nL 317 332\nL 395 271\nL 473 236\nL 551 222\" style=\"stroke-width:2;stroke:rgb(165,42,42);fill:none\"/><path stroke-dasharray=\"9.2, 7.4\" d=\"M 84 347\nQ162,385 193,378\nQ240,369 270,350\nQ317,323 348,301\nQ395,268 426,252\nQ473,228 504,227\nQ473,228 551,226\" style=\"stroke-width:2;stroke:rgb(217,0,116);fill:none\"/><path d=\"M 84 250\nL 162 222\nL 240 194\nL 317 166\nL 395 138\nL 473 110\nL 551 82\" style=\"stroke-width:2;stroke:red;fill:none\"/><path stroke-dasharray=\"9.2, 7.4\" d=\"M 84 213\nL 162 225\nL 240 218\nL 317 191\nL 395 131\nL 473 95\nL 551 81\" style=\"stroke-width:2;stroke:rgb(165,42,42);fill:none\"/><path stroke-dasharray=\"9.2, 7.4\" d=\"M 84 207\nQ162,244 193,238\nQ240,229 270,210\nQ317,182 348,160\nQ395,127 426,111\nQ473,87 504,86\nQ473,87 551,85\" style=\"stroke-width:2;stroke:rgb(217,0,116);fill:none\"/></svg>",
 			pngCRC: 0x7eb47fcd,
 		},
 		{
@@ -1062,7 +1066,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 335\nL 172 332\nL 248 340\nL 324 332\nL 400 342\nL 476 308\nL 552 313\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"340\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"342\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 163\nL 172 135\nL 248 143\nL 324 135\nL 400 47\nL 476 38\nL 552 40\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"163\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"135\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"143\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"135\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"47\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"38\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"40\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path stroke-dasharray=\"8.9, 7.1\" d=\"M 96 336\nL 172 342\nL 248 342\nL 324 347\nL 400 356\nL 476 351\nL 552 315\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/><path stroke-dasharray=\"8.9, 7.1\" d=\"M 96 122\nL 172 124\nL 248 130\nL 324 22\nL 400 10\nL 476 33\nL 552 36\" style=\"stroke-width:2;stroke:rgb(111,202,67);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 334.5\nL 172 331.55\nL 248 339.17\nL 324 331.06\nL 400 341.88\nL 476 307.46\nL 552 312.38\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"334.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"331.55\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"339.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"331.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"341.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"307.46\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"312.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 162.42\nL 172 134.88\nL 248 142.5\nL 324 134.39\nL 400 46.88\nL 476 37.04\nL 552 39.5\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"162.42\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"134.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"142.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"134.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"46.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"37.04\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"39.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path stroke-dasharray=\"8.9, 7.1\" d=\"M 96 336\nL 172 342\nL 248 342\nL 324 347\nL 400 356\nL 476 351\nL 552 315\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/><path stroke-dasharray=\"8.9, 7.1\" d=\"M 96 122\nL 172 124\nL 248 130\nL 324 22\nL 400 10\nL 476 33\nL 552 36\" style=\"stroke-width:2;stroke:rgb(111,202,67);fill:none\"/></svg>",
 			pngCRC: 0x22b1911a,
 		},
 		{
@@ -1076,7 +1080,7 @@ func TestLineChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 335\nL 172 332\nL 248 340\nL 324 332\nL 400 342\nL 476 308\nL 552 313\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"335\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"340\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"342\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 163\nL 172 135\nL 248 143\nL 324 135\nL 400 47\nL 476 38\nL 552 40\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"163\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"135\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"143\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"135\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"47\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"38\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"40\" r=\"2\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path stroke-dasharray=\"8.9, 7.1\" d=\"M 324 350\nL 400 357\nL 476 345\nL 552 348\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"9\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"94\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"133\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"211\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"250\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"368\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 10\nL 590 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 49\nL 590 49\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 88\nL 590 88\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 128\nL 590 128\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 167\nL 590 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 206\nL 590 206\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 246\nL 590 246\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 285\nL 590 285\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 324\nL 590 324\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 364\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 369\nL 58 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 134 369\nL 134 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 210 369\nL 210 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 286 369\nL 286 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 362 369\nL 362 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 438 369\nL 438 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 514 369\nL 514 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 590 369\nL 590 364\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"91\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"167\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"243\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"319\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"396\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"472\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"547\" y=\"390\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 96 334.5\nL 172 331.55\nL 248 339.17\nL 324 331.06\nL 400 341.88\nL 476 307.46\nL 552 312.38\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"96\" cy=\"334.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"172\" cy=\"331.55\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"248\" cy=\"339.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"324\" cy=\"331.06\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"400\" cy=\"341.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"476\" cy=\"307.46\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"552\" cy=\"312.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 96 162.42\nL 172 134.88\nL 248 142.5\nL 324 134.39\nL 400 46.88\nL 476 37.04\nL 552 39.5\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"96\" cy=\"162.42\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"172\" cy=\"134.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"248\" cy=\"142.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"324\" cy=\"134.39\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"400\" cy=\"46.88\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"476\" cy=\"37.04\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"552\" cy=\"39.5\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:white\"/><path stroke-dasharray=\"8.9, 7.1\" d=\"M 324 350\nL 400 357\nL 476 345\nL 552 348\" style=\"stroke-width:2;stroke:rgb(46,80,184);fill:none\"/></svg>",
 			pngCRC: 0x2c4fcad4,
 		},
 	}
@@ -1187,3 +1191,177 @@ func TestBoundaryGapAxisPositions(t *testing.T) {
 	assert.Equal(t, 1, got[0])
 	assert.Equal(t, 8, got[len(got)-1])
 }
+
+func TestBoundaryGapAxisPositionsF(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []float64{0, 5, 10}, boundaryGapAxisPositionsF(10, false, 3))
+	assert.Equal(t, []float64{10}, boundaryGapAxisPositionsF(10, false, 1))
+	assert.Empty(t, boundaryGapAxisPositionsF(10, false, 0))
+
+	got := boundaryGapAxisPositionsF(10, true, 3)
+	require.Len(t, got, 3)
+	assert.InDelta(t, 10.0/6, got[0], 0.0001)
+	assert.InDelta(t, 5.0, got[1], 0.0001)
+	assert.InDelta(t, 50.0/6, got[2], 0.0001)
+}
+
+func TestLineChartBollingerBandTrend(t *testing.T) {
+	t.Parallel()
+
+	opt := makeBasicLineChartOption()
+	opt.SeriesList[0].TrendLine = []SeriesTrendLine{
+		{Type: SeriesTrendTypeBollingerBand, Period: 3, Multiplier: 1.5},
+	}
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.LineChart(opt))
+	data, err := p.Bytes()
+	require.NoError(t, err)
+
+	trendColor := getPreferredTheme(opt.Theme).GetSeriesTrendColor(0)
+	fill := "fill:" + trendColor.WithAlpha(defaultTrendFillOpacity).String()
+	assert.Equal(t, 1, strings.Count(string(data), fill))
+	assert.Equal(t, 3, strings.Count(string(data), "stroke-dasharray"))
+}
+
+func TestStepLinePoints(t *testing.T) {
+	t.Parallel()
+
+	points := []PointF{{X: 0, Y: 10}, {X: 10, Y: 20}, {X: 20, Y: math.MaxInt32}, {X: 30, Y: 5}, {X: 40, Y: 15}}
+
+	tests := []struct {
+		mode     string
+		expected []PointF
+	}{
+		{
+			mode:     "",
+			expected: points,
+		},
+		{
+			mode: LineStepBefore,
+			expected: []PointF{{X: 0, Y: 10}, {X: 0, Y: 20}, {X: 10, Y: 20}, {X: 20, Y: math.MaxInt32},
+				{X: 30, Y: 5}, {X: 30, Y: 15}, {X: 40, Y: 15}},
+		},
+		{
+			mode: LineStepAfter,
+			expected: []PointF{{X: 0, Y: 10}, {X: 10, Y: 10}, {X: 10, Y: 20}, {X: 20, Y: math.MaxInt32},
+				{X: 30, Y: 5}, {X: 40, Y: 5}, {X: 40, Y: 15}},
+		},
+		{
+			mode: LineStepMiddle,
+			expected: []PointF{{X: 0, Y: 10}, {X: 5, Y: 10}, {X: 5, Y: 20}, {X: 10, Y: 20}, {X: 20, Y: math.MaxInt32},
+				{X: 30, Y: 5}, {X: 35, Y: 5}, {X: 35, Y: 15}, {X: 40, Y: 15}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("mode_"+tt.mode, func(t *testing.T) {
+			assert.Equal(t, tt.expected, stepLinePoints(points, tt.mode))
+		})
+	}
+}
+
+func TestLineChartStepMode(t *testing.T) {
+	t.Parallel()
+
+	render := func(opt LineChartOption) string {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.LineChart(opt))
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		return string(data)
+	}
+	makeOption := func() LineChartOption {
+		opt := NewLineChartOptionWithData([][]float64{{1, 3, 2}, {2, 1, 3}})
+		opt.Symbol = SymbolNone
+		opt.XAxis.Show = Ptr(false)
+		opt.YAxis[0].Show = Ptr(false)
+		opt.YAxis[0].Min = Ptr(0.0)
+		opt.YAxis[0].Max = Ptr(6.0)
+		opt.Padding = NewBoxEqual(0)
+		return opt
+	}
+
+	t.Run("chart_step", func(t *testing.T) {
+		opt := makeOption()
+		opt.StepMode = LineStepAfter
+		svg := render(opt)
+		assert.Contains(t, svg, "M 100 333.33\nL 300 333.33\nL 300 200\nL 500 200\nL 500 266.67")
+	})
+
+	t.Run("series_override", func(t *testing.T) {
+		opt := makeOption()
+		opt.StepMode = LineStepAfter
+		opt.SeriesList[1].StepMode = LineStepBefore
+		svg := render(opt)
+		assert.Contains(t, svg, "M 100 266.67\nL 100 333.33\nL 300 333.33\nL 300 200\nL 500 200")
+	})
+
+	t.Run("stacked_fill", func(t *testing.T) {
+		opt := makeOption()
+		opt.StepMode = LineStepMiddle
+		opt.StackSeries = Ptr(true)
+		svg := render(opt)
+		// second series is stacked on the first, filling down to the first series step line
+		assert.Contains(t, svg, "M 0 200\nL 150 200\nL 150 133.33\nL 300 133.33\nL 450 133.33\nL 450 66.67\nL 600 66.67\nL 600 266.67\nL 450 266.67\nL 450 200\nL 300 200\nL 150 200\nL 150 333.33\nL 0 333.33\nL 0 200")
+	})
+}
+
+func TestLineChartSeriesStyle(t *testing.T) {
+	t.Parallel()
+
+	opt := NewLineChartOptionWithData([][]float64{{1, 3, 2}, {2, 1, 3}})
+	opt.Symbol = SymbolNone
+	opt.XAxis.Show = Ptr(false)
+	opt.YAxis[0].Show = Ptr(false)
+	opt.YAxis[0].Min = Ptr(0.0)
+	opt.YAxis[0].Max = Ptr(6.0)
+	opt.Padding = NewBoxEqual(0)
+	opt.SeriesList[0].StrokeDashArray = []float64{6, 3}
+	opt.SeriesList[0].LineStrokeWidth = 3
+	opt.SeriesList[1].LineOpacity = 128
+	opt.SeriesList[1].FillArea = Ptr(true)
+	opt.SeriesList[1].FillOpacity = 51
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.LineChart(opt))
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:white\"/><path stroke-dasharray=\"6.0, 3.0\" d=\"M 100 333.33\nL 300 200\nL 500 266.67\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 100 266.67\nL 300 333.33\nL 500 200\nL 500 400\nL 100 400\nL 100 266.67\" style=\"stroke:none;fill:rgba(145,204,117,0.2)\"/><path d=\"M 100 266.67\nL 300 333.33\nL 500 200\" style=\"stroke-width:2;stroke:rgba(145,204,117,0.5);fill:none\"/></svg>", data)
+}
+
+func TestLineChartFillGradient(t *testing.T) {
+	t.Parallel()
+
+	opt := NewLineChartOptionWithData([][]float64{{1, 3, 2}, {2, 1, 3}})
+	opt.Symbol = SymbolNone
+	opt.XAxis.Show = Ptr(false)
+	opt.YAxis[0].Show = Ptr(false)
+	opt.Padding = NewBoxEqual(0)
+	opt.FillArea = Ptr(true)
+	opt.FillGradient = Ptr(true)
+	opt.SeriesList[1].FillGradient = Ptr(false)
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.LineChart(opt))
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	svg := string(data)
+	assert.Equal(t, 1, strings.Count(svg, "<linearGradient"))
+	assert.Contains(t, svg, `<stop offset="0" stop-color="rgb(84,112,198)" stop-opacity="0.78"/><stop offset="1" stop-color="rgb(84,112,198)" stop-opacity="0"/>`)
+	assert.Equal(t, 1, strings.Count(svg, "fill:url(#gradient-"))
+	assert.Contains(t, svg, "fill:rgba(145,204,117,0.8)")
+
+	// raster output fades from the top of the area to the bottom
+	p = NewPainter(PainterOptions{OutputFormat: ChartOutputPNG, Width: 600, Height: 400})
+	opt.SeriesList = opt.SeriesList[:1]
+	opt.Theme = GetTheme(ThemeLight).WithBackgroundColor(ColorTransparent)
+	require.NoError(t, p.LineChart(opt))
+	data, err = p.Bytes()
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	_, _, _, upperAlpha := img.At(300, 150).RGBA()
+	_, _, _, lowerAlpha := img.At(300, 390).RGBA()
+	assert.Greater(t, upperAlpha, lowerAlpha)
+}
@@ -98,6 +98,12 @@ func (m *markPointPainter) Render() (Box, error) {
 			}
 
 			painter.Pin(p.X, p.Y-opt.symbolSize>>1, opt.symbolSize, opt.fillColor, opt.fillColor, 0.0)
+			if painter.labelLayout != nil {
+				// reserve the pin head and tail
+				r := opt.symbolSize >> 1
+				headY := p.Y - r - opt.symbolSize>>2
+				painter.labelLayout.addObstacle(painter, NewBox(p.X-r, headY-r, p.X+r, p.Y))
+			}
 			text := opt.valueFormatter(value)
 			textBox := painter.MeasureText(text, 0, textStyle)
 			if textStyle.FontSize > smallLabelFontSize && textBox.Width() > opt.symbolSize {
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"image"
+	"image/draw"
+	"io"
 	"math"
 	"strconv"
 	"strings"
@@ -37,11 +40,17 @@ type Painter struct {
 	box          Box
 	theme        ColorPalette
 	font         *truetype.Font
+	interactive  *InteractiveOption
+	accessible   *accessibleState
+	// labelLayout when set collects series labels to be placed together, avoiding overlaps.
+	labelLayout *labelLayout
+	// transparentBackground is set to skip drawing the chart theme background.
+	transparentBackground bool
 }
 
 // PainterOptions contains parameters for creating a new Painter.
 type PainterOptions struct {
-	// OutputFormat specifies the output type: "svg", "png", "jpg". Default is "png".
+	// OutputFormat specifies the output type: "svg", "png", "jpg", "pdf", "terminal". Default is "png".
 	OutputFormat string
 	// Width is the width of the painter canvas.
 	Width int
@@ -51,6 +60,27 @@ type PainterOptions struct {
 	Font *truetype.Font
 	// Theme is the default theme used when charts don't specify one.
 	Theme ColorPalette
+	// Interactive enables grouping chart elements with their data for interactive SVG output, optionally with
+	// embedded tooltip and legend toggle scripts. Ignored for other output formats.
+	Interactive *InteractiveOption
+	// Accessible enables setting the chart title and description as document metadata, and labeling series and data
+	// elements with ARIA attributes in SVG output.
+	Accessible *AccessibleOption
+	// ScaleFactor renders raster output at a multiplied pixel density, for example 2 for high-DPI displays. The layout
+	// is computed at the logical Width and Height, while the image, strokes and fonts are rendered at the scaled
+	// resolution. Ignored for SVG and PDF output.
+	ScaleFactor float64
+	// Image when set renders PNG and JPG output directly onto the provided image, which can be a sub-image to render
+	// into a region of a larger image. Width and Height default to the image size divided by the ScaleFactor.
+	// Ignored for SVG and PDF output.
+	Image draw.Image
+	// TransparentBackground when set to *true skips drawing the chart background, leaving PNG and SVG output
+	// transparent. JPG output is composited over white.
+	TransparentBackground *bool
+	// Encoder configures the JPG quality and PNG compression of raster output.
+	Encoder EncoderOptions
+	// Terminal configures the character mode, width and colors for terminal output.
+	Terminal TerminalOptions
 }
 
 // PainterOptionFunc defines a function that can modify a Painter after creation.
@@ -67,12 +97,14 @@ type ticksOption struct {
 }
 
 type multiTextOption struct {
-	textList       []string
-	fontStyle      FontStyle
-	vertical       bool
-	centerLabels   bool
-	align          string
-	textRotation   float64
+	textList     []string
+	fontStyle    FontStyle
+	vertical     bool
+	centerLabels bool
+	align        string
+	textRotation float64
+	// lineHeight is the distance between baselines (excluding spacing) of multi-line text, measured per text if unset.
+	lineHeight     int
 	offset         OffsetInt
 	firstIndex     int
 	labelCount     int
@@ -117,18 +149,40 @@ func PainterFontOption(font *truetype.Font) PainterOptionFunc {
 
 // NewPainter creates a painter for rendering charts.
 func NewPainter(opts PainterOptions, opt ...PainterOptionFunc) *Painter {
+	targetImage := rasterTargetImage(opts.OutputFormat, opts.Image)
+	if targetImage != nil {
+		width, height := imageLayoutSize(targetImage, opts.ScaleFactor)
+		if opts.Width <= 0 {
+			opts.Width = width
+		}
+		if opts.Height <= 0 {
+			opts.Height = height
+		}
+	}
 	if opts.Width <= 0 {
 		opts.Width = defaultChartWidth
 	}
 	if opts.Height <= 0 {
 		opts.Height = defaultChartHeight
 	}
-	fn := chartdraw.PNG
+	rasterOpt := chartdraw.RasterOptions{
+		Scale:   opts.ScaleFactor,
+		Image:   targetImage,
+		Encoder: opts.Encoder,
+	}
+	fn := chartdraw.PNGWithOptions(rasterOpt)
 	switch opts.OutputFormat {
 	case ChartOutputJPG:
-		fn = chartdraw.JPG
+		fn = chartdraw.JPGWithOptions(rasterOpt)
 	case ChartOutputSVG:
 		fn = chartdraw.SVG
+		if opts.Interactive != nil {
+			fn = opts.Interactive.rendererFunc()
+		}
+	case ChartOutputPDF:
+		fn = chartdraw.PDF
+	case ChartOutputTerminal:
+		fn = chartdraw.Terminal(opts.Terminal)
 	}
 
 	p := &Painter{
@@ -141,11 +195,36 @@ func NewPainter(opts PainterOptions, opt ...PainterOptionFunc) *Painter {
 		},
 		font:  opts.Font,
 		theme: opts.Theme,
+
+		transparentBackground: flagIs(true, opts.TransparentBackground),
+	}
+	if opts.OutputFormat == ChartOutputSVG {
+		p.interactive = opts.Interactive
+	}
+	if opts.Accessible != nil {
+		p.accessible = &accessibleState{opt: *opts.Accessible}
 	}
 	p.setOptions(opt...)
 	return p
 }
 
+// rasterTargetImage returns the image to render onto if the output format is a raster format, otherwise nil.
+func rasterTargetImage(outputFormat string, img draw.Image) draw.Image {
+	if outputFormat == ChartOutputSVG || outputFormat == ChartOutputPDF || outputFormat == ChartOutputTerminal {
+		return nil
+	}
+	return img
+}
+
+// imageLayoutSize returns the chart size which fills the image when rendered at the scale factor.
+func imageLayoutSize(img image.Image, scale float64) (int, int) {
+	if scale <= 0 {
+		scale = 1
+	}
+	bounds := img.Bounds()
+	return int(float64(bounds.Dx()) / scale), int(float64(bounds.Dy()) / scale)
+}
+
 func (p *Painter) setOptions(opts ...PainterOptionFunc) {
 	for _, fn := range opts {
 		fn(p)
@@ -160,6 +239,11 @@ func (p *Painter) Child(opt ...PainterOptionFunc) *Painter {
 		box:          p.box.Clone(),
 		theme:        p.theme,
 		font:         p.font,
+		interactive:  p.interactive,
+		accessible:   p.accessible,
+		labelLayout:  p.labelLayout,
+
+		transparentBackground: p.transparentBackground,
 	}
 	child.setOptions(opt...)
 	return child
@@ -174,6 +258,34 @@ func (p *Painter) Bytes() ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// WriteTo writes the rendered chart to the writer without first buffering the encoded output.
+func (p *Painter) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	err := p.render.Save(cw)
+	return cw.n, err
+}
+
+// countingWriter counts the bytes written to the wrapped writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	return n, err
+}
+
+// Image returns the rendered image for PNG and JPG output without encoding it. When the painter was created with an
+// Image option the same image is returned.
+func (p *Painter) Image() (image.Image, error) {
+	if r, ok := p.render.(chartdraw.ImageRenderer); ok {
+		return r.Image()
+	}
+	return nil, fmt.Errorf("image is only available for raster output, not %q", p.outputFormat)
+}
+
 // moveTo sets the current path cursor to a given point.
 func (p *Painter) moveTo(x, y int) {
 	p.render.MoveTo(x+p.box.Left, y+p.box.Top)
@@ -194,6 +306,58 @@ func (p *Painter) lineTo(x, y int) {
 	p.render.LineTo(x+p.box.Left, y+p.box.Top)
 }
 
+// moveToF sets the current path cursor to a given point with subpixel precision. Renderers without subpixel support
+// are given the position rounded to whole pixels, as are the other float drawing functions.
+func (p *Painter) moveToF(x, y float64) {
+	x, y = x+float64(p.box.Left), y+float64(p.box.Top)
+	if r, ok := p.render.(chartdraw.FloatRenderer); ok {
+		r.MoveToF(x, y)
+	} else {
+		p.render.MoveTo(roundFloatToInt(x), roundFloatToInt(y))
+	}
+}
+
+// lineToF draws a line from the current path cursor to the given point with subpixel precision.
+func (p *Painter) lineToF(x, y float64) {
+	x, y = x+float64(p.box.Left), y+float64(p.box.Top)
+	if r, ok := p.render.(chartdraw.FloatRenderer); ok {
+		r.LineToF(x, y)
+	} else {
+		p.render.LineTo(roundFloatToInt(x), roundFloatToInt(y))
+	}
+}
+
+// quadCurveToF draws a quadratic curve from the current cursor with subpixel precision.
+func (p *Painter) quadCurveToF(cx, cy, x, y float64) {
+	left, top := float64(p.box.Left), float64(p.box.Top)
+	cx, cy, x, y = cx+left, cy+top, x+left, y+top
+	if r, ok := p.render.(chartdraw.FloatRenderer); ok {
+		r.QuadCurveToF(cx, cy, x, y)
+	} else {
+		p.render.QuadCurveTo(roundFloatToInt(cx), roundFloatToInt(cy), roundFloatToInt(x), roundFloatToInt(y))
+	}
+}
+
+// arcToF renders an arc from the current cursor with subpixel precision.
+func (p *Painter) arcToF(cx, cy, rx, ry, startAngle, delta float64) {
+	cx, cy = cx+float64(p.box.Left), cy+float64(p.box.Top)
+	if r, ok := p.render.(chartdraw.FloatRenderer); ok {
+		r.ArcToF(cx, cy, rx, ry, startAngle, delta)
+	} else {
+		p.render.ArcTo(roundFloatToInt(cx), roundFloatToInt(cy), rx, ry, startAngle, delta)
+	}
+}
+
+// circleF adds a circle to the path with subpixel precision, to be drawn with the next fill or stroke.
+func (p *Painter) circleF(radius, x, y float64) {
+	x, y = x+float64(p.box.Left), y+float64(p.box.Top)
+	if r, ok := p.render.(chartdraw.FloatRenderer); ok {
+		r.CircleF(radius, x, y)
+	} else {
+		p.render.Circle(radius, roundFloatToInt(x), roundFloatToInt(y))
+	}
+}
+
 // close finalizes a shape as drawn by the current path.
 func (p *Painter) close() {
 	p.render.Close()
@@ -209,15 +373,38 @@ func (p *Painter) stroke(strokeColor Color, strokeWidth float64) {
 
 // fill performs a fill with the given color, then resets style.
 func (p *Painter) fill(fillColor Color) {
+	p.fillGradient(fillColor, LinearGradient{})
+}
+
+// fillGradient performs a fill with the given gradient, or the color if the gradient is zero, then resets style.
+func (p *Painter) fillGradient(fillColor Color, gradient LinearGradient) {
 	defer p.render.ResetStyle()
-	p.render.SetFillColor(fillColor)
+	p.setFill(fillColor, gradient)
 	p.render.Fill()
 }
 
+// setFill sets the fill color, or the gradient if set. Renderers without gradient support are filled with the color at
+// the middle of the gradient.
+func (p *Painter) setFill(fillColor Color, gradient LinearGradient) {
+	if gradient.IsZero() {
+		p.render.SetFillColor(fillColor)
+	} else if r, ok := p.render.(chartdraw.GradientRenderer); ok {
+		p.render.SetFillColor(fillColor)
+		r.SetFillGradient(gradient)
+	} else {
+		p.render.SetFillColor(gradient.ColorAt(0.5))
+	}
+}
+
 // fillStroke performs a fill+stroke with the given colors and stroke width, then resets the style.
 func (p *Painter) fillStroke(fillColor, strokeColor Color, strokeWidth float64) {
+	p.fillStrokeGradient(fillColor, LinearGradient{}, strokeColor, strokeWidth)
+}
+
+// fillStrokeGradient performs a fill+stroke using the gradient for the fill if set, then resets the style.
+func (p *Painter) fillStrokeGradient(fillColor Color, gradient LinearGradient, strokeColor Color, strokeWidth float64) {
 	defer p.render.ResetStyle()
-	p.render.SetFillColor(fillColor)
+	p.setFill(fillColor, gradient)
 	if strokeWidth > 0 && !strokeColor.IsTransparent() {
 		p.render.SetStrokeColor(strokeColor)
 		p.render.SetStrokeWidth(strokeWidth)
@@ -237,8 +424,29 @@ func (p *Painter) Height() int {
 	return p.box.Height()
 }
 
-// MeasureText returns the rendered size of the text for the provided font style.
+// MeasureText returns the rendered size of the text for the provided font style, including any rich text markup when
+// enabled by FontStyle.RichText.
 func (p *Painter) MeasureText(text string, textRotation float64, fontStyle FontStyle) Box {
+	if text == "" || fontStyle.FontSize == 0 || fontStyle.FontColor.IsTransparent() {
+		return BoxZero
+	}
+	if flagIs(true, fontStyle.RichText) {
+		if fontStyle.Font == nil {
+			fontStyle.Font = getPreferredFont(p.font)
+		}
+		if rich, ok := p.layoutRichText(text, fontStyle); ok {
+			box := rich.box
+			if textRotation != 0 {
+				box = box.Corners().Rotate(chartdraw.RadiansToDegrees(textRotation)).Box()
+			}
+			return box
+		}
+	}
+	return p.measurePlainText(text, textRotation, fontStyle)
+}
+
+// measurePlainText measures the text without parsing rich text markup.
+func (p *Painter) measurePlainText(text string, textRotation float64, fontStyle FontStyle) Box {
 	if text == "" || fontStyle.FontSize == 0 || fontStyle.FontColor.IsTransparent() {
 		return BoxZero
 	}
@@ -263,7 +471,7 @@ func (p *Painter) measureTextMaxWidthHeight(textList []string, textRotation floa
 	}
 	var maxWidth, maxHeight int
 	for _, text := range textList {
-		box := p.MeasureText(text, textRotation, fontStyle)
+		_, _, box := p.measureMultilineText(text, textRotation, fontStyle)
 		if maxWidth < box.Width() {
 			maxWidth = box.Width()
 		}
@@ -286,43 +494,76 @@ func (p *Painter) Circle(radius float64, x, y int, fillColor, strokeColor Color,
 	p.render.FillStroke()
 }
 
-// LineStroke draws a line in the graph from point to point with the specified stroke color/width.
-// Points with values of math.MaxInt32 are skipped, resulting in a gap.
-// Single or isolated points result in just a dot being drawn at the point.
-func (p *Painter) LineStroke(points []Point, strokeColor Color, strokeWidth float64) {
-	valid := make([]Point, 0, len(points))
+// CircleF draws a circle at the given coords with a given radius, without rounding the position or radius.
+func (p *Painter) CircleF(radius, x, y float64, fillColor, strokeColor Color, strokeWidth float64) {
+	defer p.render.ResetStyle()
+	p.render.SetFillColor(fillColor)
+	p.render.SetStrokeColor(strokeColor)
+	p.render.SetStrokeWidth(strokeWidth)
+	p.circleF(radius, x, y)
+	p.render.FillStroke()
+}
+
+// pointsToF converts the points to float points, preserving MaxInt32 break values.
+func pointsToF(points []Point) []PointF {
+	result := make([]PointF, len(points))
+	for i, pt := range points {
+		result[i] = PointF{X: float64(pt.X), Y: float64(pt.Y)}
+	}
+	return result
+}
+
+// drawSegments calls drawPath for each run of points between "null" (MaxInt32) break values, followed by paint.
+func drawSegments(points []PointF, drawPath func([]PointF), paint func()) {
+	valid := make([]PointF, 0, len(points))
 	for _, pt := range points {
 		if pt.Y == math.MaxInt32 {
 			// If we encounter a break, draw the accumulated segment
 			if len(valid) > 0 {
-				p.drawStraightPath(valid, true)
-				p.stroke(strokeColor, strokeWidth)
+				drawPath(valid)
+				paint()
 				valid = valid[:0] // reset
 			}
 			continue
 		}
 		valid = append(valid, pt)
 	}
-	p.drawStraightPath(valid, true)
-	p.stroke(strokeColor, strokeWidth)
+	drawPath(valid)
+	paint()
+}
+
+// LineStroke draws a line in the graph from point to point with the specified stroke color/width.
+// Points with values of math.MaxInt32 are skipped, resulting in a gap.
+// Single or isolated points result in just a dot being drawn at the point.
+func (p *Painter) LineStroke(points []Point, strokeColor Color, strokeWidth float64) {
+	p.LineStrokeF(pointsToF(points), strokeColor, strokeWidth)
+}
+
+// LineStrokeF draws a line through the points with subpixel precision, see LineStroke.
+func (p *Painter) LineStrokeF(points []PointF, strokeColor Color, strokeWidth float64) {
+	drawSegments(points, func(segment []PointF) {
+		p.drawStraightPath(segment, true)
+	}, func() {
+		p.stroke(strokeColor, strokeWidth)
+	})
 }
 
 // drawStraightPath draws a simple (non-curved) path for the given points.
 // If dotForSinglePoint is true, single points are drawn as 2 px radius dots.
-func (p *Painter) drawStraightPath(points []Point, dotForSinglePoint bool) {
+func (p *Painter) drawStraightPath(points []PointF, dotForSinglePoint bool) {
 	pointCount := len(points)
 	switch pointCount {
 	case 0:
 		return
 	case 1:
 		if dotForSinglePoint {
-			p.render.Circle(2.0, points[0].X+p.box.Left, points[0].Y+p.box.Top)
+			p.circleF(2.0, points[0].X, points[0].Y)
 		}
 		return
 	}
-	p.moveTo(points[0].X, points[0].Y)
+	p.moveToF(points[0].X, points[0].Y)
 	for i := 1; i < pointCount; i++ {
-		p.lineTo(points[i].X, points[i].Y)
+		p.lineToF(points[i].X, points[i].Y)
 	}
 }
 
@@ -331,104 +572,88 @@ func (p *Painter) drawStraightPath(points []Point, dotForSinglePoint bool) {
 // Because tension smooths out the line, the line no longer hits the provided points exactly. The more variable
 // the points and the higher the tension, the more the line deviates.
 func (p *Painter) SmoothLineStroke(points []Point, tension float64, strokeColor Color, strokeWidth float64) {
+	p.smoothLineStroke(pointsToF(points), tension, true, strokeColor, strokeWidth, nil)
+}
+
+// SmoothLineStrokeF draws a smooth curve through the points with subpixel precision, see SmoothLineStroke.
+func (p *Painter) SmoothLineStrokeF(points []PointF, tension float64, strokeColor Color, strokeWidth float64) {
+	p.smoothLineStroke(points, tension, false, strokeColor, strokeWidth, nil)
+}
+
+// smoothLineStroke draws a smooth curve, dashed if strokeDashArray is set. When truncate is true the curve end
+// points are truncated to whole pixels.
+func (p *Painter) smoothLineStroke(points []PointF, tension float64, truncate bool,
+	strokeColor Color, strokeWidth float64, strokeDashArray []float64) {
 	if tension <= 0 {
-		p.LineStroke(points, strokeColor, strokeWidth)
+		p.DashedLineStrokeF(points, strokeColor, strokeWidth, strokeDashArray)
 		return
 	} else if tension > 1 {
 		tension = 1
 	}
 
-	valid := make([]Point, 0, len(points)) // Slice to hold valid points between breaks
-	for _, pt := range points {
-		if pt.Y == math.MaxInt32 {
-			// When a line break is found, draw the curve for the accumulated valid points if any
-			if len(valid) > 0 {
-				p.drawSmoothCurve(valid, tension, true)
-				p.stroke(strokeColor, strokeWidth)
-				valid = valid[:0] // reset
-			}
-			continue
-		}
-		valid = append(valid, pt)
-	}
-	// draw any remaining points collected
-	p.drawSmoothCurve(valid, tension, true)
-	p.stroke(strokeColor, strokeWidth)
+	drawSegments(points, func(segment []PointF) {
+		p.drawSmoothCurve(segment, tension, truncate, true)
+	}, func() {
+		p.dashedStroke(strokeColor, strokeWidth, strokeDashArray)
+	})
 }
 
 // drawSmoothCurve handles the actual path drawing (MoveTo/LineTo/QuadCurveTo)
-// but does NOT call Stroke() or Fill(), letting caller do it.
-func (p *Painter) drawSmoothCurve(points []Point, tension float64, dotForSinglePoint bool) {
+// but does NOT call Stroke() or Fill(), letting caller do it. When truncate is true the curve end points between
+// the provided points are truncated to whole pixels.
+func (p *Painter) drawSmoothCurve(points []PointF, tension float64, truncate, dotForSinglePoint bool) {
 	if len(points) < 3 { // Not enough points to form a curve, draw a line
 		p.drawStraightPath(points, dotForSinglePoint)
 		return
 	}
 
-	p.moveTo(points[0].X, points[0].Y) // Start from the first valid point
+	p.moveToF(points[0].X, points[0].Y) // Start from the first valid point
 
 	// Handle each segment between points with quadratic Bézier curves
 	for i := 1; i < len(points)-1; i++ {
 		x1, y1 := points[i].X, points[i].Y
 		x2, y2 := points[i+1].X, points[i+1].Y
 
-		mx := float64(x1+x2) / 2.0
-		my := float64(y1+y2) / 2.0
+		mx := (x1 + x2) / 2.0
+		my := (y1 + y2) / 2.0
 
-		cx := float64(x1) + tension*(mx-float64(x1))
-		cy := float64(y1) + tension*(my-float64(y1))
+		cx := x1 + tension*(mx-x1)
+		cy := y1 + tension*(my-y1)
+		if truncate {
+			cx, cy = math.Trunc(cx), math.Trunc(cy)
+		}
 
-		p.quadCurveTo(x1, y1, int(cx), int(cy))
+		p.quadCurveToF(x1, y1, cx, cy)
 	}
 
 	// Connect the second-to-last point to the last point
 	n := len(points)
-	p.quadCurveTo(points[n-2].X, points[n-2].Y, points[n-1].X, points[n-1].Y)
+	p.quadCurveToF(points[n-2].X, points[n-2].Y, points[n-1].X, points[n-1].Y)
 }
 
 // DashedLineStroke draws line segments through the provided points with dashed strokes.
 // Points with values of math.MaxInt32 are skipped, resulting in a gap.
 func (p *Painter) DashedLineStroke(points []Point, strokeColor Color, strokeWidth float64, strokeDashArray []float64) {
-	valid := make([]Point, 0, len(points))
-	for _, pt := range points {
-		if pt.Y == math.MaxInt32 {
-			// If we encounter a break, draw the accumulated segment
-			if len(valid) > 0 {
-				p.drawStraightPath(valid, true)
-				p.dashedStroke(strokeColor, strokeWidth, strokeDashArray)
-				valid = valid[:0] // reset
-			}
-			continue
-		}
-		valid = append(valid, pt)
-	}
-	p.drawStraightPath(valid, true)
-	p.dashedStroke(strokeColor, strokeWidth, strokeDashArray)
+	p.DashedLineStrokeF(pointsToF(points), strokeColor, strokeWidth, strokeDashArray)
+}
+
+// DashedLineStrokeF draws dashed line segments through the points with subpixel precision, see DashedLineStroke.
+func (p *Painter) DashedLineStrokeF(points []PointF, strokeColor Color, strokeWidth float64, strokeDashArray []float64) {
+	drawSegments(points, func(segment []PointF) {
+		p.drawStraightPath(segment, true)
+	}, func() {
+		p.dashedStroke(strokeColor, strokeWidth, strokeDashArray)
+	})
 }
 
 // SmoothDashedLineStroke draws a smooth dashed curve through the given points.
 func (p *Painter) SmoothDashedLineStroke(points []Point, tension float64, strokeColor Color, strokeWidth float64, strokeDashArray []float64) {
-	if tension <= 0 {
-		p.DashedLineStroke(points, strokeColor, strokeWidth, strokeDashArray)
-		return
-	} else if tension > 1 {
-		tension = 1
-	}
+	p.smoothLineStroke(pointsToF(points), tension, true, strokeColor, strokeWidth, strokeDashArray)
+}
 
-	valid := make([]Point, 0, len(points))
-	for _, pt := range points {
-		if pt.Y == math.MaxInt32 {
-			// If we encounter a break, draw the accumulated segment
-			if len(valid) > 0 {
-				p.drawSmoothCurve(valid, tension, true)
-				p.dashedStroke(strokeColor, strokeWidth, strokeDashArray)
-				valid = valid[:0] // reset
-			}
-			continue
-		}
-		valid = append(valid, pt)
-	}
-	p.drawSmoothCurve(valid, tension, true)
-	p.dashedStroke(strokeColor, strokeWidth, strokeDashArray)
+// SmoothDashedLineStrokeF draws a smooth dashed curve through the points with subpixel precision.
+func (p *Painter) SmoothDashedLineStrokeF(points []PointF, tension float64, strokeColor Color, strokeWidth float64, strokeDashArray []float64) {
+	p.smoothLineStroke(points, tension, false, strokeColor, strokeWidth, strokeDashArray)
 }
 
 // dashedStroke applies the stroke with the given dash array.
@@ -451,6 +676,25 @@ func (p *Painter) FilledRect(x1, y1, x2, y2 int, fillColor, strokeColor Color, s
 	p.fillStroke(fillColor, strokeColor, strokeWidth)
 }
 
+// FilledRectF draws a filled box with the given coordinates, without rounding to whole pixels.
+func (p *Painter) FilledRectF(x1, y1, x2, y2 float64, fillColor, strokeColor Color, strokeWidth float64) {
+	p.rectMoveLineF(x1, y1, x2, y2)
+	p.fillStroke(fillColor, strokeColor, strokeWidth)
+}
+
+// FilledRectGradientF draws a box with the given coordinates filled using the linear gradient, without rounding to
+// whole pixels.
+func (p *Painter) FilledRectGradientF(x1, y1, x2, y2 float64, gradient LinearGradient, strokeColor Color, strokeWidth float64) {
+	p.rectMoveLineF(x1, y1, x2, y2)
+	p.fillStrokeGradient(ColorTransparent, gradient, strokeColor, strokeWidth)
+}
+
+// FilledRectGradient draws a box with the given coordinates filled using the linear gradient.
+func (p *Painter) FilledRectGradient(x1, y1, x2, y2 int, gradient LinearGradient, strokeColor Color, strokeWidth float64) {
+	p.rectMoveLine(x1, y1, x2, y2)
+	p.fillStrokeGradient(ColorTransparent, gradient, strokeColor, strokeWidth)
+}
+
 func (p *Painter) rectMoveLine(x1, y1, x2, y2 int) {
 	p.moveTo(x1, y1)
 	p.lineTo(x2, y1)
@@ -459,6 +703,14 @@ func (p *Painter) rectMoveLine(x1, y1, x2, y2 int) {
 	p.lineTo(x1, y1)
 }
 
+func (p *Painter) rectMoveLineF(x1, y1, x2, y2 float64) {
+	p.moveToF(x1, y1)
+	p.lineToF(x2, y1)
+	p.lineToF(x2, y2)
+	p.lineToF(x1, y2)
+	p.lineToF(x1, y1)
+}
+
 // FilledDiamond draws a filled diamond centered at (cx, cy) with the given width and height.
 func (p *Painter) FilledDiamond(cx, cy, width, height int, fillColor, strokeColor Color, strokeWidth float64) {
 	p.diamondMoveLine(cx, cy, width, height)
@@ -480,6 +732,15 @@ func (p *Painter) diamondMoveLine(cx, cy, width, height int) {
 	p.lineTo(p1x, p1y)
 }
 
+func (p *Painter) diamondMoveLineF(cx, cy, width, height float64) {
+	hw, hh := width/2, height/2
+	p.moveToF(cx, cy-hh)
+	p.lineToF(cx+hw, cy)
+	p.lineToF(cx, cy+hh)
+	p.lineToF(cx-hw, cy)
+	p.lineToF(cx, cy-hh)
+}
+
 // HorizontalMarkLine draws a horizontal line with a small circle and arrow at the right.
 func (p *Painter) HorizontalMarkLine(x, y, width int, fillColor, strokeColor Color, strokeWidth float64, strokeDashArray []float64) {
 	const arrowWidth = 16
@@ -535,7 +796,7 @@ func (p *Painter) VerticalMarkLine(x, y, height int, fillColor, strokeColor Colo
 // Polygon draws a polygon with the specified center, radius, and number of sides.
 func (p *Painter) Polygon(center Point, radius float64, sides int, strokeColor Color, strokeWidth float64) {
 	points := getPolygonPoints(center, radius, sides)
-	p.drawStraightPath(points, false)
+	p.drawStraightPath(pointsToF(points), false)
 	p.lineTo(points[0].X, points[0].Y)
 	p.stroke(strokeColor, strokeWidth)
 }
@@ -642,35 +903,43 @@ func (p *Painter) ArrowDown(x, y, width, height int,
 // FillArea draws a filled polygon through the given points, skipping "null" (MaxInt32) break values
 // (filling the area flat between them).
 func (p *Painter) FillArea(points []Point, fillColor Color) {
+	p.fillArea(pointsToF(points), fillColor, LinearGradient{})
+}
+
+// FillAreaF draws a filled polygon through the points with subpixel precision, see FillArea.
+func (p *Painter) FillAreaF(points []PointF, fillColor Color) {
+	p.fillArea(points, fillColor, LinearGradient{})
+}
+
+// FillAreaGradient draws a polygon through the given points filled using the linear gradient, skipping "null"
+// (MaxInt32) break values.
+func (p *Painter) FillAreaGradient(points []Point, gradient LinearGradient) {
+	p.fillArea(pointsToF(points), ColorTransparent, gradient)
+}
+
+// FillAreaGradientF draws a polygon through the points filled using the linear gradient with subpixel precision.
+func (p *Painter) FillAreaGradientF(points []PointF, gradient LinearGradient) {
+	p.fillArea(points, ColorTransparent, gradient)
+}
+
+func (p *Painter) fillArea(points []PointF, fillColor Color, gradient LinearGradient) {
 	if len(points) == 0 {
 		return
 	}
 
-	valid := make([]Point, 0, len(points))
-	for _, pt := range points {
-		if pt.Y == math.MaxInt32 {
-			// If we encounter a break, fill the accumulated segment
-			if len(valid) > 0 {
-				p.drawStraightPath(valid, false)
-				p.fill(fillColor)
-				valid = valid[:0] // reset
-			}
-			continue
-		}
-		valid = append(valid, pt)
-	}
-
-	// Fill the last segment if there is one
-	p.drawStraightPath(valid, false)
-	p.fill(fillColor)
+	drawSegments(points, func(segment []PointF) {
+		p.drawStraightPath(segment, false)
+	}, func() {
+		p.fillGradient(fillColor, gradient)
+	})
 }
 
 // smoothFillChartArea draws a smooth curve for the "top" portion of points but uses straight lines for
-// the bottom corners, producing a fill with sharp corners.
-func (p *Painter) smoothFillChartArea(points []Point, tension float64, fillColor Color) {
+// the bottom corners, producing a fill with sharp corners. The gradient is used for the fill when set.
+func (p *Painter) smoothFillChartArea(points []PointF, tension float64, fillColor Color, gradient LinearGradient) {
 	pointCount := len(points)
 	if tension <= 0 || pointCount < 4 /* need at least 4 points to curve the line */ {
-		p.FillArea(points, fillColor)
+		p.fillArea(points, fillColor, gradient)
 		return
 	} else if tension > 1 {
 		tension = 1
@@ -684,18 +953,18 @@ func (p *Painter) smoothFillChartArea(points []Point, tension float64, fillColor
 
 	// If top portion is empty or 1 point, just fill straight
 	if len(top) < 2 {
-		p.FillArea(points, fillColor)
+		p.fillArea(points, fillColor, gradient)
 		return
 	}
 
 	// Build the smooth path for the top portion
-	currentSegment := make([]Point, 0, len(top))
+	currentSegment := make([]PointF, 0, len(top))
 	firstPointSet := false
 	for _, pt := range top {
 		if pt.Y == math.MaxInt32 {
 			// If we encounter a break, fill the accumulated segment
 			if len(currentSegment) > 0 {
-				p.drawSmoothCurve(currentSegment, tension, false)
+				p.drawSmoothCurve(currentSegment, tension, false, false)
 				firstPointSet = true
 				currentSegment = currentSegment[:0]
 			}
@@ -706,29 +975,35 @@ func (p *Painter) smoothFillChartArea(points []Point, tension float64, fillColor
 
 	// Draw the remaining top segment
 	if len(currentSegment) > 0 {
-		p.drawSmoothCurve(currentSegment, tension, false)
+		p.drawSmoothCurve(currentSegment, tension, false, false)
 		firstPointSet = true
 	}
 
 	if !firstPointSet {
-		p.FillArea(points, fillColor) // No actual top segment was drawn, fallback to straight fill
+		p.fillArea(points, fillColor, gradient) // No actual top segment was drawn, fallback to straight fill
 		return
 	}
 
 	// Add sharp lines to close the shape at the bottom
 	// The path is currently at the last top point we drew. Now we need to draw to corner1 -> corner2 -> firstTopAgain
 	for i := 0; i < len(bottom); i++ {
-		p.lineTo(bottom[i].X, bottom[i].Y)
+		p.lineToF(bottom[i].X, bottom[i].Y)
 	}
-	p.fill(fillColor)
+	p.fillGradient(fillColor, gradient)
 }
 
 // Text draws the given string at the specified position using the given font style.
-// Specifying radians rotates the text.
+// Specifying radians rotates the text. When FontStyle.RichText is enabled, inline markup such as "{b|bold}" or
+// "{color=red|red}" is drawn as styled runs, see parseRichText for the supported markup.
 func (p *Painter) Text(body string, x, y int, radians float64, fontStyle FontStyle) {
 	if fontStyle.Font == nil {
 		fontStyle.Font = getPreferredFont(p.font)
 	}
+	var rich richText
+	var isRich bool
+	if flagIs(true, fontStyle.RichText) {
+		rich, isRich = p.layoutRichText(body, fontStyle)
+	}
 	defer p.render.ResetStyle()
 	p.render.SetFont(fontStyle.Font)
 	p.render.SetFontSize(fontStyle.FontSize)
@@ -738,9 +1013,35 @@ func (p *Painter) Text(body string, x, y int, radians float64, fontStyle FontSty
 		defer p.render.ClearTextRotation()
 		p.render.SetTextRotation(radians)
 	}
+	if isRich {
+		p.drawRichText(rich, x, y, radians)
+		return
+	}
 	p.render.Text(body, x+p.box.Left, y+p.box.Top)
 }
 
+// TextF draws the given string at the specified position with subpixel precision, see Text.
+func (p *Painter) TextF(body string, x, y float64, radians float64, fontStyle FontStyle) {
+	if fontStyle.Font == nil {
+		fontStyle.Font = getPreferredFont(p.font)
+	}
+	defer p.render.ResetStyle()
+	p.render.SetFont(fontStyle.Font)
+	p.render.SetFontSize(fontStyle.FontSize)
+	p.render.SetFontColor(fontStyle.FontColor)
+
+	if radians != 0 {
+		defer p.render.ClearTextRotation()
+		p.render.SetTextRotation(radians)
+	}
+	x, y = x+float64(p.box.Left), y+float64(p.box.Top)
+	if r, ok := p.render.(chartdraw.FloatRenderer); ok {
+		r.TextF(body, x, y)
+	} else {
+		p.render.Text(body, roundFloatToInt(x), roundFloatToInt(y))
+	}
+}
+
 // TextFit draws multi-line text constrained to a given width.
 func (p *Painter) TextFit(body string, x, y, width int, fontStyle FontStyle, textAligns ...string) Box {
 	if fontStyle.Font == nil {
@@ -789,6 +1090,112 @@ func (p *Painter) TextFit(body string, x, y, width int, fontStyle FontStyle, tex
 	return output
 }
 
+// textEllipsis is appended to text which is truncated to fit a width.
+const textEllipsis = "…"
+
+// truncateText shortens the text with an ellipsis so that it fits within the max width.
+func truncateText(p *Painter, text string, maxWidth int, fontStyle FontStyle) string {
+	if p.MeasureText(text, 0, fontStyle).Width() <= maxWidth {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		truncated := strings.TrimRight(string(runes), " ") + textEllipsis
+		if p.MeasureText(truncated, 0, fontStyle).Width() <= maxWidth {
+			return truncated
+		}
+	}
+	return textEllipsis
+}
+
+// wrapText wraps the text at word boundaries to fit within the width using the same wrapping as TextFit, returning
+// the lines joined with '\n'. Text beyond maxLines (when positive) is truncated with an ellipsis, and true is
+// returned if the text was truncated.
+func (p *Painter) wrapText(text string, width, maxLines int, fontStyle FontStyle) (string, bool) {
+	if fontStyle.Font == nil {
+		fontStyle.Font = getPreferredFont(p.font)
+	}
+	defer p.render.ResetStyle()
+	wrapped := chartdraw.Text.WrapFit(p.render, text, width, chartdraw.Style{
+		FontStyle: fontStyle,
+		TextWrap:  chartdraw.TextWrapWord,
+	})
+	lines := make([]string, 0, len(wrapped))
+	for _, line := range wrapped {
+		if line != "" { // an empty line is produced when the first word is wider than the width
+			lines = append(lines, line)
+		}
+	}
+	var truncated bool
+	if maxLines > 0 && len(lines) > maxLines {
+		// join the remaining text so the ellipsis is placed at the width limit
+		lines[maxLines-1] = strings.Join(lines[maxLines-1:], " ")
+		lines = lines[:maxLines]
+		truncated = true
+	}
+	for i, line := range lines {
+		if fitted := truncateText(p, line, width, fontStyle); fitted != line {
+			lines[i] = fitted
+			truncated = true
+		}
+	}
+	return strings.Join(lines, "\n"), truncated
+}
+
+// measureMultilineText measures text which may contain '\n' separated lines. The lines are returned along with the
+// height of the tallest line, which spaced by the TextFit line spacing is the distance between line baselines. The
+// returned box covers all lines, and is rotated if a rotation is specified.
+func (p *Painter) measureMultilineText(text string, textRotation float64, fontStyle FontStyle) ([]string, int, Box) {
+	lines := strings.Split(text, "\n")
+	if len(lines) == 1 {
+		box := p.MeasureText(text, textRotation, fontStyle)
+		return lines, box.Height(), box
+	}
+	var width, lineHeight int
+	for _, line := range lines {
+		lineBox := p.MeasureText(line, 0, fontStyle)
+		width = chartdraw.MaxInt(width, lineBox.Width())
+		lineHeight = chartdraw.MaxInt(lineHeight, lineBox.Height())
+	}
+	lineSpacing := chartdraw.Style{}.GetTextLineSpacing()
+	box := Box{Right: width, Bottom: len(lines)*lineHeight + (len(lines)-1)*lineSpacing, IsSet: true}
+	if textRotation != 0 {
+		box = box.Corners().Rotate(chartdraw.RadiansToDegrees(textRotation)).Box()
+	}
+	return lines, lineHeight, box
+}
+
+// multilineText draws the lines with the first line baseline at the position and following lines below it. Each
+// line is aligned within the widest line, and the lines are rotated together around the position.
+func (p *Painter) multilineText(lines []string, x, y, lineHeight int, radians float64, fontStyle FontStyle, align string) {
+	if len(lines) == 1 {
+		p.Text(lines[0], x, y, radians, fontStyle)
+		return
+	}
+	widths := make([]int, len(lines))
+	var maxWidth int
+	for i, line := range lines {
+		widths[i] = p.MeasureText(line, 0, fontStyle).Width()
+		maxWidth = chartdraw.MaxInt(maxWidth, widths[i])
+	}
+	lineStep := lineHeight + chartdraw.Style{}.GetTextLineSpacing()
+	sin, cos := math.Sin(radians), math.Cos(radians)
+	for i, line := range lines {
+		var dx int
+		switch align {
+		case AlignRight:
+			dx = maxWidth - widths[i]
+		case AlignCenter:
+			dx = (maxWidth - widths[i]) >> 1
+		}
+		dy := i * lineStep
+		lineX := x + int(math.Round(float64(dx)*cos-float64(dy)*sin))
+		lineY := y + int(math.Round(float64(dx)*sin+float64(dy)*cos))
+		p.Text(line, lineX, lineY, radians, fontStyle)
+	}
+}
+
 // isTick determines whether the given index is a "tick" mark out of numTicks.
 func isTick(totalRange int, numTicks int, index int) bool {
 	if numTicks >= totalRange {
@@ -889,8 +1296,10 @@ func (p *Painter) multiText(opt multiTextOption) {
 			skippedLabels = 0
 		}
 
-		text := opt.textList[index]
-		box := p.MeasureText(text, opt.textRotation, opt.fontStyle)
+		lines, lineHeight, box := p.measureMultilineText(opt.textList[index], opt.textRotation, opt.fontStyle)
+		if len(lines) > 1 && opt.lineHeight > 0 {
+			lineHeight = opt.lineHeight
+		}
 		var x, y int
 		if opt.vertical {
 			if opt.centerLabels {
@@ -899,6 +1308,9 @@ func (p *Painter) multiText(opt multiTextOption) {
 				start = positions[index]
 			}
 			y = start + (box.Height() >> 1)
+			if len(lines) > 1 {
+				y -= box.Height() - lineHeight // move to the baseline of the first line
+			}
 			switch opt.align {
 			case AlignRight:
 				x = width - box.Width()
@@ -931,7 +1343,7 @@ func (p *Painter) multiText(opt multiTextOption) {
 		}
 		x += opt.offset.Left
 		y += opt.offset.Top
-		p.Text(text, x, y, opt.textRotation, opt.fontStyle)
+		p.multilineText(lines, x, y, lineHeight, opt.textRotation, opt.fontStyle, opt.align)
 	}
 }
 
@@ -977,29 +1389,58 @@ func (p *Painter) Dots(points []Point, fillColor, strokeColor Color, strokeWidth
 	p.render.FillStroke()
 }
 
+// DrawImage draws the image scaled to fill the box, relative to the painter box. The opacity ranges from 0 for fully
+// transparent to 1 for opaque, and the filter selects the interpolation used when scaling raster output. Renderers
+// which can't draw images skip it.
+func (p *Painter) DrawImage(img image.Image, box Box, opacity float64, filter ImageFilter) {
+	r, ok := p.render.(chartdraw.DrawImageRenderer)
+	if !ok {
+		return
+	}
+	r.DrawImage(img, Box{
+		Left:   box.Left + p.box.Left,
+		Top:    box.Top + p.box.Top,
+		Right:  box.Right + p.box.Left,
+		Bottom: box.Bottom + p.box.Top,
+		IsSet:  true,
+	}, opacity, filter)
+}
+
+// DotsF prints filled circles for the given points without rounding their position or radius.
+func (p *Painter) DotsF(points []PointF, fillColor, strokeColor Color, strokeWidth float64, dotRadius float64) {
+	defer p.render.ResetStyle()
+	p.render.SetFillColor(fillColor)
+	p.render.SetStrokeColor(strokeColor)
+	p.render.SetStrokeWidth(strokeWidth)
+	for _, item := range points {
+		p.circleF(dotRadius, item.X, item.Y)
+	}
+	p.render.FillStroke()
+}
+
 // squares prints filled squares for the given points.
-func (p *Painter) squares(points []Point, fillColor, strokeColor Color, strokeWidth float64, size int) {
+func (p *Painter) squares(points []PointF, fillColor, strokeColor Color, strokeWidth float64, size float64) {
 	defer p.render.ResetStyle()
 	p.render.SetFillColor(fillColor)
 	p.render.SetStrokeColor(strokeColor)
 	p.render.SetStrokeWidth(strokeWidth)
-	halfSize := int(math.Round(float64(size) / 2.0))
+	halfSize := size / 2.0
 	for _, item := range points {
 		x1 := item.X - halfSize
 		y1 := item.Y - halfSize
-		p.rectMoveLine(x1, y1, x1+size, y1+size)
+		p.rectMoveLineF(x1, y1, x1+size, y1+size)
 	}
 	p.render.FillStroke()
 }
 
 // diamonds prints filled diamonds for the given points.
-func (p *Painter) diamonds(points []Point, fillColor, strokeColor Color, strokeWidth float64, size int) {
+func (p *Painter) diamonds(points []PointF, fillColor, strokeColor Color, strokeWidth float64, size float64) {
 	defer p.render.ResetStyle()
 	p.render.SetFillColor(fillColor)
 	p.render.SetStrokeColor(strokeColor)
 	p.render.SetStrokeWidth(strokeWidth)
 	for _, item := range points {
-		p.diamondMoveLine(item.X, item.Y, size, size)
+		p.diamondMoveLineF(item.X, item.Y, size, size)
 	}
 	p.render.FillStroke()
 }
@@ -1007,58 +1448,65 @@ func (p *Painter) diamonds(points []Point, fillColor, strokeColor Color, strokeW
 // roundedRect is similar to filledRect except the top and bottom are rounded.
 func (p *Painter) roundedRect(box Box, radius int, roundTop, roundBottom bool,
 	fillColor, strokeColor Color, strokeWidth float64) {
-	r := (box.Right - box.Left) / 2
-	if radius > r {
+	p.roundedRectF(float64(box.Left), float64(box.Top), float64(box.Right), float64(box.Bottom), float64(radius),
+		roundTop, roundBottom, fillColor, strokeColor, strokeWidth)
+}
+
+// roundedRectF is similar to roundedRect, without rounding the coordinates to whole pixels.
+func (p *Painter) roundedRectF(x1, y1, x2, y2, radius float64, roundTop, roundBottom bool,
+	fillColor, strokeColor Color, strokeWidth float64) {
+	p.roundedRectMoveLineF(x1, y1, x2, y2, radius, roundTop, roundBottom)
+	p.fillStroke(fillColor, strokeColor, strokeWidth)
+}
+
+// roundedRectGradientF is similar to roundedRectF except the fill is done using the linear gradient.
+func (p *Painter) roundedRectGradientF(x1, y1, x2, y2, radius float64, roundTop, roundBottom bool,
+	gradient LinearGradient, strokeColor Color, strokeWidth float64) {
+	p.roundedRectMoveLineF(x1, y1, x2, y2, radius, roundTop, roundBottom)
+	p.fillStrokeGradient(ColorTransparent, gradient, strokeColor, strokeWidth)
+}
+
+func (p *Painter) roundedRectMoveLineF(left, top, right, bottom, radius float64, roundTop, roundBottom bool) {
+	if r := (right - left) / 2; radius > r {
 		radius = r
 	}
-	rx := float64(radius)
-	ry := float64(radius)
 
 	if roundTop {
 		// Start at the appropriate point depending on rounding at the top
-		p.moveTo(box.Left+radius, box.Top)
-		p.lineTo(box.Right-radius, box.Top)
+		p.moveToF(left+radius, top)
+		p.lineToF(right-radius, top)
 
 		// right top
-		cx := box.Right - radius
-		cy := box.Top + radius
-		p.arcTo(cx, cy, rx, ry, -_pi2, _pi2)
+		p.arcToF(right-radius, top+radius, radius, radius, -_pi2, _pi2)
 	} else {
-		p.moveTo(box.Left, box.Top)
-		p.lineTo(box.Right, box.Top)
+		p.moveToF(left, top)
+		p.lineToF(right, top)
 	}
 
 	if roundBottom {
-		p.lineTo(box.Right, box.Bottom-radius)
+		p.lineToF(right, bottom-radius)
 
 		// right bottom
-		cx := box.Right - radius
-		cy := box.Bottom - radius
-		p.arcTo(cx, cy, rx, ry, 0, _pi2)
+		p.arcToF(right-radius, bottom-radius, radius, radius, 0, _pi2)
 
-		p.lineTo(box.Left+radius, box.Bottom)
+		p.lineToF(left+radius, bottom)
 
 		// left bottom
-		cx = box.Left + radius
-		cy = box.Bottom - radius
-		p.arcTo(cx, cy, rx, ry, _pi2, _pi2)
+		p.arcToF(left+radius, bottom-radius, radius, radius, _pi2, _pi2)
 	} else {
-		p.lineTo(box.Right, box.Bottom)
-		p.lineTo(box.Left, box.Bottom)
+		p.lineToF(right, bottom)
+		p.lineToF(left, bottom)
 	}
 
 	if roundTop {
 		// left top
-		p.lineTo(box.Left, box.Top+radius)
-		cx := box.Left + radius
-		cy := box.Top + radius
-		p.arcTo(cx, cy, rx, ry, math.Pi, _pi2)
+		p.lineToF(left, top+radius)
+		p.arcToF(left+radius, top+radius, radius, radius, math.Pi, _pi2)
 	} else {
-		p.lineTo(box.Left, box.Top)
+		p.lineToF(left, top)
 	}
 
 	p.close()
-	p.fillStroke(fillColor, strokeColor, strokeWidth)
 }
 
 // legendLineDot draws a small horizontal line with a dot in the middle, often used in legends.
@@ -1146,6 +1594,12 @@ func (p *Painter) CandlestickChart(opt CandlestickChartOption) error {
 	return err
 }
 
+// VisualMap renders a color scale legend with the provided configuration to the painter.
+func (p *Painter) VisualMap(opt VisualMapOption) error {
+	_, err := newVisualMapPainter(p, opt).Render()
+	return err
+}
+
 // LayoutBuilderGrid is returned by Painter.LayoutByGrid() and provides methods
 // for building grid-based layouts with cell spanning support.
 type LayoutBuilderGrid interface {
@@ -7,10 +7,13 @@ import (
 	"image/color"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/go-analyze/charts/chartdraw"
 )
 
 func TestPainterOption(t *testing.T) {
@@ -77,6 +80,10 @@ func TestBytesFormat(t *testing.T) {
 			outputFormat: ChartOutputSVG,
 			magicStart:   []byte("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 "),
 		},
+		{
+			outputFormat: ChartOutputPDF,
+			magicStart:   []byte("%PDF-1.4\n"),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.outputFormat, func(t *testing.T) {
@@ -95,6 +102,72 @@ func TestBytesFormat(t *testing.T) {
 	}
 }
 
+func TestPainterWriteTo(t *testing.T) {
+	t.Parallel()
+
+	for _, outputFormat := range []string{ChartOutputPNG, ChartOutputJPG, ChartOutputSVG, ChartOutputPDF} {
+		t.Run(outputFormat, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: outputFormat,
+				Width:        200,
+				Height:       100,
+			})
+			require.NoError(t, p.LineChart(makeFullLineChartStackedOption()))
+			expected, err := p.Bytes()
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			n, err := p.WriteTo(&buf)
+			require.NoError(t, err)
+			assert.Equal(t, int64(buf.Len()), n)
+			assert.Equal(t, string(expected), buf.String())
+		})
+	}
+}
+
+func TestPainterImage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("png", func(t *testing.T) {
+		p := NewPainter(PainterOptions{
+			OutputFormat: ChartOutputPNG,
+			Width:        200,
+			Height:       100,
+			ScaleFactor:  2,
+		})
+		require.NoError(t, p.LineChart(makeFullLineChartStackedOption()))
+		img, err := p.Image()
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 400, 200), img.Bounds())
+	})
+
+	t.Run("svg", func(t *testing.T) {
+		p := NewPainter(PainterOptions{
+			OutputFormat: ChartOutputSVG,
+		})
+		_, err := p.Image()
+		require.Error(t, err)
+	})
+
+	t.Run("target_sub_image", func(t *testing.T) {
+		dest := image.NewRGBA(image.Rect(0, 0, 300, 200))
+		sub := dest.SubImage(image.Rect(100, 100, 300, 200)).(*image.RGBA)
+		p := NewPainter(PainterOptions{
+			OutputFormat: ChartOutputPNG,
+			Image:        sub,
+		})
+		assert.Equal(t, 200, p.Width())
+		assert.Equal(t, 100, p.Height())
+		p.FilledRect(0, 0, 200, 100, ColorBlack, ColorTransparent, 0)
+
+		img, err := p.Image()
+		require.NoError(t, err)
+		assert.Same(t, sub, img)
+		assert.Equal(t, uint8(0), dest.RGBAAt(50, 50).A)
+		assert.Equal(t, uint8(255), dest.RGBAAt(150, 150).A)
+	})
+}
+
 func TestBytesCompareRenderedOutputs(t *testing.T) {
 	t.Parallel()
 
@@ -381,7 +454,7 @@ func TestPainterExternal(t *testing.T) {
 				opt.Theme = GetDefaultTheme().WithBackgroundColor(ColorTransparent)
 				_ = p.LineChart(opt)
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 400 300\"><path d=\"M 5 10\nL 400 10\nL 400 300\nL 5 300\nL 5 10\" style=\"stroke:none;fill:white\"/><text x=\"14\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1440</text><text x=\"14\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1280</text><text x=\"14\" y=\"85\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1120</text><text x=\"22\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"22\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"22\" y=\"174\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"22\" y=\"204\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"22\" y=\"234\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"22\" y=\"264\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"40\" y=\"294\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 55 20\nL 390 20\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 50\nL 390 50\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 80\nL 390 80\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 110\nL 390 110\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 140\nL 390 140\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 170\nL 390 170\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 200\nL 390 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 230\nL 390 230\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 260\nL 390 260\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 82 268\nL 129 266\nL 176 272\nL 224 265\nL 271 274\nL 318 247\nL 366 251\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 82 137\nL 129 116\nL 176 122\nL 224 115\nL 271 49\nL 318 41\nL 366 43\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 200 0\nL 400 0\nL 400 200\nL 200 200\nL 200 0\" style=\"stroke:none;fill:none\"/><text x=\"209\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"209\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"209\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"221\" y=\"75\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"221\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"221\" y=\"114\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"221\" y=\"134\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"221\" y=\"154\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"221\" y=\"174\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"239\" y=\"194\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 254 10\nL 390 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 30\nL 390 30\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 50\nL 390 50\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 70\nL 390 70\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 90\nL 390 90\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 110\nL 390 110\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 130\nL 390 130\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 150\nL 390 150\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 170\nL 390 170\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 258 175\nL 280 174\nL 302 178\nL 324 174\nL 346 179\nL 368 162\nL 390 164\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 258 88\nL 280 74\nL 302 78\nL 324 74\nL 346 29\nL 368 24\nL 390 25\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 400 300\"><path d=\"M 5 10\nL 400 10\nL 400 300\nL 5 300\nL 5 10\" style=\"stroke:none;fill:white\"/><text x=\"14\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1440</text><text x=\"14\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1280</text><text x=\"14\" y=\"85\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1120</text><text x=\"22\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"22\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"22\" y=\"174\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"22\" y=\"204\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"22\" y=\"234\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"22\" y=\"264\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"40\" y=\"294\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 55 20\nL 390 20\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 50\nL 390 50\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 80\nL 390 80\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 110\nL 390 110\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 140\nL 390 140\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 170\nL 390 170\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 200\nL 390 200\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 230\nL 390 230\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 55 260\nL 390 260\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 82.64 267.5\nL 129.93 265.25\nL 177.21 271.06\nL 224.5 264.88\nL 271.79 273.12\nL 319.07 246.88\nL 366.36 250.62\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 82.64 136.25\nL 129.93 115.25\nL 177.21 121.06\nL 224.5 114.88\nL 271.79 48.12\nL 319.07 40.62\nL 366.36 42.5\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 200 0\nL 400 0\nL 400 200\nL 200 200\nL 200 0\" style=\"stroke:none;fill:none\"/><text x=\"209\" y=\"16\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"209\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"209\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"221\" y=\"75\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"221\" y=\"95\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"221\" y=\"114\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"221\" y=\"134\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"221\" y=\"154\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"221\" y=\"174\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"239\" y=\"194\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 254 10\nL 390 10\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 30\nL 390 30\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 50\nL 390 50\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 70\nL 390 70\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 90\nL 390 90\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 110\nL 390 110\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 130\nL 390 130\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 150\nL 390 150\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 254 170\nL 390 170\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 258 175\nL 280 173.5\nL 302 177.38\nL 324 173.25\nL 346 178.75\nL 368 161.25\nL 390 163.75\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 258 87.5\nL 280 73.5\nL 302 77.38\nL 324 73.25\nL 346 28.75\nL 368 23.75\nL 390 25\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/></svg>",
 			pngCRC: 0xc3dc7021,
 		},
 	}
@@ -1038,7 +1111,7 @@ func TestMultipleChartsOnPainter(t *testing.T) {
 
 	buf, err := p.Bytes()
 	require.NoError(t, err)
-	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 0 300\nL 400 300\nL 400 600\nL 0 600\nL 0 300\" style=\"stroke:none;fill:white\"/><text x=\"185\" y=\"336\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"187\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 200 473\nL 200 388\nA 85 85 119.89 0 1 274 515\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(91,143,249)\"/><path d=\"M 273 431\nL 286 423\nM 286 423\nL 301 423\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:none\"/><text x=\"304\" y=\"428\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 200 473\nL 274 515\nA 85 85 84.08 0 1 165 551\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(90,216,166)\"/><path d=\"M 226 553\nL 231 568\nM 231 568\nL 246 568\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:none\"/><text x=\"249\" y=\"573\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 200 473\nL 165 551\nA 85 85 66.35 0 1 115 473\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(93,112,146)\"/><path d=\"M 129 519\nL 116 527\nM 116 527\nL 101 527\" style=\"stroke-width:1;stroke:rgb(93,112,146);fill:none\"/><text x=\"0\" y=\"532\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 200 473\nL 115 473\nA 85 85 55.37 0 1 152 403\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(246,189,22)\"/><path d=\"M 125 434\nL 112 426\nM 112 426\nL 97 426\" style=\"stroke-width:1;stroke:rgb(246,189,22);fill:none\"/><text x=\"-4\" y=\"431\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 200 473\nL 152 403\nA 85 85 34.32 0 1 200 388\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(111,94,249)\"/><path d=\"M 175 392\nL 171 378\nM 171 378\nL 156 378\" style=\"stroke-width:1;stroke:rgb(111,94,249);fill:none\"/><text x=\"64\" y=\"383\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><path d=\"M 400 300\nL 800 300\nL 800 600\nL 400 600\nL 400 300\" style=\"stroke:none;fill:white\"/><text x=\"409\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"409\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"409\" y=\"372\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"409\" y=\"400\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"409\" y=\"428\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"418\" y=\"456\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"418\" y=\"484\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"418\" y=\"512\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"418\" y=\"540\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"427\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 442 310\nL 790 310\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 338\nL 790 338\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 366\nL 790 366\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 394\nL 790 394\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 422\nL 790 422\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 451\nL 790 451\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 479\nL 790 479\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 507\nL 790 507\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 535\nL 790 535\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 446 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 446 569\nL 446 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 477 569\nL 477 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 508 569\nL 508 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 539 569\nL 539 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 571 569\nL 571 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 602 569\nL 602 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 633 569\nL 633 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 664 569\nL 664 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 696 569\nL 696 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 727 569\nL 727 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 758 569\nL 758 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"445\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"507\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"570\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"632\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"663\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"726\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"763\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 451 562\nL 458 562\nL 458 563\nL 451 563\nL 451 562\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 479 558\nL 486 558\nL 486 563\nL 479 563\nL 479 558\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 508 555\nL 515 555\nL 515 563\nL 508 563\nL 508 555\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 537 533\nL 544 533\nL 544 563\nL 537 563\nL 537 533\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 565 530\nL 572 530\nL 572 563\nL 565 563\nL 565 530\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 594 461\nL 601 461\nL 601 563\nL 594 563\nL 594 461\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 623 382\nL 630 382\nL 630 563\nL 623 563\nL 623 382\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 651 347\nL 658 347\nL 658 563\nL 651 563\nL 651 347\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 680 521\nL 687 521\nL 687 563\nL 680 563\nL 680 521\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 709 538\nL 716 538\nL 716 563\nL 709 563\nL 709 538\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 737 556\nL 744 556\nL 744 563\nL 737 563\nL 737 556\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 766 560\nL 773 560\nL 773 563\nL 766 563\nL 766 560\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 461 561\nL 468 561\nL 468 563\nL 461 563\nL 461 561\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 489 557\nL 496 557\nL 496 563\nL 489 563\nL 489 557\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 518 552\nL 525 552\nL 525 563\nL 518 563\nL 518 552\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 547 529\nL 554 529\nL 554 563\nL 547 563\nL 547 529\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 575 526\nL 582 526\nL 582 563\nL 575 563\nL 575 526\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 604 469\nL 611 469\nL 611 563\nL 604 563\nL 604 469\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 633 329\nL 640 329\nL 640 563\nL 633 563\nL 633 329\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 661 320\nL 668 320\nL 668 563\nL 661 563\nL 661 320\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 690 499\nL 697 499\nL 697 563\nL 690 563\nL 690 499\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 719 539\nL 726 539\nL 726 563\nL 719 563\nL 719 539\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 747 556\nL 754 556\nL 754 563\nL 747 563\nL 747 556\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 776 561\nL 783 561\nL 783 563\nL 776 563\nL 776 561\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"450\" y=\"557\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"473\" y=\"553\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"507\" y=\"550\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"527\" y=\"528\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"555\" y=\"525\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"584\" y=\"456\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"610\" y=\"377\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"638\" y=\"342\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"670\" y=\"516\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"705\" y=\"533\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"731\" y=\"551\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"760\" y=\"555\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"455\" y=\"556\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"483\" y=\"552\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"517\" y=\"547\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"537\" y=\"524\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"565\" y=\"521\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"594\" y=\"464\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"620\" y=\"324\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"648\" y=\"315\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"680\" y=\"494\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"709\" y=\"534\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"746\" y=\"551\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"770\" y=\"556\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><path d=\"M 0 0\nL 800 0\nL 800 300\nL 0 300\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 350 19\nL 380 19\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"365\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"382\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 411 19\nL 441 19\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"426\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"443\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"76\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"148\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"196\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"220\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"244\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 70\nL 790 70\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 790 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 118\nL 790 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 142\nL 790 142\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 167\nL 790 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 191\nL 790 191\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 215\nL 790 215\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 239\nL 790 239\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 264\nL 790 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 269\nL 58 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 162 269\nL 162 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 267 269\nL 267 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 371 269\nL 371 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 476 269\nL 476 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 269\nL 580 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 685 269\nL 685 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 269\nL 790 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"105\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"209\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"314\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"418\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"524\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"628\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"732\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 110 246\nL 214 245\nL 319 249\nL 423 244\nL 528 251\nL 632 230\nL 737 233\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"110\" cy=\"246\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"214\" cy=\"245\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"319\" cy=\"249\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"423\" cy=\"244\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"528\" cy=\"251\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"632\" cy=\"230\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"737\" cy=\"233\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><path d=\"M 110 140\nL 214 123\nL 319 128\nL 423 123\nL 528 69\nL 632 63\nL 737 65\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"110\" cy=\"140\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"214\" cy=\"123\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"319\" cy=\"128\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"423\" cy=\"123\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"528\" cy=\"69\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"632\" cy=\"63\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"737\" cy=\"65\" r=\"2\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/></svg>", buf)
+	assertEqualSVG(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 800 600\"><path d=\"M 0 0\nL 800 0\nL 800 600\nL 0 600\nL 0 0\" style=\"stroke:none;fill:white\"/><path d=\"M 0 300\nL 400 300\nL 400 600\nL 0 600\nL 0 300\" style=\"stroke:none;fill:white\"/><text x=\"185\" y=\"336\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"187\" y=\"352\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 200 473\nL 200 387.8\nA 85.2 85.2 119.89 0 1 273.87 515.45\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(91,143,249)\"/><path d=\"M 273 431\nL 286 423\nM 286 423\nL 301 423\" style=\"stroke-width:1;stroke:rgb(91,143,249);fill:none\"/><text x=\"304\" y=\"428\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 200 473\nL 273.87 515.45\nA 85.2 85.2 84.08 0 1 165.39 550.85\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(90,216,166)\"/><path d=\"M 226 553\nL 231 568\nM 231 568\nL 246 568\" style=\"stroke-width:1;stroke:rgb(90,216,166);fill:none\"/><text x=\"249\" y=\"573\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 200 473\nL 165.39 550.85\nA 85.2 85.2 66.35 0 1 114.8 472.53\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(93,112,146)\"/><path d=\"M 129 519\nL 116 527\nM 116 527\nL 101 527\" style=\"stroke-width:1;stroke:rgb(93,112,146);fill:none\"/><text x=\"0\" y=\"532\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 200 473\nL 114.8 472.53\nA 85.2 85.2 55.37 0 1 151.96 402.63\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(246,189,22)\"/><path d=\"M 125 434\nL 112 426\nM 112 426\nL 97 426\" style=\"stroke-width:1;stroke:rgb(246,189,22);fill:none\"/><text x=\"-4\" y=\"431\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 200 473\nL 151.96 402.63\nA 85.2 85.2 34.32 0 1 200 387.8\nL 200 473\nZ\" style=\"stroke:none;fill:rgb(111,94,249)\"/><path d=\"M 175 392\nL 171 378\nM 171 378\nL 156 378\" style=\"stroke-width:1;stroke:rgb(111,94,249);fill:none\"/><text x=\"64\" y=\"383\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text><path d=\"M 400 300\nL 800 300\nL 800 600\nL 400 600\nL 400 300\" style=\"stroke:none;fill:white\"/><text x=\"409\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">189</text><text x=\"409\" y=\"344\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">168</text><text x=\"409\" y=\"372\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">147</text><text x=\"409\" y=\"400\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">126</text><text x=\"409\" y=\"428\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">105</text><text x=\"418\" y=\"456\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">84</text><text x=\"418\" y=\"484\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">63</text><text x=\"418\" y=\"512\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"418\" y=\"540\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">21</text><text x=\"427\" y=\"568\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 442 310\nL 790 310\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 338\nL 790 338\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 366\nL 790 366\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 394\nL 790 394\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 422\nL 790 422\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 451\nL 790 451\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 479\nL 790 479\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 507\nL 790 507\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 442 535\nL 790 535\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 446 564\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 446 569\nL 446 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 477 569\nL 477 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 508 569\nL 508 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 539 569\nL 539 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 571 569\nL 571 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 602 569\nL 602 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 633 569\nL 633 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 664 569\nL 664 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 696 569\nL 696 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 727 569\nL 727 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 758 569\nL 758 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 569\nL 790 564\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"445\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"507\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mar</text><text x=\"570\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"632\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jul</text><text x=\"663\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"726\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Oct</text><text x=\"763\" y=\"590\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dec</text><path d=\"M 451 561.31\nL 458.83 561.31\nL 458.83 563\nL 451 563\nL 451 561.31\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 479.67 557.41\nL 487.5 557.41\nL 487.5 563\nL 479.67 563\nL 479.67 557.41\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 508.33 554.59\nL 516.17 554.59\nL 516.17 563\nL 508.33 563\nL 508.33 554.59\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 537 532.82\nL 544.83 532.82\nL 544.83 563\nL 537 563\nL 537 532.82\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 565.67 529.6\nL 573.5 529.6\nL 573.5 563\nL 565.67 563\nL 565.67 529.6\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 594.33 460.92\nL 602.17 460.92\nL 602.17 563\nL 594.33 563\nL 594.33 460.92\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 623 381.77\nL 630.83 381.77\nL 630.83 563\nL 623 563\nL 623 381.77\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 651.67 346.02\nL 659.5 346.02\nL 659.5 563\nL 651.67 563\nL 651.67 346.02\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 680.33 520.19\nL 688.17 520.19\nL 688.17 563\nL 680.33 563\nL 680.33 520.19\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 709 537.12\nL 716.83 537.12\nL 716.83 563\nL 709 563\nL 709 537.12\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 737.67 555.4\nL 745.5 555.4\nL 745.5 563\nL 737.67 563\nL 737.67 555.4\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 766.33 559.57\nL 774.17 559.57\nL 774.17 563\nL 766.33 563\nL 766.33 559.57\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 461.83 560.51\nL 469.67 560.51\nL 469.67 563\nL 461.83 563\nL 461.83 560.51\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 490.5 556.07\nL 498.33 556.07\nL 498.33 563\nL 490.5 563\nL 490.5 556.07\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 519.17 551.9\nL 527 551.9\nL 527 563\nL 519.17 563\nL 519.17 551.9\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 547.83 528.52\nL 555.67 528.52\nL 555.67 563\nL 547.83 563\nL 547.83 528.52\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 576.5 525.43\nL 584.33 525.43\nL 584.33 563\nL 576.5 563\nL 576.5 525.43\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 605.17 468.99\nL 613 468.99\nL 613 563\nL 605.17 563\nL 605.17 468.99\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 633.83 328.01\nL 641.67 328.01\nL 641.67 563\nL 633.83 563\nL 633.83 328.01\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 662.5 319.14\nL 670.33 319.14\nL 670.33 563\nL 662.5 563\nL 662.5 319.14\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 691.17 498.55\nL 699 498.55\nL 699 563\nL 691.17 563\nL 691.17 498.55\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 719.83 538.73\nL 727.67 538.73\nL 727.67 563\nL 719.83 563\nL 719.83 538.73\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 748.5 555.94\nL 756.33 555.94\nL 756.33 563\nL 748.5 563\nL 748.5 555.94\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 777.17 560.91\nL 785 560.91\nL 785 563\nL 777.17 563\nL 777.17 560.91\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"450\" y=\"556\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"474\" y=\"552\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4.9</text><text x=\"508\" y=\"549\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"527\" y=\"527\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23.2</text><text x=\"556\" y=\"524\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25.6</text><text x=\"585\" y=\"455\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">76.7</text><text x=\"610\" y=\"376\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">135.6</text><text x=\"639\" y=\"341\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">162.2</text><text x=\"671\" y=\"515\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">32.6</text><text x=\"705\" y=\"532\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"732\" y=\"550\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6.4</text><text x=\"761\" y=\"554\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3.3</text><text x=\"456\" y=\"555\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.6</text><text x=\"485\" y=\"551\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5.9</text><text x=\"519\" y=\"546\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"538\" y=\"523\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">26.4</text><text x=\"567\" y=\"520\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">28.7</text><text x=\"596\" y=\"463\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">70.7</text><text x=\"621\" y=\"323\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">175.6</text><text x=\"650\" y=\"314\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">182.2</text><text x=\"682\" y=\"493\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.7</text><text x=\"710\" y=\"533\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18.8</text><text x=\"748\" y=\"550\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"772\" y=\"555\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><path d=\"M 0 0\nL 800 0\nL 800 300\nL 0 300\nL 0 0\" style=\"stroke:none;fill:white\"/><text x=\"10\" y=\"26\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><path d=\"M 350 19\nL 380 19\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"365\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"382\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path d=\"M 411 19\nL 441 19\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"426\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"443\" y=\"25\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"9\" y=\"52\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"9\" y=\"76\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.28k</text><text x=\"9\" y=\"100\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.12k</text><text x=\"21\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"21\" y=\"148\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"21\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">640</text><text x=\"21\" y=\"196\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"21\" y=\"220\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">320</text><text x=\"21\" y=\"244\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"39\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path d=\"M 54 46\nL 790 46\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 70\nL 790 70\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 94\nL 790 94\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 118\nL 790 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 142\nL 790 142\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 167\nL 790 167\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 191\nL 790 191\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 215\nL 790 215\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 54 239\nL 790 239\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 58 264\nL 790 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 58 269\nL 58 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 162 269\nL 162 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 267 269\nL 267 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 371 269\nL 371 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 476 269\nL 476 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 580 269\nL 580 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 685 269\nL 685 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><path d=\"M 790 269\nL 790 264\" style=\"stroke-width:1;stroke:rgb(110,112,121);fill:none\"/><text x=\"105\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"209\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"314\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"418\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"524\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"628\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"732\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">G</text><path d=\"M 110.29 245.83\nL 214.86 244.02\nL 319.43 248.71\nL 424 243.71\nL 528.57 250.38\nL 633.14 229.18\nL 737.71 232.21\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"110.29\" cy=\"245.83\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"214.86\" cy=\"244.02\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"319.43\" cy=\"248.71\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"424\" cy=\"243.71\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"528.57\" cy=\"250.38\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"633.14\" cy=\"229.18\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><circle cx=\"737.71\" cy=\"232.21\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:white\"/><path d=\"M 110.29 139.86\nL 214.86 122.91\nL 319.43 127.6\nL 424 122.6\nL 528.57 68.71\nL 633.14 62.65\nL 737.71 64.17\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"110.29\" cy=\"139.86\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"214.86\" cy=\"122.91\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"319.43\" cy=\"127.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"424\" cy=\"122.6\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"528.57\" cy=\"68.71\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"633.14\" cy=\"62.65\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/><circle cx=\"737.71\" cy=\"64.17\" r=\"2.4\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:white\"/></svg>", buf)
 }
 
 func TestDashedLineStroke(t *testing.T) {
@@ -1248,3 +1321,256 @@ func TestSmoothDashedLineStroke(t *testing.T) {
 		})
 	}
 }
+
+func TestPainterFloatDrawing(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		fn   func(*Painter)
+		svg  string
+	}{
+		{
+			name: "line_stroke",
+			fn: func(p *Painter) {
+				p.LineStrokeF([]PointF{{X: 10.5, Y: 20.25}, {X: 30.125, Y: 40}}, ColorBlack, 1)
+			},
+			svg: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 400 300\"><path d=\"M 15.5 30.25\nL 35.12 50\" style=\"stroke-width:1;stroke:black;fill:none\"/></svg>",
+		},
+		{
+			name: "smooth_line_stroke",
+			fn: func(p *Painter) {
+				p.SmoothLineStrokeF([]PointF{
+					{X: 10, Y: 20.5}, {X: 20.5, Y: 40}, {X: 30, Y: 60.75}, {X: 40.25, Y: 50},
+				}, 0.5, ColorBlack, 1)
+			},
+			svg: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 400 300\"><path d=\"M 15 30.5\nQ25.5,50 27.88,55.19\nQ35,70.75 37.56,68.06\nQ35,70.75 45.25,60\" style=\"stroke-width:1;stroke:black;fill:none\"/></svg>",
+		},
+		{
+			name: "fill_area",
+			fn: func(p *Painter) {
+				p.FillAreaF([]PointF{{X: 10.5, Y: 10}, {X: 50, Y: 10.5}, {X: 50, Y: 50}, {X: 10.5, Y: 10}}, ColorBlue)
+			},
+			svg: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 400 300\"><path d=\"M 15.5 20\nL 55 20.5\nL 55 60\nL 15.5 20\" style=\"stroke:none;fill:blue\"/></svg>",
+		},
+		{
+			name: "filled_rect",
+			fn: func(p *Painter) {
+				p.FilledRectF(10.33, 20.67, 30.33, 60, ColorRed, ColorTransparent, 0)
+			},
+			svg: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 400 300\"><path d=\"M 15.33 30.67\nL 35.33 30.67\nL 35.33 70\nL 15.33 70\nL 15.33 30.67\" style=\"stroke:none;fill:red\"/></svg>",
+		},
+		{
+			name: "dots",
+			fn: func(p *Painter) {
+				p.DotsF([]PointF{{X: 10.5, Y: 20.5}}, ColorWhite, ColorBlack, 1, 2.5)
+			},
+			svg: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 400 300\"><circle cx=\"15.5\" cy=\"30.5\" r=\"2.5\" style=\"stroke-width:1;stroke:black;fill:white\"/></svg>",
+		},
+		{
+			name: "text",
+			fn: func(p *Painter) {
+				p.TextF("hi", 10.5, 20.25, 0, FontStyle{FontSize: 12, FontColor: ColorBlack})
+			},
+			svg: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 400 300\"><text x=\"15.5\" y=\"30.25\" style=\"stroke:none;fill:black;font-size:15.3px;font-family:'Roboto Medium',sans-serif\">hi</text></svg>",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        400,
+				Height:       300,
+			}, PainterPaddingOption(Box{Left: 5, Top: 10}))
+			tt.fn(p)
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertEqualSVG(t, tt.svg, data)
+		})
+	}
+}
+
+func TestPainterDrawImage(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 200, Height: 100})
+	p.Child(PainterPaddingOption(NewBox(10, 20, 0, 0))).
+		DrawImage(makeTestLogo(2, 2), NewBox(5, 5, 25, 15), 1, ImageFilterBilinear)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `<image x="15" y="25" width="20" height="10" preserveAspectRatio="none" xlink:href="data:image/png;base64,`)
+}
+func TestTruncateText(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	fontStyle := FontStyle{FontSize: defaultFontSize, FontColor: ColorBlack, Font: GetDefaultFont()}
+
+	assert.Equal(t, "Short", truncateText(p, "Short", 100, fontStyle))
+	truncated := truncateText(p, "A very long series name", 80, fontStyle)
+	assert.Equal(t, "A very lon…", truncated)
+	assert.LessOrEqual(t, p.MeasureText(truncated, 0, fontStyle).Width(), 80)
+	assert.Equal(t, textEllipsis, truncateText(p, "Name", 1, fontStyle))
+}
+
+func TestPainterWrapText(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	fontStyle := FontStyle{FontSize: defaultFontSize, FontColor: ColorBlack}
+
+	text, truncated := p.wrapText("Short", 100, 2, fontStyle)
+	assert.Equal(t, "Short", text)
+	assert.False(t, truncated)
+	text, truncated = p.wrapText("Middle East and Africa", 100, 2, fontStyle)
+	assert.Equal(t, "Middle East\nand Africa", text)
+	assert.False(t, truncated)
+	text, truncated = p.wrapText("Middle East and Africa", 60, 2, fontStyle)
+	assert.True(t, strings.HasPrefix(text, "Middle\n"))
+	assert.True(t, strings.HasSuffix(text, textEllipsis))
+	assert.Equal(t, 1, strings.Count(text, "\n"))
+	assert.True(t, truncated)
+	text, truncated = p.wrapText("Middle East and Africa", 60, 0, fontStyle)
+	assert.True(t, strings.HasSuffix(text, "\nAfrica"))
+	assert.Greater(t, strings.Count(text, "\n"), 1)
+	assert.False(t, truncated)
+}
+
+func TestPainterMeasureMultilineText(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	fontStyle := FontStyle{FontSize: defaultFontSize, FontColor: ColorBlack}
+
+	single := p.MeasureText("Middle East", 0, fontStyle)
+	lines, lineHeight, box := p.measureMultilineText("Middle East", 0, fontStyle)
+	assert.Equal(t, []string{"Middle East"}, lines)
+	assert.Equal(t, single.Height(), lineHeight)
+	assert.Equal(t, single, box)
+
+	lines, lineHeight, box = p.measureMultilineText("Middle East\nand Africa", 0, fontStyle)
+	assert.Equal(t, []string{"Middle East", "and Africa"}, lines)
+	assert.Equal(t, single.Height(), lineHeight)
+	assert.Equal(t, single.Width(), box.Width())
+	assert.Equal(t, 2*lineHeight+chartdraw.Style{}.GetTextLineSpacing(), box.Height())
+
+	_, _, rotated := p.measureMultilineText("Middle East\nand Africa", DegreesToRadians(90), fontStyle)
+	assert.Equal(t, box.Width(), rotated.Height())
+	assert.Equal(t, box.Height(), rotated.Width())
+
+	p.multilineText(lines, 10, 20, lineHeight, 0, fontStyle, AlignCenter)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "<text"))
+}
+
+// basicRenderer hides the optional capabilities of the wrapped renderer, exposing only chartdraw.Renderer.
+type basicRenderer struct {
+	chartdraw.Renderer
+}
+
+func TestPainterBasicRendererFallback(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		fn     func(*Painter)
+		expect func(t *testing.T, svg string)
+	}{
+		{
+			name: "gradient",
+			fn: func(p *Painter) {
+				gradient := NewVerticalGradient(ColorRed, ColorRed.WithAlpha(0))
+				p.FilledRectGradientF(10, 10, 50, 50, gradient, ColorTransparent, 0)
+			},
+			expect: func(t *testing.T, svg string) {
+				t.Helper()
+
+				assert.NotContains(t, svg, "linearGradient")
+				assert.Contains(t, svg, "fill:rgba(255,0,0,0.5)")
+			},
+		},
+		{
+			name: "group",
+			fn: func(p *Painter) {
+				p.interactive = &InteractiveOption{Tooltip: Ptr(true)}
+				p.startDataGroup("series", "name", interactiveValue{key: "value", value: 1, formatted: "1"})
+				p.Circle(5, 20, 20, ColorBlue, ColorBlue, 1)
+				p.endGroup()
+			},
+			expect: func(t *testing.T, svg string) {
+				t.Helper()
+
+				assert.NotContains(t, svg, "<g")
+				assert.Contains(t, svg, "<circle")
+			},
+		},
+		{
+			name: "document_info",
+			fn: func(p *Painter) {
+				p.accessible = &accessibleState{opt: AccessibleOption{Title: "title", DataTable: Ptr(true)}}
+				p.setDocumentInfo(TitleOption{}, NewSeriesListLine([][]float64{{1, 2}}).ToGenericSeriesList(), nil, nil)
+			},
+			expect: func(t *testing.T, svg string) {
+				t.Helper()
+
+				assert.NotContains(t, svg, "<title")
+				assert.NotContains(t, svg, "foreignObject")
+			},
+		},
+		{
+			name: "float",
+			fn: func(p *Painter) {
+				p.LineStrokeF([]PointF{{X: 10.4, Y: 20.6}, {X: 30.5, Y: 40}}, ColorBlack, 1)
+				p.CircleF(2.5, 50.6, 50.2, ColorBlack, ColorBlack, 1)
+			},
+			expect: func(t *testing.T, svg string) {
+				t.Helper()
+
+				assert.Contains(t, svg, "M 10 21\nL 31 40")
+				assert.Contains(t, svg, `<circle cx="51" cy="50" r="3"`)
+			},
+		},
+		{
+			name: "text_runs",
+			fn: func(p *Painter) {
+				p.Text("{b|Total} 5", 10, 50, 0, FontStyle{FontSize: 12, FontColor: ColorBlack, RichText: Ptr(true)})
+			},
+			expect: func(t *testing.T, svg string) {
+				t.Helper()
+
+				assert.NotContains(t, svg, "<tspan")
+				assert.Contains(t, svg, `<text x="10" y="50"`)
+				assert.Contains(t, svg, ">Total</text>")
+				assert.Contains(t, svg, "> 5</text>")
+			},
+		},
+		{
+			name: "image",
+			fn: func(p *Painter) {
+				p.DrawImage(image.NewRGBA(image.Rect(0, 0, 2, 2)), NewBox(10, 10, 30, 30), 1, ImageFilterNearest)
+			},
+			expect: func(t *testing.T, svg string) {
+				t.Helper()
+
+				assert.NotContains(t, svg, "<image")
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        200,
+				Height:       100,
+			})
+			p.render = basicRenderer{p.render}
+			tt.fn(p)
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			tt.expect(t, string(data))
+		})
+	}
+}
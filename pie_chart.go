@@ -66,6 +66,7 @@ type sector struct {
 	midAngle    float64
 	quadrant    int  // 1: top-right, 2: top-left, 3: bottom-left, 4: bottom-right
 	yCenter     bool // set to true if close to center in the y-axis
+	name        string
 	label       string
 	labelStyle  *LabelStyle
 	seriesLabel SeriesLabel
@@ -79,6 +80,7 @@ func newSector(radius float64, index int, value, currentValue, totalValue float6
 		radius:      radius,
 		startAngle:  chartdraw.PercentToRadians(currentValue/totalValue) - math.Pi/2,
 		delta:       chartdraw.PercentToRadians(value / totalValue),
+		name:        label,
 		seriesLabel: seriesLabel,
 		color:       color,
 	}
@@ -245,20 +247,23 @@ func renderPie(p *Painter, cx, cy int, space, radius, total float64, renderLabel
 	}
 	sectors := append(append(append(quadrant1, quadrant4...), quadrant3...), quadrant2...)
 
+	centerX, centerY := float64(cx), float64(cy)
 	var currentQuadrant int
 	var prevY, maxY int
 	minY := cy * 2 // initialize to bottom of canvas
 	for _, s := range sectors {
 		// draw the pie slice
-		p.moveTo(cx, cy)
-		p.arcTo(cx, cy, s.radius, s.radius, s.startAngle, s.delta)
-		p.lineTo(cx, cy)
+		p.startSectorGroup(s, valueFormatter, total)
+		p.moveToF(centerX, centerY)
+		p.arcToF(centerX, centerY, s.radius, s.radius, s.startAngle, s.delta)
+		p.lineToF(centerX, centerY)
 		p.close()
 		if sliceGap > 0 {
 			p.fillStroke(s.color, theme.GetBackgroundColor(), sliceGap)
 		} else {
 			p.fill(s.color)
 		}
+		p.endGroup()
 
 		if !renderLabels || s.label == "" {
 			continue
@@ -297,6 +302,7 @@ func renderPie(p *Painter, cx, cy int, space, radius, total float64, renderLabel
 		if prevY < minY {
 			minY = prevY
 		}
+		p.startSeriesGroup(s.name) // group the label with the series so it's hidden with the slice
 		p.moveTo(lsX, lsY)
 		p.lineTo(lbX, lbY)
 		p.moveTo(lbX, lbY)
@@ -317,6 +323,7 @@ func renderPie(p *Painter, cx, cy int, space, radius, total float64, renderLabel
 		}
 
 		drawLabelWithBackground(p, s.label, textX, textY, 0, fontStyle, backgroundColor, cornerRadius, borderColor, borderWidth)
+		p.endGroup()
 	}
 	return sectors, nil
 }
@@ -326,6 +333,11 @@ func (p *pieChart) Render() (Box, error) {
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.p.theme)
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		var visible []int
+		visible, opt.Theme = opt.Legend.hideSeries(opt.SeriesList, opt.Theme, opt.ValueFormatter)
+		opt.SeriesList = selectSeriesIndexes(opt.SeriesList, visible)
+	}
 	if opt.Legend.Symbol == "" {
 		opt.Legend.Symbol = SymbolSquare // default to square symbol for pie charts
 	}
@@ -66,14 +66,14 @@ func TestPieChart(t *testing.T) {
 				opt.Legend.Vertical = nil
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 40 43\nL 70 43\nL 70 56\nL 40 56\nL 40 43\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"72\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 151 43\nL 181 43\nL 181 56\nL 151 56\nL 151 43\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"183\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 261 43\nL 291 43\nL 291 56\nL 261 56\nL 261 43\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"293\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 372 43\nL 402 43\nL 402 56\nL 372 56\nL 372 43\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"404\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 40 59\nL 70 59\nL 70 72\nL 40 72\nL 40 59\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"72\" y=\"71\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 226\nL 300 119\nA 107 107 119.89 0 1 393 279\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 392 173\nL 405 165\nM 405 165\nL 420 165\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"423\" y=\"170\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 226\nL 393 279\nA 107 107 84.08 0 1 256 324\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 333 327\nL 337 342\nM 337 342\nL 352 342\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"355\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 226\nL 256 324\nA 107 107 66.35 0 1 193 225\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 210 284\nL 198 292\nM 198 292\nL 183 292\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"82\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 226\nL 193 225\nA 107 107 55.37 0 1 240 137\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 206 176\nL 193 169\nM 193 169\nL 178 169\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"77\" y=\"174\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 226\nL 240 137\nA 107 107 34.32 0 1 300 119\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 269 124\nL 264 110\nM 264 110\nL 249 110\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"157\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 40 43\nL 70 43\nL 70 56\nL 40 56\nL 40 43\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"72\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 151 43\nL 181 43\nL 181 56\nL 151 56\nL 151 43\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"183\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 261 43\nL 291 43\nL 291 56\nL 261 56\nL 261 43\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"293\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 372 43\nL 402 43\nL 402 56\nL 372 56\nL 372 43\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"404\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 40 59\nL 70 59\nL 70 72\nL 40 72\nL 40 59\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"72\" y=\"71\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 226\nL 300 118.8\nA 107.2 107.2 119.89 0 1 392.94 279.41\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 392 173\nL 405 165\nM 405 165\nL 420 165\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"423\" y=\"170\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 226\nL 392.94 279.41\nA 107.2 107.2 84.08 0 1 256.46 323.96\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 333 327\nL 337 342\nM 337 342\nL 352 342\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"355\" y=\"347\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 226\nL 256.46 323.96\nA 107.2 107.2 66.35 0 1 192.8 225.41\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 210 284\nL 198 292\nM 198 292\nL 183 292\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"82\" y=\"297\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 226\nL 192.8 225.41\nA 107.2 107.2 55.37 0 1 239.56 137.46\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 206 176\nL 193 169\nM 193 169\nL 178 169\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"77\" y=\"174\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 226\nL 239.56 137.46\nA 107.2 107.2 34.32 0 1 300 118.8\nL 300 226\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 269 124\nL 264 110\nM 264 110\nL 249 110\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"157\" y=\"115\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
 			pngCRC: 0x52188ed5,
 		},
 		{
 			name:        "basic_themed",
 			themed:      true,
 			makeOptions: makeBasicPieChartOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"285\" y=\"36\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"287\" y=\"52\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"52\" y=\"35\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 20 49\nL 50 49\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"35\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"52\" y=\"55\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 20 69\nL 50 69\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:none\"/><circle cx=\"35\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:rgb(100,180,210)\"/><text x=\"52\" y=\"75\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 20 89\nL 50 89\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:none\"/><circle cx=\"35\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:rgb(64,160,110)\"/><text x=\"52\" y=\"95\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 20 109\nL 50 109\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:none\"/><circle cx=\"35\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:rgb(154,96,180)\"/><text x=\"52\" y=\"115\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 223\nL 300 98\nA 125 125 119.89 0 1 409 285\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 408 161\nL 421 153\nM 421 153\nL 436 153\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:none\"/><text x=\"439\" y=\"158\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 223\nL 409 285\nA 125 125 84.08 0 1 249 337\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 338 342\nL 343 356\nM 343 356\nL 358 356\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:none\"/><text x=\"361\" y=\"361\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 223\nL 249 337\nA 125 125 66.35 0 1 175 222\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(100,180,210)\"/><path d=\"M 195 290\nL 183 299\nM 183 299\nL 168 299\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:none\"/><text x=\"67\" y=\"304\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 223\nL 175 222\nA 125 125 55.37 0 1 229 120\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 190 165\nL 177 158\nM 177 158\nL 162 158\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><text x=\"61\" y=\"163\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 223\nL 229 120\nA 125 125 34.32 0 1 300 98\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 264 104\nL 259 90\nM 259 90\nL 244 90\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"152\" y=\"95\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"285\" y=\"36\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"287\" y=\"52\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:none\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><text x=\"52\" y=\"35\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 20 49\nL 50 49\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:none\"/><circle cx=\"35\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><text x=\"52\" y=\"55\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 20 69\nL 50 69\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:none\"/><circle cx=\"35\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(100,180,210);fill:rgb(100,180,210)\"/><text x=\"52\" y=\"75\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 20 89\nL 50 89\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:none\"/><circle cx=\"35\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(64,160,110);fill:rgb(64,160,110)\"/><text x=\"52\" y=\"95\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 20 109\nL 50 109\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:none\"/><circle cx=\"35\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(154,96,180);fill:rgb(154,96,180)\"/><text x=\"52\" y=\"115\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 223\nL 300 97.8\nA 125.2 125.2 119.89 0 1 408.55 285.38\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(255,100,100)\"/><path d=\"M 408 161\nL 421 153\nM 421 153\nL 436 153\" style=\"stroke-width:1;stroke:rgb(255,100,100);fill:none\"/><text x=\"439\" y=\"158\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 223\nL 408.55 285.38\nA 125.2 125.2 84.08 0 1 249.15 337.41\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(255,210,100)\"/><path d=\"M 338 342\nL 343 356\nM 343 356\nL 358 356\" style=\"stroke-width:1;stroke:rgb(255,210,100);fill:none\"/><text x=\"361\" y=\"361\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 223\nL 249.15 337.41\nA 125.2 125.2 66.35 0 1 174.8 222.31\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(100,180,210)\"/><path d=\"M 195 290\nL 183 299\nM 183 299\nL 168 299\" style=\"stroke-width:1;stroke:rgb(100,180,210);fill:none\"/><text x=\"67\" y=\"304\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 223\nL 174.8 222.31\nA 125.2 125.2 55.37 0 1 229.41 119.6\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(64,160,110)\"/><path d=\"M 190 165\nL 177 158\nM 177 158\nL 162 158\" style=\"stroke-width:1;stroke:rgb(64,160,110);fill:none\"/><text x=\"61\" y=\"163\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 223\nL 229.41 119.6\nA 125.2 125.2 34.32 0 1 300 97.8\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 264 104\nL 259 90\nM 259 90\nL 244 90\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"152\" y=\"95\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
 			pngCRC:      0x2380709a,
 		},
 		{
@@ -134,7 +134,7 @@ func TestPieChart(t *testing.T) {
 					},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 1000 800\"><path d=\"M 20 20\nL 980 20\nL 980 780\nL 20 780\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 500 400\nL 500 200\nA 200 200 67.71 0 1 685 324\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 611 234\nL 619 222\nM 619 222\nL 634 222\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"637\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Germany (84358845 ≅ 18.8%)</text><path d=\"M 500 400\nL 685 324\nA 200 200 54.63 0 1 669 507\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 699 417\nL 714 418\nM 714 418\nL 729 418\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"732\" y=\"423\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">France (68070697 ≅ 15.17%)</text><path d=\"M 500 400\nL 669 507\nA 200 200 47.23 0 1 536 597\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 611 565\nL 620 578\nM 620 578\nL 635 578\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"638\" y=\"583\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Italy (58850717 ≅ 13.12%)</text><path d=\"M 500 400\nL 309 459\nA 200 200 14.40 0 1 300 409\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 303 434\nL 289 436\nM 289 436\nL 274 436\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><text x=\"98\" y=\"441\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Netherlands (17947406 ≅ 4%)</text><path d=\"M 500 400\nL 331 507\nA 200 200 15.29 0 1 309 459\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 319 483\nL 305 489\nM 305 489\nL 290 489\" style=\"stroke-width:1;stroke:rgb(59,162,114);fill:none\"/><text x=\"115\" y=\"494\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Romania (19051562 ≅ 4.24%)</text><path d=\"M 500 400\nL 406 576\nA 200 200 29.50 0 1 331 507\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 364 546\nL 354 557\nM 354 557\nL 339 557\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"174\" y=\"562\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Poland (36753736 ≅ 8.19%)</text><path d=\"M 500 400\nL 536 597\nA 200 200 38.57 0 1 406 576\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 470 597\nL 467 612\nM 467 612\nL 452 612\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"288\" y=\"617\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Spain (48059777 ≅ 10.71%)</text><path d=\"M 500 400\nL 300 409\nA 200 200 9.43 0 1 301 376\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 301 393\nL 286 393\nM 286 393\nL 271 393\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"99\" y=\"398\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Belgium (11754004 ≅ 2.62%)</text><path d=\"M 500 400\nL 301 376\nA 200 200 8.69 0 1 307 347\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 304 362\nL 290 359\nM 290 359\nL 275 359\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><text x=\"62\" y=\"364\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Czech Republic (10827529 ≅ 2.41%)</text><path d=\"M 500 400\nL 307 347\nA 200 200 8.44 0 1 317 319\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 312 333\nL 298 328\nM 298 328\nL 283 328\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"113\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sweden (10521556 ≅ 2.34%)</text><path d=\"M 500 400\nL 317 319\nA 200 200 8.40 0 1 331 293\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 324 306\nL 311 299\nM 311 299\nL 296 299\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"123\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Portugal (10467366 ≅ 2.33%)</text><path d=\"M 500 400\nL 331 293\nA 200 200 8.34 0 1 348 270\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 340 282\nL 328 273\nM 328 273\nL 313 273\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"148\" y=\"278\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Greece (10394055 ≅ 2.31%)</text><path d=\"M 500 400\nL 348 270\nA 200 200 7.70 0 1 367 251\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 358 260\nL 347 250\nM 347 250\nL 332 250\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"166\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Hungary (9597085 ≅ 2.13%)</text><path d=\"M 500 400\nL 367 251\nA 200 200 7.31 0 1 387 235\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 377 243\nL 368 231\nM 368 231\nL 353 231\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"194\" y=\"236\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Austria (9104772 ≅ 2.02%)</text><path d=\"M 500 400\nL 387 235\nA 200 200 5.18 0 1 403 225\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 395 230\nL 387 215\nM 387 215\nL 372 215\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"207\" y=\"220\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Bulgaria (6447710 ≅ 1.43%)</text><path d=\"M 500 400\nL 403 225\nA 200 200 4.76 0 1 417 218\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 410 222\nL 404 199\nM 404 199\nL 389 199\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"220\" y=\"204\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Denmark (5932654 ≅ 1.32%)</text><path d=\"M 500 400\nL 417 218\nA 200 200 4.47 0 1 432 212\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 425 215\nL 419 183\nM 419 183\nL 404 183\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"245\" y=\"188\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Finland (5563970 ≅ 1.24%)</text><path d=\"M 500 400\nL 432 212\nA 200 200 4.36 0 1 446 207\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 440 210\nL 435 167\nM 435 167\nL 420 167\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"254\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Slovakia (5428792 ≅ 1.21%)</text><path d=\"M 500 400\nL 446 207\nA 200 200 4.17 0 1 460 204\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 454 206\nL 450 151\nM 450 151\nL 435 151\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"278\" y=\"156\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ireland (5194336 ≅ 1.15%)</text><path d=\"M 500 400\nL 460 204\nA 200 200 3.09 0 1 471 202\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 466 203\nL 464 135\nM 464 135\nL 449 135\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"290\" y=\"140\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Croatia (3850894 ≅ 0.85%)</text><path d=\"M 500 400\nL 471 202\nA 200 200 2.29 0 1 479 201\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 476 202\nL 474 119\nM 474 119\nL 459 119\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"289\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Lithuania (2857279 ≅ 0.63%)</text><path d=\"M 500 400\nL 479 201\nA 200 200 1.70 0 1 485 201\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 482 201\nL 481 103\nM 481 103\nL 466 103\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"300\" y=\"108\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Slovenia (2116792 ≅ 0.47%)</text><path d=\"M 500 400\nL 485 201\nA 200 200 1.51 0 1 490 200\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 488 201\nL 487 87\nM 487 87\nL 472 87\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"320\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Latvia (1883008 ≅ 0.41%)</text><path d=\"M 500 400\nL 490 200\nA 200 200 1.10 0 1 494 200\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 493 201\nL 492 71\nM 492 71\nL 477 71\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"324\" y=\"76\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Estonia (1373101 ≅ 0.3%)</text><path d=\"M 500 400\nL 494 200\nA 200 200 0.74 0 1 497 200\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 496 201\nL 495 55\nM 495 55\nL 480 55\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"337\" y=\"60\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Cyprus (920701 ≅ 0.2%)</text><path d=\"M 500 400\nL 497 200\nA 200 200 0.53 0 1 498 200\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 498 201\nL 498 39\nM 498 39\nL 483 39\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"301\" y=\"44\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Luxembourg (660809 ≅ 0.14%)</text><path d=\"M 500 400\nL 498 200\nA 200 200 0.44 0 1 500 200\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 500 201\nL 500 23\nM 500 23\nL 485 23\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"342\" y=\"28\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Malta (542051 ≅ 0.12%)</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 1000 800\"><path d=\"M 20 20\nL 980 20\nL 980 780\nL 20 780\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 500 400\nL 500 200\nA 200 200 67.71 0 1 685.05 324.13\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 611 234\nL 619 222\nM 619 222\nL 634 222\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"637\" y=\"227\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Germany (84358845 ≅ 18.8%)</text><path d=\"M 500 400\nL 685.05 324.13\nA 200 200 54.63 0 1 668.97 507\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 699 417\nL 714 418\nM 714 418\nL 729 418\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"732\" y=\"423\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">France (68070697 ≅ 15.17%)</text><path d=\"M 500 400\nL 668.97 507\nA 200 200 47.23 0 1 536.18 596.7\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 611 565\nL 620 578\nM 620 578\nL 635 578\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"638\" y=\"583\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Italy (58850717 ≅ 13.12%)</text><path d=\"M 500 400\nL 308.8 458.67\nA 200 200 14.40 0 1 300.21 409.26\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 303 434\nL 289 436\nM 289 436\nL 274 436\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><text x=\"98\" y=\"441\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Netherlands (17947406 ≅ 4%)</text><path d=\"M 500 400\nL 331.04 507.02\nA 200 200 15.29 0 1 308.8 458.67\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 319 483\nL 305 489\nM 305 489\nL 290 489\" style=\"stroke-width:1;stroke:rgb(59,162,114);fill:none\"/><text x=\"115\" y=\"494\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Romania (19051562 ≅ 4.24%)</text><path d=\"M 500 400\nL 405.64 576.34\nA 200 200 29.50 0 1 331.04 507.02\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 364 546\nL 354 557\nM 354 557\nL 339 557\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"174\" y=\"562\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Poland (36753736 ≅ 8.19%)</text><path d=\"M 500 400\nL 536.18 596.7\nA 200 200 38.57 0 1 405.64 576.34\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 470 597\nL 467 612\nM 467 612\nL 452 612\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"288\" y=\"617\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Spain (48059777 ≅ 10.71%)</text><path d=\"M 500 400\nL 300.21 409.26\nA 200 200 9.43 0 1 301.4 376.39\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 301 393\nL 286 393\nM 286 393\nL 271 393\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"99\" y=\"398\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Belgium (11754004 ≅ 2.62%)</text><path d=\"M 500 400\nL 301.4 376.39\nA 200 200 8.69 0 1 307.25 346.65\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 304 362\nL 290 359\nM 290 359\nL 275 359\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><text x=\"62\" y=\"364\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Czech Republic (10827529 ≅ 2.41%)</text><path d=\"M 500 400\nL 307.25 346.65\nA 200 200 8.44 0 1 317.17 318.92\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 312 333\nL 298 328\nM 298 328\nL 283 328\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"113\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sweden (10521556 ≅ 2.34%)</text><path d=\"M 500 400\nL 317.17 318.92\nA 200 200 8.40 0 1 330.98 293.08\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 324 306\nL 311 299\nM 311 299\nL 296 299\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"123\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Portugal (10467366 ≅ 2.33%)</text><path d=\"M 500 400\nL 330.98 293.08\nA 200 200 8.34 0 1 348.28 269.69\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 340 282\nL 328 273\nM 328 273\nL 313 273\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"148\" y=\"278\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Greece (10394055 ≅ 2.31%)</text><path d=\"M 500 400\nL 348.28 269.69\nA 200 200 7.70 0 1 367.12 250.53\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 358 260\nL 347 250\nM 347 250\nL 332 250\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"166\" y=\"255\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Hungary (9597085 ≅ 2.13%)</text><path d=\"M 500 400\nL 367.12 250.53\nA 200 200 7.31 0 1 387.21 234.84\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 377 243\nL 368 231\nM 368 231\nL 353 231\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"194\" y=\"236\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Austria (9104772 ≅ 2.02%)</text><path d=\"M 500 400\nL 387.21 234.84\nA 200 200 5.18 0 1 402.57 225.34\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 395 230\nL 387 215\nM 387 215\nL 372 215\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"207\" y=\"220\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Bulgaria (6447710 ≅ 1.43%)</text><path d=\"M 500 400\nL 402.57 225.34\nA 200 200 4.76 0 1 417.4 217.85\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 410 222\nL 404 199\nM 404 199\nL 389 199\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"220\" y=\"204\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Denmark (5932654 ≅ 1.32%)</text><path d=\"M 500 400\nL 417.4 217.85\nA 200 200 4.47 0 1 431.83 211.97\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 425 215\nL 419 183\nM 419 183\nL 404 183\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"245\" y=\"188\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Finland (5563970 ≅ 1.24%)</text><path d=\"M 500 400\nL 431.83 211.97\nA 200 200 4.36 0 1 446.32 207.34\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 440 210\nL 435 167\nM 435 167\nL 420 167\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"254\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Slovakia (5428792 ≅ 1.21%)</text><path d=\"M 500 400\nL 446.32 207.34\nA 200 200 4.17 0 1 460.47 203.95\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 454 206\nL 450 151\nM 450 151\nL 435 151\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"278\" y=\"156\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ireland (5194336 ≅ 1.15%)</text><path d=\"M 500 400\nL 460.47 203.95\nA 200 200 3.09 0 1 471.09 202.1\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 466 203\nL 464 135\nM 464 135\nL 449 135\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"290\" y=\"140\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Croatia (3850894 ≅ 0.85%)</text><path d=\"M 500 400\nL 471.09 202.1\nA 200 200 2.29 0 1 479.04 201.1\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 476 202\nL 474 119\nM 474 119\nL 459 119\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"289\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Lithuania (2857279 ≅ 0.63%)</text><path d=\"M 500 400\nL 479.04 201.1\nA 200 200 1.70 0 1 484.94 200.57\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 482 201\nL 481 103\nM 481 103\nL 466 103\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"300\" y=\"108\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Slovenia (2116792 ≅ 0.47%)</text><path d=\"M 500 400\nL 484.94 200.57\nA 200 200 1.51 0 1 490.21 200.24\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 488 201\nL 487 87\nM 487 87\nL 472 87\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"320\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Latvia (1883008 ≅ 0.41%)</text><path d=\"M 500 400\nL 490.21 200.24\nA 200 200 1.10 0 1 494.05 200.09\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 493 201\nL 492 71\nM 492 71\nL 477 71\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"324\" y=\"76\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Estonia (1373101 ≅ 0.3%)</text><path d=\"M 500 400\nL 494.05 200.09\nA 200 200 0.74 0 1 496.63 200.03\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 496 201\nL 495 55\nM 495 55\nL 480 55\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"337\" y=\"60\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Cyprus (920701 ≅ 0.2%)</text><path d=\"M 500 400\nL 496.63 200.03\nA 200 200 0.53 0 1 498.48 200.01\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 498 201\nL 498 39\nM 498 39\nL 483 39\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"301\" y=\"44\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Luxembourg (660809 ≅ 0.14%)</text><path d=\"M 500 400\nL 498.48 200.01\nA 200 200 0.44 0 1 500 200\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 500 201\nL 500 23\nM 500 23\nL 485 23\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"342\" y=\"28\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Malta (542051 ≅ 0.12%)</text></svg>",
 			pngCRC: 0x6c21894d,
 		},
 		{
@@ -195,7 +195,7 @@ func TestPieChart(t *testing.T) {
 					},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 1000 800\"><path d=\"M 20 20\nL 980 20\nL 980 780\nL 20 780\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 500 400\nL 641 258\nA 200 200 54.63 0 1 697 433\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 690 339\nL 704 334\nM 704 334\nL 719 334\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"722\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">France (68070697 ≅ 15.17%)</text><path d=\"M 500 400\nL 629 247\nA 200 200 4.47 0 1 641 258\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 635 253\nL 645 242\nM 645 242\nL 660 242\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><text x=\"663\" y=\"247\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Finland (5563970 ≅ 1.24%)</text><path d=\"M 500 400\nL 626 245\nA 200 200 1.10 0 1 629 247\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 627 247\nL 637 226\nM 637 226\nL 652 226\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"655\" y=\"231\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Estonia (1373101 ≅ 0.3%)</text><path d=\"M 500 400\nL 613 235\nA 200 200 4.76 0 1 626 245\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 619 240\nL 628 210\nM 628 210\nL 643 210\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><text x=\"646\" y=\"215\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Denmark (5932654 ≅ 1.32%)</text><path d=\"M 500 400\nL 587 220\nA 200 200 8.69 0 1 613 235\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 600 227\nL 607 194\nM 607 194\nL 622 194\" style=\"stroke-width:1;stroke:rgb(59,162,114);fill:none\"/><text x=\"625\" y=\"199\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Czech Republic (10827529 ≅ 2.41%)</text><path d=\"M 500 400\nL 585 219\nA 200 200 0.74 0 1 587 220\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 585 220\nL 592 178\nM 592 178\nL 607 178\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"610\" y=\"183\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Cyprus (920701 ≅ 0.2%)</text><path d=\"M 500 400\nL 575 214\nA 200 200 3.09 0 1 585 219\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 579 217\nL 585 162\nM 585 162\nL 600 162\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"603\" y=\"167\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Croatia (3850894 ≅ 0.85%)</text><path d=\"M 500 400\nL 558 208\nA 200 200 5.18 0 1 575 214\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 566 212\nL 571 146\nM 571 146\nL 586 146\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"589\" y=\"151\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Bulgaria (6447710 ≅ 1.43%)</text><path d=\"M 500 400\nL 525 202\nA 200 200 9.43 0 1 558 208\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 541 205\nL 544 130\nM 544 130\nL 559 130\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"562\" y=\"135\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Belgium (11754004 ≅ 2.62%)</text><path d=\"M 500 400\nL 500 200\nA 200 200 7.31 0 1 525 202\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 512 201\nL 513 114\nM 513 114\nL 528 114\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"531\" y=\"119\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Austria (9104772 ≅ 2.02%)</text><path d=\"M 500 400\nL 697 433\nA 200 200 67.71 0 1 545 595\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 645 537\nL 656 547\nM 656 547\nL 671 547\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"674\" y=\"552\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Germany (84358845 ≅ 18.8%)</text><path d=\"M 500 400\nL 545 595\nA 200 200 8.34 0 1 516 599\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 530 597\nL 532 612\nM 532 612\nL 547 612\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"550\" y=\"617\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Greece (10394055 ≅ 2.31%)</text><path d=\"M 500 400\nL 516 599\nA 200 200 7.70 0 1 489 600\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 502 599\nL 502 628\nM 502 628\nL 517 628\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"520\" y=\"633\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Hungary (9597085 ≅ 2.13%)</text><path d=\"M 500 400\nL 308 456\nA 200 200 29.50 0 1 305 354\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 301 405\nL 286 405\nM 286 405\nL 271 405\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"106\" y=\"410\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Poland (36753736 ≅ 8.19%)</text><path d=\"M 500 400\nL 328 502\nA 200 200 14.40 0 1 308 456\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 317 479\nL 303 485\nM 303 485\nL 288 485\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"112\" y=\"490\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Netherlands (17947406 ≅ 4%)</text><path d=\"M 500 400\nL 329 503\nA 200 200 0.44 0 1 328 502\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 329 502\nL 316 510\nM 316 510\nL 301 510\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"158\" y=\"515\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Malta (542051 ≅ 0.12%)</text><path d=\"M 500 400\nL 330 505\nA 200 200 0.53 0 1 329 503\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 330 504\nL 317 526\nM 317 526\nL 302 526\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"120\" y=\"531\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Luxembourg (660809 ≅ 0.14%)</text><path d=\"M 500 400\nL 334 512\nA 200 200 2.29 0 1 330 505\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 332 508\nL 320 542\nM 320 542\nL 305 542\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"135\" y=\"547\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Lithuania (2857279 ≅ 0.63%)</text><path d=\"M 500 400\nL 337 516\nA 200 200 1.51 0 1 334 512\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 336 513\nL 324 558\nM 324 558\nL 309 558\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"157\" y=\"563\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Latvia (1883008 ≅ 0.41%)</text><path d=\"M 500 400\nL 475 598\nA 200 200 47.23 0 1 337 516\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 398 571\nL 390 584\nM 390 584\nL 375 584\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"219\" y=\"589\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Italy (58850717 ≅ 13.12%)</text><path d=\"M 500 400\nL 489 600\nA 200 200 4.17 0 1 475 598\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 482 599\nL 481 614\nM 481 614\nL 466 614\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"309\" y=\"619\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ireland (5194336 ≅ 1.15%)</text><path d=\"M 500 400\nL 305 354\nA 200 200 8.40 0 1 314 326\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 310 341\nL 295 336\nM 295 336\nL 280 336\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"107\" y=\"341\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Portugal (10467366 ≅ 2.33%)</text><path d=\"M 500 400\nL 314 326\nA 200 200 15.29 0 1 340 280\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 326 303\nL 313 295\nM 313 295\nL 298 295\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"123\" y=\"300\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Romania (19051562 ≅ 4.24%)</text><path d=\"M 500 400\nL 340 280\nA 200 200 4.36 0 1 350 268\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 345 274\nL 334 265\nM 334 265\nL 319 265\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"153\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Slovakia (5428792 ≅ 1.21%)</text><path d=\"M 500 400\nL 350 268\nA 200 200 1.70 0 1 354 264\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 352 266\nL 341 249\nM 341 249\nL 326 249\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"160\" y=\"254\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Slovenia (2116792 ≅ 0.47%)</text><path d=\"M 500 400\nL 354 264\nA 200 200 38.57 0 1 471 202\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 407 223\nL 400 210\nM 400 210\nL 385 210\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"221\" y=\"215\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Spain (48059777 ≅ 10.71%)</text><path d=\"M 500 400\nL 471 202\nA 200 200 8.44 0 1 500 200\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 486 201\nL 485 186\nM 485 186\nL 470 186\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"300\" y=\"191\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sweden (10521556 ≅ 2.34%)</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 1000 800\"><path d=\"M 20 20\nL 980 20\nL 980 780\nL 20 780\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 500 400\nL 640.84 258\nA 200 200 54.63 0 1 697.31 432.67\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 690 339\nL 704 334\nM 704 334\nL 719 334\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"722\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">France (68070697 ≅ 15.17%)</text><path d=\"M 500 400\nL 629.36 247.47\nA 200 200 4.47 0 1 640.84 258\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 635 253\nL 645 242\nM 645 242\nL 660 242\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><text x=\"663\" y=\"247\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Finland (5563970 ≅ 1.24%)</text><path d=\"M 500 400\nL 626.4 245.01\nA 200 200 1.10 0 1 629.36 247.47\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 627 247\nL 637 226\nM 637 226\nL 652 226\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"655\" y=\"231\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Estonia (1373101 ≅ 0.3%)</text><path d=\"M 500 400\nL 613.1 235.05\nA 200 200 4.76 0 1 626.4 245.01\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 619 240\nL 628 210\nM 628 210\nL 643 210\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><text x=\"646\" y=\"215\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Denmark (5932654 ≅ 1.32%)</text><path d=\"M 500 400\nL 586.88 219.86\nA 200 200 8.69 0 1 613.1 235.05\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 600 227\nL 607 194\nM 607 194\nL 622 194\" style=\"stroke-width:1;stroke:rgb(59,162,114);fill:none\"/><text x=\"625\" y=\"199\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Czech Republic (10827529 ≅ 2.41%)</text><path d=\"M 500 400\nL 584.55 218.75\nA 200 200 0.74 0 1 586.88 219.86\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 585 220\nL 592 178\nM 592 178\nL 607 178\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"610\" y=\"183\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Cyprus (920701 ≅ 0.2%)</text><path d=\"M 500 400\nL 574.65 214.45\nA 200 200 3.09 0 1 584.55 218.75\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 579 217\nL 585 162\nM 585 162\nL 600 162\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"603\" y=\"167\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Croatia (3850894 ≅ 0.85%)</text><path d=\"M 500 400\nL 557.61 208.48\nA 200 200 5.18 0 1 574.65 214.45\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 566 212\nL 571 146\nM 571 146\nL 586 146\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"589\" y=\"151\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Bulgaria (6447710 ≅ 1.43%)</text><path d=\"M 500 400\nL 525.44 201.62\nA 200 200 9.43 0 1 557.61 208.48\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 541 205\nL 544 130\nM 544 130\nL 559 130\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"562\" y=\"135\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Belgium (11754004 ≅ 2.62%)</text><path d=\"M 500 400\nL 500 200\nA 200 200 7.31 0 1 525.44 201.62\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 512 201\nL 513 114\nM 513 114\nL 528 114\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"531\" y=\"119\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Austria (9104772 ≅ 2.02%)</text><path d=\"M 500 400\nL 697.31 432.67\nA 200 200 67.71 0 1 544.62 594.96\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 645 537\nL 656 547\nM 656 547\nL 671 547\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"674\" y=\"552\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Germany (84358845 ≅ 18.8%)</text><path d=\"M 500 400\nL 544.62 594.96\nA 200 200 8.34 0 1 515.86 599.37\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 530 597\nL 532 612\nM 532 612\nL 547 612\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"550\" y=\"617\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Greece (10394055 ≅ 2.31%)</text><path d=\"M 500 400\nL 515.86 599.37\nA 200 200 7.70 0 1 488.99 599.7\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 502 599\nL 502 628\nM 502 628\nL 517 628\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"520\" y=\"633\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Hungary (9597085 ≅ 2.13%)</text><path d=\"M 500 400\nL 308.01 456.02\nA 200 200 29.50 0 1 305.31 354.22\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 301 405\nL 286 405\nM 286 405\nL 271 405\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"106\" y=\"410\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Poland (36753736 ≅ 8.19%)</text><path d=\"M 500 400\nL 327.98 502.02\nA 200 200 14.40 0 1 308.01 456.02\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 317 479\nL 303 485\nM 303 485\nL 288 485\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"112\" y=\"490\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Netherlands (17947406 ≅ 4%)</text><path d=\"M 500 400\nL 328.76 503.33\nA 200 200 0.44 0 1 327.98 502.02\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 329 502\nL 316 510\nM 316 510\nL 301 510\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"158\" y=\"515\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Malta (542051 ≅ 0.12%)</text><path d=\"M 500 400\nL 329.72 504.91\nA 200 200 0.53 0 1 328.76 503.33\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 330 504\nL 317 526\nM 317 526\nL 302 526\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"120\" y=\"531\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Luxembourg (660809 ≅ 0.14%)</text><path d=\"M 500 400\nL 334.06 511.64\nA 200 200 2.29 0 1 329.72 504.91\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 332 508\nL 320 542\nM 320 542\nL 305 542\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"135\" y=\"547\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Lithuania (2857279 ≅ 0.63%)</text><path d=\"M 500 400\nL 337.06 515.97\nA 200 200 1.51 0 1 334.06 511.64\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 336 513\nL 324 558\nM 324 558\nL 309 558\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"157\" y=\"563\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Latvia (1883008 ≅ 0.41%)</text><path d=\"M 500 400\nL 474.51 598.37\nA 200 200 47.23 0 1 337.06 515.97\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 398 571\nL 390 584\nM 390 584\nL 375 584\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"219\" y=\"589\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Italy (58850717 ≅ 13.12%)</text><path d=\"M 500 400\nL 488.99 599.7\nA 200 200 4.17 0 1 474.51 598.37\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 482 599\nL 481 614\nM 481 614\nL 466 614\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"309\" y=\"619\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Ireland (5194336 ≅ 1.15%)</text><path d=\"M 500 400\nL 305.31 354.22\nA 200 200 8.40 0 1 314.09 326.26\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 310 341\nL 295 336\nM 295 336\nL 280 336\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"107\" y=\"341\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Portugal (10467366 ≅ 2.33%)</text><path d=\"M 500 400\nL 314.09 326.26\nA 200 200 15.29 0 1 340.12 279.85\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 326 303\nL 313 295\nM 313 295\nL 298 295\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"123\" y=\"300\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Romania (19051562 ≅ 4.24%)</text><path d=\"M 500 400\nL 340.12 279.85\nA 200 200 4.36 0 1 349.71 268.05\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 345 274\nL 334 265\nM 334 265\nL 319 265\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"153\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Slovakia (5428792 ≅ 1.21%)</text><path d=\"M 500 400\nL 349.71 268.05\nA 200 200 1.70 0 1 353.69 263.65\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 352 266\nL 341 249\nM 341 249\nL 326 249\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"160\" y=\"254\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Slovenia (2116792 ≅ 0.47%)</text><path d=\"M 500 400\nL 353.69 263.65\nA 200 200 38.57 0 1 470.63 202.17\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 407 223\nL 400 210\nM 400 210\nL 385 210\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"221\" y=\"215\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Spain (48059777 ≅ 10.71%)</text><path d=\"M 500 400\nL 470.63 202.17\nA 200 200 8.44 0 1 500 200\nL 500 400\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 486 201\nL 485 186\nM 485 186\nL 470 186\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"300\" y=\"191\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sweden (10521556 ≅ 2.34%)</text></svg>",
 			pngCRC: 0x28ad9867,
 		},
 		{
@@ -220,7 +220,7 @@ func TestPieChart(t *testing.T) {
 					},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 1000 900\"><path d=\"M 20 20\nL 980 20\nL 980 880\nL 20 880\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 500 450\nL 700 437\nA 200 200 3.60 0 1 700 450\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 699 444\nL 714 444\nM 714 444\nL 729 444\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"732\" y=\"449\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 25: 1%</text><path d=\"M 500 450\nL 698 425\nA 200 200 3.60 0 1 700 437\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 699 432\nL 714 428\nM 714 428\nL 729 428\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"732\" y=\"433\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 24: 1%</text><path d=\"M 500 450\nL 696 413\nA 200 200 3.60 0 1 698 425\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 697 419\nL 712 412\nM 712 412\nL 727 412\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"730\" y=\"417\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 23: 1%</text><path d=\"M 500 450\nL 694 400\nA 200 200 3.60 0 1 696 413\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 695 407\nL 709 396\nM 709 396\nL 724 396\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"727\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 22: 1%</text><path d=\"M 500 450\nL 690 388\nA 200 200 3.60 0 1 694 400\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 692 395\nL 706 380\nM 706 380\nL 721 380\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"724\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 21: 1%</text><path d=\"M 500 450\nL 686 376\nA 200 200 3.60 0 1 690 388\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 688 383\nL 702 364\nM 702 364\nL 717 364\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"720\" y=\"369\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 20: 1%</text><path d=\"M 500 450\nL 681 365\nA 200 200 3.60 0 1 686 376\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 683 371\nL 697 348\nM 697 348\nL 712 348\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"715\" y=\"353\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 19: 1%</text><path d=\"M 500 450\nL 675 354\nA 200 200 3.60 0 1 681 365\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 678 360\nL 691 332\nM 691 332\nL 706 332\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"709\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 18: 1%</text><path d=\"M 500 450\nL 669 343\nA 200 200 3.60 0 1 675 354\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 672 349\nL 685 316\nM 685 316\nL 700 316\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"703\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 17: 1%</text><path d=\"M 500 450\nL 662 332\nA 200 200 3.60 0 1 669 343\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 665 338\nL 677 300\nM 677 300\nL 692 300\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"695\" y=\"305\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 16: 1%</text><path d=\"M 500 450\nL 654 323\nA 200 200 3.60 0 1 662 332\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 658 328\nL 669 284\nM 669 284\nL 684 284\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"687\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 15: 1%</text><path d=\"M 500 450\nL 646 313\nA 200 200 3.60 0 1 654 323\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 650 318\nL 661 268\nM 661 268\nL 676 268\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"679\" y=\"273\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 14: 1%</text><path d=\"M 500 450\nL 637 304\nA 200 200 3.60 0 1 646 313\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 641 309\nL 652 252\nM 652 252\nL 667 252\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"670\" y=\"257\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 13: 1%</text><path d=\"M 500 450\nL 627 296\nA 200 200 3.60 0 1 637 304\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 632 300\nL 642 236\nM 642 236\nL 657 236\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"660\" y=\"241\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 12: 1%</text><path d=\"M 500 450\nL 618 288\nA 200 200 3.60 0 1 627 296\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 622 292\nL 631 220\nM 631 220\nL 646 220\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"649\" y=\"225\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 11: 1%</text><path d=\"M 500 450\nL 607 281\nA 200 200 3.60 0 1 618 288\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 612 285\nL 620 204\nM 620 204\nL 635 204\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"638\" y=\"209\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 10: 1%</text><path d=\"M 500 450\nL 596 275\nA 200 200 3.60 0 1 607 281\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 601 278\nL 609 188\nM 609 188\nL 624 188\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><text x=\"627\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 9: 1%</text><path d=\"M 500 450\nL 585 269\nA 200 200 3.60 0 1 596 275\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 590 272\nL 597 172\nM 597 172\nL 612 172\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"615\" y=\"177\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 8: 1%</text><path d=\"M 500 450\nL 574 264\nA 200 200 3.60 0 1 585 269\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 579 267\nL 585 156\nM 585 156\nL 600 156\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><text x=\"603\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 7: 1%</text><path d=\"M 500 450\nL 562 260\nA 200 200 3.60 0 1 574 264\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 567 262\nL 572 140\nM 572 140\nL 587 140\" style=\"stroke-width:1;stroke:rgb(59,162,114);fill:none\"/><text x=\"590\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 6: 1%</text><path d=\"M 500 450\nL 550 256\nA 200 200 3.60 0 1 562 260\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 555 258\nL 559 124\nM 559 124\nL 574 124\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"577\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 5: 1%</text><path d=\"M 500 450\nL 537 254\nA 200 200 3.60 0 1 550 256\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 543 255\nL 546 108\nM 546 108\nL 561 108\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"564\" y=\"113\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 4: 1%</text><path d=\"M 500 450\nL 525 252\nA 200 200 3.60 0 1 537 254\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 531 253\nL 533 92\nM 533 92\nL 548 92\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"551\" y=\"97\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 3: 1%</text><path d=\"M 500 450\nL 513 250\nA 200 200 3.60 0 1 525 252\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 518 251\nL 520 76\nM 520 76\nL 535 76\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"538\" y=\"81\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 2: 1%</text><path d=\"M 500 450\nL 500 250\nA 200 200 3.60 0 1 513 250\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 506 251\nL 506 60\nM 506 60\nL 521 60\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"524\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 1: 1%</text><path d=\"M 500 450\nL 700 450\nA 200 200 3.60 0 1 700 463\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 699 456\nL 714 460\nM 714 460\nL 729 460\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"732\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 26: 1%</text><path d=\"M 500 450\nL 700 463\nA 200 200 3.60 0 1 698 475\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 699 468\nL 714 476\nM 714 476\nL 729 476\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"732\" y=\"481\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 27: 1%</text><path d=\"M 500 450\nL 698 475\nA 200 200 3.60 0 1 696 487\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(191,196,212)\"/><path d=\"M 697 481\nL 712 492\nM 712 492\nL 727 492\" style=\"stroke-width:1;stroke:rgb(191,196,212);fill:none\"/><text x=\"730\" y=\"497\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 28: 1%</text><path d=\"M 500 450\nL 696 487\nA 200 200 3.60 0 1 694 500\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 695 493\nL 709 508\nM 709 508\nL 724 508\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><text x=\"727\" y=\"513\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 29: 1%</text><path d=\"M 500 450\nL 694 500\nA 200 200 3.60 0 1 690 512\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,236,214)\"/><path d=\"M 692 505\nL 706 524\nM 706 524\nL 721 524\" style=\"stroke-width:1;stroke:rgb(246,236,214);fill:none\"/><text x=\"724\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 30: 1%</text><path d=\"M 500 450\nL 690 512\nA 200 200 3.60 0 1 686 524\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 688 517\nL 702 540\nM 702 540\nL 717 540\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><text x=\"720\" y=\"545\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 31: 1%</text><path d=\"M 500 450\nL 686 524\nA 200 200 3.60 0 1 681 535\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(221,233,237)\"/><path d=\"M 683 529\nL 697 556\nM 697 556\nL 712 556\" style=\"stroke-width:1;stroke:rgb(221,233,237);fill:none\"/><text x=\"715\" y=\"561\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 32: 1%</text><path d=\"M 500 450\nL 681 535\nA 200 200 3.60 0 1 675 546\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(157,185,172)\"/><path d=\"M 678 540\nL 691 572\nM 691 572\nL 706 572\" style=\"stroke-width:1;stroke:rgb(157,185,172);fill:none\"/><text x=\"709\" y=\"577\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 33: 1%</text><path d=\"M 500 450\nL 675 546\nA 200 200 3.60 0 1 669 557\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,220,210)\"/><path d=\"M 672 551\nL 685 588\nM 685 588\nL 700 588\" style=\"stroke-width:1;stroke:rgb(246,220,210);fill:none\"/><text x=\"703\" y=\"593\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 34: 1%</text><path d=\"M 500 450\nL 669 557\nA 200 200 3.60 0 1 662 568\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(200,195,202)\"/><path d=\"M 665 562\nL 677 604\nM 677 604\nL 692 604\" style=\"stroke-width:1;stroke:rgb(200,195,202);fill:none\"/><text x=\"695\" y=\"609\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 35: 1%</text><path d=\"M 500 450\nL 662 568\nA 200 200 3.60 0 1 654 577\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,233,243)\"/><path d=\"M 658 572\nL 669 620\nM 669 620\nL 684 620\" style=\"stroke-width:1;stroke:rgb(246,233,243);fill:none\"/><text x=\"687\" y=\"625\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 36: 1%</text><path d=\"M 500 450\nL 654 577\nA 200 200 3.60 0 1 646 587\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 650 582\nL 661 636\nM 661 636\nL 676 636\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"679\" y=\"641\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 37: 1%</text><path d=\"M 500 450\nL 646 587\nA 200 200 3.60 0 1 637 596\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 641 591\nL 652 652\nM 652 652\nL 667 652\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"670\" y=\"657\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 38: 1%</text><path d=\"M 500 450\nL 637 596\nA 200 200 3.60 0 1 627 604\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 632 600\nL 642 668\nM 642 668\nL 657 668\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"660\" y=\"673\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 39: 1%</text><path d=\"M 500 450\nL 627 604\nA 200 200 3.60 0 1 618 612\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 622 608\nL 631 684\nM 631 684\nL 646 684\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"649\" y=\"689\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 40: 1%</text><path d=\"M 500 450\nL 618 612\nA 200 200 3.60 0 1 607 619\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 612 615\nL 620 700\nM 620 700\nL 635 700\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"638\" y=\"705\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 41: 1%</text><path d=\"M 500 450\nL 607 619\nA 200 200 3.60 0 1 596 625\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 601 622\nL 609 716\nM 609 716\nL 624 716\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"627\" y=\"721\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 42: 1%</text><path d=\"M 500 450\nL 596 625\nA 200 200 3.60 0 1 585 631\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 590 628\nL 597 732\nM 597 732\nL 612 732\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"615\" y=\"737\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 43: 1%</text><path d=\"M 500 450\nL 585 631\nA 200 200 3.60 0 1 574 636\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 579 633\nL 585 748\nM 585 748\nL 600 748\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"603\" y=\"753\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 44: 1%</text><path d=\"M 500 450\nL 574 636\nA 200 200 3.60 0 1 562 640\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 567 638\nL 572 764\nM 572 764\nL 587 764\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"590\" y=\"769\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 45: 1%</text><path d=\"M 500 450\nL 562 640\nA 200 200 3.60 0 1 550 644\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 555 642\nL 559 780\nM 559 780\nL 574 780\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"577\" y=\"785\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 46: 1%</text><path d=\"M 500 450\nL 550 644\nA 200 200 3.60 0 1 537 646\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 543 645\nL 546 796\nM 546 796\nL 561 796\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"564\" y=\"801\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 47: 1%</text><path d=\"M 500 450\nL 537 646\nA 200 200 3.60 0 1 525 648\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 531 647\nL 533 812\nM 533 812\nL 548 812\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"551\" y=\"817\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 48: 1%</text><path d=\"M 500 450\nL 525 648\nA 200 200 3.60 0 1 513 650\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 518 649\nL 520 828\nM 520 828\nL 535 828\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"538\" y=\"833\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 49: 1%</text><path d=\"M 500 450\nL 513 650\nA 200 200 3.60 0 1 500 650\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 506 649\nL 506 844\nM 506 844\nL 521 844\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"524\" y=\"849\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 50: 1%</text><path d=\"M 500 450\nL 300 463\nA 200 200 3.60 0 1 300 450\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 301 456\nL 286 456\nM 286 456\nL 271 456\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"196\" y=\"461\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 75: 1%</text><path d=\"M 500 450\nL 302 475\nA 200 200 3.60 0 1 300 463\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 301 468\nL 286 472\nM 286 472\nL 271 472\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"196\" y=\"477\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 74: 1%</text><path d=\"M 500 450\nL 304 487\nA 200 200 3.60 0 1 302 475\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 303 481\nL 288 488\nM 288 488\nL 273 488\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"198\" y=\"493\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 73: 1%</text><path d=\"M 500 450\nL 306 500\nA 200 200 3.60 0 1 304 487\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 305 493\nL 291 504\nM 291 504\nL 276 504\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"201\" y=\"509\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 72: 1%</text><path d=\"M 500 450\nL 310 512\nA 200 200 3.60 0 1 306 500\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 308 505\nL 294 520\nM 294 520\nL 279 520\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"204\" y=\"525\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 71: 1%</text><path d=\"M 500 450\nL 314 524\nA 200 200 3.60 0 1 310 512\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 312 517\nL 298 536\nM 298 536\nL 283 536\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"208\" y=\"541\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 70: 1%</text><path d=\"M 500 450\nL 319 535\nA 200 200 3.60 0 1 314 524\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 317 529\nL 303 552\nM 303 552\nL 288 552\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"213\" y=\"557\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 69: 1%</text><path d=\"M 500 450\nL 325 546\nA 200 200 3.60 0 1 319 535\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 322 540\nL 309 568\nM 309 568\nL 294 568\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"219\" y=\"573\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 68: 1%</text><path d=\"M 500 450\nL 331 557\nA 200 200 3.60 0 1 325 546\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 328 551\nL 315 584\nM 315 584\nL 300 584\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"225\" y=\"589\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 67: 1%</text><path d=\"M 500 450\nL 338 568\nA 200 200 3.60 0 1 331 557\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 335 562\nL 323 600\nM 323 600\nL 308 600\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"233\" y=\"605\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 66: 1%</text><path d=\"M 500 450\nL 346 577\nA 200 200 3.60 0 1 338 568\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 342 572\nL 331 616\nM 331 616\nL 316 616\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"241\" y=\"621\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 65: 1%</text><path d=\"M 500 450\nL 354 587\nA 200 200 3.60 0 1 346 577\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 350 582\nL 339 632\nM 339 632\nL 324 632\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"249\" y=\"637\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 64: 1%</text><path d=\"M 500 450\nL 363 596\nA 200 200 3.60 0 1 354 587\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,233,243)\"/><path d=\"M 359 591\nL 348 648\nM 348 648\nL 333 648\" style=\"stroke-width:1;stroke:rgb(246,233,243);fill:none\"/><text x=\"258\" y=\"653\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 63: 1%</text><path d=\"M 500 450\nL 373 604\nA 200 200 3.60 0 1 363 596\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(200,195,202)\"/><path d=\"M 368 600\nL 358 664\nM 358 664\nL 343 664\" style=\"stroke-width:1;stroke:rgb(200,195,202);fill:none\"/><text x=\"268\" y=\"669\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 62: 1%</text><path d=\"M 500 450\nL 382 612\nA 200 200 3.60 0 1 373 604\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,220,210)\"/><path d=\"M 378 608\nL 369 680\nM 369 680\nL 354 680\" style=\"stroke-width:1;stroke:rgb(246,220,210);fill:none\"/><text x=\"279\" y=\"685\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 61: 1%</text><path d=\"M 500 450\nL 393 619\nA 200 200 3.60 0 1 382 612\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(157,185,172)\"/><path d=\"M 388 615\nL 380 696\nM 380 696\nL 365 696\" style=\"stroke-width:1;stroke:rgb(157,185,172);fill:none\"/><text x=\"290\" y=\"701\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 60: 1%</text><path d=\"M 500 450\nL 404 625\nA 200 200 3.60 0 1 393 619\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(221,233,237)\"/><path d=\"M 399 622\nL 391 712\nM 391 712\nL 376 712\" style=\"stroke-width:1;stroke:rgb(221,233,237);fill:none\"/><text x=\"301\" y=\"717\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 59: 1%</text><path d=\"M 500 450\nL 415 631\nA 200 200 3.60 0 1 404 625\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 410 628\nL 403 728\nM 403 728\nL 388 728\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><text x=\"313\" y=\"733\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 58: 1%</text><path d=\"M 500 450\nL 426 636\nA 200 200 3.60 0 1 415 631\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,236,214)\"/><path d=\"M 421 633\nL 415 744\nM 415 744\nL 400 744\" style=\"stroke-width:1;stroke:rgb(246,236,214);fill:none\"/><text x=\"325\" y=\"749\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 57: 1%</text><path d=\"M 500 450\nL 438 640\nA 200 200 3.60 0 1 426 636\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 433 638\nL 428 760\nM 428 760\nL 413 760\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><text x=\"338\" y=\"765\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 56: 1%</text><path d=\"M 500 450\nL 450 644\nA 200 200 3.60 0 1 438 640\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(191,196,212)\"/><path d=\"M 445 642\nL 441 776\nM 441 776\nL 426 776\" style=\"stroke-width:1;stroke:rgb(191,196,212);fill:none\"/><text x=\"351\" y=\"781\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 55: 1%</text><path d=\"M 500 450\nL 463 646\nA 200 200 3.60 0 1 450 644\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 457 645\nL 454 792\nM 454 792\nL 439 792\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"364\" y=\"797\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 54: 1%</text><path d=\"M 500 450\nL 475 648\nA 200 200 3.60 0 1 463 646\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 469 647\nL 467 808\nM 467 808\nL 452 808\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"377\" y=\"813\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 53: 1%</text><path d=\"M 500 450\nL 487 650\nA 200 200 3.60 0 1 475 648\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 482 649\nL 480 824\nM 480 824\nL 465 824\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"390\" y=\"829\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 52: 1%</text><path d=\"M 500 450\nL 500 650\nA 200 200 3.60 0 1 487 650\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 494 649\nL 494 840\nM 494 840\nL 479 840\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"404\" y=\"845\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 51: 1%</text><path d=\"M 500 450\nL 300 450\nA 200 200 3.60 0 1 300 437\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 301 444\nL 286 440\nM 286 440\nL 271 440\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"196\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 76: 1%</text><path d=\"M 500 450\nL 300 437\nA 200 200 3.60 0 1 302 425\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 301 432\nL 286 424\nM 286 424\nL 271 424\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"196\" y=\"429\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 77: 1%</text><path d=\"M 500 450\nL 302 425\nA 200 200 3.60 0 1 304 413\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 303 419\nL 288 408\nM 288 408\nL 273 408\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"198\" y=\"413\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 78: 1%</text><path d=\"M 500 450\nL 304 413\nA 200 200 3.60 0 1 306 400\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 305 407\nL 291 392\nM 291 392\nL 276 392\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"201\" y=\"397\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 79: 1%</text><path d=\"M 500 450\nL 306 400\nA 200 200 3.60 0 1 310 388\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 308 395\nL 294 376\nM 294 376\nL 279 376\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"204\" y=\"381\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 80: 1%</text><path d=\"M 500 450\nL 310 388\nA 200 200 3.60 0 1 314 376\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 312 383\nL 298 360\nM 298 360\nL 283 360\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"208\" y=\"365\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 81: 1%</text><path d=\"M 500 450\nL 314 376\nA 200 200 3.60 0 1 319 365\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(191,196,212)\"/><path d=\"M 317 371\nL 303 344\nM 303 344\nL 288 344\" style=\"stroke-width:1;stroke:rgb(191,196,212);fill:none\"/><text x=\"213\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 82: 1%</text><path d=\"M 500 450\nL 319 365\nA 200 200 3.60 0 1 325 354\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 322 360\nL 309 328\nM 309 328\nL 294 328\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><text x=\"219\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 83: 1%</text><path d=\"M 500 450\nL 325 354\nA 200 200 3.60 0 1 331 343\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,236,214)\"/><path d=\"M 328 349\nL 315 312\nM 315 312\nL 300 312\" style=\"stroke-width:1;stroke:rgb(246,236,214);fill:none\"/><text x=\"225\" y=\"317\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 84: 1%</text><path d=\"M 500 450\nL 331 343\nA 200 200 3.60 0 1 338 332\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 335 338\nL 323 296\nM 323 296\nL 308 296\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><text x=\"233\" y=\"301\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 85: 1%</text><path d=\"M 500 450\nL 338 332\nA 200 200 3.60 0 1 346 323\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(221,233,237)\"/><path d=\"M 342 328\nL 331 280\nM 331 280\nL 316 280\" style=\"stroke-width:1;stroke:rgb(221,233,237);fill:none\"/><text x=\"241\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 86: 1%</text><path d=\"M 500 450\nL 346 323\nA 200 200 3.60 0 1 354 313\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(157,185,172)\"/><path d=\"M 350 318\nL 339 264\nM 339 264\nL 324 264\" style=\"stroke-width:1;stroke:rgb(157,185,172);fill:none\"/><text x=\"249\" y=\"269\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 87: 1%</text><path d=\"M 500 450\nL 354 313\nA 200 200 3.60 0 1 363 304\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,220,210)\"/><path d=\"M 359 309\nL 348 248\nM 348 248\nL 333 248\" style=\"stroke-width:1;stroke:rgb(246,220,210);fill:none\"/><text x=\"258\" y=\"253\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 88: 1%</text><path d=\"M 500 450\nL 363 304\nA 200 200 3.60 0 1 373 296\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(200,195,202)\"/><path d=\"M 368 300\nL 358 232\nM 358 232\nL 343 232\" style=\"stroke-width:1;stroke:rgb(200,195,202);fill:none\"/><text x=\"268\" y=\"237\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 89: 1%</text><path d=\"M 500 450\nL 373 296\nA 200 200 3.60 0 1 382 288\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,233,243)\"/><path d=\"M 378 292\nL 369 216\nM 369 216\nL 354 216\" style=\"stroke-width:1;stroke:rgb(246,233,243);fill:none\"/><text x=\"279\" y=\"221\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 90: 1%</text><path d=\"M 500 450\nL 382 288\nA 200 200 3.60 0 1 393 281\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 388 285\nL 380 200\nM 380 200\nL 365 200\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"290\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 91: 1%</text><path d=\"M 500 450\nL 393 281\nA 200 200 3.60 0 1 404 275\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 399 278\nL 391 184\nM 391 184\nL 376 184\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"301\" y=\"189\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 92: 1%</text><path d=\"M 500 450\nL 404 275\nA 200 200 3.60 0 1 415 269\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 410 272\nL 403 168\nM 403 168\nL 388 168\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"313\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 93: 1%</text><path d=\"M 500 450\nL 415 269\nA 200 200 3.60 0 1 426 264\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 421 267\nL 415 152\nM 415 152\nL 400 152\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"325\" y=\"157\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 94: 1%</text><path d=\"M 500 450\nL 426 264\nA 200 200 3.60 0 1 438 260\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 433 262\nL 428 136\nM 428 136\nL 413 136\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"338\" y=\"141\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 95: 1%</text><path d=\"M 500 450\nL 438 260\nA 200 200 3.60 0 1 450 256\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 445 258\nL 441 120\nM 441 120\nL 426 120\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"351\" y=\"125\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 96: 1%</text><path d=\"M 500 450\nL 450 256\nA 200 200 3.60 0 1 463 254\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 457 255\nL 454 104\nM 454 104\nL 439 104\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"364\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 97: 1%</text><path d=\"M 500 450\nL 463 254\nA 200 200 3.60 0 1 475 252\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 469 253\nL 467 88\nM 467 88\nL 452 88\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"377\" y=\"93\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 98: 1%</text><path d=\"M 500 450\nL 475 252\nA 200 200 3.60 0 1 487 250\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 482 251\nL 480 72\nM 480 72\nL 465 72\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"390\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 99: 1%</text><path d=\"M 500 450\nL 487 250\nA 200 200 3.60 0 1 500 250\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 494 251\nL 494 56\nM 494 56\nL 479 56\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"396\" y=\"61\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 100: 1%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 1000 900\"><path d=\"M 20 20\nL 980 20\nL 980 880\nL 20 880\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 500 450\nL 699.61 437.44\nA 200 200 3.60 0 1 700 450\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 699 444\nL 714 444\nM 714 444\nL 729 444\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"732\" y=\"449\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 25: 1%</text><path d=\"M 500 450\nL 698.42 424.93\nA 200 200 3.60 0 1 699.61 437.44\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 699 432\nL 714 428\nM 714 428\nL 729 428\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"732\" y=\"433\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 24: 1%</text><path d=\"M 500 450\nL 696.46 412.52\nA 200 200 3.60 0 1 698.42 424.93\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 697 419\nL 712 412\nM 712 412\nL 727 412\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"730\" y=\"417\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 23: 1%</text><path d=\"M 500 450\nL 693.72 400.26\nA 200 200 3.60 0 1 696.46 412.52\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 695 407\nL 709 396\nM 709 396\nL 724 396\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"727\" y=\"401\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 22: 1%</text><path d=\"M 500 450\nL 690.21 388.2\nA 200 200 3.60 0 1 693.72 400.26\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 692 395\nL 706 380\nM 706 380\nL 721 380\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"724\" y=\"385\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 21: 1%</text><path d=\"M 500 450\nL 685.96 376.38\nA 200 200 3.60 0 1 690.21 388.2\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 688 383\nL 702 364\nM 702 364\nL 717 364\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"720\" y=\"369\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 20: 1%</text><path d=\"M 500 450\nL 680.97 364.84\nA 200 200 3.60 0 1 685.96 376.38\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 683 371\nL 697 348\nM 697 348\nL 712 348\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"715\" y=\"353\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 19: 1%</text><path d=\"M 500 450\nL 675.26 353.65\nA 200 200 3.60 0 1 680.97 364.84\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 678 360\nL 691 332\nM 691 332\nL 706 332\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"709\" y=\"337\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 18: 1%</text><path d=\"M 500 450\nL 668.87 342.83\nA 200 200 3.60 0 1 675.26 353.65\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 672 349\nL 685 316\nM 685 316\nL 700 316\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"703\" y=\"321\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 17: 1%</text><path d=\"M 500 450\nL 661.8 332.44\nA 200 200 3.60 0 1 668.87 342.83\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 665 338\nL 677 300\nM 677 300\nL 692 300\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"695\" y=\"305\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 16: 1%</text><path d=\"M 500 450\nL 654.1 322.52\nA 200 200 3.60 0 1 661.8 332.44\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 658 328\nL 669 284\nM 669 284\nL 684 284\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"687\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 15: 1%</text><path d=\"M 500 450\nL 645.79 313.09\nA 200 200 3.60 0 1 654.1 322.52\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 650 318\nL 661 268\nM 661 268\nL 676 268\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"679\" y=\"273\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 14: 1%</text><path d=\"M 500 450\nL 636.91 304.21\nA 200 200 3.60 0 1 645.79 313.09\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 641 309\nL 652 252\nM 652 252\nL 667 252\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"670\" y=\"257\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 13: 1%</text><path d=\"M 500 450\nL 627.48 295.9\nA 200 200 3.60 0 1 636.91 304.21\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 632 300\nL 642 236\nM 642 236\nL 657 236\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"660\" y=\"241\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 12: 1%</text><path d=\"M 500 450\nL 617.56 288.2\nA 200 200 3.60 0 1 627.48 295.9\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 622 292\nL 631 220\nM 631 220\nL 646 220\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"649\" y=\"225\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 11: 1%</text><path d=\"M 500 450\nL 607.17 281.13\nA 200 200 3.60 0 1 617.56 288.2\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 612 285\nL 620 204\nM 620 204\nL 635 204\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"638\" y=\"209\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 10: 1%</text><path d=\"M 500 450\nL 596.35 274.74\nA 200 200 3.60 0 1 607.17 281.13\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 601 278\nL 609 188\nM 609 188\nL 624 188\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><text x=\"627\" y=\"193\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 9: 1%</text><path d=\"M 500 450\nL 585.16 269.03\nA 200 200 3.60 0 1 596.35 274.74\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 590 272\nL 597 172\nM 597 172\nL 612 172\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"615\" y=\"177\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 8: 1%</text><path d=\"M 500 450\nL 573.62 264.04\nA 200 200 3.60 0 1 585.16 269.03\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 579 267\nL 585 156\nM 585 156\nL 600 156\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><text x=\"603\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 7: 1%</text><path d=\"M 500 450\nL 561.8 259.79\nA 200 200 3.60 0 1 573.62 264.04\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 567 262\nL 572 140\nM 572 140\nL 587 140\" style=\"stroke-width:1;stroke:rgb(59,162,114);fill:none\"/><text x=\"590\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 6: 1%</text><path d=\"M 500 450\nL 549.74 256.28\nA 200 200 3.60 0 1 561.8 259.79\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 555 258\nL 559 124\nM 559 124\nL 574 124\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"577\" y=\"129\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 5: 1%</text><path d=\"M 500 450\nL 537.48 253.54\nA 200 200 3.60 0 1 549.74 256.28\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 543 255\nL 546 108\nM 546 108\nL 561 108\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"564\" y=\"113\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 4: 1%</text><path d=\"M 500 450\nL 525.07 251.58\nA 200 200 3.60 0 1 537.48 253.54\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 531 253\nL 533 92\nM 533 92\nL 548 92\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"551\" y=\"97\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 3: 1%</text><path d=\"M 500 450\nL 512.56 250.39\nA 200 200 3.60 0 1 525.07 251.58\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 518 251\nL 520 76\nM 520 76\nL 535 76\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"538\" y=\"81\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 2: 1%</text><path d=\"M 500 450\nL 500 250\nA 200 200 3.60 0 1 512.56 250.39\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 506 251\nL 506 60\nM 506 60\nL 521 60\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"524\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 1: 1%</text><path d=\"M 500 450\nL 700 450\nA 200 200 3.60 0 1 699.61 462.56\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 699 456\nL 714 460\nM 714 460\nL 729 460\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"732\" y=\"465\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 26: 1%</text><path d=\"M 500 450\nL 699.61 462.56\nA 200 200 3.60 0 1 698.42 475.07\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 699 468\nL 714 476\nM 714 476\nL 729 476\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"732\" y=\"481\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 27: 1%</text><path d=\"M 500 450\nL 698.42 475.07\nA 200 200 3.60 0 1 696.46 487.48\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(191,196,212)\"/><path d=\"M 697 481\nL 712 492\nM 712 492\nL 727 492\" style=\"stroke-width:1;stroke:rgb(191,196,212);fill:none\"/><text x=\"730\" y=\"497\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 28: 1%</text><path d=\"M 500 450\nL 696.46 487.48\nA 200 200 3.60 0 1 693.72 499.74\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 695 493\nL 709 508\nM 709 508\nL 724 508\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><text x=\"727\" y=\"513\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 29: 1%</text><path d=\"M 500 450\nL 693.72 499.74\nA 200 200 3.60 0 1 690.21 511.8\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,236,214)\"/><path d=\"M 692 505\nL 706 524\nM 706 524\nL 721 524\" style=\"stroke-width:1;stroke:rgb(246,236,214);fill:none\"/><text x=\"724\" y=\"529\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 30: 1%</text><path d=\"M 500 450\nL 690.21 511.8\nA 200 200 3.60 0 1 685.96 523.62\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 688 517\nL 702 540\nM 702 540\nL 717 540\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><text x=\"720\" y=\"545\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 31: 1%</text><path d=\"M 500 450\nL 685.96 523.62\nA 200 200 3.60 0 1 680.97 535.16\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(221,233,237)\"/><path d=\"M 683 529\nL 697 556\nM 697 556\nL 712 556\" style=\"stroke-width:1;stroke:rgb(221,233,237);fill:none\"/><text x=\"715\" y=\"561\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 32: 1%</text><path d=\"M 500 450\nL 680.97 535.16\nA 200 200 3.60 0 1 675.26 546.35\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(157,185,172)\"/><path d=\"M 678 540\nL 691 572\nM 691 572\nL 706 572\" style=\"stroke-width:1;stroke:rgb(157,185,172);fill:none\"/><text x=\"709\" y=\"577\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 33: 1%</text><path d=\"M 500 450\nL 675.26 546.35\nA 200 200 3.60 0 1 668.87 557.17\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,220,210)\"/><path d=\"M 672 551\nL 685 588\nM 685 588\nL 700 588\" style=\"stroke-width:1;stroke:rgb(246,220,210);fill:none\"/><text x=\"703\" y=\"593\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 34: 1%</text><path d=\"M 500 450\nL 668.87 557.17\nA 200 200 3.60 0 1 661.8 567.56\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(200,195,202)\"/><path d=\"M 665 562\nL 677 604\nM 677 604\nL 692 604\" style=\"stroke-width:1;stroke:rgb(200,195,202);fill:none\"/><text x=\"695\" y=\"609\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 35: 1%</text><path d=\"M 500 450\nL 661.8 567.56\nA 200 200 3.60 0 1 654.1 577.48\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,233,243)\"/><path d=\"M 658 572\nL 669 620\nM 669 620\nL 684 620\" style=\"stroke-width:1;stroke:rgb(246,233,243);fill:none\"/><text x=\"687\" y=\"625\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 36: 1%</text><path d=\"M 500 450\nL 654.1 577.48\nA 200 200 3.60 0 1 645.79 586.91\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 650 582\nL 661 636\nM 661 636\nL 676 636\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"679\" y=\"641\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 37: 1%</text><path d=\"M 500 450\nL 645.79 586.91\nA 200 200 3.60 0 1 636.91 595.79\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 641 591\nL 652 652\nM 652 652\nL 667 652\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"670\" y=\"657\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 38: 1%</text><path d=\"M 500 450\nL 636.91 595.79\nA 200 200 3.60 0 1 627.48 604.1\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 632 600\nL 642 668\nM 642 668\nL 657 668\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"660\" y=\"673\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 39: 1%</text><path d=\"M 500 450\nL 627.48 604.1\nA 200 200 3.60 0 1 617.56 611.8\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 622 608\nL 631 684\nM 631 684\nL 646 684\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"649\" y=\"689\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 40: 1%</text><path d=\"M 500 450\nL 617.56 611.8\nA 200 200 3.60 0 1 607.17 618.87\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 612 615\nL 620 700\nM 620 700\nL 635 700\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"638\" y=\"705\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 41: 1%</text><path d=\"M 500 450\nL 607.17 618.87\nA 200 200 3.60 0 1 596.35 625.26\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 601 622\nL 609 716\nM 609 716\nL 624 716\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"627\" y=\"721\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 42: 1%</text><path d=\"M 500 450\nL 596.35 625.26\nA 200 200 3.60 0 1 585.16 630.97\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 590 628\nL 597 732\nM 597 732\nL 612 732\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"615\" y=\"737\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 43: 1%</text><path d=\"M 500 450\nL 585.16 630.97\nA 200 200 3.60 0 1 573.62 635.96\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 579 633\nL 585 748\nM 585 748\nL 600 748\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"603\" y=\"753\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 44: 1%</text><path d=\"M 500 450\nL 573.62 635.96\nA 200 200 3.60 0 1 561.8 640.21\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 567 638\nL 572 764\nM 572 764\nL 587 764\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"590\" y=\"769\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 45: 1%</text><path d=\"M 500 450\nL 561.8 640.21\nA 200 200 3.60 0 1 549.74 643.72\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 555 642\nL 559 780\nM 559 780\nL 574 780\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"577\" y=\"785\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 46: 1%</text><path d=\"M 500 450\nL 549.74 643.72\nA 200 200 3.60 0 1 537.48 646.46\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 543 645\nL 546 796\nM 546 796\nL 561 796\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"564\" y=\"801\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 47: 1%</text><path d=\"M 500 450\nL 537.48 646.46\nA 200 200 3.60 0 1 525.07 648.42\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 531 647\nL 533 812\nM 533 812\nL 548 812\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"551\" y=\"817\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 48: 1%</text><path d=\"M 500 450\nL 525.07 648.42\nA 200 200 3.60 0 1 512.56 649.61\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 518 649\nL 520 828\nM 520 828\nL 535 828\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"538\" y=\"833\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 49: 1%</text><path d=\"M 500 450\nL 512.56 649.61\nA 200 200 3.60 0 1 500 650\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 506 649\nL 506 844\nM 506 844\nL 521 844\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"524\" y=\"849\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 50: 1%</text><path d=\"M 500 450\nL 300.39 462.56\nA 200 200 3.60 0 1 300 450\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 301 456\nL 286 456\nM 286 456\nL 271 456\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"196\" y=\"461\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 75: 1%</text><path d=\"M 500 450\nL 301.58 475.07\nA 200 200 3.60 0 1 300.39 462.56\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 301 468\nL 286 472\nM 286 472\nL 271 472\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"196\" y=\"477\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 74: 1%</text><path d=\"M 500 450\nL 303.54 487.48\nA 200 200 3.60 0 1 301.58 475.07\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 303 481\nL 288 488\nM 288 488\nL 273 488\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"198\" y=\"493\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 73: 1%</text><path d=\"M 500 450\nL 306.28 499.74\nA 200 200 3.60 0 1 303.54 487.48\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 305 493\nL 291 504\nM 291 504\nL 276 504\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"201\" y=\"509\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 72: 1%</text><path d=\"M 500 450\nL 309.79 511.8\nA 200 200 3.60 0 1 306.28 499.74\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 308 505\nL 294 520\nM 294 520\nL 279 520\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"204\" y=\"525\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 71: 1%</text><path d=\"M 500 450\nL 314.04 523.62\nA 200 200 3.60 0 1 309.79 511.8\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 312 517\nL 298 536\nM 298 536\nL 283 536\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"208\" y=\"541\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 70: 1%</text><path d=\"M 500 450\nL 319.03 535.16\nA 200 200 3.60 0 1 314.04 523.62\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 317 529\nL 303 552\nM 303 552\nL 288 552\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"213\" y=\"557\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 69: 1%</text><path d=\"M 500 450\nL 324.74 546.35\nA 200 200 3.60 0 1 319.03 535.16\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 322 540\nL 309 568\nM 309 568\nL 294 568\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"219\" y=\"573\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 68: 1%</text><path d=\"M 500 450\nL 331.13 557.17\nA 200 200 3.60 0 1 324.74 546.35\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 328 551\nL 315 584\nM 315 584\nL 300 584\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"225\" y=\"589\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 67: 1%</text><path d=\"M 500 450\nL 338.2 567.56\nA 200 200 3.60 0 1 331.13 557.17\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 335 562\nL 323 600\nM 323 600\nL 308 600\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"233\" y=\"605\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 66: 1%</text><path d=\"M 500 450\nL 345.9 577.48\nA 200 200 3.60 0 1 338.2 567.56\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 342 572\nL 331 616\nM 331 616\nL 316 616\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"241\" y=\"621\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 65: 1%</text><path d=\"M 500 450\nL 354.21 586.91\nA 200 200 3.60 0 1 345.9 577.48\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 350 582\nL 339 632\nM 339 632\nL 324 632\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"249\" y=\"637\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 64: 1%</text><path d=\"M 500 450\nL 363.09 595.79\nA 200 200 3.60 0 1 354.21 586.91\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,233,243)\"/><path d=\"M 359 591\nL 348 648\nM 348 648\nL 333 648\" style=\"stroke-width:1;stroke:rgb(246,233,243);fill:none\"/><text x=\"258\" y=\"653\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 63: 1%</text><path d=\"M 500 450\nL 372.52 604.1\nA 200 200 3.60 0 1 363.09 595.79\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(200,195,202)\"/><path d=\"M 368 600\nL 358 664\nM 358 664\nL 343 664\" style=\"stroke-width:1;stroke:rgb(200,195,202);fill:none\"/><text x=\"268\" y=\"669\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 62: 1%</text><path d=\"M 500 450\nL 382.44 611.8\nA 200 200 3.60 0 1 372.52 604.1\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,220,210)\"/><path d=\"M 378 608\nL 369 680\nM 369 680\nL 354 680\" style=\"stroke-width:1;stroke:rgb(246,220,210);fill:none\"/><text x=\"279\" y=\"685\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 61: 1%</text><path d=\"M 500 450\nL 392.83 618.87\nA 200 200 3.60 0 1 382.44 611.8\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(157,185,172)\"/><path d=\"M 388 615\nL 380 696\nM 380 696\nL 365 696\" style=\"stroke-width:1;stroke:rgb(157,185,172);fill:none\"/><text x=\"290\" y=\"701\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 60: 1%</text><path d=\"M 500 450\nL 403.65 625.26\nA 200 200 3.60 0 1 392.83 618.87\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(221,233,237)\"/><path d=\"M 399 622\nL 391 712\nM 391 712\nL 376 712\" style=\"stroke-width:1;stroke:rgb(221,233,237);fill:none\"/><text x=\"301\" y=\"717\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 59: 1%</text><path d=\"M 500 450\nL 414.84 630.97\nA 200 200 3.60 0 1 403.65 625.26\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 410 628\nL 403 728\nM 403 728\nL 388 728\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><text x=\"313\" y=\"733\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 58: 1%</text><path d=\"M 500 450\nL 426.38 635.96\nA 200 200 3.60 0 1 414.84 630.97\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,236,214)\"/><path d=\"M 421 633\nL 415 744\nM 415 744\nL 400 744\" style=\"stroke-width:1;stroke:rgb(246,236,214);fill:none\"/><text x=\"325\" y=\"749\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 57: 1%</text><path d=\"M 500 450\nL 438.2 640.21\nA 200 200 3.60 0 1 426.38 635.96\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 433 638\nL 428 760\nM 428 760\nL 413 760\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><text x=\"338\" y=\"765\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 56: 1%</text><path d=\"M 500 450\nL 450.26 643.72\nA 200 200 3.60 0 1 438.2 640.21\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(191,196,212)\"/><path d=\"M 445 642\nL 441 776\nM 441 776\nL 426 776\" style=\"stroke-width:1;stroke:rgb(191,196,212);fill:none\"/><text x=\"351\" y=\"781\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 55: 1%</text><path d=\"M 500 450\nL 462.52 646.46\nA 200 200 3.60 0 1 450.26 643.72\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 457 645\nL 454 792\nM 454 792\nL 439 792\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"364\" y=\"797\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 54: 1%</text><path d=\"M 500 450\nL 474.93 648.42\nA 200 200 3.60 0 1 462.52 646.46\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 469 647\nL 467 808\nM 467 808\nL 452 808\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"377\" y=\"813\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 53: 1%</text><path d=\"M 500 450\nL 487.44 649.61\nA 200 200 3.60 0 1 474.93 648.42\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 482 649\nL 480 824\nM 480 824\nL 465 824\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"390\" y=\"829\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 52: 1%</text><path d=\"M 500 450\nL 500 650\nA 200 200 3.60 0 1 487.44 649.61\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 494 649\nL 494 840\nM 494 840\nL 479 840\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"404\" y=\"845\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 51: 1%</text><path d=\"M 500 450\nL 300 450\nA 200 200 3.60 0 1 300.39 437.44\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 301 444\nL 286 440\nM 286 440\nL 271 440\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"196\" y=\"445\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 76: 1%</text><path d=\"M 500 450\nL 300.39 437.44\nA 200 200 3.60 0 1 301.58 424.93\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 301 432\nL 286 424\nM 286 424\nL 271 424\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"196\" y=\"429\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 77: 1%</text><path d=\"M 500 450\nL 301.58 424.93\nA 200 200 3.60 0 1 303.54 412.52\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 303 419\nL 288 408\nM 288 408\nL 273 408\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"198\" y=\"413\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 78: 1%</text><path d=\"M 500 450\nL 303.54 412.52\nA 200 200 3.60 0 1 306.28 400.26\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 305 407\nL 291 392\nM 291 392\nL 276 392\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"201\" y=\"397\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 79: 1%</text><path d=\"M 500 450\nL 306.28 400.26\nA 200 200 3.60 0 1 309.79 388.2\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 308 395\nL 294 376\nM 294 376\nL 279 376\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"204\" y=\"381\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 80: 1%</text><path d=\"M 500 450\nL 309.79 388.2\nA 200 200 3.60 0 1 314.04 376.38\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 312 383\nL 298 360\nM 298 360\nL 283 360\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"208\" y=\"365\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 81: 1%</text><path d=\"M 500 450\nL 314.04 376.38\nA 200 200 3.60 0 1 319.03 364.84\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(191,196,212)\"/><path d=\"M 317 371\nL 303 344\nM 303 344\nL 288 344\" style=\"stroke-width:1;stroke:rgb(191,196,212);fill:none\"/><text x=\"213\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 82: 1%</text><path d=\"M 500 450\nL 319.03 364.84\nA 200 200 3.60 0 1 324.74 353.65\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 322 360\nL 309 328\nM 309 328\nL 294 328\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><text x=\"219\" y=\"333\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 83: 1%</text><path d=\"M 500 450\nL 324.74 353.65\nA 200 200 3.60 0 1 331.13 342.83\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,236,214)\"/><path d=\"M 328 349\nL 315 312\nM 315 312\nL 300 312\" style=\"stroke-width:1;stroke:rgb(246,236,214);fill:none\"/><text x=\"225\" y=\"317\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 84: 1%</text><path d=\"M 500 450\nL 331.13 342.83\nA 200 200 3.60 0 1 338.2 332.44\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 335 338\nL 323 296\nM 323 296\nL 308 296\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><text x=\"233\" y=\"301\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 85: 1%</text><path d=\"M 500 450\nL 338.2 332.44\nA 200 200 3.60 0 1 345.9 322.52\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(221,233,237)\"/><path d=\"M 342 328\nL 331 280\nM 331 280\nL 316 280\" style=\"stroke-width:1;stroke:rgb(221,233,237);fill:none\"/><text x=\"241\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 86: 1%</text><path d=\"M 500 450\nL 345.9 322.52\nA 200 200 3.60 0 1 354.21 313.09\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(157,185,172)\"/><path d=\"M 350 318\nL 339 264\nM 339 264\nL 324 264\" style=\"stroke-width:1;stroke:rgb(157,185,172);fill:none\"/><text x=\"249\" y=\"269\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 87: 1%</text><path d=\"M 500 450\nL 354.21 313.09\nA 200 200 3.60 0 1 363.09 304.21\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,220,210)\"/><path d=\"M 359 309\nL 348 248\nM 348 248\nL 333 248\" style=\"stroke-width:1;stroke:rgb(246,220,210);fill:none\"/><text x=\"258\" y=\"253\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 88: 1%</text><path d=\"M 500 450\nL 363.09 304.21\nA 200 200 3.60 0 1 372.52 295.9\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(200,195,202)\"/><path d=\"M 368 300\nL 358 232\nM 358 232\nL 343 232\" style=\"stroke-width:1;stroke:rgb(200,195,202);fill:none\"/><text x=\"268\" y=\"237\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 89: 1%</text><path d=\"M 500 450\nL 372.52 295.9\nA 200 200 3.60 0 1 382.44 288.2\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(246,233,243)\"/><path d=\"M 378 292\nL 369 216\nM 369 216\nL 354 216\" style=\"stroke-width:1;stroke:rgb(246,233,243);fill:none\"/><text x=\"279\" y=\"221\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 90: 1%</text><path d=\"M 500 450\nL 382.44 288.2\nA 200 200 3.60 0 1 392.83 281.13\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 388 285\nL 380 200\nM 380 200\nL 365 200\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"290\" y=\"205\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 91: 1%</text><path d=\"M 500 450\nL 392.83 281.13\nA 200 200 3.60 0 1 403.65 274.74\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 399 278\nL 391 184\nM 391 184\nL 376 184\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"301\" y=\"189\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 92: 1%</text><path d=\"M 500 450\nL 403.65 274.74\nA 200 200 3.60 0 1 414.84 269.03\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 410 272\nL 403 168\nM 403 168\nL 388 168\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"313\" y=\"173\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 93: 1%</text><path d=\"M 500 450\nL 414.84 269.03\nA 200 200 3.60 0 1 426.38 264.04\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 421 267\nL 415 152\nM 415 152\nL 400 152\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"325\" y=\"157\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 94: 1%</text><path d=\"M 500 450\nL 426.38 264.04\nA 200 200 3.60 0 1 438.2 259.79\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 433 262\nL 428 136\nM 428 136\nL 413 136\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"338\" y=\"141\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 95: 1%</text><path d=\"M 500 450\nL 438.2 259.79\nA 200 200 3.60 0 1 450.26 256.28\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 445 258\nL 441 120\nM 441 120\nL 426 120\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"351\" y=\"125\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 96: 1%</text><path d=\"M 500 450\nL 450.26 256.28\nA 200 200 3.60 0 1 462.52 253.54\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 457 255\nL 454 104\nM 454 104\nL 439 104\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"364\" y=\"109\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 97: 1%</text><path d=\"M 500 450\nL 462.52 253.54\nA 200 200 3.60 0 1 474.93 251.58\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 469 253\nL 467 88\nM 467 88\nL 452 88\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"377\" y=\"93\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 98: 1%</text><path d=\"M 500 450\nL 474.93 251.58\nA 200 200 3.60 0 1 487.44 250.39\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 482 251\nL 480 72\nM 480 72\nL 465 72\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"390\" y=\"77\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 99: 1%</text><path d=\"M 500 450\nL 487.44 250.39\nA 200 200 3.60 0 1 500 250\nL 500 450\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 494 251\nL 494 56\nM 494 56\nL 479 56\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"396\" y=\"61\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Label 100: 1%</text></svg>",
 			pngCRC: 0x65b9fb22,
 		},
 		{
@@ -263,7 +263,7 @@ func TestPieChart(t *testing.T) {
 					},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 1150 550\"><path d=\"M 20 20\nL 1130 20\nL 1130 530\nL 20 530\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"981\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fix label K (72586)</text><path d=\"M 575 290\nL 717 241\nA 150 150 18.17 0 1 725 288\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 722 265\nL 737 262\nM 737 262\nL 752 262\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"755\" y=\"267\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">C (149086 ≅ 5.04%)</text><path d=\"M 575 290\nL 687 191\nA 150 150 22.62 0 1 717 241\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 704 215\nL 717 207\nM 717 207\nL 732 207\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"735\" y=\"212\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">B (185596 ≅ 6.28%)</text><path d=\"M 575 290\nL 575 140\nA 150 150 48.45 0 1 687 191\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 636 154\nL 642 140\nM 642 140\nL 657 140\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"660\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">A (397594 ≅ 13.45%)</text><path d=\"M 575 290\nL 725 288\nA 150 150 17.58 0 1 719 333\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 723 310\nL 738 313\nM 738 313\nL 753 313\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"756\" y=\"318\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">D (144258 ≅ 4.88%)</text><path d=\"M 575 290\nL 719 333\nA 150 150 14.65 0 1 703 368\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 711 351\nL 725 357\nM 725 357\nL 740 357\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"743\" y=\"362\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">E (120194 ≅ 4.06%)</text><path d=\"M 575 290\nL 703 368\nA 150 150 14.32 0 1 680 398\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 692 383\nL 703 393\nM 703 393\nL 718 393\" style=\"stroke-width:1;stroke:rgb(59,162,114);fill:none\"/><text x=\"721\" y=\"398\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">F (117514 ≅ 3.97%)</text><path d=\"M 575 290\nL 680 398\nA 150 150 12.12 0 1 655 417\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 667 407\nL 676 419\nM 676 419\nL 691 419\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><text x=\"694\" y=\"424\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">G (99412 ≅ 3.36%)</text><path d=\"M 575 290\nL 655 417\nA 150 150 11.11 0 1 629 430\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 642 424\nL 648 437\nM 648 437\nL 663 437\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"666\" y=\"442\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">H (91135 ≅ 3.08%)</text><path d=\"M 575 290\nL 629 430\nA 150 150 10.64 0 1 602 438\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 615 434\nL 619 453\nM 619 453\nL 634 453\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><text x=\"637\" y=\"458\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">I (87282 ≅ 2.95%)</text><path d=\"M 575 290\nL 602 438\nA 150 150 9.36 0 1 578 440\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 589 439\nL 591 469\nM 591 469\nL 606 469\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"609\" y=\"474\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">J (76790 ≅ 2.59%)</text><path d=\"M 575 290\nL 425 300\nA 150 150 3.61 0 1 425 291\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 426 295\nL 411 295\nM 411 295\nL 396 295\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"303\" y=\"300\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Z (29608 ≅ 1%)</text><path d=\"M 575 290\nL 426 310\nA 150 150 3.97 0 1 425 300\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 426 305\nL 411 311\nM 411 311\nL 396 311\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"292\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Y (32566 ≅ 1.1%)</text><path d=\"M 575 290\nL 428 321\nA 150 150 4.00 0 1 426 310\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 428 315\nL 413 327\nM 413 327\nL 398 327\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"294\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">X (32788 ≅ 1.1%)</text><path d=\"M 575 290\nL 431 331\nA 150 150 4.12 0 1 428 321\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 430 325\nL 415 343\nM 415 343\nL 400 343\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"286\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">W (33784 ≅ 1.14%)</text><path d=\"M 575 290\nL 434 342\nA 150 150 4.47 0 1 431 331\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 433 336\nL 419 359\nM 419 359\nL 404 359\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"293\" y=\"364\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V (36644 ≅ 1.24%)</text><path d=\"M 575 290\nL 439 353\nA 150 150 4.56 0 1 434 342\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 437 347\nL 423 375\nM 423 375\nL 408 375\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"297\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">U (37414 ≅ 1.26%)</text><path d=\"M 575 290\nL 445 364\nA 150 150 4.81 0 1 439 353\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 442 358\nL 429 391\nM 429 391\nL 414 391\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"303\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">T (39476 ≅ 1.33%)</text><path d=\"M 575 290\nL 452 376\nA 150 150 5.03 0 1 445 364\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 449 370\nL 436 407\nM 436 407\nL 421 407\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"310\" y=\"412\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">S (41242 ≅ 1.39%)</text><path d=\"M 575 290\nL 462 388\nA 150 150 6.27 0 1 452 376\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 457 382\nL 445 423\nM 445 423\nL 430 423\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"319\" y=\"428\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">R (51460 ≅ 1.74%)</text><path d=\"M 575 290\nL 474 401\nA 150 150 6.55 0 1 462 388\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 468 394\nL 457 439\nM 457 439\nL 442 439\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"330\" y=\"444\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Q (53746 ≅ 1.81%)</text><path d=\"M 575 290\nL 487 412\nA 150 150 6.68 0 1 474 401\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 481 406\nL 471 455\nM 471 455\nL 456 455\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"345\" y=\"460\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">P (54792 ≅ 1.85%)</text><path d=\"M 575 290\nL 502 421\nA 150 150 6.76 0 1 487 412\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 495 416\nL 487 471\nM 487 471\nL 472 471\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"360\" y=\"476\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">O (55486 ≅ 1.87%)</text><path d=\"M 575 290\nL 519 429\nA 150 150 6.86 0 1 502 421\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 511 425\nL 504 487\nM 504 487\nL 489 487\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"384\" y=\"492\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">N (56306 ≅ 1.9%)</text><path d=\"M 575 290\nL 536 435\nA 150 150 7.10 0 1 519 429\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 528 432\nL 523 503\nM 523 503\nL 508 503\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"394\" y=\"508\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">M (58270 ≅ 1.97%)</text><path d=\"M 575 290\nL 555 439\nA 150 150 7.17 0 1 536 435\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 546 437\nL 543 519\nM 543 519\nL 528 519\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"418\" y=\"524\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">L (58818 ≅ 1.99%)</text><path d=\"M 575 290\nL 578 440\nA 150 150 8.85 0 1 555 439\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 567 439\nL 566 535\nM 566 535\nL 551 535\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"440\" y=\"540\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">K (72586 ≅ 2.45%)</text><path d=\"M 575 290\nL 425 291\nA 150 150 3.60 0 1 425 281\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 426 286\nL 411 279\nM 411 279\nL 396 279\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"294\" y=\"284\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AA (29558 ≅ 1%)</text><path d=\"M 575 290\nL 425 281\nA 150 150 3.58 0 1 426 272\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(191,196,212)\"/><path d=\"M 426 277\nL 411 263\nM 411 263\nL 396 263\" style=\"stroke-width:1;stroke:rgb(191,196,212);fill:none\"/><text x=\"276\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AB (29384 ≅ 0.99%)</text><path d=\"M 575 290\nL 426 272\nA 150 150 3.43 0 1 427 263\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 427 268\nL 412 247\nM 412 247\nL 397 247\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><text x=\"277\" y=\"252\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AC (28166 ≅ 0.95%)</text><path d=\"M 575 290\nL 427 263\nA 150 150 3.29 0 1 429 254\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(246,236,214)\"/><path d=\"M 429 259\nL 414 231\nM 414 231\nL 399 231\" style=\"stroke-width:1;stroke:rgb(246,236,214);fill:none\"/><text x=\"279\" y=\"236\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AD (26998 ≅ 0.91%)</text><path d=\"M 575 290\nL 429 254\nA 150 150 3.28 0 1 432 246\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 431 251\nL 416 215\nM 416 215\nL 401 215\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><text x=\"282\" y=\"220\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AE (26948 ≅ 0.91%)</text><path d=\"M 575 290\nL 432 246\nA 150 150 3.18 0 1 434 238\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(221,233,237)\"/><path d=\"M 433 243\nL 419 199\nM 419 199\nL 404 199\" style=\"stroke-width:1;stroke:rgb(221,233,237);fill:none\"/><text x=\"286\" y=\"204\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AF (26054 ≅ 0.88%)</text><path d=\"M 575 290\nL 434 238\nA 150 150 3.14 0 1 437 231\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(157,185,172)\"/><path d=\"M 436 235\nL 422 183\nM 422 183\nL 407 183\" style=\"stroke-width:1;stroke:rgb(157,185,172);fill:none\"/><text x=\"287\" y=\"188\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AG (25804 ≅ 0.87%)</text><path d=\"M 575 290\nL 437 231\nA 150 150 3.14 0 1 441 223\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(246,220,210)\"/><path d=\"M 439 227\nL 426 167\nM 426 167\nL 411 167\" style=\"stroke-width:1;stroke:rgb(246,220,210);fill:none\"/><text x=\"290\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AH (25730 ≅ 0.87%)</text><path d=\"M 575 290\nL 441 223\nA 150 150 2.98 0 1 444 216\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(200,195,202)\"/><path d=\"M 443 220\nL 430 151\nM 430 151\nL 415 151\" style=\"stroke-width:1;stroke:rgb(200,195,202);fill:none\"/><text x=\"300\" y=\"156\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AI (24438 ≅ 0.82%)</text><path d=\"M 575 290\nL 444 216\nA 150 150 2.90 0 1 448 210\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(246,233,243)\"/><path d=\"M 447 214\nL 434 135\nM 434 135\nL 419 135\" style=\"stroke-width:1;stroke:rgb(246,233,243);fill:none\"/><text x=\"308\" y=\"140\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AJ (23782 ≅ 0.8%)</text><path d=\"M 575 290\nL 448 210\nA 150 150 2.79 0 1 452 204\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 451 207\nL 438 119\nM 438 119\nL 423 119\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"303\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AK (22896 ≅ 0.77%)</text><path d=\"M 575 290\nL 452 204\nA 150 150 2.61 0 1 456 198\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 455 201\nL 443 103\nM 443 103\nL 428 103\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"310\" y=\"108\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AL (21404 ≅ 0.72%)</text><path d=\"M 575 290\nL 456 198\nA 150 150 52.28 0 1 575 140\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 509 156\nL 503 87\nM 503 87\nL 488 87\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"351\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AM (428978 ≅ 14.52%)</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 1150 550\"><path d=\"M 20 20\nL 1130 20\nL 1130 530\nL 20 530\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"981\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fix label K (72586)</text><path d=\"M 575 290\nL 716.89 241.35\nA 150 150 18.17 0 1 724.99 288.02\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 722 265\nL 737 262\nM 737 262\nL 752 262\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"755\" y=\"267\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">C (149086 ≅ 5.04%)</text><path d=\"M 575 290\nL 687.26 190.52\nA 150 150 22.62 0 1 716.89 241.35\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 704 215\nL 717 207\nM 717 207\nL 732 207\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"735\" y=\"212\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">B (185596 ≅ 6.28%)</text><path d=\"M 575 290\nL 575 140\nA 150 150 48.45 0 1 687.26 190.52\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 636 154\nL 642 140\nM 642 140\nL 657 140\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"660\" y=\"145\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">A (397594 ≅ 13.45%)</text><path d=\"M 575 290\nL 724.99 288.02\nA 150 150 17.58 0 1 718.58 333.41\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 723 310\nL 738 313\nM 738 313\nL 753 313\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"756\" y=\"318\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">D (144258 ≅ 4.88%)</text><path d=\"M 575 290\nL 718.58 333.41\nA 150 150 14.65 0 1 702.94 368.31\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 711 351\nL 725 357\nM 725 357\nL 740 357\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"743\" y=\"362\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">E (120194 ≅ 4.06%)</text><path d=\"M 575 290\nL 702.94 368.31\nA 150 150 14.32 0 1 679.59 397.52\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(59,162,114)\"/><path d=\"M 692 383\nL 703 393\nM 703 393\nL 718 393\" style=\"stroke-width:1;stroke:rgb(59,162,114);fill:none\"/><text x=\"721\" y=\"398\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">F (117514 ≅ 3.97%)</text><path d=\"M 575 290\nL 679.59 397.52\nA 150 150 12.12 0 1 654.69 417.08\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(252,132,82)\"/><path d=\"M 667 407\nL 676 419\nM 676 419\nL 691 419\" style=\"stroke-width:1;stroke:rgb(252,132,82);fill:none\"/><text x=\"694\" y=\"424\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">G (99412 ≅ 3.36%)</text><path d=\"M 575 290\nL 654.69 417.08\nA 150 150 11.11 0 1 628.72 430.05\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(154,96,180)\"/><path d=\"M 642 424\nL 648 437\nM 648 437\nL 663 437\" style=\"stroke-width:1;stroke:rgb(154,96,180);fill:none\"/><text x=\"666\" y=\"442\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">H (91135 ≅ 3.08%)</text><path d=\"M 575 290\nL 628.72 430.05\nA 150 150 10.64 0 1 601.95 437.56\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(234,124,204)\"/><path d=\"M 615 434\nL 619 453\nM 619 453\nL 634 453\" style=\"stroke-width:1;stroke:rgb(234,124,204);fill:none\"/><text x=\"637\" y=\"458\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">I (87282 ≅ 2.95%)</text><path d=\"M 575 290\nL 601.95 437.56\nA 150 150 9.36 0 1 577.59 439.98\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 589 439\nL 591 469\nM 591 469\nL 606 469\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"609\" y=\"474\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">J (76790 ≅ 2.59%)</text><path d=\"M 575 290\nL 425.33 299.96\nA 150 150 3.61 0 1 425 290.52\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(183,166,190)\"/><path d=\"M 426 295\nL 411 295\nM 411 295\nL 396 295\" style=\"stroke-width:1;stroke:rgb(183,166,190);fill:none\"/><text x=\"303\" y=\"300\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Z (29608 ≅ 1%)</text><path d=\"M 575 290\nL 426.38 310.3\nA 150 150 3.97 0 1 425.33 299.96\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(243,192,171)\"/><path d=\"M 426 305\nL 411 311\nM 411 311\nL 396 311\" style=\"stroke-width:1;stroke:rgb(243,192,171);fill:none\"/><text x=\"292\" y=\"316\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Y (32566 ≅ 1.1%)</text><path d=\"M 575 290\nL 428.16 320.61\nA 150 150 4.00 0 1 426.38 310.3\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(123,178,153)\"/><path d=\"M 428 315\nL 413 327\nM 413 327\nL 398 327\" style=\"stroke-width:1;stroke:rgb(123,178,153);fill:none\"/><text x=\"294\" y=\"332\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">X (32788 ≅ 1.1%)</text><path d=\"M 575 290\nL 430.73 331.07\nA 150 150 4.12 0 1 428.16 320.61\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(190,217,228)\"/><path d=\"M 430 325\nL 415 343\nM 415 343\nL 400 343\" style=\"stroke-width:1;stroke:rgb(190,217,228);fill:none\"/><text x=\"286\" y=\"348\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">W (33784 ≅ 1.14%)</text><path d=\"M 575 290\nL 434.37 342.18\nA 150 150 4.47 0 1 430.73 331.07\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(237,184,184)\"/><path d=\"M 433 336\nL 419 359\nM 419 359\nL 404 359\" style=\"stroke-width:1;stroke:rgb(237,184,184);fill:none\"/><text x=\"293\" y=\"364\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">V (36644 ≅ 1.24%)</text><path d=\"M 575 290\nL 438.96 353.19\nA 150 150 4.56 0 1 434.37 342.18\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(243,222,176)\"/><path d=\"M 437 347\nL 423 375\nM 423 375\nL 408 375\" style=\"stroke-width:1;stroke:rgb(243,222,176);fill:none\"/><text x=\"297\" y=\"380\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">U (37414 ≅ 1.26%)</text><path d=\"M 575 290\nL 444.74 364.38\nA 150 150 4.81 0 1 438.96 353.19\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(196,215,187)\"/><path d=\"M 442 358\nL 429 391\nM 429 391\nL 414 391\" style=\"stroke-width:1;stroke:rgb(196,215,187);fill:none\"/><text x=\"303\" y=\"396\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">T (39476 ≅ 1.33%)</text><path d=\"M 575 290\nL 451.76 375.51\nA 150 150 5.03 0 1 444.74 364.38\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(159,170,203)\"/><path d=\"M 449 370\nL 436 407\nM 436 407\nL 421 407\" style=\"stroke-width:1;stroke:rgb(159,170,203);fill:none\"/><text x=\"310\" y=\"412\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">S (41242 ≅ 1.39%)</text><path d=\"M 575 290\nL 461.84 388.46\nA 150 150 6.27 0 1 451.76 375.51\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(234,164,215)\"/><path d=\"M 457 382\nL 445 423\nM 445 423\nL 430 423\" style=\"stroke-width:1;stroke:rgb(234,164,215);fill:none\"/><text x=\"319\" y=\"428\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">R (51460 ≅ 1.74%)</text><path d=\"M 575 290\nL 473.8 400.72\nA 150 150 6.55 0 1 461.84 388.46\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(167,133,183)\"/><path d=\"M 468 394\nL 457 439\nM 457 439\nL 442 439\" style=\"stroke-width:1;stroke:rgb(167,133,183);fill:none\"/><text x=\"330\" y=\"444\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Q (53746 ≅ 1.81%)</text><path d=\"M 575 290\nL 487.37 411.74\nA 150 150 6.68 0 1 473.8 400.72\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(245,163,128)\"/><path d=\"M 481 406\nL 471 455\nM 471 455\nL 456 455\" style=\"stroke-width:1;stroke:rgb(245,163,128);fill:none\"/><text x=\"345\" y=\"460\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">P (54792 ≅ 1.85%)</text><path d=\"M 575 290\nL 502.31 421.21\nA 150 150 6.76 0 1 487.37 411.74\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(85,176,133)\"/><path d=\"M 495 416\nL 487 471\nM 487 471\nL 472 471\" style=\"stroke-width:1;stroke:rgb(85,176,133);fill:none\"/><text x=\"360\" y=\"476\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">O (55486 ≅ 1.87%)</text><path d=\"M 575 290\nL 518.51 428.96\nA 150 150 6.86 0 1 502.31 421.21\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(154,203,223)\"/><path d=\"M 511 425\nL 504 487\nM 504 487\nL 489 487\" style=\"stroke-width:1;stroke:rgb(154,203,223);fill:none\"/><text x=\"384\" y=\"492\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">N (56306 ≅ 1.9%)</text><path d=\"M 575 290\nL 536.12 434.87\nA 150 150 7.10 0 1 518.51 428.96\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(235,145,145)\"/><path d=\"M 528 432\nL 523 503\nM 523 503\nL 508 503\" style=\"stroke-width:1;stroke:rgb(235,145,145);fill:none\"/><text x=\"394\" y=\"508\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">M (58270 ≅ 1.97%)</text><path d=\"M 575 290\nL 554.5 438.59\nA 150 150 7.17 0 1 536.12 434.87\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 546 437\nL 543 519\nM 543 519\nL 528 519\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"418\" y=\"524\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">L (58818 ≅ 1.99%)</text><path d=\"M 575 290\nL 577.59 439.98\nA 150 150 8.85 0 1 554.5 438.59\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 567 439\nL 566 535\nM 566 535\nL 551 535\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"440\" y=\"540\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">K (72586 ≅ 2.45%)</text><path d=\"M 575 290\nL 425 290.52\nA 150 150 3.60 0 1 425.26 281.1\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(238,201,228)\"/><path d=\"M 426 286\nL 411 279\nM 411 279\nL 396 279\" style=\"stroke-width:1;stroke:rgb(238,201,228);fill:none\"/><text x=\"294\" y=\"284\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AA (29558 ≅ 1%)</text><path d=\"M 575 290\nL 425.26 281.1\nA 150 150 3.58 0 1 426.11 271.76\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(191,196,212)\"/><path d=\"M 426 277\nL 411 263\nM 411 263\nL 396 263\" style=\"stroke-width:1;stroke:rgb(191,196,212);fill:none\"/><text x=\"276\" y=\"268\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AB (29384 ≅ 0.99%)</text><path d=\"M 575 290\nL 426.11 271.76\nA 150 150 3.43 0 1 427.47 262.88\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(219,227,216)\"/><path d=\"M 427 268\nL 412 247\nM 412 247\nL 397 247\" style=\"stroke-width:1;stroke:rgb(219,227,216);fill:none\"/><text x=\"277\" y=\"252\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AC (28166 ≅ 0.95%)</text><path d=\"M 575 290\nL 427.47 262.88\nA 150 150 3.29 0 1 429.27 254.46\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(246,236,214)\"/><path d=\"M 429 259\nL 414 231\nM 414 231\nL 399 231\" style=\"stroke-width:1;stroke:rgb(246,236,214);fill:none\"/><text x=\"279\" y=\"236\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AD (26998 ≅ 0.91%)</text><path d=\"M 575 290\nL 429.27 254.46\nA 150 150 3.28 0 1 431.55 246.17\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(243,219,219)\"/><path d=\"M 431 251\nL 416 215\nM 416 215\nL 401 215\" style=\"stroke-width:1;stroke:rgb(243,219,219);fill:none\"/><text x=\"282\" y=\"220\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AE (26948 ≅ 0.91%)</text><path d=\"M 575 290\nL 431.55 246.17\nA 150 150 3.18 0 1 434.19 238.29\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(221,233,237)\"/><path d=\"M 433 243\nL 419 199\nM 419 199\nL 404 199\" style=\"stroke-width:1;stroke:rgb(221,233,237);fill:none\"/><text x=\"286\" y=\"204\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AF (26054 ≅ 0.88%)</text><path d=\"M 575 290\nL 434.19 238.29\nA 150 150 3.14 0 1 437.24 230.64\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(157,185,172)\"/><path d=\"M 436 235\nL 422 183\nM 422 183\nL 407 183\" style=\"stroke-width:1;stroke:rgb(157,185,172);fill:none\"/><text x=\"287\" y=\"188\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AG (25804 ≅ 0.87%)</text><path d=\"M 575 290\nL 437.24 230.64\nA 150 150 3.14 0 1 440.7 223.2\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(246,220,210)\"/><path d=\"M 439 227\nL 426 167\nM 426 167\nL 411 167\" style=\"stroke-width:1;stroke:rgb(246,220,210);fill:none\"/><text x=\"290\" y=\"172\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AH (25730 ≅ 0.87%)</text><path d=\"M 575 290\nL 440.7 223.2\nA 150 150 2.98 0 1 444.35 216.31\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(200,195,202)\"/><path d=\"M 443 220\nL 430 151\nM 430 151\nL 415 151\" style=\"stroke-width:1;stroke:rgb(200,195,202);fill:none\"/><text x=\"300\" y=\"156\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AI (24438 ≅ 0.82%)</text><path d=\"M 575 290\nL 444.35 216.31\nA 150 150 2.90 0 1 448.24 209.8\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(246,233,243)\"/><path d=\"M 447 214\nL 434 135\nM 434 135\nL 419 135\" style=\"stroke-width:1;stroke:rgb(246,233,243);fill:none\"/><text x=\"308\" y=\"140\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AJ (23782 ≅ 0.8%)</text><path d=\"M 575 290\nL 448.24 209.8\nA 150 150 2.79 0 1 452.3 203.72\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(123,142,198)\"/><path d=\"M 451 207\nL 438 119\nM 438 119\nL 423 119\" style=\"stroke-width:1;stroke:rgb(123,142,198);fill:none\"/><text x=\"303\" y=\"124\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AK (22896 ≅ 0.77%)</text><path d=\"M 575 290\nL 452.3 203.72\nA 150 150 2.61 0 1 456.35 198.23\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(171,207,154)\"/><path d=\"M 455 201\nL 443 103\nM 443 103\nL 428 103\" style=\"stroke-width:1;stroke:rgb(171,207,154);fill:none\"/><text x=\"310\" y=\"108\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AL (21404 ≅ 0.72%)</text><path d=\"M 575 290\nL 456.35 198.23\nA 150 150 52.28 0 1 575 140\nL 575 290\nZ\" style=\"stroke:none;fill:rgb(244,210,134)\"/><path d=\"M 509 156\nL 503 87\nM 503 87\nL 488 87\" style=\"stroke-width:1;stroke:rgb(244,210,134);fill:none\"/><text x=\"351\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">AM (428978 ≅ 14.52%)</text></svg>",
 			pngCRC: 0xcf524a67,
 		},
 		{
@@ -283,7 +283,7 @@ func TestPieChart(t *testing.T) {
 					}),
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 217 23\nL 247 23\nL 247 36\nL 217 36\nL 217 23\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"249\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 280 23\nL 310 23\nL 310 36\nL 280 36\nL 280 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"312\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 342 23\nL 372 23\nL 372 36\nL 342 36\nL 342 23\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"374\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 300 218\nL 300 88\nA 130 130 159.66 0 1 345 340\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 427 196\nL 442 193\nM 442 193\nL 457 193\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"460\" y=\"198\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ValueFormatter: 1048</text><path d=\"M 300 218\nL 345 340\nA 130 130 111.98 0 1 170 214\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 225 323\nL 216 335\nM 216 335\nL 201 335\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"80\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ValueFormatter: 735</text><path d=\"M 300 218\nL 170 214\nA 130 130 88.36 0 1 300 88\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 210 126\nL 200 115\nM 200 115\nL 185 115\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"64\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ValueFormatter: 580</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 217 23\nL 247 23\nL 247 36\nL 217 36\nL 217 23\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"249\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 280 23\nL 310 23\nL 310 36\nL 280 36\nL 280 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"312\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 342 23\nL 372 23\nL 372 36\nL 342 36\nL 342 23\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"374\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 300 218\nL 300 88.4\nA 129.6 129.6 159.66 0 1 345.04 339.52\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 427 196\nL 442 193\nM 442 193\nL 457 193\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"460\" y=\"198\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ValueFormatter: 1048</text><path d=\"M 300 218\nL 345.04 339.52\nA 129.6 129.6 111.98 0 1 170.45 214.3\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 225 323\nL 216 335\nM 216 335\nL 201 335\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"80\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ValueFormatter: 735</text><path d=\"M 300 218\nL 170.45 214.3\nA 129.6 129.6 88.36 0 1 300 88.4\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 210 126\nL 200 115\nM 200 115\nL 185 115\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"64\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">ValueFormatter: 580</text></svg>",
 			pngCRC: 0x3030453f,
 		},
 		{
@@ -306,7 +306,7 @@ func TestPieChart(t *testing.T) {
 					}),
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 217 23\nL 247 23\nL 247 36\nL 217 36\nL 217 23\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"249\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 280 23\nL 310 23\nL 310 36\nL 280 36\nL 280 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"312\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 342 23\nL 372 23\nL 372 36\nL 342 36\nL 342 23\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"374\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 300 218\nL 300 88\nA 130 130 159.66 0 1 345 340\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 427 196\nL 442 193\nM 442 193\nL 457 193\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"460\" y=\"198\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">LabelFormatter: A=1048</text><path d=\"M 300 218\nL 345 340\nA 130 130 111.98 0 1 170 214\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 225 323\nL 216 335\nM 216 335\nL 201 335\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"66\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">LabelFormatter: B=735</text><path d=\"M 300 218\nL 170 214\nA 130 130 88.36 0 1 300 88\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 210 126\nL 200 115\nM 200 115\nL 185 115\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"50\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">LabelFormatter: C=580</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 217 23\nL 247 23\nL 247 36\nL 217 36\nL 217 23\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"249\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 280 23\nL 310 23\nL 310 36\nL 280 36\nL 280 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"312\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 342 23\nL 372 23\nL 372 36\nL 342 36\nL 342 23\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"374\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 300 218\nL 300 88.4\nA 129.6 129.6 159.66 0 1 345.04 339.52\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 427 196\nL 442 193\nM 442 193\nL 457 193\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"460\" y=\"198\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">LabelFormatter: A=1048</text><path d=\"M 300 218\nL 345.04 339.52\nA 129.6 129.6 111.98 0 1 170.45 214.3\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 225 323\nL 216 335\nM 216 335\nL 201 335\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"66\" y=\"340\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">LabelFormatter: B=735</text><path d=\"M 300 218\nL 170.45 214.3\nA 129.6 129.6 88.36 0 1 300 88.4\nL 300 218\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 210 126\nL 200 115\nM 200 115\nL 185 115\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"50\" y=\"120\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">LabelFormatter: C=580</text></svg>",
 			pngCRC: 0x3603a38e,
 		},
 		{
@@ -319,7 +319,7 @@ func TestPieChart(t *testing.T) {
 				opt.Title.FontStyle = customFont
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"295\" y=\"46\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"296\" y=\"52\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 40 49\nL 70 49\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"55\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"72\" y=\"55\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 40 69\nL 70 69\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"55\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"72\" y=\"75\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 40 89\nL 70 89\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"55\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"72\" y=\"95\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 40 109\nL 70 109\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"55\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"72\" y=\"115\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 40 129\nL 70 129\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"55\" cy=\"129\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"72\" y=\"135\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 213\nL 300 96\nA 117 117 119.89 0 1 402 271\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 401 155\nL 414 147\nM 414 147\nL 429 147\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"432\" y=\"149\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 213\nL 402 271\nA 117 117 84.08 0 1 252 320\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 336 324\nL 341 338\nM 341 338\nL 356 338\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"359\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 213\nL 252 320\nA 117 117 66.35 0 1 183 212\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 202 276\nL 189 284\nM 189 284\nL 174 284\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"73\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 213\nL 183 212\nA 117 117 55.37 0 1 234 116\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 197 158\nL 184 151\nM 184 151\nL 169 151\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"68\" y=\"156\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 213\nL 234 116\nA 117 117 34.32 0 1 300 96\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 266 102\nL 261 87\nM 261 87\nL 246 87\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"154\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"295\" y=\"46\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"296\" y=\"52\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 40 49\nL 70 49\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"55\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"72\" y=\"55\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 40 69\nL 70 69\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"55\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"72\" y=\"75\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 40 89\nL 70 89\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"55\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"72\" y=\"95\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 40 109\nL 70 109\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"55\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"72\" y=\"115\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 40 129\nL 70 129\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"55\" cy=\"129\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"72\" y=\"135\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 213\nL 300 95.8\nA 117.2 117.2 119.89 0 1 401.61 271.4\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 401 155\nL 414 147\nM 414 147\nL 429 147\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"432\" y=\"149\" style=\"stroke:none;fill:blue;font-size:5.1px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 213\nL 401.61 271.4\nA 117.2 117.2 84.08 0 1 252.39 320.1\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 336 324\nL 341 338\nM 341 338\nL 356 338\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"359\" y=\"343\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 213\nL 252.39 320.1\nA 117.2 117.2 66.35 0 1 182.8 212.36\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 202 276\nL 189 284\nM 189 284\nL 174 284\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"73\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 213\nL 182.8 212.36\nA 117.2 117.2 55.37 0 1 233.92 116.2\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 197 158\nL 184 151\nM 184 151\nL 169 151\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"68\" y=\"156\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 213\nL 233.92 116.2\nA 117.2 117.2 34.32 0 1 300 95.8\nL 300 213\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 266 102\nL 261 87\nM 261 87\nL 246 87\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"154\" y=\"92\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
 			pngCRC: 0xc0ee21e3,
 		},
 		{
@@ -332,7 +332,7 @@ func TestPieChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"285\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"287\" y=\"72\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 469 264\nL 499 264\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"484\" cy=\"264\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"501\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 469 284\nL 499 284\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"484\" cy=\"284\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"501\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 469 304\nL 499 304\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"484\" cy=\"304\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"501\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 469 324\nL 499 324\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"484\" cy=\"324\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"501\" y=\"330\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 469 344\nL 499 344\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"484\" cy=\"344\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"501\" y=\"350\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 223\nL 300 114\nA 109 109 119.89 0 1 395 277\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 394 169\nL 407 161\nM 407 161\nL 422 161\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"425\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 223\nL 395 277\nA 109 109 84.08 0 1 256 323\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 333 326\nL 338 341\nM 338 341\nL 353 341\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"356\" y=\"346\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 223\nL 256 323\nA 109 109 66.35 0 1 191 222\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 209 282\nL 196 290\nM 196 290\nL 181 290\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"80\" y=\"295\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 223\nL 191 222\nA 109 109 55.37 0 1 238 133\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 204 172\nL 191 165\nM 191 165\nL 176 165\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"75\" y=\"170\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 223\nL 238 133\nA 109 109 34.32 0 1 300 114\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 268 119\nL 264 105\nM 264 105\nL 249 105\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"157\" y=\"110\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"285\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title</text><text x=\"287\" y=\"72\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sub</text><path d=\"M 469 264\nL 499 264\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:none\"/><circle cx=\"484\" cy=\"264\" r=\"5\" style=\"stroke-width:3;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><text x=\"501\" y=\"270\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-A</text><path d=\"M 469 284\nL 499 284\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:none\"/><circle cx=\"484\" cy=\"284\" r=\"5\" style=\"stroke-width:3;stroke:rgb(145,204,117);fill:rgb(145,204,117)\"/><text x=\"501\" y=\"290\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-B</text><path d=\"M 469 304\nL 499 304\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:none\"/><circle cx=\"484\" cy=\"304\" r=\"5\" style=\"stroke-width:3;stroke:rgb(250,200,88);fill:rgb(250,200,88)\"/><text x=\"501\" y=\"310\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-C</text><path d=\"M 469 324\nL 499 324\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:none\"/><circle cx=\"484\" cy=\"324\" r=\"5\" style=\"stroke-width:3;stroke:rgb(238,102,102);fill:rgb(238,102,102)\"/><text x=\"501\" y=\"330\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-D</text><path d=\"M 469 344\nL 499 344\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:none\"/><circle cx=\"484\" cy=\"344\" r=\"5\" style=\"stroke-width:3;stroke:rgb(115,192,222);fill:rgb(115,192,222)\"/><text x=\"501\" y=\"350\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Series-E</text><path d=\"M 300 223\nL 300 113.8\nA 109.2 109.2 119.89 0 1 394.68 277.41\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 394 169\nL 407 161\nM 407 161\nL 422 161\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"425\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 223\nL 394.68 277.41\nA 109.2 109.2 84.08 0 1 255.64 322.79\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 333 326\nL 338 341\nM 338 341\nL 353 341\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"356\" y=\"346\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 223\nL 255.64 322.79\nA 109.2 109.2 66.35 0 1 190.8 222.4\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 209 282\nL 196 290\nM 196 290\nL 181 290\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"80\" y=\"295\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 223\nL 190.8 222.4\nA 109.2 109.2 55.37 0 1 238.43 132.81\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 204 172\nL 191 165\nM 191 165\nL 176 165\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"75\" y=\"170\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 223\nL 238.43 132.81\nA 109.2 109.2 34.32 0 1 300 113.8\nL 300 223\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 268 119\nL 264 105\nM 264 105\nL 249 105\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"157\" y=\"110\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
 			pngCRC: 0x33f71ce5,
 		},
 		{
@@ -347,7 +347,7 @@ func TestPieChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 168\nA 32 32 119.89 0 1 328 216\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 327 184\nL 451 113\nM 451 113\nL 466 113\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"469\" y=\"118\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 200\nL 355 232\nA 64 64 84.08 0 1 274 258\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 319 260\nL 354 366\nM 354 366\nL 369 366\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"372\" y=\"371\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 200\nL 261 288\nA 96 96 66.35 0 1 204 199\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 220 252\nL 153 294\nM 153 294\nL 138 294\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"37\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 200\nL 172 199\nA 128 128 55.37 0 1 228 94\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 187 140\nL 146 118\nM 146 118\nL 131 118\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"30\" y=\"123\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 200\nL 210 68\nA 160 160 34.32 0 1 300 40\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 253 48\nL 249 33\nM 249 33\nL 234 33\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"142\" y=\"38\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 168\nA 32 32 119.89 0 1 327.74 215.94\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 327 184\nL 451 113\nM 451 113\nL 466 113\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"469\" y=\"118\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-A: 33.3%</text><path d=\"M 300 200\nL 355.49 231.89\nA 64 64 84.08 0 1 274 258.48\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 319 260\nL 354 366\nM 354 366\nL 369 366\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"372\" y=\"371\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-B: 23.35%</text><path d=\"M 300 200\nL 261.01 287.72\nA 96 96 66.35 0 1 204 199.47\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 220 252\nL 153 294\nM 153 294\nL 138 294\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"37\" y=\"299\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-C: 18.43%</text><path d=\"M 300 200\nL 172 199.3\nA 128 128 55.37 0 1 227.83 94.28\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 187 140\nL 146 118\nM 146 118\nL 131 118\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"30\" y=\"123\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-D: 15.37%</text><path d=\"M 300 200\nL 209.79 67.85\nA 160 160 34.32 0 1 300 40\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 253 48\nL 249 33\nM 249 33\nL 234 33\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"142\" y=\"38\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Series-E: 9.53%</text></svg>",
 			pngCRC: 0xaf1b9e0b,
 		},
 		{
@@ -363,7 +363,7 @@ func TestPieChart(t *testing.T) {
 				opt.Legend.Show = Ptr(false)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 119.89 0 1 411 264\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 411 264\nA 128 128 84.08 0 1 248 317\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 248 317\nA 128 128 66.35 0 1 172 199\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 172 199\nA 128 128 55.37 0 1 228 94\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 228 94\nA 128 128 34.32 0 1 300 72\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(115,192,222)\"/></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 119.89 0 1 410.98 263.78\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(84,112,198)\"/><path d=\"M 300 200\nL 410.98 263.78\nA 128 128 84.08 0 1 248.01 316.96\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 248.01 316.96\nA 128 128 66.35 0 1 172 199.3\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(250,200,88)\"/><path d=\"M 300 200\nL 172 199.3\nA 128 128 55.37 0 1 227.83 94.28\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 227.83 94.28\nA 128 128 34.32 0 1 300 72\nL 300 200\nZ\" style=\"stroke-width:4;stroke:white;fill:rgb(115,192,222)\"/></svg>",
 			pngCRC: 0xde32ca61,
 		},
 		{
@@ -404,7 +404,7 @@ func TestPieChart(t *testing.T) {
 					},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 56\nA 144 144 119.89 0 1 425 272\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 424 128\nL 437 121\nM 437 121\nL 452 121\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"455\" y=\"126\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Visible: 1048</text><path d=\"M 300 200\nL 425 272\nA 144 144 84.08 0 1 242 332\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 242 332\nA 144 144 66.35 0 1 156 199\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 180 278\nL 167 286\nM 167 286\nL 152 286\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><path d=\"M 100 270\nL 164 270\nL 164 270\nA 4 4 90.00 0 1 168 274\nL 168 291\nL 168 291\nA 4 4 90.00 0 1 164 295\nL 100 295\nL 100 295\nA 4 4 90.00 0 1 96 291\nL 96 274\nL 96 274\nA 4 4 90.00 0 1 100 270\nZ\" style=\"stroke:none;fill:purple\"/><text x=\"100\" y=\"291\" style=\"stroke:none;fill:white;font-size:16.6px;font-family:'Roboto Medium',sans-serif\">📊 Styled</text><path d=\"M 300 200\nL 156 199\nA 144 144 55.37 0 1 219 81\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 219 81\nA 144 144 34.32 0 1 300 56\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 258 63\nL 254 49\nM 254 49\nL 239 49\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"178\" y=\"54\" style=\"stroke:none;fill:green;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">Custom ★</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 56\nA 144 144 119.89 0 1 424.85 271.75\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 424 128\nL 437 121\nM 437 121\nL 452 121\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"455\" y=\"126\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Visible: 1048</text><path d=\"M 300 200\nL 424.85 271.75\nA 144 144 84.08 0 1 241.51 331.59\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 300 200\nL 241.51 331.59\nA 144 144 66.35 0 1 156 199.21\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 180 278\nL 167 286\nM 167 286\nL 152 286\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><path d=\"M 100 270\nL 164 270\nL 164 270\nA 4 4 90.00 0 1 168 274\nL 168 291\nL 168 291\nA 4 4 90.00 0 1 164 295\nL 100 295\nL 100 295\nA 4 4 90.00 0 1 96 291\nL 96 274\nL 96 274\nA 4 4 90.00 0 1 100 270\nZ\" style=\"stroke:none;fill:purple\"/><text x=\"100\" y=\"291\" style=\"stroke:none;fill:white;font-size:16.6px;font-family:'Roboto Medium',sans-serif\">📊 Styled</text><path d=\"M 300 200\nL 156 199.21\nA 144 144 55.37 0 1 218.81 81.07\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 300 200\nL 218.81 81.07\nA 144 144 34.32 0 1 300 56\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 258 63\nL 254 49\nM 254 49\nL 239 49\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"178\" y=\"54\" style=\"stroke:none;fill:green;font-size:17.9px;font-family:'Roboto Medium',sans-serif\">Custom ★</text></svg>",
 			pngCRC: 0x95d1ed60,
 		},
 		{
@@ -434,7 +434,7 @@ func TestPieChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 80.00 0 1 426 178\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 382 102\nL 391 91\nM 391 91\nL 406 91\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 410 79\nL 476 79\nL 476 79\nA 5 5 90.00 0 1 481 84\nL 481 95\nL 481 95\nA 5 5 90.00 0 1 476 100\nL 410 100\nL 410 100\nA 5 5 90.00 0 1 405 95\nL 405 84\nL 405 84\nA 5 5 90.00 0 1 410 79\nZ\" style=\"stroke-width:2;stroke:red;fill:blue\"/><text x=\"409\" y=\"96\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">background</text><path d=\"M 300 200\nL 426 178\nA 128 128 160.00 0 1 189 264\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 343 320\nL 348 334\nM 348 334\nL 363 334\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 362 322\nL 437 322\nL 437 343\nL 362 343\nL 362 322\" style=\"stroke-width:1.5;stroke:green;fill:none\"/><text x=\"366\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">transparent</text><path d=\"M 300 200\nL 189 264\nA 128 128 120.00 0 1 300 72\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 190 137\nL 177 75\nM 177 75\nL 162 75\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"110\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 33.33%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><path d=\"M 300 200\nL 300 72\nA 128 128 80.00 0 1 426.06 177.77\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 382 102\nL 391 91\nM 391 91\nL 406 91\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 410 79\nL 476 79\nL 476 79\nA 5 5 90.00 0 1 481 84\nL 481 95\nL 481 95\nA 5 5 90.00 0 1 476 100\nL 410 100\nL 410 100\nA 5 5 90.00 0 1 405 95\nL 405 84\nL 405 84\nA 5 5 90.00 0 1 410 79\nZ\" style=\"stroke-width:2;stroke:red;fill:blue\"/><text x=\"409\" y=\"96\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">background</text><path d=\"M 300 200\nL 426.06 177.77\nA 128 128 160.00 0 1 189.15 264\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 343 320\nL 348 334\nM 348 334\nL 363 334\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 362 322\nL 437 322\nL 437 343\nL 362 343\nL 362 322\" style=\"stroke-width:1.5;stroke:green;fill:none\"/><text x=\"366\" y=\"339\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">transparent</text><path d=\"M 300 200\nL 189.15 264\nA 128 128 120.00 0 1 300 72\nL 300 200\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 190 137\nL 177 75\nM 177 75\nL 162 75\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"110\" y=\"80\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 33.33%</text></svg>",
 			pngCRC: 0x4c6d0d53,
 		},
 		{
@@ -453,7 +453,7 @@ func TestPieChart(t *testing.T) {
 					},
 				}
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Test title very long text - my pie chart</text><path d=\"M 226 43\nL 256 43\nL 256 56\nL 226 56\nL 226 43\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"258\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Used</text><path d=\"M 313 43\nL 343 43\nL 343 56\nL 313 56\nL 313 43\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"345\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Free</text><path d=\"M 300 225\nL 300 101\nA 124 124 230.40 1 1 205 304\nL 300 225\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 411 277\nL 425 284\nM 425 284\nL 440 284\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"443\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Used: 64%</text><path d=\"M 300 225\nL 205 304\nA 124 124 129.60 0 1 300 101\nL 300 225\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 189 173\nL 175 166\nM 175 166\nL 160 166\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"101\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Free: 36%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Test title very long text - my pie chart</text><path d=\"M 226 43\nL 256 43\nL 256 56\nL 226 56\nL 226 43\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"258\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Used</text><path d=\"M 313 43\nL 343 43\nL 343 56\nL 313 56\nL 313 43\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"345\" y=\"55\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Free</text><path d=\"M 300 225\nL 300 101.4\nA 123.6 123.6 230.40 1 1 204.76 303.79\nL 300 225\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 411 277\nL 425 284\nM 425 284\nL 440 284\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"443\" y=\"289\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Used: 64%</text><path d=\"M 300 225\nL 204.76 303.79\nA 123.6 123.6 129.60 0 1 300 101.4\nL 300 225\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 189 173\nL 175 166\nM 175 166\nL 160 166\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"101\" y=\"171\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Free: 36%</text></svg>",
 			pngCRC: 0xbafdc837,
 		},
 		{
@@ -470,7 +470,7 @@ func TestPieChart(t *testing.T) {
 				}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"268\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Pie Chart</text><text x=\"257\" y=\"72\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title Subtext</text><path d=\"M 40 75\nL 70 75\nL 70 88\nL 40 88\nL 40 75\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"72\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path d=\"M 191 75\nL 221 75\nL 221 88\nL 191 88\nL 191 75\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"223\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 284 75\nL 314 75\nL 314 88\nL 284 88\nL 284 75\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"316\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 375 75\nL 405 75\nL 405 88\nL 375 88\nL 375 75\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"407\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 40 91\nL 70 91\nL 70 104\nL 40 104\nL 40 91\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"72\" y=\"103\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 300 239\nL 300 143\nA 96 96 119.89 0 1 384 287\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 383 191\nL 396 184\nM 396 184\nL 411 184\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"414\" y=\"189\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Search Engine: 33.3%</text><path d=\"M 300 239\nL 384 287\nA 96 96 84.08 0 1 261 327\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 329 330\nL 334 344\nM 334 344\nL 349 344\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"352\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Direct: 23.35%</text><path d=\"M 300 239\nL 261 327\nA 96 96 66.35 0 1 204 238\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 220 291\nL 207 299\nM 207 299\nL 192 299\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"108\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Email: 18.43%</text><path d=\"M 300 239\nL 204 238\nA 96 96 55.37 0 1 246 159\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 215 194\nL 202 187\nM 202 187\nL 187 187\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"76\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Union Ads: 15.37%</text><path d=\"M 300 239\nL 246 159\nA 96 96 34.32 0 1 300 143\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 272 147\nL 268 133\nM 268 133\nL 253 133\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"150\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Video Ads: 9.53%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"268\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Pie Chart</text><text x=\"257\" y=\"72\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Title Subtext</text><path d=\"M 40 75\nL 70 75\nL 70 88\nL 40 88\nL 40 75\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"72\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path d=\"M 191 75\nL 221 75\nL 221 88\nL 191 88\nL 191 75\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"223\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 284 75\nL 314 75\nL 314 88\nL 284 88\nL 284 75\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"316\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 375 75\nL 405 75\nL 405 88\nL 375 88\nL 375 75\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"407\" y=\"87\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path d=\"M 40 91\nL 70 91\nL 70 104\nL 40 104\nL 40 91\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"72\" y=\"103\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path d=\"M 300 239\nL 300 142.6\nA 96.4 96.4 119.89 0 1 383.58 287.03\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 383 191\nL 396 184\nM 396 184\nL 411 184\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"414\" y=\"189\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Search Engine: 33.3%</text><path d=\"M 300 239\nL 383.58 287.03\nA 96.4 96.4 84.08 0 1 260.84 327.09\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 329 330\nL 334 344\nM 334 344\nL 349 344\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"352\" y=\"349\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Direct: 23.35%</text><path d=\"M 300 239\nL 260.84 327.09\nA 96.4 96.4 66.35 0 1 203.6 238.47\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 220 291\nL 207 299\nM 207 299\nL 192 299\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"108\" y=\"304\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Email: 18.43%</text><path d=\"M 300 239\nL 203.6 238.47\nA 96.4 96.4 55.37 0 1 245.65 159.38\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 215 194\nL 202 187\nM 202 187\nL 187 187\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"76\" y=\"192\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Union Ads: 15.37%</text><path d=\"M 300 239\nL 245.65 159.38\nA 96.4 96.4 34.32 0 1 300 142.6\nL 300 239\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 272 147\nL 268 133\nM 268 133\nL 253 133\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"150\" y=\"138\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Video Ads: 9.53%</text></svg>",
 			pngCRC: 0xd2c9c46b,
 		},
 		{
@@ -485,7 +485,7 @@ func TestPieChart(t *testing.T) {
 				opt.Legend.Vertical = Ptr(true)
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"40\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Chart Title</text><path d=\"M 40 59\nL 70 59\nL 70 72\nL 40 72\nL 40 59\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"72\" y=\"71\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search</text><path d=\"M 40 79\nL 70 79\nL 70 92\nL 40 92\nL 40 79\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"72\" y=\"91\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 40 99\nL 70 99\nL 70 112\nL 40 112\nL 40 99\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"72\" y=\"111\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 300 215\nL 300 99\nA 116 116 159.66 0 1 340 323\nL 300 215\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 413 195\nL 428 192\nM 428 192\nL 443 192\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"446\" y=\"197\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Search: 44.35%</text><path d=\"M 300 215\nL 340 323\nA 116 116 111.98 0 1 184 212\nL 300 215\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 233 308\nL 224 321\nM 224 321\nL 209 321\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"130\" y=\"326\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Direct: 31.1%</text><path d=\"M 300 215\nL 184 212\nA 116 116 88.36 0 1 300 99\nL 300 215\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 220 133\nL 209 122\nM 209 122\nL 194 122\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"110\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Email: 24.54%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"40\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Chart Title</text><path d=\"M 40 59\nL 70 59\nL 70 72\nL 40 72\nL 40 59\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"72\" y=\"71\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search</text><path d=\"M 40 79\nL 70 79\nL 70 92\nL 40 92\nL 40 79\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"72\" y=\"91\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path d=\"M 40 99\nL 70 99\nL 70 112\nL 40 112\nL 40 99\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"72\" y=\"111\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path d=\"M 300 215\nL 300 99.4\nA 115.6 115.6 159.66 0 1 340.18 323.39\nL 300 215\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 413 195\nL 428 192\nM 428 192\nL 443 192\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"446\" y=\"197\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Search: 44.35%</text><path d=\"M 300 215\nL 340.18 323.39\nA 115.6 115.6 111.98 0 1 184.45 211.7\nL 300 215\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 233 308\nL 224 321\nM 224 321\nL 209 321\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"130\" y=\"326\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Direct: 31.1%</text><path d=\"M 300 215\nL 184.45 211.7\nA 115.6 115.6 88.36 0 1 300 99.4\nL 300 215\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 220 133\nL 209 122\nM 209 122\nL 194 122\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"110\" y=\"127\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Email: 24.54%</text></svg>",
 			pngCRC: 0xe775743f,
 		},
 		{
@@ -500,7 +500,7 @@ func TestPieChart(t *testing.T) {
 				opt.Legend.Offset = OffsetStr{Top: "10"}
 				return opt
 			},
-			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"268\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Pie Chart</text><path d=\"M 156 53\nL 186 53\nL 186 66\nL 156 66\nL 156 53\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"188\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 219 53\nL 249 53\nL 249 66\nL 219 66\nL 219 53\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"251\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 281 53\nL 311 53\nL 311 66\nL 281 66\nL 281 53\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"313\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 343 53\nL 373 53\nL 373 66\nL 343 66\nL 343 53\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"375\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><path d=\"M 405 53\nL 435 53\nL 435 66\nL 405 66\nL 405 53\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"437\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><path d=\"M 300 220\nL 300 108\nA 112 112 119.89 0 1 397 276\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 396 165\nL 409 157\nM 409 157\nL 424 157\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"427\" y=\"162\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">A: 33.3%</text><path d=\"M 300 220\nL 397 276\nA 112 112 84.08 0 1 255 322\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 334 326\nL 339 340\nM 339 340\nL 354 340\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"357\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">B: 23.35%</text><path d=\"M 300 220\nL 255 322\nA 112 112 66.35 0 1 188 219\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 207 280\nL 194 288\nM 194 288\nL 179 288\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"119\" y=\"293\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">C: 18.43%</text><path d=\"M 300 220\nL 188 219\nA 112 112 55.37 0 1 237 128\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 202 168\nL 189 161\nM 189 161\nL 174 161\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"114\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">D: 15.37%</text><path d=\"M 300 220\nL 237 128\nA 112 112 34.32 0 1 300 108\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 268 114\nL 263 100\nM 263 100\nL 248 100\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"196\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">E: 9.53%</text></svg>",
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"268\" y=\"56\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Pie Chart</text><path d=\"M 156 53\nL 186 53\nL 186 66\nL 156 66\nL 156 53\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"188\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><path d=\"M 219 53\nL 249 53\nL 249 66\nL 219 66\nL 219 53\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"251\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path d=\"M 281 53\nL 311 53\nL 311 66\nL 281 66\nL 281 53\" style=\"stroke:none;fill:rgb(250,200,88)\"/><text x=\"313\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path d=\"M 343 53\nL 373 53\nL 373 66\nL 343 66\nL 343 53\" style=\"stroke:none;fill:rgb(238,102,102)\"/><text x=\"375\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">D</text><path d=\"M 405 53\nL 435 53\nL 435 66\nL 405 66\nL 405 53\" style=\"stroke:none;fill:rgb(115,192,222)\"/><text x=\"437\" y=\"65\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">E</text><path d=\"M 300 220\nL 300 108.4\nA 111.6 111.6 119.89 0 1 396.76 275.61\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(84,112,198)\"/><path d=\"M 396 165\nL 409 157\nM 409 157\nL 424 157\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:none\"/><text x=\"427\" y=\"162\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">A: 33.3%</text><path d=\"M 300 220\nL 396.76 275.61\nA 111.6 111.6 84.08 0 1 254.67 321.98\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(145,204,117)\"/><path d=\"M 334 326\nL 339 340\nM 339 340\nL 354 340\" style=\"stroke-width:1;stroke:rgb(145,204,117);fill:none\"/><text x=\"357\" y=\"345\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">B: 23.35%</text><path d=\"M 300 220\nL 254.67 321.98\nA 111.6 111.6 66.35 0 1 188.4 219.39\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(250,200,88)\"/><path d=\"M 207 280\nL 194 288\nM 194 288\nL 179 288\" style=\"stroke-width:1;stroke:rgb(250,200,88);fill:none\"/><text x=\"119\" y=\"293\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">C: 18.43%</text><path d=\"M 300 220\nL 188.4 219.39\nA 111.6 111.6 55.37 0 1 237.08 127.83\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(238,102,102)\"/><path d=\"M 202 168\nL 189 161\nM 189 161\nL 174 161\" style=\"stroke-width:1;stroke:rgb(238,102,102);fill:none\"/><text x=\"114\" y=\"166\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">D: 15.37%</text><path d=\"M 300 220\nL 237.08 127.83\nA 111.6 111.6 34.32 0 1 300 108.4\nL 300 220\nZ\" style=\"stroke:none;fill:rgb(115,192,222)\"/><path d=\"M 268 114\nL 263 100\nM 263 100\nL 248 100\" style=\"stroke-width:1;stroke:rgb(115,192,222);fill:none\"/><text x=\"196\" y=\"105\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">E: 9.53%</text></svg>",
 			pngCRC: 0x2d186954,
 		},
 	}
@@ -2,6 +2,7 @@ package charts
 
 import (
 	"errors"
+	"math"
 
 	"github.com/dustin/go-humanize"
 	"github.com/golang/freetype/truetype"
@@ -26,8 +27,26 @@ type RadarIndicator struct {
 	Min float64
 	// FontStyle provides the font configuration for the indicator.
 	FontStyle FontStyle
+	// AxisLabelShow overrides the chart AxisLabelShow for this indicator.
+	AxisLabelShow *bool
+	// ValueFormatter defines how the axis label values of this indicator are rendered to strings.
+	ValueFormatter ValueFormatter
 }
 
+const (
+	// RadarShapePolygon draws the radar grid as polygons connecting the indicator axes.
+	RadarShapePolygon = "polygon"
+	// RadarShapeCircle draws the radar grid as circles.
+	RadarShapeCircle = "circle"
+)
+
+const (
+	radarDivideCount        = 5
+	radarDefaultFillOpacity = 20
+	radarCircleSegments     = 72
+	radarAxisLabelOffset    = 3
+)
+
 // NewRadarChartOptionWithData returns an initialized RadarChartOption with the SeriesList set with the provided data slice.
 func NewRadarChartOptionWithData(data [][]float64, names []string, values []float64) RadarChartOption {
 	return RadarChartOption{
@@ -59,6 +78,24 @@ type RadarChartOption struct {
 	Radius string
 	// ValueFormatter defines how float values are rendered to strings, notably for series labels.
 	ValueFormatter ValueFormatter
+	// Shape specifies the grid shape, either RadarShapePolygon (default) or RadarShapeCircle.
+	Shape string
+	// SplitAreaShow when set to *true shades the areas between the grid lines with alternating colors.
+	SplitAreaShow *bool
+	// SplitAreaColors provides the colors alternated between the grid areas when SplitAreaShow is enabled. Default
+	// alternates a light shade of the theme split line color with transparent.
+	SplitAreaColors []Color
+	// AxisLabelShow when set to *true draws the value scale of each indicator along its axis. Can be overridden per
+	// indicator.
+	AxisLabelShow *bool
+	// AxisLabelFontStyle specifies the font configuration for the indicator axis labels.
+	AxisLabelFontStyle FontStyle
+	// Symbol specifies the symbol drawn at each series vertex. Default is SymbolCircle, use SymbolNone to hide.
+	Symbol Symbol
+	// FillArea when set to *false disables filling the area of each series.
+	FillArea *bool
+	// FillOpacity is the opacity/alpha (0-255) of the series area fill. Default is 20.
+	FillOpacity uint8
 }
 
 // NewRadarIndicators returns a radar indicator list.
@@ -76,6 +113,15 @@ func NewRadarIndicators(names []string, values []float64) []RadarIndicator {
 	return indicators
 }
 
+// radarIndicatorNames returns the name of each indicator.
+func radarIndicatorNames(indicators []RadarIndicator) []string {
+	names := make([]string, len(indicators))
+	for i, indicator := range indicators {
+		names[i] = indicator.Name
+	}
+	return names
+}
+
 // newRadarChart returns a radar chart renderer.
 func newRadarChart(p *Painter, opt RadarChartOption) *radarChart {
 	return &radarChart{
@@ -113,20 +159,41 @@ func (r *radarChart) renderChart(result *defaultRenderResult) (Box, error) {
 	cx, cy, diameter := circleChartPosition(seriesPainter)
 	radius := getFlexibleRadius(diameter, defaultPieRadiusFactor, opt.Radius)
 
-	divideCount := 5
-	divideRadius := float64(int(radius / float64(divideCount)))
-	radius = divideRadius * float64(divideCount)
+	divideRadius := float64(int(radius / float64(radarDivideCount)))
+	radius = divideRadius * float64(radarDivideCount)
 
 	center := Point{X: cx, Y: cy}
-	for i := 0; i < divideCount; i++ {
-		seriesPainter.Polygon(center, divideRadius*float64(i+1), sides, theme.GetAxisSplitLineColor(), 1)
+	centerF := PointF{X: float64(cx), Y: float64(cy)}
+	circleGrid := opt.Shape == RadarShapeCircle
+	if flagIs(true, opt.SplitAreaShow) {
+		colors := opt.SplitAreaColors
+		if len(colors) == 0 {
+			colors = []Color{theme.GetAxisSplitLineColor().WithAlpha(60), ColorTransparent}
+		}
+		var inner []PointF
+		for i := 0; i < radarDivideCount; i++ {
+			outer := radarGridPoints(centerF, divideRadius*float64(i+1), sides, circleGrid)
+			if color := colors[i%len(colors)]; !color.IsTransparent() {
+				seriesPainter.FillAreaF(radarRingPoints(outer, inner), color)
+			}
+			inner = outer
+		}
 	}
-	points := getPolygonPoints(center, radius, sides)
-	for _, p := range points {
-		seriesPainter.moveTo(center.X, center.Y)
-		seriesPainter.lineTo(p.X, p.Y)
+	for i := 0; i < radarDivideCount; i++ {
+		if circleGrid {
+			seriesPainter.CircleF(divideRadius*float64(i+1), centerF.X, centerF.Y,
+				ColorTransparent, theme.GetAxisSplitLineColor(), 1)
+		} else {
+			gridPoints := radarGridPoints(centerF, divideRadius*float64(i+1), sides, false)
+			seriesPainter.LineStrokeF(append(gridPoints, gridPoints[0]), theme.GetAxisSplitLineColor(), 1)
+		}
+	}
+	for _, p := range radarGridPoints(centerF, radius, sides, false) {
+		seriesPainter.moveToF(centerF.X, centerF.Y)
+		seriesPainter.lineToF(p.X, p.Y)
 		seriesPainter.stroke(theme.GetAxisSplitLineColor(), 1)
 	}
+	points := getPolygonPoints(center, radius, sides)
 
 	offset := 5
 	// text generation
@@ -169,13 +236,17 @@ func (r *radarChart) renderChart(result *defaultRenderResult) (Box, error) {
 		seriesPainter.Text(name, x, y, 0, fontStyle)
 	}
 
-	// radar chart
 	angles := getPolygonPointAngles(sides)
+	r.renderAxisLabels(seriesPainter, centerF, divideRadius, angles)
+
+	// radar chart
+	seriesNames := opt.SeriesList.names()
+	var rendererList []renderer
 	maxCount := len(indicators)
 	for index, series := range opt.SeriesList {
 		valueFormatter := getPreferredValueFormatter(series.Label.ValueFormatter, opt.ValueFormatter,
 			radarDefaultValueFormatter)
-		linePoints := make([]Point, 0, maxCount)
+		linePoints := make([]PointF, 0, maxCount)
 		for j, item := range series.Values {
 			if j >= maxCount {
 				continue
@@ -187,7 +258,7 @@ func (r *radarChart) renderChart(result *defaultRenderResult) (Box, error) {
 				percent = (item - indicator.Min) / offset
 			}
 			r := percent * radius
-			p := getPolygonPoint(center, r, angles[j])
+			p := getPolygonPointF(centerF, r, angles[j])
 			linePoints = append(linePoints, p)
 		}
 		color := theme.GetSeriesColor(index)
@@ -196,30 +267,146 @@ func (r *radarChart) renderChart(result *defaultRenderResult) (Box, error) {
 			dotFillColor = color
 		}
 		linePoints = append(linePoints, linePoints[0])
-		seriesPainter.LineStroke(linePoints, color, defaultStrokeWidth)
-		seriesPainter.FillArea(linePoints, color.WithAlpha(20))
-		dotWith := defaultDotWidth
+		seriesPainter.startSeriesGroup(series.Name)
+		seriesPainter.LineStrokeF(linePoints, color, defaultStrokeWidth)
+		fillArea := !flagIs(false, opt.FillArea)
+		if series.FillArea != nil {
+			fillArea = *series.FillArea
+		}
+		if fillArea {
+			fillOpacity := uint8(radarDefaultFillOpacity)
+			if series.FillOpacity > 0 {
+				fillOpacity = series.FillOpacity
+			} else if opt.FillOpacity > 0 {
+				fillOpacity = opt.FillOpacity
+			}
+			seriesPainter.FillAreaF(linePoints, color.WithAlpha(fillOpacity))
+		}
+		symbol := opt.Symbol
+		if series.Symbol != "" {
+			symbol = series.Symbol
+		}
+		var labelPainter *seriesLabelPainter
+		if flagIs(true, series.Label.Show) {
+			label := series.Label
+			label.ValueFormatter = valueFormatter
+			labelPainter = newSeriesLabelPainter(seriesPainter, seriesNames, label, theme, 0)
+			rendererList = append(rendererList, labelPainter)
+		}
 		for index, point := range linePoints {
-			seriesPainter.Circle(dotWith, point.X, point.Y, dotFillColor, color, defaultStrokeWidth)
-			if flagIs(true, series.Label.Show) && index < len(series.Values) {
-				fontStyle := fillFontStyleDefaults(series.Label.FontStyle,
-					defaultLabelFontSize, theme.GetLabelTextColor(), opt.Font, seriesPainter.font)
-				valueStr := valueFormatter(series.Values[index])
-				b := seriesPainter.MeasureText(valueStr, 0, fontStyle)
-				seriesPainter.Text(valueStr, point.X-b.Width()/2, point.Y, 0, fontStyle)
+			isValue := index < len(series.Values) && index < maxCount
+			if isValue {
+				seriesPainter.startValueGroup(series.Name, indicators[index].Name, series.Values[index], valueFormatter)
+			}
+			drawRadarSymbol(seriesPainter, symbol, point, dotFillColor, color)
+			if labelPainter != nil && isValue {
+				labelPainter.Add(labelValue{
+					index:     index,
+					value:     series.Values[index],
+					x:         int(point.X),
+					y:         int(point.Y),
+					vertical:  true,
+					fontStyle: FontStyle{Font: getPreferredFont(opt.Font, seriesPainter.font)},
+				})
+			}
+			if isValue {
+				seriesPainter.endGroup()
 			}
 		}
+		seriesPainter.endGroup()
+	}
+	if err := doRender(rendererList...); err != nil {
+		return BoxZero, err
 	}
 
 	return r.p.box, nil
 }
 
+// renderAxisLabels draws the value scale along each indicator axis which has axis labels enabled.
+func (r *radarChart) renderAxisLabels(p *Painter, center PointF, divideRadius float64, angles []float64) {
+	opt := r.opt
+	fontStyle := fillFontStyleDefaults(opt.AxisLabelFontStyle, defaultLabelFontSize*0.8,
+		opt.Theme.GetYAxisTextColor(), opt.Font, p.font)
+	for index, indicator := range opt.RadarIndicators {
+		show := flagIs(true, opt.AxisLabelShow)
+		if indicator.AxisLabelShow != nil {
+			show = *indicator.AxisLabelShow
+		}
+		if !show {
+			continue
+		}
+		valueFormatter := getPreferredValueFormatter(indicator.ValueFormatter, opt.ValueFormatter,
+			radarDefaultValueFormatter)
+		// labels are placed beside the axis, on the clockwise side
+		normalX, normalY := -math.Sin(angles[index]), math.Cos(angles[index])
+		for i := 1; i <= radarDivideCount; i++ {
+			value := indicator.Min + (indicator.Max-indicator.Min)*float64(i)/radarDivideCount
+			text := valueFormatter(value)
+			box := p.MeasureText(text, 0, fontStyle)
+			point := getPolygonPointF(center, divideRadius*float64(i), angles[index])
+			labelX := point.X + normalX*(float64(box.Width())/2+radarAxisLabelOffset)
+			labelY := point.Y + normalY*(float64(box.Height())/2+radarAxisLabelOffset)
+			p.TextF(text, labelX-float64(box.Width())/2, labelY+float64(box.Height())/2, 0, fontStyle)
+		}
+	}
+}
+
+// radarGridPoints returns the outline of a grid line at the radius, either a polygon with a vertex on each indicator
+// axis or an approximated circle.
+func radarGridPoints(center PointF, radius float64, sides int, circle bool) []PointF {
+	if circle {
+		sides = radarCircleSegments
+	}
+	points := make([]PointF, sides)
+	for i, angle := range getPolygonPointAngles(sides) {
+		points[i] = getPolygonPointF(center, radius, angle)
+	}
+	return points
+}
+
+// radarRingPoints returns a closed path of the area between the outer and inner outlines. The inner outline is
+// traced in the opposite direction so that it's excluded from the fill.
+func radarRingPoints(outer, inner []PointF) []PointF {
+	ring := make([]PointF, 0, len(outer)+len(inner)+3)
+	ring = append(ring, outer...)
+	ring = append(ring, outer[0])
+	if len(inner) != 0 {
+		ring = append(ring, inner[0])
+		for i := len(inner) - 1; i >= 0; i-- {
+			ring = append(ring, inner[i])
+		}
+		ring = append(ring, outer[0])
+	}
+	return ring
+}
+
+// drawRadarSymbol draws the series symbol at a vertex.
+func drawRadarSymbol(p *Painter, symbol Symbol, point PointF, fillColor, color Color) {
+	switch symbol {
+	case SymbolNone:
+		return
+	case SymbolDot:
+		p.CircleF(defaultDotWidth, point.X, point.Y, color, color, defaultStrokeWidth)
+	case SymbolSquare:
+		p.squares([]PointF{point}, color, color, 1, defaultStrokeWidth*2.8)
+	case SymbolDiamond:
+		p.diamonds([]PointF{point}, color, color, 1, defaultStrokeWidth*4.0)
+	default:
+		p.CircleF(defaultDotWidth, point.X, point.Y, fillColor, color, defaultStrokeWidth)
+	}
+}
+
 func (r *radarChart) Render() (Box, error) {
 	p := r.p
 	opt := r.opt
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		var visible []int
+		visible, opt.Theme = opt.Legend.hideSeries(opt.SeriesList, opt.Theme, opt.ValueFormatter)
+		opt.SeriesList = selectSeriesIndexes(opt.SeriesList, visible)
+	}
 	if opt.Legend.Symbol == "" {
 		// default to square symbol for this chart type
 		opt.Legend.Symbol = SymbolSquare
@@ -237,8 +424,9 @@ func (r *radarChart) Render() (Box, error) {
 				Show: Ptr(false),
 			},
 		},
-		title:  opt.Title,
-		legend: &r.opt.Legend,
+		title:          opt.Title,
+		legend:         &r.opt.Legend,
+		categoryLabels: radarIndicatorNames(opt.RadarIndicators),
 	})
 	if err != nil {
 		return BoxZero, err
@@ -73,9 +73,39 @@ func TestRadarChart(t *testing.T) {
 			name:        "basic_themed",
 			themed:      true,
 			makeOptions: makeBasicRadarChartOption,
-			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"0\" y=\"16\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Basic Radar Chart</text><path d=\"M 143 3\nL 173 3\nL 173 16\nL 143 16\nL 143 3\" style=\"stroke:none;fill:rgb(255,100,100)\"/><text x=\"175\" y=\"15\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Allocated Budget</text><path d=\"M 313 3\nL 343 3\nL 343 16\nL 313 16\nL 313 3\" style=\"stroke:none;fill:rgb(255,210,100)\"/><text x=\"345\" y=\"15\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Actual Spending</text><path d=\"M 300 189\nL 325 204\nL 325 232\nL 300 247\nL 275 232\nL 275 204\nL 300 189\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 160\nL 350 189\nL 350 246\nL 300 276\nL 250 247\nL 250 190\nL 300 160\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 131\nL 375 175\nL 375 261\nL 300 305\nL 225 261\nL 225 175\nL 300 131\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 102\nL 400 160\nL 400 275\nL 300 334\nL 200 276\nL 200 161\nL 300 102\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 73\nL 425 146\nL 425 290\nL 300 363\nL 175 290\nL 175 146\nL 300 73\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 300 73\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 425 146\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 425 290\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 300 363\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 175 290\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 175 146\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><text x=\"284\" y=\"65\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sales</text><text x=\"430\" y=\"151\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Administration</text><text x=\"430\" y=\"295\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Information Technology</text><text x=\"248\" y=\"381\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Customer Support</text><text x=\"94\" y=\"295\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Development</text><text x=\"112\" y=\"151\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Marketing</text><path d=\"M 300 125\nL 323 205\nL 383 266\nL 300 351\nL 180 287\nL 210 166\nL 300 125\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:none\"/><path d=\"M 300 125\nL 323 205\nL 383 266\nL 300 351\nL 180 287\nL 210 166\nL 300 125\" style=\"stroke:none;fill:rgba(255,100,100,0.1)\"/><circle cx=\"300\" cy=\"125\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"323\" cy=\"205\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"383\" cy=\"266\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"300\" cy=\"351\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"180\" cy=\"287\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"210\" cy=\"166\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"300\" cy=\"125\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><path d=\"M 300 107\nL 409 155\nL 417 285\nL 300 317\nL 199 276\nL 195 158\nL 300 107\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:none\"/><path d=\"M 300 107\nL 409 155\nL 417 285\nL 300 317\nL 199 276\nL 195 158\nL 300 107\" style=\"stroke:none;fill:rgba(255,210,100,0.1)\"/><circle cx=\"300\" cy=\"107\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"409\" cy=\"155\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"417\" cy=\"285\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"300\" cy=\"317\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"199\" cy=\"276\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"195\" cy=\"158\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"300\" cy=\"107\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/></svg>",
+			svg:         "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke:none;fill:rgb(40,40,40)\"/><text x=\"0\" y=\"16\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Basic Radar Chart</text><path d=\"M 143 3\nL 173 3\nL 173 16\nL 143 16\nL 143 3\" style=\"stroke:none;fill:rgb(255,100,100)\"/><text x=\"175\" y=\"15\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Allocated Budget</text><path d=\"M 313 3\nL 343 3\nL 343 16\nL 313 16\nL 313 3\" style=\"stroke:none;fill:rgb(255,210,100)\"/><text x=\"345\" y=\"15\" style=\"stroke:none;fill:rgb(238,238,238);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Actual Spending</text><path d=\"M 300 189\nL 325.11 203.5\nL 325.11 232.5\nL 300 247\nL 274.89 232.5\nL 274.89 203.5\nL 300 189\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 160\nL 350.23 189\nL 350.23 247\nL 300 276\nL 249.77 247\nL 249.77 189\nL 300 160\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 131\nL 375.34 174.5\nL 375.34 261.5\nL 300 305\nL 224.66 261.5\nL 224.66 174.5\nL 300 131\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 102\nL 400.46 160\nL 400.46 276\nL 300 334\nL 199.54 276\nL 199.54 160\nL 300 102\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 73\nL 425.57 145.5\nL 425.57 290.5\nL 300 363\nL 174.43 290.5\nL 174.43 145.5\nL 300 73\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 300 73\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 425.57 145.5\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 425.57 290.5\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 300 363\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 174.43 290.5\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><path d=\"M 300 218\nL 174.43 145.5\" style=\"stroke-width:1;stroke:rgb(72,71,83);fill:none\"/><text x=\"284\" y=\"65\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sales</text><text x=\"430\" y=\"151\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Administration</text><text x=\"430\" y=\"295\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Information Technology</text><text x=\"248\" y=\"381\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Customer Support</text><text x=\"94\" y=\"295\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Development</text><text x=\"112\" y=\"151\" style=\"stroke:none;fill:rgb(238,238,238);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Marketing</text><path d=\"M 300 124.31\nL 323.55 204.41\nL 383.72 266.33\nL 300 351.55\nL 179.26 287.71\nL 209.59 165.8\nL 300 124.31\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:none\"/><path d=\"M 300 124.31\nL 323.55 204.41\nL 383.72 266.33\nL 300 351.55\nL 179.26 287.71\nL 209.59 165.8\nL 300 124.31\" style=\"stroke:none;fill:rgba(255,100,100,0.1)\"/><circle cx=\"300\" cy=\"124.31\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"323.55\" cy=\"204.41\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"383.72\" cy=\"266.33\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"300\" cy=\"351.55\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"179.26\" cy=\"287.71\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"209.59\" cy=\"165.8\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><circle cx=\"300\" cy=\"124.31\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,100,100);fill:rgb(255,100,100)\"/><path d=\"M 300 106.46\nL 409.88 154.56\nL 417.2 285.67\nL 300 317.21\nL 198.58 276.56\nL 194.52 157.1\nL 300 106.46\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:none\"/><path d=\"M 300 106.46\nL 409.88 154.56\nL 417.2 285.67\nL 300 317.21\nL 198.58 276.56\nL 194.52 157.1\nL 300 106.46\" style=\"stroke:none;fill:rgba(255,210,100,0.1)\"/><circle cx=\"300\" cy=\"106.46\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"409.88\" cy=\"154.56\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"417.2\" cy=\"285.67\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"300\" cy=\"317.21\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"198.58\" cy=\"276.56\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"194.52\" cy=\"157.1\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/><circle cx=\"300\" cy=\"106.46\" r=\"2\" style=\"stroke-width:2;stroke:rgb(255,210,100);fill:rgb(255,210,100)\"/></svg>",
 			pngCRC:      0xaa7f7dcd,
 		},
+		{
+			name: "circle_split_area",
+			makeOptions: func() RadarChartOption {
+				opt := makeBasicRadarChartOption()
+				opt.Shape = RadarShapeCircle
+				opt.SplitAreaShow = Ptr(true)
+				opt.AxisLabelShow = Ptr(true)
+				opt.RadarIndicators[1].AxisLabelShow = Ptr(false)
+				return opt
+			},
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Basic Radar Chart</text><path d=\"M 143 23\nL 173 23\nL 173 36\nL 143 36\nL 143 23\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"175\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Allocated Budget</text><path d=\"M 313 23\nL 343 23\nL 343 36\nL 313 36\nL 313 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"345\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Actual Spending</text><path d=\"M 300 193\nL 302.18 193.1\nL 304.34 193.38\nL 306.47 193.85\nL 308.55 194.51\nL 310.57 195.34\nL 312.5 196.35\nL 314.34 197.52\nL 316.07 198.85\nL 317.68 200.32\nL 319.15 201.93\nL 320.48 203.66\nL 321.65 205.5\nL 322.66 207.43\nL 323.49 209.45\nL 324.15 211.53\nL 324.62 213.66\nL 324.9 215.82\nL 325 218\nL 324.9 220.18\nL 324.62 222.34\nL 324.15 224.47\nL 323.49 226.55\nL 322.66 228.57\nL 321.65 230.5\nL 320.48 232.34\nL 319.15 234.07\nL 317.68 235.68\nL 316.07 237.15\nL 314.34 238.48\nL 312.5 239.65\nL 310.57 240.66\nL 308.55 241.49\nL 306.47 242.15\nL 304.34 242.62\nL 302.18 242.9\nL 300 243\nL 297.82 242.9\nL 295.66 242.62\nL 293.53 242.15\nL 291.45 241.49\nL 289.43 240.66\nL 287.5 239.65\nL 285.66 238.48\nL 283.93 237.15\nL 282.32 235.68\nL 280.85 234.07\nL 279.52 232.34\nL 278.35 230.5\nL 277.34 228.57\nL 276.51 226.55\nL 275.85 224.47\nL 275.38 222.34\nL 275.1 220.18\nL 275 218\nL 275.1 215.82\nL 275.38 213.66\nL 275.85 211.53\nL 276.51 209.45\nL 277.34 207.43\nL 278.35 205.5\nL 279.52 203.66\nL 280.85 201.93\nL 282.32 200.32\nL 283.93 198.85\nL 285.66 197.52\nL 287.5 196.35\nL 289.43 195.34\nL 291.45 194.51\nL 293.53 193.85\nL 295.66 193.38\nL 297.82 193.1\nL 300 193\" style=\"stroke:none;fill:rgba(224,230,242,0.2)\"/><path d=\"M 300 143\nL 306.54 143.29\nL 313.02 144.14\nL 319.41 145.56\nL 325.65 147.52\nL 331.7 150.03\nL 337.5 153.05\nL 343.02 156.56\nL 348.21 160.55\nL 353.03 164.97\nL 357.45 169.79\nL 361.44 174.98\nL 364.95 180.5\nL 367.97 186.3\nL 370.48 192.35\nL 372.44 198.59\nL 373.86 204.98\nL 374.71 211.46\nL 375 218\nL 374.71 224.54\nL 373.86 231.02\nL 372.44 237.41\nL 370.48 243.65\nL 367.97 249.7\nL 364.95 255.5\nL 361.44 261.02\nL 357.45 266.21\nL 353.03 271.03\nL 348.21 275.45\nL 343.02 279.44\nL 337.5 282.95\nL 331.7 285.97\nL 325.65 288.48\nL 319.41 290.44\nL 313.02 291.86\nL 306.54 292.71\nL 300 293\nL 293.46 292.71\nL 286.98 291.86\nL 280.59 290.44\nL 274.35 288.48\nL 268.3 285.97\nL 262.5 282.95\nL 256.98 279.44\nL 251.79 275.45\nL 246.97 271.03\nL 242.55 266.21\nL 238.56 261.02\nL 235.05 255.5\nL 232.03 249.7\nL 229.52 243.65\nL 227.56 237.41\nL 226.14 231.02\nL 225.29 224.54\nL 225 218\nL 225.29 211.46\nL 226.14 204.98\nL 227.56 198.59\nL 229.52 192.35\nL 232.03 186.3\nL 235.05 180.5\nL 238.56 174.98\nL 242.55 169.79\nL 246.97 164.97\nL 251.79 160.55\nL 256.98 156.56\nL 262.5 153.05\nL 268.3 150.03\nL 274.35 147.52\nL 280.59 145.56\nL 286.98 144.14\nL 293.46 143.29\nL 300 143\nL 300 168\nL 295.64 168.19\nL 291.32 168.76\nL 287.06 169.7\nL 282.9 171.02\nL 278.87 172.68\nL 275 174.7\nL 271.32 177.04\nL 267.86 179.7\nL 264.64 182.64\nL 261.7 185.86\nL 259.04 189.32\nL 256.7 193\nL 254.68 196.87\nL 253.02 200.9\nL 251.7 205.06\nL 250.76 209.32\nL 250.19 213.64\nL 250 218\nL 250.19 222.36\nL 250.76 226.68\nL 251.7 230.94\nL 253.02 235.1\nL 254.68 239.13\nL 256.7 243\nL 259.04 246.68\nL 261.7 250.14\nL 264.64 253.36\nL 267.86 256.3\nL 271.32 258.96\nL 275 261.3\nL 278.87 263.32\nL 282.9 264.98\nL 287.06 266.3\nL 291.32 267.24\nL 295.64 267.81\nL 300 268\nL 304.36 267.81\nL 308.68 267.24\nL 312.94 266.3\nL 317.1 264.98\nL 321.13 263.32\nL 325 261.3\nL 328.68 258.96\nL 332.14 256.3\nL 335.36 253.36\nL 338.3 250.14\nL 340.96 246.68\nL 343.3 243\nL 345.32 239.13\nL 346.98 235.1\nL 348.3 230.94\nL 349.24 226.68\nL 349.81 222.36\nL 350 218\nL 349.81 213.64\nL 349.24 209.32\nL 348.3 205.06\nL 346.98 200.9\nL 345.32 196.87\nL 343.3 193\nL 340.96 189.32\nL 338.3 185.86\nL 335.36 182.64\nL 332.14 179.7\nL 328.68 177.04\nL 325 174.7\nL 321.13 172.68\nL 317.1 171.02\nL 312.94 169.7\nL 308.68 168.76\nL 304.36 168.19\nL 300 168\nL 300 143\" style=\"stroke:none;fill:rgba(224,230,242,0.2)\"/><path d=\"M 300 93\nL 310.89 93.48\nL 321.71 94.9\nL 332.35 97.26\nL 342.75 100.54\nL 352.83 104.71\nL 362.5 109.75\nL 371.7 115.61\nL 380.35 122.24\nL 388.39 129.61\nL 395.76 137.65\nL 402.39 146.3\nL 408.25 155.5\nL 413.29 165.17\nL 417.46 175.25\nL 420.74 185.65\nL 423.1 196.29\nL 424.52 207.11\nL 425 218\nL 424.52 228.89\nL 423.1 239.71\nL 420.74 250.35\nL 417.46 260.75\nL 413.29 270.83\nL 408.25 280.5\nL 402.39 289.7\nL 395.76 298.35\nL 388.39 306.39\nL 380.35 313.76\nL 371.7 320.39\nL 362.5 326.25\nL 352.83 331.29\nL 342.75 335.46\nL 332.35 338.74\nL 321.71 341.1\nL 310.89 342.52\nL 300 343\nL 289.11 342.52\nL 278.29 341.1\nL 267.65 338.74\nL 257.25 335.46\nL 247.17 331.29\nL 237.5 326.25\nL 228.3 320.39\nL 219.65 313.76\nL 211.61 306.39\nL 204.24 298.35\nL 197.61 289.7\nL 191.75 280.5\nL 186.71 270.83\nL 182.54 260.75\nL 179.26 250.35\nL 176.9 239.71\nL 175.48 228.89\nL 175 218\nL 175.48 207.11\nL 176.9 196.29\nL 179.26 185.65\nL 182.54 175.25\nL 186.71 165.17\nL 191.75 155.5\nL 197.61 146.3\nL 204.24 137.65\nL 211.61 129.61\nL 219.65 122.24\nL 228.3 115.61\nL 237.5 109.75\nL 247.17 104.71\nL 257.25 100.54\nL 267.65 97.26\nL 278.29 94.9\nL 289.11 93.48\nL 300 93\nL 300 118\nL 291.28 118.38\nL 282.64 119.52\nL 274.12 121.41\nL 265.8 124.03\nL 257.74 127.37\nL 250 131.4\nL 242.64 136.08\nL 235.72 141.4\nL 229.29 147.29\nL 223.4 153.72\nL 218.08 160.64\nL 213.4 168\nL 209.37 175.74\nL 206.03 183.8\nL 203.41 192.12\nL 201.52 200.64\nL 200.38 209.28\nL 200 218\nL 200.38 226.72\nL 201.52 235.36\nL 203.41 243.88\nL 206.03 252.2\nL 209.37 260.26\nL 213.4 268\nL 218.08 275.36\nL 223.4 282.28\nL 229.29 288.71\nL 235.72 294.6\nL 242.64 299.92\nL 250 304.6\nL 257.74 308.63\nL 265.8 311.97\nL 274.12 314.59\nL 282.64 316.48\nL 291.28 317.62\nL 300 318\nL 308.72 317.62\nL 317.36 316.48\nL 325.88 314.59\nL 334.2 311.97\nL 342.26 308.63\nL 350 304.6\nL 357.36 299.92\nL 364.28 294.6\nL 370.71 288.71\nL 376.6 282.28\nL 381.92 275.36\nL 386.6 268\nL 390.63 260.26\nL 393.97 252.2\nL 396.59 243.88\nL 398.48 235.36\nL 399.62 226.72\nL 400 218\nL 399.62 209.28\nL 398.48 200.64\nL 396.59 192.12\nL 393.97 183.8\nL 390.63 175.74\nL 386.6 168\nL 381.92 160.64\nL 376.6 153.72\nL 370.71 147.29\nL 364.28 141.4\nL 357.36 136.08\nL 350 131.4\nL 342.26 127.37\nL 334.2 124.03\nL 325.88 121.41\nL 317.36 119.52\nL 308.72 118.38\nL 300 118\nL 300 93\" style=\"stroke:none;fill:rgba(224,230,242,0.2)\"/><circle cx=\"300\" cy=\"218\" r=\"25\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><circle cx=\"300\" cy=\"218\" r=\"50\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><circle cx=\"300\" cy=\"218\" r=\"75\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><circle cx=\"300\" cy=\"218\" r=\"100\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><circle cx=\"300\" cy=\"218\" r=\"125\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 300 93\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 408.25 155.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 408.25 280.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 300 343\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 191.75 280.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 191.75 155.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><text x=\"284\" y=\"85\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sales</text><text x=\"413\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Administration</text><text x=\"413\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Information Technology</text><text x=\"248\" y=\"361\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Customer Support</text><text x=\"111\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Development</text><text x=\"129\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Marketing</text><text x=\"303\" y=\"198.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">1300</text><text x=\"303\" y=\"173.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">2600</text><text x=\"303\" y=\"148.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">3900</text><text x=\"303\" y=\"123.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">5200</text><text x=\"303\" y=\"98.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">6500</text><text x=\"302.15\" y=\"243.36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">6000</text><text x=\"319.3\" y=\"255.86\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">12000</text><text x=\"340.95\" y=\"268.36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">18000</text><text x=\"362.6\" y=\"280.86\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">24000</text><text x=\"384.25\" y=\"293.36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">30000</text><text x=\"273\" y=\"248.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">7600</text><text x=\"267\" y=\"273.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">15200</text><text x=\"267\" y=\"298.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">22800</text><text x=\"267\" y=\"323.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">30400</text><text x=\"267\" y=\"348.5\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">38000</text><text x=\"254.35\" y=\"228.64\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">10400</text><text x=\"232.7\" y=\"241.14\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">20800</text><text x=\"211.05\" y=\"253.64\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">31200</text><text x=\"189.4\" y=\"266.14\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">41600</text><text x=\"167.75\" y=\"278.64\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">52000</text><text x=\"273.85\" y=\"203.64\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">5000</text><text x=\"250.7\" y=\"191.14\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">10000</text><text x=\"229.05\" y=\"178.64\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">15000</text><text x=\"207.4\" y=\"166.14\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">20000</text><text x=\"185.75\" y=\"153.64\" style=\"stroke:none;fill:rgb(70,70,70);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">25000</text><path d=\"M 300 137.23\nL 320.3 206.28\nL 372.17 259.67\nL 300 333.13\nL 195.91 278.1\nL 222.06 173\nL 300 137.23\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 300 137.23\nL 320.3 206.28\nL 372.17 259.67\nL 300 333.13\nL 195.91 278.1\nL 222.06 173\nL 300 137.23\" style=\"stroke:none;fill:rgba(84,112,198,0.1)\"/><circle cx=\"300\" cy=\"137.23\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"320.3\" cy=\"206.28\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"372.17\" cy=\"259.67\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"333.13\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"195.91\" cy=\"278.1\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"222.06\" cy=\"173\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><circle cx=\"300\" cy=\"137.23\" r=\"2\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:white\"/><path d=\"M 300 121.85\nL 394.72 163.31\nL 401.04 276.33\nL 300 303.53\nL 212.56 268.48\nL 209.07 165.5\nL 300 121.85\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><path d=\"M 300 121.85\nL 394.72 163.31\nL 401.04 276.33\nL 300 303.53\nL 212.56 268.48\nL 209.07 165.5\nL 300 121.85\" style=\"stroke:none;fill:rgba(145,204,117,0.1)\"/><circle cx=\"300\" cy=\"121.85\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"394.72\" cy=\"163.31\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"401.04\" cy=\"276.33\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"303.53\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"212.56\" cy=\"268.48\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"209.07\" cy=\"165.5\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/><circle cx=\"300\" cy=\"121.85\" r=\"2\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:white\"/></svg>",
+			pngCRC: 0x65796a44,
+		},
+		{
+			name: "symbols_labels_fill",
+			makeOptions: func() RadarChartOption {
+				opt := makeBasicRadarChartOption()
+				opt.SplitAreaShow = Ptr(true)
+				opt.SplitAreaColors = []Color{ColorTransparent, ColorLightGray.WithAlpha(80)}
+				opt.Symbol = SymbolDiamond
+				opt.FillOpacity = 60
+				opt.SeriesList[0].Symbol = SymbolSquare
+				opt.SeriesList[0].Label.Show = Ptr(true)
+				opt.SeriesList[1].Symbol = SymbolNone
+				opt.SeriesList[1].FillArea = Ptr(false)
+				return opt
+			},
+			svg:    "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" viewBox=\"0 0 600 400\"><path d=\"M 20 20\nL 580 20\nL 580 380\nL 20 380\nL 20 20\" style=\"stroke:none;fill:white\"/><text x=\"20\" y=\"36\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Basic Radar Chart</text><path d=\"M 143 23\nL 173 23\nL 173 36\nL 143 36\nL 143 23\" style=\"stroke:none;fill:rgb(84,112,198)\"/><text x=\"175\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Allocated Budget</text><path d=\"M 313 23\nL 343 23\nL 343 36\nL 313 36\nL 313 23\" style=\"stroke:none;fill:rgb(145,204,117)\"/><text x=\"345\" y=\"35\" style=\"stroke:none;fill:rgb(70,70,70);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Actual Spending</text><path d=\"M 300 168\nL 343.3 193\nL 343.3 243\nL 300 268\nL 256.7 243\nL 256.7 193\nL 300 168\nL 300 193\nL 278.35 205.5\nL 278.35 230.5\nL 300 243\nL 321.65 230.5\nL 321.65 205.5\nL 300 193\nL 300 168\" style=\"stroke:none;fill:rgba(211,211,211,0.3)\"/><path d=\"M 300 118\nL 386.6 168\nL 386.6 268\nL 300 318\nL 213.4 268\nL 213.4 168\nL 300 118\nL 300 143\nL 235.05 180.5\nL 235.05 255.5\nL 300 293\nL 364.95 255.5\nL 364.95 180.5\nL 300 143\nL 300 118\" style=\"stroke:none;fill:rgba(211,211,211,0.3)\"/><path d=\"M 300 193\nL 321.65 205.5\nL 321.65 230.5\nL 300 243\nL 278.35 230.5\nL 278.35 205.5\nL 300 193\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 168\nL 343.3 193\nL 343.3 243\nL 300 268\nL 256.7 243\nL 256.7 193\nL 300 168\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 143\nL 364.95 180.5\nL 364.95 255.5\nL 300 293\nL 235.05 255.5\nL 235.05 180.5\nL 300 143\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 118\nL 386.6 168\nL 386.6 268\nL 300 318\nL 213.4 268\nL 213.4 168\nL 300 118\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 93\nL 408.25 155.5\nL 408.25 280.5\nL 300 343\nL 191.75 280.5\nL 191.75 155.5\nL 300 93\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 300 93\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 408.25 155.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 408.25 280.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 300 343\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 191.75 280.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><path d=\"M 300 218\nL 191.75 155.5\" style=\"stroke-width:1;stroke:rgb(224,230,242);fill:none\"/><text x=\"284\" y=\"85\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sales</text><text x=\"413\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Administration</text><text x=\"413\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Information Technology</text><text x=\"248\" y=\"361\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Customer Support</text><text x=\"111\" y=\"285\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Development</text><text x=\"129\" y=\"161\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Marketing</text><path d=\"M 300 137.23\nL 320.3 206.28\nL 372.17 259.67\nL 300 333.13\nL 195.91 278.1\nL 222.06 173\nL 300 137.23\" style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\"/><path d=\"M 300 137.23\nL 320.3 206.28\nL 372.17 259.67\nL 300 333.13\nL 195.91 278.1\nL 222.06 173\nL 300 137.23\" style=\"stroke:none;fill:rgba(84,112,198,0.2)\"/><path d=\"M 297.2 134.43\nL 302.8 134.43\nL 302.8 140.03\nL 297.2 140.03\nL 297.2 134.43\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 317.5 203.48\nL 323.1 203.48\nL 323.1 209.08\nL 317.5 209.08\nL 317.5 203.48\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 369.37 256.87\nL 374.97 256.87\nL 374.97 262.47\nL 369.37 262.47\nL 369.37 256.87\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 297.2 330.33\nL 302.8 330.33\nL 302.8 335.93\nL 297.2 335.93\nL 297.2 330.33\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 193.11 275.3\nL 198.71 275.3\nL 198.71 280.9\nL 193.11 280.9\nL 193.11 275.3\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 219.26 170.2\nL 224.86 170.2\nL 224.86 175.8\nL 219.26 175.8\nL 219.26 170.2\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 297.2 134.43\nL 302.8 134.43\nL 302.8 140.03\nL 297.2 140.03\nL 297.2 134.43\" style=\"stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)\"/><path d=\"M 300 121.85\nL 394.72 163.31\nL 401.04 276.33\nL 300 303.53\nL 212.56 268.48\nL 209.07 165.5\nL 300 121.85\" style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\"/><text x=\"285\" y=\"132\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4200</text><text x=\"305\" y=\"201\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3000</text><text x=\"354\" y=\"254\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20000</text><text x=\"282\" y=\"328\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">35000</text><text x=\"177\" y=\"273\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">50000</text><text x=\"204\" y=\"168\" style=\"stroke:none;fill:rgb(70,70,70);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18000</text></svg>",
+			pngCRC: 0xa285af60,
+		},
 	}
 
 	for i, tt := range tests {
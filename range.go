@@ -3,6 +3,7 @@ package charts
 import (
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/go-analyze/charts/chartdraw"
 	"github.com/go-analyze/charts/chartdraw/matrix"
@@ -28,25 +29,41 @@ type axisRange struct {
 	size           int
 	textMaxWidth   int
 	textMaxHeight  int
+	// textLineHeight is the height of a single line when labels are wrapped to multiple lines, otherwise zero.
+	textLineHeight int
 	labelRotation  float64
 	labelFontStyle FontStyle
 }
 
+// valueExtent is the minimum and maximum data values represented on a value axis.
+type valueExtent struct {
+	min, max float64
+}
+
+// getValueAxisExtent returns the min and max data values to represent on the value axis for the series.
+func getValueAxisExtent(seriesList seriesList, yAxisIndex int, stackSeries bool) (float64, float64) {
+	minVal, maxVal, sumMax := getSeriesMinMaxSumMax(seriesList, yAxisIndex, stackSeries)
+	if stackSeries { // If stacked, maxVal should be the maxVal data point of all series summed together
+		if minVal > 0 {
+			minVal-- // subtract to ensure that all series are represented as a small stacked bar (may otherwise have 0 height)
+		}
+		maxVal = sumMax
+	}
+	return minVal, maxVal
+}
+
 // calculateValueAxisRange centralizes numeric axis logic, selecting human-friendly scale and label count.
 func calculateValueAxisRange(p *Painter, isVertical bool, axisSize int,
 	minCfg, maxCfg, rangeValuePaddingScale *float64,
 	labelsCfg []string, dataStartIndex int,
 	labelCountCfg int, labelUnit float64, labelCountAdjustment int,
-	seriesList seriesList, yAxisIndex int, stackSeries bool,
+	seriesList seriesList, yAxisIndex int, stackSeries bool, dataExtent *valueExtent,
 	valueFormatter ValueFormatter,
 	labelRotation float64, fontStyle FontStyle) axisRange {
 	// calculate the range
-	minVal, maxVal, sumMax := getSeriesMinMaxSumMax(seriesList, yAxisIndex, stackSeries)
-	if stackSeries { // If stacked, maxVal should be the maxVal data point of all series summed together
-		if minVal > 0 {
-			minVal-- // subtract to ensure that all series are represented as a small stacked bar (may otherwise have 0 height)
-		}
-		maxVal = sumMax
+	minVal, maxVal := getValueAxisExtent(seriesList, yAxisIndex, stackSeries)
+	if dataExtent != nil {
+		minVal, maxVal = dataExtent.min, dataExtent.max
 	}
 	minPadScale, maxPadScale := 1.0, 1.0
 	if rangeValuePaddingScale != nil {
@@ -61,8 +78,6 @@ func calculateValueAxisRange(p *Painter, isVertical bool, axisSize int,
 		maxVal = *maxCfg
 		maxPadScale = 0.0
 	}
-	decimalData := minVal != math.Floor(minVal) || (maxVal-minVal) != math.Floor(maxVal-minVal)
-
 	// Label counts and range padding are linked together to produce a user-friendly graph.
 	// First when considering padding we want to prefer a zero axis start if reasonable, and add a slight
 	// padding to the maxVal so there is a little space at the top of the graph. We also want to pick
@@ -71,20 +86,7 @@ func calculateValueAxisRange(p *Painter, isVertical bool, axisSize int,
 	//
 	// In order to accomplish this, we estimate the label count (if necessary), produce some labels to measure,
 	// calculate our label limit, pad the range, then once the label count is finalized produce the final labels.
-	initialLabelCount := labelCountCfg
-	if initialLabelCount < 1 {
-		if labelUnit > 0 {
-			initialLabelCount = int((maxVal-minVal)/labelUnit) + 1
-		} else {
-			initialLabelCount =
-				chartdraw.MinInt(chartdraw.MaxInt(int(maxVal-minVal)+1, defaultYAxisLabelCountLow),
-					defaultYAxisLabelCountHigh)
-			if decimalData { // if there is a decimal, we double our labels to provide more detail
-				initialLabelCount = chartdraw.MinInt(initialLabelCount*2, defaultYAxisLabelCountHigh)
-			}
-		}
-	}
-	initialLabelCount = chartdraw.MaxInt(initialLabelCount+labelCountAdjustment, minimumAxisLabels)
+	initialLabelCount := estimateValueLabelCount(minVal, maxVal, labelCountCfg, labelUnit, labelCountAdjustment)
 	labels := valueLabels(labelsCfg, valueFormatter, minVal, maxVal, initialLabelCount)
 	labelW, labelH := p.measureTextMaxWidthHeight(labels, labelRotation, fontStyle)
 
@@ -253,17 +255,31 @@ func calculateValueAxisRange(p *Painter, isVertical bool, axisSize int,
 	}
 }
 
+// categoryLabelOverflow configures how category axis labels which are too wide to fit are handled.
+type categoryLabelOverflow struct {
+	// strategy is one of the LabelOverflow constants, an empty value skips labels.
+	strategy string
+	// wrapWidth wraps all labels to this width when positive.
+	wrapWidth int
+	// maxLines limits the lines of wrapped labels, defaulting to defaultLabelMaxLines.
+	maxLines int
+}
+
 // calculateCategoryAxisRange does the same for category axes (common for x-axis in line/bar charts).
 func calculateCategoryAxisRange(p *Painter, axisSize int, isVertical bool, extraSpace bool,
 	labels []string, dataStartIndex int,
 	labelCountCfg int, labelCountAdjustment int, labelUnit float64,
-	seriesList seriesList, labelRotation float64, fontStyle FontStyle) axisRange {
+	seriesList seriesList, labelRotation float64, fontStyle FontStyle, overflow categoryLabelOverflow) axisRange {
 	// If user provided no labels, use series names.
 	// If provided only partially, fill in the remaining labels.
 	for i := len(labels); i < getSeriesMaxDataCount(seriesList); i++ {
 		labels = append(labels, strconv.Itoa(i+1))
 	}
 	dataCount := len(labels)
+	maxLines := getDefaultInt(overflow.maxLines, defaultLabelMaxLines)
+	if overflow.wrapWidth > 0 {
+		labels, _ = wrapLabels(p, labels, overflow.wrapWidth, maxLines, fontStyle)
+	}
 
 	textW, textH := p.measureTextMaxWidthHeight(labels, labelRotation, fontStyle)
 
@@ -276,23 +292,57 @@ func calculateCategoryAxisRange(p *Painter, axisSize int, isVertical bool, extra
 	labelCount = chartdraw.MaxInt(labelCount+labelCountAdjustment, minimumAxisLabels)
 	// validate the labels fit, otherwise reduce the count
 	if labelCountCfg == 0 {
-		maxLabelCount := labelCount
-		if isVertical {
-			if textH > 0 {
-				var extra int
-				if extraSpace {
-					extra = 10
+		// maxLabelCountFor returns how many labels of the given size fit along the axis
+		maxLabelCountFor := func(textW, textH int) int {
+			if isVertical {
+				if textH > 0 {
+					var extra int
+					if extraSpace {
+						extra = 10
+					}
+					return chartdraw.MaxInt(axisSize/(textH+extra), minimumAxisLabels)
 				}
-				maxLabelCount = chartdraw.MaxInt(axisSize/(textH+extra), minimumAxisLabels)
-			}
-		} else {
-			if textW > 0 {
+			} else if textW > 0 {
 				// add a little extra padding for horizontal layouts
 				extra := textW
 				if !extraSpace {
 					extra /= 2
 				}
-				maxLabelCount = chartdraw.MaxInt(axisSize/(textW+extra), minimumAxisLabels)
+				return chartdraw.MaxInt(axisSize/(textW+extra), minimumAxisLabels)
+			}
+			return labelCount
+		}
+		maxLabelCount := maxLabelCountFor(textW, textH)
+		if !isVertical && maxLabelCount < labelCount && overflow.wrapWidth <= 0 && labelRotation == 0 &&
+			overflow.strategy != "" && overflow.strategy != LabelOverflowSkip {
+			strategy := overflow.strategy
+			// wrapping to the space of each label avoids skipping labels, if not truncated too much
+			wrapWidth := axisSize/labelCount - labelWrapPadding
+			wrapped, truncated := wrapLabels(p, labels, wrapWidth, maxLines, fontStyle)
+			wrapFits := wrapWidth > 0 && !truncated
+			rotation := DegreesToRadians(labelOverflowRotationDegrees)
+			// rotated labels are spaced by their height along the axis rather than their width
+			rotatedCount := maxLabelCountFor(int(float64(textH)/math.Sin(rotation)), 0)
+			if strategy == LabelOverflowAuto {
+				if wrapFits {
+					strategy = LabelOverflowWrap
+				} else if rotatedCount >= labelCount {
+					strategy = LabelOverflowRotate
+				} else if wrapWidth > 0 && maxWordWidth(p, labels, fontStyle) <= wrapWidth {
+					strategy = LabelOverflowWrap // truncated only by the line limit
+				}
+			}
+			switch strategy {
+			case LabelOverflowWrap:
+				if wrapWidth > 0 {
+					labels = wrapped
+					textW, textH = p.measureTextMaxWidthHeight(labels, labelRotation, fontStyle)
+					maxLabelCount = labelCount
+				}
+			case LabelOverflowRotate:
+				labelRotation = rotation
+				textW, textH = p.measureTextMaxWidthHeight(labels, labelRotation, fontStyle)
+				maxLabelCount = rotatedCount
 			}
 		}
 		if labelUnit > 0 {
@@ -326,6 +376,13 @@ func calculateCategoryAxisRange(p *Painter, axisSize int, isVertical bool, extra
 		// TODO - I would like to improve this, but for simplicity we will match the label count if ticks are too dense
 		tickCount = labelCount
 	}
+	var lineHeight int
+	for _, label := range labels {
+		if strings.ContainsRune(label, '\n') {
+			_, h, _ := p.measureMultilineText(label, 0, fontStyle)
+			lineHeight = chartdraw.MaxInt(lineHeight, h)
+		}
+	}
 
 	return axisRange{
 		isCategory:     true,
@@ -337,11 +394,54 @@ func calculateCategoryAxisRange(p *Painter, axisSize int, isVertical bool, extra
 		size:           axisSize,
 		textMaxWidth:   textW,
 		textMaxHeight:  textH,
+		textLineHeight: lineHeight,
 		labelRotation:  labelRotation,
 		labelFontStyle: fontStyle,
 	}
 }
 
+// maxWordWidth returns the width of the widest word within the labels.
+func maxWordWidth(p *Painter, labels []string, fontStyle FontStyle) int {
+	var width int
+	for _, label := range labels {
+		for _, word := range strings.Fields(label) {
+			width = chartdraw.MaxInt(width, p.MeasureText(word, 0, fontStyle).Width())
+		}
+	}
+	return width
+}
+
+// wrapLabels wraps each label to the width, returning the wrapped labels and true if any label was truncated.
+func wrapLabels(p *Painter, labels []string, width, maxLines int, fontStyle FontStyle) ([]string, bool) {
+	result := make([]string, len(labels))
+	var truncated bool
+	for i, label := range labels {
+		var labelTruncated bool
+		result[i], labelTruncated = p.wrapText(label, width, maxLines, fontStyle)
+		truncated = truncated || labelTruncated
+	}
+	return result, truncated
+}
+
+// estimateValueLabelCount returns the initial label count for a value axis range, before it's refined to fit the
+// axis size.
+func estimateValueLabelCount(minVal, maxVal float64, labelCountCfg int, labelUnit float64, labelCountAdjustment int) int {
+	labelCount := labelCountCfg
+	if labelCount < 1 {
+		if labelUnit > 0 {
+			labelCount = int((maxVal-minVal)/labelUnit) + 1
+		} else {
+			labelCount = chartdraw.MinInt(chartdraw.MaxInt(int(maxVal-minVal)+1, defaultYAxisLabelCountLow),
+				defaultYAxisLabelCountHigh)
+			// if there is a decimal, we double our labels to provide more detail
+			if minVal != math.Floor(minVal) || (maxVal-minVal) != math.Floor(maxVal-minVal) {
+				labelCount = chartdraw.MinInt(labelCount*2, defaultYAxisLabelCountHigh)
+			}
+		}
+	}
+	return chartdraw.MaxInt(labelCount+labelCountAdjustment, minimumAxisLabels)
+}
+
 func valueLabels(labelsCfg []string, valueFormatter ValueFormatter, min, max float64, labelCount int) []string {
 	labels := make([]string, labelCount)
 	offset := (max - min) / float64(labelCount-1)
@@ -500,6 +600,25 @@ func (r axisRange) getRestHeight(value float64) int {
 	return r.size - r.getHeight(value)
 }
 
+// getHeightF returns the height of the value within the range, without truncating to whole pixels.
+func (r axisRange) getHeightF(value float64) float64 {
+	if r.max <= r.min {
+		return 0
+	}
+	result := (value - r.min) / (r.max - r.min) * float64(r.size)
+	if result < 0 {
+		return 0
+	} else if result > float64(r.size) {
+		return float64(r.size)
+	}
+	return result
+}
+
+// getRestHeightF returns the distance from the top of the range to the value, without truncating to whole pixels.
+func (r axisRange) getRestHeightF(value float64) float64 {
+	return float64(r.size) - r.getHeightF(value)
+}
+
 // getRange returns a range at a given index.
 func (r axisRange) getRange(index int) (float64, float64) {
 	unit := float64(r.size) / float64(r.divideCount)
@@ -510,3 +629,62 @@ func (r axisRange) getRange(index int) (float64, float64) {
 func (r axisRange) autoDivide() []int {
 	return autoDivide(r.size, r.divideCount)
 }
+
+// setSharedAxisRanges calculates the value axis ranges of every chart from the data across all the charts, so that
+// charts of the same size render the same ranges and can be compared directly. shareX shares the x-axis range of
+// horizontal bar charts, while shareY shares the y-axis ranges of the other charts.
+func setSharedAxisRanges(opts []ChartOption, shareX, shareY bool) {
+	var horizontal, vertical []*ChartOption
+	for i := range opts {
+		if hasHorizontalBarSeries(opts[i].SeriesList) {
+			horizontal = append(horizontal, &opts[i])
+		} else {
+			vertical = append(vertical, &opts[i])
+		}
+	}
+
+	if shareX && len(horizontal) != 0 {
+		extent := sharedValueExtent(horizontal, 0)
+		for _, opt := range horizontal {
+			opt.XAxis.dataExtent = extent
+		}
+	}
+	if !shareY {
+		return
+	}
+	var axisCount int
+	for _, opt := range vertical {
+		axisCount = chartdraw.MaxInt(axisCount, getSeriesYAxisCount(opt.SeriesList))
+	}
+	for yIndex := 0; yIndex < axisCount; yIndex++ {
+		extent := sharedValueExtent(vertical, yIndex)
+		for _, opt := range vertical {
+			// copy the axis options so that a slice shared between charts is not modified
+			yAxis := make([]YAxisOption, chartdraw.MaxInt(len(opt.YAxis), yIndex+1))
+			copy(yAxis, opt.YAxis)
+			yAxis[yIndex].dataExtent = extent
+			opt.YAxis = yAxis
+		}
+	}
+}
+
+// hasHorizontalBarSeries returns true if the series list contains horizontal bar series, which have the value axis on
+// the x-axis.
+func hasHorizontalBarSeries(seriesList GenericSeriesList) bool {
+	for _, s := range seriesList {
+		if chartTypeMatch(ChartTypeHorizontalBar, s.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// sharedValueExtent returns the extent of the data across all the charts for the y-axis index.
+func sharedValueExtent(opts []*ChartOption, yIndex int) *valueExtent {
+	extent := &valueExtent{min: math.MaxFloat64, max: -math.MaxFloat64}
+	for _, opt := range opts {
+		minVal, maxVal := getValueAxisExtent(opt.SeriesList, yIndex, flagIs(true, opt.StackSeries))
+		extent.min, extent.max = math.Min(extent.min, minVal), math.Max(extent.max, maxVal)
+	}
+	return extent
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func newTestRange(size, divideCount int, min, max, minPaddingScale, maxPaddingScale float64) axisRange {
@@ -110,7 +111,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 
 		ar := calculateValueAxisRange(p, false, 800, nil, nil, Ptr(0.0),
 			nil, 0, 3, 0, 0,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.Len(t, ar.labels, 3)
 		assert.Equal(t, []string{"10", "20", "30"}, ar.labels)
@@ -118,6 +119,18 @@ func TestCalculateValueAxisRange(t *testing.T) {
 		assert.Equal(t, 3, ar.labelCount)
 	})
 
+	t.Run("data_extent", func(t *testing.T) {
+		p := NewPainter(PainterOptions{Width: 800, Height: 600})
+		series := testSeries{yAxisIndex: 0, values: []float64{10, 20, 30}}
+		tsl := testSeriesList{series}
+
+		ar := calculateValueAxisRange(p, false, 800, nil, nil, Ptr(0.0),
+			nil, 0, 3, 0, 0,
+			tsl, 0, false, &valueExtent{min: 0, max: 60}, defaultValueFormatter, 0, fs)
+
+		assert.Equal(t, []string{"0", "30", "60"}, ar.labels)
+	})
+
 	t.Run("label_unit", func(t *testing.T) {
 		p := NewPainter(PainterOptions{Width: 800, Height: 600})
 		series := testSeries{yAxisIndex: 0, values: []float64{0, 50}}
@@ -125,7 +138,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 
 		ar := calculateValueAxisRange(p, true, 800, nil, nil, nil,
 			nil, 0, 0, 5, 0,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.Equal(t, 12, ar.labelCount)
 		assert.Equal(t, []string{"0", "5", "10", "15", "20", "25", "30", "35", "40", "45", "50", "55"}, ar.labels)
@@ -138,7 +151,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 
 		ar := calculateValueAxisRange(p, false, 1200, nil, nil, nil,
 			nil, 0, 0, 5, 2,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.Equal(t, 8, ar.labelCount)
 		assert.InDelta(t, 0.0, ar.min, 0.0)
@@ -152,7 +165,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 
 		ar := calculateValueAxisRange(p, false, 2400, nil, nil, nil,
 			nil, 0, 0, 5, 4,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.Equal(t, 25, ar.labelCount)
 		assert.InDelta(t, -10.0, ar.min, 0.0)
@@ -168,7 +181,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 
 		ar := calculateValueAxisRange(p, true, 800, nil, nil, nil,
 			nil, 0, 0, 0, 0,
-			tsl, 0, true, defaultValueFormatter, 0, fs)
+			tsl, 0, true, nil, defaultValueFormatter, 0, fs)
 
 		assert.InDelta(t, 0.0, ar.min, 0.0)
 		assert.InDelta(t, 10.0, ar.max, 0.0)
@@ -183,7 +196,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 		max := Ptr(25.0)
 		ar := calculateValueAxisRange(p, true, 800, min, max,
 			nil, []string{}, 0, 0, 0, 0,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.InDelta(t, 5.0, ar.min, 0.0)
 		assert.InDelta(t, 25.0, ar.max, 0.0)
@@ -196,7 +209,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 
 		ar := calculateValueAxisRange(p, true, 800, nil, nil, nil,
 			nil, 0, 0, 0, 0,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.InDelta(t, 1.0, ar.min, 0.0)
 		assert.InDelta(t, 5.0, ar.max, 0.0)
@@ -213,7 +226,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 			"WowLookAtTheseLabels!", "AndHereIsAnotherReallyLongLabel"}
 		ar := calculateValueAxisRange(p, false, 800, nil, nil, nil,
 			inputLabels, 0, 0, 0, 0,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.Equal(t, 810, ar.textMaxWidth)
 		assert.Equal(t, 41, ar.textMaxHeight)
@@ -229,7 +242,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 
 		ar := calculateValueAxisRange(p, true, 800, nil, nil, nil,
 			nil, 0, 0, 0, 0,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.InDelta(t, 49.0, ar.min, 0.0)
 		assert.InDelta(t, 51.0, ar.max, 0.0)
@@ -243,7 +256,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 		rotation := DegreesToRadians(45.0)
 		ar := calculateValueAxisRange(p, true, 800, nil, nil, nil,
 			[]string{"Label One", "Label Two", "Label Three", "Label Four"}, 0, 0, 0, 0,
-			tsl, 0, false, defaultValueFormatter, rotation, fs)
+			tsl, 0, false, nil, defaultValueFormatter, rotation, fs)
 
 		assert.Equal(t, 103, ar.textMaxWidth)
 		assert.Equal(t, 103, ar.textMaxHeight)
@@ -261,7 +274,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 		explicitLabelCount := 3
 		ar := calculateValueAxisRange(p, false, 800, nil, nil, nil,
 			providedLabels, 0, explicitLabelCount, 0, 0,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.Equal(t, []string{"Label1", "Label2", "Label3"}, ar.labels)
 		assert.Equal(t, 3, ar.divideCount)
@@ -276,7 +289,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 		ar := calculateValueAxisRange(p, false, 800,
 			nil, nil, Ptr(0.0), // force no padding
 			nil, 0, 0, 7, 0,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.Equal(t, 6, ar.labelCount)
 		assert.InDelta(t, 0.0, ar.min, 0.0)
@@ -292,7 +305,7 @@ func TestCalculateValueAxisRange(t *testing.T) {
 		ar := calculateValueAxisRange(p, false, 800,
 			nil, nil, Ptr(0.0), // force no padding
 			nil, 0, 0, 9, 0,
-			tsl, 0, false, defaultValueFormatter, 0, fs)
+			tsl, 0, false, nil, defaultValueFormatter, 0, fs)
 
 		assert.Equal(t, 4, ar.labelCount)
 		assert.InDelta(t, 9.0, ar.min, 0.0)
@@ -310,11 +323,11 @@ func TestCalculateValueAxisRange(t *testing.T) {
 		ar := calculateValueAxisRange(
 			p, false, 462, // isVertical, axisSize
 			nil, nil, nil, nil, // minCfg, maxCfg, rangeValuePaddingScale, labelsCfg
-			0,            // dataStartIndex
-			0,            // labelCountCfg
-			100000,       // labelUnit (much larger than the data span)
-			0,            // labelCountAdjustment
-			tsl, 0, true, // seriesList, yAxisIndex, stackSeries
+			0,                 // dataStartIndex
+			0,                 // labelCountCfg
+			100000,            // labelUnit (much larger than the data span)
+			0,                 // labelCountAdjustment
+			tsl, 0, true, nil, // seriesList, yAxisIndex, stackSeries, dataExtent
 			defaultValueFormatter,
 			0, fs, // labelRotation, fontStyle
 		)
@@ -338,7 +351,7 @@ func TestCalculateCategoryAxisRange(t *testing.T) {
 		}
 
 		ar := calculateCategoryAxisRange(p, 800, false, false, nil, 0,
-			0, 0, 0, tsl, 0, fs)
+			0, 0, 0, tsl, 0, fs, categoryLabelOverflow{})
 
 		expectedLabels := []string{"1"}
 		assert.Equal(t, expectedLabels, ar.labels)
@@ -357,7 +370,7 @@ func TestCalculateCategoryAxisRange(t *testing.T) {
 		}
 
 		ar := calculateCategoryAxisRange(p, 800, false, false, providedLabels, 0,
-			0, 0, 0, tsl, 0, fs)
+			0, 0, 0, tsl, 0, fs, categoryLabelOverflow{})
 
 		assert.Equal(t, []string{"CustomLabel", "2"}, ar.labels)
 		assert.Equal(t, 2, ar.divideCount)
@@ -374,7 +387,7 @@ func TestCalculateCategoryAxisRange(t *testing.T) {
 		}
 
 		ar := calculateCategoryAxisRange(p, 800, false, false, nil, 0,
-			2, 1, 0, tsl, 0, fs)
+			2, 1, 0, tsl, 0, fs, categoryLabelOverflow{})
 
 		assert.Equal(t, 1, ar.divideCount)
 		assert.Equal(t, 2, ar.labelCount)
@@ -389,7 +402,7 @@ func TestCalculateCategoryAxisRange(t *testing.T) {
 
 		rotation := DegreesToRadians(30.0)
 		ar := calculateCategoryAxisRange(p, 800, true, false, []string{}, 0,
-			0, 0, 0, tsl, rotation, fs)
+			0, 0, 0, tsl, rotation, fs, categoryLabelOverflow{})
 
 		assert.Equal(t, 17, ar.textMaxWidth)
 		assert.Equal(t, 20, ar.textMaxHeight)
@@ -405,7 +418,7 @@ func TestCalculateCategoryAxisRange(t *testing.T) {
 		}
 
 		ar := calculateCategoryAxisRange(p, 800, false, false, []string{}, 0,
-			0, -2, 0, tsl, 0, fs)
+			0, -2, 0, tsl, 0, fs, categoryLabelOverflow{})
 
 		assert.Equal(t, 2, ar.labelCount)
 	})
@@ -418,7 +431,7 @@ func TestCalculateCategoryAxisRange(t *testing.T) {
 		}
 
 		ar := calculateCategoryAxisRange(p, 800, false, false, []string{}, 0,
-			5, 0, 0, tsl, 0, fs)
+			5, 0, 0, tsl, 0, fs, categoryLabelOverflow{})
 
 		assert.Equal(t, 2, ar.labelCount)
 	})
@@ -434,7 +447,7 @@ func TestCalculateCategoryAxisRange(t *testing.T) {
 		inputLabels := []string{"ThisIsAVeryLongLabelThatExceedsNormal", "AnotherVeryLongLabelThatExceedsNormal",
 			"WowLookAtTheseLabels!", "AndHereIsAnotherReallyLongLabel"}
 		ar := calculateCategoryAxisRange(p, 600, false, false, inputLabels, 0,
-			0, 0, 0, tsl, 0, fs)
+			0, 0, 0, tsl, 0, fs, categoryLabelOverflow{})
 
 		assert.Equal(t, 2, ar.labelCount)
 	})
@@ -455,7 +468,7 @@ func TestCalculateCategoryAxisRange(t *testing.T) {
 		}
 
 		ar := calculateCategoryAxisRange(p, 800, false, false, []string{}, 0,
-			0, 0, 4.0, tsl, 0, fs)
+			0, 0, 4.0, tsl, 0, fs, categoryLabelOverflow{})
 
 		assert.Equal(t, 2, ar.labelCount)
 	})
@@ -464,12 +477,94 @@ func TestCalculateCategoryAxisRange(t *testing.T) {
 		p := NewPainter(PainterOptions{Width: 800, Height: 600})
 		tsl := testSeriesList{}
 		ar := calculateCategoryAxisRange(p, 800, false, false, nil, 0,
-			0, 0, 0, tsl, 0, fs)
+			0, 0, 0, tsl, 0, fs, categoryLabelOverflow{})
 
 		assert.Empty(t, ar.labels)
 		assert.Equal(t, 0, ar.divideCount)
 		assert.Equal(t, 2, ar.labelCount)
 	})
+
+	overflowLabels := []string{"North America Region", "South America Region", "Western Europe", "Eastern Europe",
+		"Asia Pacific Markets", "Middle East and Africa"}
+	overflowSeries := testSeriesList{{values: []float64{1, 2, 3, 4, 5, 6}}}
+
+	t.Run("wrap_width", func(t *testing.T) {
+		p := NewPainter(PainterOptions{Width: 800, Height: 600})
+		ar := calculateCategoryAxisRange(p, 800, true, false, overflowLabels, 0,
+			0, 0, 0, overflowSeries, 0, fs, categoryLabelOverflow{wrapWidth: 90})
+
+		assert.Equal(t, "North\nAmeri…", ar.labels[0])
+		assert.Equal(t, "Western\nEurope", ar.labels[2])
+		assert.Positive(t, ar.textLineHeight)
+		assert.Greater(t, ar.textMaxHeight, ar.textLineHeight)
+		assert.LessOrEqual(t, ar.textMaxWidth, 90)
+	})
+
+	t.Run("wrap_max_lines", func(t *testing.T) {
+		p := NewPainter(PainterOptions{Width: 800, Height: 600})
+		ar := calculateCategoryAxisRange(p, 800, true, false, overflowLabels, 0,
+			0, 0, 0, overflowSeries, 0, fs, categoryLabelOverflow{wrapWidth: 90, maxLines: 3})
+
+		assert.Equal(t, "North\nAmerica\nRegion", ar.labels[0])
+	})
+
+	t.Run("overflow_skip", func(t *testing.T) {
+		p := NewPainter(PainterOptions{Width: 800, Height: 600})
+		ar := calculateCategoryAxisRange(p, 900, false, false, overflowLabels, 0,
+			0, 0, 0, overflowSeries, 0, fs, categoryLabelOverflow{strategy: LabelOverflowSkip})
+
+		assert.Equal(t, overflowLabels, ar.labels)
+		assert.Less(t, ar.labelCount, len(overflowLabels))
+		assert.Zero(t, ar.textLineHeight)
+	})
+
+	t.Run("overflow_auto_wrap", func(t *testing.T) {
+		p := NewPainter(PainterOptions{Width: 800, Height: 600})
+		ar := calculateCategoryAxisRange(p, 1200, false, false, overflowLabels, 0,
+			0, 0, 0, overflowSeries, 0, fs, categoryLabelOverflow{strategy: LabelOverflowAuto})
+
+		assert.Equal(t, "North America\nRegion", ar.labels[0])
+		assert.Equal(t, len(overflowLabels), ar.labelCount)
+		assert.InDelta(t, 0.0, ar.labelRotation, 0)
+	})
+
+	t.Run("overflow_auto_rotate", func(t *testing.T) {
+		p := NewPainter(PainterOptions{Width: 800, Height: 600})
+		ar := calculateCategoryAxisRange(p, 500, false, false, overflowLabels, 0,
+			0, 0, 0, overflowSeries, 0, fs, categoryLabelOverflow{strategy: LabelOverflowAuto})
+
+		assert.Equal(t, overflowLabels, ar.labels)
+		assert.Equal(t, len(overflowLabels), ar.labelCount)
+		assert.InDelta(t, DegreesToRadians(labelOverflowRotationDegrees), ar.labelRotation, 0)
+	})
+
+	t.Run("overflow_auto_skip", func(t *testing.T) {
+		p := NewPainter(PainterOptions{Width: 800, Height: 600})
+		ar := calculateCategoryAxisRange(p, 120, false, false, overflowLabels, 0,
+			0, 0, 0, overflowSeries, 0, fs, categoryLabelOverflow{strategy: LabelOverflowAuto})
+
+		assert.Equal(t, overflowLabels, ar.labels)
+		assert.Less(t, ar.labelCount, len(overflowLabels))
+		assert.InDelta(t, 0.0, ar.labelRotation, 0)
+	})
+
+	t.Run("overflow_wrap_truncated", func(t *testing.T) {
+		p := NewPainter(PainterOptions{Width: 800, Height: 600})
+		ar := calculateCategoryAxisRange(p, 600, false, false, overflowLabels, 0,
+			0, 0, 0, overflowSeries, 0, fs, categoryLabelOverflow{strategy: LabelOverflowWrap})
+
+		assert.Contains(t, ar.labels[0], "…")
+		assert.Equal(t, len(overflowLabels), ar.labelCount)
+	})
+
+	t.Run("overflow_rotate", func(t *testing.T) {
+		p := NewPainter(PainterOptions{Width: 800, Height: 600})
+		ar := calculateCategoryAxisRange(p, 1000, false, false, overflowLabels, 0,
+			0, 0, 0, overflowSeries, 0, fs, categoryLabelOverflow{strategy: LabelOverflowRotate})
+
+		assert.Equal(t, overflowLabels, ar.labels)
+		assert.InDelta(t, DegreesToRadians(labelOverflowRotationDegrees), ar.labelRotation, 0)
+	})
 }
 
 func TestPadRange(t *testing.T) {
@@ -693,3 +788,47 @@ func TestFriendlyRound(t *testing.T) {
 		})
 	}
 }
+
+func TestSetSharedAxisRanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shared_extent", func(t *testing.T) {
+		opts := []ChartOption{
+			{SeriesList: NewSeriesListLine([][]float64{{10, 40}}).ToGenericSeriesList()},
+			{SeriesList: NewSeriesListLine([][]float64{{20, 120}}).ToGenericSeriesList()},
+		}
+		setSharedAxisRanges(opts, true, true)
+
+		for _, opt := range opts {
+			require.Len(t, opt.YAxis, 1)
+			assert.Equal(t, &valueExtent{min: 10, max: 120}, opt.YAxis[0].dataExtent)
+		}
+	})
+
+	t.Run("y_not_shared", func(t *testing.T) {
+		opts := []ChartOption{
+			{SeriesList: NewSeriesListLine([][]float64{{10, 40}}).ToGenericSeriesList()},
+			{SeriesList: NewSeriesListLine([][]float64{{20, 120}}).ToGenericSeriesList()},
+		}
+		setSharedAxisRanges(opts, true, false)
+
+		for _, opt := range opts {
+			assert.Nil(t, opt.YAxis)
+		}
+	})
+
+	t.Run("horizontal_bar", func(t *testing.T) {
+		opts := []ChartOption{
+			{SeriesList: NewSeriesListHorizontalBar([][]float64{{10, 20}}).ToGenericSeriesList()},
+			{SeriesList: NewSeriesListHorizontalBar([][]float64{{5, 80}}).ToGenericSeriesList()},
+			{SeriesList: NewSeriesListLine([][]float64{{1, 2}}).ToGenericSeriesList()},
+		}
+		setSharedAxisRanges(opts, true, true)
+
+		assert.Equal(t, &valueExtent{min: 5, max: 80}, opts[0].XAxis.dataExtent)
+		assert.Equal(t, &valueExtent{min: 5, max: 80}, opts[1].XAxis.dataExtent)
+		assert.Nil(t, opts[2].XAxis.dataExtent)
+		require.Len(t, opts[2].YAxis, 1)
+		assert.Equal(t, &valueExtent{min: 1, max: 2}, opts[2].YAxis[0].dataExtent)
+	})
+}
@@ -0,0 +1,293 @@
+package charts
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-analyze/charts/chartdraw"
+)
+
+const (
+	// richTextScriptScale is the font size of superscript and subscript text relative to the surrounding text.
+	richTextScriptScale = 0.7
+	// richTextSuperscriptRise is the baseline rise of superscript text relative to the surrounding font size.
+	richTextSuperscriptRise = 0.4
+	// richTextSubscriptDrop is the baseline drop of subscript text relative to the surrounding font size.
+	richTextSubscriptDrop = 0.2
+	// richTextSymbolUp is the {up} symbol, an upward triangle.
+	richTextSymbolUp = "up"
+	// richTextSymbolDown is the {down} symbol, a downward triangle.
+	richTextSymbolDown = "down"
+)
+
+// RichTextSignSymbol returns the rich text markup for a symbol indicating the sign of the value, "{up}" for positive
+// values and "{down}" for negative values, or an empty string for zero. The symbols are colored with the theme up and
+// down colors.
+func RichTextSignSymbol(value float64) string {
+	if value > 0 {
+		return "{up}"
+	} else if value < 0 {
+		return "{down}"
+	}
+	return ""
+}
+
+// LabelFormatterValueSign is a SeriesLabelFormatter which prefixes the short value with a symbol colored by the sign
+// of the value. Rich text is enabled for the labels with a symbol.
+var LabelFormatterValueSign = func(index int, name string, val float64) (string, *LabelStyle) {
+	if symbol := RichTextSignSymbol(val); symbol != "" {
+		return symbol + " " + defaultValueFormatter(val), &LabelStyle{FontStyle: FontStyle{RichText: Ptr(true)}}
+	}
+	return defaultValueFormatter(val), nil
+}
+
+// richTextStyle is the style of a run of rich text, the rise is the baseline offset in points (positive is raised).
+type richTextStyle struct {
+	FontStyle
+	rise float64
+}
+
+type richTextRun struct {
+	text string
+	// symbol is set instead of the text for inline symbols.
+	symbol string
+	style  richTextStyle
+}
+
+// parseRichText parses inline markup within text (used by titles, legends, labels, and any other text drawn with
+// Painter.Text when FontStyle.RichText is enabled) into styled runs. Markup is written as {attributes|text}, where the
+// comma separated attributes are:
+//
+//	b or bold: bold text, using the font family installed with a "-bold" suffix when available
+//	color=<color>: text color, any format supported by ParseColor
+//	size=<points>: font size
+//	font=<family>: installed font family
+//	sup or sub: superscript or subscript text
+//
+// Tags may be nested, for example "{b|Total: {color=red|-5}}". The standalone {up} and {down} tags draw triangle
+// symbols in the theme up and down colors. Braces which don't form a tag are kept as text, and can be escaped as \{
+// and \}. False is returned if the text contains no markup.
+func parseRichText(text string, base FontStyle, theme ColorPalette) ([]richTextRun, bool) {
+	if !strings.ContainsRune(text, '{') && !strings.Contains(text, `\}`) {
+		return nil, false
+	}
+	runes := []rune(text)
+	stack := []richTextStyle{{FontStyle: base}}
+	var runs []richTextRun
+	var buf []rune
+	var rich bool
+	flush := func() {
+		if len(buf) != 0 {
+			runs = append(runs, richTextRun{text: string(buf), style: stack[len(stack)-1]})
+			buf = buf[:0]
+		}
+	}
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\\' && i+1 < len(runes) && (runes[i+1] == '{' || runes[i+1] == '}'):
+			i++
+			buf = append(buf, runes[i])
+			rich = true
+		case r == '{':
+			if symbol, color, n := parseRichTextSymbol(runes[i:], theme); n > 0 {
+				flush()
+				style := stack[len(stack)-1]
+				style.FontColor = color
+				runs = append(runs, richTextRun{symbol: symbol, style: style})
+				i += n - 1
+				rich = true
+			} else if style, n := parseRichTextTag(runes[i:], stack[len(stack)-1]); n > 0 {
+				flush()
+				stack = append(stack, style)
+				i += n - 1
+				rich = true
+			} else {
+				buf = append(buf, r)
+			}
+		case r == '}' && len(stack) > 1:
+			flush()
+			stack = stack[:len(stack)-1]
+		default:
+			buf = append(buf, r)
+		}
+	}
+	if !rich {
+		return nil, false
+	}
+	flush()
+	return runs, true
+}
+
+// parseRichTextSymbol returns the symbol and color for a standalone symbol tag at the start of the runes, and the
+// number of runes of the tag. Zero is returned if the runes don't start with a symbol tag.
+func parseRichTextSymbol(runes []rune, theme ColorPalette) (string, Color, int) {
+	upColor, downColor := getPreferredTheme(theme).GetSeriesUpDownColors(0)
+	for _, tag := range []string{"{up}", "{down}"} {
+		if len(runes) >= len(tag) && string(runes[:len(tag)]) == tag {
+			if tag == "{up}" {
+				return richTextSymbolUp, upColor, len(tag)
+			}
+			return richTextSymbolDown, downColor, len(tag)
+		}
+	}
+	return "", Color{}, 0
+}
+
+// parseRichTextTag returns the style of a tag at the start of the runes, and the number of runes up to and including
+// the '|' separator. Zero is returned if the runes don't start with a valid tag.
+func parseRichTextTag(runes []rune, parent richTextStyle) (richTextStyle, int) {
+	end := -1
+	for i := 1; i < len(runes); i++ {
+		if runes[i] == '|' {
+			end = i
+			break
+		} else if runes[i] == '{' || runes[i] == '}' {
+			break
+		}
+	}
+	if end <= 1 {
+		return parent, 0
+	}
+	style := parent
+	for _, attr := range strings.Split(string(runes[1:end]), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(attr), "=")
+		switch name {
+		case "b", "bold":
+			style.Font = getBoldFont(style.Font)
+		case "sup":
+			style.rise += parent.FontSize * richTextSuperscriptRise
+			style.FontSize = parent.FontSize * richTextScriptScale
+		case "sub":
+			style.rise -= parent.FontSize * richTextSubscriptDrop
+			style.FontSize = parent.FontSize * richTextScriptScale
+		case "color":
+			color := ParseColor(value)
+			if color.IsZero() && value != "transparent" {
+				return parent, 0
+			}
+			style.FontColor = color
+		case "size":
+			size, err := strconv.ParseFloat(value, 64)
+			if err != nil || size <= 0 {
+				return parent, 0
+			}
+			style.FontSize = size
+		case "font":
+			if value == "" {
+				return parent, 0
+			}
+			style.Font = GetFont(value)
+		default:
+			return parent, 0
+		}
+	}
+	return style, end + 1
+}
+
+// richTextSymbol is an inline symbol drawn as a filled shape, as the bundled fonts don't include the glyphs.
+type richTextSymbol struct {
+	// points outline the symbol relative to the text baseline position, before rotation.
+	points []PointF
+	color  Color
+}
+
+// richText is rich text laid out into runs relative to the text baseline position.
+type richText struct {
+	runs    []chartdraw.TextRun
+	symbols []richTextSymbol
+	// box is the unrotated size of the text.
+	box Box
+}
+
+// layoutRichText parses the text markup and positions each run. False is returned if the text contains no markup.
+func (p *Painter) layoutRichText(text string, fontStyle FontStyle) (richText, bool) {
+	parsed, ok := parseRichText(text, fontStyle, p.theme)
+	if !ok {
+		return richText{}, false
+	}
+	var result richText
+	var x, top, bottom int
+	for _, run := range parsed {
+		var box Box
+		if run.symbol != "" {
+			box = p.measurePlainText("a", 0, run.style.FontStyle)
+		} else {
+			box = p.measureRichTextRun(run.text, run.style.FontStyle)
+		}
+		var dy float64
+		if run.style.rise != 0 && run.style.FontSize > 0 {
+			// convert the rise from points to pixels using the measured font height
+			dy = -run.style.rise * float64(box.Height()) / run.style.FontSize
+		}
+		if run.symbol != "" {
+			// an equilateral triangle sized to the lowercase letter height, centered above the baseline
+			size := float64(box.Height())
+			left, right, mid := float64(x), float64(x)+size, float64(x)+size/2
+			base, apex := dy-size*0.1, dy-size*0.1-size*0.87
+			if run.symbol == richTextSymbolDown {
+				base, apex = apex, base
+			}
+			result.symbols = append(result.symbols, richTextSymbol{
+				points: []PointF{{X: left, Y: base}, {X: right, Y: base}, {X: mid, Y: apex}, {X: left, Y: base}},
+				color:  run.style.FontColor,
+			})
+			box.Right = int(size)
+		} else {
+			result.runs = append(result.runs, chartdraw.TextRun{
+				Text:      run.text,
+				Font:      run.style.Font,
+				FontSize:  run.style.FontSize,
+				FontColor: run.style.FontColor,
+				DX:        float64(x),
+				DY:        dy,
+			})
+		}
+		x += box.Width()
+		top = chartdraw.MinInt(top, int(dy)-box.Height())
+		bottom = chartdraw.MaxInt(bottom, int(dy))
+	}
+	result.box = Box{Right: x, Bottom: bottom - top, IsSet: true}
+	return result, true
+}
+
+// measureRichTextRun measures the run including leading and trailing spaces, which raster measurements exclude as
+// they have no visible bounds.
+func (p *Painter) measureRichTextRun(text string, fontStyle FontStyle) Box {
+	trimmed := strings.Trim(text, " ")
+	box := p.measurePlainText(trimmed, 0, fontStyle)
+	if spaces := len(text) - len(trimmed); spaces > 0 {
+		spaceWidth := p.measurePlainText("a a", 0, fontStyle).Width() - p.measurePlainText("aa", 0, fontStyle).Width()
+		if trimmed == "" {
+			box = p.measurePlainText("a", 0, fontStyle)
+			box.Right = 0
+		}
+		box.Right += spaces * spaceWidth
+	}
+	return box
+}
+
+// drawRichText draws the laid out runs and symbols with the baseline starting at the position.
+func (p *Painter) drawRichText(text richText, x, y int, radians float64) {
+	sin, cos := math.Sin(radians), math.Cos(radians)
+	if r, ok := p.render.(chartdraw.TextRunsRenderer); ok {
+		r.TextRuns(text.runs, x+p.box.Left, y+p.box.Top)
+	} else {
+		// draw each run as separate text, the rotation set on the renderer turns each run around its own position
+		for _, run := range text.runs {
+			p.render.SetFont(run.Font)
+			p.render.SetFontSize(run.FontSize)
+			p.render.SetFontColor(run.FontColor)
+			p.render.Text(run.Text, x+p.box.Left+roundFloatToInt(run.DX*cos-run.DY*sin),
+				y+p.box.Top+roundFloatToInt(run.DX*sin+run.DY*cos))
+		}
+	}
+	for _, symbol := range text.symbols {
+		points := make([]PointF, len(symbol.points))
+		for i, pt := range symbol.points {
+			points[i] = PointF{X: float64(x) + pt.X*cos - pt.Y*sin, Y: float64(y) + pt.X*sin + pt.Y*cos}
+		}
+		p.FillAreaF(points, symbol.color)
+	}
+}
@@ -0,0 +1,167 @@
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRichText(t *testing.T) {
+	t.Parallel()
+
+	base := FontStyle{FontSize: 10, FontColor: ColorBlack, Font: GetDefaultFont()}
+	texts := func(runs []richTextRun) []string {
+		result := make([]string, len(runs))
+		for i, run := range runs {
+			result[i] = run.text + run.symbol
+		}
+		return result
+	}
+
+	t.Run("plain", func(t *testing.T) {
+		for _, text := range []string{"", "plain", "{}", "{a}", "{unknown|text}", "{size=-1|x}"} {
+			_, ok := parseRichText(text, base, nil)
+			assert.False(t, ok, text)
+		}
+	})
+	t.Run("tags", func(t *testing.T) {
+		runs, ok := parseRichText("a {b|bold} {color=red,size=14|big}", base, nil)
+		require.True(t, ok)
+		assert.Equal(t, []string{"a ", "bold", " ", "big"}, texts(runs))
+		assert.Equal(t, GetFont(FontFamilyNotoSansBold), runs[1].style.Font)
+		assert.Equal(t, base, runs[2].style.FontStyle)
+		assert.Equal(t, ColorRed, runs[3].style.FontColor)
+		assert.InDelta(t, 14.0, runs[3].style.FontSize, 0)
+	})
+	t.Run("nested", func(t *testing.T) {
+		runs, ok := parseRichText("{b|x{sup|2}} y", base, nil)
+		require.True(t, ok)
+		assert.Equal(t, []string{"x", "2", " y"}, texts(runs))
+		assert.Equal(t, GetFont(FontFamilyNotoSansBold), runs[1].style.Font)
+		assert.InDelta(t, 7.0, runs[1].style.FontSize, 0.001)
+		assert.InDelta(t, 4.0, runs[1].style.rise, 0.001)
+		assert.InDelta(t, 0.0, runs[2].style.rise, 0)
+	})
+	t.Run("bold_variant", func(t *testing.T) {
+		require.NoError(t, InstallFont("richtext-test", getTestFontData(t)))
+		require.NoError(t, InstallFont("richtext-test-bold", getTestFontData(t)))
+		font := GetFont("richtext-test")
+		boldFont := GetFont("richtext-test-bold")
+		require.NotSame(t, font, boldFont)
+
+		runs, ok := parseRichText("{b|x}", FontStyle{FontSize: 10, Font: font}, nil)
+		require.True(t, ok)
+		assert.Same(t, boldFont, runs[0].style.Font)
+		runs, ok = parseRichText("{b|x}", FontStyle{FontSize: 10, Font: boldFont}, nil)
+		require.True(t, ok)
+		assert.Same(t, boldFont, runs[0].style.Font)
+		runs, ok = parseRichText("{b|x}", FontStyle{FontSize: 10, Font: GetFont(FontFamilyNotoSans)}, nil)
+		require.True(t, ok)
+		assert.Same(t, GetFont(FontFamilyNotoSansBold), runs[0].style.Font)
+	})
+	t.Run("sub", func(t *testing.T) {
+		runs, ok := parseRichText("H{sub|2}O", base, nil)
+		require.True(t, ok)
+		assert.Equal(t, []string{"H", "2", "O"}, texts(runs))
+		assert.InDelta(t, -2.0, runs[1].style.rise, 0.001)
+	})
+	t.Run("unclosed", func(t *testing.T) {
+		runs, ok := parseRichText("{b|open", base, nil)
+		require.True(t, ok)
+		assert.Equal(t, []string{"open"}, texts(runs))
+	})
+	t.Run("escaped", func(t *testing.T) {
+		runs, ok := parseRichText(`\{b|literal\} {b|x}`, base, nil)
+		require.True(t, ok)
+		assert.Equal(t, []string{"{b|literal} ", "x"}, texts(runs))
+	})
+	t.Run("literal_braces", func(t *testing.T) {
+		runs, ok := parseRichText("{lit} {b|x}", base, nil)
+		require.True(t, ok)
+		assert.Equal(t, []string{"{lit} ", "x"}, texts(runs))
+	})
+	t.Run("symbols", func(t *testing.T) {
+		runs, ok := parseRichText("{up}1 {down}2", base, nil)
+		require.True(t, ok)
+		assert.Equal(t, []string{richTextSymbolUp, "1 ", richTextSymbolDown, "2"}, texts(runs))
+		upColor, downColor := GetDefaultTheme().GetSeriesUpDownColors(0)
+		assert.Equal(t, upColor, runs[0].style.FontColor)
+		assert.Equal(t, downColor, runs[2].style.FontColor)
+	})
+}
+
+func TestRichTextSignSymbol(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "{up}", RichTextSignSymbol(1))
+	assert.Equal(t, "{down}", RichTextSignSymbol(-1))
+	assert.Empty(t, RichTextSignSymbol(0))
+
+	text, style := LabelFormatterValueSign(0, "", 5)
+	assert.Equal(t, "{up} 5", text)
+	require.NotNil(t, style)
+	assert.True(t, *style.FontStyle.RichText)
+	text, style = LabelFormatterValueSign(0, "", 0)
+	assert.Equal(t, "0", text)
+	assert.Nil(t, style)
+}
+
+func TestPainterRichText(t *testing.T) {
+	t.Parallel()
+
+	fontStyle := FontStyle{FontSize: 12, FontColor: ColorBlack, RichText: Ptr(true)}
+	for _, format := range []string{ChartOutputSVG, ChartOutputPNG} {
+		t.Run(format, func(t *testing.T) {
+			p := NewPainter(PainterOptions{OutputFormat: format, Width: 200, Height: 100})
+			plain := p.MeasureText("Total 2", 0, fontStyle)
+			rich := p.MeasureText("{b|Total} {sup|2}", 0, fontStyle)
+			assert.Greater(t, rich.Width(), 0)
+			assert.Greater(t, rich.Height(), plain.Height()) // raised superscript
+			rotated := p.MeasureText("{b|Total} {sup|2}", DegreesToRadians(90), fontStyle)
+			assert.Equal(t, rich.Width(), rotated.Height())
+
+			symbol := p.MeasureText("{up}", 0, fontStyle)
+			assert.Greater(t, symbol.Width(), 0)
+
+			spaceWidth := p.measurePlainText("a a", 0, fontStyle).Width() - p.measurePlainText("aa", 0, fontStyle).Width()
+			assert.Equal(t, p.measurePlainText("éé", 0, fontStyle).Width()+2*spaceWidth,
+				p.measureRichTextRun(" éé ", fontStyle).Width())
+		})
+	}
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 200, Height: 100})
+	p.Text("{b|Total}: {color=red|{down}5}", 10, 50, 0, fontStyle)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	svg := string(data)
+	assert.Equal(t, 3, strings.Count(svg, "<tspan"))
+	assert.Contains(t, svg, ">Total</tspan>")
+	assert.Contains(t, svg, "<path") // the down symbol
+	assert.NotContains(t, svg, "{")
+
+	p = NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 200, Height: 100})
+	p.Text("{b|a < b} & {color=red|c}", 10, 50, 0, fontStyle)
+	data, err = p.Bytes()
+	require.NoError(t, err)
+	svg = string(data)
+	assert.Contains(t, svg, ">a &lt; b</tspan>")
+	assert.Contains(t, svg, "> &amp; </tspan>")
+	assert.NotContains(t, svg, " & ")
+}
+
+func TestPainterRichTextDisabled(t *testing.T) {
+	t.Parallel()
+
+	// markup is drawn and measured as written unless rich text is enabled
+	fontStyle := FontStyle{FontSize: 12, FontColor: ColorBlack}
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 200, Height: 100})
+	assert.Equal(t, p.measurePlainText("{b|Total}", 0, fontStyle), p.MeasureText("{b|Total}", 0, fontStyle))
+	p.Text("{b|Total}", 10, 50, 0, fontStyle)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	svg := string(data)
+	assert.NotContains(t, svg, "<tspan")
+	assert.Contains(t, svg, ">{b|Total}</text>")
+}
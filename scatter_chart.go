@@ -68,6 +68,8 @@ type ScatterChartOption struct {
 	SymbolSize float64
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
+	// LabelLayout when set places the series labels together, moving or hiding labels to avoid overlaps.
+	LabelLayout *LabelLayoutOption
 }
 
 const defaultSymbolSize = 2.0
@@ -84,15 +86,19 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 	if opt.SymbolSize > 0 {
 		symbolSize = opt.SymbolSize
 	}
-	xValues := boundaryGapAxisPositions(seriesPainter.Width(), flagIs(true, opt.XAxis.BoundaryGap),
-		chartdraw.MaxInt(getSeriesMaxDataCount(opt.SeriesList), len(opt.XAxis.Labels)))
+	xDivideCount := chartdraw.MaxInt(getSeriesMaxDataCount(opt.SeriesList), len(opt.XAxis.Labels))
+	xValues := boundaryGapAxisPositions(seriesPainter.Width(), flagIs(true, opt.XAxis.BoundaryGap), xDivideCount)
+	xValuesF := boundaryGapAxisPositionsF(seriesPainter.Width(), flagIs(true, opt.XAxis.BoundaryGap), xDivideCount)
 
 	markLinePainter := newMarkLinePainter(seriesPainter)
 	trendLinePainter := newTrendLinePainter(seriesPainter)
 	rendererList := []renderer{markLinePainter, trendLinePainter}
 
 	seriesNames := opt.SeriesList.names()
-	var points []Point
+	var points []PointF
+	// pointValues and pointIndexes record the value and x index for each point, for interactive output metadata
+	var pointValues []float64
+	var pointIndexes []int
 	for index, series := range opt.SeriesList {
 		seriesSymbol := series.Symbol
 		if seriesSymbol == "" {
@@ -111,10 +117,12 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 		}
 
 		if points == nil {
-			points = make([]Point, 0, len(series.Values))
+			points = make([]PointF, 0, len(series.Values))
 		} else {
 			points = points[:0]
 		}
+		pointValues = pointValues[:0]
+		pointIndexes = pointIndexes[:0]
 		for i, sampleValues := range series.Values {
 			allNull := true
 			for _, item := range sampleValues {
@@ -122,11 +130,13 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 					continue
 				}
 				allNull = false
-				p := Point{
-					X: xValues[i],
-					Y: yRange.getRestHeight(item),
+				p := PointF{
+					X: xValuesF[i],
+					Y: yRange.getRestHeightF(item),
 				}
 				points = append(points, p)
+				pointValues = append(pointValues, item)
+				pointIndexes = append(pointIndexes, i)
 
 				if series.Label.FontStyle.Font == nil {
 					series.Label.FontStyle.Font = opt.Font
@@ -135,27 +145,46 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 					labelPainter.Add(labelValue{
 						index: index,
 						value: item,
-						x:     p.X,
-						y:     p.Y,
+						x:     int(p.X),
+						y:     int(p.Y),
 					})
 				}
 			}
 			if allNull {
-				points = append(points, Point{X: xValues[i], Y: math.MaxInt32})
+				points = append(points, PointF{X: xValuesF[i], Y: math.MaxInt32})
+				pointValues = append(pointValues, GetNullValue())
+				pointIndexes = append(pointIndexes, i)
 			}
 		}
 
 		// Draw points
-		switch seriesSymbol {
-		case SymbolCircle:
-			seriesPainter.Dots(points, opt.Theme.GetBackgroundColor(), seriesColor, 1.0, symbolSize)
-		case SymbolSquare:
-			seriesPainter.squares(points, seriesColor, seriesColor, 1.0, ceilFloatToInt(symbolSize*2.0))
-		case SymbolDiamond:
-			seriesPainter.diamonds(points, seriesColor, seriesColor, 1.0, ceilFloatToInt(symbolSize*2.8))
-		default:
-			seriesPainter.Dots(points, seriesColor, seriesColor, 1.0, symbolSize)
+		drawPoints := func(points []PointF) {
+			switch seriesSymbol {
+			case SymbolCircle:
+				seriesPainter.DotsF(points, opt.Theme.GetBackgroundColor(), seriesColor, 1.0, symbolSize)
+			case SymbolSquare:
+				seriesPainter.squares(points, seriesColor, seriesColor, 1.0, symbolSize*2.0)
+			case SymbolDiamond:
+				seriesPainter.diamonds(points, seriesColor, seriesColor, 1.0, symbolSize*2.8)
+			default:
+				seriesPainter.DotsF(points, seriesColor, seriesColor, 1.0, symbolSize)
+			}
 		}
+		seriesPainter.startSeriesGroup(series.Name)
+		if seriesPainter.isGrouped() {
+			valueFormatter := getPreferredValueFormatter(series.Label.ValueFormatter, opt.ValueFormatter)
+			for i, value := range pointValues {
+				if value == GetNullValue() {
+					continue
+				}
+				seriesPainter.startValueGroup(series.Name, labelAt(opt.XAxis.Labels, pointIndexes[i]), value, valueFormatter)
+				drawPoints(points[i : i+1])
+				seriesPainter.endGroup()
+			}
+		} else {
+			drawPoints(points)
+		}
+		seriesPainter.endGroup()
 
 		if len(series.MarkLine.Lines) > 0 {
 			markLinePainter.add(markLineRenderOption{
@@ -191,9 +220,17 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 func (s *scatterChart) Render() (Box, error) {
 	p := s.p
 	opt := s.opt
+	if opt.LabelLayout != nil {
+		p = p.Child(painterLabelLayoutOption(*opt.LabelLayout))
+	}
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	if len(opt.Legend.HiddenSeries) != 0 {
+		var visible []int
+		visible, opt.Theme = opt.Legend.hideSeries(opt.SeriesList, opt.Theme, opt.ValueFormatter)
+		opt.SeriesList = selectSeriesIndexes(opt.SeriesList, visible)
+	}
 	// boundary gap default must be set here as it's used by the x-axis as well
 	if opt.XAxis.BoundaryGap == nil {
 		opt.XAxis.BoundaryGap = Ptr(false)
@@ -219,5 +256,9 @@ func (s *scatterChart) Render() (Box, error) {
 	if err != nil {
 		return BoxZero, err
 	}
-	return s.renderChart(renderResult)
+	box, err := s.renderChart(renderResult)
+	if err == nil && opt.LabelLayout != nil {
+		p.labelLayout.render()
+	}
+	return box, err
 }
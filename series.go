@@ -196,6 +196,21 @@ type LineSeries struct {
 	TrendLine []SeriesTrendLine
 	// Symbol specifies a custom symbol for the series.
 	Symbol Symbol // TODO - v0.6 - consider combining symbol with size into a SymbolStyle struct
+	// StepMode overrides the chart StepMode for this series: LineStepBefore, LineStepAfter, or LineStepMiddle.
+	StepMode string
+	// LineStrokeWidth overrides the chart LineStrokeWidth for this series.
+	LineStrokeWidth float64
+	// StrokeDashArray when set renders the line dashed, alternating the dash and gap lengths provided.
+	StrokeDashArray []float64
+	// LineOpacity is the opacity/alpha (0-255) of the line. Zero (default) renders the line fully opaque.
+	LineOpacity uint8
+	// FillArea overrides the chart FillArea for this series, when set to *true the area below the line is filled.
+	FillArea *bool
+	// FillOpacity overrides the chart FillOpacity (0-255) of the area fill for this series.
+	FillOpacity uint8
+	// FillGradient overrides the chart FillGradient for this series, when set to *true the area fill fades
+	// vertically from the series color to transparent.
+	FillGradient *bool
 
 	// absThemeIndex represents the series index when combined with other chart types.
 	absThemeIndex *int
@@ -441,6 +456,9 @@ type BarSeries struct {
 	// MarkLine provides a configuration for mark lines for this series. When using a MarkLine, you will want to
 	// configure padding to the chart on the right for the values.
 	MarkLine SeriesMarkLine
+	// FillGradient when set to *true fills the bars with a vertical gradient, fading from the series color at the
+	// top of the bar to transparent at the bottom.
+	FillGradient *bool
 
 	// absThemeIndex represents the series index when combined with other chart types.
 	absThemeIndex *int
@@ -969,6 +987,12 @@ type RadarSeries struct {
 	Label SeriesLabel
 	// Name specifies a name for the series.
 	Name string
+	// Symbol overrides the chart Symbol drawn at each vertex of this series.
+	Symbol Symbol
+	// FillArea overrides the chart FillArea for this series, when set to *false the series area is not filled.
+	FillArea *bool
+	// FillOpacity overrides the chart FillOpacity (0-255) of the area fill for this series.
+	FillOpacity uint8
 }
 
 func (r *RadarSeries) getYAxisIndex() int {
@@ -1633,6 +1657,8 @@ type OHLCData struct {
 	Low float64
 	// Close is the closing price for the time period.
 	Close float64
+	// Volume is the optional traded volume for the time period, used by volume weighted indicators.
+	Volume float64
 }
 
 const (
@@ -1963,6 +1989,24 @@ func (k *CandlestickSeries) ExtractLowPrices() []float64 {
 	return result
 }
 
+// ExtractVolumes extracts volumes from OHLC data. Nil is returned if no volume data is set.
+func (k *CandlestickSeries) ExtractVolumes() []float64 {
+	var hasVolume bool
+	result := make([]float64, len(k.Data))
+	for i, ohlc := range k.Data {
+		if validateOHLCHighLow(ohlc) {
+			result[i] = ohlc.Volume
+			hasVolume = hasVolume || ohlc.Volume != 0
+		} else {
+			result[i] = GetNullValue()
+		}
+	}
+	if !hasVolume {
+		return nil
+	}
+	return result
+}
+
 // AggregateCandlestick aggregates OHLC data by the specified factor.
 func AggregateCandlestick(data CandlestickSeries, factor int) CandlestickSeries {
 	if factor <= 1 {
@@ -1981,6 +2025,7 @@ func AggregateCandlestick(data CandlestickSeries, factor int) CandlestickSeries
 		close := data.Data[end-1].Close // Last close
 		high := data.Data[i].High       // Find max high
 		low := data.Data[i].Low         // Find min low
+		var volume float64              // Total volume
 
 		for j := i; j < end; j++ {
 			if data.Data[j].High > high {
@@ -1989,13 +2034,15 @@ func AggregateCandlestick(data CandlestickSeries, factor int) CandlestickSeries
 			if data.Data[j].Low < low {
 				low = data.Data[j].Low
 			}
+			volume += data.Data[j].Volume
 		}
 
 		aggregated = append(aggregated, OHLCData{
-			Open:  open,
-			High:  high,
-			Low:   low,
-			Close: close,
+			Open:   open,
+			High:   high,
+			Low:    low,
+			Close:  close,
+			Volume: volume,
 		})
 	}
 
@@ -259,6 +259,17 @@ type labelRenderValue struct {
 	cornerRadius    int
 	borderColor     Color
 	borderWidth     float64
+	// fields below are used to reposition the label when placed by a labelLayout
+	value        float64
+	width        int
+	height       int
+	textWidth    int // unrotated size of the text, used to find the area covered by rotated labels
+	textHeight   int
+	anchorX      int
+	anchorY      int
+	vertical     bool
+	distance     int
+	rightPadding int
 }
 
 type labelValue struct {
@@ -336,14 +347,14 @@ func (o *seriesLabelPainter) Add(value labelValue) {
 
 	// Measure text accounting for potential multi-line content
 	lines := splitLabelText(text)
-	var textBox Box
-	if len(lines) <= 1 {
-		textBox = o.p.MeasureText(text, value.radians, labelFontStyle)
-	} else {
+	measure := func(radians float64) Box {
+		if len(lines) <= 1 {
+			return o.p.MeasureText(text, radians, labelFontStyle)
+		}
 		// For multi-line text, calculate total dimensions
 		var maxWidth, totalHeight int
 		for _, line := range lines {
-			lineBox := o.p.MeasureText(line, value.radians, labelFontStyle)
+			lineBox := o.p.MeasureText(line, radians, labelFontStyle)
 			w := lineBox.Width()
 			h := lineBox.Height()
 			if w > maxWidth {
@@ -351,7 +362,12 @@ func (o *seriesLabelPainter) Add(value labelValue) {
 			}
 			totalHeight += h
 		}
-		textBox = Box{Left: 0, Top: 0, Right: maxWidth, Bottom: totalHeight, IsSet: true}
+		return Box{Left: 0, Top: 0, Right: maxWidth, Bottom: totalHeight, IsSet: true}
+	}
+	textBox := measure(value.radians)
+	unrotatedBox := textBox
+	if value.radians != 0 {
+		unrotatedBox = measure(0)
 	}
 	renderValue := labelRenderValue{
 		text:      text,
@@ -359,6 +375,17 @@ func (o *seriesLabelPainter) Add(value labelValue) {
 		x:         value.x,
 		y:         value.y,
 		radians:   value.radians,
+
+		value:        value.value,
+		width:        textBox.Width(),
+		height:       textBox.Height(),
+		textWidth:    unrotatedBox.Width(),
+		textHeight:   unrotatedBox.Height(),
+		anchorX:      value.x,
+		anchorY:      value.y,
+		vertical:     value.vertical,
+		distance:     distance,
+		rightPadding: o.rightPadding,
 	}
 
 	// Set background color, corner radius, and border styling if specified
@@ -480,6 +507,11 @@ func drawLabelWithBackground(p *Painter, text string, x, y int, radians float64,
 }
 
 func (o *seriesLabelPainter) Render() (Box, error) {
+	if o.p.labelLayout != nil {
+		// labels are drawn once all series have been added to the layout
+		o.p.labelLayout.add(o.p, o.values)
+		return BoxZero, nil
+	}
 	for _, item := range o.values {
 		if item.text != "" {
 			drawLabelWithBackground(o.p, item.text, item.x, item.y, item.radians,
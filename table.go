@@ -13,6 +13,13 @@ func TableOptionRenderDirect(opt TableChartOption) (*Painter, error) {
 	if opt.OutputFormat == "" {
 		opt.OutputFormat = chartDefaultOutputFormat
 	}
+	if opt.AutoSize != nil {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 1, Height: 1, Font: opt.FontStyle.Font})
+		opt.Width = chartdraw.MaxInt(opt.Width, tableAutoWidth(p, opt))
+		if opt.AutoSize.MaxWidth > 0 {
+			opt.Width = chartdraw.MinInt(opt.Width, opt.AutoSize.MaxWidth)
+		}
+	}
 	if opt.Width <= 0 {
 		opt.Width = defaultChartWidth
 	}
@@ -90,7 +97,7 @@ type TableCell struct {
 
 // TableChartOption defines options for rendering a table chart.
 type TableChartOption struct {
-	// OutputFormat specifies the output type: "svg", "png", "jpg".
+	// OutputFormat specifies the output type: "svg", "png", "jpg", "pdf", "terminal".
 	OutputFormat string
 	// Theme specifies the colors used for the table.
 	Theme ColorPalette
@@ -98,6 +105,10 @@ type TableChartOption struct {
 	Padding Box
 	// Width specifies the width of the table.
 	Width int
+	// AutoSize when set computes the table width from the cell text so that it's not wrapped, with Width as the
+	// minimum width and limited by the MaxWidth. Only used by TableOptionRenderDirect, which always sizes the table
+	// height to fit the rows.
+	AutoSize *AutoSizeOption
 	// Header provides header data for the top of the table.
 	Header []string
 	// Data provides the row and column data for the table.
@@ -382,8 +393,11 @@ func (t *tableChart) Render() (Box, error) {
 
 	r := p.render
 	fn := chartdraw.PNG
-	if p.outputFormat == ChartOutputSVG {
+	switch p.outputFormat {
+	case ChartOutputSVG:
 		fn = chartdraw.SVG
+	case ChartOutputPDF:
+		fn = chartdraw.PDF
 	}
 	p.render = fn(p.Width(), 100)
 	info, err := t.render()
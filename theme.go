@@ -1021,6 +1021,7 @@ func (t *themeColorPalette) WithSeriesColors(colors []Color) ColorPalette {
 	}
 	copy.name += "-series_mod"
 	copy.seriesColors = colors
+	copy.seriesTrendColors = append([]Color(nil), t.seriesTrendColors...) // avoid modifying the original palette
 	for i, c := range colors {
 		trendColor := autoSeriesTrendColor(c)
 		if i < len(copy.seriesTrendColors) {
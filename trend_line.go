@@ -21,15 +21,74 @@ const (
 	SeriesTrendTypeSMA = "sma"
 	// SeriesTrendTypeEMA represents an Exponential Moving Average trend line that gives more weight to recent data points.
 	SeriesTrendTypeEMA = "ema"
-	// SeriesTrendTypeBollingerUpper represents the upper Bollinger Band (SMA + 2 * standard deviation).
+	// SeriesTrendTypeBollingerBand represents the full Bollinger Band envelope, rendering the middle SMA with the upper
+	// and lower bands (SMA ± Multiplier * standard deviation). The envelope between the bands is filled by default.
+	// Designed for financial time-series analysis to identify volatility boundaries around price movements.
+	SeriesTrendTypeBollingerBand = "bollinger"
+	// SeriesTrendTypeBollingerUpper represents the upper Bollinger Band (SMA + Multiplier * standard deviation).
 	// Designed for financial time-series analysis to identify volatility boundaries around price movements.
 	SeriesTrendTypeBollingerUpper = "bollinger_upper"
-	// SeriesTrendTypeBollingerLower represents the lower Bollinger Band (SMA - 2 * standard deviation).
+	// SeriesTrendTypeBollingerLower represents the lower Bollinger Band (SMA - Multiplier * standard deviation).
 	// Designed for financial time-series analysis to identify volatility boundaries around price movements.
 	SeriesTrendTypeBollingerLower = "bollinger_lower"
 	// SeriesTrendTypeRSI represents the Relative Strength Index momentum oscillator (0-100 scale).
 	// Measures momentum by analyzing sequential price changes, designed for financial time-series analysis.
 	SeriesTrendTypeRSI = "rsi"
+	// SeriesTrendTypeWMA represents a Weighted Moving Average trend line that linearly weights recent data points higher.
+	SeriesTrendTypeWMA = "wma"
+	// SeriesTrendTypeVWAP represents the Volume Weighted Average Price. Requires high, low and volume data, so is only
+	// supported on candlestick series with OHLCData.Volume set. A Period of zero computes a cumulative VWAP, otherwise a rolling VWAP over the period is used.
+	SeriesTrendTypeVWAP = "vwap"
+	// SeriesTrendTypeATR represents the Average True Range volatility indicator using Wilder's smoothing.
+	// Requires high and low data, so is only supported on candlestick series.
+	SeriesTrendTypeATR = "atr"
+	// SeriesTrendTypeKeltner represents both Keltner Channel bounds (EMA ± Multiplier * ATR), optionally filled.
+	// Requires high and low data for the ATR, so is only supported on candlestick series.
+	SeriesTrendTypeKeltner = "keltner"
+	// SeriesTrendTypeKeltnerUpper represents the upper Keltner Channel bound (EMA + Multiplier * ATR).
+	SeriesTrendTypeKeltnerUpper = "keltner_upper"
+	// SeriesTrendTypeKeltnerLower represents the lower Keltner Channel bound (EMA - Multiplier * ATR).
+	SeriesTrendTypeKeltnerLower = "keltner_lower"
+	// SeriesTrendTypeDonchian represents both Donchian Channel bounds (highest high and lowest low over the period),
+	// optionally filled.
+	SeriesTrendTypeDonchian = "donchian"
+	// SeriesTrendTypeDonchianUpper represents the upper Donchian Channel bound (highest high over the period).
+	SeriesTrendTypeDonchianUpper = "donchian_upper"
+	// SeriesTrendTypeDonchianLower represents the lower Donchian Channel bound (lowest low over the period).
+	SeriesTrendTypeDonchianLower = "donchian_lower"
+	// SeriesTrendTypeParabolicSAR represents the Parabolic Stop and Reverse indicator, rendered as dots.
+	// Configured with AccelerationStep and AccelerationMax.
+	SeriesTrendTypeParabolicSAR = "psar"
+	// SeriesTrendTypeIchimokuCloud represents the Ichimoku cloud (Senkou Span A and B), filled by default.
+	// Period sets the conversion line period (default 9), SlowPeriod the base line period and displacement (default 26).
+	SeriesTrendTypeIchimokuCloud = "ichimoku_cloud"
+	// SeriesTrendTypeIchimokuConversion represents the Ichimoku conversion line (Tenkan-sen).
+	SeriesTrendTypeIchimokuConversion = "ichimoku_conversion"
+	// SeriesTrendTypeIchimokuBase represents the Ichimoku base line (Kijun-sen).
+	SeriesTrendTypeIchimokuBase = "ichimoku_base"
+	// SeriesTrendTypeIchimokuSpanA represents the Ichimoku leading span A (Senkou Span A).
+	SeriesTrendTypeIchimokuSpanA = "ichimoku_span_a"
+	// SeriesTrendTypeIchimokuSpanB represents the Ichimoku leading span B (Senkou Span B).
+	SeriesTrendTypeIchimokuSpanB = "ichimoku_span_b"
+	// SeriesTrendTypeMACD represents the Moving Average Convergence Divergence line (fast EMA - slow EMA).
+	// Period sets the fast period (default 12) and SlowPeriod the slow period (default 26).
+	// Values oscillate around zero, so consider rendering on a separate y-axis.
+	SeriesTrendTypeMACD = "macd"
+	// SeriesTrendTypeMACDSignal represents the MACD signal line, an EMA of the MACD line over SignalPeriod (default 9).
+	SeriesTrendTypeMACDSignal = "macd_signal"
+)
+
+const (
+	defaultMACDFastPeriod      = 12
+	defaultMACDSlowPeriod      = 26
+	defaultMACDSignalPeriod    = 9
+	defaultIchimokuConversion  = 9
+	defaultIchimokuBase        = 26
+	defaultKeltnerMultiplier   = 2.0
+	defaultBollingerMultiplier = 2.0
+	defaultSARAccelerationStep = 0.02
+	defaultSARAccelerationMax  = 0.2
+	defaultTrendFillOpacity    = 40
 )
 
 // SeriesTrendLine describes the rendered trend line style.
@@ -42,14 +101,30 @@ type SeriesTrendLine struct {
 	LineColor Color
 	// DashedLine indicates if the trend line will be a dashed line. Default depends on chart type.
 	DashedLine *bool
-	// Type specifies the trend line type: "linear", "cubic", "sma", "ema", "rsi".
+	// Type specifies the trend line type, one of the SeriesTrendType constants (for example "linear", "sma", "macd").
 	Type string
 	// Deprecated: Window is deprecated, use Period instead.
 	Window int
 	// Period specifies the number of data points to consider for trend calculations.
-	// Used by moving averages (SMA, EMA), Bollinger Bands, RSI, and other indicators.
+	// Used by moving averages (SMA, EMA, WMA), Bollinger Bands, RSI, ATR, channels, and other indicators.
 	// For example, Period=20 calculates a 20-period moving average.
 	Period int
+	// SlowPeriod specifies the slow period for MACD (default 26) and the base line period for Ichimoku (default 26).
+	SlowPeriod int
+	// SignalPeriod specifies the MACD signal line period (default 9).
+	SignalPeriod int
+	// Multiplier scales the channel width. For Bollinger Bands it is the standard deviation multiple (default 2), for
+	// Keltner Channels it is the ATR multiple (default 2).
+	Multiplier float64
+	// AccelerationStep is the Parabolic SAR acceleration factor increment (default 0.02).
+	AccelerationStep float64
+	// AccelerationMax is the Parabolic SAR maximum acceleration factor (default 0.2).
+	AccelerationMax float64
+	// FillArea when set to *true fills between the bounds of band and channel types (Bollinger, Keltner, Donchian,
+	// Ichimoku cloud). Defaults to filled for the Bollinger Band envelope and the Ichimoku cloud.
+	FillArea *bool
+	// FillOpacity is the opacity/alpha (0-255) of the band fill.
+	FillOpacity uint8
 }
 
 // NewTrendLine returns a trend line for the provided type. Set on a specific Series instance.
@@ -86,6 +161,11 @@ type trendLineRenderOption struct {
 	xValues []int
 	// seriesValues are the raw data values.
 	seriesValues []float64
+	// highValues and lowValues optionally provide the period range for indicators such as ATR or Donchian Channels.
+	// When not set the seriesValues are used.
+	highValues, lowValues []float64
+	// volumeValues optionally provide the period volume, required for VWAP.
+	volumeValues []float64
 	// axisRange is used to transform a raw data value into a screen y-coordinate.
 	axisRange axisRange
 	// trends are the list of trend lines to render for this series.
@@ -106,30 +186,9 @@ func (t *trendLinePainter) Render() (Box, error) {
 			if trend.Window != 0 && trend.Period == 0 {
 				trend.Period = trend.Window
 			}
-			var fitted []float64
-			var err error
-			switch trend.Type {
-			case SeriesTrendTypeLinear:
-				fitted, err = linearTrend(opt.seriesValues)
-			case SeriesTrendTypeCubic:
-				fitted, err = cubicTrend(opt.seriesValues)
-			case SeriesTrendTypeSMA, "average" /* long term backwards compatibility */ :
-				fitted, err = movingAverageTrend(opt.seriesValues, trend.Period)
-			case SeriesTrendTypeEMA:
-				fitted, err = exponentialMovingAverageTrend(opt.seriesValues, trend.Period)
-			case SeriesTrendTypeBollingerUpper:
-				fitted, err = bollingerUpperTrend(opt.seriesValues, trend.Period)
-			case SeriesTrendTypeBollingerLower:
-				fitted, err = bollingerLowerTrend(opt.seriesValues, trend.Period)
-			case SeriesTrendTypeRSI:
-				fitted, err = rsiTrend(opt.seriesValues, trend.Period)
-			default:
-				err = errors.New("unknown trend type: " + trend.Type)
-			}
+			lines, defaultFill, err := computeTrendLines(trend, opt)
 			if err != nil {
 				return BoxZero, err
-			} else if len(fitted) != len(opt.xValues) {
-				return BoxZero, errors.New("mismatched data length in trend line computation")
 			}
 
 			color := trend.LineColor
@@ -142,13 +201,42 @@ func (t *trendLinePainter) Render() (Box, error) {
 			}
 
 			// Convert fitted data to screen points, break where fitted is null.
-			points := make([]Point, len(fitted))
-			for i, val := range fitted {
-				if val == GetNullValue() {
-					points[i] = Point{X: opt.xValues[i], Y: math.MaxInt32}
-				} else {
-					points[i] = Point{X: opt.xValues[i], Y: opt.axisRange.getRestHeight(val)}
+			linePoints := make([][]Point, len(lines))
+			for i, fitted := range lines {
+				if len(fitted) != len(opt.xValues) {
+					return BoxZero, errors.New("mismatched data length in trend line computation")
+				}
+				points := make([]Point, len(fitted))
+				for j, val := range fitted {
+					if val == GetNullValue() {
+						points[j] = Point{X: opt.xValues[j], Y: math.MaxInt32}
+					} else {
+						points[j] = Point{X: opt.xValues[j], Y: opt.axisRange.getRestHeight(val)}
+					}
+				}
+				linePoints[i] = points
+			}
+
+			if len(linePoints) >= 2 && (flagIs(true, trend.FillArea) || (trend.FillArea == nil && defaultFill)) {
+				opacity := trend.FillOpacity
+				if opacity == 0 {
+					opacity = defaultTrendFillOpacity
 				}
+				t.fillBetween(linePoints[0], linePoints[1], color.WithAlpha(opacity))
+			}
+
+			if trend.Type == SeriesTrendTypeParabolicSAR {
+				radius := strokeWidth * 1.2
+				for _, points := range linePoints {
+					dots := make([]Point, 0, len(points))
+					for _, pt := range points {
+						if pt.Y != math.MaxInt32 {
+							dots = append(dots, pt)
+						}
+					}
+					painter.Dots(dots, color, color, 1, radius)
+				}
+				continue
 			}
 
 			// Determine if this trend line should be dashed
@@ -157,22 +245,24 @@ func (t *trendLinePainter) Render() (Box, error) {
 				isDashed = *trend.DashedLine
 			}
 
-			if isDashed {
-				// Calculate dash size based on painter dimensions for better visibility
-				avgDimension := float64(t.p.box.Width()+t.p.box.Height()) / 2
-				dashLength := math.Max(avgDimension*0.02, 4.0) // Minimum 4px, scale with size
-				gapLength := dashLength * 0.8
-				dashArray := []float64{dashLength, gapLength}
-				if trend.StrokeSmoothingTension > 0 {
-					painter.SmoothDashedLineStroke(points, trend.StrokeSmoothingTension, color, strokeWidth, dashArray)
+			for _, points := range linePoints {
+				if isDashed {
+					// Calculate dash size based on painter dimensions for better visibility
+					avgDimension := float64(t.p.box.Width()+t.p.box.Height()) / 2
+					dashLength := math.Max(avgDimension*0.02, 4.0) // Minimum 4px, scale with size
+					gapLength := dashLength * 0.8
+					dashArray := []float64{dashLength, gapLength}
+					if trend.StrokeSmoothingTension > 0 {
+						painter.SmoothDashedLineStroke(points, trend.StrokeSmoothingTension, color, strokeWidth, dashArray)
+					} else {
+						painter.DashedLineStroke(points, color, strokeWidth, dashArray)
+					}
 				} else {
-					painter.DashedLineStroke(points, color, strokeWidth, dashArray)
-				}
-			} else {
-				if trend.StrokeSmoothingTension > 0 {
-					painter.SmoothLineStroke(points, trend.StrokeSmoothingTension, color, strokeWidth)
-				} else {
-					painter.LineStroke(points, color, strokeWidth)
+					if trend.StrokeSmoothingTension > 0 {
+						painter.SmoothLineStroke(points, trend.StrokeSmoothingTension, color, strokeWidth)
+					} else {
+						painter.LineStroke(points, color, strokeWidth)
+					}
 				}
 			}
 		}
@@ -180,6 +270,115 @@ func (t *trendLinePainter) Render() (Box, error) {
 	return BoxZero, nil
 }
 
+// fillBetween fills the area between two lines, only where both lines have values.
+func (t *trendLinePainter) fillBetween(upper, lower []Point, fillColor Color) {
+	start := -1
+	for i := 0; i <= len(upper); i++ {
+		if i < len(upper) && upper[i].Y != math.MaxInt32 && lower[i].Y != math.MaxInt32 {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 && i-start > 1 {
+			area := make([]Point, 0, 2*(i-start)+1)
+			area = append(area, upper[start:i]...)
+			for j := i - 1; j >= start; j-- {
+				area = append(area, lower[j])
+			}
+			area = append(area, upper[start])
+			t.p.FillArea(area, fillColor)
+		}
+		start = -1
+	}
+}
+
+// computeTrendLines computes the lines to render for the trend. Band and channel types return the upper and lower
+// bounds as the first two lines, with the returned bool indicating if the band should be filled by default.
+func computeTrendLines(trend SeriesTrendLine, opt trendLineRenderOption) ([][]float64, bool, error) {
+	var fitted []float64
+	var err error
+	switch trend.Type {
+	case SeriesTrendTypeLinear:
+		fitted, err = linearTrend(opt.seriesValues)
+	case SeriesTrendTypeCubic:
+		fitted, err = cubicTrend(opt.seriesValues)
+	case SeriesTrendTypeSMA, "average" /* long term backwards compatibility */ :
+		fitted, err = movingAverageTrend(opt.seriesValues, trend.Period)
+	case SeriesTrendTypeEMA:
+		fitted, err = exponentialMovingAverageTrend(opt.seriesValues, trend.Period)
+	case SeriesTrendTypeBollingerBand:
+		upper, middle, lower, err := bollingerBands(opt.seriesValues, trend.Period, trend.Multiplier)
+		if err != nil {
+			return nil, false, err
+		}
+		return [][]float64{upper, lower, middle}, true, nil
+	case SeriesTrendTypeBollingerUpper:
+		fitted, err = bollingerUpperTrend(opt.seriesValues, trend.Period, trend.Multiplier)
+	case SeriesTrendTypeBollingerLower:
+		fitted, err = bollingerLowerTrend(opt.seriesValues, trend.Period, trend.Multiplier)
+	case SeriesTrendTypeRSI:
+		fitted, err = rsiTrend(opt.seriesValues, trend.Period)
+	case SeriesTrendTypeWMA:
+		fitted, err = weightedMovingAverageTrend(opt.seriesValues, trend.Period)
+	case SeriesTrendTypeVWAP:
+		fitted, err = vwapTrend(newTrendInput(opt), trend.Period)
+	case SeriesTrendTypeATR:
+		fitted, err = atrTrend(newTrendInput(opt), trend.Period)
+	case SeriesTrendTypeKeltner, SeriesTrendTypeKeltnerUpper, SeriesTrendTypeKeltnerLower:
+		upper, lower, err := keltnerTrend(newTrendInput(opt), trend.Period, trend.Multiplier)
+		if err != nil {
+			return nil, false, err
+		}
+		return selectChannel(trend.Type, SeriesTrendTypeKeltnerUpper, SeriesTrendTypeKeltnerLower, upper, lower)
+	case SeriesTrendTypeDonchian, SeriesTrendTypeDonchianUpper, SeriesTrendTypeDonchianLower:
+		upper, lower := donchianTrend(newTrendInput(opt), trend.Period)
+		return selectChannel(trend.Type, SeriesTrendTypeDonchianUpper, SeriesTrendTypeDonchianLower, upper, lower)
+	case SeriesTrendTypeParabolicSAR:
+		fitted = parabolicSARTrend(newTrendInput(opt), trend.AccelerationStep, trend.AccelerationMax)
+	case SeriesTrendTypeIchimokuCloud, SeriesTrendTypeIchimokuConversion, SeriesTrendTypeIchimokuBase,
+		SeriesTrendTypeIchimokuSpanA, SeriesTrendTypeIchimokuSpanB:
+		ichimoku := ichimokuTrend(newTrendInput(opt), trend.Period, trend.SlowPeriod)
+		switch trend.Type {
+		case SeriesTrendTypeIchimokuConversion:
+			fitted = ichimoku.conversion
+		case SeriesTrendTypeIchimokuBase:
+			fitted = ichimoku.base
+		case SeriesTrendTypeIchimokuSpanA:
+			fitted = ichimoku.spanA
+		case SeriesTrendTypeIchimokuSpanB:
+			fitted = ichimoku.spanB
+		default:
+			return [][]float64{ichimoku.spanA, ichimoku.spanB}, true, nil
+		}
+	case SeriesTrendTypeMACD, SeriesTrendTypeMACDSignal:
+		macd, signal := macdTrend(opt.seriesValues, trend.Period, trend.SlowPeriod, trend.SignalPeriod)
+		if trend.Type == SeriesTrendTypeMACD {
+			fitted = macd
+		} else {
+			fitted = signal
+		}
+	default:
+		err = errors.New("unknown trend type: " + trend.Type)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return [][]float64{fitted}, false, nil
+}
+
+// selectChannel returns the channel bound matching the trend type, or both bounds for the combined channel type.
+func selectChannel(trendType, upperType, lowerType string, upper, lower []float64) ([][]float64, bool, error) {
+	switch trendType {
+	case upperType:
+		return [][]float64{upper}, false, nil
+	case lowerType:
+		return [][]float64{lower}, false, nil
+	default:
+		return [][]float64{upper, lower}, false, nil
+	}
+}
+
 // extractNonNullData extracts non-null values and their indices from the input.
 func extractNonNullData(y []float64) ([]float64, []int) {
 	cleanData := make([]float64, 0, len(y))
@@ -204,6 +403,15 @@ func initResultWithNulls(y []float64) []float64 {
 	return result
 }
 
+// nullResult creates a result array of the given length containing only null values.
+func nullResult(size int) []float64 {
+	result := make([]float64, size)
+	for i := range result {
+		result[i] = GetNullValue()
+	}
+	return result
+}
+
 // linearTrend computes a linear trend over the data, preserving null positions.
 func linearTrend(y []float64) ([]float64, error) {
 	cleanData, cleanIndices := extractNonNullData(y)
@@ -437,27 +645,30 @@ func movingAverageTrend(y []float64, window int) ([]float64, error) {
 	return result, nil
 }
 
-// bollingerBand computes a Bollinger Band (SMA ± multiplier * standard deviation).
-func bollingerBand(y []float64, period int, multiplier float64) ([]float64, error) {
+// bollingerBands computes the upper, middle (SMA), and lower Bollinger Bands (SMA ± multiplier * standard deviation),
+// preserving null positions. If the multiplier is <= 0 the default of 2 is used.
+func bollingerBands(y []float64, period int, multiplier float64) ([]float64, []float64, []float64, error) {
 	cleanData, _ := extractNonNullData(y)
 	nonNullCount := len(cleanData)
-	result := initResultWithNulls(y)
-
-	if nonNullCount < 2 {
-		return result, nil // Not enough data
+	if multiplier <= 0 {
+		multiplier = defaultBollingerMultiplier
 	}
+
 	if period <= 0 {
 		period = chartdraw.MaxInt(2, nonNullCount/5)
 	}
-	if period > nonNullCount {
-		return result, nil // Period too large
+	if nonNullCount < 2 || period > nonNullCount {
+		// Not enough data, or period too large
+		return nullResult(len(y)), nullResult(len(y)), nullResult(len(y)), nil
 	}
 
 	// Calculate SMA first (already handles nulls)
 	sma, err := movingAverageTrend(y, period)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
+	upper := initResultWithNulls(y)
+	lower := initResultWithNulls(y)
 
 	// Compute Bollinger bands with centered window
 	halfWindow := period / 2
@@ -483,21 +694,24 @@ func bollingerBand(y []float64, period int, multiplier float64) ([]float64, erro
 
 		if count > 0 {
 			stddev := math.Sqrt(variance / float64(count))
-			result[i] = mean + (stddev * multiplier)
+			upper[i] = mean + (stddev * multiplier)
+			lower[i] = mean - (stddev * multiplier)
 		}
 	}
 
-	return result, nil
+	return upper, sma, lower, nil
 }
 
-// bollingerUpperTrend computes the upper Bollinger Band (SMA + 2 * standard deviation), preserving null positions.
-func bollingerUpperTrend(y []float64, period int) ([]float64, error) {
-	return bollingerBand(y, period, 2.0)
+// bollingerUpperTrend computes the upper Bollinger Band (SMA + multiplier * standard deviation), preserving null positions.
+func bollingerUpperTrend(y []float64, period int, multiplier float64) ([]float64, error) {
+	upper, _, _, err := bollingerBands(y, period, multiplier)
+	return upper, err
 }
 
-// bollingerLowerTrend computes the lower Bollinger Band (SMA - 2 * standard deviation), preserving null positions.
-func bollingerLowerTrend(y []float64, period int) ([]float64, error) {
-	return bollingerBand(y, period, -2.0)
+// bollingerLowerTrend computes the lower Bollinger Band (SMA - multiplier * standard deviation), preserving null positions.
+func bollingerLowerTrend(y []float64, period int, multiplier float64) ([]float64, error) {
+	_, _, lower, err := bollingerBands(y, period, multiplier)
+	return lower, err
 }
 
 // rsiTrend computes the Relative Strength Index momentum oscillator, preserving null positions.
@@ -560,3 +774,330 @@ func rsiTrend(y []float64, period int) ([]float64, error) {
 
 	return result, nil
 }
+
+// trendInput holds the non-null samples used by the range and volume based indicators.
+type trendInput struct {
+	// size is the length of the original series.
+	size int
+	// indices maps each sample back to the index in the original series.
+	indices []int
+	close   []float64
+	high    []float64
+	low     []float64
+	// hasRange indicates if high and low values were provided, rather than falling back to the series value.
+	hasRange bool
+	// volume is nil if volume data was not provided.
+	volume []float64
+}
+
+// newTrendInput collects the non-null samples from the render option. High and low values fall back to the series
+// value when not provided.
+func newTrendInput(opt trendLineRenderOption) trendInput {
+	in := trendInput{
+		size:     len(opt.seriesValues),
+		hasRange: opt.highValues != nil && opt.lowValues != nil,
+	}
+	for i, v := range opt.seriesValues {
+		if v == GetNullValue() {
+			continue
+		}
+		high, low := v, v
+		if i < len(opt.highValues) && i < len(opt.lowValues) &&
+			opt.highValues[i] != GetNullValue() && opt.lowValues[i] != GetNullValue() {
+			high = math.Max(opt.highValues[i], v)
+			low = math.Min(opt.lowValues[i], v)
+		}
+		in.indices = append(in.indices, i)
+		in.close = append(in.close, v)
+		in.high = append(in.high, high)
+		in.low = append(in.low, low)
+		if opt.volumeValues != nil {
+			var volume float64
+			if i < len(opt.volumeValues) && opt.volumeValues[i] != GetNullValue() {
+				volume = opt.volumeValues[i]
+			}
+			in.volume = append(in.volume, volume)
+		}
+	}
+	return in
+}
+
+// defaultPeriod returns the period, or a default based on the data size if the period is not set.
+func (in trendInput) defaultPeriod(period int) int {
+	if period <= 0 {
+		return chartdraw.MaxInt(2, len(in.close)/5)
+	}
+	return period
+}
+
+// expand maps values computed from the samples back to the original series positions, with nulls elsewhere.
+func (in trendInput) expand(values []float64) []float64 {
+	result := nullResult(in.size)
+	for i, idx := range in.indices {
+		result[idx] = values[i]
+	}
+	return result
+}
+
+// emaValues computes an exponential moving average seeded with the first value. Input must not contain nulls.
+func emaValues(data []float64, period int) []float64 {
+	result := make([]float64, len(data))
+	if len(data) == 0 {
+		return result
+	}
+	multiplier := 2.0 / (float64(period) + 1.0)
+	result[0] = data[0]
+	for i := 1; i < len(data); i++ {
+		result[i] = (data[i] * multiplier) + (result[i-1] * (1 - multiplier))
+	}
+	return result
+}
+
+// weightedMovingAverageTrend computes a trailing linearly weighted moving average, preserving null positions.
+// Leading values use the available samples until the full period is reached.
+func weightedMovingAverageTrend(y []float64, period int) ([]float64, error) {
+	cleanData, cleanIndices := extractNonNullData(y)
+	result := initResultWithNulls(y)
+	if len(cleanData) == 0 {
+		return result, nil
+	}
+	if period <= 0 {
+		period = chartdraw.MaxInt(2, len(cleanData)/5)
+	}
+
+	for i := range cleanData {
+		start := chartdraw.MaxInt(0, i-period+1)
+		var sum, weightSum float64
+		for j := start; j <= i; j++ {
+			weight := float64(j - start + 1)
+			sum += cleanData[j] * weight
+			weightSum += weight
+		}
+		result[cleanIndices[i]] = sum / weightSum
+	}
+	return result, nil
+}
+
+// vwapTrend computes the volume weighted average price using the typical price (high + low + close) / 3.
+// A period of zero or less computes a cumulative VWAP, otherwise a rolling VWAP is computed.
+func vwapTrend(in trendInput, period int) ([]float64, error) {
+	if !in.hasRange {
+		return nil, errors.New("vwap trend requires high and low data")
+	} else if in.volume == nil {
+		return nil, errors.New("vwap trend requires volume data")
+	}
+
+	values := make([]float64, len(in.close))
+	var priceVolumeSum, volumeSum float64
+	for i := range in.close {
+		priceVolumeSum += (in.high[i] + in.low[i] + in.close[i]) / 3 * in.volume[i]
+		volumeSum += in.volume[i]
+		if period > 0 && i >= period {
+			j := i - period
+			priceVolumeSum -= (in.high[j] + in.low[j] + in.close[j]) / 3 * in.volume[j]
+			volumeSum -= in.volume[j]
+		}
+		if volumeSum <= 0 {
+			values[i] = GetNullValue()
+		} else {
+			values[i] = priceVolumeSum / volumeSum
+		}
+	}
+	return in.expand(values), nil
+}
+
+// atrValues computes the average true range over the samples using Wilder's smoothing. Leading values average the
+// available true ranges until the full period is reached.
+func atrValues(in trendInput, period int) []float64 {
+	values := make([]float64, len(in.close))
+	var atr float64
+	for i := range in.close {
+		trueRange := in.high[i] - in.low[i]
+		if i > 0 {
+			prevClose := in.close[i-1]
+			trueRange = math.Max(trueRange, math.Max(math.Abs(in.high[i]-prevClose), math.Abs(in.low[i]-prevClose)))
+		}
+		if i < period {
+			atr = (atr*float64(i) + trueRange) / float64(i+1)
+		} else {
+			atr = (atr*float64(period-1) + trueRange) / float64(period)
+		}
+		values[i] = atr
+	}
+	return values
+}
+
+// atrTrend computes the Average True Range, preserving null positions.
+func atrTrend(in trendInput, period int) ([]float64, error) {
+	if !in.hasRange {
+		return nil, errors.New("atr trend requires high and low data")
+	}
+	return in.expand(atrValues(in, in.defaultPeriod(period))), nil
+}
+
+// keltnerTrend computes the upper and lower Keltner Channel bounds (EMA ± multiplier * ATR).
+func keltnerTrend(in trendInput, period int, multiplier float64) ([]float64, []float64, error) {
+	if !in.hasRange {
+		return nil, nil, errors.New("keltner trend requires high and low data")
+	}
+	period = in.defaultPeriod(period)
+	if multiplier <= 0 {
+		multiplier = defaultKeltnerMultiplier
+	}
+	middle := emaValues(in.close, period)
+	atr := atrValues(in, period)
+	upper := make([]float64, len(middle))
+	lower := make([]float64, len(middle))
+	for i := range middle {
+		upper[i] = middle[i] + multiplier*atr[i]
+		lower[i] = middle[i] - multiplier*atr[i]
+	}
+	return in.expand(upper), in.expand(lower), nil
+}
+
+// donchianTrend computes the upper (highest high) and lower (lowest low) Donchian Channel bounds over the trailing
+// period.
+func donchianTrend(in trendInput, period int) ([]float64, []float64) {
+	period = in.defaultPeriod(period)
+	upper := make([]float64, len(in.close))
+	lower := make([]float64, len(in.close))
+	for i := range in.close {
+		upper[i], lower[i] = in.rangeExtremes(i, period)
+	}
+	return in.expand(upper), in.expand(lower)
+}
+
+// rangeExtremes returns the highest high and lowest low for the period ending at the sample index.
+func (in trendInput) rangeExtremes(index, period int) (float64, float64) {
+	high, low := in.high[index], in.low[index]
+	for j := chartdraw.MaxInt(0, index-period+1); j < index; j++ {
+		high = math.Max(high, in.high[j])
+		low = math.Min(low, in.low[j])
+	}
+	return high, low
+}
+
+// parabolicSARTrend computes the Parabolic Stop and Reverse values, preserving null positions.
+func parabolicSARTrend(in trendInput, step, maxAcceleration float64) []float64 {
+	if step <= 0 {
+		step = defaultSARAccelerationStep
+	}
+	if maxAcceleration <= 0 {
+		maxAcceleration = defaultSARAccelerationMax
+	}
+	n := len(in.close)
+	if n < 2 {
+		return nullResult(in.size)
+	}
+	values := make([]float64, n)
+
+	rising := in.close[1] >= in.close[0]
+	sar, extreme := in.low[0], in.high[0]
+	if !rising {
+		sar, extreme = in.high[0], in.low[0]
+	}
+	acceleration := step
+	values[0] = sar
+	for i := 1; i < n; i++ {
+		sar += acceleration * (extreme - sar)
+		if rising {
+			// SAR can not be above the prior two lows
+			sar = math.Min(sar, in.low[i-1])
+			if i > 1 {
+				sar = math.Min(sar, in.low[i-2])
+			}
+			if in.low[i] < sar { // reversal
+				rising = false
+				sar, extreme, acceleration = extreme, in.low[i], step
+			} else if in.high[i] > extreme {
+				extreme = in.high[i]
+				acceleration = math.Min(acceleration+step, maxAcceleration)
+			}
+		} else {
+			// SAR can not be below the prior two highs
+			sar = math.Max(sar, in.high[i-1])
+			if i > 1 {
+				sar = math.Max(sar, in.high[i-2])
+			}
+			if in.high[i] > sar { // reversal
+				rising = true
+				sar, extreme, acceleration = extreme, in.high[i], step
+			} else if in.low[i] < extreme {
+				extreme = in.low[i]
+				acceleration = math.Min(acceleration+step, maxAcceleration)
+			}
+		}
+		values[i] = sar
+	}
+	return in.expand(values)
+}
+
+// ichimokuLines holds the computed Ichimoku Kinko Hyo lines.
+type ichimokuLines struct {
+	conversion, base, spanA, spanB []float64
+}
+
+// ichimokuTrend computes the Ichimoku lines. The leading spans are displaced forward by the base period, with values
+// projected beyond the end of the series dropped.
+func ichimokuTrend(in trendInput, conversionPeriod, basePeriod int) ichimokuLines {
+	if conversionPeriod <= 0 {
+		conversionPeriod = defaultIchimokuConversion
+	}
+	if basePeriod <= 0 {
+		basePeriod = defaultIchimokuBase
+	}
+	n := len(in.close)
+	conversion := make([]float64, n)
+	base := make([]float64, n)
+	spanA := make([]float64, n)
+	spanB := make([]float64, n)
+	for i := 0; i < n; i++ {
+		high, low := in.rangeExtremes(i, conversionPeriod)
+		conversion[i] = (high + low) / 2
+		high, low = in.rangeExtremes(i, basePeriod)
+		base[i] = (high + low) / 2
+		spanA[i] = GetNullValue()
+		spanB[i] = GetNullValue()
+	}
+	for i := 0; i+basePeriod < n; i++ {
+		spanA[i+basePeriod] = (conversion[i] + base[i]) / 2
+		high, low := in.rangeExtremes(i, 2*basePeriod)
+		spanB[i+basePeriod] = (high + low) / 2
+	}
+	return ichimokuLines{
+		conversion: in.expand(conversion),
+		base:       in.expand(base),
+		spanA:      in.expand(spanA),
+		spanB:      in.expand(spanB),
+	}
+}
+
+// macdTrend computes the MACD line (fast EMA - slow EMA) and the signal line (EMA of the MACD line), preserving null
+// positions.
+func macdTrend(y []float64, fastPeriod, slowPeriod, signalPeriod int) ([]float64, []float64) {
+	if fastPeriod <= 0 {
+		fastPeriod = defaultMACDFastPeriod
+	}
+	if slowPeriod <= 0 {
+		slowPeriod = defaultMACDSlowPeriod
+	}
+	if signalPeriod <= 0 {
+		signalPeriod = defaultMACDSignalPeriod
+	}
+	cleanData, cleanIndices := extractNonNullData(y)
+	fast := emaValues(cleanData, fastPeriod)
+	slow := emaValues(cleanData, slowPeriod)
+	macdValues := make([]float64, len(cleanData))
+	for i := range cleanData {
+		macdValues[i] = fast[i] - slow[i]
+	}
+	signalValues := emaValues(macdValues, signalPeriod)
+
+	macd := initResultWithNulls(y)
+	signal := initResultWithNulls(y)
+	for i, idx := range cleanIndices {
+		macd[idx] = macdValues[i]
+		signal[idx] = signalValues[i]
+	}
+	return macd, signal
+}
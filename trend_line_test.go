@@ -3,6 +3,7 @@ package charts
 import (
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -363,7 +364,7 @@ func TestTrendLine_WithNullValues(t *testing.T) {
 
 	t.Run("bollinger_upper_with_nulls", func(t *testing.T) {
 		input := []float64{10, 20, nv, 30, 40, 50}
-		result, err := bollingerUpperTrend(input, 3)
+		result, err := bollingerUpperTrend(input, 3, 0)
 		require.NoError(t, err)
 
 		// Verify nulls preserved
@@ -381,7 +382,7 @@ func TestTrendLine_WithNullValues(t *testing.T) {
 
 	t.Run("bollinger_lower_with_nulls", func(t *testing.T) {
 		input := []float64{10, 20, nv, 30, 40, 50}
-		result, err := bollingerLowerTrend(input, 3)
+		result, err := bollingerLowerTrend(input, 3, 0)
 		require.NoError(t, err)
 
 		// Verify nulls preserved
@@ -421,11 +422,11 @@ func TestTrendLine_WithNullValues(t *testing.T) {
 		require.NoError(t, err)
 		assert.Len(t, ema, len(input))
 
-		upper, err := bollingerUpperTrend(input, 2)
+		upper, err := bollingerUpperTrend(input, 2, 0)
 		require.NoError(t, err)
 		assert.Len(t, upper, len(input))
 
-		lower, err := bollingerLowerTrend(input, 2)
+		lower, err := bollingerLowerTrend(input, 2, 0)
 		require.NoError(t, err)
 		assert.Len(t, lower, len(input))
 
@@ -626,7 +627,7 @@ func TestBollingerUpperTrend(t *testing.T) {
 	t.Parallel()
 
 	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-	result, err := bollingerUpperTrend(values, 3)
+	result, err := bollingerUpperTrend(values, 3, 0)
 
 	require.NoError(t, err)
 	require.Len(t, result, 10)
@@ -647,7 +648,7 @@ func TestBollingerLowerTrend(t *testing.T) {
 	t.Parallel()
 
 	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-	result, err := bollingerLowerTrend(values, 3)
+	result, err := bollingerLowerTrend(values, 3, 0)
 
 	require.NoError(t, err)
 	require.Len(t, result, 10)
@@ -685,3 +686,356 @@ func TestRsiTrend(t *testing.T) {
 		assert.LessOrEqual(t, result[i], 100.0)
 	}
 }
+
+func TestWeightedMovingAverageTrend(t *testing.T) {
+	t.Parallel()
+
+	nv := GetNullValue()
+	result, err := weightedMovingAverageTrend([]float64{1, 2, nv, 3, 4}, 3)
+	require.NoError(t, err)
+	require.Len(t, result, 5)
+
+	assert.InDelta(t, 1.0, result[0], 0.001)
+	assert.InDelta(t, 5.0/3.0, result[1], 0.001) // (1*1 + 2*2) / 3
+	assert.InDelta(t, nv, result[2], 0)
+	assert.InDelta(t, 14.0/6.0, result[3], 0.001) // (1*1 + 2*2 + 3*3) / 6
+	assert.InDelta(t, 20.0/6.0, result[4], 0.001) // (2*1 + 3*2 + 4*3) / 6
+}
+
+func TestVwapTrend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cumulative", func(t *testing.T) {
+		in := newTrendInput(trendLineRenderOption{
+			seriesValues: []float64{10, 20, 30},
+			highValues:   []float64{10, 20, 30},
+			lowValues:    []float64{10, 20, 30},
+			volumeValues: []float64{1, 1, 2},
+		})
+		result, err := vwapTrend(in, 0)
+		require.NoError(t, err)
+
+		assert.InDelta(t, 10.0, result[0], 0.001)
+		assert.InDelta(t, 15.0, result[1], 0.001)
+		assert.InDelta(t, 22.5, result[2], 0.001)
+	})
+
+	t.Run("rolling", func(t *testing.T) {
+		in := newTrendInput(trendLineRenderOption{
+			seriesValues: []float64{10, 20, 30},
+			highValues:   []float64{10, 20, 30},
+			lowValues:    []float64{10, 20, 30},
+			volumeValues: []float64{1, 1, 2},
+		})
+		result, err := vwapTrend(in, 2)
+		require.NoError(t, err)
+
+		assert.InDelta(t, 10.0, result[0], 0.001)
+		assert.InDelta(t, 15.0, result[1], 0.001)
+		assert.InDelta(t, 80.0/3.0, result[2], 0.001)
+	})
+
+	t.Run("zero_volume", func(t *testing.T) {
+		in := newTrendInput(trendLineRenderOption{
+			seriesValues: []float64{10, 20},
+			highValues:   []float64{10, 20},
+			lowValues:    []float64{10, 20},
+			volumeValues: []float64{0, 2},
+		})
+		result, err := vwapTrend(in, 0)
+		require.NoError(t, err)
+
+		assert.InDelta(t, GetNullValue(), result[0], 0)
+		assert.InDelta(t, 20.0, result[1], 0.001)
+	})
+
+	t.Run("missing_volume", func(t *testing.T) {
+		_, err := vwapTrend(newTrendInput(trendLineRenderOption{
+			seriesValues: []float64{1, 2},
+			highValues:   []float64{1, 2},
+			lowValues:    []float64{1, 2},
+		}), 0)
+		require.Error(t, err)
+	})
+
+	t.Run("missing_range", func(t *testing.T) {
+		_, err := vwapTrend(newTrendInput(trendLineRenderOption{
+			seriesValues: []float64{1, 2},
+			volumeValues: []float64{1, 1},
+		}), 0)
+		require.Error(t, err)
+	})
+}
+
+func TestAtrTrend(t *testing.T) {
+	t.Parallel()
+
+	in := newTrendInput(trendLineRenderOption{
+		seriesValues: []float64{10, 11, 10},
+		highValues:   []float64{12, 13, 11},
+		lowValues:    []float64{9, 10, 8},
+	})
+	result, err := atrTrend(in, 2)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+
+	assert.InDelta(t, 3.0, result[0], 0.001) // high - low
+	assert.InDelta(t, 3.0, result[1], 0.001) // average of 3 and max(3, 3, 0)
+	assert.InDelta(t, 3.0, result[2], 0.001) // wilder (3 + max(3, 0, 3)) / 2
+
+	t.Run("missing_range", func(t *testing.T) {
+		_, err := atrTrend(newTrendInput(trendLineRenderOption{seriesValues: []float64{10, 11, 10}}), 2)
+		require.Error(t, err)
+	})
+}
+
+func TestKeltnerTrend(t *testing.T) {
+	t.Parallel()
+
+	nv := GetNullValue()
+	in := newTrendInput(trendLineRenderOption{
+		seriesValues: []float64{10, nv, 11, 12, 11, 13},
+		highValues:   []float64{10, nv, 12, 13, 12, 14},
+		lowValues:    []float64{10, nv, 10, 11, 10, 12},
+	})
+	upper, lower, err := keltnerTrend(in, 3, 1.5)
+	require.NoError(t, err)
+	require.Len(t, upper, 6)
+	require.Len(t, lower, 6)
+
+	assert.InDelta(t, nv, upper[1], 0)
+	assert.InDelta(t, nv, lower[1], 0)
+	for _, i := range []int{0, 2, 3, 4, 5} {
+		assert.GreaterOrEqual(t, upper[i], lower[i])
+	}
+	// first point has no range since the high and low match the value
+	assert.InDelta(t, 10.0, upper[0], 0.001)
+	assert.InDelta(t, 10.0, lower[0], 0.001)
+
+	t.Run("missing_range", func(t *testing.T) {
+		_, _, err := keltnerTrend(newTrendInput(trendLineRenderOption{seriesValues: []float64{10, 11, 10}}), 2, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestDonchianTrend(t *testing.T) {
+	t.Parallel()
+
+	in := newTrendInput(trendLineRenderOption{
+		seriesValues: []float64{5, 6, 4, 8, 7},
+		highValues:   []float64{6, 7, 5, 9, 8},
+		lowValues:    []float64{4, 5, 3, 7, 6},
+	})
+	upper, lower := donchianTrend(in, 3)
+
+	assert.Equal(t, []float64{6, 7, 7, 9, 9}, upper)
+	assert.Equal(t, []float64{4, 4, 3, 3, 3}, lower)
+}
+
+func TestParabolicSARTrend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rising", func(t *testing.T) {
+		in := newTrendInput(trendLineRenderOption{
+			seriesValues: []float64{10, 11, 12, 13, 14},
+			highValues:   []float64{10.5, 11.5, 12.5, 13.5, 14.5},
+			lowValues:    []float64{9.5, 10.5, 11.5, 12.5, 13.5},
+		})
+		result := parabolicSARTrend(in, 0, 0)
+		require.Len(t, result, 5)
+
+		assert.InDelta(t, 9.5, result[0], 0.001)
+		for i := 1; i < len(result); i++ {
+			assert.GreaterOrEqual(t, result[i], result[i-1])
+			assert.Less(t, result[i], in.low[i])
+		}
+	})
+
+	t.Run("reversal", func(t *testing.T) {
+		in := newTrendInput(trendLineRenderOption{
+			seriesValues: []float64{10, 11, 12, 8, 7},
+		})
+		result := parabolicSARTrend(in, 0.02, 0.2)
+
+		assert.Less(t, result[2], 12.0)
+		assert.InDelta(t, 12.0, result[3], 0.001) // reversal sets sar to prior extreme
+		assert.Greater(t, result[4], 7.0)
+	})
+
+	t.Run("single_value", func(t *testing.T) {
+		result := parabolicSARTrend(newTrendInput(trendLineRenderOption{seriesValues: []float64{1}}), 0, 0)
+		assert.Equal(t, []float64{GetNullValue()}, result)
+	})
+}
+
+func TestIchimokuTrend(t *testing.T) {
+	t.Parallel()
+
+	values := make([]float64, 12)
+	for i := range values {
+		values[i] = float64(i)
+	}
+	lines := ichimokuTrend(newTrendInput(trendLineRenderOption{seriesValues: values}), 2, 4)
+
+	assert.InDelta(t, 4.5, lines.conversion[5], 0.001) // (5 + 4) / 2
+	assert.InDelta(t, 3.5, lines.base[5], 0.001)       // (5 + 2) / 2
+	for i := 0; i < 4; i++ {
+		assert.InDelta(t, GetNullValue(), lines.spanA[i], 0)
+		assert.InDelta(t, GetNullValue(), lines.spanB[i], 0)
+	}
+	assert.InDelta(t, 4.0, lines.spanA[9], 0.001) // displaced from index 5
+	assert.InDelta(t, 2.5, lines.spanB[9], 0.001) // (5 + 0) / 2
+}
+
+func TestMacdTrend(t *testing.T) {
+	t.Parallel()
+
+	nv := GetNullValue()
+	values := []float64{10, 11, 12, nv, 13, 14, 15, 16}
+	macd, signal := macdTrend(values, 2, 4, 2)
+	require.Len(t, macd, len(values))
+	require.Len(t, signal, len(values))
+
+	assert.InDelta(t, 0.0, macd[0], 0.001)
+	assert.InDelta(t, nv, macd[3], 0)
+	assert.InDelta(t, nv, signal[3], 0)
+	for i := 1; i < len(values); i++ {
+		if i == 3 {
+			continue
+		}
+		assert.Greater(t, macd[i], 0.0) // rising data keeps the fast EMA above the slow EMA
+		assert.Less(t, signal[i], macd[i])
+	}
+}
+
+func TestTrendLineChannelRender(t *testing.T) {
+	t.Parallel()
+
+	render := func(trend SeriesTrendLine) string {
+		p := NewPainter(PainterOptions{
+			OutputFormat: ChartOutputSVG,
+			Width:        600,
+			Height:       400,
+		}, PainterThemeOption(GetTheme(ThemeLight)))
+		trendLine := newTrendLinePainter(p)
+		trendLine.add(trendLineRenderOption{
+			defaultStrokeColor: ColorBlack,
+			xValues:            []int{50, 150, 250, 350, 450, 550},
+			seriesValues:       []float64{5, 6, 4, 8, 7, 9},
+			highValues:         []float64{6, 7, 5, 9, 8, 10},
+			lowValues:          []float64{4, 5, 3, 7, 6, 8},
+			volumeValues:       []float64{1, 2, 1, 2, 1, 2},
+			axisRange:          newTestRange(p.Height(), 6, 0.0, 12.0, 0.0, 0.0),
+			trends:             []SeriesTrendLine{trend},
+		})
+		_, err := trendLine.Render()
+		require.NoError(t, err)
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("donchian_unfilled", func(t *testing.T) {
+		svg := render(SeriesTrendLine{Type: SeriesTrendTypeDonchian, Period: 2})
+		assert.Equal(t, 2, strings.Count(svg, "<path"))
+		assert.NotContains(t, svg, "fill:rgba(0,0,0,0.2)")
+	})
+
+	t.Run("donchian_filled", func(t *testing.T) {
+		svg := render(SeriesTrendLine{Type: SeriesTrendTypeDonchian, Period: 2, FillArea: Ptr(true), FillOpacity: 51})
+		assert.Equal(t, 3, strings.Count(svg, "<path"))
+		assert.Contains(t, svg, "fill:rgba(0,0,0,0.2)")
+	})
+
+	t.Run("ichimoku_cloud_default_fill", func(t *testing.T) {
+		svg := render(SeriesTrendLine{Type: SeriesTrendTypeIchimokuCloud, Period: 1, SlowPeriod: 2})
+		assert.Equal(t, 3, strings.Count(svg, "<path"))
+	})
+
+	t.Run("psar_dots", func(t *testing.T) {
+		svg := render(SeriesTrendLine{Type: SeriesTrendTypeParabolicSAR})
+		assert.Equal(t, 6, strings.Count(svg, "<circle"))
+	})
+
+	for _, trendType := range []string{
+		SeriesTrendTypeWMA, SeriesTrendTypeVWAP, SeriesTrendTypeATR, SeriesTrendTypeKeltnerUpper,
+		SeriesTrendTypeKeltnerLower, SeriesTrendTypeDonchianUpper, SeriesTrendTypeDonchianLower,
+		SeriesTrendTypeIchimokuConversion, SeriesTrendTypeIchimokuBase, SeriesTrendTypeIchimokuSpanA,
+		SeriesTrendTypeIchimokuSpanB, SeriesTrendTypeMACD, SeriesTrendTypeMACDSignal,
+	} {
+		t.Run(trendType, func(t *testing.T) {
+			svg := render(SeriesTrendLine{Type: trendType, Period: 2, SlowPeriod: 2})
+			assert.Equal(t, 1, strings.Count(svg, "<path"))
+		})
+	}
+}
+
+func TestBollingerBands(t *testing.T) {
+	t.Parallel()
+
+	values := []float64{1, 3, 2, 5, 4, 6, 5, 8}
+	upper, middle, lower, err := bollingerBands(values, 3, 0)
+	require.NoError(t, err)
+	sma, err := movingAverageTrend(values, 3)
+	require.NoError(t, err)
+	assert.Equal(t, sma, middle)
+
+	wideUpper, _, wideLower, err := bollingerBands(values, 3, 3)
+	require.NoError(t, err)
+	for i := range values {
+		assert.InDelta(t, middle[i]-lower[i], upper[i]-middle[i], 0.0001)
+		assert.InDelta(t, (upper[i]-middle[i])*1.5, wideUpper[i]-middle[i], 0.0001)
+		assert.InDelta(t, (middle[i]-lower[i])*1.5, middle[i]-wideLower[i], 0.0001)
+	}
+
+	t.Run("period_too_large", func(t *testing.T) {
+		upper, middle, lower, err := bollingerBands([]float64{1, 2, 3}, 5, 2)
+		require.NoError(t, err)
+		nv := GetNullValue()
+		assert.Equal(t, []float64{nv, nv, nv}, upper)
+		assert.Equal(t, []float64{nv, nv, nv}, middle)
+		assert.Equal(t, []float64{nv, nv, nv}, lower)
+	})
+}
+
+func TestTrendLineBollingerBandRender(t *testing.T) {
+	t.Parallel()
+
+	render := func(trend SeriesTrendLine) string {
+		p := NewPainter(PainterOptions{
+			OutputFormat: ChartOutputSVG,
+			Width:        600,
+			Height:       400,
+		}, PainterThemeOption(GetTheme(ThemeLight)))
+		trendLine := newTrendLinePainter(p)
+		trendLine.add(trendLineRenderOption{
+			defaultStrokeColor: ColorBlack,
+			xValues:            []int{50, 150, 250, 350, 450, 550},
+			seriesValues:       []float64{5, 6, 4, 8, 7, 9},
+			axisRange:          newTestRange(p.Height(), 6, 0.0, 12.0, 0.0, 0.0),
+			trends:             []SeriesTrendLine{trend},
+		})
+		_, err := trendLine.Render()
+		require.NoError(t, err)
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("default_fill", func(t *testing.T) {
+		svg := render(SeriesTrendLine{Type: SeriesTrendTypeBollingerBand, Period: 3})
+		assert.Equal(t, 4, strings.Count(svg, "<path")) // fill, upper, lower, middle
+		assert.Contains(t, svg, "fill:rgba(0,0,0,0.2)")
+	})
+
+	t.Run("fill_disabled", func(t *testing.T) {
+		svg := render(SeriesTrendLine{Type: SeriesTrendTypeBollingerBand, Period: 3, FillArea: Ptr(false)})
+		assert.Equal(t, 3, strings.Count(svg, "<path"))
+	})
+
+	t.Run("multiplier", func(t *testing.T) {
+		assert.NotEqual(t,
+			render(SeriesTrendLine{Type: SeriesTrendTypeBollingerUpper, Period: 3}),
+			render(SeriesTrendLine{Type: SeriesTrendTypeBollingerUpper, Period: 3, Multiplier: 1}))
+	})
+}
@@ -38,6 +38,11 @@ func ceilFloatToInt(value float64) int {
 	return i + 1
 }
 
+// roundFloatToInt rounds the value to the nearest int.
+func roundFloatToInt(value float64) int {
+	return int(math.Round(value))
+}
+
 func getDefaultInt(value, defaultValue int) int {
 	if value == 0 {
 		return defaultValue
@@ -194,6 +199,10 @@ func getPolygonPoint(center Point, radius, angle float64) Point {
 	return Point{X: x, Y: y}
 }
 
+func getPolygonPointF(center PointF, radius, angle float64) PointF {
+	return PointF{X: center.X + radius*math.Cos(angle), Y: center.Y + radius*math.Sin(angle)}
+}
+
 func getPolygonPoints(center Point, radius float64, sides int) []Point {
 	points := make([]Point, sides)
 	for i, angle := range getPolygonPointAngles(sides) {
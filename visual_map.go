@@ -0,0 +1,254 @@
+package charts
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	defaultVisualMapLength    = 160
+	defaultVisualMapThickness = 14
+	visualMapLabelGap         = 6
+	visualMapGradientStops    = 11
+)
+
+// VisualMapOption configures a color scale legend, showing how values are mapped to colors as a continuous gradient
+// or as piecewise bins. Render with Painter.VisualMap, or enable on a heat map with HeatMapOption.VisualMap.
+type VisualMapOption struct {
+	// Show specifies if the visual map should be rendered. Set to *false (via Ptr(false)) to hide.
+	Show *bool
+	// Theme specifies the colors used for the labels.
+	Theme ColorPalette
+	// Min is the value mapped to the first color.
+	Min float64
+	// Max is the value mapped to the last color.
+	Max float64
+	// Colors are the colors the value range is mapped to, interpolated from the minimum to the maximum value. Set
+	// from the chart when used with a heat map.
+	Colors []Color
+	// Pieces when greater than zero divides the range into this many equal bins, each shown with a solid color.
+	Pieces int
+	// Vertical when set to *true renders the scale vertically with the maximum value at the top, otherwise it's
+	// rendered horizontally with the minimum value on the left.
+	Vertical *bool
+	// Offset positions the scale within the painter. Left can be a pixel value (20), a percentage value (20%), or
+	// 'left', 'center', 'right'. Top can be a pixel value, a percentage value, or 'top', 'center', 'bottom'.
+	// Default is right and centered for vertical scales, or centered at the bottom for horizontal scales.
+	Offset OffsetStr
+	// Length is the length of the color bar, default is 160.
+	Length int
+	// Thickness is the width of the color bar across its length, default is 14.
+	Thickness int
+	// LabelCount is the number of evenly spaced value labels including the min and max. Default is 2 for continuous
+	// scales, or a label on each bin edge for piecewise scales.
+	LabelCount int
+	// ValueFormatter formats the value labels.
+	ValueFormatter ValueFormatter
+	// FontStyle specifies the font, size, and color of the value labels.
+	FontStyle FontStyle
+	// colorAt when set overrides the Colors, returning the color for the ratio of the value range.
+	colorAt func(ratio float64) Color
+}
+
+// NewVisualMapGradientColor returns a VisualMapOption for the values colored with LabelFormatterGradientColor using
+// the same colors.
+func NewVisualMapGradientColor(values []float64, colors ...Color) VisualMapOption {
+	if len(colors) == 0 {
+		colors = []Color{ColorBlack} // matching the label formatter default
+	}
+	summary := summarizePopulationData(values)
+	return VisualMapOption{
+		Min:    summary.Min,
+		Max:    summary.Max,
+		Colors: colors,
+	}
+}
+
+type visualMapPainter struct {
+	p   *Painter
+	opt *VisualMapOption
+}
+
+// newVisualMapPainter returns a visual map renderer.
+func newVisualMapPainter(p *Painter, opt VisualMapOption) *visualMapPainter {
+	return &visualMapPainter{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// colorAtRatio returns the scale color for the ratio of the value range, binned for piecewise scales.
+func (opt *VisualMapOption) colorAtRatio(ratio float64) Color {
+	if opt.Pieces > 0 {
+		bin := math.Min(math.Floor(ratio*float64(opt.Pieces)), float64(opt.Pieces-1))
+		ratio = (bin + 0.5) / float64(opt.Pieces)
+	}
+	if opt.colorAt != nil {
+		return opt.colorAt(ratio)
+	}
+	return interpolateMultipleColors(opt.Colors, ratio)
+}
+
+// labelValues returns the values labeled along the scale, from the minimum to maximum.
+func (opt *VisualMapOption) labelValues() []float64 {
+	count := opt.LabelCount
+	if count <= 0 {
+		count = 2
+		if opt.Pieces > 0 {
+			count = opt.Pieces + 1
+		}
+	} else if count == 1 {
+		count = 2
+	}
+	values := make([]float64, count)
+	for i := range values {
+		values[i] = opt.Min + (opt.Max-opt.Min)*float64(i)/float64(count-1)
+	}
+	return values
+}
+
+// layout returns the label text, the label font style, and the overall width and height of the scale including its
+// labels.
+func (v *visualMapPainter) layout() ([]string, FontStyle, int, int) {
+	opt := v.opt
+	theme := getPreferredTheme(opt.Theme, v.p.theme)
+	fontStyle := fillFontStyleDefaults(opt.FontStyle, defaultLabelFontSize, theme.GetLabelTextColor(), v.p.font)
+	formatter := getPreferredValueFormatter(opt.ValueFormatter)
+	values := opt.labelValues()
+	labels := make([]string, len(values))
+	var maxWidth, maxHeight int
+	for i, value := range values {
+		labels[i] = formatter(value)
+		box := v.p.MeasureText(labels[i], 0, fontStyle)
+		if box.Width() > maxWidth {
+			maxWidth = box.Width()
+		}
+		if box.Height() > maxHeight {
+			maxHeight = box.Height()
+		}
+	}
+	length := getDefaultInt(opt.Length, defaultVisualMapLength)
+	thickness := getDefaultInt(opt.Thickness, defaultVisualMapThickness)
+	if flagIs(true, opt.Vertical) {
+		return labels, fontStyle, thickness + visualMapLabelGap + maxWidth, length + maxHeight
+	}
+	// the end labels are centered on the ends of the bar
+	return labels, fontStyle, length + maxWidth, thickness + visualMapLabelGap + maxHeight
+}
+
+// Render draws the color scale and its labels, returning the bounding box.
+func (v *visualMapPainter) Render() (Box, error) {
+	opt := v.opt
+	p := v.p
+	if flagIs(false, opt.Show) || (len(opt.Colors) == 0 && opt.colorAt == nil) {
+		return BoxZero, nil
+	}
+	vertical := flagIs(true, opt.Vertical)
+	labels, fontStyle, width, height := v.layout()
+	length := getDefaultInt(opt.Length, defaultVisualMapLength)
+	thickness := getDefaultInt(opt.Thickness, defaultVisualMapThickness)
+
+	offset := opt.Offset
+	if offset.Left == "" {
+		if vertical {
+			offset.Left = PositionRight
+		} else {
+			offset.Left = PositionCenter
+		}
+	}
+	if offset.Top == "" {
+		if vertical {
+			offset.Top = PositionCenter
+		} else {
+			offset.Top = PositionBottom
+		}
+	}
+	var left, top int
+	switch offset.Left {
+	case PositionLeft:
+	case PositionRight:
+		left = p.Width() - width
+	case PositionCenter:
+		left = (p.Width() - width) / 2
+	default:
+		offsetVal, err := parseFlexibleValue(offset.Left, float64(p.Width()))
+		if err != nil {
+			return BoxZero, fmt.Errorf("error parsing visual map position: %w", err)
+		}
+		left = int(offsetVal)
+	}
+	switch offset.Top {
+	case PositionTop:
+	case PositionBottom:
+		top = p.Height() - height
+	case PositionCenter:
+		top = (p.Height() - height) / 2
+	default:
+		offsetVal, err := parseFlexibleValue(offset.Top, float64(p.Height()))
+		if err != nil {
+			return BoxZero, fmt.Errorf("error parsing visual map position: %w", err)
+		}
+		top = int(offsetVal)
+	}
+
+	// the bar is inset by half the label size so that the end labels are centered on the bar ends
+	var barLeft, barTop int
+	if vertical {
+		barLeft, barTop = left, top+(height-length)/2
+	} else {
+		barLeft, barTop = left+(width-length)/2, top
+	}
+	// position returns the offset along the bar for the ratio of the value range
+	position := func(ratio float64) int {
+		if vertical {
+			return barTop + int(math.Round((1-ratio)*float64(length)))
+		}
+		return barLeft + int(math.Round(ratio*float64(length)))
+	}
+
+	if opt.Pieces > 0 {
+		for i := 0; i < opt.Pieces; i++ {
+			start := position(float64(i) / float64(opt.Pieces))
+			end := position(float64(i+1) / float64(opt.Pieces))
+			color := opt.colorAtRatio((float64(i) + 0.5) / float64(opt.Pieces))
+			if vertical {
+				p.FilledRect(barLeft, end, barLeft+thickness, start, color, color, 0)
+			} else {
+				p.FilledRect(start, barTop, end, barTop+thickness, color, color, 0)
+			}
+		}
+	} else {
+		gradient := LinearGradient{X2: 1}
+		if vertical {
+			gradient = LinearGradient{Y1: 1}
+		}
+		gradient.Stops = make([]GradientStop, visualMapGradientStops)
+		for i := range gradient.Stops {
+			ratio := float64(i) / float64(visualMapGradientStops-1)
+			gradient.Stops[i] = GradientStop{Offset: ratio, Color: opt.colorAtRatio(ratio)}
+		}
+		if vertical {
+			p.FilledRectGradient(barLeft, barTop, barLeft+thickness, barTop+length, gradient, ColorTransparent, 0)
+		} else {
+			p.FilledRectGradient(barLeft, barTop, barLeft+length, barTop+thickness, gradient, ColorTransparent, 0)
+		}
+	}
+
+	for i, label := range labels {
+		ratio := float64(i) / float64(len(labels)-1)
+		box := p.MeasureText(label, 0, fontStyle)
+		if vertical {
+			p.Text(label, barLeft+thickness+visualMapLabelGap, position(ratio)+box.Height()/2, 0, fontStyle)
+		} else {
+			p.Text(label, position(ratio)-box.Width()/2, barTop+thickness+visualMapLabelGap+box.Height(), 0, fontStyle)
+		}
+	}
+
+	return Box{
+		Left:   left,
+		Top:    top,
+		Right:  left + width,
+		Bottom: top + height,
+		IsSet:  true,
+	}, nil
+}
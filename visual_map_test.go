@@ -0,0 +1,115 @@
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVisualMapLabelValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		opt      VisualMapOption
+		expected []float64
+	}{
+		{"default", VisualMapOption{Min: 0, Max: 10}, []float64{0, 10}},
+		{"label_count", VisualMapOption{Min: 0, Max: 10, LabelCount: 3}, []float64{0, 5, 10}},
+		{"single_label", VisualMapOption{Min: 0, Max: 10, LabelCount: 1}, []float64{0, 10}},
+		{"pieces", VisualMapOption{Min: 0, Max: 8, Pieces: 4}, []float64{0, 2, 4, 6, 8}},
+		{"pieces_label_count", VisualMapOption{Min: 0, Max: 8, Pieces: 4, LabelCount: 2}, []float64{0, 8}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.opt.labelValues())
+		})
+	}
+}
+
+func TestVisualMapColorAtRatio(t *testing.T) {
+	t.Parallel()
+
+	opt := VisualMapOption{Colors: []Color{ColorBlack, ColorWhite}}
+	assert.Equal(t, ColorBlack, opt.colorAtRatio(0))
+	assert.Equal(t, ColorWhite, opt.colorAtRatio(1))
+
+	opt.Pieces = 2
+	low := opt.colorAtRatio(0.25)
+	assert.Equal(t, low, opt.colorAtRatio(0))
+	assert.Equal(t, low, opt.colorAtRatio(0.49))
+	high := opt.colorAtRatio(0.75)
+	assert.Equal(t, high, opt.colorAtRatio(1))
+	assert.NotEqual(t, low, high)
+}
+
+func TestVisualMapRender(t *testing.T) {
+	t.Parallel()
+
+	values := []float64{3, 8, 1, 12}
+	tests := []struct {
+		name     string
+		opt      VisualMapOption
+		expected Box
+		labels   []string
+	}{
+		{
+			name:     "horizontal",
+			opt:      NewVisualMapGradientColor(values, ColorBlue, ColorRed),
+			expected: NewBox(212, 367, 387, 400),
+			labels:   []string{">1</text>", ">12</text>"},
+		},
+		{
+			name: "vertical_pieces",
+			opt: func() VisualMapOption {
+				opt := NewVisualMapGradientColor(values, ColorBlue, ColorRed)
+				opt.Vertical = Ptr(true)
+				opt.Pieces = 2
+				opt.Offset = OffsetStr{Left: "10", Top: PositionTop}
+				return opt
+			}(),
+			expected: NewBox(10, 0, 49, 173),
+			labels:   []string{">1</text>", ">6.5</text>", ">12</text>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+			box, err := newVisualMapPainter(p, tt.opt).Render()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, box)
+
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			for _, label := range tt.labels {
+				assert.Equal(t, 1, strings.Count(string(data), label))
+			}
+		})
+	}
+}
+
+func TestVisualMapRenderHidden(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	opt := NewVisualMapGradientColor([]float64{1, 2})
+	opt.Show = Ptr(false)
+	box, err := newVisualMapPainter(p, opt).Render()
+	require.NoError(t, err)
+	assert.Equal(t, BoxZero, box)
+
+	box, err = newVisualMapPainter(p, VisualMapOption{Max: 1}).Render()
+	require.NoError(t, err)
+	assert.Equal(t, BoxZero, box)
+}
+
+func TestVisualMapRenderError(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	opt := NewVisualMapGradientColor([]float64{1, 2})
+	opt.Offset = OffsetStr{Left: "invalid"}
+	assert.Error(t, p.VisualMap(opt))
+}
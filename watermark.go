@@ -0,0 +1,94 @@
+package charts
+
+import (
+	"fmt"
+	"image"
+)
+
+const defaultWatermarkMargin = 10
+
+// WatermarkOption configures an image, such as a logo, drawn over or behind a chart.
+type WatermarkOption struct {
+	// Image is the image to draw.
+	Image image.Image
+	// Offset positions the image within the chart. Left can be a pixel value (20), a percentage value (20%), or
+	// 'left', 'center', 'right'. Top can be a pixel value, a percentage value, or 'top', 'center', 'bottom'.
+	// By default the image is drawn in the bottom right corner.
+	Offset OffsetStr
+	// Width is the drawn width of the image. If only one of Width or Height is set the other is scaled to maintain
+	// the aspect ratio, by default the image is drawn at its own size.
+	Width int
+	// Height is the drawn height of the image.
+	Height int
+	// Opacity is the opacity of the image from 0 to 1, default is 1.
+	Opacity *float64
+	// Margin is the space kept between the image and the chart edge when aligned to a side, default is 10.
+	Margin *int
+	// Background when set to *true draws the image behind the chart, otherwise it's drawn over the chart.
+	Background *bool
+	// Filter selects the interpolation used when scaling the image in raster output.
+	Filter ImageFilter
+}
+
+// size returns the drawn width and height of the watermark image.
+func (o *WatermarkOption) size() (int, int) {
+	bounds := o.Image.Bounds()
+	width, height := o.Width, o.Height
+	if width <= 0 && height <= 0 {
+		return bounds.Dx(), bounds.Dy()
+	} else if width <= 0 {
+		width = int(float64(height) * float64(bounds.Dx()) / float64(bounds.Dy()))
+	} else if height <= 0 {
+		height = int(float64(width) * float64(bounds.Dy()) / float64(bounds.Dx()))
+	}
+	return width, height
+}
+
+// renderWatermark draws the watermark image within the painter.
+func renderWatermark(p *Painter, opt *WatermarkOption) error {
+	if opt == nil || opt.Image == nil || opt.Image.Bounds().Empty() {
+		return nil
+	}
+	width, height := opt.size()
+	margin := defaultWatermarkMargin
+	if opt.Margin != nil {
+		margin = *opt.Margin
+	}
+
+	var left, top int
+	switch opt.Offset.Left {
+	case PositionLeft:
+		left = margin
+	case "", PositionRight:
+		left = p.Width() - width - margin
+	case PositionCenter:
+		left = (p.Width() - width) / 2
+	default:
+		v, err := parseFlexibleValue(opt.Offset.Left, float64(p.Width()))
+		if err != nil {
+			return fmt.Errorf("error parsing watermark position: %w", err)
+		}
+		left = int(v)
+	}
+	switch opt.Offset.Top {
+	case PositionTop:
+		top = margin
+	case "", PositionBottom:
+		top = p.Height() - height - margin
+	case PositionCenter:
+		top = (p.Height() - height) / 2
+	default:
+		v, err := parseFlexibleValue(opt.Offset.Top, float64(p.Height()))
+		if err != nil {
+			return fmt.Errorf("error parsing watermark position: %w", err)
+		}
+		top = int(v)
+	}
+
+	opacity := 1.0
+	if opt.Opacity != nil {
+		opacity = *opt.Opacity
+	}
+	p.DrawImage(opt.Image, NewBox(left, top, left+width, top+height), opacity, opt.Filter)
+	return nil
+}
@@ -0,0 +1,116 @@
+package charts
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeTestLogo(width, height int) *image.RGBA {
+	logo := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			logo.SetRGBA(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	return logo
+}
+
+func TestWatermarkSize(t *testing.T) {
+	t.Parallel()
+
+	logo := makeTestLogo(40, 20)
+	tests := []struct {
+		name           string
+		opt            WatermarkOption
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{name: "image_size", opt: WatermarkOption{Image: logo}, expectedWidth: 40, expectedHeight: 20},
+		{name: "width_only", opt: WatermarkOption{Image: logo, Width: 80}, expectedWidth: 80, expectedHeight: 40},
+		{name: "height_only", opt: WatermarkOption{Image: logo, Height: 10}, expectedWidth: 20, expectedHeight: 10},
+		{name: "both", opt: WatermarkOption{Image: logo, Width: 10, Height: 30}, expectedWidth: 10, expectedHeight: 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height := tt.opt.size()
+			assert.Equal(t, tt.expectedWidth, width)
+			assert.Equal(t, tt.expectedHeight, height)
+		})
+	}
+}
+
+func TestWatermarkPosition(t *testing.T) {
+	t.Parallel()
+
+	logo := makeTestLogo(40, 20)
+	tests := []struct {
+		name     string
+		opt      WatermarkOption
+		expected string
+	}{
+		{
+			name:     "default",
+			opt:      WatermarkOption{Image: logo},
+			expected: `<image x="550" y="370" width="40" height="20"`,
+		},
+		{
+			name:     "left_top",
+			opt:      WatermarkOption{Image: logo, Offset: OffsetStr{Left: PositionLeft, Top: PositionTop}, Margin: Ptr(0)},
+			expected: `<image x="0" y="0" width="40" height="20"`,
+		},
+		{
+			name:     "center",
+			opt:      WatermarkOption{Image: logo, Offset: OffsetStr{Left: PositionCenter, Top: PositionCenter}, Width: 80},
+			expected: `<image x="260" y="180" width="80" height="40"`,
+		},
+		{
+			name:     "percent",
+			opt:      WatermarkOption{Image: logo, Offset: OffsetStr{Left: "50%", Top: "25"}, Opacity: Ptr(0.25)},
+			expected: `<image x="300" y="25" width="40" height="20" preserveAspectRatio="none" opacity="0.25"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+			require.NoError(t, renderWatermark(p, &tt.opt))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assert.Contains(t, string(data), tt.expected)
+		})
+	}
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	err := renderWatermark(p, &WatermarkOption{Image: logo, Offset: OffsetStr{Left: "invalid"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "watermark position")
+}
+
+func TestLineRenderWatermark(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{{120, 132, 101, 134, 90, 230, 210}}
+	logo := makeTestLogo(40, 20)
+
+	p, err := LineRender(values, PNGOutputOptionFunc(), WatermarkOptionFunc(WatermarkOption{Image: logo}))
+	require.NoError(t, err)
+	img, err := p.Image()
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, color.RGBAModel.Convert(img.At(560, 380)))
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, color.RGBAModel.Convert(img.At(540, 380)))
+
+	// a background watermark is drawn before the chart
+	p, err = LineRender(values, SVGOutputOptionFunc(),
+		WatermarkOptionFunc(WatermarkOption{Image: logo, Background: Ptr(true)}))
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	svg := string(data)
+	imageIndex := strings.Index(svg, "<image")
+	require.NotEqual(t, -1, imageIndex)
+	assert.Less(t, imageIndex, strings.Index(svg, "<text"))
+}
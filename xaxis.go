@@ -44,10 +44,41 @@ type XAxisOption struct {
 	// LabelCountAdjustment specifies a relative influence on how many labels should be rendered.
 	// Typically, this is negative to result in cleaner graphs, positive values may result in text collisions.
 	LabelCountAdjustment int
+	// LabelWrapWidth wraps category labels at word boundaries to fit within this width in pixels.
+	LabelWrapWidth int
+	// LabelMaxLines limits the number of lines of wrapped labels, with the last line truncated with an ellipsis.
+	// Default is 2.
+	LabelMaxLines int
+	// LabelOverflow specifies how category labels which are too wide to fit along the axis are handled. The default
+	// LabelOverflowSkip shows fewer labels, LabelOverflowWrap wraps the labels to the available width,
+	// LabelOverflowRotate rotates the labels, and LabelOverflowAuto chooses wrapping or rotation if either fits
+	// without truncation, otherwise skipping labels.
+	LabelOverflow string
+	// dataExtent overrides the series data extent when calculating the axis range, so charts can share a range.
+	dataExtent *valueExtent
+	// labelsHidden skips drawing the labels and title, while still reserving their space.
+	labelsHidden bool
 }
 
 const boundaryGapDefaultThreshold = 40
 
+const (
+	// LabelOverflowSkip shows fewer category labels when they don't fit along the axis.
+	LabelOverflowSkip = "skip"
+	// LabelOverflowWrap wraps category labels at word boundaries when they don't fit along the axis.
+	LabelOverflowWrap = "wrap"
+	// LabelOverflowRotate rotates category labels when they don't fit along the axis.
+	LabelOverflowRotate = "rotate"
+	// LabelOverflowAuto chooses between wrapping, rotating, and skipping category labels based on the space available.
+	LabelOverflowAuto = "auto"
+)
+
+const (
+	defaultLabelMaxLines         = 2
+	labelOverflowRotationDegrees = 45
+	labelWrapPadding             = 8 // space between wrapped labels
+)
+
 func (opt *XAxisOption) prep(fallbackTheme ColorPalette) *XAxisOption {
 	opt.Theme = getPreferredTheme(opt.Theme, fallbackTheme)
 	if opt.LabelFontStyle.IsZero() {
@@ -60,6 +91,15 @@ func (opt *XAxisOption) prep(fallbackTheme ColorPalette) *XAxisOption {
 	return opt
 }
 
+// labelOverflow returns the configuration for handling category labels which don't fit.
+func (opt *XAxisOption) labelOverflow() categoryLabelOverflow {
+	return categoryLabelOverflow{
+		strategy:  opt.LabelOverflow,
+		wrapWidth: opt.LabelWrapWidth,
+		maxLines:  opt.LabelMaxLines,
+	}
+}
+
 // toAxisOption converts the XAxisOption to axisOption after prep has been invoked.
 func (opt *XAxisOption) toAxisOption(xAxisRange axisRange) axisOption {
 	position := PositionBottom
@@ -68,6 +108,7 @@ func (opt *XAxisOption) toAxisOption(xAxisRange axisRange) axisOption {
 	}
 	axisOpt := axisOption{
 		show:               opt.Show,
+		labelsHidden:       opt.labelsHidden,
 		aRange:             xAxisRange,
 		title:              opt.Title,
 		titleFontStyle:     opt.TitleFontStyle,
@@ -41,6 +41,12 @@ type YAxisOption struct {
 	LabelCountAdjustment int
 	// LabelSkipCount specifies a qty of lines between labels that show only horizontal lines without labels.
 	LabelSkipCount int
+	// LabelWrapWidth wraps category labels (such as horizontal bar chart names) at word boundaries to fit within this
+	// width in pixels.
+	LabelWrapWidth int
+	// LabelMaxLines limits the number of lines of wrapped labels, with the last line truncated with an ellipsis.
+	// Default is 2.
+	LabelMaxLines int
 	// SplitLineShow when set to *true shows horizontal axis split lines.
 	SplitLineShow *bool
 	// SpineLineShow controls whether the vertical spine line is shown.
@@ -49,6 +55,10 @@ type YAxisOption struct {
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
 	isCategoryAxis bool
+	// dataExtent overrides the series data extent when calculating the axis range, so charts can share a range.
+	dataExtent *valueExtent
+	// labelsHidden skips drawing the labels and title, while still reserving their space.
+	labelsHidden bool
 }
 
 func (opt *YAxisOption) prep(fallbackTheme ColorPalette) *YAxisOption {
@@ -63,10 +73,19 @@ func (opt *YAxisOption) prep(fallbackTheme ColorPalette) *YAxisOption {
 	return opt
 }
 
+// labelOverflow returns the configuration for wrapping category labels.
+func (opt *YAxisOption) labelOverflow() categoryLabelOverflow {
+	return categoryLabelOverflow{
+		wrapWidth: opt.LabelWrapWidth,
+		maxLines:  opt.LabelMaxLines,
+	}
+}
+
 // toAxisOption converts the YAxisOption to axisOption after prep has been invoked.
 func (opt *YAxisOption) toAxisOption(yAxisRange axisRange) axisOption {
 	axisOpt := axisOption{
 		show:               opt.Show,
+		labelsHidden:       opt.labelsHidden,
 		aRange:             yAxisRange,
 		title:              opt.Title,
 		titleFontStyle:     opt.TitleFontStyle,